@@ -32,7 +32,8 @@ export function deleteFeedSource(id: string) {
 export function triggerFeedSync(feedSourceId?: string) {
   return apiRequest<{
     startedAt?: string;
-    finishedAt?: string;
+    jobId: string;
+    attached?: boolean;
   }>("/api/v1/admin/feeds:sync", {
     method: "POST",
     body: { feedSourceId: feedSourceId ?? "" },
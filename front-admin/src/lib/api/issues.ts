@@ -4,7 +4,6 @@ import type {
   ListIssuesResponse,
   ManagedSyncRepo,
   SyncConfig,
-  SyncRepoResult,
   SyncStatus,
 } from "@/lib/api/types";
 
@@ -54,8 +53,8 @@ export function getSyncStatus() {
 export function triggerIssueSync(repo?: string) {
   return apiRequest<{
     startedAt?: string;
-    finishedAt?: string;
-    results: SyncRepoResult[];
+    jobId: string;
+    attached?: boolean;
   }>("/api/v1/admin/issues:sync", {
     method: "POST",
     body: { repo: repo ?? "" },
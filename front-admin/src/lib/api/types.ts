@@ -90,6 +90,9 @@ export type SyncConfig = {
   pageSize: number;
   maxPagesPerRun: number;
   requestTimeoutSeconds: number;
+  concurrency?: number;
+  reconcileIntervalSeconds?: number;
+  discoveryIntervalSeconds?: number;
   storageDriver?: string;
   githubTokenConfigured?: boolean;
 };
@@ -27,6 +27,9 @@ const syncConfigSchema = z.object({
   pageSize: z.coerce.number().int().min(1),
   maxPagesPerRun: z.coerce.number().int().min(1),
   requestTimeoutSeconds: z.coerce.number().int().min(1),
+  concurrency: z.coerce.number().int().min(1),
+  reconcileIntervalSeconds: z.coerce.number().int().min(0),
+  discoveryIntervalSeconds: z.coerce.number().int().min(1),
 });
 
 const managedRepoSchema = z.object({
@@ -71,6 +74,9 @@ export function IssueSyncPage() {
       pageSize: 50,
       maxPagesPerRun: 5,
       requestTimeoutSeconds: 10,
+      concurrency: 4,
+      reconcileIntervalSeconds: 0,
+      discoveryIntervalSeconds: 3600,
     },
   });
   const managedRepoForm = useForm<ManagedRepoFormValues>({
@@ -88,6 +94,9 @@ export function IssueSyncPage() {
       pageSize: configQuery.data.pageSize,
       maxPagesPerRun: configQuery.data.maxPagesPerRun,
       requestTimeoutSeconds: configQuery.data.requestTimeoutSeconds,
+      concurrency: configQuery.data.concurrency ?? 4,
+      reconcileIntervalSeconds: configQuery.data.reconcileIntervalSeconds ?? 0,
+      discoveryIntervalSeconds: configQuery.data.discoveryIntervalSeconds ?? 3600,
     });
   }, [configQuery.data, form]);
 
@@ -125,6 +134,9 @@ export function IssueSyncPage() {
         pageSize: values.pageSize,
         maxPagesPerRun: values.maxPagesPerRun,
         requestTimeoutSeconds: values.requestTimeoutSeconds,
+        concurrency: values.concurrency,
+        reconcileIntervalSeconds: values.reconcileIntervalSeconds,
+        discoveryIntervalSeconds: values.discoveryIntervalSeconds,
       }),
     onSuccess: async () => {
       await queryClient.invalidateQueries({ queryKey: ["issue-sync-config"] });
@@ -403,6 +415,19 @@ export function IssueSyncPage() {
               <Label htmlFor="requestTimeoutSeconds">Request Timeout</Label>
               <Input id="requestTimeoutSeconds" type="number" {...form.register("requestTimeoutSeconds")} />
             </div>
+            <div className="space-y-2">
+              <Label htmlFor="concurrency">Concurrency</Label>
+              <Input id="concurrency" type="number" {...form.register("concurrency")} />
+            </div>
+            <div className="space-y-2">
+              <Label htmlFor="reconcileIntervalSeconds">Reconcile Interval Seconds</Label>
+              <Input id="reconcileIntervalSeconds" type="number" {...form.register("reconcileIntervalSeconds")} />
+              <p className="text-xs text-muted-foreground">设为 0 关闭全量对账</p>
+            </div>
+            <div className="space-y-2">
+              <Label htmlFor="discoveryIntervalSeconds">Discovery Interval Seconds</Label>
+              <Input id="discoveryIntervalSeconds" type="number" {...form.register("discoveryIntervalSeconds")} />
+            </div>
 
             <div className="md:col-span-2">
               <Button type="submit" disabled={saveMutation.isPending}>
@@ -15,6 +15,7 @@ require (
 	github.com/go-sql-driver/mysql v1.9.3
 	github.com/google/go-github/v82 v82.0.1-0.20260206204523-c8fd3f74bcd3
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.7
+	github.com/klauspost/compress v1.17.11
 	github.com/lib/pq v1.11.2
 	github.com/openai/openai-go v1.8.3
 	github.com/redis/go-redis/v9 v9.6.3
@@ -102,7 +103,6 @@ require (
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
-	github.com/klauspost/compress v1.17.11 // indirect
 	github.com/klauspost/cpuid/v2 v2.2.11 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
 	github.com/mailru/easyjson v0.9.2 // indirect
@@ -438,6 +438,10 @@ func (m *ListBlogPostsRequest) validate(all bool) error {
 
 	// no validation rules for Query
 
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotal
+
 	if len(errors) > 0 {
 		return ListBlogPostsRequestMultiError(errors)
 	}
@@ -580,6 +584,10 @@ func (m *ListBlogPostsResponse) validate(all bool) error {
 
 	// no validation rules for HasNext
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
 		return ListBlogPostsResponseMultiError(errors)
 	}
@@ -3813,72 +3813,75 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1061 bytes of a gzipped FileDescriptorProto
+	// 1117 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5b, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0xa6, 0x49, 0x4e, 0x5a, 0xb4, 0x0c, 0x6d, 0xd7, 0x75, 0xb7, 0xdb, 0xd4, 0xab,
-	0xa5, 0xa1, 0xa0, 0x98, 0x66, 0xc5, 0x03, 0x57, 0xa9, 0x5b, 0x50, 0x01, 0xa1, 0x15, 0xb8, 0xf0,
-	0xc2, 0x4b, 0x34, 0x89, 0x67, 0x1d, 0xab, 0xf1, 0x65, 0x33, 0xe3, 0xb0, 0x17, 0x2a, 0x10, 0x42,
-	0xe2, 0x85, 0x37, 0x5e, 0xf8, 0x37, 0x48, 0xfc, 0x05, 0xfe, 0x02, 0x0f, 0xfc, 0x0a, 0x84, 0xe6,
-	0x62, 0xc7, 0xf1, 0xa5, 0x97, 0x15, 0x4f, 0xf5, 0x9c, 0x39, 0xe3, 0xef, 0x9b, 0xf3, 0x7d, 0xe7,
-	0x34, 0x06, 0x34, 0x9a, 0x86, 0xae, 0x35, 0x3f, 0xb2, 0xa2, 0x90, 0xb2, 0x7e, 0x34, 0x0b, 0x59,
-	0x88, 0x9a, 0x3c, 0xd6, 0x9f, 0x1f, 0x19, 0x77, 0xdc, 0x30, 0x74, 0xa7, 0xc4, 0xc2, 0x91, 0x67,
-	0xe1, 0x20, 0x08, 0x19, 0x66, 0x5e, 0x18, 0x50, 0x99, 0x66, 0xec, 0xa9, 0x5d, 0xb1, 0x1a, 0xc5,
-	0x8f, 0x2d, 0xe6, 0xf9, 0x84, 0x32, 0xec, 0x47, 0x32, 0xc1, 0xfc, 0xb7, 0x06, 0xad, 0x87, 0xd3,
-	0xd0, 0xfd, 0x32, 0xa4, 0x0c, 0xbd, 0x02, 0x35, 0xcf, 0xd1, 0xb5, 0xae, 0xd6, 0x6b, 0xdb, 0x35,
-	0xcf, 0x41, 0x1b, 0xd0, 0x60, 0x1e, 0x9b, 0x12, 0xbd, 0x26, 0x42, 0x72, 0x81, 0x10, 0xac, 0xd0,
-	0x69, 0xec, 0xea, 0x75, 0x11, 0x14, 0xcf, 0x48, 0x87, 0x26, 0x8d, 0x7d, 0x1f, 0xcf, 0x9e, 0xe9,
-	0x2b, 0x22, 0x9c, 0x2c, 0xf9, 0xce, 0x38, 0x0c, 0x18, 0x09, 0x98, 0xde, 0x90, 0x3b, 0x6a, 0xc9,
-	0xdf, 0xc3, 0xb0, 0x4b, 0xf5, 0xd5, 0x6e, 0x9d, 0xbf, 0x87, 0x3f, 0xa3, 0x2d, 0x58, 0xa5, 0x0c,
-	0xb3, 0x98, 0xea, 0x4d, 0x91, 0xac, 0x56, 0xe8, 0x1e, 0xac, 0x8f, 0x43, 0xdf, 0x27, 0x01, 0x1b,
-	0x8e, 0xc3, 0x38, 0x60, 0x7a, 0xab, 0xab, 0xf5, 0x1a, 0xf6, 0x9a, 0x0a, 0x9e, 0xf0, 0x18, 0x7a,
-	0x17, 0x60, 0x3c, 0x23, 0x98, 0x11, 0x67, 0x88, 0x99, 0xde, 0xee, 0x6a, 0xbd, 0xce, 0xc0, 0xe8,
-	0xcb, 0x0a, 0xf4, 0x93, 0x0a, 0xf4, 0xbf, 0x4e, 0x2a, 0x60, 0xb7, 0x55, 0xf6, 0xb1, 0x38, 0x1a,
-	0x47, 0x4e, 0x72, 0x14, 0xae, 0x3e, 0xaa, 0xb2, 0x8f, 0x19, 0xfa, 0x10, 0xd6, 0xa2, 0x78, 0x34,
-	0xf5, 0xe8, 0x44, 0x1e, 0xee, 0x5c, 0x79, 0xb8, 0x93, 0xe6, 0x1f, 0x33, 0xf3, 0x8f, 0x1a, 0x74,
-	0xb8, 0x00, 0x27, 0xf2, 0x26, 0x05, 0x0d, 0x6e, 0x43, 0x93, 0xcb, 0x3e, 0xf4, 0x1c, 0xa5, 0xc2,
-	0x2a, 0x5f, 0x7e, 0xe6, 0xa0, 0x1d, 0x68, 0x8b, 0x8d, 0x8c, 0x16, 0x2d, 0x1e, 0x38, 0xe3, 0x7a,
-	0xec, 0x41, 0x07, 0xc7, 0x6c, 0x12, 0xce, 0x86, 0x01, 0xf6, 0x89, 0xd2, 0x04, 0x64, 0xe8, 0x11,
-	0xf6, 0x09, 0xda, 0x87, 0x35, 0x95, 0x40, 0x7c, 0xec, 0x4d, 0x95, 0x36, 0xea, 0xd0, 0x27, 0x3c,
-	0x94, 0x55, 0x6e, 0x75, 0x59, 0xb9, 0x2a, 0x95, 0x96, 0x05, 0x68, 0xbd, 0xbc, 0x00, 0xed, 0x1b,
-	0x08, 0x60, 0xfe, 0xa2, 0xc1, 0xc6, 0x17, 0x1e, 0x65, 0x89, 0x8d, 0xa9, 0x4d, 0x9e, 0xc4, 0x84,
-	0x0a, 0x83, 0x45, 0xd8, 0x25, 0xa2, 0x98, 0x0d, 0x5b, 0x3c, 0x8b, 0xaa, 0x61, 0x97, 0x0c, 0xa9,
-	0xf7, 0x5c, 0xda, 0xba, 0x61, 0xb7, 0x78, 0xe0, 0xcc, 0x7b, 0x4e, 0x32, 0xf7, 0xaa, 0x2f, 0xdd,
-	0xeb, 0x16, 0xd4, 0x19, 0x76, 0x55, 0x15, 0xf9, 0x23, 0xef, 0x8c, 0x27, 0x31, 0x99, 0x3d, 0x53,
-	0x75, 0x93, 0x0b, 0xf3, 0x57, 0x0d, 0x36, 0x73, 0x4c, 0x68, 0x14, 0x06, 0x94, 0xa0, 0x03, 0x68,
-	0x70, 0x6d, 0xa8, 0xae, 0x75, 0xeb, 0xbd, 0xce, 0xe0, 0xd5, 0xbe, 0x6a, 0xdf, 0x7e, 0x92, 0x6a,
-	0xcb, 0xfd, 0x94, 0x73, 0xad, 0x8a, 0x73, 0x3d, 0xc7, 0x79, 0x1b, 0x5a, 0x13, 0x4c, 0x87, 0x01,
-	0x79, 0xca, 0x04, 0xc1, 0x96, 0xdd, 0x9c, 0x60, 0xfa, 0x88, 0x3c, 0x65, 0x66, 0x0f, 0xd0, 0x29,
-	0x49, 0xc9, 0x64, 0xaa, 0x22, 0x2c, 0xa3, 0x2d, 0xda, 0xd7, 0xfc, 0x00, 0x5e, 0x5b, 0xca, 0x54,
-	0xac, 0xef, 0xc3, 0x0a, 0x67, 0x25, 0x52, 0x4b, 0x49, 0x8b, 0x6d, 0xf3, 0x23, 0xd8, 0x3c, 0x11,
-	0x42, 0xe6, 0xa1, 0xae, 0x7f, 0xfe, 0x1b, 0xa1, 0xe6, 0x4b, 0x9e, 0x3f, 0x80, 0xcd, 0x8f, 0xc9,
-	0x94, 0x14, 0xcf, 0xe7, 0x7a, 0xc9, 0xec, 0xc1, 0x56, 0x3e, 0x51, 0xdd, 0x34, 0x9f, 0xf9, 0x03,
-	0xdc, 0x4e, 0x84, 0x54, 0x8d, 0x99, 0xba, 0x6a, 0xa9, 0xef, 0xb4, 0x5c, 0xdf, 0xdd, 0x58, 0xbe,
-	0x85, 0xe5, 0x56, 0xb2, 0x96, 0x33, 0x7f, 0xd7, 0x40, 0x2f, 0x32, 0x50, 0x6c, 0xdf, 0x86, 0x96,
-	0x1a, 0x7c, 0x89, 0xa1, 0x36, 0x96, 0x6a, 0xa3, 0x0e, 0xd8, 0x69, 0xd6, 0xff, 0x6a, 0x2b, 0x17,
-	0xf4, 0x85, 0xdc, 0x09, 0xd4, 0x75, 0x8a, 0xd3, 0xe7, 0x03, 0x45, 0xa4, 0x0b, 0x1e, 0x55, 0xac,
-	0x93, 0x24, 0xae, 0xab, 0x72, 0x65, 0x0e, 0x25, 0xaf, 0xd6, 0xa7, 0xb0, 0x95, 0x4f, 0x54, 0x95,
-	0xca, 0x40, 0x6a, 0xd7, 0x81, 0xfc, 0x1c, 0xf4, 0x85, 0x15, 0x73, 0xa8, 0x37, 0x7d, 0xd7, 0x21,
-	0xe8, 0x0b, 0xb7, 0x5d, 0x71, 0x83, 0x37, 0x61, 0xbb, 0x24, 0xb7, 0xdc, 0x9c, 0x83, 0x7f, 0xea,
-	0x70, 0x8b, 0xe7, 0x7d, 0xc5, 0x87, 0xce, 0x19, 0x99, 0xcd, 0xbd, 0x31, 0x41, 0x8f, 0xa1, 0xcd,
-	0xfd, 0x22, 0xc6, 0x0e, 0xda, 0x4d, 0x99, 0x95, 0x0d, 0x46, 0xe3, 0x6e, 0xd5, 0xb6, 0x04, 0x34,
-	0x8d, 0x9f, 0xfe, 0xfa, 0xfb, 0xb7, 0xda, 0x06, 0x42, 0xe2, 0x57, 0xc5, 0xfc, 0xc8, 0x12, 0x3f,
-	0x40, 0xe4, 0x80, 0x72, 0xa1, 0x79, 0x4a, 0x04, 0x0c, 0xda, 0x49, 0x5f, 0x53, 0x1c, 0x33, 0xc6,
-	0x9d, 0xf2, 0x4d, 0x85, 0xb0, 0x2f, 0x10, 0x76, 0xd0, 0x76, 0x11, 0xc1, 0x7a, 0xc1, 0xcd, 0x73,
-	0x81, 0x7e, 0xd6, 0x60, 0x8d, 0xd3, 0x4b, 0xdc, 0x8f, 0xba, 0x05, 0xd6, 0xb9, 0xd6, 0x34, 0xf6,
-	0x2f, 0xc9, 0x50, 0xc0, 0x96, 0x00, 0x7e, 0x03, 0x1d, 0x94, 0x01, 0xa7, 0xd6, 0xbd, 0xb0, 0xd2,
-	0xce, 0xf9, 0x1e, 0xd6, 0xa5, 0xdb, 0x93, 0x7f, 0xd0, 0x0b, 0x90, 0xaa, 0x2e, 0x30, 0x4a, 0x8d,
-	0x61, 0x0e, 0x04, 0xf4, 0x5b, 0xef, 0x69, 0x87, 0xe6, 0x75, 0xd1, 0x07, 0x7f, 0x36, 0xa4, 0xd4,
-	0xc7, 0x8e, 0xef, 0x05, 0x89, 0xd4, 0x0e, 0x80, 0x84, 0x16, 0x2a, 0xdc, 0x2d, 0xe1, 0x93, 0x15,
-	0xa2, 0x38, 0x36, 0xcd, 0x7b, 0x82, 0xc9, 0x2e, 0x67, 0xa2, 0x27, 0x4c, 0x30, 0x47, 0xc8, 0x0a,
-	0xed, 0x00, 0xc8, 0x56, 0xc8, 0xa1, 0x94, 0x8e, 0xea, 0xcb, 0x51, 0x06, 0xd5, 0x28, 0x0c, 0x40,
-	0x1a, 0x3f, 0x87, 0x52, 0x3a, 0xd0, 0x8d, 0xbd, 0xca, 0x7d, 0x25, 0xef, 0x7d, 0x81, 0xb9, 0x77,
-	0xb8, 0x5b, 0x05, 0x68, 0xbd, 0xf0, 0x9c, 0x0b, 0xf4, 0x1d, 0xc0, 0x29, 0x49, 0x9c, 0x95, 0x41,
-	0x2d, 0x1d, 0x37, 0x19, 0xd4, 0xf2, 0x29, 0x63, 0xf6, 0x04, 0xaa, 0x89, 0xba, 0x25, 0xa8, 0x89,
-	0x94, 0x12, 0x38, 0x82, 0x75, 0x59, 0xbf, 0xa2, 0x9b, 0xaa, 0xe6, 0x4e, 0x85, 0x9b, 0x5e, 0x17,
-	0x98, 0x5d, 0x5e, 0xdd, 0x9d, 0x4b, 0x60, 0xd1, 0x8f, 0x1a, 0xac, 0xcb, 0x62, 0x15, 0x21, 0xab,
-	0xc6, 0x93, 0x61, 0x5e, 0x96, 0xb2, 0x7c, 0xe9, 0xc3, 0x2b, 0x2f, 0xfd, 0xf0, 0x9d, 0x6f, 0x1f,
-	0xb8, 0x1e, 0x9b, 0xc4, 0xa3, 0xfe, 0x38, 0xf4, 0xad, 0xf3, 0x30, 0x70, 0xcf, 0x49, 0x60, 0x39,
-	0x98, 0x61, 0x3a, 0x9b, 0x5b, 0xd1, 0xb9, 0x2b, 0x3f, 0x4f, 0x2c, 0xf5, 0x99, 0xf3, 0x3e, 0xff,
-	0x3b, 0x3f, 0x1a, 0xad, 0x8a, 0xe8, 0x83, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xb6, 0xd9,
-	0xa0, 0xff, 0x0c, 0x00, 0x00,
+	0x14, 0x96, 0x93, 0xa6, 0x49, 0x4e, 0x5a, 0x58, 0x86, 0xb6, 0xeb, 0xba, 0xdb, 0x36, 0xf5, 0x6a,
+	0xb7, 0xa1, 0xa0, 0x98, 0x66, 0xc5, 0x03, 0x57, 0xa9, 0x5b, 0x50, 0x01, 0xa1, 0xd5, 0xe2, 0x96,
+	0x17, 0x5e, 0xa2, 0x49, 0x3c, 0xeb, 0x58, 0xf5, 0x6d, 0x33, 0xe3, 0xb0, 0x17, 0x2a, 0x10, 0xe2,
+	0x1f, 0xf0, 0xc2, 0xbf, 0x41, 0xe2, 0x11, 0x1e, 0xf9, 0x0b, 0x3c, 0xf0, 0x2b, 0x10, 0x9a, 0x8b,
+	0x9d, 0xc4, 0xb1, 0x7b, 0x59, 0xf1, 0x54, 0xcf, 0x99, 0x6f, 0xe6, 0x3b, 0xe7, 0x7c, 0xdf, 0x1c,
+	0x35, 0x80, 0x06, 0x7e, 0xe4, 0x5a, 0x93, 0x43, 0x2b, 0x8e, 0x28, 0xeb, 0xc6, 0xe3, 0x88, 0x45,
+	0xa8, 0xce, 0x63, 0xdd, 0xc9, 0xa1, 0x71, 0xc7, 0x8d, 0x22, 0xd7, 0x27, 0x16, 0x8e, 0x3d, 0x0b,
+	0x87, 0x61, 0xc4, 0x30, 0xf3, 0xa2, 0x90, 0x4a, 0x98, 0xb1, 0xab, 0x76, 0xc5, 0x6a, 0x90, 0x3c,
+	0xb1, 0x98, 0x17, 0x10, 0xca, 0x70, 0x10, 0x4b, 0x80, 0xf9, 0x6f, 0x05, 0x1a, 0x0f, 0xfd, 0xc8,
+	0x7d, 0x1c, 0x51, 0x86, 0x5e, 0x83, 0x8a, 0xe7, 0xe8, 0x5a, 0x5b, 0xeb, 0x34, 0xed, 0x8a, 0xe7,
+	0xa0, 0x35, 0xa8, 0x31, 0x8f, 0xf9, 0x44, 0xaf, 0x88, 0x90, 0x5c, 0x20, 0x04, 0x4b, 0xd4, 0x4f,
+	0x5c, 0xbd, 0x2a, 0x82, 0xe2, 0x1b, 0xe9, 0x50, 0xa7, 0x49, 0x10, 0xe0, 0xf1, 0x73, 0x7d, 0x49,
+	0x84, 0xd3, 0x25, 0xdf, 0x19, 0x46, 0x21, 0x23, 0x21, 0xd3, 0x6b, 0x72, 0x47, 0x2d, 0xf9, 0x3d,
+	0x0c, 0xbb, 0x54, 0x5f, 0x6e, 0x57, 0xf9, 0x3d, 0xfc, 0x1b, 0x6d, 0xc0, 0x32, 0x65, 0x98, 0x25,
+	0x54, 0xaf, 0x0b, 0xb0, 0x5a, 0xa1, 0xbb, 0xb0, 0x3a, 0x8c, 0x82, 0x80, 0x84, 0xac, 0x3f, 0x8c,
+	0x92, 0x90, 0xe9, 0x8d, 0xb6, 0xd6, 0xa9, 0xd9, 0x2b, 0x2a, 0x78, 0xcc, 0x63, 0xe8, 0x7d, 0x80,
+	0xe1, 0x98, 0x60, 0x46, 0x9c, 0x3e, 0x66, 0x7a, 0xb3, 0xad, 0x75, 0x5a, 0x3d, 0xa3, 0x2b, 0x3b,
+	0xd0, 0x4d, 0x3b, 0xd0, 0x3d, 0x4b, 0x3b, 0x60, 0x37, 0x15, 0xfa, 0x48, 0x1c, 0x4d, 0x62, 0x27,
+	0x3d, 0x0a, 0x57, 0x1f, 0x55, 0xe8, 0x23, 0x86, 0x3e, 0x86, 0x95, 0x38, 0x19, 0xf8, 0x1e, 0x1d,
+	0xc9, 0xc3, 0xad, 0x2b, 0x0f, 0xb7, 0x32, 0xfc, 0x11, 0x33, 0x7f, 0xab, 0x40, 0x8b, 0x0b, 0x70,
+	0x2c, 0x2b, 0x59, 0xd0, 0xe0, 0x36, 0xd4, 0xb9, 0xec, 0x7d, 0xcf, 0x51, 0x2a, 0x2c, 0xf3, 0xe5,
+	0x17, 0x0e, 0xda, 0x82, 0xa6, 0xd8, 0x98, 0xd1, 0xa2, 0xc1, 0x03, 0xa7, 0x5c, 0x8f, 0x5d, 0x68,
+	0xe1, 0x84, 0x8d, 0xa2, 0x71, 0x3f, 0xc4, 0x01, 0x51, 0x9a, 0x80, 0x0c, 0x3d, 0xc2, 0x01, 0x41,
+	0x7b, 0xb0, 0xa2, 0x00, 0x24, 0xc0, 0x9e, 0xaf, 0xb4, 0x51, 0x87, 0x3e, 0xe3, 0xa1, 0x59, 0xe5,
+	0x96, 0xe7, 0x95, 0x2b, 0x53, 0x69, 0x5e, 0x80, 0xc6, 0xab, 0x0b, 0xd0, 0xbc, 0x81, 0x00, 0xe6,
+	0x9f, 0x1a, 0xac, 0x7d, 0xe5, 0x51, 0x96, 0xda, 0x98, 0xda, 0xe4, 0x69, 0x42, 0xa8, 0x30, 0x58,
+	0x8c, 0x5d, 0x22, 0x9a, 0x59, 0xb3, 0xc5, 0xb7, 0xe8, 0x1a, 0x76, 0x49, 0x9f, 0x7a, 0x2f, 0xa4,
+	0xad, 0x6b, 0x76, 0x83, 0x07, 0x4e, 0xbd, 0x17, 0x64, 0xa6, 0xae, 0xea, 0x5c, 0x5d, 0xb7, 0xa0,
+	0xca, 0xb0, 0xab, 0xba, 0xc8, 0x3f, 0xf9, 0xcb, 0x78, 0x9a, 0x90, 0xf1, 0x73, 0xd5, 0x37, 0xb9,
+	0x40, 0xdb, 0x00, 0xe2, 0x72, 0x16, 0x9d, 0x93, 0x50, 0x35, 0x4d, 0xd0, 0x9d, 0xf1, 0x00, 0x37,
+	0xb1, 0x17, 0x0e, 0xfd, 0xc4, 0xe1, 0x08, 0x86, 0x7d, 0xd1, 0xbd, 0x86, 0xbd, 0xa2, 0x82, 0x67,
+	0x3c, 0x66, 0xfe, 0xa1, 0xc1, 0x7a, 0xae, 0x1a, 0x1a, 0x47, 0x21, 0x25, 0x68, 0x1f, 0x6a, 0x5c,
+	0x5f, 0xaa, 0x6b, 0xed, 0x6a, 0xa7, 0xd5, 0x7b, 0xa3, 0xab, 0x46, 0x40, 0x37, 0x85, 0xda, 0x72,
+	0x3f, 0xab, 0xbb, 0x52, 0x56, 0x77, 0x35, 0x57, 0xf7, 0x26, 0x34, 0x46, 0x98, 0xf6, 0x43, 0xf2,
+	0x8c, 0x89, 0x22, 0x1b, 0x76, 0x7d, 0x84, 0xe9, 0x23, 0xf2, 0x8c, 0xa1, 0xfb, 0xf0, 0x3a, 0x0f,
+	0xf7, 0x67, 0xea, 0x92, 0x25, 0xaf, 0xf2, 0xf0, 0xe3, 0xac, 0x36, 0x3e, 0x2a, 0x44, 0x4d, 0xbc,
+	0xea, 0xaa, 0x2d, 0x17, 0x66, 0x07, 0xd0, 0x09, 0xc9, 0x4a, 0x99, 0xd1, 0x45, 0x98, 0x56, 0x9b,
+	0x0e, 0x10, 0xf3, 0x23, 0x78, 0x73, 0x0e, 0xa9, 0x6a, 0xbe, 0x07, 0x4b, 0xbc, 0x26, 0x01, 0x2d,
+	0x2c, 0x59, 0x6c, 0x9b, 0x9f, 0xc0, 0xfa, 0xb1, 0xb0, 0x52, 0x9e, 0xea, 0xfa, 0xe7, 0xbf, 0x11,
+	0x7e, 0x7a, 0xc5, 0xf3, 0xfb, 0xb0, 0xfe, 0x29, 0xf1, 0xc9, 0xe2, 0xf9, 0xdc, 0x6b, 0x36, 0x3b,
+	0xb0, 0x91, 0x07, 0xaa, 0x4a, 0xf3, 0xc8, 0x1f, 0xe0, 0x76, 0x6a, 0x03, 0x35, 0x1a, 0x32, 0x5f,
+	0xcf, 0xbd, 0x7c, 0x2d, 0xf7, 0xf2, 0x6f, 0x2c, 0xfe, 0xd4, 0xf4, 0x4b, 0xb3, 0xa6, 0x37, 0x7f,
+	0xd5, 0x40, 0x5f, 0xcc, 0x40, 0x65, 0xfb, 0x2e, 0x34, 0xd4, 0xe8, 0x4d, 0xed, 0xb8, 0x36, 0xd7,
+	0x1b, 0x75, 0xc0, 0xce, 0x50, 0xff, 0xa7, 0x29, 0x4d, 0x17, 0xf4, 0xa9, 0xdc, 0x29, 0xd5, 0x75,
+	0x9a, 0xd3, 0xe5, 0x23, 0x4d, 0xc0, 0x45, 0x1e, 0x65, 0x59, 0xa7, 0x20, 0xae, 0xab, 0x72, 0x65,
+	0x8e, 0x25, 0xaf, 0xd6, 0xe7, 0xb0, 0x91, 0x07, 0xaa, 0x4e, 0xcd, 0x50, 0x6a, 0xd7, 0xa1, 0xfc,
+	0x12, 0xf4, 0xa9, 0x15, 0x73, 0xac, 0x37, 0xbd, 0xeb, 0x00, 0xf4, 0xa9, 0xdb, 0xae, 0xa8, 0xe0,
+	0x6d, 0xd8, 0x2c, 0xc0, 0x16, 0x9b, 0xb3, 0xf7, 0x4f, 0x15, 0x6e, 0x71, 0xdc, 0xd7, 0x7c, 0xec,
+	0x9d, 0x92, 0xf1, 0xc4, 0x1b, 0x12, 0xf4, 0x04, 0x9a, 0xdc, 0x2f, 0x62, 0x68, 0xa1, 0xed, 0x2c,
+	0xb3, 0xa2, 0xd1, 0x6c, 0xec, 0x94, 0x6d, 0x4b, 0x42, 0xd3, 0xf8, 0xe9, 0xaf, 0xbf, 0x7f, 0xa9,
+	0xac, 0x21, 0x24, 0xfe, 0xaf, 0x99, 0x1c, 0x5a, 0x1c, 0x6e, 0xc9, 0xf1, 0xe6, 0x42, 0xfd, 0x84,
+	0x08, 0x1a, 0xb4, 0x95, 0x5d, 0xb3, 0x38, 0x66, 0x8c, 0x3b, 0xc5, 0x9b, 0x8a, 0x61, 0x4f, 0x30,
+	0x6c, 0xa1, 0xcd, 0x45, 0x06, 0xeb, 0x25, 0x37, 0xcf, 0x05, 0xfa, 0x59, 0x83, 0x15, 0x9e, 0x5e,
+	0xea, 0x7e, 0xd4, 0x5e, 0xc8, 0x3a, 0xf7, 0x34, 0x8d, 0xbd, 0x4b, 0x10, 0x8a, 0xd8, 0x12, 0xc4,
+	0x6f, 0xa1, 0xfd, 0x22, 0xe2, 0xcc, 0xba, 0x17, 0x56, 0xf6, 0x72, 0xbe, 0x87, 0x55, 0xe9, 0x76,
+	0x75, 0x15, 0x9a, 0x92, 0x94, 0xbd, 0x02, 0xa3, 0xd0, 0x18, 0x66, 0x4f, 0x50, 0xbf, 0xf3, 0x81,
+	0x76, 0x60, 0x5e, 0x97, 0xbd, 0xf7, 0x7b, 0x4d, 0x4a, 0x7d, 0xe4, 0x04, 0x5e, 0x98, 0x4a, 0xed,
+	0x00, 0x48, 0x6a, 0xa1, 0xc2, 0x4e, 0x41, 0x3e, 0xb3, 0x42, 0x2c, 0x8e, 0x4d, 0xf3, 0xae, 0xc8,
+	0x64, 0x9b, 0x67, 0xa2, 0xa7, 0x99, 0x60, 0xce, 0x30, 0x2b, 0xb4, 0x03, 0x20, 0x9f, 0x42, 0x8e,
+	0xa5, 0x70, 0x54, 0x5f, 0xce, 0xd2, 0x2b, 0x67, 0x61, 0x00, 0xd2, 0xf8, 0x39, 0x96, 0xc2, 0x81,
+	0x6e, 0xec, 0x96, 0xee, 0x2b, 0x79, 0xef, 0x09, 0xce, 0xdd, 0x83, 0xed, 0x32, 0x42, 0xeb, 0xa5,
+	0xe7, 0x5c, 0xa0, 0xef, 0x00, 0x4e, 0x48, 0xea, 0x2c, 0xb4, 0x93, 0xb7, 0x6a, 0x4e, 0xce, 0xdd,
+	0xd2, 0x7d, 0xc5, 0xda, 0x11, 0xac, 0x26, 0x6a, 0x17, 0xb0, 0xa6, 0x52, 0x4a, 0xe2, 0x18, 0x56,
+	0x65, 0xff, 0x16, 0xdd, 0x54, 0x36, 0x77, 0x4a, 0xdc, 0x74, 0x5f, 0x70, 0xb6, 0x79, 0x77, 0xb7,
+	0x2e, 0xa1, 0x45, 0x3f, 0x6a, 0xb0, 0x2a, 0x9b, 0xb5, 0x48, 0x59, 0x36, 0x9e, 0x0c, 0xf3, 0x32,
+	0xc8, 0x7c, 0xd1, 0x07, 0x57, 0x16, 0xfd, 0xf0, 0xbd, 0x6f, 0x1f, 0xb8, 0x1e, 0x1b, 0x25, 0x83,
+	0xee, 0x30, 0x0a, 0xac, 0xf3, 0x28, 0x74, 0xcf, 0x49, 0x68, 0x39, 0x98, 0x61, 0x3a, 0x9e, 0x58,
+	0xf1, 0xb9, 0x2b, 0x7f, 0x20, 0x59, 0xea, 0x87, 0xd6, 0x87, 0xfc, 0xef, 0xe4, 0x70, 0xb0, 0x2c,
+	0xa2, 0x0f, 0xfe, 0x1b, 0x00, 0x50, 0xce, 0x6e, 0x4d, 0x81, 0x0d, 0x00, 0x00,
 }
@@ -891,14 +891,16 @@ func (x *SyncFeedsRequest) GetFeedSourceId() string {
 	return ""
 }
 
+// SyncFeedsResponse is returned as soon as the sync starts; follow it with WatchSyncJob.
 type SyncFeedsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
-	Results    []*FeedSyncResult      `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	JobId     string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// A feed sync was already running and job_id is that run; the requested source was not queued.
+	Attached bool `protobuf:"varint,5,opt,name=attached,proto3" json:"attached,omitempty"`
 }
 
 func (x *SyncFeedsResponse) Reset() {
@@ -940,16 +942,163 @@ func (x *SyncFeedsResponse) GetStartedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *SyncFeedsResponse) GetFinishedAt() *timestamppb.Timestamp {
+func (x *SyncFeedsResponse) GetJobId() string {
 	if x != nil {
-		return x.FinishedAt
+		return x.JobId
 	}
-	return nil
+	return ""
+}
+
+func (x *SyncFeedsResponse) GetAttached() bool {
+	if x != nil {
+		return x.Attached
+	}
+	return false
+}
+
+type WatchSyncJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchSyncJobRequest) Reset() {
+	*x = WatchSyncJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feeds_v1_feed_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSyncJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SyncFeedsResponse) GetResults() []*FeedSyncResult {
+func (*WatchSyncJobRequest) ProtoMessage() {}
+
+func (x *WatchSyncJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feed_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSyncJobRequest.ProtoReflect.Descriptor instead.
+func (*WatchSyncJobRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchSyncJobRequest) GetId() string {
 	if x != nil {
-		return x.Results
+		return x.Id
+	}
+	return ""
+}
+
+// SyncJobEvent is one step of a feed sync job.
+type SyncJobEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// started, target_finished or finished.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Feed source id of target_finished events.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Totals of the source, or of the job on the finished event.
+	Fetched   int32 `protobuf:"varint,4,opt,name=fetched,proto3" json:"fetched,omitempty"`
+	Persisted int32 `protobuf:"varint,5,opt,name=persisted,proto3" json:"persisted,omitempty"`
+	// Error of the source, or the error that stopped the job on the finished event.
+	Error string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	At    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *SyncJobEvent) Reset() {
+	*x = SyncJobEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feeds_v1_feed_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncJobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncJobEvent) ProtoMessage() {}
+
+func (x *SyncJobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feed_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncJobEvent.ProtoReflect.Descriptor instead.
+func (*SyncJobEvent) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SyncJobEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *SyncJobEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SyncJobEvent) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SyncJobEvent) GetFetched() int32 {
+	if x != nil {
+		return x.Fetched
+	}
+	return 0
+}
+
+func (x *SyncJobEvent) GetPersisted() int32 {
+	if x != nil {
+		return x.Persisted
+	}
+	return 0
+}
+
+func (x *SyncJobEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyncJobEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
 	}
 	return nil
 }
@@ -964,12 +1113,14 @@ type GetFeedSyncStatusResponse struct {
 	Running        bool                   `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
 	LastResults    []*FeedSyncResult      `protobuf:"bytes,4,rep,name=last_results,json=lastResults,proto3" json:"last_results,omitempty"`
 	Statuses       []*FeedSyncStatus      `protobuf:"bytes,5,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// Unset when leader election is off and every replica runs the scheduled jobs.
+	Leader *SchedulerLeader `protobuf:"bytes,6,opt,name=leader,proto3" json:"leader,omitempty"`
 }
 
 func (x *GetFeedSyncStatusResponse) Reset() {
 	*x = GetFeedSyncStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feeds_v1_feed_proto_msgTypes[13]
+		mi := &file_feeds_v1_feed_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -982,7 +1133,7 @@ func (x *GetFeedSyncStatusResponse) String() string {
 func (*GetFeedSyncStatusResponse) ProtoMessage() {}
 
 func (x *GetFeedSyncStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feed_proto_msgTypes[13]
+	mi := &file_feeds_v1_feed_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1146,7 @@ func (x *GetFeedSyncStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeedSyncStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetFeedSyncStatusResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{13}
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetFeedSyncStatusResponse) GetLastStartedAt() *timestamppb.Timestamp {
@@ -1033,6 +1184,79 @@ func (x *GetFeedSyncStatusResponse) GetStatuses() []*FeedSyncStatus {
 	return nil
 }
 
+func (x *GetFeedSyncStatusResponse) GetLeader() *SchedulerLeader {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+// SchedulerLeader is the replica elected to run the scheduled jobs.
+type SchedulerLeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Replica holding the scheduler lease, empty when nobody does.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// Replica that answered the request.
+	Replica string `protobuf:"bytes,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	Leading bool   `protobuf:"varint,3,opt,name=leading,proto3" json:"leading,omitempty"`
+}
+
+func (x *SchedulerLeader) Reset() {
+	*x = SchedulerLeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feeds_v1_feed_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerLeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerLeader) ProtoMessage() {}
+
+func (x *SchedulerLeader) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feed_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerLeader.ProtoReflect.Descriptor instead.
+func (*SchedulerLeader) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SchedulerLeader) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *SchedulerLeader) GetReplica() string {
+	if x != nil {
+		return x.Replica
+	}
+	return ""
+}
+
+func (x *SchedulerLeader) GetLeading() bool {
+	if x != nil {
+		return x.Leading
+	}
+	return false
+}
+
 type ListFeedContentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1041,12 +1265,17 @@ type ListFeedContentsRequest struct {
 	FeedSourceId string `protobuf:"bytes,1,opt,name=feed_source_id,json=feedSourceId,proto3" json:"feed_source_id,omitempty"`
 	Page         int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize     int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from next_page_token of the previous page. Continues after the last item returned, so
+	// items do not shift between pages while syncs run; page is ignored when set.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Also return the total number of matching items, which costs a count query.
+	IncludeTotal bool `protobuf:"varint,5,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
 }
 
 func (x *ListFeedContentsRequest) Reset() {
 	*x = ListFeedContentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feeds_v1_feed_proto_msgTypes[14]
+		mi := &file_feeds_v1_feed_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1059,7 +1288,7 @@ func (x *ListFeedContentsRequest) String() string {
 func (*ListFeedContentsRequest) ProtoMessage() {}
 
 func (x *ListFeedContentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feed_proto_msgTypes[14]
+	mi := &file_feeds_v1_feed_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1301,7 @@ func (x *ListFeedContentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedContentsRequest.ProtoReflect.Descriptor instead.
 func (*ListFeedContentsRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{14}
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListFeedContentsRequest) GetFeedSourceId() string {
@@ -1096,6 +1325,20 @@ func (x *ListFeedContentsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListFeedContentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFeedContentsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
 type ListFeedContentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1105,12 +1348,16 @@ type ListFeedContentsResponse struct {
 	Page     int32          `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize int32          `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	HasNext  bool           `protobuf:"varint,4,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	// Token for the next page, empty on the last one.
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of matching items, set only with include_total.
+	Total int64 `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
 }
 
 func (x *ListFeedContentsResponse) Reset() {
 	*x = ListFeedContentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feeds_v1_feed_proto_msgTypes[15]
+		mi := &file_feeds_v1_feed_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1123,7 +1370,7 @@ func (x *ListFeedContentsResponse) String() string {
 func (*ListFeedContentsResponse) ProtoMessage() {}
 
 func (x *ListFeedContentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feed_proto_msgTypes[15]
+	mi := &file_feeds_v1_feed_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1383,7 @@ func (x *ListFeedContentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFeedContentsResponse.ProtoReflect.Descriptor instead.
 func (*ListFeedContentsResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{15}
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListFeedContentsResponse) GetContents() []*FeedContent {
@@ -1167,6 +1414,20 @@ func (x *ListFeedContentsResponse) GetHasNext() bool {
 	return false
 }
 
+func (x *ListFeedContentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFeedContentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetFeedContentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1178,7 +1439,7 @@ type GetFeedContentRequest struct {
 func (x *GetFeedContentRequest) Reset() {
 	*x = GetFeedContentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feeds_v1_feed_proto_msgTypes[16]
+		mi := &file_feeds_v1_feed_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1191,7 +1452,7 @@ func (x *GetFeedContentRequest) String() string {
 func (*GetFeedContentRequest) ProtoMessage() {}
 
 func (x *GetFeedContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feed_proto_msgTypes[16]
+	mi := &file_feeds_v1_feed_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1465,7 @@ func (x *GetFeedContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeedContentRequest.ProtoReflect.Descriptor instead.
 func (*GetFeedContentRequest) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{16}
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetFeedContentRequest) GetId() string {
@@ -1226,7 +1487,7 @@ type GetFeedContentResponse struct {
 func (x *GetFeedContentResponse) Reset() {
 	*x = GetFeedContentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feeds_v1_feed_proto_msgTypes[17]
+		mi := &file_feeds_v1_feed_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1239,7 +1500,7 @@ func (x *GetFeedContentResponse) String() string {
 func (*GetFeedContentResponse) ProtoMessage() {}
 
 func (x *GetFeedContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feeds_v1_feed_proto_msgTypes[17]
+	mi := &file_feeds_v1_feed_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1513,7 @@ func (x *GetFeedContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeedContentResponse.ProtoReflect.Descriptor instead.
 func (*GetFeedContentResponse) Descriptor() ([]byte, []int) {
-	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{17}
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetFeedContentResponse) GetContent() *FeedContent {
@@ -1269,6 +1530,168 @@ func (x *GetFeedContentResponse) GetSource() *FeedSource {
 	return nil
 }
 
+type GetFeedSyncConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled               bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IntervalSeconds       int32 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	RequestTimeoutSeconds int32 `protobuf:"varint,3,opt,name=request_timeout_seconds,json=requestTimeoutSeconds,proto3" json:"request_timeout_seconds,omitempty"`
+	// Version of the saved settings, 0 while the config file values apply.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Admin user who saved the version, empty for calls without an admin token.
+	UpdatedBy string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *GetFeedSyncConfigResponse) Reset() {
+	*x = GetFeedSyncConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feeds_v1_feed_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedSyncConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedSyncConfigResponse) ProtoMessage() {}
+
+func (x *GetFeedSyncConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feed_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedSyncConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedSyncConfigResponse) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetFeedSyncConfigResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetFeedSyncConfigResponse) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *GetFeedSyncConfigResponse) GetRequestTimeoutSeconds() int32 {
+	if x != nil {
+		return x.RequestTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *GetFeedSyncConfigResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetFeedSyncConfigResponse) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *GetFeedSyncConfigResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateFeedSyncConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled               bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	IntervalSeconds       int32 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	RequestTimeoutSeconds int32 `protobuf:"varint,3,opt,name=request_timeout_seconds,json=requestTimeoutSeconds,proto3" json:"request_timeout_seconds,omitempty"`
+	// Version the change is based on. When set, the update fails with ABORTED if another change was
+	// saved since.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateFeedSyncConfigRequest) Reset() {
+	*x = UpdateFeedSyncConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feeds_v1_feed_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFeedSyncConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFeedSyncConfigRequest) ProtoMessage() {}
+
+func (x *UpdateFeedSyncConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feeds_v1_feed_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFeedSyncConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeedSyncConfigRequest) Descriptor() ([]byte, []int) {
+	return file_feeds_v1_feed_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateFeedSyncConfigRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateFeedSyncConfigRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *UpdateFeedSyncConfigRequest) GetRequestTimeoutSeconds() int32 {
+	if x != nil {
+		return x.RequestTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *UpdateFeedSyncConfigRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_feeds_v1_feed_proto protoreflect.FileDescriptor
 
 var file_feeds_v1_feed_proto_rawDesc = []byte{
@@ -1405,46 +1828,73 @@ var file_feeds_v1_feed_proto_rawDesc = []byte{
 	0x10, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x64, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63,
 	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
 	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0c, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
-	0x64, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x6c, 0x61, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x70,
-	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65,
-	0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0x99, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x25, 0x0a,
+	0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x61, 0x74, 0x22, 0xe5, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x65, 0x65,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x0f, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x6c, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xb4, 0x01, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0xd7, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e,
 	0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
 	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x43,
@@ -1453,98 +1903,155 @@ var file_feeds_v1_feed_proto_rawDesc = []byte{
 	0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
 	0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x27, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x2c, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x32, 0xd5,
-	0x06, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x72, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
-	0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x77, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8c, 0x02, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x32, 0xdc, 0x09, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x20,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x72, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
 	0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
 	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x32,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22,
 	0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66,
-	0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x10,
+	0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x3a, 0x01, 0x2a, 0x32, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x82, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a,
-	0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66,
-	0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x12, 0x69, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x1a, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x65, 0x65, 0x64,
+	0x73, 0x12, 0x1a, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
 	0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x65, 0x65,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x66, 0x65, 0x65, 0x64,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01,
-	0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x79, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xee, 0x02, 0x0a, 0x10, 0x46, 0x65, 0x65, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x09, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x65, 0x65,
-	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66,
-	0x65, 0x65, 0x64, 0x73, 0x12, 0x78, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x65,
-	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x77,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x1f, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x6f, 0x6e, 0x67, 0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x72, 0x76, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x3a, 0x73, 0x79, 0x6e, 0x63, 0x12,
+	0x79, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x12,
+	0x1d, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f,
+	0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x79, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x23, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12,
+	0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x8e, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53,
+	0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x2e, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x53,
+	0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a,
+	0x32, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x32, 0xee, 0x02, 0x0a, 0x10, 0x46, 0x65, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x12,
+	0x78, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x77, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x66, 0x65,
+	0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x66, 0x65, 0x65, 0x64, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6b, 0x6f, 0x6e, 0x67, 0x6b, 0x65, 0x6e, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x72, 0x76,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x64, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1559,77 +2066,89 @@ func file_feeds_v1_feed_proto_rawDescGZIP() []byte {
 	return file_feeds_v1_feed_proto_rawDescData
 }
 
-var file_feeds_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_feeds_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_feeds_v1_feed_proto_goTypes = []interface{}{
-	(*FeedSource)(nil),                // 0: feeds.v1.FeedSource
-	(*FeedContent)(nil),               // 1: feeds.v1.FeedContent
-	(*FeedSyncResult)(nil),            // 2: feeds.v1.FeedSyncResult
-	(*FeedSyncStatus)(nil),            // 3: feeds.v1.FeedSyncStatus
-	(*ListFeedSourcesRequest)(nil),    // 4: feeds.v1.ListFeedSourcesRequest
-	(*ListFeedSourcesResponse)(nil),   // 5: feeds.v1.ListFeedSourcesResponse
-	(*GetFeedSourceRequest)(nil),      // 6: feeds.v1.GetFeedSourceRequest
-	(*CreateFeedSourceRequest)(nil),   // 7: feeds.v1.CreateFeedSourceRequest
-	(*UpdateFeedSourceRequest)(nil),   // 8: feeds.v1.UpdateFeedSourceRequest
-	(*DeleteFeedSourceRequest)(nil),   // 9: feeds.v1.DeleteFeedSourceRequest
-	(*DeleteFeedSourceResponse)(nil),  // 10: feeds.v1.DeleteFeedSourceResponse
-	(*SyncFeedsRequest)(nil),          // 11: feeds.v1.SyncFeedsRequest
-	(*SyncFeedsResponse)(nil),         // 12: feeds.v1.SyncFeedsResponse
-	(*GetFeedSyncStatusResponse)(nil), // 13: feeds.v1.GetFeedSyncStatusResponse
-	(*ListFeedContentsRequest)(nil),   // 14: feeds.v1.ListFeedContentsRequest
-	(*ListFeedContentsResponse)(nil),  // 15: feeds.v1.ListFeedContentsResponse
-	(*GetFeedContentRequest)(nil),     // 16: feeds.v1.GetFeedContentRequest
-	(*GetFeedContentResponse)(nil),    // 17: feeds.v1.GetFeedContentResponse
-	(*timestamppb.Timestamp)(nil),     // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),             // 19: google.protobuf.Empty
+	(*FeedSource)(nil),                  // 0: feeds.v1.FeedSource
+	(*FeedContent)(nil),                 // 1: feeds.v1.FeedContent
+	(*FeedSyncResult)(nil),              // 2: feeds.v1.FeedSyncResult
+	(*FeedSyncStatus)(nil),              // 3: feeds.v1.FeedSyncStatus
+	(*ListFeedSourcesRequest)(nil),      // 4: feeds.v1.ListFeedSourcesRequest
+	(*ListFeedSourcesResponse)(nil),     // 5: feeds.v1.ListFeedSourcesResponse
+	(*GetFeedSourceRequest)(nil),        // 6: feeds.v1.GetFeedSourceRequest
+	(*CreateFeedSourceRequest)(nil),     // 7: feeds.v1.CreateFeedSourceRequest
+	(*UpdateFeedSourceRequest)(nil),     // 8: feeds.v1.UpdateFeedSourceRequest
+	(*DeleteFeedSourceRequest)(nil),     // 9: feeds.v1.DeleteFeedSourceRequest
+	(*DeleteFeedSourceResponse)(nil),    // 10: feeds.v1.DeleteFeedSourceResponse
+	(*SyncFeedsRequest)(nil),            // 11: feeds.v1.SyncFeedsRequest
+	(*SyncFeedsResponse)(nil),           // 12: feeds.v1.SyncFeedsResponse
+	(*WatchSyncJobRequest)(nil),         // 13: feeds.v1.WatchSyncJobRequest
+	(*SyncJobEvent)(nil),                // 14: feeds.v1.SyncJobEvent
+	(*GetFeedSyncStatusResponse)(nil),   // 15: feeds.v1.GetFeedSyncStatusResponse
+	(*SchedulerLeader)(nil),             // 16: feeds.v1.SchedulerLeader
+	(*ListFeedContentsRequest)(nil),     // 17: feeds.v1.ListFeedContentsRequest
+	(*ListFeedContentsResponse)(nil),    // 18: feeds.v1.ListFeedContentsResponse
+	(*GetFeedContentRequest)(nil),       // 19: feeds.v1.GetFeedContentRequest
+	(*GetFeedContentResponse)(nil),      // 20: feeds.v1.GetFeedContentResponse
+	(*GetFeedSyncConfigResponse)(nil),   // 21: feeds.v1.GetFeedSyncConfigResponse
+	(*UpdateFeedSyncConfigRequest)(nil), // 22: feeds.v1.UpdateFeedSyncConfigRequest
+	(*timestamppb.Timestamp)(nil),       // 23: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 24: google.protobuf.Empty
 }
 var file_feeds_v1_feed_proto_depIdxs = []int32{
-	18, // 0: feeds.v1.FeedSource.last_synced_at:type_name -> google.protobuf.Timestamp
-	18, // 1: feeds.v1.FeedSource.last_success_at:type_name -> google.protobuf.Timestamp
-	18, // 2: feeds.v1.FeedSource.created_at:type_name -> google.protobuf.Timestamp
-	18, // 3: feeds.v1.FeedSource.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 4: feeds.v1.FeedContent.published_at:type_name -> google.protobuf.Timestamp
-	18, // 5: feeds.v1.FeedContent.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 6: feeds.v1.FeedContent.fetched_at:type_name -> google.protobuf.Timestamp
-	18, // 7: feeds.v1.FeedSyncStatus.last_synced_at:type_name -> google.protobuf.Timestamp
-	18, // 8: feeds.v1.FeedSyncStatus.last_success_at:type_name -> google.protobuf.Timestamp
+	23, // 0: feeds.v1.FeedSource.last_synced_at:type_name -> google.protobuf.Timestamp
+	23, // 1: feeds.v1.FeedSource.last_success_at:type_name -> google.protobuf.Timestamp
+	23, // 2: feeds.v1.FeedSource.created_at:type_name -> google.protobuf.Timestamp
+	23, // 3: feeds.v1.FeedSource.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 4: feeds.v1.FeedContent.published_at:type_name -> google.protobuf.Timestamp
+	23, // 5: feeds.v1.FeedContent.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 6: feeds.v1.FeedContent.fetched_at:type_name -> google.protobuf.Timestamp
+	23, // 7: feeds.v1.FeedSyncStatus.last_synced_at:type_name -> google.protobuf.Timestamp
+	23, // 8: feeds.v1.FeedSyncStatus.last_success_at:type_name -> google.protobuf.Timestamp
 	0,  // 9: feeds.v1.ListFeedSourcesResponse.sources:type_name -> feeds.v1.FeedSource
 	0,  // 10: feeds.v1.CreateFeedSourceRequest.source:type_name -> feeds.v1.FeedSource
 	0,  // 11: feeds.v1.UpdateFeedSourceRequest.source:type_name -> feeds.v1.FeedSource
-	18, // 12: feeds.v1.SyncFeedsResponse.started_at:type_name -> google.protobuf.Timestamp
-	18, // 13: feeds.v1.SyncFeedsResponse.finished_at:type_name -> google.protobuf.Timestamp
-	2,  // 14: feeds.v1.SyncFeedsResponse.results:type_name -> feeds.v1.FeedSyncResult
-	18, // 15: feeds.v1.GetFeedSyncStatusResponse.last_started_at:type_name -> google.protobuf.Timestamp
-	18, // 16: feeds.v1.GetFeedSyncStatusResponse.last_finished_at:type_name -> google.protobuf.Timestamp
-	2,  // 17: feeds.v1.GetFeedSyncStatusResponse.last_results:type_name -> feeds.v1.FeedSyncResult
-	3,  // 18: feeds.v1.GetFeedSyncStatusResponse.statuses:type_name -> feeds.v1.FeedSyncStatus
+	23, // 12: feeds.v1.SyncFeedsResponse.started_at:type_name -> google.protobuf.Timestamp
+	23, // 13: feeds.v1.SyncJobEvent.at:type_name -> google.protobuf.Timestamp
+	23, // 14: feeds.v1.GetFeedSyncStatusResponse.last_started_at:type_name -> google.protobuf.Timestamp
+	23, // 15: feeds.v1.GetFeedSyncStatusResponse.last_finished_at:type_name -> google.protobuf.Timestamp
+	2,  // 16: feeds.v1.GetFeedSyncStatusResponse.last_results:type_name -> feeds.v1.FeedSyncResult
+	3,  // 17: feeds.v1.GetFeedSyncStatusResponse.statuses:type_name -> feeds.v1.FeedSyncStatus
+	16, // 18: feeds.v1.GetFeedSyncStatusResponse.leader:type_name -> feeds.v1.SchedulerLeader
 	1,  // 19: feeds.v1.ListFeedContentsResponse.contents:type_name -> feeds.v1.FeedContent
 	1,  // 20: feeds.v1.GetFeedContentResponse.content:type_name -> feeds.v1.FeedContent
 	0,  // 21: feeds.v1.GetFeedContentResponse.source:type_name -> feeds.v1.FeedSource
-	4,  // 22: feeds.v1.FeedSyncAdminService.ListFeedSources:input_type -> feeds.v1.ListFeedSourcesRequest
-	6,  // 23: feeds.v1.FeedSyncAdminService.GetFeedSource:input_type -> feeds.v1.GetFeedSourceRequest
-	7,  // 24: feeds.v1.FeedSyncAdminService.CreateFeedSource:input_type -> feeds.v1.CreateFeedSourceRequest
-	8,  // 25: feeds.v1.FeedSyncAdminService.UpdateFeedSource:input_type -> feeds.v1.UpdateFeedSourceRequest
-	9,  // 26: feeds.v1.FeedSyncAdminService.DeleteFeedSource:input_type -> feeds.v1.DeleteFeedSourceRequest
-	11, // 27: feeds.v1.FeedSyncAdminService.SyncFeeds:input_type -> feeds.v1.SyncFeedsRequest
-	19, // 28: feeds.v1.FeedSyncAdminService.GetFeedSyncStatus:input_type -> google.protobuf.Empty
-	4,  // 29: feeds.v1.FeedQueryService.ListFeeds:input_type -> feeds.v1.ListFeedSourcesRequest
-	14, // 30: feeds.v1.FeedQueryService.ListFeedContents:input_type -> feeds.v1.ListFeedContentsRequest
-	16, // 31: feeds.v1.FeedQueryService.GetFeedContent:input_type -> feeds.v1.GetFeedContentRequest
-	5,  // 32: feeds.v1.FeedSyncAdminService.ListFeedSources:output_type -> feeds.v1.ListFeedSourcesResponse
-	0,  // 33: feeds.v1.FeedSyncAdminService.GetFeedSource:output_type -> feeds.v1.FeedSource
-	0,  // 34: feeds.v1.FeedSyncAdminService.CreateFeedSource:output_type -> feeds.v1.FeedSource
-	0,  // 35: feeds.v1.FeedSyncAdminService.UpdateFeedSource:output_type -> feeds.v1.FeedSource
-	10, // 36: feeds.v1.FeedSyncAdminService.DeleteFeedSource:output_type -> feeds.v1.DeleteFeedSourceResponse
-	12, // 37: feeds.v1.FeedSyncAdminService.SyncFeeds:output_type -> feeds.v1.SyncFeedsResponse
-	13, // 38: feeds.v1.FeedSyncAdminService.GetFeedSyncStatus:output_type -> feeds.v1.GetFeedSyncStatusResponse
-	5,  // 39: feeds.v1.FeedQueryService.ListFeeds:output_type -> feeds.v1.ListFeedSourcesResponse
-	15, // 40: feeds.v1.FeedQueryService.ListFeedContents:output_type -> feeds.v1.ListFeedContentsResponse
-	17, // 41: feeds.v1.FeedQueryService.GetFeedContent:output_type -> feeds.v1.GetFeedContentResponse
-	32, // [32:42] is the sub-list for method output_type
-	22, // [22:32] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	23, // 22: feeds.v1.GetFeedSyncConfigResponse.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 23: feeds.v1.FeedSyncAdminService.ListFeedSources:input_type -> feeds.v1.ListFeedSourcesRequest
+	6,  // 24: feeds.v1.FeedSyncAdminService.GetFeedSource:input_type -> feeds.v1.GetFeedSourceRequest
+	7,  // 25: feeds.v1.FeedSyncAdminService.CreateFeedSource:input_type -> feeds.v1.CreateFeedSourceRequest
+	8,  // 26: feeds.v1.FeedSyncAdminService.UpdateFeedSource:input_type -> feeds.v1.UpdateFeedSourceRequest
+	9,  // 27: feeds.v1.FeedSyncAdminService.DeleteFeedSource:input_type -> feeds.v1.DeleteFeedSourceRequest
+	11, // 28: feeds.v1.FeedSyncAdminService.SyncFeeds:input_type -> feeds.v1.SyncFeedsRequest
+	13, // 29: feeds.v1.FeedSyncAdminService.WatchSyncJob:input_type -> feeds.v1.WatchSyncJobRequest
+	24, // 30: feeds.v1.FeedSyncAdminService.GetFeedSyncStatus:input_type -> google.protobuf.Empty
+	24, // 31: feeds.v1.FeedSyncAdminService.GetFeedSyncConfig:input_type -> google.protobuf.Empty
+	22, // 32: feeds.v1.FeedSyncAdminService.UpdateFeedSyncConfig:input_type -> feeds.v1.UpdateFeedSyncConfigRequest
+	4,  // 33: feeds.v1.FeedQueryService.ListFeeds:input_type -> feeds.v1.ListFeedSourcesRequest
+	17, // 34: feeds.v1.FeedQueryService.ListFeedContents:input_type -> feeds.v1.ListFeedContentsRequest
+	19, // 35: feeds.v1.FeedQueryService.GetFeedContent:input_type -> feeds.v1.GetFeedContentRequest
+	5,  // 36: feeds.v1.FeedSyncAdminService.ListFeedSources:output_type -> feeds.v1.ListFeedSourcesResponse
+	0,  // 37: feeds.v1.FeedSyncAdminService.GetFeedSource:output_type -> feeds.v1.FeedSource
+	0,  // 38: feeds.v1.FeedSyncAdminService.CreateFeedSource:output_type -> feeds.v1.FeedSource
+	0,  // 39: feeds.v1.FeedSyncAdminService.UpdateFeedSource:output_type -> feeds.v1.FeedSource
+	10, // 40: feeds.v1.FeedSyncAdminService.DeleteFeedSource:output_type -> feeds.v1.DeleteFeedSourceResponse
+	12, // 41: feeds.v1.FeedSyncAdminService.SyncFeeds:output_type -> feeds.v1.SyncFeedsResponse
+	14, // 42: feeds.v1.FeedSyncAdminService.WatchSyncJob:output_type -> feeds.v1.SyncJobEvent
+	15, // 43: feeds.v1.FeedSyncAdminService.GetFeedSyncStatus:output_type -> feeds.v1.GetFeedSyncStatusResponse
+	21, // 44: feeds.v1.FeedSyncAdminService.GetFeedSyncConfig:output_type -> feeds.v1.GetFeedSyncConfigResponse
+	21, // 45: feeds.v1.FeedSyncAdminService.UpdateFeedSyncConfig:output_type -> feeds.v1.GetFeedSyncConfigResponse
+	5,  // 46: feeds.v1.FeedQueryService.ListFeeds:output_type -> feeds.v1.ListFeedSourcesResponse
+	18, // 47: feeds.v1.FeedQueryService.ListFeedContents:output_type -> feeds.v1.ListFeedContentsResponse
+	20, // 48: feeds.v1.FeedQueryService.GetFeedContent:output_type -> feeds.v1.GetFeedContentResponse
+	36, // [36:49] is the sub-list for method output_type
+	23, // [23:36] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_feeds_v1_feed_proto_init() }
@@ -1795,7 +2314,7 @@ func file_feeds_v1_feed_proto_init() {
 			}
 		}
 		file_feeds_v1_feed_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFeedSyncStatusResponse); i {
+			switch v := v.(*WatchSyncJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1807,7 +2326,7 @@ func file_feeds_v1_feed_proto_init() {
 			}
 		}
 		file_feeds_v1_feed_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFeedContentsRequest); i {
+			switch v := v.(*SyncJobEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1819,7 +2338,7 @@ func file_feeds_v1_feed_proto_init() {
 			}
 		}
 		file_feeds_v1_feed_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFeedContentsResponse); i {
+			switch v := v.(*GetFeedSyncStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,7 +2350,7 @@ func file_feeds_v1_feed_proto_init() {
 			}
 		}
 		file_feeds_v1_feed_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFeedContentRequest); i {
+			switch v := v.(*SchedulerLeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,6 +2362,42 @@ func file_feeds_v1_feed_proto_init() {
 			}
 		}
 		file_feeds_v1_feed_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeedContentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feeds_v1_feed_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeedContentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feeds_v1_feed_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeedContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feeds_v1_feed_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFeedContentResponse); i {
 			case 0:
 				return &v.state
@@ -1854,6 +2409,30 @@ func file_feeds_v1_feed_proto_init() {
 				return nil
 			}
 		}
+		file_feeds_v1_feed_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeedSyncConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feeds_v1_feed_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFeedSyncConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1861,7 +2440,7 @@ func file_feeds_v1_feed_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feeds_v1_feed_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
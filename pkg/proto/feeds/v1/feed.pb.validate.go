@@ -1725,68 +1725,9 @@ func (m *SyncFeedsResponse) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetFinishedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SyncFeedsResponseValidationError{
-					field:  "FinishedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SyncFeedsResponseValidationError{
-					field:  "FinishedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SyncFeedsResponseValidationError{
-				field:  "FinishedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
+	// no validation rules for JobId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SyncFeedsResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SyncFeedsResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SyncFeedsResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Attached
 
 	if len(errors) > 0 {
 		return SyncFeedsResponseMultiError(errors)
@@ -1868,6 +1809,250 @@ var _ interface {
 	ErrorName() string
 } = SyncFeedsResponseValidationError{}
 
+// Validate checks the field values on WatchSyncJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchSyncJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchSyncJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchSyncJobRequestMultiError, or nil if none found.
+func (m *WatchSyncJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchSyncJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return WatchSyncJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchSyncJobRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchSyncJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchSyncJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchSyncJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchSyncJobRequestMultiError) AllErrors() []error { return m }
+
+// WatchSyncJobRequestValidationError is the validation error returned by
+// WatchSyncJobRequest.Validate if the designated constraints aren't met.
+type WatchSyncJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchSyncJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchSyncJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchSyncJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchSyncJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchSyncJobRequestValidationError) ErrorName() string {
+	return "WatchSyncJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchSyncJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchSyncJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchSyncJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchSyncJobRequestValidationError{}
+
+// Validate checks the field values on SyncJobEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncJobEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncJobEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncJobEventMultiError, or
+// nil if none found.
+func (m *SyncJobEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncJobEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Type
+
+	// no validation rules for Target
+
+	// no validation rules for Fetched
+
+	// no validation rules for Persisted
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncJobEventValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncJobEventValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncJobEventValidationError{
+				field:  "At",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SyncJobEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncJobEventMultiError is an error wrapping multiple validation errors
+// returned by SyncJobEvent.ValidateAll() if the designated constraints aren't met.
+type SyncJobEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncJobEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncJobEventMultiError) AllErrors() []error { return m }
+
+// SyncJobEventValidationError is the validation error returned by
+// SyncJobEvent.Validate if the designated constraints aren't met.
+type SyncJobEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncJobEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncJobEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncJobEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncJobEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncJobEventValidationError) ErrorName() string { return "SyncJobEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncJobEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncJobEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncJobEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncJobEventValidationError{}
+
 // Validate checks the field values on GetFeedSyncStatusResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1984,54 +2169,191 @@ func (m *GetFeedSyncStatusResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetStatuses() {
-		_, _ = idx, item
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFeedSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFeedSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFeedSyncStatusResponseValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFeedSyncStatusResponseValidationError{
+					field:  "Leader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFeedSyncStatusResponseValidationError{
+					field:  "Leader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFeedSyncStatusResponseValidationError{
+				field:  "Leader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFeedSyncStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeedSyncStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFeedSyncStatusResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetFeedSyncStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeedSyncStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeedSyncStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetFeedSyncStatusResponseValidationError is the validation error returned by
+// GetFeedSyncStatusResponse.Validate if the designated constraints aren't met.
+type GetFeedSyncStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeedSyncStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeedSyncStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeedSyncStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeedSyncStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeedSyncStatusResponseValidationError) ErrorName() string {
+	return "GetFeedSyncStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeedSyncStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeedSyncStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeedSyncStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeedSyncStatusResponseValidationError{}
+
+// Validate checks the field values on SchedulerLeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchedulerLeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchedulerLeader with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchedulerLeaderMultiError, or nil if none found.
+func (m *SchedulerLeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchedulerLeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetFeedSyncStatusResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetFeedSyncStatusResponseValidationError{
-						field:  fmt.Sprintf("Statuses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetFeedSyncStatusResponseValidationError{
-					field:  fmt.Sprintf("Statuses[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Holder
 
-	}
+	// no validation rules for Replica
+
+	// no validation rules for Leading
 
 	if len(errors) > 0 {
-		return GetFeedSyncStatusResponseMultiError(errors)
+		return SchedulerLeaderMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFeedSyncStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by GetFeedSyncStatusResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetFeedSyncStatusResponseMultiError []error
+// SchedulerLeaderMultiError is an error wrapping multiple validation errors
+// returned by SchedulerLeader.ValidateAll() if the designated constraints
+// aren't met.
+type SchedulerLeaderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFeedSyncStatusResponseMultiError) Error() string {
+func (m SchedulerLeaderMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2040,11 +2362,11 @@ func (m GetFeedSyncStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFeedSyncStatusResponseMultiError) AllErrors() []error { return m }
+func (m SchedulerLeaderMultiError) AllErrors() []error { return m }
 
-// GetFeedSyncStatusResponseValidationError is the validation error returned by
-// GetFeedSyncStatusResponse.Validate if the designated constraints aren't met.
-type GetFeedSyncStatusResponseValidationError struct {
+// SchedulerLeaderValidationError is the validation error returned by
+// SchedulerLeader.Validate if the designated constraints aren't met.
+type SchedulerLeaderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2052,24 +2374,22 @@ type GetFeedSyncStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFeedSyncStatusResponseValidationError) Field() string { return e.field }
+func (e SchedulerLeaderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFeedSyncStatusResponseValidationError) Reason() string { return e.reason }
+func (e SchedulerLeaderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFeedSyncStatusResponseValidationError) Cause() error { return e.cause }
+func (e SchedulerLeaderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFeedSyncStatusResponseValidationError) Key() bool { return e.key }
+func (e SchedulerLeaderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFeedSyncStatusResponseValidationError) ErrorName() string {
-	return "GetFeedSyncStatusResponseValidationError"
-}
+func (e SchedulerLeaderValidationError) ErrorName() string { return "SchedulerLeaderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFeedSyncStatusResponseValidationError) Error() string {
+func (e SchedulerLeaderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2081,14 +2401,14 @@ func (e GetFeedSyncStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFeedSyncStatusResponse.%s: %s%s",
+		"invalid %sSchedulerLeader.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFeedSyncStatusResponseValidationError{}
+var _ error = SchedulerLeaderValidationError{}
 
 var _ interface {
 	Field() string
@@ -2096,7 +2416,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFeedSyncStatusResponseValidationError{}
+} = SchedulerLeaderValidationError{}
 
 // Validate checks the field values on ListFeedContentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -2126,6 +2446,10 @@ func (m *ListFeedContentsRequest) validate(all bool) error {
 
 	// no validation rules for PageSize
 
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotal
+
 	if len(errors) > 0 {
 		return ListFeedContentsRequestMultiError(errors)
 	}
@@ -2268,6 +2592,10 @@ func (m *ListFeedContentsResponse) validate(all bool) error {
 
 	// no validation rules for HasNext
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
 		return ListFeedContentsResponseMultiError(errors)
 	}
@@ -2611,3 +2939,255 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetFeedContentResponseValidationError{}
+
+// Validate checks the field values on GetFeedSyncConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeedSyncConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeedSyncConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeedSyncConfigResponseMultiError, or nil if none found.
+func (m *GetFeedSyncConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeedSyncConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for Version
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFeedSyncConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFeedSyncConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFeedSyncConfigResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFeedSyncConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeedSyncConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFeedSyncConfigResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetFeedSyncConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeedSyncConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeedSyncConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetFeedSyncConfigResponseValidationError is the validation error returned by
+// GetFeedSyncConfigResponse.Validate if the designated constraints aren't met.
+type GetFeedSyncConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeedSyncConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeedSyncConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeedSyncConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeedSyncConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeedSyncConfigResponseValidationError) ErrorName() string {
+	return "GetFeedSyncConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeedSyncConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeedSyncConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeedSyncConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeedSyncConfigResponseValidationError{}
+
+// Validate checks the field values on UpdateFeedSyncConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFeedSyncConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFeedSyncConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFeedSyncConfigRequestMultiError, or nil if none found.
+func (m *UpdateFeedSyncConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFeedSyncConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdateFeedSyncConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFeedSyncConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateFeedSyncConfigRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateFeedSyncConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFeedSyncConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFeedSyncConfigRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFeedSyncConfigRequestValidationError is the validation error returned
+// by UpdateFeedSyncConfigRequest.Validate if the designated constraints
+// aren't met.
+type UpdateFeedSyncConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFeedSyncConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFeedSyncConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFeedSyncConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFeedSyncConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFeedSyncConfigRequestValidationError) ErrorName() string {
+	return "UpdateFeedSyncConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFeedSyncConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFeedSyncConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFeedSyncConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFeedSyncConfigRequestValidationError{}
@@ -46,7 +46,13 @@ type FeedSyncAdminService interface {
 
 	SyncFeeds(context.Context, *SyncFeedsRequest) (*SyncFeedsResponse, error)
 
+	WatchSyncJob(context.Context, *WatchSyncJobRequest) (*SyncJobEvent, error)
+
 	GetFeedSyncStatus(context.Context, *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error)
+
+	GetFeedSyncConfig(context.Context, *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error)
+
+	UpdateFeedSyncConfig(context.Context, *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error)
 }
 
 // ====================================
@@ -55,7 +61,7 @@ type FeedSyncAdminService interface {
 
 type feedSyncAdminServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [7]string
+	urls        [10]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -83,14 +89,17 @@ func NewFeedSyncAdminServiceProtobufClient(baseURL string, client HTTPClient, op
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "feeds.v1", "FeedSyncAdminService")
-	urls := [7]string{
+	urls := [10]string{
 		serviceURL + "ListFeedSources",
 		serviceURL + "GetFeedSource",
 		serviceURL + "CreateFeedSource",
 		serviceURL + "UpdateFeedSource",
 		serviceURL + "DeleteFeedSource",
 		serviceURL + "SyncFeeds",
+		serviceURL + "WatchSyncJob",
 		serviceURL + "GetFeedSyncStatus",
+		serviceURL + "GetFeedSyncConfig",
+		serviceURL + "UpdateFeedSyncConfig",
 	}
 
 	return &feedSyncAdminServiceProtobufClient{
@@ -377,6 +386,52 @@ func (c *feedSyncAdminServiceProtobufClient) callSyncFeeds(ctx context.Context,
 	return out, nil
 }
 
+func (c *feedSyncAdminServiceProtobufClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
+	caller := c.callWatchSyncJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchSyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
+					}
+					return c.callWatchSyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncJobEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *feedSyncAdminServiceProtobufClient) callWatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	out := new(SyncJobEvent)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *feedSyncAdminServiceProtobufClient) GetFeedSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
@@ -408,7 +463,99 @@ func (c *feedSyncAdminServiceProtobufClient) GetFeedSyncStatus(ctx context.Conte
 
 func (c *feedSyncAdminServiceProtobufClient) callGetFeedSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
 	out := new(GetFeedSyncStatusResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *feedSyncAdminServiceProtobufClient) GetFeedSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncConfig")
+	caller := c.callGetFeedSyncConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callGetFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *feedSyncAdminServiceProtobufClient) callGetFeedSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+	out := new(GetFeedSyncConfigResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *feedSyncAdminServiceProtobufClient) UpdateFeedSyncConfig(ctx context.Context, in *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFeedSyncConfig")
+	caller := c.callUpdateFeedSyncConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFeedSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFeedSyncConfigRequest) when calling interceptor")
+					}
+					return c.callUpdateFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *feedSyncAdminServiceProtobufClient) callUpdateFeedSyncConfig(ctx context.Context, in *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+	out := new(GetFeedSyncConfigResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -429,7 +576,7 @@ func (c *feedSyncAdminServiceProtobufClient) callGetFeedSyncStatus(ctx context.C
 
 type feedSyncAdminServiceJSONClient struct {
 	client      HTTPClient
-	urls        [7]string
+	urls        [10]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -457,14 +604,17 @@ func NewFeedSyncAdminServiceJSONClient(baseURL string, client HTTPClient, opts .
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "feeds.v1", "FeedSyncAdminService")
-	urls := [7]string{
+	urls := [10]string{
 		serviceURL + "ListFeedSources",
 		serviceURL + "GetFeedSource",
 		serviceURL + "CreateFeedSource",
 		serviceURL + "UpdateFeedSource",
 		serviceURL + "DeleteFeedSource",
 		serviceURL + "SyncFeeds",
+		serviceURL + "WatchSyncJob",
 		serviceURL + "GetFeedSyncStatus",
+		serviceURL + "GetFeedSyncConfig",
+		serviceURL + "UpdateFeedSyncConfig",
 	}
 
 	return &feedSyncAdminServiceJSONClient{
@@ -751,6 +901,52 @@ func (c *feedSyncAdminServiceJSONClient) callSyncFeeds(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *feedSyncAdminServiceJSONClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
+	caller := c.callWatchSyncJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchSyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
+					}
+					return c.callWatchSyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncJobEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *feedSyncAdminServiceJSONClient) callWatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	out := new(SyncJobEvent)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *feedSyncAdminServiceJSONClient) GetFeedSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
@@ -782,7 +978,99 @@ func (c *feedSyncAdminServiceJSONClient) GetFeedSyncStatus(ctx context.Context,
 
 func (c *feedSyncAdminServiceJSONClient) callGetFeedSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
 	out := new(GetFeedSyncStatusResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *feedSyncAdminServiceJSONClient) GetFeedSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncConfig")
+	caller := c.callGetFeedSyncConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callGetFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *feedSyncAdminServiceJSONClient) callGetFeedSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+	out := new(GetFeedSyncConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *feedSyncAdminServiceJSONClient) UpdateFeedSyncConfig(ctx context.Context, in *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "feeds.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "FeedSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFeedSyncConfig")
+	caller := c.callUpdateFeedSyncConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFeedSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFeedSyncConfigRequest) when calling interceptor")
+					}
+					return c.callUpdateFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *feedSyncAdminServiceJSONClient) callUpdateFeedSyncConfig(ctx context.Context, in *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+	out := new(GetFeedSyncConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -912,9 +1200,18 @@ func (s *feedSyncAdminServiceServer) ServeHTTP(resp http.ResponseWriter, req *ht
 	case "SyncFeeds":
 		s.serveSyncFeeds(ctx, resp, req)
 		return
+	case "WatchSyncJob":
+		s.serveWatchSyncJob(ctx, resp, req)
+		return
 	case "GetFeedSyncStatus":
 		s.serveGetFeedSyncStatus(ctx, resp, req)
 		return
+	case "GetFeedSyncConfig":
+		s.serveGetFeedSyncConfig(ctx, resp, req)
+		return
+	case "UpdateFeedSyncConfig":
+		s.serveUpdateFeedSyncConfig(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -2002,7 +2299,7 @@ func (s *feedSyncAdminServiceServer) serveSyncFeedsProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *feedSyncAdminServiceServer) serveWatchSyncJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2010,9 +2307,9 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatus(ctx context.Context,
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetFeedSyncStatusJSON(ctx, resp, req)
+		s.serveWatchSyncJobJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetFeedSyncStatusProtobuf(ctx, resp, req)
+		s.serveWatchSyncJobProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2020,9 +2317,9 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatus(ctx context.Context,
 	}
 }
 
-func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *feedSyncAdminServiceServer) serveWatchSyncJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2035,29 +2332,29 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusJSON(ctx context.Cont
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(WatchSyncJobRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.FeedSyncAdminService.GetFeedSyncStatus
+	handler := s.FeedSyncAdminService.WatchSyncJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
+		handler = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*WatchSyncJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
 					}
-					return s.FeedSyncAdminService.GetFeedSyncStatus(ctx, typedReq)
+					return s.FeedSyncAdminService.WatchSyncJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetFeedSyncStatusResponse)
+				typedResp, ok := resp.(*SyncJobEvent)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2066,7 +2363,7 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusJSON(ctx context.Cont
 	}
 
 	// Call service method
-	var respContent *GetFeedSyncStatusResponse
+	var respContent *SyncJobEvent
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2077,7 +2374,7 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusJSON(ctx context.Cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncStatusResponse and nil error while calling GetFeedSyncStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncJobEvent and nil error while calling WatchSyncJob. nil responses are not supported"))
 		return
 	}
 
@@ -2103,9 +2400,9 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusJSON(ctx context.Cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *feedSyncAdminServiceServer) serveWatchSyncJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2117,28 +2414,28 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusProtobuf(ctx context.
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(WatchSyncJobRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.FeedSyncAdminService.GetFeedSyncStatus
+	handler := s.FeedSyncAdminService.WatchSyncJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
+		handler = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*WatchSyncJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
 					}
-					return s.FeedSyncAdminService.GetFeedSyncStatus(ctx, typedReq)
+					return s.FeedSyncAdminService.WatchSyncJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetFeedSyncStatusResponse)
+				typedResp, ok := resp.(*SyncJobEvent)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2147,7 +2444,7 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusProtobuf(ctx context.
 	}
 
 	// Call service method
-	var respContent *GetFeedSyncStatusResponse
+	var respContent *SyncJobEvent
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2158,7 +2455,547 @@ func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusProtobuf(ctx context.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncStatusResponse and nil error while calling GetFeedSyncStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncJobEvent and nil error while calling WatchSyncJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFeedSyncStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFeedSyncStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FeedSyncAdminService.GetFeedSyncStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.FeedSyncAdminService.GetFeedSyncStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFeedSyncStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncStatusResponse and nil error while calling GetFeedSyncStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *feedSyncAdminServiceServer) serveGetFeedSyncStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FeedSyncAdminService.GetFeedSyncStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.FeedSyncAdminService.GetFeedSyncStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFeedSyncStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncStatusResponse and nil error while calling GetFeedSyncStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *feedSyncAdminServiceServer) serveGetFeedSyncConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetFeedSyncConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetFeedSyncConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *feedSyncAdminServiceServer) serveGetFeedSyncConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FeedSyncAdminService.GetFeedSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.FeedSyncAdminService.GetFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFeedSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncConfigResponse and nil error while calling GetFeedSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *feedSyncAdminServiceServer) serveGetFeedSyncConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetFeedSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FeedSyncAdminService.GetFeedSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetFeedSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.FeedSyncAdminService.GetFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFeedSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncConfigResponse and nil error while calling GetFeedSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *feedSyncAdminServiceServer) serveUpdateFeedSyncConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateFeedSyncConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateFeedSyncConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *feedSyncAdminServiceServer) serveUpdateFeedSyncConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFeedSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateFeedSyncConfigRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.FeedSyncAdminService.UpdateFeedSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFeedSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFeedSyncConfigRequest) when calling interceptor")
+					}
+					return s.FeedSyncAdminService.UpdateFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFeedSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncConfigResponse and nil error while calling UpdateFeedSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *feedSyncAdminServiceServer) serveUpdateFeedSyncConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateFeedSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateFeedSyncConfigRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.FeedSyncAdminService.UpdateFeedSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateFeedSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateFeedSyncConfigRequest) when calling interceptor")
+					}
+					return s.FeedSyncAdminService.UpdateFeedSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetFeedSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetFeedSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetFeedSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetFeedSyncConfigResponse and nil error while calling UpdateFeedSyncConfig. nil responses are not supported"))
 		return
 	}
 
@@ -3815,91 +4652,116 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xd6, 0xda, 0x71, 0x6c, 0x1f, 0x3b, 0xa9, 0x3b, 0xe4, 0x67, 0xeb, 0xfe, 0xc4, 0xdd, 0x42,
-	0x1b, 0x2a, 0xea, 0x55, 0x02, 0x08, 0xda, 0x0a, 0x09, 0xf7, 0x57, 0x95, 0xa0, 0x12, 0x1b, 0x7a,
-	0xc3, 0x8d, 0xb5, 0xf1, 0x4e, 0x9c, 0x51, 0xec, 0xdd, 0x65, 0x67, 0x36, 0xad, 0x8b, 0xb8, 0xa9,
-	0x78, 0x03, 0xc4, 0x05, 0xf7, 0x3c, 0x01, 0xd7, 0x08, 0x1e, 0x02, 0xf1, 0x06, 0x5c, 0xf3, 0x0c,
-	0x68, 0xce, 0xcc, 0xae, 0x9d, 0x5d, 0x6f, 0xdc, 0xa8, 0xb9, 0xca, 0xfc, 0x7c, 0x73, 0xce, 0x9c,
-	0x73, 0xbe, 0xf3, 0x8d, 0x37, 0xf0, 0xde, 0x01, 0xa5, 0x1e, 0xb7, 0x8f, 0x77, 0x6c, 0x39, 0xe8,
-	0x86, 0x51, 0x20, 0x02, 0x52, 0xc3, 0xc5, 0xee, 0xf1, 0x4e, 0xfb, 0xca, 0x30, 0x08, 0x86, 0x23,
-	0x6a, 0xbb, 0x21, 0xb3, 0x5d, 0xdf, 0x0f, 0x84, 0x2b, 0x58, 0xe0, 0x73, 0x85, 0x6b, 0x5f, 0xd6,
-	0xbb, 0x38, 0xdb, 0x8f, 0x0f, 0x6c, 0x3a, 0x0e, 0xc5, 0x44, 0x6f, 0x6e, 0x65, 0x37, 0x05, 0x1b,
-	0x53, 0x2e, 0xdc, 0x71, 0xa8, 0x00, 0xd6, 0x6f, 0x4b, 0x00, 0x4f, 0x28, 0xf5, 0xf6, 0x82, 0x38,
-	0x1a, 0x50, 0xb2, 0x0a, 0x25, 0xe6, 0x99, 0x46, 0xc7, 0xd8, 0xae, 0x3b, 0x25, 0xe6, 0x91, 0x16,
-	0x94, 0xe3, 0x68, 0x64, 0x96, 0x70, 0x41, 0x0e, 0xc9, 0x75, 0x68, 0x7a, 0x8c, 0x87, 0x23, 0x77,
-	0xd2, 0xf7, 0xdd, 0x31, 0x35, 0xcb, 0xb8, 0xd5, 0xd0, 0x6b, 0xcf, 0xdd, 0x31, 0x25, 0x1d, 0x68,
-	0x78, 0x94, 0x0f, 0x22, 0x16, 0xca, 0x7b, 0x9a, 0x4b, 0x1a, 0x31, 0x5d, 0x22, 0x97, 0xa0, 0xc6,
-	0x99, 0xa0, 0x7d, 0x69, 0xbb, 0x82, 0xdb, 0x55, 0x39, 0x7f, 0x11, 0x8d, 0x88, 0x09, 0x55, 0xea,
-	0xbb, 0xfb, 0x23, 0xea, 0x99, 0xcb, 0x1d, 0x63, 0xbb, 0xe6, 0x24, 0x53, 0x42, 0x60, 0x89, 0x0a,
-	0x77, 0x68, 0x56, 0xf1, 0x00, 0x8e, 0xc9, 0x0d, 0x58, 0x19, 0xb9, 0x5c, 0xf4, 0xc7, 0x81, 0xc7,
-	0x0e, 0x18, 0xf5, 0xcc, 0x1a, 0x6e, 0x36, 0xe5, 0xe2, 0xd7, 0x7a, 0x8d, 0x7c, 0x09, 0xab, 0x08,
-	0xe2, 0x13, 0x7f, 0x40, 0xbd, 0xbe, 0x2b, 0xcc, 0x7a, 0xc7, 0xd8, 0x6e, 0xec, 0xb6, 0xbb, 0x2a,
-	0x3b, 0xdd, 0x24, 0x3b, 0xdd, 0x6f, 0x93, 0xec, 0x28, 0x0b, 0x7b, 0x78, 0xa0, 0x27, 0xc8, 0x03,
-	0xb8, 0xa0, 0x2c, 0xc4, 0x83, 0x01, 0xe5, 0x5c, 0x9a, 0x80, 0x85, 0x26, 0xf0, 0x66, 0x7b, 0xea,
-	0x44, 0x4f, 0x90, 0x9b, 0xda, 0x46, 0x14, 0xfb, 0x7d, 0x2e, 0x5c, 0x11, 0x73, 0xb3, 0x81, 0x97,
-	0x45, 0x9c, 0x13, 0xfb, 0x7b, 0xb8, 0x48, 0xae, 0x02, 0x20, 0x8e, 0x46, 0x51, 0x10, 0x99, 0x4d,
-	0x84, 0xd4, 0xe5, 0xca, 0x63, 0xb9, 0x40, 0xee, 0x02, 0x0c, 0x22, 0xea, 0x0a, 0x15, 0xc8, 0xca,
-	0xc2, 0x5b, 0xd4, 0x35, 0xba, 0x27, 0xe4, 0xd1, 0x38, 0xf4, 0x92, 0xa3, 0xab, 0x8b, 0x8f, 0x6a,
-	0x74, 0x4f, 0x58, 0x7f, 0x96, 0xa1, 0x21, 0x69, 0xf2, 0x30, 0xf0, 0x05, 0xf5, 0x45, 0x8e, 0x27,
-	0xef, 0xc3, 0xaa, 0xa4, 0x6b, 0x9f, 0x23, 0x8d, 0xfa, 0xcc, 0xd3, 0x94, 0x69, 0x1e, 0xa4, 0xdc,
-	0x7a, 0xe6, 0x91, 0x36, 0xd4, 0x98, 0x47, 0x7d, 0xc1, 0xc4, 0x44, 0xf3, 0x26, 0x9d, 0xcb, 0xea,
-	0x0e, 0x63, 0xe6, 0x69, 0xb6, 0xe0, 0x98, 0xac, 0x41, 0x45, 0x30, 0x31, 0xa2, 0x9a, 0x23, 0x6a,
-	0x22, 0x19, 0xc2, 0xe3, 0xf1, 0xd8, 0x8d, 0x26, 0xc8, 0x10, 0xc9, 0x1d, 0x35, 0x95, 0x3b, 0x03,
-	0x75, 0x41, 0x4d, 0x92, 0x64, 0x2a, 0xad, 0x8f, 0x98, 0x7f, 0xa4, 0xe9, 0x81, 0x63, 0xb2, 0x01,
-	0xcb, 0x6e, 0x2c, 0x0e, 0x83, 0x08, 0xe9, 0x50, 0x77, 0xf4, 0x8c, 0x5c, 0x03, 0x18, 0xb8, 0x82,
-	0x0e, 0x83, 0x88, 0x51, 0x6e, 0x42, 0xa7, 0xbc, 0x5d, 0x77, 0x66, 0x56, 0xc8, 0x17, 0xd0, 0x0c,
-	0xe3, 0xfd, 0x11, 0xe3, 0x87, 0x2a, 0x91, 0x8d, 0x85, 0x89, 0x6c, 0xa4, 0xf8, 0x5c, 0x15, 0x9a,
-	0x67, 0xa8, 0x82, 0x3c, 0x7a, 0x40, 0xc5, 0xe0, 0xf0, 0xad, 0x6b, 0xaf, 0xd1, 0x3d, 0x61, 0xfd,
-	0x64, 0xc0, 0x2a, 0xf6, 0xf9, 0xc4, 0x1f, 0x38, 0x94, 0xc7, 0x23, 0x31, 0xa7, 0x66, 0xc6, 0x9c,
-	0x9a, 0x99, 0x50, 0xd5, 0x56, 0xb0, 0xa4, 0x15, 0x27, 0x99, 0x92, 0x2b, 0x50, 0x0f, 0x69, 0xc4,
-	0x19, 0x17, 0xd4, 0xc3, 0x72, 0x56, 0x9c, 0xe9, 0x82, 0xac, 0x9d, 0x62, 0xb0, 0x2a, 0xa8, 0x9a,
-	0x58, 0x7f, 0x94, 0xa6, 0xd7, 0xd0, 0x7c, 0x7f, 0xbb, 0x6b, 0xe4, 0x7b, 0xb8, 0xf4, 0xee, 0x3d,
-	0x5c, 0x3e, 0x87, 0x1e, 0x5e, 0x5a, 0xdc, 0xc3, 0x95, 0x6c, 0x0f, 0x27, 0x4a, 0xb6, 0x7c, 0x9a,
-	0x92, 0x55, 0xf3, 0x4a, 0x66, 0x3d, 0x83, 0x8d, 0xaf, 0x18, 0x17, 0x53, 0xc1, 0xe6, 0x0e, 0xfd,
-	0x3e, 0xa6, 0x1c, 0x09, 0x1e, 0xba, 0x43, 0x8a, 0xb9, 0xab, 0x38, 0x38, 0x26, 0x97, 0xa1, 0x2e,
-	0xff, 0xf6, 0x39, 0x7b, 0x4d, 0x75, 0xf1, 0x6a, 0x72, 0x61, 0x8f, 0xbd, 0xa6, 0xd6, 0x2f, 0x06,
-	0x6c, 0xe6, 0x6c, 0xf1, 0x30, 0xf0, 0x39, 0x25, 0x5d, 0xa8, 0xaa, 0x6a, 0x70, 0xd3, 0xe8, 0x94,
-	0xb7, 0x1b, 0xbb, 0x6b, 0xdd, 0xe4, 0x31, 0xea, 0x4e, 0xf1, 0x4e, 0x02, 0x4a, 0x9d, 0x97, 0x8a,
-	0x9c, 0x97, 0x4f, 0x3a, 0x97, 0xfa, 0x7f, 0xe8, 0xf2, 0xbe, 0x4f, 0x5f, 0x09, 0x4c, 0x60, 0xcd,
-	0xa9, 0x1e, 0xba, 0xfc, 0x39, 0x7d, 0x25, 0xac, 0x9b, 0xb0, 0xf6, 0x94, 0xce, 0xdc, 0x2a, 0x09,
-	0x30, 0xa3, 0x38, 0xd6, 0x53, 0xd8, 0x7c, 0x88, 0xca, 0x96, 0x87, 0x7e, 0x04, 0xcb, 0xea, 0x66,
-	0x08, 0x2f, 0xba, 0xbd, 0xc6, 0x48, 0x43, 0x2f, 0xb0, 0xc3, 0xde, 0xd5, 0xd0, 0x87, 0xb0, 0xf9,
-	0x88, 0x8e, 0xe8, 0x3c, 0x43, 0xd9, 0xcb, 0xdf, 0x06, 0x33, 0x0f, 0xd5, 0xc9, 0xcf, 0x62, 0x3f,
-	0x87, 0x96, 0xe4, 0xb0, 0x44, 0xa6, 0xd5, 0x7e, 0xab, 0x9e, 0xb1, 0xfe, 0x32, 0xe0, 0xe2, 0xcc,
-	0x51, 0x6d, 0xff, 0x2e, 0x00, 0x17, 0x6e, 0xa4, 0xf5, 0xc7, 0x58, 0x2c, 0x22, 0x1a, 0xdd, 0x13,
-	0xe4, 0x3e, 0x34, 0x0e, 0x98, 0x9f, 0x0a, 0xdf, 0xe2, 0x0e, 0x84, 0x04, 0xde, 0x13, 0x64, 0x17,
-	0xaa, 0x11, 0x0a, 0x0f, 0x37, 0xcb, 0x48, 0x2a, 0x33, 0x93, 0xcd, 0x54, 0x99, 0x9c, 0x04, 0x68,
-	0xfd, 0x5e, 0x82, 0x4b, 0x09, 0x1b, 0x52, 0xc5, 0x48, 0x23, 0x49, 0x3b, 0xfa, 0x2c, 0xe1, 0xa8,
-	0x8e, 0x4e, 0x43, 0x7a, 0x04, 0x2d, 0xb4, 0x71, 0xb6, 0xb8, 0x50, 0x8b, 0x9e, 0x4c, 0x63, 0x33,
-	0xa1, 0x1a, 0xc5, 0xbe, 0xcf, 0xfc, 0x21, 0x52, 0xbd, 0xe6, 0x24, 0x53, 0x72, 0x1f, 0x9a, 0x4a,
-	0x31, 0x74, 0xe8, 0x4b, 0x0b, 0x42, 0x6f, 0xa0, 0x90, 0x28, 0x30, 0xf9, 0x04, 0x6a, 0x4a, 0x65,
-	0x28, 0x37, 0x2b, 0x45, 0x07, 0x75, 0x52, 0x52, 0xa4, 0x15, 0x4e, 0x1b, 0x5b, 0x3f, 0xd7, 0x67,
-	0xe3, 0xcd, 0x99, 0xdb, 0xd9, 0xfa, 0xd5, 0x00, 0x33, 0xef, 0x52, 0x57, 0x69, 0x07, 0x6a, 0xfa,
-	0x15, 0x4e, 0xd4, 0x64, 0xfd, 0x64, 0x10, 0xfa, 0x84, 0x93, 0xc2, 0xce, 0x55, 0x4f, 0x6e, 0xc1,
-	0xba, 0x66, 0x50, 0xe2, 0xa7, 0xa0, 0x27, 0x5f, 0xc2, 0x46, 0x16, 0xa8, 0x23, 0xb0, 0xa7, 0x3f,
-	0x2b, 0x14, 0xbf, 0x0a, 0x02, 0x48, 0x7f, 0x6d, 0x4c, 0x75, 0xa3, 0xb4, 0x58, 0x37, 0x76, 0xff,
-	0x59, 0x86, 0xb5, 0xa4, 0x98, 0x3d, 0x6f, 0xcc, 0xfc, 0x3d, 0x1a, 0x1d, 0xb3, 0x01, 0x25, 0xaf,
-	0xe1, 0x42, 0x46, 0xa1, 0x49, 0x67, 0x6a, 0x69, 0xfe, 0x43, 0xd0, 0xbe, 0x7e, 0x0a, 0x42, 0xc5,
-	0x63, 0x59, 0x6f, 0xfe, 0xfe, 0xf7, 0xe7, 0xd2, 0x15, 0xd2, 0xc6, 0x2f, 0x8a, 0xe3, 0x1d, 0xdb,
-	0x95, 0x5e, 0xf1, 0xdb, 0xe3, 0x4e, 0x22, 0xe9, 0x3e, 0xac, 0x9c, 0x90, 0x61, 0x72, 0x6d, 0x6a,
-	0x77, 0x9e, 0x3e, 0xb7, 0xe7, 0xc6, 0x68, 0xdd, 0x42, 0x57, 0xd7, 0xc9, 0x56, 0xb1, 0x2b, 0xfb,
-	0x07, 0xe6, 0xfd, 0x48, 0x22, 0x68, 0x65, 0xe5, 0x9c, 0xcc, 0x84, 0x52, 0x20, 0xf5, 0x05, 0x5e,
-	0x3f, 0x40, 0xaf, 0x5b, 0xf7, 0x8c, 0xdb, 0xd6, 0x69, 0x31, 0x46, 0xd0, 0xca, 0x2a, 0xff, 0xac,
-	0xcf, 0x82, 0x57, 0x61, 0xa1, 0xcf, 0xdd, 0xd3, 0x7c, 0xbe, 0x31, 0xa0, 0x95, 0x95, 0xfe, 0x59,
-	0xa7, 0x05, 0x2f, 0x48, 0xdb, 0x3a, 0x0d, 0xa2, 0xeb, 0xaa, 0x93, 0x7d, 0x7b, 0x61, 0xb2, 0x19,
-	0xd4, 0xd3, 0x77, 0x81, 0xb4, 0xa7, 0x96, 0xb3, 0xef, 0x4c, 0xfb, 0xf2, 0xdc, 0x3d, 0xed, 0xee,
-	0x06, 0xba, 0xbb, 0x2a, 0xb3, 0x6c, 0xe6, 0x3d, 0xf2, 0x7b, 0xf2, 0xa7, 0x1a, 0x99, 0xc0, 0xc5,
-	0x9c, 0x80, 0x93, 0x8d, 0x9c, 0xb4, 0x3e, 0x96, 0xdf, 0xac, 0xed, 0x1b, 0x79, 0x8e, 0xe5, 0x54,
-	0xff, 0x34, 0x4a, 0x71, 0x5b, 0xfa, 0xbc, 0xa3, 0x94, 0x70, 0xf7, 0xbf, 0x12, 0xb4, 0xa4, 0x8d,
-	0x6f, 0x62, 0x1a, 0x4d, 0x92, 0x9e, 0x1a, 0x42, 0x3d, 0x69, 0x8b, 0x73, 0xea, 0xa6, 0x75, 0xbc,
-	0xcf, 0x05, 0xb2, 0x92, 0xdc, 0x07, 0x4f, 0x90, 0x57, 0xd0, 0xca, 0x4a, 0x22, 0x99, 0x63, 0x2d,
-	0xa3, 0xd0, 0xb3, 0x75, 0x2e, 0x52, 0x54, 0xeb, 0x2a, 0x7a, 0xdc, 0x24, 0xeb, 0xb3, 0x1e, 0xef,
-	0xa4, 0xea, 0xf9, 0x12, 0x56, 0x4f, 0x0a, 0x19, 0xd9, 0xca, 0xe5, 0xf5, 0xa4, 0x16, 0xb6, 0x3b,
-	0xc5, 0x80, 0x22, 0xcd, 0x38, 0xe1, 0x13, 0x69, 0xf5, 0xe0, 0xb3, 0xef, 0x3e, 0x1d, 0x32, 0x71,
-	0x18, 0xef, 0x77, 0x07, 0xc1, 0xd8, 0x3e, 0x0a, 0xfc, 0xe1, 0x11, 0xf5, 0x6d, 0xcf, 0x15, 0x2e,
-	0x8f, 0x8e, 0xed, 0xf0, 0x68, 0xa8, 0xfe, 0x0d, 0x61, 0x27, 0xff, 0xed, 0xb8, 0x8f, 0x83, 0xe3,
-	0x9d, 0xfd, 0x65, 0x5c, 0xff, 0xf8, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x53, 0xa5, 0xc0, 0xb3,
-	0x08, 0x11, 0x00, 0x00,
+	// 1772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x8f, 0x1b, 0xc7,
+	0x11, 0xc6, 0xf0, 0xcd, 0x22, 0x77, 0x97, 0x6e, 0xef, 0x63, 0x44, 0x69, 0xbd, 0xd4, 0xc8, 0xb2,
+	0xd7, 0x8b, 0x88, 0xf4, 0x6e, 0x9e, 0x96, 0x11, 0x20, 0x94, 0x2c, 0x1b, 0x16, 0x1c, 0x23, 0x19,
+	0xae, 0x11, 0x20, 0x40, 0x40, 0x34, 0x67, 0x7a, 0xc9, 0xd6, 0x0e, 0x67, 0x98, 0xe9, 0x1e, 0x4a,
+	0x54, 0x90, 0x8b, 0x91, 0x63, 0x90, 0x4b, 0x90, 0x5f, 0x90, 0xfc, 0x04, 0x1f, 0x83, 0xfc, 0x81,
+	0x1c, 0x73, 0xc8, 0x25, 0xc7, 0xe4, 0x9a, 0xdf, 0x10, 0xf4, 0x63, 0x86, 0xc3, 0xe1, 0x6b, 0x17,
+	0xd6, 0x21, 0x27, 0x76, 0x57, 0x7f, 0x5d, 0x55, 0x5d, 0x55, 0xfd, 0x75, 0x0d, 0xe1, 0xed, 0x2b,
+	0x42, 0x5c, 0xd6, 0x99, 0x9e, 0x77, 0xc4, 0xa0, 0x3d, 0x09, 0x03, 0x1e, 0xa0, 0x8a, 0x14, 0xb6,
+	0xa7, 0xe7, 0xcd, 0x7b, 0xc3, 0x20, 0x18, 0x7a, 0xa4, 0x83, 0x27, 0xb4, 0x83, 0x7d, 0x3f, 0xe0,
+	0x98, 0xd3, 0xc0, 0x67, 0x0a, 0xd7, 0xbc, 0xab, 0x57, 0xe5, 0x6c, 0x10, 0x5d, 0x75, 0xc8, 0x78,
+	0xc2, 0x67, 0x7a, 0xf1, 0x24, 0xbb, 0xc8, 0xe9, 0x98, 0x30, 0x8e, 0xc7, 0x13, 0x05, 0xb0, 0xfe,
+	0x52, 0x00, 0xf8, 0x94, 0x10, 0xb7, 0x17, 0x44, 0xa1, 0x43, 0xd0, 0x2e, 0xe4, 0xa8, 0x6b, 0x1a,
+	0x2d, 0xe3, 0xb4, 0x6a, 0xe7, 0xa8, 0x8b, 0x1a, 0x90, 0x8f, 0x42, 0xcf, 0xcc, 0x49, 0x81, 0x18,
+	0xa2, 0xfb, 0x50, 0x77, 0x29, 0x9b, 0x78, 0x78, 0xd6, 0xf7, 0xf1, 0x98, 0x98, 0x79, 0xb9, 0x54,
+	0xd3, 0xb2, 0x2f, 0xf1, 0x98, 0xa0, 0x16, 0xd4, 0x5c, 0xc2, 0x9c, 0x90, 0x4e, 0x84, 0x9f, 0x66,
+	0x41, 0x23, 0xe6, 0x22, 0x74, 0x07, 0x2a, 0x8c, 0x72, 0xd2, 0x17, 0xba, 0x8b, 0x72, 0xb9, 0x2c,
+	0xe6, 0x5f, 0x85, 0x1e, 0x32, 0xa1, 0x4c, 0x7c, 0x3c, 0xf0, 0x88, 0x6b, 0x96, 0x5a, 0xc6, 0x69,
+	0xc5, 0x8e, 0xa7, 0x08, 0x41, 0x81, 0x70, 0x3c, 0x34, 0xcb, 0x72, 0x83, 0x1c, 0xa3, 0x07, 0xb0,
+	0xe3, 0x61, 0xc6, 0xfb, 0xe3, 0xc0, 0xa5, 0x57, 0x94, 0xb8, 0x66, 0x45, 0x2e, 0xd6, 0x85, 0xf0,
+	0xa7, 0x5a, 0x86, 0x7e, 0x02, 0xbb, 0x12, 0xc4, 0x66, 0xbe, 0x43, 0xdc, 0x3e, 0xe6, 0x66, 0xb5,
+	0x65, 0x9c, 0xd6, 0x2e, 0x9a, 0x6d, 0x15, 0x9d, 0x76, 0x1c, 0x9d, 0xf6, 0x65, 0x1c, 0x1d, 0xa5,
+	0xa1, 0x27, 0x37, 0x74, 0x39, 0x7a, 0x02, 0x7b, 0x4a, 0x43, 0xe4, 0x38, 0x84, 0x31, 0xa1, 0x02,
+	0xb6, 0xaa, 0x90, 0x9e, 0xf5, 0xd4, 0x8e, 0x2e, 0x47, 0xef, 0x69, 0x1d, 0x61, 0xe4, 0xf7, 0x19,
+	0xc7, 0x3c, 0x62, 0x66, 0x4d, 0x3a, 0x2b, 0x71, 0x76, 0xe4, 0xf7, 0xa4, 0x10, 0x1d, 0x03, 0x48,
+	0x1c, 0x09, 0xc3, 0x20, 0x34, 0xeb, 0x12, 0x52, 0x15, 0x92, 0x67, 0x42, 0x80, 0x3e, 0x02, 0x70,
+	0x42, 0x82, 0xb9, 0x3a, 0xc8, 0xce, 0x56, 0x2f, 0xaa, 0x1a, 0xdd, 0xe5, 0x62, 0x6b, 0x34, 0x71,
+	0xe3, 0xad, 0xbb, 0xdb, 0xb7, 0x6a, 0x74, 0x97, 0x5b, 0x7f, 0xcb, 0x43, 0x4d, 0x94, 0xc9, 0xd3,
+	0xc0, 0xe7, 0xc4, 0xe7, 0x4b, 0x75, 0xf2, 0x2e, 0xec, 0x8a, 0x72, 0xed, 0x33, 0x59, 0x46, 0x7d,
+	0xea, 0xea, 0x92, 0xa9, 0x5f, 0x25, 0xb5, 0xf5, 0xb9, 0x8b, 0x9a, 0x50, 0xa1, 0x2e, 0xf1, 0x39,
+	0xe5, 0x33, 0x5d, 0x37, 0xc9, 0x5c, 0x64, 0x77, 0x18, 0x51, 0x57, 0x57, 0x8b, 0x1c, 0xa3, 0x7d,
+	0x28, 0x72, 0xca, 0x3d, 0xa2, 0x6b, 0x44, 0x4d, 0x44, 0x85, 0xb0, 0x68, 0x3c, 0xc6, 0xe1, 0xcc,
+	0x2c, 0xe9, 0xda, 0x51, 0x53, 0xb1, 0xe2, 0x28, 0x07, 0x75, 0x91, 0xc4, 0x53, 0xa1, 0xdd, 0xa3,
+	0xfe, 0xb5, 0x2e, 0x0f, 0x39, 0x46, 0x87, 0x50, 0xc2, 0x11, 0x1f, 0x05, 0xa1, 0x2c, 0x87, 0xaa,
+	0xad, 0x67, 0xe8, 0x1d, 0x00, 0x07, 0x73, 0x32, 0x0c, 0x42, 0x4a, 0x98, 0x09, 0xad, 0xfc, 0x69,
+	0xd5, 0x4e, 0x49, 0xd0, 0x8f, 0xa1, 0x3e, 0x89, 0x06, 0x1e, 0x65, 0x23, 0x15, 0xc8, 0xda, 0xd6,
+	0x40, 0xd6, 0x12, 0xfc, 0x52, 0x16, 0xea, 0xb7, 0xc8, 0x82, 0xd8, 0x7a, 0x45, 0xb8, 0x33, 0xba,
+	0x71, 0xee, 0x35, 0xba, 0xcb, 0xad, 0xdf, 0x19, 0xb0, 0x2b, 0xef, 0xf9, 0xcc, 0x77, 0x6c, 0xc2,
+	0x22, 0x8f, 0xaf, 0xc8, 0x99, 0xb1, 0x22, 0x67, 0x26, 0x94, 0xb5, 0x16, 0x99, 0xd2, 0xa2, 0x1d,
+	0x4f, 0xd1, 0x3d, 0xa8, 0x4e, 0x48, 0xc8, 0x28, 0xe3, 0xc4, 0x95, 0xe9, 0x2c, 0xda, 0x73, 0x81,
+	0xc8, 0x9d, 0xaa, 0x60, 0x95, 0x50, 0x35, 0xb1, 0xfe, 0x9a, 0x9b, 0xbb, 0xa1, 0xeb, 0xfd, 0x66,
+	0x6e, 0x2c, 0xdf, 0xe1, 0xdc, 0xb7, 0xbf, 0xc3, 0xf9, 0x37, 0x70, 0x87, 0x0b, 0xdb, 0xef, 0x70,
+	0x31, 0x7b, 0x87, 0x63, 0x26, 0x2b, 0x6d, 0x62, 0xb2, 0xf2, 0x32, 0x93, 0x59, 0x9f, 0xc3, 0xe1,
+	0x17, 0x94, 0xf1, 0x39, 0x61, 0x33, 0x9b, 0xfc, 0x3a, 0x22, 0x4c, 0x16, 0xf8, 0x04, 0x0f, 0x89,
+	0x8c, 0x5d, 0xd1, 0x96, 0x63, 0x74, 0x17, 0xaa, 0xe2, 0xb7, 0xcf, 0xe8, 0x6b, 0xa2, 0x93, 0x57,
+	0x11, 0x82, 0x1e, 0x7d, 0x4d, 0xac, 0x3f, 0x19, 0x70, 0xb4, 0xa4, 0x8b, 0x4d, 0x02, 0x9f, 0x11,
+	0xd4, 0x86, 0xb2, 0xca, 0x06, 0x33, 0x8d, 0x56, 0xfe, 0xb4, 0x76, 0xb1, 0xdf, 0x8e, 0x1f, 0xa3,
+	0xf6, 0x1c, 0x6f, 0xc7, 0xa0, 0xc4, 0x78, 0x6e, 0x9d, 0xf1, 0xfc, 0xa2, 0x71, 0xc1, 0xff, 0x23,
+	0xcc, 0xfa, 0x3e, 0x79, 0xc5, 0x65, 0x00, 0x2b, 0x76, 0x79, 0x84, 0xd9, 0x97, 0xe4, 0x15, 0xb7,
+	0xde, 0x83, 0xfd, 0xcf, 0x48, 0xca, 0xab, 0xf8, 0x80, 0x19, 0xc6, 0xb1, 0x3e, 0x83, 0xa3, 0xa7,
+	0x92, 0xd9, 0x96, 0xa1, 0xdf, 0x81, 0x92, 0xf2, 0x4c, 0xc2, 0xd7, 0x79, 0xaf, 0x31, 0x42, 0xd1,
+	0x57, 0xf2, 0x86, 0x7d, 0x5b, 0x45, 0x1f, 0xc0, 0xd1, 0x27, 0xc4, 0x23, 0x9c, 0x6c, 0x77, 0xfe,
+	0x0c, 0xcc, 0x65, 0xa8, 0x0e, 0x7e, 0x16, 0xfb, 0x23, 0x68, 0x88, 0x1a, 0x16, 0xc8, 0x24, 0xdb,
+	0x37, 0xba, 0x33, 0xd6, 0x9f, 0x0d, 0x78, 0x2b, 0xb5, 0x55, 0xeb, 0xff, 0x08, 0x80, 0x71, 0x1c,
+	0x6a, 0xfe, 0x31, 0xb6, 0x93, 0x88, 0x46, 0x77, 0x39, 0x3a, 0x80, 0xd2, 0x8b, 0x60, 0xd0, 0x4f,
+	0x58, 0xba, 0xf8, 0x22, 0x18, 0x28, 0x5a, 0xc7, 0x9c, 0x63, 0xc9, 0x11, 0x45, 0x99, 0xcd, 0x64,
+	0xfe, 0xbc, 0x50, 0xc9, 0x35, 0xf2, 0xcf, 0x0b, 0x95, 0x7c, 0xa3, 0x60, 0xd7, 0xae, 0xa8, 0x1f,
+	0xb3, 0xa6, 0x5d, 0x0e, 0x25, 0x0b, 0x31, 0xeb, 0x21, 0xbc, 0xfd, 0x0b, 0xcc, 0x9d, 0x91, 0xf0,
+	0xf4, 0x79, 0x30, 0x58, 0x17, 0xb2, 0xbf, 0x1b, 0x50, 0xd7, 0x90, 0x67, 0x53, 0xe2, 0xa7, 0x9d,
+	0x31, 0xd2, 0xce, 0x20, 0x28, 0xf0, 0xd9, 0x84, 0xe8, 0xf7, 0x47, 0x8e, 0x05, 0xd3, 0x73, 0x1c,
+	0x0e, 0x09, 0xd7, 0xaf, 0x8e, 0x9e, 0xa5, 0xb9, 0xad, 0xb0, 0x81, 0xdb, 0x8a, 0x6b, 0xb9, 0xad,
+	0x94, 0xe2, 0x36, 0x74, 0x06, 0x39, 0xac, 0x1e, 0x9e, 0xcd, 0x01, 0xcd, 0x61, 0x6e, 0xfd, 0x27,
+	0x07, 0x77, 0xe2, 0x32, 0x4f, 0xa8, 0x30, 0x49, 0x51, 0x42, 0x55, 0xb7, 0xc9, 0x93, 0xa2, 0xaa,
+	0x24, 0x57, 0x9f, 0x40, 0x43, 0xea, 0x48, 0xc5, 0xfc, 0x06, 0x94, 0x29, 0x49, 0xf6, 0x53, 0xbd,
+	0xa5, 0x2b, 0x23, 0x14, 0x46, 0xbe, 0x4f, 0xfd, 0xa1, 0x0c, 0x5d, 0xc5, 0x8e, 0xa7, 0xe8, 0x63,
+	0xa8, 0x2b, 0x2a, 0x54, 0x69, 0x34, 0x0b, 0x92, 0x28, 0xcc, 0xcc, 0x0d, 0x49, 0x5e, 0x1b, 0xbb,
+	0x26, 0xd0, 0x6a, 0xcc, 0xd0, 0xf7, 0xa0, 0xa2, 0xe8, 0x93, 0x30, 0xb3, 0xb8, 0x6e, 0xa3, 0x0e,
+	0x4a, 0x82, 0x44, 0xe7, 0x50, 0xf2, 0x08, 0x76, 0x89, 0x8a, 0x7b, 0xed, 0xe2, 0xce, 0x7c, 0x4f,
+	0x4f, 0x64, 0x2d, 0xf2, 0x48, 0xf8, 0x85, 0x04, 0xd8, 0x1a, 0x68, 0xfd, 0x0a, 0xf6, 0x32, 0x4b,
+	0xa2, 0x18, 0x46, 0x81, 0x27, 0xb4, 0xa8, 0xba, 0xd1, 0x33, 0x79, 0x54, 0x32, 0xf1, 0xa8, 0x83,
+	0x75, 0xed, 0xc4, 0x53, 0xb1, 0x22, 0xd4, 0xa5, 0x82, 0xa0, 0xa7, 0xd6, 0x37, 0x29, 0x12, 0xd5,
+	0xad, 0xd1, 0xed, 0xee, 0xe8, 0xed, 0xa9, 0xf3, 0x18, 0x40, 0x2e, 0xf2, 0xe0, 0x9a, 0xc4, 0xbd,
+	0xb5, 0x84, 0x5f, 0x0a, 0x81, 0x78, 0x46, 0xa8, 0xef, 0x78, 0x91, 0x2b, 0x10, 0x1c, 0x7b, 0xfa,
+	0x42, 0xd6, 0xb5, 0xf0, 0x52, 0xc8, 0xac, 0x7f, 0x1a, 0x60, 0x2e, 0xbb, 0xad, 0x8b, 0xef, 0x1c,
+	0x2a, 0xba, 0x6b, 0x8a, 0xd9, 0xff, 0x60, 0x31, 0x37, 0x7a, 0x87, 0x9d, 0xc0, 0xde, 0x24, 0xff,
+	0x8b, 0x27, 0x56, 0x88, 0xfb, 0xa9, 0x43, 0xaa, 0xf7, 0x73, 0x47, 0x88, 0x7f, 0x96, 0x1c, 0x54,
+	0xf4, 0x86, 0xf2, 0x80, 0xa2, 0x16, 0xf2, 0xb6, 0x9a, 0x58, 0xef, 0xc3, 0x81, 0xbe, 0x56, 0xb1,
+	0x97, 0x6b, 0xe8, 0xe4, 0x25, 0x1c, 0x66, 0x81, 0xfa, 0xfc, 0x9d, 0x79, 0x13, 0xa9, 0x2e, 0xdd,
+	0x9a, 0xe3, 0xc7, 0xa8, 0xd4, 0x2b, 0x91, 0xbb, 0xc1, 0x2b, 0xf1, 0xfb, 0xc5, 0x9b, 0xff, 0x34,
+	0xf0, 0xaf, 0xe8, 0x30, 0x31, 0x9e, 0xfa, 0xfa, 0x31, 0x16, 0xbf, 0x7e, 0x3e, 0x80, 0x06, 0xf5,
+	0x39, 0x09, 0xa7, 0xd8, 0xeb, 0x33, 0xe2, 0x04, 0xbe, 0xcb, 0x74, 0xbc, 0xf7, 0x62, 0x79, 0x4f,
+	0x89, 0xd1, 0x0f, 0xe0, 0x28, 0x54, 0xc7, 0xee, 0x8b, 0xcf, 0xbd, 0x20, 0xe2, 0xc9, 0x0e, 0x95,
+	0x88, 0x03, 0xbd, 0x7c, 0xa9, 0x56, 0xe3, 0x7d, 0x26, 0x94, 0xa7, 0x82, 0xe3, 0xf4, 0x37, 0x5b,
+	0xde, 0x8e, 0xa7, 0xa2, 0xe8, 0xe2, 0x9e, 0x75, 0x30, 0x8b, 0xfb, 0x19, 0x2d, 0x79, 0x32, 0xcb,
+	0xb4, 0xb4, 0xa5, 0xdb, 0x7c, 0x58, 0x7c, 0x63, 0xc0, 0xdd, 0xd4, 0xf3, 0x9b, 0x8a, 0x88, 0xca,
+	0xdb, 0xff, 0x67, 0x40, 0x2e, 0xfe, 0x55, 0x85, 0xfd, 0xd8, 0xe1, 0xae, 0x3b, 0xa6, 0x7e, 0x8f,
+	0x84, 0x53, 0xea, 0x10, 0xf4, 0x1a, 0xf6, 0x32, 0x5d, 0x15, 0x6a, 0xcd, 0xeb, 0x61, 0x75, 0xf3,
+	0xd6, 0xbc, 0xbf, 0x01, 0xa1, 0x0a, 0xc3, 0xb2, 0xbe, 0xfe, 0xc7, 0xbf, 0xff, 0x98, 0xbb, 0x87,
+	0x9a, 0xf2, 0x5f, 0x80, 0xe9, 0x79, 0x07, 0x0b, 0xab, 0xf2, 0xff, 0x82, 0x47, 0x71, 0x1b, 0xe6,
+	0xc3, 0xce, 0x42, 0xeb, 0x84, 0xde, 0x99, 0xeb, 0x5d, 0xd5, 0x53, 0x35, 0x57, 0x56, 0xaa, 0xf5,
+	0xbe, 0x34, 0x75, 0x1f, 0x9d, 0xac, 0x37, 0xd5, 0xf9, 0x0d, 0x75, 0x7f, 0x8b, 0x42, 0x68, 0x64,
+	0x5b, 0x30, 0x94, 0x3a, 0xca, 0x9a, 0xf6, 0x6c, 0x8d, 0xd5, 0x87, 0xd2, 0xea, 0xc9, 0x63, 0xe3,
+	0xcc, 0xda, 0x74, 0xc6, 0x10, 0x1a, 0xd9, 0x6e, 0x2d, 0x6d, 0x73, 0x4d, 0x27, 0xb7, 0xd5, 0xe6,
+	0xc5, 0x26, 0x9b, 0x5f, 0x1b, 0xd0, 0xc8, 0xb6, 0x6b, 0x69, 0xa3, 0x6b, 0xba, 0xbe, 0xa6, 0xb5,
+	0x09, 0xa2, 0xf3, 0xaa, 0x83, 0x7d, 0xb6, 0x35, 0xd8, 0x14, 0xaa, 0x49, 0x2f, 0x87, 0x9a, 0xa9,
+	0x97, 0x2f, 0xd3, 0x1b, 0x36, 0xef, 0xae, 0x5c, 0xd3, 0xe6, 0x1e, 0x48, 0x73, 0xc7, 0x22, 0xca,
+	0xe6, 0xb2, 0x45, 0xf6, 0x58, 0x7c, 0x5e, 0xa1, 0x19, 0xd4, 0xd3, 0x1d, 0x19, 0x3a, 0x9e, 0x6b,
+	0x5c, 0xd1, 0xa9, 0x35, 0x0f, 0x17, 0x0d, 0xc6, 0x0d, 0x9a, 0xf5, 0xa1, 0xb4, 0x75, 0x86, 0x4e,
+	0x57, 0x18, 0xea, 0x08, 0x43, 0x8f, 0x5e, 0x04, 0x03, 0x75, 0xba, 0xc7, 0x2f, 0x85, 0xde, 0x0f,
+	0x0d, 0x34, 0x83, 0xb7, 0x96, 0xda, 0x22, 0x74, 0xb8, 0x44, 0x25, 0xcf, 0xc4, 0x5f, 0x5c, 0xcd,
+	0x07, 0xcb, 0xe5, 0xbd, 0xd4, 0x4b, 0x6d, 0xaa, 0x66, 0xed, 0x85, 0xea, 0x2f, 0x32, 0xa6, 0x15,
+	0x0b, 0xdd, 0xd2, 0xf4, 0x22, 0x99, 0xdf, 0xc0, 0xb4, 0xa3, 0xac, 0xfc, 0xc1, 0x80, 0xfd, 0x55,
+	0x24, 0x88, 0x1e, 0xae, 0xac, 0xec, 0x2c, 0x49, 0xde, 0xcc, 0x9b, 0x33, 0xe9, 0xcd, 0xbb, 0xa2,
+	0xd8, 0xb7, 0x39, 0x74, 0xf1, 0xdf, 0x1c, 0x34, 0x84, 0x9a, 0x9f, 0x47, 0x24, 0x9c, 0xc5, 0xd4,
+	0x36, 0x84, 0x6a, 0xcc, 0x4e, 0x6f, 0x88, 0xd4, 0x0e, 0xa4, 0x4b, 0x7b, 0x68, 0x27, 0xf6, 0x47,
+	0xee, 0x40, 0xaf, 0xa0, 0x91, 0xed, 0x4e, 0xd0, 0x0a, 0x6d, 0x99, 0x86, 0xab, 0x69, 0x6d, 0x82,
+	0x68, 0x8b, 0xc7, 0xd2, 0xe2, 0x11, 0x3a, 0x48, 0x5b, 0x7c, 0x94, 0x34, 0x32, 0x2f, 0x61, 0x77,
+	0xb1, 0x2b, 0x40, 0x27, 0x4b, 0xa1, 0x5d, 0x6c, 0x2c, 0x9a, 0xad, 0xf5, 0x80, 0x75, 0xd4, 0xbd,
+	0x60, 0x53, 0xd6, 0xff, 0x93, 0x1f, 0xfe, 0xf2, 0xfb, 0x43, 0xca, 0x47, 0xd1, 0xa0, 0xed, 0x04,
+	0xe3, 0xce, 0x75, 0xe0, 0x0f, 0xaf, 0x89, 0xdf, 0x71, 0x31, 0xc7, 0x2c, 0x9c, 0x76, 0x26, 0xd7,
+	0x43, 0xf5, 0x0f, 0x6e, 0x27, 0xfe, 0xa3, 0xf8, 0x63, 0x39, 0x98, 0x9e, 0x0f, 0x4a, 0x52, 0xfe,
+	0xdd, 0xff, 0x0d, 0x00, 0x9d, 0x04, 0xc7, 0xfa, 0x43, 0x16, 0x00, 0x00,
 }
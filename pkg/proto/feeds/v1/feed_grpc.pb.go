@@ -20,13 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FeedSyncAdminService_ListFeedSources_FullMethodName   = "/feeds.v1.FeedSyncAdminService/ListFeedSources"
-	FeedSyncAdminService_GetFeedSource_FullMethodName     = "/feeds.v1.FeedSyncAdminService/GetFeedSource"
-	FeedSyncAdminService_CreateFeedSource_FullMethodName  = "/feeds.v1.FeedSyncAdminService/CreateFeedSource"
-	FeedSyncAdminService_UpdateFeedSource_FullMethodName  = "/feeds.v1.FeedSyncAdminService/UpdateFeedSource"
-	FeedSyncAdminService_DeleteFeedSource_FullMethodName  = "/feeds.v1.FeedSyncAdminService/DeleteFeedSource"
-	FeedSyncAdminService_SyncFeeds_FullMethodName         = "/feeds.v1.FeedSyncAdminService/SyncFeeds"
-	FeedSyncAdminService_GetFeedSyncStatus_FullMethodName = "/feeds.v1.FeedSyncAdminService/GetFeedSyncStatus"
+	FeedSyncAdminService_ListFeedSources_FullMethodName      = "/feeds.v1.FeedSyncAdminService/ListFeedSources"
+	FeedSyncAdminService_GetFeedSource_FullMethodName        = "/feeds.v1.FeedSyncAdminService/GetFeedSource"
+	FeedSyncAdminService_CreateFeedSource_FullMethodName     = "/feeds.v1.FeedSyncAdminService/CreateFeedSource"
+	FeedSyncAdminService_UpdateFeedSource_FullMethodName     = "/feeds.v1.FeedSyncAdminService/UpdateFeedSource"
+	FeedSyncAdminService_DeleteFeedSource_FullMethodName     = "/feeds.v1.FeedSyncAdminService/DeleteFeedSource"
+	FeedSyncAdminService_SyncFeeds_FullMethodName            = "/feeds.v1.FeedSyncAdminService/SyncFeeds"
+	FeedSyncAdminService_WatchSyncJob_FullMethodName         = "/feeds.v1.FeedSyncAdminService/WatchSyncJob"
+	FeedSyncAdminService_GetFeedSyncStatus_FullMethodName    = "/feeds.v1.FeedSyncAdminService/GetFeedSyncStatus"
+	FeedSyncAdminService_GetFeedSyncConfig_FullMethodName    = "/feeds.v1.FeedSyncAdminService/GetFeedSyncConfig"
+	FeedSyncAdminService_UpdateFeedSyncConfig_FullMethodName = "/feeds.v1.FeedSyncAdminService/UpdateFeedSyncConfig"
 )
 
 // FeedSyncAdminServiceClient is the client API for FeedSyncAdminService service.
@@ -39,7 +42,10 @@ type FeedSyncAdminServiceClient interface {
 	UpdateFeedSource(ctx context.Context, in *UpdateFeedSourceRequest, opts ...grpc.CallOption) (*FeedSource, error)
 	DeleteFeedSource(ctx context.Context, in *DeleteFeedSourceRequest, opts ...grpc.CallOption) (*DeleteFeedSourceResponse, error)
 	SyncFeeds(ctx context.Context, in *SyncFeedsRequest, opts ...grpc.CallOption) (*SyncFeedsResponse, error)
+	WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncJobEvent], error)
 	GetFeedSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFeedSyncStatusResponse, error)
+	GetFeedSyncConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFeedSyncConfigResponse, error)
+	UpdateFeedSyncConfig(ctx context.Context, in *UpdateFeedSyncConfigRequest, opts ...grpc.CallOption) (*GetFeedSyncConfigResponse, error)
 }
 
 type feedSyncAdminServiceClient struct {
@@ -110,6 +116,25 @@ func (c *feedSyncAdminServiceClient) SyncFeeds(ctx context.Context, in *SyncFeed
 	return out, nil
 }
 
+func (c *feedSyncAdminServiceClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncJobEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FeedSyncAdminService_ServiceDesc.Streams[0], FeedSyncAdminService_WatchSyncJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSyncJobRequest, SyncJobEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeedSyncAdminService_WatchSyncJobClient = grpc.ServerStreamingClient[SyncJobEvent]
+
 func (c *feedSyncAdminServiceClient) GetFeedSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFeedSyncStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFeedSyncStatusResponse)
@@ -120,6 +145,26 @@ func (c *feedSyncAdminServiceClient) GetFeedSyncStatus(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *feedSyncAdminServiceClient) GetFeedSyncConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetFeedSyncConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedSyncConfigResponse)
+	err := c.cc.Invoke(ctx, FeedSyncAdminService_GetFeedSyncConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedSyncAdminServiceClient) UpdateFeedSyncConfig(ctx context.Context, in *UpdateFeedSyncConfigRequest, opts ...grpc.CallOption) (*GetFeedSyncConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedSyncConfigResponse)
+	err := c.cc.Invoke(ctx, FeedSyncAdminService_UpdateFeedSyncConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeedSyncAdminServiceServer is the server API for FeedSyncAdminService service.
 // All implementations must embed UnimplementedFeedSyncAdminServiceServer
 // for forward compatibility.
@@ -130,7 +175,10 @@ type FeedSyncAdminServiceServer interface {
 	UpdateFeedSource(context.Context, *UpdateFeedSourceRequest) (*FeedSource, error)
 	DeleteFeedSource(context.Context, *DeleteFeedSourceRequest) (*DeleteFeedSourceResponse, error)
 	SyncFeeds(context.Context, *SyncFeedsRequest) (*SyncFeedsResponse, error)
+	WatchSyncJob(*WatchSyncJobRequest, grpc.ServerStreamingServer[SyncJobEvent]) error
 	GetFeedSyncStatus(context.Context, *emptypb.Empty) (*GetFeedSyncStatusResponse, error)
+	GetFeedSyncConfig(context.Context, *emptypb.Empty) (*GetFeedSyncConfigResponse, error)
+	UpdateFeedSyncConfig(context.Context, *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error)
 	mustEmbedUnimplementedFeedSyncAdminServiceServer()
 }
 
@@ -159,9 +207,18 @@ func (UnimplementedFeedSyncAdminServiceServer) DeleteFeedSource(context.Context,
 func (UnimplementedFeedSyncAdminServiceServer) SyncFeeds(context.Context, *SyncFeedsRequest) (*SyncFeedsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SyncFeeds not implemented")
 }
+func (UnimplementedFeedSyncAdminServiceServer) WatchSyncJob(*WatchSyncJobRequest, grpc.ServerStreamingServer[SyncJobEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchSyncJob not implemented")
+}
 func (UnimplementedFeedSyncAdminServiceServer) GetFeedSyncStatus(context.Context, *emptypb.Empty) (*GetFeedSyncStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFeedSyncStatus not implemented")
 }
+func (UnimplementedFeedSyncAdminServiceServer) GetFeedSyncConfig(context.Context, *emptypb.Empty) (*GetFeedSyncConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeedSyncConfig not implemented")
+}
+func (UnimplementedFeedSyncAdminServiceServer) UpdateFeedSyncConfig(context.Context, *UpdateFeedSyncConfigRequest) (*GetFeedSyncConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFeedSyncConfig not implemented")
+}
 func (UnimplementedFeedSyncAdminServiceServer) mustEmbedUnimplementedFeedSyncAdminServiceServer() {}
 func (UnimplementedFeedSyncAdminServiceServer) testEmbeddedByValue()                              {}
 
@@ -291,6 +348,17 @@ func _FeedSyncAdminService_SyncFeeds_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeedSyncAdminService_WatchSyncJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSyncJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FeedSyncAdminServiceServer).WatchSyncJob(m, &grpc.GenericServerStream[WatchSyncJobRequest, SyncJobEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeedSyncAdminService_WatchSyncJobServer = grpc.ServerStreamingServer[SyncJobEvent]
+
 func _FeedSyncAdminService_GetFeedSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -309,6 +377,42 @@ func _FeedSyncAdminService_GetFeedSyncStatus_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeedSyncAdminService_GetFeedSyncConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSyncAdminServiceServer).GetFeedSyncConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSyncAdminService_GetFeedSyncConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSyncAdminServiceServer).GetFeedSyncConfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedSyncAdminService_UpdateFeedSyncConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFeedSyncConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedSyncAdminServiceServer).UpdateFeedSyncConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedSyncAdminService_UpdateFeedSyncConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedSyncAdminServiceServer).UpdateFeedSyncConfig(ctx, req.(*UpdateFeedSyncConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FeedSyncAdminService_ServiceDesc is the grpc.ServiceDesc for FeedSyncAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,8 +448,22 @@ var FeedSyncAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFeedSyncStatus",
 			Handler:    _FeedSyncAdminService_GetFeedSyncStatus_Handler,
 		},
+		{
+			MethodName: "GetFeedSyncConfig",
+			Handler:    _FeedSyncAdminService_GetFeedSyncConfig_Handler,
+		},
+		{
+			MethodName: "UpdateFeedSyncConfig",
+			Handler:    _FeedSyncAdminService_UpdateFeedSyncConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSyncJob",
+			Handler:       _FeedSyncAdminService_WatchSyncJob_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "feeds/v1/feed.proto",
 }
 
@@ -54,9 +54,18 @@ const (
 	// FeedSyncAdminServiceSyncFeedsProcedure is the fully-qualified name of the FeedSyncAdminService's
 	// SyncFeeds RPC.
 	FeedSyncAdminServiceSyncFeedsProcedure = "/feeds.v1.FeedSyncAdminService/SyncFeeds"
+	// FeedSyncAdminServiceWatchSyncJobProcedure is the fully-qualified name of the
+	// FeedSyncAdminService's WatchSyncJob RPC.
+	FeedSyncAdminServiceWatchSyncJobProcedure = "/feeds.v1.FeedSyncAdminService/WatchSyncJob"
 	// FeedSyncAdminServiceGetFeedSyncStatusProcedure is the fully-qualified name of the
 	// FeedSyncAdminService's GetFeedSyncStatus RPC.
 	FeedSyncAdminServiceGetFeedSyncStatusProcedure = "/feeds.v1.FeedSyncAdminService/GetFeedSyncStatus"
+	// FeedSyncAdminServiceGetFeedSyncConfigProcedure is the fully-qualified name of the
+	// FeedSyncAdminService's GetFeedSyncConfig RPC.
+	FeedSyncAdminServiceGetFeedSyncConfigProcedure = "/feeds.v1.FeedSyncAdminService/GetFeedSyncConfig"
+	// FeedSyncAdminServiceUpdateFeedSyncConfigProcedure is the fully-qualified name of the
+	// FeedSyncAdminService's UpdateFeedSyncConfig RPC.
+	FeedSyncAdminServiceUpdateFeedSyncConfigProcedure = "/feeds.v1.FeedSyncAdminService/UpdateFeedSyncConfig"
 	// FeedQueryServiceListFeedsProcedure is the fully-qualified name of the FeedQueryService's
 	// ListFeeds RPC.
 	FeedQueryServiceListFeedsProcedure = "/feeds.v1.FeedQueryService/ListFeeds"
@@ -76,7 +85,10 @@ type FeedSyncAdminServiceClient interface {
 	UpdateFeedSource(context.Context, *connect.Request[v1.UpdateFeedSourceRequest]) (*connect.Response[v1.FeedSource], error)
 	DeleteFeedSource(context.Context, *connect.Request[v1.DeleteFeedSourceRequest]) (*connect.Response[v1.DeleteFeedSourceResponse], error)
 	SyncFeeds(context.Context, *connect.Request[v1.SyncFeedsRequest]) (*connect.Response[v1.SyncFeedsResponse], error)
+	WatchSyncJob(context.Context, *connect.Request[v1.WatchSyncJobRequest]) (*connect.ServerStreamForClient[v1.SyncJobEvent], error)
 	GetFeedSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncStatusResponse], error)
+	GetFeedSyncConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncConfigResponse], error)
+	UpdateFeedSyncConfig(context.Context, *connect.Request[v1.UpdateFeedSyncConfigRequest]) (*connect.Response[v1.GetFeedSyncConfigResponse], error)
 }
 
 // NewFeedSyncAdminServiceClient constructs a client for the feeds.v1.FeedSyncAdminService service.
@@ -126,24 +138,45 @@ func NewFeedSyncAdminServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(feedSyncAdminServiceMethods.ByName("SyncFeeds")),
 			connect.WithClientOptions(opts...),
 		),
+		watchSyncJob: connect.NewClient[v1.WatchSyncJobRequest, v1.SyncJobEvent](
+			httpClient,
+			baseURL+FeedSyncAdminServiceWatchSyncJobProcedure,
+			connect.WithSchema(feedSyncAdminServiceMethods.ByName("WatchSyncJob")),
+			connect.WithClientOptions(opts...),
+		),
 		getFeedSyncStatus: connect.NewClient[emptypb.Empty, v1.GetFeedSyncStatusResponse](
 			httpClient,
 			baseURL+FeedSyncAdminServiceGetFeedSyncStatusProcedure,
 			connect.WithSchema(feedSyncAdminServiceMethods.ByName("GetFeedSyncStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getFeedSyncConfig: connect.NewClient[emptypb.Empty, v1.GetFeedSyncConfigResponse](
+			httpClient,
+			baseURL+FeedSyncAdminServiceGetFeedSyncConfigProcedure,
+			connect.WithSchema(feedSyncAdminServiceMethods.ByName("GetFeedSyncConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateFeedSyncConfig: connect.NewClient[v1.UpdateFeedSyncConfigRequest, v1.GetFeedSyncConfigResponse](
+			httpClient,
+			baseURL+FeedSyncAdminServiceUpdateFeedSyncConfigProcedure,
+			connect.WithSchema(feedSyncAdminServiceMethods.ByName("UpdateFeedSyncConfig")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // feedSyncAdminServiceClient implements FeedSyncAdminServiceClient.
 type feedSyncAdminServiceClient struct {
-	listFeedSources   *connect.Client[v1.ListFeedSourcesRequest, v1.ListFeedSourcesResponse]
-	getFeedSource     *connect.Client[v1.GetFeedSourceRequest, v1.FeedSource]
-	createFeedSource  *connect.Client[v1.CreateFeedSourceRequest, v1.FeedSource]
-	updateFeedSource  *connect.Client[v1.UpdateFeedSourceRequest, v1.FeedSource]
-	deleteFeedSource  *connect.Client[v1.DeleteFeedSourceRequest, v1.DeleteFeedSourceResponse]
-	syncFeeds         *connect.Client[v1.SyncFeedsRequest, v1.SyncFeedsResponse]
-	getFeedSyncStatus *connect.Client[emptypb.Empty, v1.GetFeedSyncStatusResponse]
+	listFeedSources      *connect.Client[v1.ListFeedSourcesRequest, v1.ListFeedSourcesResponse]
+	getFeedSource        *connect.Client[v1.GetFeedSourceRequest, v1.FeedSource]
+	createFeedSource     *connect.Client[v1.CreateFeedSourceRequest, v1.FeedSource]
+	updateFeedSource     *connect.Client[v1.UpdateFeedSourceRequest, v1.FeedSource]
+	deleteFeedSource     *connect.Client[v1.DeleteFeedSourceRequest, v1.DeleteFeedSourceResponse]
+	syncFeeds            *connect.Client[v1.SyncFeedsRequest, v1.SyncFeedsResponse]
+	watchSyncJob         *connect.Client[v1.WatchSyncJobRequest, v1.SyncJobEvent]
+	getFeedSyncStatus    *connect.Client[emptypb.Empty, v1.GetFeedSyncStatusResponse]
+	getFeedSyncConfig    *connect.Client[emptypb.Empty, v1.GetFeedSyncConfigResponse]
+	updateFeedSyncConfig *connect.Client[v1.UpdateFeedSyncConfigRequest, v1.GetFeedSyncConfigResponse]
 }
 
 // ListFeedSources calls feeds.v1.FeedSyncAdminService.ListFeedSources.
@@ -176,11 +209,26 @@ func (c *feedSyncAdminServiceClient) SyncFeeds(ctx context.Context, req *connect
 	return c.syncFeeds.CallUnary(ctx, req)
 }
 
+// WatchSyncJob calls feeds.v1.FeedSyncAdminService.WatchSyncJob.
+func (c *feedSyncAdminServiceClient) WatchSyncJob(ctx context.Context, req *connect.Request[v1.WatchSyncJobRequest]) (*connect.ServerStreamForClient[v1.SyncJobEvent], error) {
+	return c.watchSyncJob.CallServerStream(ctx, req)
+}
+
 // GetFeedSyncStatus calls feeds.v1.FeedSyncAdminService.GetFeedSyncStatus.
 func (c *feedSyncAdminServiceClient) GetFeedSyncStatus(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncStatusResponse], error) {
 	return c.getFeedSyncStatus.CallUnary(ctx, req)
 }
 
+// GetFeedSyncConfig calls feeds.v1.FeedSyncAdminService.GetFeedSyncConfig.
+func (c *feedSyncAdminServiceClient) GetFeedSyncConfig(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncConfigResponse], error) {
+	return c.getFeedSyncConfig.CallUnary(ctx, req)
+}
+
+// UpdateFeedSyncConfig calls feeds.v1.FeedSyncAdminService.UpdateFeedSyncConfig.
+func (c *feedSyncAdminServiceClient) UpdateFeedSyncConfig(ctx context.Context, req *connect.Request[v1.UpdateFeedSyncConfigRequest]) (*connect.Response[v1.GetFeedSyncConfigResponse], error) {
+	return c.updateFeedSyncConfig.CallUnary(ctx, req)
+}
+
 // FeedSyncAdminServiceHandler is an implementation of the feeds.v1.FeedSyncAdminService service.
 type FeedSyncAdminServiceHandler interface {
 	ListFeedSources(context.Context, *connect.Request[v1.ListFeedSourcesRequest]) (*connect.Response[v1.ListFeedSourcesResponse], error)
@@ -189,7 +237,10 @@ type FeedSyncAdminServiceHandler interface {
 	UpdateFeedSource(context.Context, *connect.Request[v1.UpdateFeedSourceRequest]) (*connect.Response[v1.FeedSource], error)
 	DeleteFeedSource(context.Context, *connect.Request[v1.DeleteFeedSourceRequest]) (*connect.Response[v1.DeleteFeedSourceResponse], error)
 	SyncFeeds(context.Context, *connect.Request[v1.SyncFeedsRequest]) (*connect.Response[v1.SyncFeedsResponse], error)
+	WatchSyncJob(context.Context, *connect.Request[v1.WatchSyncJobRequest], *connect.ServerStream[v1.SyncJobEvent]) error
 	GetFeedSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncStatusResponse], error)
+	GetFeedSyncConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncConfigResponse], error)
+	UpdateFeedSyncConfig(context.Context, *connect.Request[v1.UpdateFeedSyncConfigRequest]) (*connect.Response[v1.GetFeedSyncConfigResponse], error)
 }
 
 // NewFeedSyncAdminServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -235,12 +286,30 @@ func NewFeedSyncAdminServiceHandler(svc FeedSyncAdminServiceHandler, opts ...con
 		connect.WithSchema(feedSyncAdminServiceMethods.ByName("SyncFeeds")),
 		connect.WithHandlerOptions(opts...),
 	)
+	feedSyncAdminServiceWatchSyncJobHandler := connect.NewServerStreamHandler(
+		FeedSyncAdminServiceWatchSyncJobProcedure,
+		svc.WatchSyncJob,
+		connect.WithSchema(feedSyncAdminServiceMethods.ByName("WatchSyncJob")),
+		connect.WithHandlerOptions(opts...),
+	)
 	feedSyncAdminServiceGetFeedSyncStatusHandler := connect.NewUnaryHandler(
 		FeedSyncAdminServiceGetFeedSyncStatusProcedure,
 		svc.GetFeedSyncStatus,
 		connect.WithSchema(feedSyncAdminServiceMethods.ByName("GetFeedSyncStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	feedSyncAdminServiceGetFeedSyncConfigHandler := connect.NewUnaryHandler(
+		FeedSyncAdminServiceGetFeedSyncConfigProcedure,
+		svc.GetFeedSyncConfig,
+		connect.WithSchema(feedSyncAdminServiceMethods.ByName("GetFeedSyncConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedSyncAdminServiceUpdateFeedSyncConfigHandler := connect.NewUnaryHandler(
+		FeedSyncAdminServiceUpdateFeedSyncConfigProcedure,
+		svc.UpdateFeedSyncConfig,
+		connect.WithSchema(feedSyncAdminServiceMethods.ByName("UpdateFeedSyncConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/feeds.v1.FeedSyncAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FeedSyncAdminServiceListFeedSourcesProcedure:
@@ -255,8 +324,14 @@ func NewFeedSyncAdminServiceHandler(svc FeedSyncAdminServiceHandler, opts ...con
 			feedSyncAdminServiceDeleteFeedSourceHandler.ServeHTTP(w, r)
 		case FeedSyncAdminServiceSyncFeedsProcedure:
 			feedSyncAdminServiceSyncFeedsHandler.ServeHTTP(w, r)
+		case FeedSyncAdminServiceWatchSyncJobProcedure:
+			feedSyncAdminServiceWatchSyncJobHandler.ServeHTTP(w, r)
 		case FeedSyncAdminServiceGetFeedSyncStatusProcedure:
 			feedSyncAdminServiceGetFeedSyncStatusHandler.ServeHTTP(w, r)
+		case FeedSyncAdminServiceGetFeedSyncConfigProcedure:
+			feedSyncAdminServiceGetFeedSyncConfigHandler.ServeHTTP(w, r)
+		case FeedSyncAdminServiceUpdateFeedSyncConfigProcedure:
+			feedSyncAdminServiceUpdateFeedSyncConfigHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -290,10 +365,22 @@ func (UnimplementedFeedSyncAdminServiceHandler) SyncFeeds(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedSyncAdminService.SyncFeeds is not implemented"))
 }
 
+func (UnimplementedFeedSyncAdminServiceHandler) WatchSyncJob(context.Context, *connect.Request[v1.WatchSyncJobRequest], *connect.ServerStream[v1.SyncJobEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedSyncAdminService.WatchSyncJob is not implemented"))
+}
+
 func (UnimplementedFeedSyncAdminServiceHandler) GetFeedSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedSyncAdminService.GetFeedSyncStatus is not implemented"))
 }
 
+func (UnimplementedFeedSyncAdminServiceHandler) GetFeedSyncConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetFeedSyncConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedSyncAdminService.GetFeedSyncConfig is not implemented"))
+}
+
+func (UnimplementedFeedSyncAdminServiceHandler) UpdateFeedSyncConfig(context.Context, *connect.Request[v1.UpdateFeedSyncConfigRequest]) (*connect.Response[v1.GetFeedSyncConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("feeds.v1.FeedSyncAdminService.UpdateFeedSyncConfig is not implemented"))
+}
+
 // FeedQueryServiceClient is a client for the feeds.v1.FeedQueryService service.
 type FeedQueryServiceClient interface {
 	ListFeeds(context.Context, *connect.Request[v1.ListFeedSourcesRequest]) (*connect.Response[v1.ListFeedSourcesResponse], error)
@@ -323,6 +323,68 @@ func (m *Issue) validate(all bool) error {
 
 	// no validation rules for Repo
 
+	// no validation rules for Tombstone
+
+	if all {
+		switch v := interface{}(m.GetTombstonedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "TombstonedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "TombstonedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTombstonedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueValidationError{
+				field:  "TombstonedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TransferredTo
+
+	if all {
+		switch v := interface{}(m.GetPullRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "PullRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueValidationError{
+					field:  "PullRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPullRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueValidationError{
+				field:  "PullRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return IssueMultiError(errors)
 	}
@@ -400,48 +462,153 @@ var _ interface {
 	ErrorName() string
 } = IssueValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on PullRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PullRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// ValidateAll checks the field values on PullRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PullRequestMultiError, or
+// nil if none found.
+func (m *PullRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *PullRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Merged
 
-	// no validation rules for Login
+	if all {
+		switch v := interface{}(m.GetMergedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PullRequestValidationError{
+					field:  "MergedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PullRequestValidationError{
+					field:  "MergedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMergedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PullRequestValidationError{
+				field:  "MergedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for AvatarUrl
+	// no validation rules for MergedBy
 
-	// no validation rules for HtmlUrl
+	// no validation rules for Draft
+
+	// no validation rules for HeadRef
+
+	// no validation rules for HeadSha
+
+	// no validation rules for BaseRef
+
+	// no validation rules for Additions
+
+	// no validation rules for Deletions
+
+	// no validation rules for ChangedFiles
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PullRequestValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PullRequestValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PullRequestValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReviewDecision
+
+	if all {
+		switch v := interface{}(m.GetSyncedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PullRequestValidationError{
+					field:  "SyncedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PullRequestValidationError{
+					field:  "SyncedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PullRequestValidationError{
+				field:  "SyncedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return PullRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// PullRequestMultiError is an error wrapping multiple validation errors
+// returned by PullRequest.ValidateAll() if the designated constraints aren't met.
+type PullRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m PullRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -450,11 +617,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m PullRequestMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// PullRequestValidationError is the validation error returned by
+// PullRequest.Validate if the designated constraints aren't met.
+type PullRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -462,22 +629,22 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e PullRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e PullRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e PullRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e PullRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e PullRequestValidationError) ErrorName() string { return "PullRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e PullRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -489,14 +656,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sPullRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = PullRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -504,50 +671,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = PullRequestValidationError{}
 
-// Validate checks the field values on Label with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Label) Validate() error {
+// Validate checks the field values on PullRequestReviewVerdict with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PullRequestReviewVerdict) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Label with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in LabelMultiError, or nil if none found.
-func (m *Label) ValidateAll() error {
+// ValidateAll checks the field values on PullRequestReviewVerdict with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullRequestReviewVerdictMultiError, or nil if none found.
+func (m *PullRequestReviewVerdict) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Label) validate(all bool) error {
+func (m *PullRequestReviewVerdict) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
+	// no validation rules for Reviewer
 
-	// no validation rules for Color
+	// no validation rules for State
 
-	// no validation rules for Description
+	if all {
+		switch v := interface{}(m.GetSubmittedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PullRequestReviewVerdictValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PullRequestReviewVerdictValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmittedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PullRequestReviewVerdictValidationError{
+				field:  "SubmittedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return LabelMultiError(errors)
+		return PullRequestReviewVerdictMultiError(errors)
 	}
 
 	return nil
 }
 
-// LabelMultiError is an error wrapping multiple validation errors returned by
-// Label.ValidateAll() if the designated constraints aren't met.
-type LabelMultiError []error
+// PullRequestReviewVerdictMultiError is an error wrapping multiple validation
+// errors returned by PullRequestReviewVerdict.ValidateAll() if the designated
+// constraints aren't met.
+type PullRequestReviewVerdictMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LabelMultiError) Error() string {
+func (m PullRequestReviewVerdictMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -556,11 +750,11 @@ func (m LabelMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LabelMultiError) AllErrors() []error { return m }
+func (m PullRequestReviewVerdictMultiError) AllErrors() []error { return m }
 
-// LabelValidationError is the validation error returned by Label.Validate if
-// the designated constraints aren't met.
-type LabelValidationError struct {
+// PullRequestReviewVerdictValidationError is the validation error returned by
+// PullRequestReviewVerdict.Validate if the designated constraints aren't met.
+type PullRequestReviewVerdictValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -568,22 +762,24 @@ type LabelValidationError struct {
 }
 
 // Field function returns field value.
-func (e LabelValidationError) Field() string { return e.field }
+func (e PullRequestReviewVerdictValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LabelValidationError) Reason() string { return e.reason }
+func (e PullRequestReviewVerdictValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LabelValidationError) Cause() error { return e.cause }
+func (e PullRequestReviewVerdictValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LabelValidationError) Key() bool { return e.key }
+func (e PullRequestReviewVerdictValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LabelValidationError) ErrorName() string { return "LabelValidationError" }
+func (e PullRequestReviewVerdictValidationError) ErrorName() string {
+	return "PullRequestReviewVerdictValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LabelValidationError) Error() string {
+func (e PullRequestReviewVerdictValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -595,14 +791,14 @@ func (e LabelValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLabel.%s: %s%s",
+		"invalid %sPullRequestReviewVerdict.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LabelValidationError{}
+var _ error = PullRequestReviewVerdictValidationError{}
 
 var _ interface {
 	Field() string
@@ -610,24 +806,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LabelValidationError{}
+} = PullRequestReviewVerdictValidationError{}
 
-// Validate checks the field values on Milestone with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Milestone) Validate() error {
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Milestone with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MilestoneMultiError, or nil
-// if none found.
-func (m *Milestone) ValidateAll() error {
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Milestone) validate(all bool) error {
+func (m *User) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -636,18 +831,237 @@ func (m *Milestone) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Number
+	// no validation rules for Login
 
-	// no validation rules for Title
+	// no validation rules for AvatarUrl
 
-	// no validation rules for Description
+	// no validation rules for HtmlUrl
 
-	// no validation rules for State
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
 
-	if all {
-		switch v := interface{}(m.GetDueOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on Label with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Label) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Label with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LabelMultiError, or nil if none found.
+func (m *Label) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Label) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Color
+
+	// no validation rules for Description
+
+	// no validation rules for Repo
+
+	// no validation rules for OpenIssues
+
+	// no validation rules for ClosedIssues
+
+	if len(errors) > 0 {
+		return LabelMultiError(errors)
+	}
+
+	return nil
+}
+
+// LabelMultiError is an error wrapping multiple validation errors returned by
+// Label.ValidateAll() if the designated constraints aren't met.
+type LabelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LabelMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LabelMultiError) AllErrors() []error { return m }
+
+// LabelValidationError is the validation error returned by Label.Validate if
+// the designated constraints aren't met.
+type LabelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LabelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LabelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LabelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LabelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LabelValidationError) ErrorName() string { return "LabelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LabelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLabel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LabelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LabelValidationError{}
+
+// Validate checks the field values on Milestone with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Milestone) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Milestone with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MilestoneMultiError, or nil
+// if none found.
+func (m *Milestone) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Milestone) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Number
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetDueOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
 				errors = append(errors, MilestoneValidationError{
 					field:  "DueOn",
 					reason: "embedded message failed validation",
@@ -673,6 +1087,41 @@ func (m *Milestone) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetClosedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MilestoneValidationError{
+					field:  "ClosedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MilestoneValidationError{
+					field:  "ClosedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MilestoneValidationError{
+				field:  "ClosedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Repo
+
+	// no validation rules for OpenIssues
+
+	// no validation rules for ClosedIssues
+
 	if len(errors) > 0 {
 		return MilestoneMultiError(errors)
 	}
@@ -1205,68 +1654,9 @@ func (m *SyncIssuesResponse) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetFinishedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SyncIssuesResponseValidationError{
-					field:  "FinishedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SyncIssuesResponseValidationError{
-					field:  "FinishedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SyncIssuesResponseValidationError{
-				field:  "FinishedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SyncIssuesResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SyncIssuesResponseValidationError{
-						field:  fmt.Sprintf("Results[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SyncIssuesResponseValidationError{
-					field:  fmt.Sprintf("Results[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for JobId
 
-	}
+	// no validation rules for Attached
 
 	if len(errors) > 0 {
 		return SyncIssuesResponseMultiError(errors)
@@ -1348,56 +1738,44 @@ var _ interface {
 	ErrorName() string
 } = SyncIssuesResponseValidationError{}
 
-// Validate checks the field values on GetSyncConfigResponse with the rules
+// Validate checks the field values on WatchSyncJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSyncConfigResponse) Validate() error {
+func (m *WatchSyncJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSyncConfigResponse with the rules
+// ValidateAll checks the field values on WatchSyncJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSyncConfigResponseMultiError, or nil if none found.
-func (m *GetSyncConfigResponse) ValidateAll() error {
+// WatchSyncJobRequestMultiError, or nil if none found.
+func (m *WatchSyncJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSyncConfigResponse) validate(all bool) error {
+func (m *WatchSyncJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Enabled
-
-	// no validation rules for IntervalSeconds
-
-	// no validation rules for PageSize
-
-	// no validation rules for MaxPagesPerRun
-
-	// no validation rules for RequestTimeoutSeconds
-
-	// no validation rules for StorageDriver
-
-	// no validation rules for GithubTokenConfigured
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetSyncConfigResponseMultiError(errors)
+		return WatchSyncJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSyncConfigResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSyncConfigResponse.ValidateAll() if the designated
+// WatchSyncJobRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchSyncJobRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetSyncConfigResponseMultiError []error
+type WatchSyncJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSyncConfigResponseMultiError) Error() string {
+func (m WatchSyncJobRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1406,11 +1784,11 @@ func (m GetSyncConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSyncConfigResponseMultiError) AllErrors() []error { return m }
+func (m WatchSyncJobRequestMultiError) AllErrors() []error { return m }
 
-// GetSyncConfigResponseValidationError is the validation error returned by
-// GetSyncConfigResponse.Validate if the designated constraints aren't met.
-type GetSyncConfigResponseValidationError struct {
+// WatchSyncJobRequestValidationError is the validation error returned by
+// WatchSyncJobRequest.Validate if the designated constraints aren't met.
+type WatchSyncJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1418,24 +1796,24 @@ type GetSyncConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSyncConfigResponseValidationError) Field() string { return e.field }
+func (e WatchSyncJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSyncConfigResponseValidationError) Reason() string { return e.reason }
+func (e WatchSyncJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSyncConfigResponseValidationError) Cause() error { return e.cause }
+func (e WatchSyncJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSyncConfigResponseValidationError) Key() bool { return e.key }
+func (e WatchSyncJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSyncConfigResponseValidationError) ErrorName() string {
-	return "GetSyncConfigResponseValidationError"
+func (e WatchSyncJobRequestValidationError) ErrorName() string {
+	return "WatchSyncJobRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSyncConfigResponseValidationError) Error() string {
+func (e WatchSyncJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1447,14 +1825,14 @@ func (e GetSyncConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSyncConfigResponse.%s: %s%s",
+		"invalid %sWatchSyncJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSyncConfigResponseValidationError{}
+var _ error = WatchSyncJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1462,54 +1840,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSyncConfigResponseValidationError{}
+} = WatchSyncJobRequestValidationError{}
 
-// Validate checks the field values on UpdateSyncConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateSyncConfigRequest) Validate() error {
+// Validate checks the field values on SyncJobEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncJobEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateSyncConfigRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateSyncConfigRequestMultiError, or nil if none found.
-func (m *UpdateSyncConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on SyncJobEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncJobEventMultiError, or
+// nil if none found.
+func (m *SyncJobEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateSyncConfigRequest) validate(all bool) error {
+func (m *SyncJobEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Enabled
+	// no validation rules for JobId
 
-	// no validation rules for IntervalSeconds
+	// no validation rules for Type
 
-	// no validation rules for PageSize
+	// no validation rules for Target
 
-	// no validation rules for MaxPagesPerRun
+	// no validation rules for Page
 
-	// no validation rules for RequestTimeoutSeconds
+	// no validation rules for Fetched
+
+	// no validation rules for Persisted
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncJobEventValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncJobEventValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncJobEventValidationError{
+				field:  "At",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UpdateSyncConfigRequestMultiError(errors)
+		return SyncJobEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateSyncConfigRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateSyncConfigRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateSyncConfigRequestMultiError []error
+// SyncJobEventMultiError is an error wrapping multiple validation errors
+// returned by SyncJobEvent.ValidateAll() if the designated constraints aren't met.
+type SyncJobEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateSyncConfigRequestMultiError) Error() string {
+func (m SyncJobEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1518,11 +1928,11 @@ func (m UpdateSyncConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateSyncConfigRequestMultiError) AllErrors() []error { return m }
+func (m SyncJobEventMultiError) AllErrors() []error { return m }
 
-// UpdateSyncConfigRequestValidationError is the validation error returned by
-// UpdateSyncConfigRequest.Validate if the designated constraints aren't met.
-type UpdateSyncConfigRequestValidationError struct {
+// SyncJobEventValidationError is the validation error returned by
+// SyncJobEvent.Validate if the designated constraints aren't met.
+type SyncJobEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1530,24 +1940,22 @@ type UpdateSyncConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateSyncConfigRequestValidationError) Field() string { return e.field }
+func (e SyncJobEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateSyncConfigRequestValidationError) Reason() string { return e.reason }
+func (e SyncJobEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateSyncConfigRequestValidationError) Cause() error { return e.cause }
+func (e SyncJobEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateSyncConfigRequestValidationError) Key() bool { return e.key }
+func (e SyncJobEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateSyncConfigRequestValidationError) ErrorName() string {
-	return "UpdateSyncConfigRequestValidationError"
-}
+func (e SyncJobEventValidationError) ErrorName() string { return "SyncJobEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateSyncConfigRequestValidationError) Error() string {
+func (e SyncJobEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1559,14 +1967,14 @@ func (e UpdateSyncConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateSyncConfigRequest.%s: %s%s",
+		"invalid %sSyncJobEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateSyncConfigRequestValidationError{}
+var _ error = SyncJobEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1574,24 +1982,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateSyncConfigRequestValidationError{}
+} = SyncJobEventValidationError{}
 
-// Validate checks the field values on ManagedSyncRepo with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ManagedSyncRepo) Validate() error {
+// Validate checks the field values on ReconcileIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileIssuesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ManagedSyncRepo with the rules
+// ValidateAll checks the field values on ReconcileIssuesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ManagedSyncRepoMultiError, or nil if none found.
-func (m *ManagedSyncRepo) ValidateAll() error {
+// ReconcileIssuesRequestMultiError, or nil if none found.
+func (m *ReconcileIssuesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ManagedSyncRepo) validate(all bool) error {
+func (m *ReconcileIssuesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1600,80 +2008,20 @@ func (m *ManagedSyncRepo) validate(all bool) error {
 
 	// no validation rules for Repo
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ManagedSyncRepoValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ManagedSyncRepoValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ManagedSyncRepoValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ManagedSyncRepoValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ManagedSyncRepoValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ManagedSyncRepoValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for IssueCount
-
 	if len(errors) > 0 {
-		return ManagedSyncRepoMultiError(errors)
+		return ReconcileIssuesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ManagedSyncRepoMultiError is an error wrapping multiple validation errors
-// returned by ManagedSyncRepo.ValidateAll() if the designated constraints
-// aren't met.
-type ManagedSyncRepoMultiError []error
+// ReconcileIssuesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReconcileIssuesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReconcileIssuesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ManagedSyncRepoMultiError) Error() string {
+func (m ReconcileIssuesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1682,11 +2030,11 @@ func (m ManagedSyncRepoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ManagedSyncRepoMultiError) AllErrors() []error { return m }
+func (m ReconcileIssuesRequestMultiError) AllErrors() []error { return m }
 
-// ManagedSyncRepoValidationError is the validation error returned by
-// ManagedSyncRepo.Validate if the designated constraints aren't met.
-type ManagedSyncRepoValidationError struct {
+// ReconcileIssuesRequestValidationError is the validation error returned by
+// ReconcileIssuesRequest.Validate if the designated constraints aren't met.
+type ReconcileIssuesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1694,22 +2042,24 @@ type ManagedSyncRepoValidationError struct {
 }
 
 // Field function returns field value.
-func (e ManagedSyncRepoValidationError) Field() string { return e.field }
+func (e ReconcileIssuesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ManagedSyncRepoValidationError) Reason() string { return e.reason }
+func (e ReconcileIssuesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ManagedSyncRepoValidationError) Cause() error { return e.cause }
+func (e ReconcileIssuesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ManagedSyncRepoValidationError) Key() bool { return e.key }
+func (e ReconcileIssuesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ManagedSyncRepoValidationError) ErrorName() string { return "ManagedSyncRepoValidationError" }
+func (e ReconcileIssuesRequestValidationError) ErrorName() string {
+	return "ReconcileIssuesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ManagedSyncRepoValidationError) Error() string {
+func (e ReconcileIssuesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1721,14 +2071,14 @@ func (e ManagedSyncRepoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sManagedSyncRepo.%s: %s%s",
+		"invalid %sReconcileIssuesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ManagedSyncRepoValidationError{}
+var _ error = ReconcileIssuesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1736,181 +2086,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ManagedSyncRepoValidationError{}
+} = ReconcileIssuesRequestValidationError{}
 
-// Validate checks the field values on ListManagedSyncReposResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReconcileRepoResult with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListManagedSyncReposResponse) Validate() error {
+func (m *ReconcileRepoResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListManagedSyncReposResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReconcileRepoResult with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListManagedSyncReposResponseMultiError, or nil if none found.
-func (m *ListManagedSyncReposResponse) ValidateAll() error {
+// ReconcileRepoResultMultiError, or nil if none found.
+func (m *ReconcileRepoResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListManagedSyncReposResponse) validate(all bool) error {
+func (m *ReconcileRepoResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRepos() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListManagedSyncReposResponseValidationError{
-						field:  fmt.Sprintf("Repos[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListManagedSyncReposResponseValidationError{
-						field:  fmt.Sprintf("Repos[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListManagedSyncReposResponseValidationError{
-					field:  fmt.Sprintf("Repos[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return ListManagedSyncReposResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// ListManagedSyncReposResponseMultiError is an error wrapping multiple
-// validation errors returned by ListManagedSyncReposResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListManagedSyncReposResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListManagedSyncReposResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ListManagedSyncReposResponseMultiError) AllErrors() []error { return m }
-
-// ListManagedSyncReposResponseValidationError is the validation error returned
-// by ListManagedSyncReposResponse.Validate if the designated constraints
-// aren't met.
-type ListManagedSyncReposResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ListManagedSyncReposResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ListManagedSyncReposResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ListManagedSyncReposResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ListManagedSyncReposResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ListManagedSyncReposResponseValidationError) ErrorName() string {
-	return "ListManagedSyncReposResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ListManagedSyncReposResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sListManagedSyncReposResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ListManagedSyncReposResponseValidationError{}
+	// no validation rules for Repo
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListManagedSyncReposResponseValidationError{}
+	// no validation rules for Checked
 
-// Validate checks the field values on ReplaceManagedSyncReposRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReplaceManagedSyncReposRequest) Validate() error {
-	return m.validate(false)
-}
+	// no validation rules for Tombstoned
 
-// ValidateAll checks the field values on ReplaceManagedSyncReposRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ReplaceManagedSyncReposRequestMultiError, or nil if none found.
-func (m *ReplaceManagedSyncReposRequest) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for Transferred
 
-func (m *ReplaceManagedSyncReposRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	// no validation rules for Restored
 
-	var errors []error
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return ReplaceManagedSyncReposRequestMultiError(errors)
+		return ReconcileRepoResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplaceManagedSyncReposRequestMultiError is an error wrapping multiple
-// validation errors returned by ReplaceManagedSyncReposRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ReplaceManagedSyncReposRequestMultiError []error
+// ReconcileRepoResultMultiError is an error wrapping multiple validation
+// errors returned by ReconcileRepoResult.ValidateAll() if the designated
+// constraints aren't met.
+type ReconcileRepoResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplaceManagedSyncReposRequestMultiError) Error() string {
+func (m ReconcileRepoResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1919,12 +2144,11 @@ func (m ReplaceManagedSyncReposRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplaceManagedSyncReposRequestMultiError) AllErrors() []error { return m }
+func (m ReconcileRepoResultMultiError) AllErrors() []error { return m }
 
-// ReplaceManagedSyncReposRequestValidationError is the validation error
-// returned by ReplaceManagedSyncReposRequest.Validate if the designated
-// constraints aren't met.
-type ReplaceManagedSyncReposRequestValidationError struct {
+// ReconcileRepoResultValidationError is the validation error returned by
+// ReconcileRepoResult.Validate if the designated constraints aren't met.
+type ReconcileRepoResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1932,24 +2156,24 @@ type ReplaceManagedSyncReposRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplaceManagedSyncReposRequestValidationError) Field() string { return e.field }
+func (e ReconcileRepoResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplaceManagedSyncReposRequestValidationError) Reason() string { return e.reason }
+func (e ReconcileRepoResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplaceManagedSyncReposRequestValidationError) Cause() error { return e.cause }
+func (e ReconcileRepoResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplaceManagedSyncReposRequestValidationError) Key() bool { return e.key }
+func (e ReconcileRepoResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplaceManagedSyncReposRequestValidationError) ErrorName() string {
-	return "ReplaceManagedSyncReposRequestValidationError"
+func (e ReconcileRepoResultValidationError) ErrorName() string {
+	return "ReconcileRepoResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReplaceManagedSyncReposRequestValidationError) Error() string {
+func (e ReconcileRepoResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1961,14 +2185,14 @@ func (e ReplaceManagedSyncReposRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplaceManagedSyncReposRequest.%s: %s%s",
+		"invalid %sReconcileRepoResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplaceManagedSyncReposRequestValidationError{}
+var _ error = ReconcileRepoResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1976,55 +2200,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplaceManagedSyncReposRequestValidationError{}
+} = ReconcileRepoResultValidationError{}
 
-// Validate checks the field values on SyncCheckpoint with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SyncCheckpoint) Validate() error {
+// Validate checks the field values on ReconcileIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileIssuesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SyncCheckpoint with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SyncCheckpointMultiError,
-// or nil if none found.
-func (m *SyncCheckpoint) ValidateAll() error {
+// ValidateAll checks the field values on ReconcileIssuesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReconcileIssuesResponseMultiError, or nil if none found.
+func (m *ReconcileIssuesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SyncCheckpoint) validate(all bool) error {
+func (m *ReconcileIssuesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Repo
-
 	if all {
-		switch v := interface{}(m.GetLastSyncedAt()).(type) {
+		switch v := interface{}(m.GetStartedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SyncCheckpointValidationError{
-					field:  "LastSyncedAt",
+				errors = append(errors, ReconcileIssuesResponseValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SyncCheckpointValidationError{
-					field:  "LastSyncedAt",
+				errors = append(errors, ReconcileIssuesResponseValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLastSyncedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SyncCheckpointValidationError{
-				field:  "LastSyncedAt",
+			return ReconcileIssuesResponseValidationError{
+				field:  "StartedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2032,52 +2254,82 @@ func (m *SyncCheckpoint) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetLastIssueUpdatedAt()).(type) {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SyncCheckpointValidationError{
-					field:  "LastIssueUpdatedAt",
+				errors = append(errors, ReconcileIssuesResponseValidationError{
+					field:  "FinishedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SyncCheckpointValidationError{
-					field:  "LastIssueUpdatedAt",
+				errors = append(errors, ReconcileIssuesResponseValidationError{
+					field:  "FinishedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLastIssueUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SyncCheckpointValidationError{
-				field:  "LastIssueUpdatedAt",
+			return ReconcileIssuesResponseValidationError{
+				field:  "FinishedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for LastRunStatus
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-	// no validation rules for LastError
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReconcileIssuesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReconcileIssuesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReconcileIssuesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return SyncCheckpointMultiError(errors)
+		return ReconcileIssuesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SyncCheckpointMultiError is an error wrapping multiple validation errors
-// returned by SyncCheckpoint.ValidateAll() if the designated constraints
-// aren't met.
-type SyncCheckpointMultiError []error
+// ReconcileIssuesResponseMultiError is an error wrapping multiple validation
+// errors returned by ReconcileIssuesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReconcileIssuesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SyncCheckpointMultiError) Error() string {
+func (m ReconcileIssuesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2086,11 +2338,11 @@ func (m SyncCheckpointMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SyncCheckpointMultiError) AllErrors() []error { return m }
+func (m ReconcileIssuesResponseMultiError) AllErrors() []error { return m }
 
-// SyncCheckpointValidationError is the validation error returned by
-// SyncCheckpoint.Validate if the designated constraints aren't met.
-type SyncCheckpointValidationError struct {
+// ReconcileIssuesResponseValidationError is the validation error returned by
+// ReconcileIssuesResponse.Validate if the designated constraints aren't met.
+type ReconcileIssuesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2098,22 +2350,24 @@ type SyncCheckpointValidationError struct {
 }
 
 // Field function returns field value.
-func (e SyncCheckpointValidationError) Field() string { return e.field }
+func (e ReconcileIssuesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SyncCheckpointValidationError) Reason() string { return e.reason }
+func (e ReconcileIssuesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SyncCheckpointValidationError) Cause() error { return e.cause }
+func (e ReconcileIssuesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SyncCheckpointValidationError) Key() bool { return e.key }
+func (e ReconcileIssuesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SyncCheckpointValidationError) ErrorName() string { return "SyncCheckpointValidationError" }
+func (e ReconcileIssuesResponseValidationError) ErrorName() string {
+	return "ReconcileIssuesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SyncCheckpointValidationError) Error() string {
+func (e ReconcileIssuesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2125,14 +2379,14 @@ func (e SyncCheckpointValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSyncCheckpoint.%s: %s%s",
+		"invalid %sReconcileIssuesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SyncCheckpointValidationError{}
+var _ error = ReconcileIssuesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2140,172 +2394,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SyncCheckpointValidationError{}
+} = ReconcileIssuesResponseValidationError{}
 
-// Validate checks the field values on GetSyncStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MigrateIssueCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSyncStatusResponse) Validate() error {
+func (m *MigrateIssueCommentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSyncStatusResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MigrateIssueCommentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSyncStatusResponseMultiError, or nil if none found.
-func (m *GetSyncStatusResponse) ValidateAll() error {
+// MigrateIssueCommentsRequestMultiError, or nil if none found.
+func (m *MigrateIssueCommentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSyncStatusResponse) validate(all bool) error {
+func (m *MigrateIssueCommentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetLastStartedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSyncStatusResponseValidationError{
-					field:  "LastStartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSyncStatusResponseValidationError{
-					field:  "LastStartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLastStartedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSyncStatusResponseValidationError{
-				field:  "LastStartedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetLastFinishedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSyncStatusResponseValidationError{
-					field:  "LastFinishedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSyncStatusResponseValidationError{
-					field:  "LastFinishedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLastFinishedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSyncStatusResponseValidationError{
-				field:  "LastFinishedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Running
-
-	for idx, item := range m.GetLastResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSyncStatusResponseValidationError{
-						field:  fmt.Sprintf("LastResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSyncStatusResponseValidationError{
-						field:  fmt.Sprintf("LastResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetSyncStatusResponseValidationError{
-					field:  fmt.Sprintf("LastResults[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Source
 
-	for idx, item := range m.GetCheckpoints() {
-		_, _ = idx, item
+	// no validation rules for Target
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSyncStatusResponseValidationError{
-						field:  fmt.Sprintf("Checkpoints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSyncStatusResponseValidationError{
-						field:  fmt.Sprintf("Checkpoints[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetSyncStatusResponseValidationError{
-					field:  fmt.Sprintf("Checkpoints[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Repo
 
-	}
+	// no validation rules for Overwrite
 
 	if len(errors) > 0 {
-		return GetSyncStatusResponseMultiError(errors)
+		return MigrateIssueCommentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSyncStatusResponseMultiError is an error wrapping multiple validation
-// errors returned by GetSyncStatusResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetSyncStatusResponseMultiError []error
+// MigrateIssueCommentsRequestMultiError is an error wrapping multiple
+// validation errors returned by MigrateIssueCommentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MigrateIssueCommentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSyncStatusResponseMultiError) Error() string {
+func (m MigrateIssueCommentsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2314,11 +2448,12 @@ func (m GetSyncStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSyncStatusResponseMultiError) AllErrors() []error { return m }
+func (m MigrateIssueCommentsRequestMultiError) AllErrors() []error { return m }
 
-// GetSyncStatusResponseValidationError is the validation error returned by
-// GetSyncStatusResponse.Validate if the designated constraints aren't met.
-type GetSyncStatusResponseValidationError struct {
+// MigrateIssueCommentsRequestValidationError is the validation error returned
+// by MigrateIssueCommentsRequest.Validate if the designated constraints
+// aren't met.
+type MigrateIssueCommentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2326,24 +2461,24 @@ type GetSyncStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSyncStatusResponseValidationError) Field() string { return e.field }
+func (e MigrateIssueCommentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSyncStatusResponseValidationError) Reason() string { return e.reason }
+func (e MigrateIssueCommentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSyncStatusResponseValidationError) Cause() error { return e.cause }
+func (e MigrateIssueCommentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSyncStatusResponseValidationError) Key() bool { return e.key }
+func (e MigrateIssueCommentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSyncStatusResponseValidationError) ErrorName() string {
-	return "GetSyncStatusResponseValidationError"
+func (e MigrateIssueCommentsRequestValidationError) ErrorName() string {
+	return "MigrateIssueCommentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSyncStatusResponseValidationError) Error() string {
+func (e MigrateIssueCommentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2355,14 +2490,14 @@ func (e GetSyncStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSyncStatusResponse.%s: %s%s",
+		"invalid %sMigrateIssueCommentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSyncStatusResponseValidationError{}
+var _ error = MigrateIssueCommentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2370,24 +2505,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSyncStatusResponseValidationError{}
+} = MigrateIssueCommentsRequestValidationError{}
 
-// Validate checks the field values on ListIssuesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListIssuesRequest) Validate() error {
+// Validate checks the field values on IssueCommentMigrationResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueCommentMigrationResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListIssuesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueCommentMigrationResult with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListIssuesRequestMultiError, or nil if none found.
-func (m *ListIssuesRequest) ValidateAll() error {
+// IssueCommentMigrationResultMultiError, or nil if none found.
+func (m *IssueCommentMigrationResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListIssuesRequest) validate(all bool) error {
+func (m *IssueCommentMigrationResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2396,26 +2531,32 @@ func (m *ListIssuesRequest) validate(all bool) error {
 
 	// no validation rules for Repo
 
-	// no validation rules for State
+	// no validation rules for Scanned
 
-	// no validation rules for Page
+	// no validation rules for Copied
 
-	// no validation rules for PageSize
+	// no validation rules for Missing
+
+	// no validation rules for Skipped
+
+	// no validation rules for Failed
+
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return ListIssuesRequestMultiError(errors)
+		return IssueCommentMigrationResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListIssuesRequestMultiError is an error wrapping multiple validation errors
-// returned by ListIssuesRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListIssuesRequestMultiError []error
+// IssueCommentMigrationResultMultiError is an error wrapping multiple
+// validation errors returned by IssueCommentMigrationResult.ValidateAll() if
+// the designated constraints aren't met.
+type IssueCommentMigrationResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListIssuesRequestMultiError) Error() string {
+func (m IssueCommentMigrationResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2424,11 +2565,12 @@ func (m ListIssuesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListIssuesRequestMultiError) AllErrors() []error { return m }
+func (m IssueCommentMigrationResultMultiError) AllErrors() []error { return m }
 
-// ListIssuesRequestValidationError is the validation error returned by
-// ListIssuesRequest.Validate if the designated constraints aren't met.
-type ListIssuesRequestValidationError struct {
+// IssueCommentMigrationResultValidationError is the validation error returned
+// by IssueCommentMigrationResult.Validate if the designated constraints
+// aren't met.
+type IssueCommentMigrationResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2436,24 +2578,24 @@ type ListIssuesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListIssuesRequestValidationError) Field() string { return e.field }
+func (e IssueCommentMigrationResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListIssuesRequestValidationError) Reason() string { return e.reason }
+func (e IssueCommentMigrationResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListIssuesRequestValidationError) Cause() error { return e.cause }
+func (e IssueCommentMigrationResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListIssuesRequestValidationError) Key() bool { return e.key }
+func (e IssueCommentMigrationResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListIssuesRequestValidationError) ErrorName() string {
-	return "ListIssuesRequestValidationError"
+func (e IssueCommentMigrationResultValidationError) ErrorName() string {
+	return "IssueCommentMigrationResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListIssuesRequestValidationError) Error() string {
+func (e IssueCommentMigrationResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2465,14 +2607,14 @@ func (e ListIssuesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListIssuesRequest.%s: %s%s",
+		"invalid %sIssueCommentMigrationResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListIssuesRequestValidationError{}
+var _ error = IssueCommentMigrationResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -2480,84 +2622,8062 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListIssuesRequestValidationError{}
+} = IssueCommentMigrationResultValidationError{}
 
-// Validate checks the field values on ListIssuesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MigrateIssueCommentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListIssuesResponse) Validate() error {
+func (m *MigrateIssueCommentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListIssuesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MigrateIssueCommentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListIssuesResponseMultiError, or nil if none found.
-func (m *ListIssuesResponse) ValidateAll() error {
+// MigrateIssueCommentsResponseMultiError, or nil if none found.
+func (m *MigrateIssueCommentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListIssuesResponse) validate(all bool) error {
+func (m *MigrateIssueCommentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetIssues() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListIssuesResponseValidationError{
-						field:  fmt.Sprintf("Issues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListIssuesResponseValidationError{
-						field:  fmt.Sprintf("Issues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrateIssueCommentsResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListIssuesResponseValidationError{
-					field:  fmt.Sprintf("Issues[%v]", idx),
+				errors = append(errors, MigrateIssueCommentsResponseValidationError{
+					field:  "StartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrateIssueCommentsResponseValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Page
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrateIssueCommentsResponseValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrateIssueCommentsResponseValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrateIssueCommentsResponseValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MigrateIssueCommentsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MigrateIssueCommentsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MigrateIssueCommentsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MigrateIssueCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateIssueCommentsResponseMultiError is an error wrapping multiple
+// validation errors returned by MigrateIssueCommentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MigrateIssueCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateIssueCommentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateIssueCommentsResponseMultiError) AllErrors() []error { return m }
+
+// MigrateIssueCommentsResponseValidationError is the validation error returned
+// by MigrateIssueCommentsResponse.Validate if the designated constraints
+// aren't met.
+type MigrateIssueCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateIssueCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateIssueCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateIssueCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateIssueCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateIssueCommentsResponseValidationError) ErrorName() string {
+	return "MigrateIssueCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateIssueCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateIssueCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateIssueCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateIssueCommentsResponseValidationError{}
+
+// Validate checks the field values on ListIssueCommentVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueCommentVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueCommentVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListIssueCommentVersionsRequestMultiError, or nil if none found.
+func (m *ListIssueCommentVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueCommentVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	switch v := m.Selector.(type) {
+	case *ListIssueCommentVersionsRequest_IssueId:
+		if v == nil {
+			err := ListIssueCommentVersionsRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for IssueId
+	case *ListIssueCommentVersionsRequest_Number:
+		if v == nil {
+			err := ListIssueCommentVersionsRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Number
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ListIssueCommentVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueCommentVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListIssueCommentVersionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListIssueCommentVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueCommentVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueCommentVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListIssueCommentVersionsRequestValidationError is the validation error
+// returned by ListIssueCommentVersionsRequest.Validate if the designated
+// constraints aren't met.
+type ListIssueCommentVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueCommentVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueCommentVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueCommentVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueCommentVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueCommentVersionsRequestValidationError) ErrorName() string {
+	return "ListIssueCommentVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueCommentVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueCommentVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueCommentVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueCommentVersionsRequestValidationError{}
+
+// Validate checks the field values on IssueCommentVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueCommentVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueCommentVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueCommentVersionMultiError, or nil if none found.
+func (m *IssueCommentVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueCommentVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VersionId
+
+	if all {
+		switch v := interface{}(m.GetLastModified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueCommentVersionValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueCommentVersionValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueCommentVersionValidationError{
+				field:  "LastModified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Size
+
+	// no validation rules for IsLatest
+
+	if len(errors) > 0 {
+		return IssueCommentVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueCommentVersionMultiError is an error wrapping multiple validation
+// errors returned by IssueCommentVersion.ValidateAll() if the designated
+// constraints aren't met.
+type IssueCommentVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueCommentVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueCommentVersionMultiError) AllErrors() []error { return m }
+
+// IssueCommentVersionValidationError is the validation error returned by
+// IssueCommentVersion.Validate if the designated constraints aren't met.
+type IssueCommentVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueCommentVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueCommentVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueCommentVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueCommentVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueCommentVersionValidationError) ErrorName() string {
+	return "IssueCommentVersionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueCommentVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueCommentVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueCommentVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueCommentVersionValidationError{}
+
+// Validate checks the field values on ListIssueCommentVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListIssueCommentVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueCommentVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListIssueCommentVersionsResponseMultiError, or nil if none found.
+func (m *ListIssueCommentVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueCommentVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIssueCommentVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIssueCommentVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIssueCommentVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIssueCommentVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueCommentVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListIssueCommentVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListIssueCommentVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueCommentVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueCommentVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListIssueCommentVersionsResponseValidationError is the validation error
+// returned by ListIssueCommentVersionsResponse.Validate if the designated
+// constraints aren't met.
+type ListIssueCommentVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueCommentVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueCommentVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueCommentVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueCommentVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueCommentVersionsResponseValidationError) ErrorName() string {
+	return "ListIssueCommentVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueCommentVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueCommentVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueCommentVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueCommentVersionsResponseValidationError{}
+
+// Validate checks the field values on RestoreIssueCommentVersionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RestoreIssueCommentVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreIssueCommentVersionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RestoreIssueCommentVersionRequestMultiError, or nil if none found.
+func (m *RestoreIssueCommentVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreIssueCommentVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for VersionId
+
+	switch v := m.Selector.(type) {
+	case *RestoreIssueCommentVersionRequest_IssueId:
+		if v == nil {
+			err := RestoreIssueCommentVersionRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for IssueId
+	case *RestoreIssueCommentVersionRequest_Number:
+		if v == nil {
+			err := RestoreIssueCommentVersionRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Number
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return RestoreIssueCommentVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreIssueCommentVersionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RestoreIssueCommentVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreIssueCommentVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreIssueCommentVersionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreIssueCommentVersionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreIssueCommentVersionRequestValidationError is the validation error
+// returned by RestoreIssueCommentVersionRequest.Validate if the designated
+// constraints aren't met.
+type RestoreIssueCommentVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreIssueCommentVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreIssueCommentVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreIssueCommentVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreIssueCommentVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreIssueCommentVersionRequestValidationError) ErrorName() string {
+	return "RestoreIssueCommentVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreIssueCommentVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreIssueCommentVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreIssueCommentVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreIssueCommentVersionRequestValidationError{}
+
+// Validate checks the field values on RestoreIssueCommentVersionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RestoreIssueCommentVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreIssueCommentVersionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RestoreIssueCommentVersionResponseMultiError, or nil if none found.
+func (m *RestoreIssueCommentVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreIssueCommentVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RestoreIssueCommentVersionResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RestoreIssueCommentVersionResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RestoreIssueCommentVersionResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RestoreIssueCommentVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreIssueCommentVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RestoreIssueCommentVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreIssueCommentVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreIssueCommentVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreIssueCommentVersionResponseMultiError) AllErrors() []error { return m }
+
+// RestoreIssueCommentVersionResponseValidationError is the validation error
+// returned by RestoreIssueCommentVersionResponse.Validate if the designated
+// constraints aren't met.
+type RestoreIssueCommentVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreIssueCommentVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreIssueCommentVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreIssueCommentVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreIssueCommentVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreIssueCommentVersionResponseValidationError) ErrorName() string {
+	return "RestoreIssueCommentVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreIssueCommentVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreIssueCommentVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreIssueCommentVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreIssueCommentVersionResponseValidationError{}
+
+// Validate checks the field values on GetSyncConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSyncConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSyncConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSyncConfigResponseMultiError, or nil if none found.
+func (m *GetSyncConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSyncConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for PageSize
+
+	// no validation rules for MaxPagesPerRun
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for StorageDriver
+
+	// no validation rules for GithubTokenConfigured
+
+	// no validation rules for Concurrency
+
+	// no validation rules for ReconcileIntervalSeconds
+
+	// no validation rules for DiscoveryIntervalSeconds
+
+	// no validation rules for Version
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSyncConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSyncConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSyncConfigResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSyncConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSyncConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSyncConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSyncConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSyncConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSyncConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetSyncConfigResponseValidationError is the validation error returned by
+// GetSyncConfigResponse.Validate if the designated constraints aren't met.
+type GetSyncConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSyncConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSyncConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSyncConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSyncConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSyncConfigResponseValidationError) ErrorName() string {
+	return "GetSyncConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSyncConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSyncConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSyncConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSyncConfigResponseValidationError{}
+
+// Validate checks the field values on UpdateSyncConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSyncConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSyncConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSyncConfigRequestMultiError, or nil if none found.
+func (m *UpdateSyncConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSyncConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for PageSize
+
+	// no validation rules for MaxPagesPerRun
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for Version
+
+	if m.Concurrency != nil {
+		// no validation rules for Concurrency
+	}
+
+	if m.ReconcileIntervalSeconds != nil {
+		// no validation rules for ReconcileIntervalSeconds
+	}
+
+	if m.DiscoveryIntervalSeconds != nil {
+		// no validation rules for DiscoveryIntervalSeconds
+	}
+
+	if len(errors) > 0 {
+		return UpdateSyncConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSyncConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSyncConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSyncConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSyncConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSyncConfigRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSyncConfigRequestValidationError is the validation error returned by
+// UpdateSyncConfigRequest.Validate if the designated constraints aren't met.
+type UpdateSyncConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSyncConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSyncConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSyncConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSyncConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSyncConfigRequestValidationError) ErrorName() string {
+	return "UpdateSyncConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSyncConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSyncConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSyncConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSyncConfigRequestValidationError{}
+
+// Validate checks the field values on GetIssueSummaryConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueSummaryConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueSummaryConfigResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetIssueSummaryConfigResponseMultiError, or nil if none found.
+func (m *GetIssueSummaryConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueSummaryConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for BatchSize
+
+	// no validation rules for MaxIssuesPerRun
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for State
+
+	// no validation rules for OverwriteExisting
+
+	// no validation rules for Provider
+
+	// no validation rules for Model
+
+	// no validation rules for Version
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueSummaryConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueSummaryConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueSummaryConfigResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetIssueSummaryConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueSummaryConfigResponseMultiError is an error wrapping multiple
+// validation errors returned by GetIssueSummaryConfigResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetIssueSummaryConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueSummaryConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueSummaryConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetIssueSummaryConfigResponseValidationError is the validation error
+// returned by GetIssueSummaryConfigResponse.Validate if the designated
+// constraints aren't met.
+type GetIssueSummaryConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueSummaryConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueSummaryConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueSummaryConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueSummaryConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueSummaryConfigResponseValidationError) ErrorName() string {
+	return "GetIssueSummaryConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueSummaryConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueSummaryConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueSummaryConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueSummaryConfigResponseValidationError{}
+
+// Validate checks the field values on UpdateIssueSummaryConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateIssueSummaryConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateIssueSummaryConfigRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateIssueSummaryConfigRequestMultiError, or nil if none found.
+func (m *UpdateIssueSummaryConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateIssueSummaryConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for BatchSize
+
+	// no validation rules for MaxIssuesPerRun
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for State
+
+	// no validation rules for OverwriteExisting
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdateIssueSummaryConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateIssueSummaryConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateIssueSummaryConfigRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateIssueSummaryConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateIssueSummaryConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateIssueSummaryConfigRequestMultiError) AllErrors() []error { return m }
+
+// UpdateIssueSummaryConfigRequestValidationError is the validation error
+// returned by UpdateIssueSummaryConfigRequest.Validate if the designated
+// constraints aren't met.
+type UpdateIssueSummaryConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateIssueSummaryConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateIssueSummaryConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateIssueSummaryConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateIssueSummaryConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateIssueSummaryConfigRequestValidationError) ErrorName() string {
+	return "UpdateIssueSummaryConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateIssueSummaryConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateIssueSummaryConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateIssueSummaryConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateIssueSummaryConfigRequestValidationError{}
+
+// Validate checks the field values on GetPRReviewConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPRReviewConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPRReviewConfigResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPRReviewConfigResponseMultiError, or nil if none found.
+func (m *GetPRReviewConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPRReviewConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for BatchSize
+
+	// no validation rules for MaxPrsPerRun
+
+	// no validation rules for MaxDiffSize
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for OverwriteExisting
+
+	// no validation rules for Provider
+
+	// no validation rules for Model
+
+	// no validation rules for Version
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPRReviewConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPRReviewConfigResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPRReviewConfigResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPRReviewConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPRReviewConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPRReviewConfigResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetPRReviewConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPRReviewConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPRReviewConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetPRReviewConfigResponseValidationError is the validation error returned by
+// GetPRReviewConfigResponse.Validate if the designated constraints aren't met.
+type GetPRReviewConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPRReviewConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPRReviewConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPRReviewConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPRReviewConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPRReviewConfigResponseValidationError) ErrorName() string {
+	return "GetPRReviewConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPRReviewConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPRReviewConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPRReviewConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPRReviewConfigResponseValidationError{}
+
+// Validate checks the field values on UpdatePRReviewConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePRReviewConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePRReviewConfigRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePRReviewConfigRequestMultiError, or nil if none found.
+func (m *UpdatePRReviewConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePRReviewConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for BatchSize
+
+	// no validation rules for MaxPrsPerRun
+
+	// no validation rules for MaxDiffSize
+
+	// no validation rules for RequestTimeoutSeconds
+
+	// no validation rules for OverwriteExisting
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdatePRReviewConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePRReviewConfigRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdatePRReviewConfigRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdatePRReviewConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePRReviewConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePRReviewConfigRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePRReviewConfigRequestValidationError is the validation error returned
+// by UpdatePRReviewConfigRequest.Validate if the designated constraints
+// aren't met.
+type UpdatePRReviewConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePRReviewConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePRReviewConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePRReviewConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePRReviewConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePRReviewConfigRequestValidationError) ErrorName() string {
+	return "UpdatePRReviewConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePRReviewConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePRReviewConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePRReviewConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePRReviewConfigRequestValidationError{}
+
+// Validate checks the field values on ListSettingsChangesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSettingsChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSettingsChangesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSettingsChangesRequestMultiError, or nil if none found.
+func (m *ListSettingsChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSettingsChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Section
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListSettingsChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSettingsChangesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListSettingsChangesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListSettingsChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSettingsChangesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSettingsChangesRequestMultiError) AllErrors() []error { return m }
+
+// ListSettingsChangesRequestValidationError is the validation error returned
+// by ListSettingsChangesRequest.Validate if the designated constraints aren't met.
+type ListSettingsChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSettingsChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSettingsChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSettingsChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSettingsChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSettingsChangesRequestValidationError) ErrorName() string {
+	return "ListSettingsChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSettingsChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSettingsChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSettingsChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSettingsChangesRequestValidationError{}
+
+// Validate checks the field values on SettingsChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SettingsChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettingsChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SettingsChangeMultiError,
+// or nil if none found.
+func (m *SettingsChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettingsChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Section
+
+	// no validation rules for Version
+
+	// no validation rules for Data
+
+	// no validation rules for ChangedBy
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettingsChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettingsChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettingsChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SettingsChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettingsChangeMultiError is an error wrapping multiple validation errors
+// returned by SettingsChange.ValidateAll() if the designated constraints
+// aren't met.
+type SettingsChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettingsChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettingsChangeMultiError) AllErrors() []error { return m }
+
+// SettingsChangeValidationError is the validation error returned by
+// SettingsChange.Validate if the designated constraints aren't met.
+type SettingsChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettingsChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettingsChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettingsChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettingsChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettingsChangeValidationError) ErrorName() string { return "SettingsChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SettingsChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettingsChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettingsChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettingsChangeValidationError{}
+
+// Validate checks the field values on ListSettingsChangesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSettingsChangesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSettingsChangesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSettingsChangesResponseMultiError, or nil if none found.
+func (m *ListSettingsChangesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSettingsChangesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSettingsChangesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSettingsChangesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSettingsChangesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSettingsChangesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSettingsChangesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListSettingsChangesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListSettingsChangesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSettingsChangesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSettingsChangesResponseMultiError) AllErrors() []error { return m }
+
+// ListSettingsChangesResponseValidationError is the validation error returned
+// by ListSettingsChangesResponse.Validate if the designated constraints
+// aren't met.
+type ListSettingsChangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSettingsChangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSettingsChangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSettingsChangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSettingsChangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSettingsChangesResponseValidationError) ErrorName() string {
+	return "ListSettingsChangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSettingsChangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSettingsChangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSettingsChangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSettingsChangesResponseValidationError{}
+
+// Validate checks the field values on ManagedSyncRepo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ManagedSyncRepo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ManagedSyncRepo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ManagedSyncRepoMultiError, or nil if none found.
+func (m *ManagedSyncRepo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ManagedSyncRepo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ManagedSyncRepoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ManagedSyncRepoValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ManagedSyncRepoValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ManagedSyncRepoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ManagedSyncRepoValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ManagedSyncRepoValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IssueCount
+
+	// no validation rules for Rule
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ManagedSyncRepoValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ManagedSyncRepoValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ManagedSyncRepoValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ManagedSyncRepoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ManagedSyncRepoMultiError is an error wrapping multiple validation errors
+// returned by ManagedSyncRepo.ValidateAll() if the designated constraints
+// aren't met.
+type ManagedSyncRepoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ManagedSyncRepoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ManagedSyncRepoMultiError) AllErrors() []error { return m }
+
+// ManagedSyncRepoValidationError is the validation error returned by
+// ManagedSyncRepo.Validate if the designated constraints aren't met.
+type ManagedSyncRepoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ManagedSyncRepoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ManagedSyncRepoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ManagedSyncRepoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ManagedSyncRepoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ManagedSyncRepoValidationError) ErrorName() string { return "ManagedSyncRepoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ManagedSyncRepoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sManagedSyncRepo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ManagedSyncRepoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ManagedSyncRepoValidationError{}
+
+// Validate checks the field values on RepoSyncPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RepoSyncPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepoSyncPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RepoSyncPolicyMultiError,
+// or nil if none found.
+func (m *RepoSyncPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepoSyncPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for State
+
+	// no validation rules for IncludePullRequests
+
+	// no validation rules for SyncComments
+
+	if all {
+		switch v := interface{}(m.GetSyncSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RepoSyncPolicyValidationError{
+					field:  "SyncSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RepoSyncPolicyValidationError{
+					field:  "SyncSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSyncSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RepoSyncPolicyValidationError{
+				field:  "SyncSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RepoSyncPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoSyncPolicyMultiError is an error wrapping multiple validation errors
+// returned by RepoSyncPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type RepoSyncPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoSyncPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoSyncPolicyMultiError) AllErrors() []error { return m }
+
+// RepoSyncPolicyValidationError is the validation error returned by
+// RepoSyncPolicy.Validate if the designated constraints aren't met.
+type RepoSyncPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoSyncPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoSyncPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoSyncPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoSyncPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoSyncPolicyValidationError) ErrorName() string { return "RepoSyncPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RepoSyncPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepoSyncPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoSyncPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoSyncPolicyValidationError{}
+
+// Validate checks the field values on GetManagedSyncRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetManagedSyncRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetManagedSyncRepoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetManagedSyncRepoRequestMultiError, or nil if none found.
+func (m *GetManagedSyncRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetManagedSyncRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	if len(errors) > 0 {
+		return GetManagedSyncRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetManagedSyncRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by GetManagedSyncRepoRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetManagedSyncRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetManagedSyncRepoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetManagedSyncRepoRequestMultiError) AllErrors() []error { return m }
+
+// GetManagedSyncRepoRequestValidationError is the validation error returned by
+// GetManagedSyncRepoRequest.Validate if the designated constraints aren't met.
+type GetManagedSyncRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetManagedSyncRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetManagedSyncRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetManagedSyncRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetManagedSyncRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetManagedSyncRepoRequestValidationError) ErrorName() string {
+	return "GetManagedSyncRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetManagedSyncRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetManagedSyncRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetManagedSyncRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetManagedSyncRepoRequestValidationError{}
+
+// Validate checks the field values on UpdateRepoSyncPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRepoSyncPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRepoSyncPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRepoSyncPolicyRequestMultiError, or nil if none found.
+func (m *UpdateRepoSyncPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRepoSyncPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRepoSyncPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRepoSyncPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRepoSyncPolicyRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRepoSyncPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRepoSyncPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateRepoSyncPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateRepoSyncPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRepoSyncPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRepoSyncPolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRepoSyncPolicyRequestValidationError is the validation error returned
+// by UpdateRepoSyncPolicyRequest.Validate if the designated constraints
+// aren't met.
+type UpdateRepoSyncPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRepoSyncPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRepoSyncPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRepoSyncPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRepoSyncPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRepoSyncPolicyRequestValidationError) ErrorName() string {
+	return "UpdateRepoSyncPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRepoSyncPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRepoSyncPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRepoSyncPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRepoSyncPolicyRequestValidationError{}
+
+// Validate checks the field values on ListManagedSyncReposResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListManagedSyncReposResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListManagedSyncReposResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListManagedSyncReposResponseMultiError, or nil if none found.
+func (m *ListManagedSyncReposResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListManagedSyncReposResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRepos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListManagedSyncReposResponseValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListManagedSyncReposResponseValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListManagedSyncReposResponseValidationError{
+					field:  fmt.Sprintf("Repos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListManagedSyncReposResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListManagedSyncReposResponseMultiError is an error wrapping multiple
+// validation errors returned by ListManagedSyncReposResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListManagedSyncReposResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListManagedSyncReposResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListManagedSyncReposResponseMultiError) AllErrors() []error { return m }
+
+// ListManagedSyncReposResponseValidationError is the validation error returned
+// by ListManagedSyncReposResponse.Validate if the designated constraints
+// aren't met.
+type ListManagedSyncReposResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListManagedSyncReposResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListManagedSyncReposResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListManagedSyncReposResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListManagedSyncReposResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListManagedSyncReposResponseValidationError) ErrorName() string {
+	return "ListManagedSyncReposResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListManagedSyncReposResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListManagedSyncReposResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListManagedSyncReposResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListManagedSyncReposResponseValidationError{}
+
+// Validate checks the field values on ReplaceManagedSyncReposRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceManagedSyncReposRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceManagedSyncReposRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReplaceManagedSyncReposRequestMultiError, or nil if none found.
+func (m *ReplaceManagedSyncReposRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceManagedSyncReposRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReplaceManagedSyncReposRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceManagedSyncReposRequestMultiError is an error wrapping multiple
+// validation errors returned by ReplaceManagedSyncReposRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReplaceManagedSyncReposRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceManagedSyncReposRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceManagedSyncReposRequestMultiError) AllErrors() []error { return m }
+
+// ReplaceManagedSyncReposRequestValidationError is the validation error
+// returned by ReplaceManagedSyncReposRequest.Validate if the designated
+// constraints aren't met.
+type ReplaceManagedSyncReposRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceManagedSyncReposRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceManagedSyncReposRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceManagedSyncReposRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceManagedSyncReposRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceManagedSyncReposRequestValidationError) ErrorName() string {
+	return "ReplaceManagedSyncReposRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceManagedSyncReposRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceManagedSyncReposRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceManagedSyncReposRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceManagedSyncReposRequestValidationError{}
+
+// Validate checks the field values on RepoDiscoveryRuleResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RepoDiscoveryRuleResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RepoDiscoveryRuleResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RepoDiscoveryRuleResultMultiError, or nil if none found.
+func (m *RepoDiscoveryRuleResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RepoDiscoveryRuleResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rule
+
+	// no validation rules for Matched
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return RepoDiscoveryRuleResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// RepoDiscoveryRuleResultMultiError is an error wrapping multiple validation
+// errors returned by RepoDiscoveryRuleResult.ValidateAll() if the designated
+// constraints aren't met.
+type RepoDiscoveryRuleResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RepoDiscoveryRuleResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RepoDiscoveryRuleResultMultiError) AllErrors() []error { return m }
+
+// RepoDiscoveryRuleResultValidationError is the validation error returned by
+// RepoDiscoveryRuleResult.Validate if the designated constraints aren't met.
+type RepoDiscoveryRuleResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RepoDiscoveryRuleResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RepoDiscoveryRuleResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RepoDiscoveryRuleResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RepoDiscoveryRuleResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RepoDiscoveryRuleResultValidationError) ErrorName() string {
+	return "RepoDiscoveryRuleResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RepoDiscoveryRuleResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRepoDiscoveryRuleResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RepoDiscoveryRuleResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RepoDiscoveryRuleResultValidationError{}
+
+// Validate checks the field values on DiscoverManagedSyncReposResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DiscoverManagedSyncReposResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiscoverManagedSyncReposResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DiscoverManagedSyncReposResponseMultiError, or nil if none found.
+func (m *DiscoverManagedSyncReposResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiscoverManagedSyncReposResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverManagedSyncReposResponseValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscoverManagedSyncReposResponseValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoverManagedSyncReposResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Added
+
+	// no validation rules for Removed
+
+	for idx, item := range m.GetRepos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiscoverManagedSyncReposResponseValidationError{
+						field:  fmt.Sprintf("Repos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiscoverManagedSyncReposResponseValidationError{
+					field:  fmt.Sprintf("Repos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiscoverManagedSyncReposResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscoverManagedSyncReposResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DiscoverManagedSyncReposResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiscoverManagedSyncReposResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscoverManagedSyncReposResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscoverManagedSyncReposResponseMultiError) AllErrors() []error { return m }
+
+// DiscoverManagedSyncReposResponseValidationError is the validation error
+// returned by DiscoverManagedSyncReposResponse.Validate if the designated
+// constraints aren't met.
+type DiscoverManagedSyncReposResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscoverManagedSyncReposResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscoverManagedSyncReposResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscoverManagedSyncReposResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscoverManagedSyncReposResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscoverManagedSyncReposResponseValidationError) ErrorName() string {
+	return "DiscoverManagedSyncReposResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiscoverManagedSyncReposResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscoverManagedSyncReposResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscoverManagedSyncReposResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscoverManagedSyncReposResponseValidationError{}
+
+// Validate checks the field values on ResyncRepoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResyncRepoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResyncRepoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResyncRepoRequestMultiError, or nil if none found.
+func (m *ResyncRepoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResyncRepoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for Mode
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResyncRepoRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResyncRepoRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResyncRepoRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResyncRepoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResyncRepoRequestMultiError is an error wrapping multiple validation errors
+// returned by ResyncRepoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResyncRepoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResyncRepoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResyncRepoRequestMultiError) AllErrors() []error { return m }
+
+// ResyncRepoRequestValidationError is the validation error returned by
+// ResyncRepoRequest.Validate if the designated constraints aren't met.
+type ResyncRepoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResyncRepoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResyncRepoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResyncRepoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResyncRepoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResyncRepoRequestValidationError) ErrorName() string {
+	return "ResyncRepoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResyncRepoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResyncRepoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResyncRepoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResyncRepoRequestValidationError{}
+
+// Validate checks the field values on ResyncJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResyncJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResyncJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResyncJobMultiError, or nil
+// if none found.
+func (m *ResyncJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResyncJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Repo
+
+	// no validation rules for Mode
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResyncJobValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResyncJobValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResyncJobValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	// no validation rules for Pages
+
+	// no validation rules for Fetched
+
+	// no validation rules for Persisted
+
+	// no validation rules for Comments
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResyncJobValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResyncJobValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResyncJobValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResyncJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResyncJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResyncJobValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResyncJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResyncJobMultiError is an error wrapping multiple validation errors returned
+// by ResyncJob.ValidateAll() if the designated constraints aren't met.
+type ResyncJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResyncJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResyncJobMultiError) AllErrors() []error { return m }
+
+// ResyncJobValidationError is the validation error returned by
+// ResyncJob.Validate if the designated constraints aren't met.
+type ResyncJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResyncJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResyncJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResyncJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResyncJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResyncJobValidationError) ErrorName() string { return "ResyncJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResyncJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResyncJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResyncJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResyncJobValidationError{}
+
+// Validate checks the field values on GetResyncJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetResyncJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResyncJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResyncJobRequestMultiError, or nil if none found.
+func (m *GetResyncJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResyncJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetResyncJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResyncJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetResyncJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetResyncJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResyncJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResyncJobRequestMultiError) AllErrors() []error { return m }
+
+// GetResyncJobRequestValidationError is the validation error returned by
+// GetResyncJobRequest.Validate if the designated constraints aren't met.
+type GetResyncJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResyncJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResyncJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResyncJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResyncJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResyncJobRequestValidationError) ErrorName() string {
+	return "GetResyncJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResyncJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResyncJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResyncJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResyncJobRequestValidationError{}
+
+// Validate checks the field values on ListResyncJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResyncJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResyncJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResyncJobsResponseMultiError, or nil if none found.
+func (m *ListResyncJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResyncJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResyncJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResyncJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResyncJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListResyncJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResyncJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResyncJobsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListResyncJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResyncJobsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResyncJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListResyncJobsResponseValidationError is the validation error returned by
+// ListResyncJobsResponse.Validate if the designated constraints aren't met.
+type ListResyncJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResyncJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResyncJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResyncJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResyncJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResyncJobsResponseValidationError) ErrorName() string {
+	return "ListResyncJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResyncJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResyncJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResyncJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResyncJobsResponseValidationError{}
+
+// Validate checks the field values on SyncRunTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncRunTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncRunTarget with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncRunTargetMultiError, or
+// nil if none found.
+func (m *SyncRunTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncRunTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Target
+
+	// no validation rules for Fetched
+
+	// no validation rules for Persisted
+
+	// no validation rules for Failed
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return SyncRunTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncRunTargetMultiError is an error wrapping multiple validation errors
+// returned by SyncRunTarget.ValidateAll() if the designated constraints
+// aren't met.
+type SyncRunTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncRunTargetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncRunTargetMultiError) AllErrors() []error { return m }
+
+// SyncRunTargetValidationError is the validation error returned by
+// SyncRunTarget.Validate if the designated constraints aren't met.
+type SyncRunTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncRunTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncRunTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncRunTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncRunTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncRunTargetValidationError) ErrorName() string { return "SyncRunTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncRunTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncRunTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncRunTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncRunTargetValidationError{}
+
+// Validate checks the field values on SyncRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SyncRunMultiError, or nil if none found.
+func (m *SyncRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for JobType
+
+	// no validation rules for Trigger
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncRunValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncRunValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncRunValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncRunValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Fetched
+
+	// no validation rules for Persisted
+
+	// no validation rules for Failed
+
+	// no validation rules for Error
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncRunValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncRunValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncRunValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SyncRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncRunMultiError is an error wrapping multiple validation errors returned
+// by SyncRun.ValidateAll() if the designated constraints aren't met.
+type SyncRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncRunMultiError) AllErrors() []error { return m }
+
+// SyncRunValidationError is the validation error returned by SyncRun.Validate
+// if the designated constraints aren't met.
+type SyncRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncRunValidationError) ErrorName() string { return "SyncRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncRunValidationError{}
+
+// Validate checks the field values on ListSyncRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSyncRunsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSyncRunsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSyncRunsRequestMultiError, or nil if none found.
+func (m *ListSyncRunsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSyncRunsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobType
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStartedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSyncRunsRequestValidationError{
+					field:  "StartedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSyncRunsRequestValidationError{
+					field:  "StartedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSyncRunsRequestValidationError{
+				field:  "StartedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSyncRunsRequestValidationError{
+					field:  "StartedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSyncRunsRequestValidationError{
+					field:  "StartedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSyncRunsRequestValidationError{
+				field:  "StartedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListSyncRunsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSyncRunsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSyncRunsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSyncRunsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSyncRunsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSyncRunsRequestMultiError) AllErrors() []error { return m }
+
+// ListSyncRunsRequestValidationError is the validation error returned by
+// ListSyncRunsRequest.Validate if the designated constraints aren't met.
+type ListSyncRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSyncRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSyncRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSyncRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSyncRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSyncRunsRequestValidationError) ErrorName() string {
+	return "ListSyncRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSyncRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSyncRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSyncRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSyncRunsRequestValidationError{}
+
+// Validate checks the field values on ListSyncRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSyncRunsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSyncRunsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSyncRunsResponseMultiError, or nil if none found.
+func (m *ListSyncRunsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSyncRunsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSyncRunsResponseValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSyncRunsResponseValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSyncRunsResponseValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for HasNext
+
+	if len(errors) > 0 {
+		return ListSyncRunsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSyncRunsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSyncRunsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSyncRunsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSyncRunsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSyncRunsResponseMultiError) AllErrors() []error { return m }
+
+// ListSyncRunsResponseValidationError is the validation error returned by
+// ListSyncRunsResponse.Validate if the designated constraints aren't met.
+type ListSyncRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSyncRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSyncRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSyncRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSyncRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSyncRunsResponseValidationError) ErrorName() string {
+	return "ListSyncRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSyncRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSyncRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSyncRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSyncRunsResponseValidationError{}
+
+// Validate checks the field values on GetSyncRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSyncRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSyncRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSyncRunRequestMultiError, or nil if none found.
+func (m *GetSyncRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSyncRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSyncRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSyncRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSyncRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSyncRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSyncRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSyncRunRequestMultiError) AllErrors() []error { return m }
+
+// GetSyncRunRequestValidationError is the validation error returned by
+// GetSyncRunRequest.Validate if the designated constraints aren't met.
+type GetSyncRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSyncRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSyncRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSyncRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSyncRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSyncRunRequestValidationError) ErrorName() string {
+	return "GetSyncRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSyncRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSyncRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSyncRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSyncRunRequestValidationError{}
+
+// Validate checks the field values on SyncCheckpoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncCheckpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncCheckpoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncCheckpointMultiError,
+// or nil if none found.
+func (m *SyncCheckpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncCheckpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	if all {
+		switch v := interface{}(m.GetLastSyncedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncCheckpointValidationError{
+					field:  "LastSyncedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncCheckpointValidationError{
+					field:  "LastSyncedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSyncedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncCheckpointValidationError{
+				field:  "LastSyncedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastIssueUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SyncCheckpointValidationError{
+					field:  "LastIssueUpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SyncCheckpointValidationError{
+					field:  "LastIssueUpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastIssueUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SyncCheckpointValidationError{
+				field:  "LastIssueUpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastRunStatus
+
+	// no validation rules for LastError
+
+	if len(errors) > 0 {
+		return SyncCheckpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncCheckpointMultiError is an error wrapping multiple validation errors
+// returned by SyncCheckpoint.ValidateAll() if the designated constraints
+// aren't met.
+type SyncCheckpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncCheckpointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncCheckpointMultiError) AllErrors() []error { return m }
+
+// SyncCheckpointValidationError is the validation error returned by
+// SyncCheckpoint.Validate if the designated constraints aren't met.
+type SyncCheckpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncCheckpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncCheckpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncCheckpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncCheckpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncCheckpointValidationError) ErrorName() string { return "SyncCheckpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncCheckpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncCheckpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncCheckpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncCheckpointValidationError{}
+
+// Validate checks the field values on GetSyncStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSyncStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSyncStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSyncStatusResponseMultiError, or nil if none found.
+func (m *GetSyncStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSyncStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "LastStartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "LastStartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSyncStatusResponseValidationError{
+				field:  "LastStartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "LastFinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "LastFinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSyncStatusResponseValidationError{
+				field:  "LastFinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Running
+
+	for idx, item := range m.GetLastResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("LastResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("LastResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSyncStatusResponseValidationError{
+					field:  fmt.Sprintf("LastResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCheckpoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("Checkpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("Checkpoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSyncStatusResponseValidationError{
+					field:  fmt.Sprintf("Checkpoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSyncStatusResponseValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLeader()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "Leader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSyncStatusResponseValidationError{
+					field:  "Leader",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeader()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSyncStatusResponseValidationError{
+				field:  "Leader",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRateLimits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("RateLimits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSyncStatusResponseValidationError{
+						field:  fmt.Sprintf("RateLimits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSyncStatusResponseValidationError{
+					field:  fmt.Sprintf("RateLimits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSyncStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSyncStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSyncStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSyncStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSyncStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSyncStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetSyncStatusResponseValidationError is the validation error returned by
+// GetSyncStatusResponse.Validate if the designated constraints aren't met.
+type GetSyncStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSyncStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSyncStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSyncStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSyncStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSyncStatusResponseValidationError) ErrorName() string {
+	return "GetSyncStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSyncStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSyncStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSyncStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSyncStatusResponseValidationError{}
+
+// Validate checks the field values on SchedulerLeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchedulerLeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchedulerLeader with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchedulerLeaderMultiError, or nil if none found.
+func (m *SchedulerLeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchedulerLeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Holder
+
+	// no validation rules for Replica
+
+	// no validation rules for Leading
+
+	if len(errors) > 0 {
+		return SchedulerLeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchedulerLeaderMultiError is an error wrapping multiple validation errors
+// returned by SchedulerLeader.ValidateAll() if the designated constraints
+// aren't met.
+type SchedulerLeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchedulerLeaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchedulerLeaderMultiError) AllErrors() []error { return m }
+
+// SchedulerLeaderValidationError is the validation error returned by
+// SchedulerLeader.Validate if the designated constraints aren't met.
+type SchedulerLeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchedulerLeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchedulerLeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchedulerLeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchedulerLeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchedulerLeaderValidationError) ErrorName() string { return "SchedulerLeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchedulerLeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchedulerLeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchedulerLeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchedulerLeaderValidationError{}
+
+// Validate checks the field values on GitHubRateLimit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GitHubRateLimit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitHubRateLimit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GitHubRateLimitMultiError, or nil if none found.
+func (m *GitHubRateLimit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitHubRateLimit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Remaining
+
+	if all {
+		switch v := interface{}(m.GetResetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitHubRateLimitValidationError{
+					field:  "ResetAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitHubRateLimitValidationError{
+					field:  "ResetAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitHubRateLimitValidationError{
+				field:  "ResetAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetObservedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitHubRateLimitValidationError{
+					field:  "ObservedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitHubRateLimitValidationError{
+					field:  "ObservedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObservedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitHubRateLimitValidationError{
+				field:  "ObservedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPausedUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GitHubRateLimitValidationError{
+					field:  "PausedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GitHubRateLimitValidationError{
+					field:  "PausedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPausedUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GitHubRateLimitValidationError{
+				field:  "PausedUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Credential
+
+	if len(errors) > 0 {
+		return GitHubRateLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitHubRateLimitMultiError is an error wrapping multiple validation errors
+// returned by GitHubRateLimit.ValidateAll() if the designated constraints
+// aren't met.
+type GitHubRateLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitHubRateLimitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitHubRateLimitMultiError) AllErrors() []error { return m }
+
+// GitHubRateLimitValidationError is the validation error returned by
+// GitHubRateLimit.Validate if the designated constraints aren't met.
+type GitHubRateLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitHubRateLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitHubRateLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitHubRateLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitHubRateLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitHubRateLimitValidationError) ErrorName() string { return "GitHubRateLimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GitHubRateLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitHubRateLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitHubRateLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitHubRateLimitValidationError{}
+
+// Validate checks the field values on ListIssuesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListIssuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssuesRequestMultiError, or nil if none found.
+func (m *ListIssuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for State
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeTombstoned
+
+	// no validation rules for LabelMatch
+
+	// no validation rules for Assignee
+
+	// no validation rules for Author
+
+	// no validation rules for IsPullRequest
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListIssuesRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListIssuesRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "UpdatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "UpdatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListIssuesRequestValidationError{
+				field:  "UpdatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "UpdatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "UpdatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListIssuesRequestValidationError{
+				field:  "UpdatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "ClosedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "ClosedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListIssuesRequestValidationError{
+				field:  "ClosedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "ClosedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListIssuesRequestValidationError{
+					field:  "ClosedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListIssuesRequestValidationError{
+				field:  "ClosedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HasAiSummary
+
+	// no validation rules for Comments
+
+	// no validation rules for Sort
+
+	// no validation rules for Direction
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotal
+
+	if len(errors) > 0 {
+		return ListIssuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssuesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListIssuesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListIssuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssuesRequestMultiError) AllErrors() []error { return m }
+
+// ListIssuesRequestValidationError is the validation error returned by
+// ListIssuesRequest.Validate if the designated constraints aren't met.
+type ListIssuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssuesRequestValidationError) ErrorName() string {
+	return "ListIssuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssuesRequestValidationError{}
+
+// Validate checks the field values on ListIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssuesResponseMultiError, or nil if none found.
+func (m *ListIssuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIssuesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIssuesResponseValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIssuesResponseValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for HasNext
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListIssuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssuesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListIssuesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListIssuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssuesResponseMultiError) AllErrors() []error { return m }
+
+// ListIssuesResponseValidationError is the validation error returned by
+// ListIssuesResponse.Validate if the designated constraints aren't met.
+type ListIssuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssuesResponseValidationError) ErrorName() string {
+	return "ListIssuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssuesResponseValidationError{}
+
+// Validate checks the field values on SearchIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchIssuesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchIssuesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchIssuesRequestMultiError, or nil if none found.
+func (m *SearchIssuesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchIssuesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for IncludeTombstoned
+
+	if len(errors) > 0 {
+		return SearchIssuesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchIssuesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchIssuesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchIssuesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchIssuesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchIssuesRequestMultiError) AllErrors() []error { return m }
+
+// SearchIssuesRequestValidationError is the validation error returned by
+// SearchIssuesRequest.Validate if the designated constraints aren't met.
+type SearchIssuesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchIssuesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchIssuesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchIssuesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchIssuesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchIssuesRequestValidationError) ErrorName() string {
+	return "SearchIssuesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchIssuesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchIssuesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchIssuesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchIssuesRequestValidationError{}
+
+// Validate checks the field values on IssueSearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueSearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueSearchHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueSearchHitMultiError,
+// or nil if none found.
+func (m *IssueSearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueSearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueSearchHitValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueSearchHitValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueSearchHitValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for TitleHighlight
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return IssueSearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueSearchHitMultiError is an error wrapping multiple validation errors
+// returned by IssueSearchHit.ValidateAll() if the designated constraints
+// aren't met.
+type IssueSearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueSearchHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueSearchHitMultiError) AllErrors() []error { return m }
+
+// IssueSearchHitValidationError is the validation error returned by
+// IssueSearchHit.Validate if the designated constraints aren't met.
+type IssueSearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueSearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueSearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueSearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueSearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueSearchHitValidationError) ErrorName() string { return "IssueSearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueSearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueSearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueSearchHitValidationError{}
+
+// Validate checks the field values on SearchIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchIssuesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchIssuesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchIssuesResponseMultiError, or nil if none found.
+func (m *SearchIssuesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchIssuesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchIssuesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchIssuesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchIssuesResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for HasNext
+
+	if len(errors) > 0 {
+		return SearchIssuesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchIssuesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchIssuesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchIssuesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchIssuesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchIssuesResponseMultiError) AllErrors() []error { return m }
+
+// SearchIssuesResponseValidationError is the validation error returned by
+// SearchIssuesResponse.Validate if the designated constraints aren't met.
+type SearchIssuesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchIssuesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchIssuesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchIssuesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchIssuesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchIssuesResponseValidationError) ErrorName() string {
+	return "SearchIssuesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchIssuesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchIssuesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchIssuesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchIssuesResponseValidationError{}
+
+// Validate checks the field values on GetIssueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIssueRequestMultiError, or nil if none found.
+func (m *GetIssueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for IncludeTombstoned
+
+	switch v := m.Selector.(type) {
+	case *GetIssueRequest_IssueId:
+		if v == nil {
+			err := GetIssueRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for IssueId
+	case *GetIssueRequest_Number:
+		if v == nil {
+			err := GetIssueRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Number
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetIssueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueRequestMultiError is an error wrapping multiple validation errors
+// returned by GetIssueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetIssueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueRequestMultiError) AllErrors() []error { return m }
+
+// GetIssueRequestValidationError is the validation error returned by
+// GetIssueRequest.Validate if the designated constraints aren't met.
+type GetIssueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueRequestValidationError) ErrorName() string { return "GetIssueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetIssueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueRequestValidationError{}
+
+// Validate checks the field values on GetIssueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIssueResponseMultiError, or nil if none found.
+func (m *GetIssueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetIssue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueResponseValidationError{
+					field:  "Issue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueResponseValidationError{
+				field:  "Issue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetIssueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueResponseMultiError is an error wrapping multiple validation errors
+// returned by GetIssueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetIssueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueResponseMultiError) AllErrors() []error { return m }
+
+// GetIssueResponseValidationError is the validation error returned by
+// GetIssueResponse.Validate if the designated constraints aren't met.
+type GetIssueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueResponseValidationError) ErrorName() string { return "GetIssueResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetIssueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueResponseValidationError{}
+
+// Validate checks the field values on IssueEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueEventMultiError, or
+// nil if none found.
+func (m *IssueEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Repo
+
+	// no validation rules for IssueId
+
+	// no validation rules for IssueNumber
+
+	// no validation rules for Event
+
+	// no validation rules for Actor
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Label
+
+	// no validation rules for Assignee
+
+	// no validation rules for Milestone
+
+	// no validation rules for RenameFrom
+
+	// no validation rules for RenameTo
+
+	// no validation rules for CommitId
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return IssueEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueEventMultiError is an error wrapping multiple validation errors
+// returned by IssueEvent.ValidateAll() if the designated constraints aren't met.
+type IssueEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueEventMultiError) AllErrors() []error { return m }
+
+// IssueEventValidationError is the validation error returned by
+// IssueEvent.Validate if the designated constraints aren't met.
+type IssueEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueEventValidationError) ErrorName() string { return "IssueEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueEventValidationError{}
+
+// Validate checks the field values on ListLabelsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLabelsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLabelsRequestMultiError, or nil if none found.
+func (m *ListLabelsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLabelsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	if len(errors) > 0 {
+		return ListLabelsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLabelsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLabelsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLabelsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLabelsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLabelsRequestMultiError) AllErrors() []error { return m }
+
+// ListLabelsRequestValidationError is the validation error returned by
+// ListLabelsRequest.Validate if the designated constraints aren't met.
+type ListLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLabelsRequestValidationError) ErrorName() string {
+	return "ListLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLabelsRequestValidationError{}
+
+// Validate checks the field values on ListLabelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLabelsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLabelsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLabelsResponseMultiError, or nil if none found.
+func (m *ListLabelsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLabelsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLabels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLabelsResponseValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLabelsResponseValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLabelsResponseValidationError{
+					field:  fmt.Sprintf("Labels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLabelsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLabelsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListLabelsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListLabelsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLabelsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLabelsResponseMultiError) AllErrors() []error { return m }
+
+// ListLabelsResponseValidationError is the validation error returned by
+// ListLabelsResponse.Validate if the designated constraints aren't met.
+type ListLabelsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLabelsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLabelsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLabelsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLabelsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLabelsResponseValidationError) ErrorName() string {
+	return "ListLabelsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLabelsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLabelsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLabelsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLabelsResponseValidationError{}
+
+// Validate checks the field values on ListMilestonesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMilestonesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMilestonesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMilestonesRequestMultiError, or nil if none found.
+func (m *ListMilestonesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMilestonesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return ListMilestonesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMilestonesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMilestonesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMilestonesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMilestonesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMilestonesRequestMultiError) AllErrors() []error { return m }
+
+// ListMilestonesRequestValidationError is the validation error returned by
+// ListMilestonesRequest.Validate if the designated constraints aren't met.
+type ListMilestonesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMilestonesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMilestonesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMilestonesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMilestonesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMilestonesRequestValidationError) ErrorName() string {
+	return "ListMilestonesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMilestonesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMilestonesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMilestonesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMilestonesRequestValidationError{}
+
+// Validate checks the field values on ListMilestonesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMilestonesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMilestonesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMilestonesResponseMultiError, or nil if none found.
+func (m *ListMilestonesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMilestonesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMilestones() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMilestonesResponseValidationError{
+						field:  fmt.Sprintf("Milestones[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMilestonesResponseValidationError{
+						field:  fmt.Sprintf("Milestones[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMilestonesResponseValidationError{
+					field:  fmt.Sprintf("Milestones[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMilestonesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMilestonesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMilestonesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMilestonesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMilestonesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMilestonesResponseMultiError) AllErrors() []error { return m }
+
+// ListMilestonesResponseValidationError is the validation error returned by
+// ListMilestonesResponse.Validate if the designated constraints aren't met.
+type ListMilestonesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMilestonesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMilestonesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMilestonesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMilestonesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMilestonesResponseValidationError) ErrorName() string {
+	return "ListMilestonesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMilestonesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMilestonesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMilestonesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMilestonesResponseValidationError{}
+
+// Validate checks the field values on ListIssueEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssueEventsRequestMultiError, or nil if none found.
+func (m *ListIssueEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	switch v := m.Selector.(type) {
+	case *ListIssueEventsRequest_IssueId:
+		if v == nil {
+			err := ListIssueEventsRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for IssueId
+	case *ListIssueEventsRequest_Number:
+		if v == nil {
+			err := ListIssueEventsRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Number
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ListIssueEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListIssueEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListIssueEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListIssueEventsRequestValidationError is the validation error returned by
+// ListIssueEventsRequest.Validate if the designated constraints aren't met.
+type ListIssueEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueEventsRequestValidationError) ErrorName() string {
+	return "ListIssueEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueEventsRequestValidationError{}
+
+// Validate checks the field values on ListIssueEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIssueEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIssueEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIssueEventsResponseMultiError, or nil if none found.
+func (m *ListIssueEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIssueEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIssueEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIssueEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIssueEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for HasNext
+
+	if len(errors) > 0 {
+		return ListIssueEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIssueEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListIssueEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListIssueEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIssueEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIssueEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListIssueEventsResponseValidationError is the validation error returned by
+// ListIssueEventsResponse.Validate if the designated constraints aren't met.
+type ListIssueEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIssueEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIssueEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIssueEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIssueEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIssueEventsResponseValidationError) ErrorName() string {
+	return "ListIssueEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIssueEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIssueEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIssueEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIssueEventsResponseValidationError{}
+
+// Validate checks the field values on GetIssueResponsivenessRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueResponsivenessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueResponsivenessRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetIssueResponsivenessRequestMultiError, or nil if none found.
+func (m *GetIssueResponsivenessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueResponsivenessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueResponsivenessRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueResponsivenessRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueResponsivenessRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetIssueResponsivenessRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetIssueResponsivenessRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetIssueResponsivenessRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupBy
+
+	if len(errors) > 0 {
+		return GetIssueResponsivenessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueResponsivenessRequestMultiError is an error wrapping multiple
+// validation errors returned by GetIssueResponsivenessRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetIssueResponsivenessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueResponsivenessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueResponsivenessRequestMultiError) AllErrors() []error { return m }
+
+// GetIssueResponsivenessRequestValidationError is the validation error
+// returned by GetIssueResponsivenessRequest.Validate if the designated
+// constraints aren't met.
+type GetIssueResponsivenessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueResponsivenessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueResponsivenessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueResponsivenessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueResponsivenessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueResponsivenessRequestValidationError) ErrorName() string {
+	return "GetIssueResponsivenessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueResponsivenessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueResponsivenessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueResponsivenessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueResponsivenessRequestValidationError{}
+
+// Validate checks the field values on IssueResponsivenessGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueResponsivenessGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueResponsivenessGroup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueResponsivenessGroupMultiError, or nil if none found.
+func (m *IssueResponsivenessGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueResponsivenessGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Issues
+
+	// no validation rules for Responded
+
+	// no validation rules for FirstResponseP50Seconds
+
+	// no validation rules for FirstResponseP90Seconds
+
+	// no validation rules for Closed
+
+	// no validation rules for CloseP50Seconds
+
+	// no validation rules for CloseP90Seconds
+
+	// no validation rules for Reopened
+
+	// no validation rules for Reopens
+
+	if len(errors) > 0 {
+		return IssueResponsivenessGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueResponsivenessGroupMultiError is an error wrapping multiple validation
+// errors returned by IssueResponsivenessGroup.ValidateAll() if the designated
+// constraints aren't met.
+type IssueResponsivenessGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueResponsivenessGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueResponsivenessGroupMultiError) AllErrors() []error { return m }
+
+// IssueResponsivenessGroupValidationError is the validation error returned by
+// IssueResponsivenessGroup.Validate if the designated constraints aren't met.
+type IssueResponsivenessGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueResponsivenessGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueResponsivenessGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueResponsivenessGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueResponsivenessGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueResponsivenessGroupValidationError) ErrorName() string {
+	return "IssueResponsivenessGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueResponsivenessGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueResponsivenessGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueResponsivenessGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueResponsivenessGroupValidationError{}
+
+// Validate checks the field values on GetIssueResponsivenessResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetIssueResponsivenessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssueResponsivenessResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetIssueResponsivenessResponseMultiError, or nil if none found.
+func (m *GetIssueResponsivenessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssueResponsivenessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetIssueResponsivenessResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetIssueResponsivenessResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetIssueResponsivenessResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	if len(errors) > 0 {
+		return GetIssueResponsivenessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetIssueResponsivenessResponseMultiError is an error wrapping multiple
+// validation errors returned by GetIssueResponsivenessResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetIssueResponsivenessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssueResponsivenessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssueResponsivenessResponseMultiError) AllErrors() []error { return m }
+
+// GetIssueResponsivenessResponseValidationError is the validation error
+// returned by GetIssueResponsivenessResponse.Validate if the designated
+// constraints aren't met.
+type GetIssueResponsivenessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssueResponsivenessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssueResponsivenessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssueResponsivenessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssueResponsivenessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssueResponsivenessResponseValidationError) ErrorName() string {
+	return "GetIssueResponsivenessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetIssueResponsivenessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssueResponsivenessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssueResponsivenessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssueResponsivenessResponseValidationError{}
+
+// Validate checks the field values on UpdateIssueAISummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateIssueAISummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateIssueAISummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateIssueAISummaryRequestMultiError, or nil if none found.
+func (m *UpdateIssueAISummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateIssueAISummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	// no validation rules for AiSummary
+
+	switch v := m.Selector.(type) {
+	case *UpdateIssueAISummaryRequest_IssueId:
+		if v == nil {
+			err := UpdateIssueAISummaryRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for IssueId
+	case *UpdateIssueAISummaryRequest_Number:
+		if v == nil {
+			err := UpdateIssueAISummaryRequestValidationError{
+				field:  "Selector",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Number
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UpdateIssueAISummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateIssueAISummaryRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateIssueAISummaryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateIssueAISummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateIssueAISummaryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateIssueAISummaryRequestMultiError) AllErrors() []error { return m }
+
+// UpdateIssueAISummaryRequestValidationError is the validation error returned
+// by UpdateIssueAISummaryRequest.Validate if the designated constraints
+// aren't met.
+type UpdateIssueAISummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateIssueAISummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateIssueAISummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateIssueAISummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateIssueAISummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateIssueAISummaryRequestValidationError) ErrorName() string {
+	return "UpdateIssueAISummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateIssueAISummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateIssueAISummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateIssueAISummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateIssueAISummaryRequestValidationError{}
+
+// Validate checks the field values on ClearIssueAISummariesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearIssueAISummariesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearIssueAISummariesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearIssueAISummariesRequestMultiError, or nil if none found.
+func (m *ClearIssueAISummariesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearIssueAISummariesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repo
+
+	if len(errors) > 0 {
+		return ClearIssueAISummariesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearIssueAISummariesRequestMultiError is an error wrapping multiple
+// validation errors returned by ClearIssueAISummariesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ClearIssueAISummariesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearIssueAISummariesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearIssueAISummariesRequestMultiError) AllErrors() []error { return m }
+
+// ClearIssueAISummariesRequestValidationError is the validation error returned
+// by ClearIssueAISummariesRequest.Validate if the designated constraints
+// aren't met.
+type ClearIssueAISummariesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearIssueAISummariesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearIssueAISummariesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearIssueAISummariesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearIssueAISummariesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearIssueAISummariesRequestValidationError) ErrorName() string {
+	return "ClearIssueAISummariesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearIssueAISummariesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearIssueAISummariesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearIssueAISummariesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearIssueAISummariesRequestValidationError{}
+
+// Validate checks the field values on ClearIssueAISummariesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearIssueAISummariesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearIssueAISummariesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ClearIssueAISummariesResponseMultiError, or nil if none found.
+func (m *ClearIssueAISummariesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearIssueAISummariesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for PageSize
+	var errors []error
 
-	// no validation rules for HasNext
+	// no validation rules for Cleared
 
 	if len(errors) > 0 {
-		return ListIssuesResponseMultiError(errors)
+		return ClearIssueAISummariesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListIssuesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListIssuesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListIssuesResponseMultiError []error
+// ClearIssueAISummariesResponseMultiError is an error wrapping multiple
+// validation errors returned by ClearIssueAISummariesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ClearIssueAISummariesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListIssuesResponseMultiError) Error() string {
+func (m ClearIssueAISummariesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2566,11 +10686,12 @@ func (m ListIssuesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListIssuesResponseMultiError) AllErrors() []error { return m }
+func (m ClearIssueAISummariesResponseMultiError) AllErrors() []error { return m }
 
-// ListIssuesResponseValidationError is the validation error returned by
-// ListIssuesResponse.Validate if the designated constraints aren't met.
-type ListIssuesResponseValidationError struct {
+// ClearIssueAISummariesResponseValidationError is the validation error
+// returned by ClearIssueAISummariesResponse.Validate if the designated
+// constraints aren't met.
+type ClearIssueAISummariesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2578,24 +10699,24 @@ type ListIssuesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListIssuesResponseValidationError) Field() string { return e.field }
+func (e ClearIssueAISummariesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListIssuesResponseValidationError) Reason() string { return e.reason }
+func (e ClearIssueAISummariesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListIssuesResponseValidationError) Cause() error { return e.cause }
+func (e ClearIssueAISummariesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListIssuesResponseValidationError) Key() bool { return e.key }
+func (e ClearIssueAISummariesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListIssuesResponseValidationError) ErrorName() string {
-	return "ListIssuesResponseValidationError"
+func (e ClearIssueAISummariesResponseValidationError) ErrorName() string {
+	return "ClearIssueAISummariesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListIssuesResponseValidationError) Error() string {
+func (e ClearIssueAISummariesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2607,14 +10728,14 @@ func (e ListIssuesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListIssuesResponse.%s: %s%s",
+		"invalid %sClearIssueAISummariesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListIssuesResponseValidationError{}
+var _ error = ClearIssueAISummariesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2622,75 +10743,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListIssuesResponseValidationError{}
+} = ClearIssueAISummariesResponseValidationError{}
 
-// Validate checks the field values on GetIssueRequest with the rules defined
+// Validate checks the field values on AdminLoginRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetIssueRequest) Validate() error {
+func (m *AdminLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetIssueRequest with the rules
+// ValidateAll checks the field values on AdminLoginRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetIssueRequestMultiError, or nil if none found.
-func (m *GetIssueRequest) ValidateAll() error {
+// AdminLoginRequestMultiError, or nil if none found.
+func (m *AdminLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetIssueRequest) validate(all bool) error {
+func (m *AdminLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Repo
+	// no validation rules for User
 
-	switch v := m.Selector.(type) {
-	case *GetIssueRequest_IssueId:
-		if v == nil {
-			err := GetIssueRequestValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for IssueId
-	case *GetIssueRequest_Number:
-		if v == nil {
-			err := GetIssueRequestValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Number
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return GetIssueRequestMultiError(errors)
+		return AdminLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetIssueRequestMultiError is an error wrapping multiple validation errors
-// returned by GetIssueRequest.ValidateAll() if the designated constraints
+// AdminLoginRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetIssueRequestMultiError []error
+type AdminLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetIssueRequestMultiError) Error() string {
+func (m AdminLoginRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2699,11 +10793,11 @@ func (m GetIssueRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetIssueRequestMultiError) AllErrors() []error { return m }
+func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
 
-// GetIssueRequestValidationError is the validation error returned by
-// GetIssueRequest.Validate if the designated constraints aren't met.
-type GetIssueRequestValidationError struct {
+// AdminLoginRequestValidationError is the validation error returned by
+// AdminLoginRequest.Validate if the designated constraints aren't met.
+type AdminLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2711,22 +10805,24 @@ type GetIssueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetIssueRequestValidationError) Field() string { return e.field }
+func (e AdminLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetIssueRequestValidationError) Reason() string { return e.reason }
+func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetIssueRequestValidationError) Cause() error { return e.cause }
+func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetIssueRequestValidationError) Key() bool { return e.key }
+func (e AdminLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetIssueRequestValidationError) ErrorName() string { return "GetIssueRequestValidationError" }
+func (e AdminLoginRequestValidationError) ErrorName() string {
+	return "AdminLoginRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetIssueRequestValidationError) Error() string {
+func (e AdminLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2738,14 +10834,14 @@ func (e GetIssueRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetIssueRequest.%s: %s%s",
+		"invalid %sAdminLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetIssueRequestValidationError{}
+var _ error = AdminLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2753,53 +10849,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetIssueRequestValidationError{}
+} = AdminLoginRequestValidationError{}
 
-// Validate checks the field values on GetIssueResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetIssueResponse) Validate() error {
+// Validate checks the field values on AdminLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLoginResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetIssueResponse with the rules
+// ValidateAll checks the field values on AdminLoginResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetIssueResponseMultiError, or nil if none found.
-func (m *GetIssueResponse) ValidateAll() error {
+// AdminLoginResponseMultiError, or nil if none found.
+func (m *AdminLoginResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetIssueResponse) validate(all bool) error {
+func (m *AdminLoginResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for Token
+
 	if all {
-		switch v := interface{}(m.GetIssue()).(type) {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetIssueResponseValidationError{
-					field:  "Issue",
+				errors = append(errors, AdminLoginResponseValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetIssueResponseValidationError{
-					field:  "Issue",
+				errors = append(errors, AdminLoginResponseValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetIssue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetIssueResponseValidationError{
-				field:  "Issue",
+			return AdminLoginResponseValidationError{
+				field:  "ExpiresAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2807,19 +10909,19 @@ func (m *GetIssueResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetIssueResponseMultiError(errors)
+		return AdminLoginResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetIssueResponseMultiError is an error wrapping multiple validation errors
-// returned by GetIssueResponse.ValidateAll() if the designated constraints
+// AdminLoginResponseMultiError is an error wrapping multiple validation errors
+// returned by AdminLoginResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetIssueResponseMultiError []error
+type AdminLoginResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetIssueResponseMultiError) Error() string {
+func (m AdminLoginResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2828,11 +10930,11 @@ func (m GetIssueResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetIssueResponseMultiError) AllErrors() []error { return m }
+func (m AdminLoginResponseMultiError) AllErrors() []error { return m }
 
-// GetIssueResponseValidationError is the validation error returned by
-// GetIssueResponse.Validate if the designated constraints aren't met.
-type GetIssueResponseValidationError struct {
+// AdminLoginResponseValidationError is the validation error returned by
+// AdminLoginResponse.Validate if the designated constraints aren't met.
+type AdminLoginResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2840,22 +10942,24 @@ type GetIssueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetIssueResponseValidationError) Field() string { return e.field }
+func (e AdminLoginResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetIssueResponseValidationError) Reason() string { return e.reason }
+func (e AdminLoginResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetIssueResponseValidationError) Cause() error { return e.cause }
+func (e AdminLoginResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetIssueResponseValidationError) Key() bool { return e.key }
+func (e AdminLoginResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetIssueResponseValidationError) ErrorName() string { return "GetIssueResponseValidationError" }
+func (e AdminLoginResponseValidationError) ErrorName() string {
+	return "AdminLoginResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetIssueResponseValidationError) Error() string {
+func (e AdminLoginResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2867,14 +10971,14 @@ func (e GetIssueResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetIssueResponse.%s: %s%s",
+		"invalid %sAdminLoginResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetIssueResponseValidationError{}
+var _ error = AdminLoginResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2882,77 +10986,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetIssueResponseValidationError{}
+} = AdminLoginResponseValidationError{}
 
-// Validate checks the field values on UpdateIssueAISummaryRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLogoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateIssueAISummaryRequest) Validate() error {
+func (m *AdminLogoutRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateIssueAISummaryRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLogoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateIssueAISummaryRequestMultiError, or nil if none found.
-func (m *UpdateIssueAISummaryRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UpdateIssueAISummaryRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for Repo
-
-	// no validation rules for AiSummary
-
-	switch v := m.Selector.(type) {
-	case *UpdateIssueAISummaryRequest_IssueId:
-		if v == nil {
-			err := UpdateIssueAISummaryRequestValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for IssueId
-	case *UpdateIssueAISummaryRequest_Number:
-		if v == nil {
-			err := UpdateIssueAISummaryRequestValidationError{
-				field:  "Selector",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for Number
-	default:
-		_ = v // ensures v is used
+// AdminLogoutRequestMultiError, or nil if none found.
+func (m *AdminLogoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLogoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return UpdateIssueAISummaryRequestMultiError(errors)
+		return AdminLogoutRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateIssueAISummaryRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateIssueAISummaryRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateIssueAISummaryRequestMultiError []error
+// AdminLogoutRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminLogoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminLogoutRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateIssueAISummaryRequestMultiError) Error() string {
+func (m AdminLogoutRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2961,12 +11034,11 @@ func (m UpdateIssueAISummaryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateIssueAISummaryRequestMultiError) AllErrors() []error { return m }
+func (m AdminLogoutRequestMultiError) AllErrors() []error { return m }
 
-// UpdateIssueAISummaryRequestValidationError is the validation error returned
-// by UpdateIssueAISummaryRequest.Validate if the designated constraints
-// aren't met.
-type UpdateIssueAISummaryRequestValidationError struct {
+// AdminLogoutRequestValidationError is the validation error returned by
+// AdminLogoutRequest.Validate if the designated constraints aren't met.
+type AdminLogoutRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2974,24 +11046,24 @@ type UpdateIssueAISummaryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateIssueAISummaryRequestValidationError) Field() string { return e.field }
+func (e AdminLogoutRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateIssueAISummaryRequestValidationError) Reason() string { return e.reason }
+func (e AdminLogoutRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateIssueAISummaryRequestValidationError) Cause() error { return e.cause }
+func (e AdminLogoutRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateIssueAISummaryRequestValidationError) Key() bool { return e.key }
+func (e AdminLogoutRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateIssueAISummaryRequestValidationError) ErrorName() string {
-	return "UpdateIssueAISummaryRequestValidationError"
+func (e AdminLogoutRequestValidationError) ErrorName() string {
+	return "AdminLogoutRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateIssueAISummaryRequestValidationError) Error() string {
+func (e AdminLogoutRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3003,14 +11075,14 @@ func (e UpdateIssueAISummaryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateIssueAISummaryRequest.%s: %s%s",
+		"invalid %sAdminLogoutRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateIssueAISummaryRequestValidationError{}
+var _ error = AdminLogoutRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3018,46 +11090,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateIssueAISummaryRequestValidationError{}
+} = AdminLogoutRequestValidationError{}
 
-// Validate checks the field values on ClearIssueAISummariesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminLogoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ClearIssueAISummariesRequest) Validate() error {
+func (m *AdminLogoutResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClearIssueAISummariesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminLogoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ClearIssueAISummariesRequestMultiError, or nil if none found.
-func (m *ClearIssueAISummariesRequest) ValidateAll() error {
+// AdminLogoutResponseMultiError, or nil if none found.
+func (m *AdminLogoutResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClearIssueAISummariesRequest) validate(all bool) error {
+func (m *AdminLogoutResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Repo
+	// no validation rules for Success
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return ClearIssueAISummariesRequestMultiError(errors)
+		return AdminLogoutResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClearIssueAISummariesRequestMultiError is an error wrapping multiple
-// validation errors returned by ClearIssueAISummariesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ClearIssueAISummariesRequestMultiError []error
+// AdminLogoutResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminLogoutResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLogoutResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClearIssueAISummariesRequestMultiError) Error() string {
+func (m AdminLogoutResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3066,12 +11140,11 @@ func (m ClearIssueAISummariesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClearIssueAISummariesRequestMultiError) AllErrors() []error { return m }
+func (m AdminLogoutResponseMultiError) AllErrors() []error { return m }
 
-// ClearIssueAISummariesRequestValidationError is the validation error returned
-// by ClearIssueAISummariesRequest.Validate if the designated constraints
-// aren't met.
-type ClearIssueAISummariesRequestValidationError struct {
+// AdminLogoutResponseValidationError is the validation error returned by
+// AdminLogoutResponse.Validate if the designated constraints aren't met.
+type AdminLogoutResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3079,24 +11152,24 @@ type ClearIssueAISummariesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClearIssueAISummariesRequestValidationError) Field() string { return e.field }
+func (e AdminLogoutResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClearIssueAISummariesRequestValidationError) Reason() string { return e.reason }
+func (e AdminLogoutResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClearIssueAISummariesRequestValidationError) Cause() error { return e.cause }
+func (e AdminLogoutResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClearIssueAISummariesRequestValidationError) Key() bool { return e.key }
+func (e AdminLogoutResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClearIssueAISummariesRequestValidationError) ErrorName() string {
-	return "ClearIssueAISummariesRequestValidationError"
+func (e AdminLogoutResponseValidationError) ErrorName() string {
+	return "AdminLogoutResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ClearIssueAISummariesRequestValidationError) Error() string {
+func (e AdminLogoutResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3108,14 +11181,14 @@ func (e ClearIssueAISummariesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClearIssueAISummariesRequest.%s: %s%s",
+		"invalid %sAdminLogoutResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClearIssueAISummariesRequestValidationError{}
+var _ error = AdminLogoutResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3123,46 +11196,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClearIssueAISummariesRequestValidationError{}
+} = AdminLogoutResponseValidationError{}
 
-// Validate checks the field values on ClearIssueAISummariesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWhoAmIRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ClearIssueAISummariesResponse) Validate() error {
+func (m *AdminWhoAmIRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClearIssueAISummariesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ClearIssueAISummariesResponseMultiError, or nil if none found.
-func (m *ClearIssueAISummariesResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminWhoAmIRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWhoAmIRequestMultiError, or nil if none found.
+func (m *AdminWhoAmIRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClearIssueAISummariesResponse) validate(all bool) error {
+func (m *AdminWhoAmIRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cleared
-
 	if len(errors) > 0 {
-		return ClearIssueAISummariesResponseMultiError(errors)
+		return AdminWhoAmIRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClearIssueAISummariesResponseMultiError is an error wrapping multiple
-// validation errors returned by ClearIssueAISummariesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ClearIssueAISummariesResponseMultiError []error
+// AdminWhoAmIRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminWhoAmIRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWhoAmIRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClearIssueAISummariesResponseMultiError) Error() string {
+func (m AdminWhoAmIRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3171,12 +11242,11 @@ func (m ClearIssueAISummariesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClearIssueAISummariesResponseMultiError) AllErrors() []error { return m }
+func (m AdminWhoAmIRequestMultiError) AllErrors() []error { return m }
 
-// ClearIssueAISummariesResponseValidationError is the validation error
-// returned by ClearIssueAISummariesResponse.Validate if the designated
-// constraints aren't met.
-type ClearIssueAISummariesResponseValidationError struct {
+// AdminWhoAmIRequestValidationError is the validation error returned by
+// AdminWhoAmIRequest.Validate if the designated constraints aren't met.
+type AdminWhoAmIRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3184,24 +11254,24 @@ type ClearIssueAISummariesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClearIssueAISummariesResponseValidationError) Field() string { return e.field }
+func (e AdminWhoAmIRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClearIssueAISummariesResponseValidationError) Reason() string { return e.reason }
+func (e AdminWhoAmIRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClearIssueAISummariesResponseValidationError) Cause() error { return e.cause }
+func (e AdminWhoAmIRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClearIssueAISummariesResponseValidationError) Key() bool { return e.key }
+func (e AdminWhoAmIRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClearIssueAISummariesResponseValidationError) ErrorName() string {
-	return "ClearIssueAISummariesResponseValidationError"
+func (e AdminWhoAmIRequestValidationError) ErrorName() string {
+	return "AdminWhoAmIRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ClearIssueAISummariesResponseValidationError) Error() string {
+func (e AdminWhoAmIRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3213,14 +11283,14 @@ func (e ClearIssueAISummariesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClearIssueAISummariesResponse.%s: %s%s",
+		"invalid %sAdminWhoAmIRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClearIssueAISummariesResponseValidationError{}
+var _ error = AdminWhoAmIRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3228,24 +11298,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClearIssueAISummariesResponseValidationError{}
+} = AdminWhoAmIRequestValidationError{}
 
-// Validate checks the field values on AdminLoginRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginRequest) Validate() error {
+// Validate checks the field values on AdminWhoAmIResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWhoAmIResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginRequest with the rules
+// ValidateAll checks the field values on AdminWhoAmIResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLoginRequestMultiError, or nil if none found.
-func (m *AdminLoginRequest) ValidateAll() error {
+// AdminWhoAmIResponseMultiError, or nil if none found.
+func (m *AdminWhoAmIResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginRequest) validate(all bool) error {
+func (m *AdminWhoAmIResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3254,22 +11324,49 @@ func (m *AdminLoginRequest) validate(all bool) error {
 
 	// no validation rules for User
 
-	// no validation rules for Password
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWhoAmIResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWhoAmIResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWhoAmIResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminLoginRequestMultiError(errors)
+		return AdminWhoAmIResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminLoginRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLoginRequestMultiError []error
+// AdminWhoAmIResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminWhoAmIResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWhoAmIResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginRequestMultiError) Error() string {
+func (m AdminWhoAmIResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3278,11 +11375,11 @@ func (m AdminLoginRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginRequestMultiError) AllErrors() []error { return m }
+func (m AdminWhoAmIResponseMultiError) AllErrors() []error { return m }
 
-// AdminLoginRequestValidationError is the validation error returned by
-// AdminLoginRequest.Validate if the designated constraints aren't met.
-type AdminLoginRequestValidationError struct {
+// AdminWhoAmIResponseValidationError is the validation error returned by
+// AdminWhoAmIResponse.Validate if the designated constraints aren't met.
+type AdminWhoAmIResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3290,24 +11387,24 @@ type AdminLoginRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginRequestValidationError) Field() string { return e.field }
+func (e AdminWhoAmIResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginRequestValidationError) Reason() string { return e.reason }
+func (e AdminWhoAmIResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginRequestValidationError) Cause() error { return e.cause }
+func (e AdminWhoAmIResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginRequestValidationError) Key() bool { return e.key }
+func (e AdminWhoAmIResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginRequestValidationError) ErrorName() string {
-	return "AdminLoginRequestValidationError"
+func (e AdminWhoAmIResponseValidationError) ErrorName() string {
+	return "AdminWhoAmIResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLoginRequestValidationError) Error() string {
+func (e AdminWhoAmIResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3319,14 +11416,14 @@ func (e AdminLoginRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginRequest.%s: %s%s",
+		"invalid %sAdminWhoAmIResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginRequestValidationError{}
+var _ error = AdminWhoAmIResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3334,59 +11431,129 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginRequestValidationError{}
+} = AdminWhoAmIResponseValidationError{}
 
-// Validate checks the field values on AdminLoginResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLoginResponse) Validate() error {
+// Validate checks the field values on PRReview with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PRReview) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLoginResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLoginResponseMultiError, or nil if none found.
-func (m *AdminLoginResponse) ValidateAll() error {
+// ValidateAll checks the field values on PRReview with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PRReviewMultiError, or nil
+// if none found.
+func (m *PRReview) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLoginResponse) validate(all bool) error {
+func (m *PRReview) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for Id
 
-	// no validation rules for Message
+	// no validation rules for Repo
 
-	// no validation rules for Token
+	// no validation rules for IssueId
+
+	// no validation rules for Number
+
+	// no validation rules for ReviewSummary
+
+	// no validation rules for RiskAreas
+
+	// no validation rules for Suggestions
+
+	// no validation rules for RawDiffSize
+
+	// no validation rules for ModelUsed
 
 	if all {
-		switch v := interface{}(m.GetExpiresAt()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminLoginResponseValidationError{
-					field:  "ExpiresAt",
+				errors = append(errors, PRReviewValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminLoginResponseValidationError{
-					field:  "ExpiresAt",
+				errors = append(errors, PRReviewValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminLoginResponseValidationError{
-				field:  "ExpiresAt",
+			return PRReviewValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PRReviewValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PRReviewValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PRReviewValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPullRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PRReviewValidationError{
+					field:  "PullRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PRReviewValidationError{
+					field:  "PullRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPullRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PRReviewValidationError{
+				field:  "PullRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3394,19 +11561,18 @@ func (m *AdminLoginResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminLoginResponseMultiError(errors)
+		return PRReviewMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLoginResponseMultiError is an error wrapping multiple validation errors
-// returned by AdminLoginResponse.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLoginResponseMultiError []error
+// PRReviewMultiError is an error wrapping multiple validation errors returned
+// by PRReview.ValidateAll() if the designated constraints aren't met.
+type PRReviewMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLoginResponseMultiError) Error() string {
+func (m PRReviewMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3415,11 +11581,11 @@ func (m AdminLoginResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLoginResponseMultiError) AllErrors() []error { return m }
+func (m PRReviewMultiError) AllErrors() []error { return m }
 
-// AdminLoginResponseValidationError is the validation error returned by
-// AdminLoginResponse.Validate if the designated constraints aren't met.
-type AdminLoginResponseValidationError struct {
+// PRReviewValidationError is the validation error returned by
+// PRReview.Validate if the designated constraints aren't met.
+type PRReviewValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3427,24 +11593,22 @@ type AdminLoginResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLoginResponseValidationError) Field() string { return e.field }
+func (e PRReviewValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLoginResponseValidationError) Reason() string { return e.reason }
+func (e PRReviewValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLoginResponseValidationError) Cause() error { return e.cause }
+func (e PRReviewValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLoginResponseValidationError) Key() bool { return e.key }
+func (e PRReviewValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLoginResponseValidationError) ErrorName() string {
-	return "AdminLoginResponseValidationError"
-}
+func (e PRReviewValidationError) ErrorName() string { return "PRReviewValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminLoginResponseValidationError) Error() string {
+func (e PRReviewValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3456,14 +11620,14 @@ func (e AdminLoginResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLoginResponse.%s: %s%s",
+		"invalid %sPRReview.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLoginResponseValidationError{}
+var _ error = PRReviewValidationError{}
 
 var _ interface {
 	Field() string
@@ -3471,46 +11635,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLoginResponseValidationError{}
+} = PRReviewValidationError{}
 
-// Validate checks the field values on AdminLogoutRequest with the rules
+// Validate checks the field values on ListPRReviewsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLogoutRequest) Validate() error {
+func (m *ListPRReviewsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLogoutRequest with the rules
+// ValidateAll checks the field values on ListPRReviewsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLogoutRequestMultiError, or nil if none found.
-func (m *AdminLogoutRequest) ValidateAll() error {
+// ListPRReviewsRequestMultiError, or nil if none found.
+func (m *ListPRReviewsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLogoutRequest) validate(all bool) error {
+func (m *ListPRReviewsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	// no validation rules for Repo
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeTotal
 
 	if len(errors) > 0 {
-		return AdminLogoutRequestMultiError(errors)
+		return ListPRReviewsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLogoutRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminLogoutRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminLogoutRequestMultiError []error
+// ListPRReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPRReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPRReviewsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLogoutRequestMultiError) Error() string {
+func (m ListPRReviewsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3519,11 +11691,11 @@ func (m AdminLogoutRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLogoutRequestMultiError) AllErrors() []error { return m }
+func (m ListPRReviewsRequestMultiError) AllErrors() []error { return m }
 
-// AdminLogoutRequestValidationError is the validation error returned by
-// AdminLogoutRequest.Validate if the designated constraints aren't met.
-type AdminLogoutRequestValidationError struct {
+// ListPRReviewsRequestValidationError is the validation error returned by
+// ListPRReviewsRequest.Validate if the designated constraints aren't met.
+type ListPRReviewsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3531,24 +11703,24 @@ type AdminLogoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLogoutRequestValidationError) Field() string { return e.field }
+func (e ListPRReviewsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLogoutRequestValidationError) Reason() string { return e.reason }
+func (e ListPRReviewsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLogoutRequestValidationError) Cause() error { return e.cause }
+func (e ListPRReviewsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLogoutRequestValidationError) Key() bool { return e.key }
+func (e ListPRReviewsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLogoutRequestValidationError) ErrorName() string {
-	return "AdminLogoutRequestValidationError"
+func (e ListPRReviewsRequestValidationError) ErrorName() string {
+	return "ListPRReviewsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLogoutRequestValidationError) Error() string {
+func (e ListPRReviewsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3560,14 +11732,14 @@ func (e AdminLogoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLogoutRequest.%s: %s%s",
+		"invalid %sListPRReviewsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLogoutRequestValidationError{}
+var _ error = ListPRReviewsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3575,48 +11747,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLogoutRequestValidationError{}
+} = ListPRReviewsRequestValidationError{}
+
+// Validate checks the field values on ListPRReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPRReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPRReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPRReviewsResponseMultiError, or nil if none found.
+func (m *ListPRReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPRReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPRReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPRReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPRReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Validate checks the field values on AdminLogoutResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLogoutResponse) Validate() error {
-	return m.validate(false)
-}
+	}
 
-// ValidateAll checks the field values on AdminLogoutResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminLogoutResponseMultiError, or nil if none found.
-func (m *AdminLogoutResponse) ValidateAll() error {
-	return m.validate(true)
-}
+	// no validation rules for Page
 
-func (m *AdminLogoutResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	// no validation rules for PageSize
 
-	var errors []error
+	// no validation rules for HasNext
 
-	// no validation rules for Success
+	// no validation rules for NextPageToken
 
-	// no validation rules for Message
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return AdminLogoutResponseMultiError(errors)
+		return ListPRReviewsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLogoutResponseMultiError is an error wrapping multiple validation
-// errors returned by AdminLogoutResponse.ValidateAll() if the designated
+// ListPRReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPRReviewsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AdminLogoutResponseMultiError []error
+type ListPRReviewsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLogoutResponseMultiError) Error() string {
+func (m ListPRReviewsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3625,11 +11837,11 @@ func (m AdminLogoutResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLogoutResponseMultiError) AllErrors() []error { return m }
+func (m ListPRReviewsResponseMultiError) AllErrors() []error { return m }
 
-// AdminLogoutResponseValidationError is the validation error returned by
-// AdminLogoutResponse.Validate if the designated constraints aren't met.
-type AdminLogoutResponseValidationError struct {
+// ListPRReviewsResponseValidationError is the validation error returned by
+// ListPRReviewsResponse.Validate if the designated constraints aren't met.
+type ListPRReviewsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3637,24 +11849,24 @@ type AdminLogoutResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLogoutResponseValidationError) Field() string { return e.field }
+func (e ListPRReviewsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLogoutResponseValidationError) Reason() string { return e.reason }
+func (e ListPRReviewsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLogoutResponseValidationError) Cause() error { return e.cause }
+func (e ListPRReviewsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLogoutResponseValidationError) Key() bool { return e.key }
+func (e ListPRReviewsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLogoutResponseValidationError) ErrorName() string {
-	return "AdminLogoutResponseValidationError"
+func (e ListPRReviewsResponseValidationError) ErrorName() string {
+	return "ListPRReviewsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLogoutResponseValidationError) Error() string {
+func (e ListPRReviewsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3666,14 +11878,14 @@ func (e AdminLogoutResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLogoutResponse.%s: %s%s",
+		"invalid %sListPRReviewsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLogoutResponseValidationError{}
+var _ error = ListPRReviewsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3681,44 +11893,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLogoutResponseValidationError{}
+} = ListPRReviewsResponseValidationError{}
 
-// Validate checks the field values on AdminWhoAmIRequest with the rules
+// Validate checks the field values on GetPRReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWhoAmIRequest) Validate() error {
+func (m *GetPRReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWhoAmIRequest with the rules
+// ValidateAll checks the field values on GetPRReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWhoAmIRequestMultiError, or nil if none found.
-func (m *AdminWhoAmIRequest) ValidateAll() error {
+// GetPRReviewRequestMultiError, or nil if none found.
+func (m *GetPRReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWhoAmIRequest) validate(all bool) error {
+func (m *GetPRReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Repo
+
+	// no validation rules for Number
+
 	if len(errors) > 0 {
-		return AdminWhoAmIRequestMultiError(errors)
+		return GetPRReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWhoAmIRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminWhoAmIRequest.ValidateAll() if the designated constraints
+// GetPRReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPRReviewRequest.ValidateAll() if the designated constraints
 // aren't met.
-type AdminWhoAmIRequestMultiError []error
+type GetPRReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWhoAmIRequestMultiError) Error() string {
+func (m GetPRReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3727,11 +11943,11 @@ func (m AdminWhoAmIRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWhoAmIRequestMultiError) AllErrors() []error { return m }
+func (m GetPRReviewRequestMultiError) AllErrors() []error { return m }
 
-// AdminWhoAmIRequestValidationError is the validation error returned by
-// AdminWhoAmIRequest.Validate if the designated constraints aren't met.
-type AdminWhoAmIRequestValidationError struct {
+// GetPRReviewRequestValidationError is the validation error returned by
+// GetPRReviewRequest.Validate if the designated constraints aren't met.
+type GetPRReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3739,24 +11955,24 @@ type AdminWhoAmIRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWhoAmIRequestValidationError) Field() string { return e.field }
+func (e GetPRReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWhoAmIRequestValidationError) Reason() string { return e.reason }
+func (e GetPRReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWhoAmIRequestValidationError) Cause() error { return e.cause }
+func (e GetPRReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWhoAmIRequestValidationError) Key() bool { return e.key }
+func (e GetPRReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWhoAmIRequestValidationError) ErrorName() string {
-	return "AdminWhoAmIRequestValidationError"
+func (e GetPRReviewRequestValidationError) ErrorName() string {
+	return "GetPRReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWhoAmIRequestValidationError) Error() string {
+func (e GetPRReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3768,14 +11984,14 @@ func (e AdminWhoAmIRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWhoAmIRequest.%s: %s%s",
+		"invalid %sGetPRReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWhoAmIRequestValidationError{}
+var _ error = GetPRReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3783,55 +11999,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWhoAmIRequestValidationError{}
+} = GetPRReviewRequestValidationError{}
 
-// Validate checks the field values on AdminWhoAmIResponse with the rules
+// Validate checks the field values on GetPRReviewResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWhoAmIResponse) Validate() error {
+func (m *GetPRReviewResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWhoAmIResponse with the rules
+// ValidateAll checks the field values on GetPRReviewResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWhoAmIResponseMultiError, or nil if none found.
-func (m *AdminWhoAmIResponse) ValidateAll() error {
+// GetPRReviewResponseMultiError, or nil if none found.
+func (m *GetPRReviewResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWhoAmIResponse) validate(all bool) error {
+func (m *GetPRReviewResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for User
-
 	if all {
-		switch v := interface{}(m.GetExpiresAt()).(type) {
+		switch v := interface{}(m.GetReview()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminWhoAmIResponseValidationError{
-					field:  "ExpiresAt",
+				errors = append(errors, GetPRReviewResponseValidationError{
+					field:  "Review",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminWhoAmIResponseValidationError{
-					field:  "ExpiresAt",
+				errors = append(errors, GetPRReviewResponseValidationError{
+					field:  "Review",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AdminWhoAmIResponseValidationError{
-				field:  "ExpiresAt",
+			return GetPRReviewResponseValidationError{
+				field:  "Review",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3839,19 +12053,19 @@ func (m *AdminWhoAmIResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AdminWhoAmIResponseMultiError(errors)
+		return GetPRReviewResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWhoAmIResponseMultiError is an error wrapping multiple validation
-// errors returned by AdminWhoAmIResponse.ValidateAll() if the designated
+// GetPRReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPRReviewResponse.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWhoAmIResponseMultiError []error
+type GetPRReviewResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWhoAmIResponseMultiError) Error() string {
+func (m GetPRReviewResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3860,11 +12074,11 @@ func (m AdminWhoAmIResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWhoAmIResponseMultiError) AllErrors() []error { return m }
+func (m GetPRReviewResponseMultiError) AllErrors() []error { return m }
 
-// AdminWhoAmIResponseValidationError is the validation error returned by
-// AdminWhoAmIResponse.Validate if the designated constraints aren't met.
-type AdminWhoAmIResponseValidationError struct {
+// GetPRReviewResponseValidationError is the validation error returned by
+// GetPRReviewResponse.Validate if the designated constraints aren't met.
+type GetPRReviewResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3872,24 +12086,24 @@ type AdminWhoAmIResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWhoAmIResponseValidationError) Field() string { return e.field }
+func (e GetPRReviewResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWhoAmIResponseValidationError) Reason() string { return e.reason }
+func (e GetPRReviewResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWhoAmIResponseValidationError) Cause() error { return e.cause }
+func (e GetPRReviewResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWhoAmIResponseValidationError) Key() bool { return e.key }
+func (e GetPRReviewResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWhoAmIResponseValidationError) ErrorName() string {
-	return "AdminWhoAmIResponseValidationError"
+func (e GetPRReviewResponseValidationError) ErrorName() string {
+	return "GetPRReviewResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWhoAmIResponseValidationError) Error() string {
+func (e GetPRReviewResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3901,14 +12115,14 @@ func (e AdminWhoAmIResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWhoAmIResponse.%s: %s%s",
+		"invalid %sGetPRReviewResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWhoAmIResponseValidationError{}
+var _ error = GetPRReviewResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3916,71 +12130,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWhoAmIResponseValidationError{}
+} = GetPRReviewResponseValidationError{}
 
-// Validate checks the field values on PRReview with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PRReview) Validate() error {
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PRReview with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PRReviewMultiError, or nil
-// if none found.
-func (m *PRReview) ValidateAll() error {
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PRReview) validate(all bool) error {
+func (m *Job) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Repo
-
-	// no validation rules for IssueId
-
-	// no validation rules for Number
+	// no validation rules for Name
 
-	// no validation rules for ReviewSummary
+	// no validation rules for Schedule
 
-	// no validation rules for RiskAreas
+	// no validation rules for Overlap
 
-	// no validation rules for Suggestions
+	// no validation rules for Paused
 
-	// no validation rules for RawDiffSize
+	// no validation rules for Running
 
-	// no validation rules for ModelUsed
+	if all {
+		switch v := interface{}(m.GetNextRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobValidationError{
+				field:  "NextRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetLastStartedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PRReviewValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, JobValidationError{
+					field:  "LastStartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PRReviewValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, JobValidationError{
+					field:  "LastStartedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLastStartedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PRReviewValidationError{
-				field:  "CreatedAt",
+			return JobValidationError{
+				field:  "LastStartedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3988,47 +12222,53 @@ func (m *PRReview) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetLastFinishedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PRReviewValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, JobValidationError{
+					field:  "LastFinishedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PRReviewValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, JobValidationError{
+					field:  "LastFinishedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLastFinishedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PRReviewValidationError{
-				field:  "UpdatedAt",
+			return JobValidationError{
+				field:  "LastFinishedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for LastError
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for JitterSeconds
+
 	if len(errors) > 0 {
-		return PRReviewMultiError(errors)
+		return JobMultiError(errors)
 	}
 
 	return nil
 }
 
-// PRReviewMultiError is an error wrapping multiple validation errors returned
-// by PRReview.ValidateAll() if the designated constraints aren't met.
-type PRReviewMultiError []error
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PRReviewMultiError) Error() string {
+func (m JobMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4037,11 +12277,11 @@ func (m PRReviewMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PRReviewMultiError) AllErrors() []error { return m }
+func (m JobMultiError) AllErrors() []error { return m }
 
-// PRReviewValidationError is the validation error returned by
-// PRReview.Validate if the designated constraints aren't met.
-type PRReviewValidationError struct {
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4049,22 +12289,22 @@ type PRReviewValidationError struct {
 }
 
 // Field function returns field value.
-func (e PRReviewValidationError) Field() string { return e.field }
+func (e JobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PRReviewValidationError) Reason() string { return e.reason }
+func (e JobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PRReviewValidationError) Cause() error { return e.cause }
+func (e JobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PRReviewValidationError) Key() bool { return e.key }
+func (e JobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PRReviewValidationError) ErrorName() string { return "PRReviewValidationError" }
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PRReviewValidationError) Error() string {
+func (e JobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4076,14 +12316,14 @@ func (e PRReviewValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPRReview.%s: %s%s",
+		"invalid %sJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PRReviewValidationError{}
+var _ error = JobValidationError{}
 
 var _ interface {
 	Field() string
@@ -4091,50 +12331,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PRReviewValidationError{}
+} = JobValidationError{}
 
-// Validate checks the field values on ListPRReviewsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPRReviewsRequest) Validate() error {
+// Validate checks the field values on ListJobsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPRReviewsRequest with the rules
+// ValidateAll checks the field values on ListJobsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPRReviewsRequestMultiError, or nil if none found.
-func (m *ListPRReviewsRequest) ValidateAll() error {
+// ListJobsResponseMultiError, or nil if none found.
+func (m *ListJobsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPRReviewsRequest) validate(all bool) error {
+func (m *ListJobsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Repo
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
 
-	// no validation rules for Page
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for PageSize
+	}
 
 	if len(errors) > 0 {
-		return ListPRReviewsRequestMultiError(errors)
+		return ListJobsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPRReviewsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPRReviewsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListPRReviewsRequestMultiError []error
+// ListJobsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListJobsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPRReviewsRequestMultiError) Error() string {
+func (m ListJobsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4143,11 +12411,11 @@ func (m ListPRReviewsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPRReviewsRequestMultiError) AllErrors() []error { return m }
+func (m ListJobsResponseMultiError) AllErrors() []error { return m }
 
-// ListPRReviewsRequestValidationError is the validation error returned by
-// ListPRReviewsRequest.Validate if the designated constraints aren't met.
-type ListPRReviewsRequestValidationError struct {
+// ListJobsResponseValidationError is the validation error returned by
+// ListJobsResponse.Validate if the designated constraints aren't met.
+type ListJobsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4155,24 +12423,22 @@ type ListPRReviewsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPRReviewsRequestValidationError) Field() string { return e.field }
+func (e ListJobsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPRReviewsRequestValidationError) Reason() string { return e.reason }
+func (e ListJobsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPRReviewsRequestValidationError) Cause() error { return e.cause }
+func (e ListJobsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPRReviewsRequestValidationError) Key() bool { return e.key }
+func (e ListJobsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPRReviewsRequestValidationError) ErrorName() string {
-	return "ListPRReviewsRequestValidationError"
-}
+func (e ListJobsResponseValidationError) ErrorName() string { return "ListJobsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPRReviewsRequestValidationError) Error() string {
+func (e ListJobsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4184,14 +12450,14 @@ func (e ListPRReviewsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPRReviewsRequest.%s: %s%s",
+		"invalid %sListJobsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPRReviewsRequestValidationError{}
+var _ error = ListJobsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4199,84 +12465,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPRReviewsRequestValidationError{}
+} = ListJobsResponseValidationError{}
 
-// Validate checks the field values on ListPRReviewsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPRReviewsResponse) Validate() error {
+// Validate checks the field values on PauseJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPRReviewsResponse with the rules
+// ValidateAll checks the field values on PauseJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPRReviewsResponseMultiError, or nil if none found.
-func (m *ListPRReviewsResponse) ValidateAll() error {
+// PauseJobRequestMultiError, or nil if none found.
+func (m *PauseJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPRReviewsResponse) validate(all bool) error {
+func (m *PauseJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetReviews() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPRReviewsResponseValidationError{
-						field:  fmt.Sprintf("Reviews[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPRReviewsResponseValidationError{
-						field:  fmt.Sprintf("Reviews[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListPRReviewsResponseValidationError{
-					field:  fmt.Sprintf("Reviews[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
-
-	// no validation rules for HasNext
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return ListPRReviewsResponseMultiError(errors)
+		return PauseJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPRReviewsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListPRReviewsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListPRReviewsResponseMultiError []error
+// PauseJobRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPRReviewsResponseMultiError) Error() string {
+func (m PauseJobRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4285,11 +12513,11 @@ func (m ListPRReviewsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPRReviewsResponseMultiError) AllErrors() []error { return m }
+func (m PauseJobRequestMultiError) AllErrors() []error { return m }
 
-// ListPRReviewsResponseValidationError is the validation error returned by
-// ListPRReviewsResponse.Validate if the designated constraints aren't met.
-type ListPRReviewsResponseValidationError struct {
+// PauseJobRequestValidationError is the validation error returned by
+// PauseJobRequest.Validate if the designated constraints aren't met.
+type PauseJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4297,24 +12525,22 @@ type ListPRReviewsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPRReviewsResponseValidationError) Field() string { return e.field }
+func (e PauseJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPRReviewsResponseValidationError) Reason() string { return e.reason }
+func (e PauseJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPRReviewsResponseValidationError) Cause() error { return e.cause }
+func (e PauseJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPRReviewsResponseValidationError) Key() bool { return e.key }
+func (e PauseJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPRReviewsResponseValidationError) ErrorName() string {
-	return "ListPRReviewsResponseValidationError"
-}
+func (e PauseJobRequestValidationError) ErrorName() string { return "PauseJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListPRReviewsResponseValidationError) Error() string {
+func (e PauseJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4326,14 +12552,14 @@ func (e ListPRReviewsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPRReviewsResponse.%s: %s%s",
+		"invalid %sPauseJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPRReviewsResponseValidationError{}
+var _ error = PauseJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4341,48 +12567,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPRReviewsResponseValidationError{}
+} = PauseJobRequestValidationError{}
 
-// Validate checks the field values on GetPRReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPRReviewRequest) Validate() error {
+// Validate checks the field values on ResumeJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPRReviewRequest with the rules
+// ValidateAll checks the field values on ResumeJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPRReviewRequestMultiError, or nil if none found.
-func (m *GetPRReviewRequest) ValidateAll() error {
+// ResumeJobRequestMultiError, or nil if none found.
+func (m *ResumeJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPRReviewRequest) validate(all bool) error {
+func (m *ResumeJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Repo
-
-	// no validation rules for Number
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return GetPRReviewRequestMultiError(errors)
+		return ResumeJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPRReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by GetPRReviewRequest.ValidateAll() if the designated constraints
+// ResumeJobRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeJobRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetPRReviewRequestMultiError []error
+type ResumeJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPRReviewRequestMultiError) Error() string {
+func (m ResumeJobRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4391,11 +12615,11 @@ func (m GetPRReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPRReviewRequestMultiError) AllErrors() []error { return m }
+func (m ResumeJobRequestMultiError) AllErrors() []error { return m }
 
-// GetPRReviewRequestValidationError is the validation error returned by
-// GetPRReviewRequest.Validate if the designated constraints aren't met.
-type GetPRReviewRequestValidationError struct {
+// ResumeJobRequestValidationError is the validation error returned by
+// ResumeJobRequest.Validate if the designated constraints aren't met.
+type ResumeJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4403,24 +12627,22 @@ type GetPRReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPRReviewRequestValidationError) Field() string { return e.field }
+func (e ResumeJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPRReviewRequestValidationError) Reason() string { return e.reason }
+func (e ResumeJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPRReviewRequestValidationError) Cause() error { return e.cause }
+func (e ResumeJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPRReviewRequestValidationError) Key() bool { return e.key }
+func (e ResumeJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPRReviewRequestValidationError) ErrorName() string {
-	return "GetPRReviewRequestValidationError"
-}
+func (e ResumeJobRequestValidationError) ErrorName() string { return "ResumeJobRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetPRReviewRequestValidationError) Error() string {
+func (e ResumeJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4432,14 +12654,14 @@ func (e GetPRReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPRReviewRequest.%s: %s%s",
+		"invalid %sResumeJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPRReviewRequestValidationError{}
+var _ error = ResumeJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4447,73 +12669,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPRReviewRequestValidationError{}
+} = ResumeJobRequestValidationError{}
 
-// Validate checks the field values on GetPRReviewResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPRReviewResponse) Validate() error {
+// Validate checks the field values on TriggerJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TriggerJobRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPRReviewResponse with the rules
+// ValidateAll checks the field values on TriggerJobRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPRReviewResponseMultiError, or nil if none found.
-func (m *GetPRReviewResponse) ValidateAll() error {
+// TriggerJobRequestMultiError, or nil if none found.
+func (m *TriggerJobRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPRReviewResponse) validate(all bool) error {
+func (m *TriggerJobRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetReview()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetPRReviewResponseValidationError{
-					field:  "Review",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetPRReviewResponseValidationError{
-					field:  "Review",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetPRReviewResponseValidationError{
-				field:  "Review",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return GetPRReviewResponseMultiError(errors)
+		return TriggerJobRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPRReviewResponseMultiError is an error wrapping multiple validation
-// errors returned by GetPRReviewResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetPRReviewResponseMultiError []error
+// TriggerJobRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerJobRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPRReviewResponseMultiError) Error() string {
+func (m TriggerJobRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4522,11 +12717,11 @@ func (m GetPRReviewResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPRReviewResponseMultiError) AllErrors() []error { return m }
+func (m TriggerJobRequestMultiError) AllErrors() []error { return m }
 
-// GetPRReviewResponseValidationError is the validation error returned by
-// GetPRReviewResponse.Validate if the designated constraints aren't met.
-type GetPRReviewResponseValidationError struct {
+// TriggerJobRequestValidationError is the validation error returned by
+// TriggerJobRequest.Validate if the designated constraints aren't met.
+type TriggerJobRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4534,24 +12729,24 @@ type GetPRReviewResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPRReviewResponseValidationError) Field() string { return e.field }
+func (e TriggerJobRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPRReviewResponseValidationError) Reason() string { return e.reason }
+func (e TriggerJobRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPRReviewResponseValidationError) Cause() error { return e.cause }
+func (e TriggerJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPRReviewResponseValidationError) Key() bool { return e.key }
+func (e TriggerJobRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPRReviewResponseValidationError) ErrorName() string {
-	return "GetPRReviewResponseValidationError"
+func (e TriggerJobRequestValidationError) ErrorName() string {
+	return "TriggerJobRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetPRReviewResponseValidationError) Error() string {
+func (e TriggerJobRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4563,14 +12758,14 @@ func (e GetPRReviewResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPRReviewResponse.%s: %s%s",
+		"invalid %sTriggerJobRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPRReviewResponseValidationError{}
+var _ error = TriggerJobRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4578,4 +12773,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPRReviewResponseValidationError{}
+} = TriggerJobRequestValidationError{}
@@ -36,10 +36,22 @@ const _ = twirp.TwirpPackageMinVersion_8_1_0
 type IssueSyncAdminService interface {
 	SyncIssues(context.Context, *SyncIssuesRequest) (*SyncIssuesResponse, error)
 
+	WatchSyncJob(context.Context, *WatchSyncJobRequest) (*SyncJobEvent, error)
+
 	GetSyncConfig(context.Context, *google_protobuf1.Empty) (*GetSyncConfigResponse, error)
 
 	UpdateSyncConfig(context.Context, *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error)
 
+	GetIssueSummaryConfig(context.Context, *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error)
+
+	UpdateIssueSummaryConfig(context.Context, *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error)
+
+	GetPRReviewConfig(context.Context, *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error)
+
+	UpdatePRReviewConfig(context.Context, *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error)
+
+	ListSettingsChanges(context.Context, *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error)
+
 	ListManagedSyncRepos(context.Context, *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error)
 
 	ReplaceManagedSyncRepos(context.Context, *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error)
@@ -49,6 +61,30 @@ type IssueSyncAdminService interface {
 	UpdateIssueAISummary(context.Context, *UpdateIssueAISummaryRequest) (*GetIssueResponse, error)
 
 	ClearIssueAISummaries(context.Context, *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error)
+
+	ReconcileIssues(context.Context, *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error)
+
+	GetManagedSyncRepo(context.Context, *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error)
+
+	UpdateRepoSyncPolicy(context.Context, *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error)
+
+	DiscoverManagedSyncRepos(context.Context, *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error)
+
+	ResyncRepo(context.Context, *ResyncRepoRequest) (*ResyncJob, error)
+
+	GetResyncJob(context.Context, *GetResyncJobRequest) (*ResyncJob, error)
+
+	ListResyncJobs(context.Context, *google_protobuf1.Empty) (*ListResyncJobsResponse, error)
+
+	ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error)
+
+	GetSyncRun(context.Context, *GetSyncRunRequest) (*SyncRun, error)
+
+	MigrateIssueComments(context.Context, *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error)
+
+	ListIssueCommentVersions(context.Context, *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error)
+
+	RestoreIssueCommentVersion(context.Context, *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error)
 }
 
 // =====================================
@@ -57,7 +93,7 @@ type IssueSyncAdminService interface {
 
 type issueSyncAdminServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [8]string
+	urls        [26]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -85,15 +121,33 @@ func NewIssueSyncAdminServiceProtobufClient(baseURL string, client HTTPClient, o
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueSyncAdminService")
-	urls := [8]string{
+	urls := [26]string{
 		serviceURL + "SyncIssues",
+		serviceURL + "WatchSyncJob",
 		serviceURL + "GetSyncConfig",
 		serviceURL + "UpdateSyncConfig",
+		serviceURL + "GetIssueSummaryConfig",
+		serviceURL + "UpdateIssueSummaryConfig",
+		serviceURL + "GetPRReviewConfig",
+		serviceURL + "UpdatePRReviewConfig",
+		serviceURL + "ListSettingsChanges",
 		serviceURL + "ListManagedSyncRepos",
 		serviceURL + "ReplaceManagedSyncRepos",
 		serviceURL + "GetSyncStatus",
 		serviceURL + "UpdateIssueAISummary",
 		serviceURL + "ClearIssueAISummaries",
+		serviceURL + "ReconcileIssues",
+		serviceURL + "GetManagedSyncRepo",
+		serviceURL + "UpdateRepoSyncPolicy",
+		serviceURL + "DiscoverManagedSyncRepos",
+		serviceURL + "ResyncRepo",
+		serviceURL + "GetResyncJob",
+		serviceURL + "ListResyncJobs",
+		serviceURL + "ListSyncRuns",
+		serviceURL + "GetSyncRun",
+		serviceURL + "MigrateIssueComments",
+		serviceURL + "ListIssueCommentVersions",
+		serviceURL + "RestoreIssueCommentVersion",
 	}
 
 	return &issueSyncAdminServiceProtobufClient{
@@ -150,26 +204,26 @@ func (c *issueSyncAdminServiceProtobufClient) callSyncIssues(ctx context.Context
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) GetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
-	caller := c.callGetSyncConfig
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
+	caller := c.callWatchSyncJob
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+		caller = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*WatchSyncJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
 					}
-					return c.callGetSyncConfig(ctx, typedReq)
+					return c.callWatchSyncJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*SyncJobEvent)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -179,8 +233,8 @@ func (c *issueSyncAdminServiceProtobufClient) GetSyncConfig(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callGetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
-	out := new(GetSyncConfigResponse)
+func (c *issueSyncAdminServiceProtobufClient) callWatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	out := new(SyncJobEvent)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -196,20 +250,20 @@ func (c *issueSyncAdminServiceProtobufClient) callGetSyncConfig(ctx context.Cont
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) UpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) GetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
-	caller := c.callUpdateSyncConfig
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
+	caller := c.callGetSyncConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callUpdateSyncConfig(ctx, typedReq)
+					return c.callGetSyncConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -225,7 +279,7 @@ func (c *issueSyncAdminServiceProtobufClient) UpdateSyncConfig(ctx context.Conte
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callUpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) callGetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
 	out := new(GetSyncConfigResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
@@ -242,26 +296,26 @@ func (c *issueSyncAdminServiceProtobufClient) callUpdateSyncConfig(ctx context.C
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) ListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) UpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
-	caller := c.callListManagedSyncRepos
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
+	caller := c.callUpdateSyncConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+		caller = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*UpdateSyncConfigRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
 					}
-					return c.callListManagedSyncRepos(ctx, typedReq)
+					return c.callUpdateSyncConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*GetSyncConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -271,8 +325,8 @@ func (c *issueSyncAdminServiceProtobufClient) ListManagedSyncRepos(ctx context.C
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
-	out := new(ListManagedSyncReposResponse)
+func (c *issueSyncAdminServiceProtobufClient) callUpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+	out := new(GetSyncConfigResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -288,26 +342,26 @@ func (c *issueSyncAdminServiceProtobufClient) callListManagedSyncRepos(ctx conte
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) ReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) GetIssueSummaryConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
-	caller := c.callReplaceManagedSyncRepos
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueSummaryConfig")
+	caller := c.callGetIssueSummaryConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callReplaceManagedSyncRepos(ctx, typedReq)
+					return c.callGetIssueSummaryConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -317,8 +371,8 @@ func (c *issueSyncAdminServiceProtobufClient) ReplaceManagedSyncRepos(ctx contex
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
-	out := new(ListManagedSyncReposResponse)
+func (c *issueSyncAdminServiceProtobufClient) callGetIssueSummaryConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
+	out := new(GetIssueSummaryConfigResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -334,26 +388,26 @@ func (c *issueSyncAdminServiceProtobufClient) callReplaceManagedSyncRepos(ctx co
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) GetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) UpdateIssueSummaryConfig(ctx context.Context, in *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
-	caller := c.callGetSyncStatus
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueSummaryConfig")
+	caller := c.callUpdateIssueSummaryConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+		caller = func(ctx context.Context, req *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*UpdateIssueSummaryConfigRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueSummaryConfigRequest) when calling interceptor")
 					}
-					return c.callGetSyncStatus(ctx, typedReq)
+					return c.callUpdateIssueSummaryConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncStatusResponse)
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -363,8 +417,8 @@ func (c *issueSyncAdminServiceProtobufClient) GetSyncStatus(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callGetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
-	out := new(GetSyncStatusResponse)
+func (c *issueSyncAdminServiceProtobufClient) callUpdateIssueSummaryConfig(ctx context.Context, in *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+	out := new(GetIssueSummaryConfigResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -380,26 +434,26 @@ func (c *issueSyncAdminServiceProtobufClient) callGetSyncStatus(ctx context.Cont
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) UpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) GetPRReviewConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
-	caller := c.callUpdateIssueAISummary
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReviewConfig")
+	caller := c.callGetPRReviewConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callUpdateIssueAISummary(ctx, typedReq)
+					return c.callGetPRReviewConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -409,8 +463,8 @@ func (c *issueSyncAdminServiceProtobufClient) UpdateIssueAISummary(ctx context.C
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callUpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
-	out := new(GetIssueResponse)
+func (c *issueSyncAdminServiceProtobufClient) callGetPRReviewConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
+	out := new(GetPRReviewConfigResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -426,26 +480,26 @@ func (c *issueSyncAdminServiceProtobufClient) callUpdateIssueAISummary(ctx conte
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceProtobufClient) ClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) UpdatePRReviewConfig(ctx context.Context, in *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
-	caller := c.callClearIssueAISummaries
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePRReviewConfig")
+	caller := c.callUpdatePRReviewConfig
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+		caller = func(ctx context.Context, req *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					typedReq, ok := req.(*UpdatePRReviewConfigRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePRReviewConfigRequest) when calling interceptor")
 					}
-					return c.callClearIssueAISummaries(ctx, typedReq)
+					return c.callUpdatePRReviewConfig(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -455,8 +509,8 @@ func (c *issueSyncAdminServiceProtobufClient) ClearIssueAISummaries(ctx context.
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceProtobufClient) callClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
-	out := new(ClearIssueAISummariesResponse)
+func (c *issueSyncAdminServiceProtobufClient) callUpdatePRReviewConfig(ctx context.Context, in *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+	out := new(GetPRReviewConfigResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -472,79 +526,26 @@ func (c *issueSyncAdminServiceProtobufClient) callClearIssueAISummaries(ctx cont
 	return out, nil
 }
 
-// =================================
-// IssueSyncAdminService JSON Client
-// =================================
-
-type issueSyncAdminServiceJSONClient struct {
-	client      HTTPClient
-	urls        [8]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewIssueSyncAdminServiceJSONClient creates a JSON client that implements the IssueSyncAdminService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewIssueSyncAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) IssueSyncAdminService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
-
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueSyncAdminService")
-	urls := [8]string{
-		serviceURL + "SyncIssues",
-		serviceURL + "GetSyncConfig",
-		serviceURL + "UpdateSyncConfig",
-		serviceURL + "ListManagedSyncRepos",
-		serviceURL + "ReplaceManagedSyncRepos",
-		serviceURL + "GetSyncStatus",
-		serviceURL + "UpdateIssueAISummary",
-		serviceURL + "ClearIssueAISummaries",
-	}
-
-	return &issueSyncAdminServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
-}
-
-func (c *issueSyncAdminServiceJSONClient) SyncIssues(ctx context.Context, in *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) ListSettingsChanges(ctx context.Context, in *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "SyncIssues")
-	caller := c.callSyncIssues
+	ctx = ctxsetters.WithMethodName(ctx, "ListSettingsChanges")
+	caller := c.callListSettingsChanges
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+		caller = func(ctx context.Context, req *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SyncIssuesRequest)
+					typedReq, ok := req.(*ListSettingsChangesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SyncIssuesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListSettingsChangesRequest) when calling interceptor")
 					}
-					return c.callSyncIssues(ctx, typedReq)
+					return c.callListSettingsChanges(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SyncIssuesResponse)
+				typedResp, ok := resp.(*ListSettingsChangesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SyncIssuesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSettingsChangesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -554,9 +555,9 @@ func (c *issueSyncAdminServiceJSONClient) SyncIssues(ctx context.Context, in *Sy
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callSyncIssues(ctx context.Context, in *SyncIssuesRequest) (*SyncIssuesResponse, error) {
-	out := new(SyncIssuesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callListSettingsChanges(ctx context.Context, in *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+	out := new(ListSettingsChangesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -571,26 +572,26 @@ func (c *issueSyncAdminServiceJSONClient) callSyncIssues(ctx context.Context, in
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) GetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) ListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
-	caller := c.callGetSyncConfig
+	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
+	caller := c.callListManagedSyncRepos
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callGetSyncConfig(ctx, typedReq)
+					return c.callListManagedSyncRepos(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -600,9 +601,9 @@ func (c *issueSyncAdminServiceJSONClient) GetSyncConfig(ctx context.Context, in
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callGetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
-	out := new(GetSyncConfigResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+	out := new(ListManagedSyncReposResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -617,26 +618,26 @@ func (c *issueSyncAdminServiceJSONClient) callGetSyncConfig(ctx context.Context,
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) UpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) ReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
-	caller := c.callUpdateSyncConfig
+	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
+	caller := c.callReplaceManagedSyncRepos
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+		caller = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
 					}
-					return c.callUpdateSyncConfig(ctx, typedReq)
+					return c.callReplaceManagedSyncRepos(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -646,9 +647,9 @@ func (c *issueSyncAdminServiceJSONClient) UpdateSyncConfig(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callUpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
-	out := new(GetSyncConfigResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+	out := new(ListManagedSyncReposResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -663,26 +664,26 @@ func (c *issueSyncAdminServiceJSONClient) callUpdateSyncConfig(ctx context.Conte
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) ListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) GetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
-	caller := c.callListManagedSyncRepos
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
+	caller := c.callGetSyncStatus
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callListManagedSyncRepos(ctx, typedReq)
+					return c.callGetSyncStatus(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*GetSyncStatusResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -692,9 +693,9 @@ func (c *issueSyncAdminServiceJSONClient) ListManagedSyncRepos(ctx context.Conte
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
-	out := new(ListManagedSyncReposResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callGetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -709,26 +710,26 @@ func (c *issueSyncAdminServiceJSONClient) callListManagedSyncRepos(ctx context.C
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) ReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) UpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
-	caller := c.callReplaceManagedSyncRepos
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
+	caller := c.callUpdateIssueAISummary
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+		caller = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
 					}
-					return c.callReplaceManagedSyncRepos(ctx, typedReq)
+					return c.callUpdateIssueAISummary(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*GetIssueResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -738,9 +739,9 @@ func (c *issueSyncAdminServiceJSONClient) ReplaceManagedSyncRepos(ctx context.Co
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
-	out := new(ListManagedSyncReposResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callUpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+	out := new(GetIssueResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -755,26 +756,26 @@ func (c *issueSyncAdminServiceJSONClient) callReplaceManagedSyncRepos(ctx contex
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) GetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) ClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
-	caller := c.callGetSyncStatus
+	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
+	caller := c.callClearIssueAISummaries
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+		caller = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*ClearIssueAISummariesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
 					}
-					return c.callGetSyncStatus(ctx, typedReq)
+					return c.callClearIssueAISummaries(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncStatusResponse)
+				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -784,9 +785,9 @@ func (c *issueSyncAdminServiceJSONClient) GetSyncStatus(ctx context.Context, in
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callGetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
-	out := new(GetSyncStatusResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+	out := new(ClearIssueAISummariesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -801,26 +802,26 @@ func (c *issueSyncAdminServiceJSONClient) callGetSyncStatus(ctx context.Context,
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) UpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) ReconcileIssues(ctx context.Context, in *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
-	caller := c.callUpdateIssueAISummary
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcileIssues")
+	caller := c.callReconcileIssues
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+		caller = func(ctx context.Context, req *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					typedReq, ok := req.(*ReconcileIssuesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcileIssuesRequest) when calling interceptor")
 					}
-					return c.callUpdateIssueAISummary(ctx, typedReq)
+					return c.callReconcileIssues(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*ReconcileIssuesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcileIssuesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -830,9 +831,9 @@ func (c *issueSyncAdminServiceJSONClient) UpdateIssueAISummary(ctx context.Conte
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callUpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
-	out := new(GetIssueResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callReconcileIssues(ctx context.Context, in *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+	out := new(ReconcileIssuesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -847,26 +848,26 @@ func (c *issueSyncAdminServiceJSONClient) callUpdateIssueAISummary(ctx context.C
 	return out, nil
 }
 
-func (c *issueSyncAdminServiceJSONClient) ClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+func (c *issueSyncAdminServiceProtobufClient) GetManagedSyncRepo(ctx context.Context, in *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
-	caller := c.callClearIssueAISummaries
+	ctx = ctxsetters.WithMethodName(ctx, "GetManagedSyncRepo")
+	caller := c.callGetManagedSyncRepo
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+		caller = func(ctx context.Context, req *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					typedReq, ok := req.(*GetManagedSyncRepoRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetManagedSyncRepoRequest) when calling interceptor")
 					}
-					return c.callClearIssueAISummaries(ctx, typedReq)
+					return c.callGetManagedSyncRepo(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				typedResp, ok := resp.(*ManagedSyncRepo)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -876,9 +877,9 @@ func (c *issueSyncAdminServiceJSONClient) ClearIssueAISummaries(ctx context.Cont
 	return caller(ctx, in)
 }
 
-func (c *issueSyncAdminServiceJSONClient) callClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
-	out := new(ClearIssueAISummariesResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+func (c *issueSyncAdminServiceProtobufClient) callGetManagedSyncRepo(ctx context.Context, in *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+	out := new(ManagedSyncRepo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -893,315 +894,7504 @@ func (c *issueSyncAdminServiceJSONClient) callClearIssueAISummaries(ctx context.
 	return out, nil
 }
 
-// ====================================
-// IssueSyncAdminService Server Handler
-// ====================================
-
-type issueSyncAdminServiceServer struct {
-	IssueSyncAdminService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewIssueSyncAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewIssueSyncAdminServiceServer(svc IssueSyncAdminService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
-	}
-
-	return &issueSyncAdminServiceServer{
-		IssueSyncAdminService: svc,
-		hooks:                 serverOpts.Hooks,
-		interceptor:           twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:            pathPrefix,
-		jsonSkipDefaults:      jsonSkipDefaults,
-		jsonCamelCase:         jsonCamelCase,
-	}
-}
-
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *issueSyncAdminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
-
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *issueSyncAdminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
-	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
-	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
-
-// IssueSyncAdminServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const IssueSyncAdminServicePathPrefix = "/twirp/issues.v1.IssueSyncAdminService/"
-
-func (s *issueSyncAdminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
+func (c *issueSyncAdminServiceProtobufClient) UpdateRepoSyncPolicy(ctx context.Context, in *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
-
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "issues.v1.IssueSyncAdminService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-
-	switch method {
-	case "SyncIssues":
-		s.serveSyncIssues(ctx, resp, req)
-		return
-	case "GetSyncConfig":
-		s.serveGetSyncConfig(ctx, resp, req)
-		return
-	case "UpdateSyncConfig":
-		s.serveUpdateSyncConfig(ctx, resp, req)
-		return
-	case "ListManagedSyncRepos":
-		s.serveListManagedSyncRepos(ctx, resp, req)
-		return
-	case "ReplaceManagedSyncRepos":
-		s.serveReplaceManagedSyncRepos(ctx, resp, req)
-		return
-	case "GetSyncStatus":
-		s.serveGetSyncStatus(ctx, resp, req)
-		return
-	case "UpdateIssueAISummary":
-		s.serveUpdateIssueAISummary(ctx, resp, req)
-		return
-	case "ClearIssueAISummaries":
-		s.serveClearIssueAISummaries(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-}
-
-func (s *issueSyncAdminServiceServer) serveSyncIssues(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveSyncIssuesJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveSyncIssuesProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepoSyncPolicy")
+	caller := c.callUpdateRepoSyncPolicy
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepoSyncPolicyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepoSyncPolicyRequest) when calling interceptor")
+					}
+					return c.callUpdateRepoSyncPolicy(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
+	return caller(ctx, in)
 }
 
-func (s *issueSyncAdminServiceServer) serveSyncIssuesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SyncIssues")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *issueSyncAdminServiceProtobufClient) callUpdateRepoSyncPolicy(ctx context.Context, in *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+	out := new(ManagedSyncRepo)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(SyncIssuesRequest)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.IssueSyncAdminService.SyncIssues
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SyncIssuesRequest) (*SyncIssuesResponse, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) DiscoverManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DiscoverManagedSyncRepos")
+	caller := c.callDiscoverManagedSyncRepos
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SyncIssuesRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SyncIssuesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.SyncIssues(ctx, typedReq)
+					return c.callDiscoverManagedSyncRepos(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SyncIssuesResponse)
+				typedResp, ok := resp.(*DiscoverManagedSyncReposResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SyncIssuesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DiscoverManagedSyncReposResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *SyncIssuesResponse
+func (c *issueSyncAdminServiceProtobufClient) callDiscoverManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+	out := new(DiscoverManagedSyncReposResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) ResyncRepo(ctx context.Context, in *ResyncRepoRequest) (*ResyncJob, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResyncRepo")
+	caller := c.callResyncRepo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResyncRepoRequest) (*ResyncJob, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResyncRepoRequest) when calling interceptor")
+					}
+					return c.callResyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callResyncRepo(ctx context.Context, in *ResyncRepoRequest) (*ResyncJob, error) {
+	out := new(ResyncJob)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) GetResyncJob(ctx context.Context, in *GetResyncJobRequest) (*ResyncJob, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetResyncJob")
+	caller := c.callGetResyncJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetResyncJobRequest) (*ResyncJob, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetResyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetResyncJobRequest) when calling interceptor")
+					}
+					return c.callGetResyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callGetResyncJob(ctx context.Context, in *GetResyncJobRequest) (*ResyncJob, error) {
+	out := new(ResyncJob)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) ListResyncJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListResyncJobs")
+	caller := c.callListResyncJobs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callListResyncJobs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListResyncJobsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListResyncJobsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callListResyncJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+	out := new(ListResyncJobsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSyncRuns")
+	caller := c.callListSyncRuns
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSyncRunsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSyncRunsRequest) when calling interceptor")
+					}
+					return c.callListSyncRuns(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSyncRunsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSyncRunsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callListSyncRuns(ctx context.Context, in *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	out := new(ListSyncRunsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) GetSyncRun(ctx context.Context, in *GetSyncRunRequest) (*SyncRun, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncRun")
+	caller := c.callGetSyncRun
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSyncRunRequest) (*SyncRun, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSyncRunRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSyncRunRequest) when calling interceptor")
+					}
+					return c.callGetSyncRun(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRun)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRun) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callGetSyncRun(ctx context.Context, in *GetSyncRunRequest) (*SyncRun, error) {
+	out := new(SyncRun)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) MigrateIssueComments(ctx context.Context, in *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "MigrateIssueComments")
+	caller := c.callMigrateIssueComments
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MigrateIssueCommentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MigrateIssueCommentsRequest) when calling interceptor")
+					}
+					return c.callMigrateIssueComments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MigrateIssueCommentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MigrateIssueCommentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callMigrateIssueComments(ctx context.Context, in *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+	out := new(MigrateIssueCommentsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) ListIssueCommentVersions(ctx context.Context, in *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueCommentVersions")
+	caller := c.callListIssueCommentVersions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssueCommentVersionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueCommentVersionsRequest) when calling interceptor")
+					}
+					return c.callListIssueCommentVersions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssueCommentVersionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueCommentVersionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callListIssueCommentVersions(ctx context.Context, in *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+	out := new(ListIssueCommentVersionsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceProtobufClient) RestoreIssueCommentVersion(ctx context.Context, in *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreIssueCommentVersion")
+	caller := c.callRestoreIssueCommentVersion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreIssueCommentVersionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreIssueCommentVersionRequest) when calling interceptor")
+					}
+					return c.callRestoreIssueCommentVersion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreIssueCommentVersionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreIssueCommentVersionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceProtobufClient) callRestoreIssueCommentVersion(ctx context.Context, in *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+	out := new(RestoreIssueCommentVersionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =================================
+// IssueSyncAdminService JSON Client
+// =================================
+
+type issueSyncAdminServiceJSONClient struct {
+	client      HTTPClient
+	urls        [26]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewIssueSyncAdminServiceJSONClient creates a JSON client that implements the IssueSyncAdminService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewIssueSyncAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) IssueSyncAdminService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueSyncAdminService")
+	urls := [26]string{
+		serviceURL + "SyncIssues",
+		serviceURL + "WatchSyncJob",
+		serviceURL + "GetSyncConfig",
+		serviceURL + "UpdateSyncConfig",
+		serviceURL + "GetIssueSummaryConfig",
+		serviceURL + "UpdateIssueSummaryConfig",
+		serviceURL + "GetPRReviewConfig",
+		serviceURL + "UpdatePRReviewConfig",
+		serviceURL + "ListSettingsChanges",
+		serviceURL + "ListManagedSyncRepos",
+		serviceURL + "ReplaceManagedSyncRepos",
+		serviceURL + "GetSyncStatus",
+		serviceURL + "UpdateIssueAISummary",
+		serviceURL + "ClearIssueAISummaries",
+		serviceURL + "ReconcileIssues",
+		serviceURL + "GetManagedSyncRepo",
+		serviceURL + "UpdateRepoSyncPolicy",
+		serviceURL + "DiscoverManagedSyncRepos",
+		serviceURL + "ResyncRepo",
+		serviceURL + "GetResyncJob",
+		serviceURL + "ListResyncJobs",
+		serviceURL + "ListSyncRuns",
+		serviceURL + "GetSyncRun",
+		serviceURL + "MigrateIssueComments",
+		serviceURL + "ListIssueCommentVersions",
+		serviceURL + "RestoreIssueCommentVersion",
+	}
+
+	return &issueSyncAdminServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *issueSyncAdminServiceJSONClient) SyncIssues(ctx context.Context, in *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "SyncIssues")
+	caller := c.callSyncIssues
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncIssuesRequest) when calling interceptor")
+					}
+					return c.callSyncIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callSyncIssues(ctx context.Context, in *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+	out := new(SyncIssuesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
+	caller := c.callWatchSyncJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchSyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
+					}
+					return c.callWatchSyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncJobEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callWatchSyncJob(ctx context.Context, in *WatchSyncJobRequest) (*SyncJobEvent, error) {
+	out := new(SyncJobEvent)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
+	caller := c.callGetSyncConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callGetSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetSyncConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+	out := new(GetSyncConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) UpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
+	caller := c.callUpdateSyncConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+					}
+					return c.callUpdateSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callUpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+	out := new(GetSyncConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetIssueSummaryConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueSummaryConfig")
+	caller := c.callGetIssueSummaryConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callGetIssueSummaryConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetIssueSummaryConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
+	out := new(GetIssueSummaryConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) UpdateIssueSummaryConfig(ctx context.Context, in *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueSummaryConfig")
+	caller := c.callUpdateIssueSummaryConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateIssueSummaryConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueSummaryConfigRequest) when calling interceptor")
+					}
+					return c.callUpdateIssueSummaryConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callUpdateIssueSummaryConfig(ctx context.Context, in *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+	out := new(GetIssueSummaryConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetPRReviewConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReviewConfig")
+	caller := c.callGetPRReviewConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callGetPRReviewConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetPRReviewConfig(ctx context.Context, in *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
+	out := new(GetPRReviewConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) UpdatePRReviewConfig(ctx context.Context, in *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePRReviewConfig")
+	caller := c.callUpdatePRReviewConfig
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePRReviewConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePRReviewConfigRequest) when calling interceptor")
+					}
+					return c.callUpdatePRReviewConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callUpdatePRReviewConfig(ctx context.Context, in *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+	out := new(GetPRReviewConfigResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ListSettingsChanges(ctx context.Context, in *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSettingsChanges")
+	caller := c.callListSettingsChanges
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSettingsChangesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSettingsChangesRequest) when calling interceptor")
+					}
+					return c.callListSettingsChanges(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSettingsChangesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSettingsChangesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callListSettingsChanges(ctx context.Context, in *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+	out := new(ListSettingsChangesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
+	caller := c.callListManagedSyncRepos
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callListManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callListManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+	out := new(ListManagedSyncReposResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
+	caller := c.callReplaceManagedSyncRepos
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+					}
+					return c.callReplaceManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+	out := new(ListManagedSyncReposResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
+	caller := c.callGetSyncStatus
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callGetSyncStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetSyncStatus(ctx context.Context, in *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[11], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) UpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
+	caller := c.callUpdateIssueAISummary
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+					}
+					return c.callUpdateIssueAISummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callUpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+	out := new(GetIssueResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
+	caller := c.callClearIssueAISummaries
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+					}
+					return c.callClearIssueAISummaries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+	out := new(ClearIssueAISummariesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[13], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ReconcileIssues(ctx context.Context, in *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcileIssues")
+	caller := c.callReconcileIssues
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcileIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcileIssuesRequest) when calling interceptor")
+					}
+					return c.callReconcileIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcileIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcileIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callReconcileIssues(ctx context.Context, in *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+	out := new(ReconcileIssuesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[14], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetManagedSyncRepo(ctx context.Context, in *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetManagedSyncRepo")
+	caller := c.callGetManagedSyncRepo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetManagedSyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetManagedSyncRepoRequest) when calling interceptor")
+					}
+					return c.callGetManagedSyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetManagedSyncRepo(ctx context.Context, in *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+	out := new(ManagedSyncRepo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[15], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) UpdateRepoSyncPolicy(ctx context.Context, in *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepoSyncPolicy")
+	caller := c.callUpdateRepoSyncPolicy
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepoSyncPolicyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepoSyncPolicyRequest) when calling interceptor")
+					}
+					return c.callUpdateRepoSyncPolicy(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callUpdateRepoSyncPolicy(ctx context.Context, in *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+	out := new(ManagedSyncRepo)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[16], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) DiscoverManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "DiscoverManagedSyncRepos")
+	caller := c.callDiscoverManagedSyncRepos
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callDiscoverManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DiscoverManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DiscoverManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callDiscoverManagedSyncRepos(ctx context.Context, in *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+	out := new(DiscoverManagedSyncReposResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[17], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ResyncRepo(ctx context.Context, in *ResyncRepoRequest) (*ResyncJob, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResyncRepo")
+	caller := c.callResyncRepo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ResyncRepoRequest) (*ResyncJob, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResyncRepoRequest) when calling interceptor")
+					}
+					return c.callResyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callResyncRepo(ctx context.Context, in *ResyncRepoRequest) (*ResyncJob, error) {
+	out := new(ResyncJob)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[18], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetResyncJob(ctx context.Context, in *GetResyncJobRequest) (*ResyncJob, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetResyncJob")
+	caller := c.callGetResyncJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetResyncJobRequest) (*ResyncJob, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetResyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetResyncJobRequest) when calling interceptor")
+					}
+					return c.callGetResyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetResyncJob(ctx context.Context, in *GetResyncJobRequest) (*ResyncJob, error) {
+	out := new(ResyncJob)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[19], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ListResyncJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListResyncJobs")
+	caller := c.callListResyncJobs
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return c.callListResyncJobs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListResyncJobsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListResyncJobsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callListResyncJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+	out := new(ListResyncJobsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[20], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSyncRuns")
+	caller := c.callListSyncRuns
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSyncRunsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSyncRunsRequest) when calling interceptor")
+					}
+					return c.callListSyncRuns(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSyncRunsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSyncRunsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callListSyncRuns(ctx context.Context, in *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	out := new(ListSyncRunsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[21], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) GetSyncRun(ctx context.Context, in *GetSyncRunRequest) (*SyncRun, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncRun")
+	caller := c.callGetSyncRun
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetSyncRunRequest) (*SyncRun, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSyncRunRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSyncRunRequest) when calling interceptor")
+					}
+					return c.callGetSyncRun(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRun)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRun) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callGetSyncRun(ctx context.Context, in *GetSyncRunRequest) (*SyncRun, error) {
+	out := new(SyncRun)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[22], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) MigrateIssueComments(ctx context.Context, in *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "MigrateIssueComments")
+	caller := c.callMigrateIssueComments
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MigrateIssueCommentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MigrateIssueCommentsRequest) when calling interceptor")
+					}
+					return c.callMigrateIssueComments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MigrateIssueCommentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MigrateIssueCommentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callMigrateIssueComments(ctx context.Context, in *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+	out := new(MigrateIssueCommentsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[23], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) ListIssueCommentVersions(ctx context.Context, in *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueCommentVersions")
+	caller := c.callListIssueCommentVersions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssueCommentVersionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueCommentVersionsRequest) when calling interceptor")
+					}
+					return c.callListIssueCommentVersions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssueCommentVersionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueCommentVersionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callListIssueCommentVersions(ctx context.Context, in *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+	out := new(ListIssueCommentVersionsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[24], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceJSONClient) RestoreIssueCommentVersion(ctx context.Context, in *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreIssueCommentVersion")
+	caller := c.callRestoreIssueCommentVersion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreIssueCommentVersionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreIssueCommentVersionRequest) when calling interceptor")
+					}
+					return c.callRestoreIssueCommentVersion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreIssueCommentVersionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreIssueCommentVersionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueSyncAdminServiceJSONClient) callRestoreIssueCommentVersion(ctx context.Context, in *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+	out := new(RestoreIssueCommentVersionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[25], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ====================================
+// IssueSyncAdminService Server Handler
+// ====================================
+
+type issueSyncAdminServiceServer struct {
+	IssueSyncAdminService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewIssueSyncAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewIssueSyncAdminServiceServer(svc IssueSyncAdminService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &issueSyncAdminServiceServer{
+		IssueSyncAdminService: svc,
+		hooks:                 serverOpts.Hooks,
+		interceptor:           twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:            pathPrefix,
+		jsonSkipDefaults:      jsonSkipDefaults,
+		jsonCamelCase:         jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *issueSyncAdminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *issueSyncAdminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// IssueSyncAdminServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const IssueSyncAdminServicePathPrefix = "/twirp/issues.v1.IssueSyncAdminService/"
+
+func (s *issueSyncAdminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueSyncAdminService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "issues.v1.IssueSyncAdminService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "SyncIssues":
+		s.serveSyncIssues(ctx, resp, req)
+		return
+	case "WatchSyncJob":
+		s.serveWatchSyncJob(ctx, resp, req)
+		return
+	case "GetSyncConfig":
+		s.serveGetSyncConfig(ctx, resp, req)
+		return
+	case "UpdateSyncConfig":
+		s.serveUpdateSyncConfig(ctx, resp, req)
+		return
+	case "GetIssueSummaryConfig":
+		s.serveGetIssueSummaryConfig(ctx, resp, req)
+		return
+	case "UpdateIssueSummaryConfig":
+		s.serveUpdateIssueSummaryConfig(ctx, resp, req)
+		return
+	case "GetPRReviewConfig":
+		s.serveGetPRReviewConfig(ctx, resp, req)
+		return
+	case "UpdatePRReviewConfig":
+		s.serveUpdatePRReviewConfig(ctx, resp, req)
+		return
+	case "ListSettingsChanges":
+		s.serveListSettingsChanges(ctx, resp, req)
+		return
+	case "ListManagedSyncRepos":
+		s.serveListManagedSyncRepos(ctx, resp, req)
+		return
+	case "ReplaceManagedSyncRepos":
+		s.serveReplaceManagedSyncRepos(ctx, resp, req)
+		return
+	case "GetSyncStatus":
+		s.serveGetSyncStatus(ctx, resp, req)
+		return
+	case "UpdateIssueAISummary":
+		s.serveUpdateIssueAISummary(ctx, resp, req)
+		return
+	case "ClearIssueAISummaries":
+		s.serveClearIssueAISummaries(ctx, resp, req)
+		return
+	case "ReconcileIssues":
+		s.serveReconcileIssues(ctx, resp, req)
+		return
+	case "GetManagedSyncRepo":
+		s.serveGetManagedSyncRepo(ctx, resp, req)
+		return
+	case "UpdateRepoSyncPolicy":
+		s.serveUpdateRepoSyncPolicy(ctx, resp, req)
+		return
+	case "DiscoverManagedSyncRepos":
+		s.serveDiscoverManagedSyncRepos(ctx, resp, req)
+		return
+	case "ResyncRepo":
+		s.serveResyncRepo(ctx, resp, req)
+		return
+	case "GetResyncJob":
+		s.serveGetResyncJob(ctx, resp, req)
+		return
+	case "ListResyncJobs":
+		s.serveListResyncJobs(ctx, resp, req)
+		return
+	case "ListSyncRuns":
+		s.serveListSyncRuns(ctx, resp, req)
+		return
+	case "GetSyncRun":
+		s.serveGetSyncRun(ctx, resp, req)
+		return
+	case "MigrateIssueComments":
+		s.serveMigrateIssueComments(ctx, resp, req)
+		return
+	case "ListIssueCommentVersions":
+		s.serveListIssueCommentVersions(ctx, resp, req)
+		return
+	case "RestoreIssueCommentVersion":
+		s.serveRestoreIssueCommentVersion(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveSyncIssues(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSyncIssuesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSyncIssuesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveSyncIssuesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SyncIssues")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SyncIssuesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.SyncIssues
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncIssuesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.SyncIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncIssuesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncIssuesResponse and nil error while calling SyncIssues. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveSyncIssuesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SyncIssues")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SyncIssuesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.SyncIssues
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SyncIssuesRequest) (*SyncIssuesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SyncIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SyncIssuesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.SyncIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncIssuesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncIssuesResponse and nil error while calling SyncIssues. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveWatchSyncJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWatchSyncJobJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWatchSyncJobProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveWatchSyncJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WatchSyncJobRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.WatchSyncJob
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchSyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.WatchSyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncJobEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncJobEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncJobEvent and nil error while calling WatchSyncJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveWatchSyncJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WatchSyncJob")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WatchSyncJobRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.WatchSyncJob
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WatchSyncJobRequest) (*SyncJobEvent, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WatchSyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WatchSyncJobRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.WatchSyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncJobEvent)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncJobEvent) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncJobEvent
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncJobEvent and nil error while calling WatchSyncJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSyncConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSyncConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling GetSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling GetSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateSyncConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateSyncConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateSyncConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateSyncConfigRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling UpdateSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateSyncConfigRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateSyncConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateSyncConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSyncConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling UpdateSyncConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetIssueSummaryConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetIssueSummaryConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetIssueSummaryConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetIssueSummaryConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueSummaryConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetIssueSummaryConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetIssueSummaryConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetIssueSummaryConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueSummaryConfigResponse and nil error while calling GetIssueSummaryConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetIssueSummaryConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueSummaryConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetIssueSummaryConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetIssueSummaryConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetIssueSummaryConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetIssueSummaryConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueSummaryConfigResponse and nil error while calling GetIssueSummaryConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateIssueSummaryConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateIssueSummaryConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateIssueSummaryConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateIssueSummaryConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueSummaryConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateIssueSummaryConfigRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateIssueSummaryConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateIssueSummaryConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueSummaryConfigRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateIssueSummaryConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetIssueSummaryConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueSummaryConfigResponse and nil error while calling UpdateIssueSummaryConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateIssueSummaryConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueSummaryConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateIssueSummaryConfigRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateIssueSummaryConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateIssueSummaryConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueSummaryConfigRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateIssueSummaryConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueSummaryConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueSummaryConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetIssueSummaryConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueSummaryConfigResponse and nil error while calling UpdateIssueSummaryConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetPRReviewConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPRReviewConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPRReviewConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetPRReviewConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReviewConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetPRReviewConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetPRReviewConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPRReviewConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewConfigResponse and nil error while calling GetPRReviewConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetPRReviewConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReviewConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetPRReviewConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetPRReviewConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetPRReviewConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPRReviewConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewConfigResponse and nil error while calling GetPRReviewConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdatePRReviewConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdatePRReviewConfigJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdatePRReviewConfigProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdatePRReviewConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePRReviewConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdatePRReviewConfigRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdatePRReviewConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePRReviewConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePRReviewConfigRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdatePRReviewConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPRReviewConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewConfigResponse and nil error while calling UpdatePRReviewConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdatePRReviewConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdatePRReviewConfig")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdatePRReviewConfigRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdatePRReviewConfig
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdatePRReviewConfigRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdatePRReviewConfigRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdatePRReviewConfig(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPRReviewConfigResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewConfigResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPRReviewConfigResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewConfigResponse and nil error while calling UpdatePRReviewConfig. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListSettingsChanges(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListSettingsChangesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListSettingsChangesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveListSettingsChangesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSettingsChanges")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListSettingsChangesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListSettingsChanges
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSettingsChangesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSettingsChangesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListSettingsChanges(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSettingsChangesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSettingsChangesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListSettingsChangesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSettingsChangesResponse and nil error while calling ListSettingsChanges. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListSettingsChangesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSettingsChanges")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListSettingsChangesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListSettingsChanges
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSettingsChangesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSettingsChangesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListSettingsChanges(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSettingsChangesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSettingsChangesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListSettingsChangesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSettingsChangesResponse and nil error while calling ListSettingsChanges. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListManagedSyncRepos(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListManagedSyncReposJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListManagedSyncReposProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveListManagedSyncReposJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListManagedSyncRepos
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListManagedSyncReposResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ListManagedSyncRepos. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListManagedSyncReposProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListManagedSyncRepos
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListManagedSyncReposResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ListManagedSyncRepos. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncRepos(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReplaceManagedSyncReposJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReplaceManagedSyncReposProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReplaceManagedSyncReposRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ReplaceManagedSyncRepos
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ReplaceManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListManagedSyncReposResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ReplaceManagedSyncRepos. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReplaceManagedSyncReposRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ReplaceManagedSyncRepos
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ReplaceManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListManagedSyncReposResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ReplaceManagedSyncRepos. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSyncStatusJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSyncStatusProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetSyncStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetSyncStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSyncStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncStatusResponse and nil error while calling GetSyncStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetSyncStatus
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetSyncStatus(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetSyncStatusResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetSyncStatusResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncStatusResponse and nil error while calling GetSyncStatus. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummary(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateIssueAISummaryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateIssueAISummaryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateIssueAISummaryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateIssueAISummary
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateIssueAISummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetIssueResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling UpdateIssueAISummary. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateIssueAISummaryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateIssueAISummary
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateIssueAISummary(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetIssueResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling UpdateIssueAISummary. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveClearIssueAISummaries(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveClearIssueAISummariesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveClearIssueAISummariesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ClearIssueAISummariesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ClearIssueAISummaries
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ClearIssueAISummaries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClearIssueAISummariesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClearIssueAISummariesResponse and nil error while calling ClearIssueAISummaries. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ClearIssueAISummariesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ClearIssueAISummaries
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ClearIssueAISummaries(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ClearIssueAISummariesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClearIssueAISummariesResponse and nil error while calling ClearIssueAISummaries. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveReconcileIssues(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveReconcileIssuesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveReconcileIssuesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveReconcileIssuesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcileIssues")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ReconcileIssuesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ReconcileIssues
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcileIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcileIssuesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ReconcileIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcileIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcileIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReconcileIssuesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReconcileIssuesResponse and nil error while calling ReconcileIssues. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveReconcileIssuesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ReconcileIssues")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ReconcileIssuesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ReconcileIssues
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ReconcileIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ReconcileIssuesRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ReconcileIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ReconcileIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ReconcileIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ReconcileIssuesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ReconcileIssuesResponse and nil error while calling ReconcileIssues. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetManagedSyncRepo(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetManagedSyncRepoJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetManagedSyncRepoProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetManagedSyncRepoJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetManagedSyncRepo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetManagedSyncRepoRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetManagedSyncRepo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetManagedSyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetManagedSyncRepoRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetManagedSyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ManagedSyncRepo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ManagedSyncRepo and nil error while calling GetManagedSyncRepo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetManagedSyncRepoProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetManagedSyncRepo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetManagedSyncRepoRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetManagedSyncRepo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetManagedSyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetManagedSyncRepoRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetManagedSyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ManagedSyncRepo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ManagedSyncRepo and nil error while calling GetManagedSyncRepo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateRepoSyncPolicy(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateRepoSyncPolicyJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateRepoSyncPolicyProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateRepoSyncPolicyJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepoSyncPolicy")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateRepoSyncPolicyRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateRepoSyncPolicy
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepoSyncPolicyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepoSyncPolicyRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateRepoSyncPolicy(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ManagedSyncRepo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ManagedSyncRepo and nil error while calling UpdateRepoSyncPolicy. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveUpdateRepoSyncPolicyProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateRepoSyncPolicy")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateRepoSyncPolicyRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.UpdateRepoSyncPolicy
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateRepoSyncPolicyRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateRepoSyncPolicyRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.UpdateRepoSyncPolicy(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ManagedSyncRepo)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ManagedSyncRepo) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ManagedSyncRepo
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ManagedSyncRepo and nil error while calling UpdateRepoSyncPolicy. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveDiscoverManagedSyncRepos(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDiscoverManagedSyncReposJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDiscoverManagedSyncReposProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveDiscoverManagedSyncReposJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DiscoverManagedSyncRepos")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.DiscoverManagedSyncRepos
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.DiscoverManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DiscoverManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DiscoverManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DiscoverManagedSyncReposResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DiscoverManagedSyncReposResponse and nil error while calling DiscoverManagedSyncRepos. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveDiscoverManagedSyncReposProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DiscoverManagedSyncRepos")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.DiscoverManagedSyncRepos
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*DiscoverManagedSyncReposResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.DiscoverManagedSyncRepos(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DiscoverManagedSyncReposResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DiscoverManagedSyncReposResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DiscoverManagedSyncReposResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DiscoverManagedSyncReposResponse and nil error while calling DiscoverManagedSyncRepos. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveResyncRepo(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveResyncRepoJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveResyncRepoProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveResyncRepoJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResyncRepo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ResyncRepoRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ResyncRepo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResyncRepoRequest) (*ResyncJob, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResyncRepoRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ResyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResyncJob
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResyncJob and nil error while calling ResyncRepo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveResyncRepoProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ResyncRepo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ResyncRepoRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ResyncRepo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ResyncRepoRequest) (*ResyncJob, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ResyncRepoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ResyncRepoRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ResyncRepo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResyncJob
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResyncJob and nil error while calling ResyncRepo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetResyncJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetResyncJobJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetResyncJobProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetResyncJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetResyncJob")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetResyncJobRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetResyncJob
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetResyncJobRequest) (*ResyncJob, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetResyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetResyncJobRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetResyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResyncJob
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResyncJob and nil error while calling GetResyncJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetResyncJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetResyncJob")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetResyncJobRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetResyncJob
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetResyncJobRequest) (*ResyncJob, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetResyncJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetResyncJobRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetResyncJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ResyncJob)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ResyncJob) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ResyncJob
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ResyncJob and nil error while calling GetResyncJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListResyncJobs(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListResyncJobsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListResyncJobsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveListResyncJobsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListResyncJobs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListResyncJobs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListResyncJobs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListResyncJobsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListResyncJobsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListResyncJobsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListResyncJobsResponse and nil error while calling ListResyncJobs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListResyncJobsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListResyncJobs")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(google_protobuf1.Empty)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListResyncJobs
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListResyncJobsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*google_protobuf1.Empty)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListResyncJobs(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListResyncJobsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListResyncJobsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListResyncJobsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListResyncJobsResponse and nil error while calling ListResyncJobs. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListSyncRuns(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListSyncRunsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListSyncRunsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveListSyncRunsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSyncRuns")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListSyncRunsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListSyncRuns
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSyncRunsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSyncRunsRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListSyncRuns(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSyncRunsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSyncRunsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListSyncRunsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSyncRunsResponse and nil error while calling ListSyncRuns. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListSyncRunsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSyncRuns")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListSyncRunsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListSyncRuns
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSyncRunsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSyncRunsRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListSyncRuns(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSyncRunsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSyncRunsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListSyncRunsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSyncRunsResponse and nil error while calling ListSyncRuns. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncRun(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSyncRunJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSyncRunProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncRunJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncRun")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetSyncRunRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetSyncRun
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSyncRunRequest) (*SyncRun, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSyncRunRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSyncRunRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetSyncRun(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRun)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRun) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncRun
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncRun and nil error while calling GetSyncRun. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveGetSyncRunProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSyncRun")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetSyncRunRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.GetSyncRun
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetSyncRunRequest) (*SyncRun, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetSyncRunRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetSyncRunRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.GetSyncRun(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SyncRun)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SyncRun) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SyncRun
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncRun and nil error while calling GetSyncRun. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveMigrateIssueComments(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMigrateIssueCommentsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMigrateIssueCommentsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveMigrateIssueCommentsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MigrateIssueComments")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MigrateIssueCommentsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.MigrateIssueComments
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MigrateIssueCommentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MigrateIssueCommentsRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.MigrateIssueComments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MigrateIssueCommentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MigrateIssueCommentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MigrateIssueCommentsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MigrateIssueCommentsResponse and nil error while calling MigrateIssueComments. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveMigrateIssueCommentsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MigrateIssueComments")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MigrateIssueCommentsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.MigrateIssueComments
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MigrateIssueCommentsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MigrateIssueCommentsRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.MigrateIssueComments(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MigrateIssueCommentsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MigrateIssueCommentsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *MigrateIssueCommentsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MigrateIssueCommentsResponse and nil error while calling MigrateIssueComments. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListIssueCommentVersions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListIssueCommentVersionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListIssueCommentVersionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveListIssueCommentVersionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueCommentVersions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListIssueCommentVersionsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListIssueCommentVersions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssueCommentVersionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueCommentVersionsRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListIssueCommentVersions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssueCommentVersionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueCommentVersionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListIssueCommentVersionsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssueCommentVersionsResponse and nil error while calling ListIssueCommentVersions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveListIssueCommentVersionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueCommentVersions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListIssueCommentVersionsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.ListIssueCommentVersions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssueCommentVersionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueCommentVersionsRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.ListIssueCommentVersions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssueCommentVersionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueCommentVersionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListIssueCommentVersionsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssueCommentVersionsResponse and nil error while calling ListIssueCommentVersions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveRestoreIssueCommentVersion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRestoreIssueCommentVersionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRestoreIssueCommentVersionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *issueSyncAdminServiceServer) serveRestoreIssueCommentVersionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreIssueCommentVersion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RestoreIssueCommentVersionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.IssueSyncAdminService.RestoreIssueCommentVersion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreIssueCommentVersionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreIssueCommentVersionRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.RestoreIssueCommentVersion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreIssueCommentVersionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreIssueCommentVersionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RestoreIssueCommentVersionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RestoreIssueCommentVersionResponse and nil error while calling RestoreIssueCommentVersion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) serveRestoreIssueCommentVersionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreIssueCommentVersion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RestoreIssueCommentVersionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.IssueSyncAdminService.RestoreIssueCommentVersion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreIssueCommentVersionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreIssueCommentVersionRequest) when calling interceptor")
+					}
+					return s.IssueSyncAdminService.RestoreIssueCommentVersion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreIssueCommentVersionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreIssueCommentVersionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RestoreIssueCommentVersionResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
 	}()
 
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RestoreIssueCommentVersionResponse and nil error while calling RestoreIssueCommentVersion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *issueSyncAdminServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *issueSyncAdminServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *issueSyncAdminServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "issues.v1", "IssueSyncAdminService")
+}
+
+// ===========================
+// IssueQueryService Interface
+// ===========================
+
+type IssueQueryService interface {
+	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
+
+	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+
+	SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error)
+
+	ListIssueEvents(context.Context, *ListIssueEventsRequest) (*ListIssueEventsResponse, error)
+
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
+
+	ListMilestones(context.Context, *ListMilestonesRequest) (*ListMilestonesResponse, error)
+
+	GetIssueResponsiveness(context.Context, *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error)
+}
+
+// =================================
+// IssueQueryService Protobuf Client
+// =================================
+
+type issueQueryServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [7]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewIssueQueryServiceProtobufClient creates a Protobuf client that implements the IssueQueryService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewIssueQueryServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) IssueQueryService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueQueryService")
+	urls := [7]string{
+		serviceURL + "ListIssues",
+		serviceURL + "GetIssue",
+		serviceURL + "SearchIssues",
+		serviceURL + "ListIssueEvents",
+		serviceURL + "ListLabels",
+		serviceURL + "ListMilestones",
+		serviceURL + "GetIssueResponsiveness",
+	}
+
+	return &issueQueryServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *issueQueryServiceProtobufClient) ListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
+	caller := c.callListIssues
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
+					}
+					return c.callListIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
+	out := new(ListIssuesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceProtobufClient) GetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
+	caller := c.callGetIssue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetIssueRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
+					}
+					return c.callGetIssue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callGetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
+	out := new(GetIssueResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceProtobufClient) SearchIssues(ctx context.Context, in *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchIssues")
+	caller := c.callSearchIssues
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchIssuesRequest) when calling interceptor")
+					}
+					return c.callSearchIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callSearchIssues(ctx context.Context, in *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	out := new(SearchIssuesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceProtobufClient) ListIssueEvents(ctx context.Context, in *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueEvents")
+	caller := c.callListIssueEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssueEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueEventsRequest) when calling interceptor")
+					}
+					return c.callListIssueEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssueEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callListIssueEvents(ctx context.Context, in *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+	out := new(ListIssueEventsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceProtobufClient) ListLabels(ctx context.Context, in *ListLabelsRequest) (*ListLabelsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListLabels")
+	caller := c.callListLabels
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListLabelsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListLabelsRequest) when calling interceptor")
+					}
+					return c.callListLabels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListLabelsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLabelsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callListLabels(ctx context.Context, in *ListLabelsRequest) (*ListLabelsResponse, error) {
+	out := new(ListLabelsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceProtobufClient) ListMilestones(ctx context.Context, in *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListMilestones")
+	caller := c.callListMilestones
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListMilestonesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListMilestonesRequest) when calling interceptor")
+					}
+					return c.callListMilestones(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListMilestonesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMilestonesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callListMilestones(ctx context.Context, in *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+	out := new(ListMilestonesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceProtobufClient) GetIssueResponsiveness(ctx context.Context, in *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueResponsiveness")
+	caller := c.callGetIssueResponsiveness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetIssueResponsivenessRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueResponsivenessRequest) when calling interceptor")
+					}
+					return c.callGetIssueResponsiveness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponsivenessResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponsivenessResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceProtobufClient) callGetIssueResponsiveness(ctx context.Context, in *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+	out := new(GetIssueResponsivenessResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// IssueQueryService JSON Client
+// =============================
+
+type issueQueryServiceJSONClient struct {
+	client      HTTPClient
+	urls        [7]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewIssueQueryServiceJSONClient creates a JSON client that implements the IssueQueryService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewIssueQueryServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) IssueQueryService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueQueryService")
+	urls := [7]string{
+		serviceURL + "ListIssues",
+		serviceURL + "GetIssue",
+		serviceURL + "SearchIssues",
+		serviceURL + "ListIssueEvents",
+		serviceURL + "ListLabels",
+		serviceURL + "ListMilestones",
+		serviceURL + "GetIssueResponsiveness",
+	}
+
+	return &issueQueryServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *issueQueryServiceJSONClient) ListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
+	caller := c.callListIssues
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
+					}
+					return c.callListIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
+	out := new(ListIssuesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceJSONClient) GetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
+	caller := c.callGetIssue
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetIssueRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
+					}
+					return c.callGetIssue(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callGetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
+	out := new(GetIssueResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceJSONClient) SearchIssues(ctx context.Context, in *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchIssues")
+	caller := c.callSearchIssues
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchIssuesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchIssuesRequest) when calling interceptor")
+					}
+					return c.callSearchIssues(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchIssuesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchIssuesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callSearchIssues(ctx context.Context, in *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	out := new(SearchIssuesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceJSONClient) ListIssueEvents(ctx context.Context, in *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueEvents")
+	caller := c.callListIssueEvents
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListIssueEventsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueEventsRequest) when calling interceptor")
+					}
+					return c.callListIssueEvents(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListIssueEventsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueEventsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callListIssueEvents(ctx context.Context, in *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+	out := new(ListIssueEventsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceJSONClient) ListLabels(ctx context.Context, in *ListLabelsRequest) (*ListLabelsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListLabels")
+	caller := c.callListLabels
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListLabelsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListLabelsRequest) when calling interceptor")
+					}
+					return c.callListLabels(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListLabelsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLabelsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callListLabels(ctx context.Context, in *ListLabelsRequest) (*ListLabelsResponse, error) {
+	out := new(ListLabelsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceJSONClient) ListMilestones(ctx context.Context, in *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListMilestones")
+	caller := c.callListMilestones
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListMilestonesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListMilestonesRequest) when calling interceptor")
+					}
+					return c.callListMilestones(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListMilestonesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMilestonesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callListMilestones(ctx context.Context, in *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+	out := new(ListMilestonesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *issueQueryServiceJSONClient) GetIssueResponsiveness(ctx context.Context, in *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueResponsiveness")
+	caller := c.callGetIssueResponsiveness
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetIssueResponsivenessRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueResponsivenessRequest) when calling interceptor")
+					}
+					return c.callGetIssueResponsiveness(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetIssueResponsivenessResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponsivenessResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *issueQueryServiceJSONClient) callGetIssueResponsiveness(ctx context.Context, in *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+	out := new(GetIssueResponsivenessResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ================================
+// IssueQueryService Server Handler
+// ================================
+
+type issueQueryServiceServer struct {
+	IssueQueryService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewIssueQueryServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewIssueQueryServiceServer(svc IssueQueryService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncIssuesResponse and nil error while calling SyncIssues. nil responses are not supported"))
-		return
+
+	return &issueQueryServiceServer{
+		IssueQueryService: svc,
+		hooks:             serverOpts.Hooks,
+		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:        pathPrefix,
+		jsonSkipDefaults:  jsonSkipDefaults,
+		jsonCamelCase:     jsonCamelCase,
 	}
+}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *issueQueryServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
 
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *issueQueryServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
 	}
-
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
 	}
-	callResponseSent(ctx, s.hooks)
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-func (s *issueSyncAdminServiceServer) serveSyncIssuesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+// IssueQueryServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const IssueQueryServicePathPrefix = "/twirp/issues.v1.IssueQueryService/"
+
+func (s *issueQueryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "SyncIssues")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
 
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(SyncIssuesRequest)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.SyncIssues
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *SyncIssuesRequest) (*SyncIssuesResponse, error) {
-			resp, err := s.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*SyncIssuesRequest)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*SyncIssuesRequest) when calling interceptor")
-					}
-					return s.IssueSyncAdminService.SyncIssues(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*SyncIssuesResponse)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SyncIssuesResponse) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
-	}
-
-	// Call service method
-	var respContent *SyncIssuesResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "issues.v1.IssueQueryService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SyncIssuesResponse and nil error while calling SyncIssues. nil responses are not supported"))
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+	switch method {
+	case "ListIssues":
+		s.serveListIssues(ctx, resp, req)
+		return
+	case "GetIssue":
+		s.serveGetIssue(ctx, resp, req)
+		return
+	case "SearchIssues":
+		s.serveSearchIssues(ctx, resp, req)
+		return
+	case "ListIssueEvents":
+		s.serveListIssueEvents(ctx, resp, req)
+		return
+	case "ListLabels":
+		s.serveListLabels(ctx, resp, req)
+		return
+	case "ListMilestones":
+		s.serveListMilestones(ctx, resp, req)
+		return
+	case "GetIssueResponsiveness":
+		s.serveGetIssueResponsiveness(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
 	}
-
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveGetSyncConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListIssues(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1209,9 +8399,9 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfig(ctx context.Context, re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetSyncConfigJSON(ctx, resp, req)
+		s.serveListIssuesJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetSyncConfigProtobuf(ctx, resp, req)
+		s.serveListIssuesProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1219,9 +8409,9 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfig(ctx context.Context, re
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveGetSyncConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListIssuesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1234,29 +8424,29 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigJSON(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(ListIssuesRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.GetSyncConfig
+	handler := s.IssueQueryService.ListIssues
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+		handler = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*ListIssuesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.GetSyncConfig(ctx, typedReq)
+					return s.IssueQueryService.ListIssues(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*ListIssuesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1265,7 +8455,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigJSON(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *GetSyncConfigResponse
+	var respContent *ListIssuesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1276,7 +8466,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigJSON(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling GetSyncConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssuesResponse and nil error while calling ListIssues. nil responses are not supported"))
 		return
 	}
 
@@ -1302,9 +8492,9 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigJSON(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveGetSyncConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListIssuesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1316,28 +8506,28 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigProtobuf(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(ListIssuesRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.GetSyncConfig
+	handler := s.IssueQueryService.ListIssues
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncConfigResponse, error) {
+		handler = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*ListIssuesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.GetSyncConfig(ctx, typedReq)
+					return s.IssueQueryService.ListIssues(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*ListIssuesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1346,7 +8536,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigProtobuf(ctx context.Con
 	}
 
 	// Call service method
-	var respContent *GetSyncConfigResponse
+	var respContent *ListIssuesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1357,7 +8547,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigProtobuf(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling GetSyncConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssuesResponse and nil error while calling ListIssues. nil responses are not supported"))
 		return
 	}
 
@@ -1381,7 +8571,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncConfigProtobuf(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveUpdateSyncConfig(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveGetIssue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1389,9 +8579,9 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfig(ctx context.Context,
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUpdateSyncConfigJSON(ctx, resp, req)
+		s.serveGetIssueJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUpdateSyncConfigProtobuf(ctx, resp, req)
+		s.serveGetIssueProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1399,9 +8589,9 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfig(ctx context.Context,
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveGetIssueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1414,29 +8604,29 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigJSON(ctx context.Cont
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UpdateSyncConfigRequest)
+	reqContent := new(GetIssueRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.UpdateSyncConfig
+	handler := s.IssueQueryService.GetIssue
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+		handler = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					typedReq, ok := req.(*GetIssueRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.UpdateSyncConfig(ctx, typedReq)
+					return s.IssueQueryService.GetIssue(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*GetIssueResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1445,7 +8635,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigJSON(ctx context.Cont
 	}
 
 	// Call service method
-	var respContent *GetSyncConfigResponse
+	var respContent *GetIssueResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1456,7 +8646,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigJSON(ctx context.Cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling UpdateSyncConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling GetIssue. nil responses are not supported"))
 		return
 	}
 
@@ -1482,9 +8672,9 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigJSON(ctx context.Cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveGetIssueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateSyncConfig")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1496,28 +8686,28 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigProtobuf(ctx context.
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UpdateSyncConfigRequest)
+	reqContent := new(GetIssueRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.UpdateSyncConfig
+	handler := s.IssueQueryService.GetIssue
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
+		handler = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateSyncConfigRequest)
+					typedReq, ok := req.(*GetIssueRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateSyncConfigRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.UpdateSyncConfig(ctx, typedReq)
+					return s.IssueQueryService.GetIssue(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncConfigResponse)
+				typedResp, ok := resp.(*GetIssueResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncConfigResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1526,7 +8716,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigProtobuf(ctx context.
 	}
 
 	// Call service method
-	var respContent *GetSyncConfigResponse
+	var respContent *GetIssueResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1537,7 +8727,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigProtobuf(ctx context.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncConfigResponse and nil error while calling UpdateSyncConfig. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling GetIssue. nil responses are not supported"))
 		return
 	}
 
@@ -1561,7 +8751,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateSyncConfigProtobuf(ctx context.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveListManagedSyncRepos(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveSearchIssues(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1569,9 +8759,9 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncRepos(ctx context.Cont
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveListManagedSyncReposJSON(ctx, resp, req)
+		s.serveSearchIssuesJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveListManagedSyncReposProtobuf(ctx, resp, req)
+		s.serveSearchIssuesProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1579,9 +8769,9 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncRepos(ctx context.Cont
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveListManagedSyncReposJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveSearchIssuesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchIssues")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1594,29 +8784,29 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposJSON(ctx context.
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(SearchIssuesRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.ListManagedSyncRepos
+	handler := s.IssueQueryService.SearchIssues
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+		handler = func(ctx context.Context, req *SearchIssuesRequest) (*SearchIssuesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*SearchIssuesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchIssuesRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.ListManagedSyncRepos(ctx, typedReq)
+					return s.IssueQueryService.SearchIssues(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*SearchIssuesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchIssuesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1625,7 +8815,7 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposJSON(ctx context.
 	}
 
 	// Call service method
-	var respContent *ListManagedSyncReposResponse
+	var respContent *SearchIssuesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1636,7 +8826,7 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposJSON(ctx context.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ListManagedSyncRepos. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchIssuesResponse and nil error while calling SearchIssues. nil responses are not supported"))
 		return
 	}
 
@@ -1662,9 +8852,9 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposJSON(ctx context.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveListManagedSyncReposProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveSearchIssuesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListManagedSyncRepos")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchIssues")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1676,28 +8866,28 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposProtobuf(ctx cont
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(SearchIssuesRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.ListManagedSyncRepos
+	handler := s.IssueQueryService.SearchIssues
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListManagedSyncReposResponse, error) {
+		handler = func(ctx context.Context, req *SearchIssuesRequest) (*SearchIssuesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*SearchIssuesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchIssuesRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.ListManagedSyncRepos(ctx, typedReq)
+					return s.IssueQueryService.SearchIssues(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*SearchIssuesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchIssuesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1706,7 +8896,7 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposProtobuf(ctx cont
 	}
 
 	// Call service method
-	var respContent *ListManagedSyncReposResponse
+	var respContent *SearchIssuesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1717,7 +8907,7 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposProtobuf(ctx cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ListManagedSyncRepos. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchIssuesResponse and nil error while calling SearchIssues. nil responses are not supported"))
 		return
 	}
 
@@ -1741,7 +8931,7 @@ func (s *issueSyncAdminServiceServer) serveListManagedSyncReposProtobuf(ctx cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncRepos(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListIssueEvents(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1749,9 +8939,9 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncRepos(ctx context.C
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveReplaceManagedSyncReposJSON(ctx, resp, req)
+		s.serveListIssueEventsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveReplaceManagedSyncReposProtobuf(ctx, resp, req)
+		s.serveListIssueEventsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1759,9 +8949,9 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncRepos(ctx context.C
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListIssueEventsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueEvents")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1774,29 +8964,29 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposJSON(ctx conte
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ReplaceManagedSyncReposRequest)
+	reqContent := new(ListIssueEventsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.ReplaceManagedSyncRepos
+	handler := s.IssueQueryService.ListIssueEvents
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+		handler = func(ctx context.Context, req *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					typedReq, ok := req.(*ListIssueEventsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueEventsRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.ReplaceManagedSyncRepos(ctx, typedReq)
+					return s.IssueQueryService.ListIssueEvents(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*ListIssueEventsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueEventsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1805,7 +8995,7 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposJSON(ctx conte
 	}
 
 	// Call service method
-	var respContent *ListManagedSyncReposResponse
+	var respContent *ListIssueEventsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1816,7 +9006,7 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposJSON(ctx conte
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ReplaceManagedSyncRepos. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssueEventsResponse and nil error while calling ListIssueEvents. nil responses are not supported"))
 		return
 	}
 
@@ -1842,9 +9032,9 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposJSON(ctx conte
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListIssueEventsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ReplaceManagedSyncRepos")
+	ctx = ctxsetters.WithMethodName(ctx, "ListIssueEvents")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1856,28 +9046,28 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposProtobuf(ctx c
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ReplaceManagedSyncReposRequest)
+	reqContent := new(ListIssueEventsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.ReplaceManagedSyncRepos
+	handler := s.IssueQueryService.ListIssueEvents
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error) {
+		handler = func(ctx context.Context, req *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ReplaceManagedSyncReposRequest)
+					typedReq, ok := req.(*ListIssueEventsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ReplaceManagedSyncReposRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListIssueEventsRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.ReplaceManagedSyncRepos(ctx, typedReq)
+					return s.IssueQueryService.ListIssueEvents(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListManagedSyncReposResponse)
+				typedResp, ok := resp.(*ListIssueEventsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListManagedSyncReposResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListIssueEventsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1886,7 +9076,7 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposProtobuf(ctx c
 	}
 
 	// Call service method
-	var respContent *ListManagedSyncReposResponse
+	var respContent *ListIssueEventsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1897,7 +9087,7 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposProtobuf(ctx c
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListManagedSyncReposResponse and nil error while calling ReplaceManagedSyncRepos. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssueEventsResponse and nil error while calling ListIssueEvents. nil responses are not supported"))
 		return
 	}
 
@@ -1921,7 +9111,7 @@ func (s *issueSyncAdminServiceServer) serveReplaceManagedSyncReposProtobuf(ctx c
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveGetSyncStatus(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListLabels(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1929,9 +9119,9 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatus(ctx context.Context, re
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetSyncStatusJSON(ctx, resp, req)
+		s.serveListLabelsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetSyncStatusProtobuf(ctx, resp, req)
+		s.serveListLabelsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1939,9 +9129,9 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatus(ctx context.Context, re
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveGetSyncStatusJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListLabelsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "ListLabels")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1954,29 +9144,29 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusJSON(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(ListLabelsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.GetSyncStatus
+	handler := s.IssueQueryService.ListLabels
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+		handler = func(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*ListLabelsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListLabelsRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.GetSyncStatus(ctx, typedReq)
+					return s.IssueQueryService.ListLabels(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncStatusResponse)
+				typedResp, ok := resp.(*ListLabelsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLabelsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1985,7 +9175,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusJSON(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *GetSyncStatusResponse
+	var respContent *ListLabelsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1996,7 +9186,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusJSON(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncStatusResponse and nil error while calling GetSyncStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListLabelsResponse and nil error while calling ListLabels. nil responses are not supported"))
 		return
 	}
 
@@ -2022,9 +9212,9 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusJSON(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveGetSyncStatusProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListLabelsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetSyncStatus")
+	ctx = ctxsetters.WithMethodName(ctx, "ListLabels")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2036,28 +9226,28 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusProtobuf(ctx context.Con
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(google_protobuf1.Empty)
+	reqContent := new(ListLabelsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.GetSyncStatus
+	handler := s.IssueQueryService.ListLabels
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*GetSyncStatusResponse, error) {
+		handler = func(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*google_protobuf1.Empty)
+					typedReq, ok := req.(*ListLabelsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListLabelsRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.GetSyncStatus(ctx, typedReq)
+					return s.IssueQueryService.ListLabels(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetSyncStatusResponse)
+				typedResp, ok := resp.(*ListLabelsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetSyncStatusResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListLabelsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2066,7 +9256,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusProtobuf(ctx context.Con
 	}
 
 	// Call service method
-	var respContent *GetSyncStatusResponse
+	var respContent *ListLabelsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2077,7 +9267,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusProtobuf(ctx context.Con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetSyncStatusResponse and nil error while calling GetSyncStatus. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListLabelsResponse and nil error while calling ListLabels. nil responses are not supported"))
 		return
 	}
 
@@ -2101,7 +9291,7 @@ func (s *issueSyncAdminServiceServer) serveGetSyncStatusProtobuf(ctx context.Con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummary(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListMilestones(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2109,9 +9299,9 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummary(ctx context.Cont
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveUpdateIssueAISummaryJSON(ctx, resp, req)
+		s.serveListMilestonesJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveUpdateIssueAISummaryProtobuf(ctx, resp, req)
+		s.serveListMilestonesProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2119,9 +9309,9 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummary(ctx context.Cont
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListMilestonesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
+	ctx = ctxsetters.WithMethodName(ctx, "ListMilestones")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2134,29 +9324,29 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryJSON(ctx context.
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(UpdateIssueAISummaryRequest)
+	reqContent := new(ListMilestonesRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.UpdateIssueAISummary
+	handler := s.IssueQueryService.ListMilestones
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+		handler = func(ctx context.Context, req *ListMilestonesRequest) (*ListMilestonesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					typedReq, ok := req.(*ListMilestonesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListMilestonesRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.UpdateIssueAISummary(ctx, typedReq)
+					return s.IssueQueryService.ListMilestones(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*ListMilestonesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMilestonesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2165,7 +9355,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryJSON(ctx context.
 	}
 
 	// Call service method
-	var respContent *GetIssueResponse
+	var respContent *ListMilestonesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2176,7 +9366,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryJSON(ctx context.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling UpdateIssueAISummary. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListMilestonesResponse and nil error while calling ListMilestones. nil responses are not supported"))
 		return
 	}
 
@@ -2202,9 +9392,9 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryJSON(ctx context.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveListMilestonesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "UpdateIssueAISummary")
+	ctx = ctxsetters.WithMethodName(ctx, "ListMilestones")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2216,28 +9406,28 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryProtobuf(ctx cont
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(UpdateIssueAISummaryRequest)
+	reqContent := new(ListMilestonesRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.UpdateIssueAISummary
+	handler := s.IssueQueryService.ListMilestones
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *UpdateIssueAISummaryRequest) (*GetIssueResponse, error) {
+		handler = func(ctx context.Context, req *ListMilestonesRequest) (*ListMilestonesResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*UpdateIssueAISummaryRequest)
+					typedReq, ok := req.(*ListMilestonesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*UpdateIssueAISummaryRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListMilestonesRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.UpdateIssueAISummary(ctx, typedReq)
+					return s.IssueQueryService.ListMilestones(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*ListMilestonesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListMilestonesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2246,7 +9436,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryProtobuf(ctx cont
 	}
 
 	// Call service method
-	var respContent *GetIssueResponse
+	var respContent *ListMilestonesResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2257,7 +9447,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryProtobuf(ctx cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling UpdateIssueAISummary. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListMilestonesResponse and nil error while calling ListMilestones. nil responses are not supported"))
 		return
 	}
 
@@ -2281,7 +9471,7 @@ func (s *issueSyncAdminServiceServer) serveUpdateIssueAISummaryProtobuf(ctx cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveClearIssueAISummaries(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveGetIssueResponsiveness(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2289,9 +9479,9 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummaries(ctx context.Con
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveClearIssueAISummariesJSON(ctx, resp, req)
+		s.serveGetIssueResponsivenessJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveClearIssueAISummariesProtobuf(ctx, resp, req)
+		s.serveGetIssueResponsivenessProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2299,9 +9489,9 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummaries(ctx context.Con
 	}
 }
 
-func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveGetIssueResponsivenessJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueResponsiveness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2314,29 +9504,29 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesJSON(ctx context
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ClearIssueAISummariesRequest)
+	reqContent := new(GetIssueResponsivenessRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueSyncAdminService.ClearIssueAISummaries
+	handler := s.IssueQueryService.GetIssueResponsiveness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+		handler = func(ctx context.Context, req *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					typedReq, ok := req.(*GetIssueResponsivenessRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueResponsivenessRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.ClearIssueAISummaries(ctx, typedReq)
+					return s.IssueQueryService.GetIssueResponsiveness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				typedResp, ok := resp.(*GetIssueResponsivenessResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponsivenessResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2345,7 +9535,7 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesJSON(ctx context
 	}
 
 	// Call service method
-	var respContent *ClearIssueAISummariesResponse
+	var respContent *GetIssueResponsivenessResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2356,7 +9546,7 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesJSON(ctx context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClearIssueAISummariesResponse and nil error while calling ClearIssueAISummaries. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponsivenessResponse and nil error while calling GetIssueResponsiveness. nil responses are not supported"))
 		return
 	}
 
@@ -2382,9 +9572,9 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesJSON(ctx context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *issueQueryServiceServer) serveGetIssueResponsivenessProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ClearIssueAISummaries")
+	ctx = ctxsetters.WithMethodName(ctx, "GetIssueResponsiveness")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2396,28 +9586,28 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesProtobuf(ctx con
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ClearIssueAISummariesRequest)
+	reqContent := new(GetIssueResponsivenessRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueSyncAdminService.ClearIssueAISummaries
+	handler := s.IssueQueryService.GetIssueResponsiveness
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
+		handler = func(ctx context.Context, req *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ClearIssueAISummariesRequest)
+					typedReq, ok := req.(*GetIssueResponsivenessRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ClearIssueAISummariesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetIssueResponsivenessRequest) when calling interceptor")
 					}
-					return s.IssueSyncAdminService.ClearIssueAISummaries(ctx, typedReq)
+					return s.IssueQueryService.GetIssueResponsiveness(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ClearIssueAISummariesResponse)
+				typedResp, ok := resp.(*GetIssueResponsivenessResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ClearIssueAISummariesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponsivenessResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2426,7 +9616,7 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesProtobuf(ctx con
 	}
 
 	// Call service method
-	var respContent *ClearIssueAISummariesResponse
+	var respContent *GetIssueResponsivenessResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2437,7 +9627,7 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesProtobuf(ctx con
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ClearIssueAISummariesResponse and nil error while calling ClearIssueAISummaries. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponsivenessResponse and nil error while calling GetIssueResponsiveness. nil responses are not supported"))
 		return
 	}
 
@@ -2461,45 +9651,45 @@ func (s *issueSyncAdminServiceServer) serveClearIssueAISummariesProtobuf(ctx con
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueSyncAdminServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
+func (s *issueQueryServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 1
 }
 
-func (s *issueSyncAdminServiceServer) ProtocGenTwirpVersion() string {
+func (s *issueQueryServiceServer) ProtocGenTwirpVersion() string {
 	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *issueSyncAdminServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "issues.v1", "IssueSyncAdminService")
+func (s *issueQueryServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "issues.v1", "IssueQueryService")
 }
 
-// ===========================
-// IssueQueryService Interface
-// ===========================
+// ==============================
+// PRReviewQueryService Interface
+// ==============================
 
-type IssueQueryService interface {
-	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
+type PRReviewQueryService interface {
+	ListPRReviews(context.Context, *ListPRReviewsRequest) (*ListPRReviewsResponse, error)
 
-	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+	GetPRReview(context.Context, *GetPRReviewRequest) (*GetPRReviewResponse, error)
 }
 
-// =================================
-// IssueQueryService Protobuf Client
-// =================================
+// ====================================
+// PRReviewQueryService Protobuf Client
+// ====================================
 
-type issueQueryServiceProtobufClient struct {
+type pRReviewQueryServiceProtobufClient struct {
 	client      HTTPClient
 	urls        [2]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewIssueQueryServiceProtobufClient creates a Protobuf client that implements the IssueQueryService interface.
+// NewPRReviewQueryServiceProtobufClient creates a Protobuf client that implements the PRReviewQueryService interface.
 // It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewIssueQueryServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) IssueQueryService {
+func NewPRReviewQueryServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PRReviewQueryService {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -2519,13 +9709,13 @@ func NewIssueQueryServiceProtobufClient(baseURL string, client HTTPClient, opts
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueQueryService")
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "PRReviewQueryService")
 	urls := [2]string{
-		serviceURL + "ListIssues",
-		serviceURL + "GetIssue",
+		serviceURL + "ListPRReviews",
+		serviceURL + "GetPRReview",
 	}
 
-	return &issueQueryServiceProtobufClient{
+	return &pRReviewQueryServiceProtobufClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -2533,26 +9723,26 @@ func NewIssueQueryServiceProtobufClient(baseURL string, client HTTPClient, opts
 	}
 }
 
-func (c *issueQueryServiceProtobufClient) ListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
+func (c *pRReviewQueryServiceProtobufClient) ListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
-	caller := c.callListIssues
+	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
+	caller := c.callListPRReviews
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
+		caller = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListIssuesRequest)
+					typedReq, ok := req.(*ListPRReviewsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
 					}
-					return c.callListIssues(ctx, typedReq)
+					return c.callListPRReviews(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListIssuesResponse)
+				typedResp, ok := resp.(*ListPRReviewsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2562,8 +9752,8 @@ func (c *issueQueryServiceProtobufClient) ListIssues(ctx context.Context, in *Li
 	return caller(ctx, in)
 }
 
-func (c *issueQueryServiceProtobufClient) callListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
-	out := new(ListIssuesResponse)
+func (c *pRReviewQueryServiceProtobufClient) callListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+	out := new(ListPRReviewsResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -2579,26 +9769,26 @@ func (c *issueQueryServiceProtobufClient) callListIssues(ctx context.Context, in
 	return out, nil
 }
 
-func (c *issueQueryServiceProtobufClient) GetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
+func (c *pRReviewQueryServiceProtobufClient) GetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
-	caller := c.callGetIssue
+	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
+	caller := c.callGetPRReview
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
+		caller = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetIssueRequest)
+					typedReq, ok := req.(*GetPRReviewRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
 					}
-					return c.callGetIssue(ctx, typedReq)
+					return c.callGetPRReview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*GetPRReviewResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2608,8 +9798,8 @@ func (c *issueQueryServiceProtobufClient) GetIssue(ctx context.Context, in *GetI
 	return caller(ctx, in)
 }
 
-func (c *issueQueryServiceProtobufClient) callGetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
-	out := new(GetIssueResponse)
+func (c *pRReviewQueryServiceProtobufClient) callGetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
+	out := new(GetPRReviewResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -2625,20 +9815,20 @@ func (c *issueQueryServiceProtobufClient) callGetIssue(ctx context.Context, in *
 	return out, nil
 }
 
-// =============================
-// IssueQueryService JSON Client
-// =============================
+// ================================
+// PRReviewQueryService JSON Client
+// ================================
 
-type issueQueryServiceJSONClient struct {
+type pRReviewQueryServiceJSONClient struct {
 	client      HTTPClient
 	urls        [2]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewIssueQueryServiceJSONClient creates a JSON client that implements the IssueQueryService interface.
+// NewPRReviewQueryServiceJSONClient creates a JSON client that implements the PRReviewQueryService interface.
 // It communicates using JSON and can be configured with a custom HTTPClient.
-func NewIssueQueryServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) IssueQueryService {
+func NewPRReviewQueryServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PRReviewQueryService {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -2658,13 +9848,13 @@ func NewIssueQueryServiceJSONClient(baseURL string, client HTTPClient, opts ...t
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "IssueQueryService")
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "PRReviewQueryService")
 	urls := [2]string{
-		serviceURL + "ListIssues",
-		serviceURL + "GetIssue",
+		serviceURL + "ListPRReviews",
+		serviceURL + "GetPRReview",
 	}
 
-	return &issueQueryServiceJSONClient{
+	return &pRReviewQueryServiceJSONClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -2672,26 +9862,26 @@ func NewIssueQueryServiceJSONClient(baseURL string, client HTTPClient, opts ...t
 	}
 }
 
-func (c *issueQueryServiceJSONClient) ListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
+func (c *pRReviewQueryServiceJSONClient) ListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
-	caller := c.callListIssues
+	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
+	caller := c.callListPRReviews
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
+		caller = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListIssuesRequest)
+					typedReq, ok := req.(*ListPRReviewsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
 					}
-					return c.callListIssues(ctx, typedReq)
+					return c.callListPRReviews(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListIssuesResponse)
+				typedResp, ok := resp.(*ListPRReviewsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2701,8 +9891,8 @@ func (c *issueQueryServiceJSONClient) ListIssues(ctx context.Context, in *ListIs
 	return caller(ctx, in)
 }
 
-func (c *issueQueryServiceJSONClient) callListIssues(ctx context.Context, in *ListIssuesRequest) (*ListIssuesResponse, error) {
-	out := new(ListIssuesResponse)
+func (c *pRReviewQueryServiceJSONClient) callListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+	out := new(ListPRReviewsResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -2718,26 +9908,26 @@ func (c *issueQueryServiceJSONClient) callListIssues(ctx context.Context, in *Li
 	return out, nil
 }
 
-func (c *issueQueryServiceJSONClient) GetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
+func (c *pRReviewQueryServiceJSONClient) GetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
-	caller := c.callGetIssue
+	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
+	caller := c.callGetPRReview
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
+		caller = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetIssueRequest)
+					typedReq, ok := req.(*GetPRReviewRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
 					}
-					return c.callGetIssue(ctx, typedReq)
+					return c.callGetPRReview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*GetPRReviewResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2747,8 +9937,8 @@ func (c *issueQueryServiceJSONClient) GetIssue(ctx context.Context, in *GetIssue
 	return caller(ctx, in)
 }
 
-func (c *issueQueryServiceJSONClient) callGetIssue(ctx context.Context, in *GetIssueRequest) (*GetIssueResponse, error) {
-	out := new(GetIssueResponse)
+func (c *pRReviewQueryServiceJSONClient) callGetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
+	out := new(GetPRReviewResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -2764,12 +9954,12 @@ func (c *issueQueryServiceJSONClient) callGetIssue(ctx context.Context, in *GetI
 	return out, nil
 }
 
-// ================================
-// IssueQueryService Server Handler
-// ================================
+// ===================================
+// PRReviewQueryService Server Handler
+// ===================================
 
-type issueQueryServiceServer struct {
-	IssueQueryService
+type pRReviewQueryServiceServer struct {
+	PRReviewQueryService
 	interceptor      twirp.Interceptor
 	hooks            *twirp.ServerHooks
 	pathPrefix       string // prefix for routing
@@ -2777,10 +9967,10 @@ type issueQueryServiceServer struct {
 	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-// NewIssueQueryServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// NewPRReviewQueryServiceServer builds a TwirpServer that can be used as an http.Handler to handle
 // HTTP requests that are routed to the right method in the provided svc implementation.
 // The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewIssueQueryServiceServer(svc IssueQueryService, opts ...interface{}) TwirpServer {
+func NewPRReviewQueryServiceServer(svc PRReviewQueryService, opts ...interface{}) TwirpServer {
 	serverOpts := newServerOpts(opts)
 
 	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
@@ -2793,24 +9983,24 @@ func NewIssueQueryServiceServer(svc IssueQueryService, opts ...interface{}) Twir
 		pathPrefix = "/twirp" // default prefix
 	}
 
-	return &issueQueryServiceServer{
-		IssueQueryService: svc,
-		hooks:             serverOpts.Hooks,
-		interceptor:       twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:        pathPrefix,
-		jsonSkipDefaults:  jsonSkipDefaults,
-		jsonCamelCase:     jsonCamelCase,
+	return &pRReviewQueryServiceServer{
+		PRReviewQueryService: svc,
+		hooks:                serverOpts.Hooks,
+		interceptor:          twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:           pathPrefix,
+		jsonSkipDefaults:     jsonSkipDefaults,
+		jsonCamelCase:        jsonCamelCase,
 	}
 }
 
 // writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
 // If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *issueQueryServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+func (s *pRReviewQueryServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
 	writeError(ctx, resp, err, s.hooks)
 }
 
 // handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *issueQueryServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+func (s *pRReviewQueryServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
 	if context.Canceled == ctx.Err() {
 		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
@@ -2822,59 +10012,239 @@ func (s *issueQueryServiceServer) handleRequestBodyError(ctx context.Context, re
 	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-// IssueQueryServicePathPrefix is a convenience constant that may identify URL paths.
+// PRReviewQueryServicePathPrefix is a convenience constant that may identify URL paths.
 // Should be used with caution, it only matches routes generated by Twirp Go clients,
 // with the default "/twirp" prefix and default CamelCase service and method names.
 // More info: https://twitchtv.github.io/twirp/docs/routing.html
-const IssueQueryServicePathPrefix = "/twirp/issues.v1.IssueQueryService/"
+const PRReviewQueryServicePathPrefix = "/twirp/issues.v1.PRReviewQueryService/"
+
+func (s *pRReviewQueryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "issues.v1.PRReviewQueryService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "ListPRReviews":
+		s.serveListPRReviews(ctx, resp, req)
+		return
+	case "GetPRReview":
+		s.serveGetPRReview(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *pRReviewQueryServiceServer) serveListPRReviews(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPRReviewsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPRReviewsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *pRReviewQueryServiceServer) serveListPRReviewsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListPRReviewsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PRReviewQueryService.ListPRReviews
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPRReviewsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
+					}
+					return s.PRReviewQueryService.ListPRReviews(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPRReviewsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPRReviewsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPRReviewsResponse and nil error while calling ListPRReviews. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *pRReviewQueryServiceServer) serveListPRReviewsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListPRReviewsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PRReviewQueryService.ListPRReviews
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPRReviewsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
+					}
+					return s.PRReviewQueryService.ListPRReviews(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPRReviewsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *issueQueryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "IssueQueryService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *ListPRReviewsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPRReviewsResponse and nil error while calling ListPRReviews. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "issues.v1.IssueQueryService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "ListIssues":
-		s.serveListIssues(ctx, resp, req)
-		return
-	case "GetIssue":
-		s.serveGetIssue(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueQueryServiceServer) serveListIssues(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *pRReviewQueryServiceServer) serveGetPRReview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2882,9 +10252,9 @@ func (s *issueQueryServiceServer) serveListIssues(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveListIssuesJSON(ctx, resp, req)
+		s.serveGetPRReviewJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveListIssuesProtobuf(ctx, resp, req)
+		s.serveGetPRReviewProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2892,9 +10262,9 @@ func (s *issueQueryServiceServer) serveListIssues(ctx context.Context, resp http
 	}
 }
 
-func (s *issueQueryServiceServer) serveListIssuesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *pRReviewQueryServiceServer) serveGetPRReviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2907,29 +10277,29 @@ func (s *issueQueryServiceServer) serveListIssuesJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ListIssuesRequest)
+	reqContent := new(GetPRReviewRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.IssueQueryService.ListIssues
+	handler := s.PRReviewQueryService.GetPRReview
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
+		handler = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListIssuesRequest)
+					typedReq, ok := req.(*GetPRReviewRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
 					}
-					return s.IssueQueryService.ListIssues(ctx, typedReq)
+					return s.PRReviewQueryService.GetPRReview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListIssuesResponse)
+				typedResp, ok := resp.(*GetPRReviewResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2938,7 +10308,7 @@ func (s *issueQueryServiceServer) serveListIssuesJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *ListIssuesResponse
+	var respContent *GetPRReviewResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2949,7 +10319,7 @@ func (s *issueQueryServiceServer) serveListIssuesJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssuesResponse and nil error while calling ListIssues. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewResponse and nil error while calling GetPRReview. nil responses are not supported"))
 		return
 	}
 
@@ -2975,9 +10345,9 @@ func (s *issueQueryServiceServer) serveListIssuesJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueQueryServiceServer) serveListIssuesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *pRReviewQueryServiceServer) serveGetPRReviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListIssues")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2989,28 +10359,28 @@ func (s *issueQueryServiceServer) serveListIssuesProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ListIssuesRequest)
+	reqContent := new(GetPRReviewRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.IssueQueryService.ListIssues
+	handler := s.PRReviewQueryService.GetPRReview
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListIssuesRequest) (*ListIssuesResponse, error) {
+		handler = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListIssuesRequest)
+					typedReq, ok := req.(*GetPRReviewRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListIssuesRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
 					}
-					return s.IssueQueryService.ListIssues(ctx, typedReq)
+					return s.PRReviewQueryService.GetPRReview(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListIssuesResponse)
+				typedResp, ok := resp.(*GetPRReviewResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListIssuesResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3019,7 +10389,7 @@ func (s *issueQueryServiceServer) serveListIssuesProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *ListIssuesResponse
+	var respContent *GetPRReviewResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3030,7 +10400,7 @@ func (s *issueQueryServiceServer) serveListIssuesProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListIssuesResponse and nil error while calling ListIssues. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewResponse and nil error while calling GetPRReview. nil responses are not supported"))
 		return
 	}
 
@@ -3054,225 +10424,233 @@ func (s *issueQueryServiceServer) serveListIssuesProtobuf(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *issueQueryServiceServer) serveGetIssue(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
+func (s *pRReviewQueryServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 2
+}
+
+func (s *pRReviewQueryServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *pRReviewQueryServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "issues.v1", "PRReviewQueryService")
+}
+
+// ==========================
+// AdminAuthService Interface
+// ==========================
+
+type AdminAuthService interface {
+	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginResponse, error)
+
+	AdminLogout(context.Context, *AdminLogoutRequest) (*AdminLogoutResponse, error)
+
+	AdminWhoAmI(context.Context, *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error)
+}
+
+// ================================
+// AdminAuthService Protobuf Client
+// ================================
+
+type adminAuthServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [3]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAdminAuthServiceProtobufClient creates a Protobuf client that implements the AdminAuthService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAdminAuthServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminAuthService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveGetIssueJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveGetIssueProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
 	}
-}
 
-func (s *issueQueryServiceServer) serveGetIssueJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
 
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "AdminAuthService")
+	urls := [3]string{
+		serviceURL + "AdminLogin",
+		serviceURL + "AdminLogout",
+		serviceURL + "AdminWhoAmI",
 	}
-	reqContent := new(GetIssueRequest)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
+
+	return &adminAuthServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
 	}
+}
 
-	handler := s.IssueQueryService.GetIssue
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
-			resp, err := s.interceptor(
+func (c *adminAuthServiceProtobufClient) AdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
+	caller := c.callAdminLogin
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdminLoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+					}
+					return c.callAdminLogin(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminLoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminAuthServiceProtobufClient) callAdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
+	out := new(AdminLoginResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminAuthServiceProtobufClient) AdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
+	caller := c.callAdminLogout
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetIssueRequest)
+					typedReq, ok := req.(*AdminLogoutRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
 					}
-					return s.IssueQueryService.GetIssue(ctx, typedReq)
+					return c.callAdminLogout(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*AdminLogoutResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *GetIssueResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling GetIssue. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
+func (c *adminAuthServiceProtobufClient) callAdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+	out := new(AdminLogoutResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *issueQueryServiceServer) serveGetIssueProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetIssue")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := io.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(GetIssueRequest)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.IssueQueryService.GetIssue
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetIssueRequest) (*GetIssueResponse, error) {
-			resp, err := s.interceptor(
+func (c *adminAuthServiceProtobufClient) AdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
+	caller := c.callAdminWhoAmI
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetIssueRequest)
+					typedReq, ok := req.(*AdminWhoAmIRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetIssueRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
 					}
-					return s.IssueQueryService.GetIssue(ctx, typedReq)
+					return c.callAdminWhoAmI(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetIssueResponse)
+				typedResp, ok := resp.(*AdminWhoAmIResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetIssueResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *GetIssueResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetIssueResponse and nil error while calling GetIssue. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
+func (c *adminAuthServiceProtobufClient) callAdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+	out := new(AdminWhoAmIResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
-	}
-
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
-	callResponseSent(ctx, s.hooks)
-}
-
-func (s *issueQueryServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 1
-}
-
-func (s *issueQueryServiceServer) ProtocGenTwirpVersion() string {
-	return "v8.1.3"
-}
-
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *issueQueryServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "issues.v1", "IssueQueryService")
-}
-
-// ==============================
-// PRReviewQueryService Interface
-// ==============================
 
-type PRReviewQueryService interface {
-	ListPRReviews(context.Context, *ListPRReviewsRequest) (*ListPRReviewsResponse, error)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	GetPRReview(context.Context, *GetPRReviewRequest) (*GetPRReviewResponse, error)
+	return out, nil
 }
 
-// ====================================
-// PRReviewQueryService Protobuf Client
-// ====================================
+// ============================
+// AdminAuthService JSON Client
+// ============================
 
-type pRReviewQueryServiceProtobufClient struct {
+type adminAuthServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewPRReviewQueryServiceProtobufClient creates a Protobuf client that implements the PRReviewQueryService interface.
-// It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewPRReviewQueryServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PRReviewQueryService {
+// NewAdminAuthServiceJSONClient creates a JSON client that implements the AdminAuthService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAdminAuthServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminAuthService {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -3292,13 +10670,14 @@ func NewPRReviewQueryServiceProtobufClient(baseURL string, client HTTPClient, op
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "PRReviewQueryService")
-	urls := [2]string{
-		serviceURL + "ListPRReviews",
-		serviceURL + "GetPRReview",
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "AdminAuthService")
+	urls := [3]string{
+		serviceURL + "AdminLogin",
+		serviceURL + "AdminLogout",
+		serviceURL + "AdminWhoAmI",
 	}
 
-	return &pRReviewQueryServiceProtobufClient{
+	return &adminAuthServiceJSONClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -3306,26 +10685,72 @@ func NewPRReviewQueryServiceProtobufClient(baseURL string, client HTTPClient, op
 	}
 }
 
-func (c *pRReviewQueryServiceProtobufClient) ListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+func (c *adminAuthServiceJSONClient) AdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
-	caller := c.callListPRReviews
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
+	caller := c.callAdminLogin
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdminLoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+					}
+					return c.callAdminLogin(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminLoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminAuthServiceJSONClient) callAdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
+	out := new(AdminLoginResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminAuthServiceJSONClient) AdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
+	caller := c.callAdminLogout
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+		caller = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListPRReviewsRequest)
+					typedReq, ok := req.(*AdminLogoutRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
 					}
-					return c.callListPRReviews(ctx, typedReq)
+					return c.callAdminLogout(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListPRReviewsResponse)
+				typedResp, ok := resp.(*AdminLogoutResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3335,9 +10760,9 @@ func (c *pRReviewQueryServiceProtobufClient) ListPRReviews(ctx context.Context,
 	return caller(ctx, in)
 }
 
-func (c *pRReviewQueryServiceProtobufClient) callListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
-	out := new(ListPRReviewsResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (c *adminAuthServiceJSONClient) callAdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+	out := new(AdminLogoutResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3352,26 +10777,26 @@ func (c *pRReviewQueryServiceProtobufClient) callListPRReviews(ctx context.Conte
 	return out, nil
 }
 
-func (c *pRReviewQueryServiceProtobufClient) GetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
+func (c *adminAuthServiceJSONClient) AdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
-	caller := c.callGetPRReview
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
+	caller := c.callAdminWhoAmI
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
+		caller = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPRReviewRequest)
+					typedReq, ok := req.(*AdminWhoAmIRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
 					}
-					return c.callGetPRReview(ctx, typedReq)
+					return c.callAdminWhoAmI(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPRReviewResponse)
+				typedResp, ok := resp.(*AdminWhoAmIResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3381,9 +10806,9 @@ func (c *pRReviewQueryServiceProtobufClient) GetPRReview(ctx context.Context, in
 	return caller(ctx, in)
 }
 
-func (c *pRReviewQueryServiceProtobufClient) callGetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
-	out := new(GetPRReviewResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+func (c *adminAuthServiceJSONClient) callAdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+	out := new(AdminWhoAmIResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -3398,256 +10823,300 @@ func (c *pRReviewQueryServiceProtobufClient) callGetPRReview(ctx context.Context
 	return out, nil
 }
 
-// ================================
-// PRReviewQueryService JSON Client
-// ================================
+// ===============================
+// AdminAuthService Server Handler
+// ===============================
 
-type pRReviewQueryServiceJSONClient struct {
-	client      HTTPClient
-	urls        [2]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+type adminAuthServiceServer struct {
+	AdminAuthService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-// NewPRReviewQueryServiceJSONClient creates a JSON client that implements the PRReviewQueryService interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewPRReviewQueryServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PRReviewQueryService {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
+// NewAdminAuthServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAdminAuthServiceServer(svc AdminAuthService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
 
 	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
 	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
 		pathPrefix = "/twirp" // default prefix
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "PRReviewQueryService")
-	urls := [2]string{
-		serviceURL + "ListPRReviews",
-		serviceURL + "GetPRReview",
+	return &adminAuthServiceServer{
+		AdminAuthService: svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
 	}
+}
 
-	return &pRReviewQueryServiceJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
-	}
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *adminAuthServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
 }
 
-func (c *pRReviewQueryServiceJSONClient) ListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
-	caller := c.callListPRReviews
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
-			resp, err := c.interceptor(
-				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListPRReviewsRequest)
-					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
-					}
-					return c.callListPRReviews(ctx, typedReq)
-				},
-			)(ctx, req)
-			if resp != nil {
-				typedResp, ok := resp.(*ListPRReviewsResponse)
-				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
-				}
-				return typedResp, err
-			}
-			return nil, err
-		}
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *adminAuthServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
 	}
-	return caller(ctx, in)
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-func (c *pRReviewQueryServiceJSONClient) callListPRReviews(ctx context.Context, in *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
-	out := new(ListPRReviewsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+// AdminAuthServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AdminAuthServicePathPrefix = "/twirp/issues.v1.AdminAuthService/"
+
+func (s *adminAuthServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
 
-	return out, nil
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "issues.v1.AdminAuthService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "AdminLogin":
+		s.serveAdminLogin(ctx, resp, req)
+		return
+	case "AdminLogout":
+		s.serveAdminLogout(ctx, resp, req)
+		return
+	case "AdminWhoAmI":
+		s.serveAdminWhoAmI(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
 }
 
-func (c *pRReviewQueryServiceJSONClient) GetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
-	caller := c.callGetPRReview
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
-			resp, err := c.interceptor(
+func (s *adminAuthServiceServer) serveAdminLogin(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveAdminLoginJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveAdminLoginProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminAuthServiceServer) serveAdminLoginJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(AdminLoginRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.AdminAuthService.AdminLogin
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPRReviewRequest)
+					typedReq, ok := req.(*AdminLoginRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
 					}
-					return c.callGetPRReview(ctx, typedReq)
+					return s.AdminAuthService.AdminLogin(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPRReviewResponse)
+				typedResp, ok := resp.(*AdminLoginResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *pRReviewQueryServiceJSONClient) callGetPRReview(ctx context.Context, in *GetPRReviewRequest) (*GetPRReviewResponse, error) {
-	out := new(GetPRReviewResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *AdminLoginResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLoginResponse and nil error while calling AdminLogin. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-// ===================================
-// PRReviewQueryService Server Handler
-// ===================================
-
-type pRReviewQueryServiceServer struct {
-	PRReviewQueryService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewPRReviewQueryServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewPRReviewQueryServiceServer(svc PRReviewQueryService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	return &pRReviewQueryServiceServer{
-		PRReviewQueryService: svc,
-		hooks:                serverOpts.Hooks,
-		interceptor:          twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:           pathPrefix,
-		jsonSkipDefaults:     jsonSkipDefaults,
-		jsonCamelCase:        jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *pRReviewQueryServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+func (s *adminAuthServiceServer) serveAdminLoginProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *pRReviewQueryServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(AdminLoginRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// PRReviewQueryServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const PRReviewQueryServicePathPrefix = "/twirp/issues.v1.PRReviewQueryService/"
+	handler := s.AdminAuthService.AdminLogin
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*AdminLoginRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+					}
+					return s.AdminAuthService.AdminLogin(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*AdminLoginResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *pRReviewQueryServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "PRReviewQueryService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *AdminLoginResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLoginResponse and nil error while calling AdminLogin. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "issues.v1.PRReviewQueryService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "ListPRReviews":
-		s.serveListPRReviews(ctx, resp, req)
-		return
-	case "GetPRReview":
-		s.serveGetPRReview(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *pRReviewQueryServiceServer) serveListPRReviews(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminAuthServiceServer) serveAdminLogout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3655,9 +11124,9 @@ func (s *pRReviewQueryServiceServer) serveListPRReviews(ctx context.Context, res
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveListPRReviewsJSON(ctx, resp, req)
+		s.serveAdminLogoutJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveListPRReviewsProtobuf(ctx, resp, req)
+		s.serveAdminLogoutProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3665,9 +11134,9 @@ func (s *pRReviewQueryServiceServer) serveListPRReviews(ctx context.Context, res
 	}
 }
 
-func (s *pRReviewQueryServiceServer) serveListPRReviewsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminAuthServiceServer) serveAdminLogoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3680,29 +11149,29 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsJSON(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ListPRReviewsRequest)
+	reqContent := new(AdminLogoutRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.PRReviewQueryService.ListPRReviews
+	handler := s.AdminAuthService.AdminLogout
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+		handler = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListPRReviewsRequest)
+					typedReq, ok := req.(*AdminLogoutRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
 					}
-					return s.PRReviewQueryService.ListPRReviews(ctx, typedReq)
+					return s.AdminAuthService.AdminLogout(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListPRReviewsResponse)
+				typedResp, ok := resp.(*AdminLogoutResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3711,7 +11180,7 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsJSON(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *ListPRReviewsResponse
+	var respContent *AdminLogoutResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3722,7 +11191,7 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsJSON(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPRReviewsResponse and nil error while calling ListPRReviews. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLogoutResponse and nil error while calling AdminLogout. nil responses are not supported"))
 		return
 	}
 
@@ -3748,9 +11217,9 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsJSON(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *pRReviewQueryServiceServer) serveListPRReviewsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminAuthServiceServer) serveAdminLogoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListPRReviews")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3762,28 +11231,28 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsProtobuf(ctx context.Cont
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ListPRReviewsRequest)
+	reqContent := new(AdminLogoutRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.PRReviewQueryService.ListPRReviews
+	handler := s.AdminAuthService.AdminLogout
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListPRReviewsRequest) (*ListPRReviewsResponse, error) {
+		handler = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListPRReviewsRequest)
+					typedReq, ok := req.(*AdminLogoutRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListPRReviewsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
 					}
-					return s.PRReviewQueryService.ListPRReviews(ctx, typedReq)
+					return s.AdminAuthService.AdminLogout(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListPRReviewsResponse)
+				typedResp, ok := resp.(*AdminLogoutResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListPRReviewsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3792,7 +11261,7 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsProtobuf(ctx context.Cont
 	}
 
 	// Call service method
-	var respContent *ListPRReviewsResponse
+	var respContent *AdminLogoutResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3803,7 +11272,7 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsProtobuf(ctx context.Cont
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPRReviewsResponse and nil error while calling ListPRReviews. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLogoutResponse and nil error while calling AdminLogout. nil responses are not supported"))
 		return
 	}
 
@@ -3827,7 +11296,7 @@ func (s *pRReviewQueryServiceServer) serveListPRReviewsProtobuf(ctx context.Cont
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *pRReviewQueryServiceServer) serveGetPRReview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminAuthServiceServer) serveAdminWhoAmI(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -3835,9 +11304,9 @@ func (s *pRReviewQueryServiceServer) serveGetPRReview(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetPRReviewJSON(ctx, resp, req)
+		s.serveAdminWhoAmIJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetPRReviewProtobuf(ctx, resp, req)
+		s.serveAdminWhoAmIProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -3845,9 +11314,9 @@ func (s *pRReviewQueryServiceServer) serveGetPRReview(ctx context.Context, resp
 	}
 }
 
-func (s *pRReviewQueryServiceServer) serveGetPRReviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminAuthServiceServer) serveAdminWhoAmIJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3860,29 +11329,29 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetPRReviewRequest)
+	reqContent := new(AdminWhoAmIRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.PRReviewQueryService.GetPRReview
+	handler := s.AdminAuthService.AdminWhoAmI
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
+		handler = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPRReviewRequest)
+					typedReq, ok := req.(*AdminWhoAmIRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
 					}
-					return s.PRReviewQueryService.GetPRReview(ctx, typedReq)
+					return s.AdminAuthService.AdminWhoAmI(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPRReviewResponse)
+				typedResp, ok := resp.(*AdminWhoAmIResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3891,7 +11360,7 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *GetPRReviewResponse
+	var respContent *AdminWhoAmIResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3902,7 +11371,7 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewResponse and nil error while calling GetPRReview. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminWhoAmIResponse and nil error while calling AdminWhoAmI. nil responses are not supported"))
 		return
 	}
 
@@ -3928,9 +11397,9 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *pRReviewQueryServiceServer) serveGetPRReviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *adminAuthServiceServer) serveAdminWhoAmIProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetPRReview")
+	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -3942,28 +11411,28 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetPRReviewRequest)
+	reqContent := new(AdminWhoAmIRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.PRReviewQueryService.GetPRReview
+	handler := s.AdminAuthService.AdminWhoAmI
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetPRReviewRequest) (*GetPRReviewResponse, error) {
+		handler = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPRReviewRequest)
+					typedReq, ok := req.(*AdminWhoAmIRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPRReviewRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
 					}
-					return s.PRReviewQueryService.GetPRReview(ctx, typedReq)
+					return s.AdminAuthService.AdminWhoAmI(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPRReviewResponse)
+				typedResp, ok := resp.(*AdminWhoAmIResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPRReviewResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -3972,7 +11441,7 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *GetPRReviewResponse
+	var respContent *AdminWhoAmIResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -3983,7 +11452,7 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPRReviewResponse and nil error while calling GetPRReview. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminWhoAmIResponse and nil error while calling AdminWhoAmI. nil responses are not supported"))
 		return
 	}
 
@@ -4007,47 +11476,50 @@ func (s *pRReviewQueryServiceServer) serveGetPRReviewProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *pRReviewQueryServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 2
+func (s *adminAuthServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 3
 }
 
-func (s *pRReviewQueryServiceServer) ProtocGenTwirpVersion() string {
+func (s *adminAuthServiceServer) ProtocGenTwirpVersion() string {
 	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *pRReviewQueryServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "issues.v1", "PRReviewQueryService")
+func (s *adminAuthServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "issues.v1", "AdminAuthService")
 }
 
-// ==========================
-// AdminAuthService Interface
-// ==========================
+// =========================
+// JobAdminService Interface
+// =========================
 
-type AdminAuthService interface {
-	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginResponse, error)
+type JobAdminService interface {
+	ListJobs(context.Context, *google_protobuf1.Empty) (*ListJobsResponse, error)
 
-	AdminLogout(context.Context, *AdminLogoutRequest) (*AdminLogoutResponse, error)
+	PauseJob(context.Context, *PauseJobRequest) (*Job, error)
 
-	AdminWhoAmI(context.Context, *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error)
+	ResumeJob(context.Context, *ResumeJobRequest) (*Job, error)
+
+	// TriggerJob starts a run now, on whichever replica receives the request.
+	TriggerJob(context.Context, *TriggerJobRequest) (*Job, error)
 }
 
-// ================================
-// AdminAuthService Protobuf Client
-// ================================
+// ===============================
+// JobAdminService Protobuf Client
+// ===============================
 
-type adminAuthServiceProtobufClient struct {
+type jobAdminServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [4]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewAdminAuthServiceProtobufClient creates a Protobuf client that implements the AdminAuthService interface.
+// NewJobAdminServiceProtobufClient creates a Protobuf client that implements the JobAdminService interface.
 // It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewAdminAuthServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminAuthService {
+func NewJobAdminServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) JobAdminService {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -4067,14 +11539,15 @@ func NewAdminAuthServiceProtobufClient(baseURL string, client HTTPClient, opts .
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "AdminAuthService")
-	urls := [3]string{
-		serviceURL + "AdminLogin",
-		serviceURL + "AdminLogout",
-		serviceURL + "AdminWhoAmI",
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "JobAdminService")
+	urls := [4]string{
+		serviceURL + "ListJobs",
+		serviceURL + "PauseJob",
+		serviceURL + "ResumeJob",
+		serviceURL + "TriggerJob",
 	}
 
-	return &adminAuthServiceProtobufClient{
+	return &jobAdminServiceProtobufClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -4082,26 +11555,26 @@ func NewAdminAuthServiceProtobufClient(baseURL string, client HTTPClient, opts .
 	}
 }
 
-func (c *adminAuthServiceProtobufClient) AdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
+func (c *jobAdminServiceProtobufClient) ListJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListJobsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
-	caller := c.callAdminLogin
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
+	caller := c.callListJobs
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListJobsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLoginRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callAdminLogin(ctx, typedReq)
+					return c.callListJobs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLoginResponse)
+				typedResp, ok := resp.(*ListJobsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4111,8 +11584,8 @@ func (c *adminAuthServiceProtobufClient) AdminLogin(ctx context.Context, in *Adm
 	return caller(ctx, in)
 }
 
-func (c *adminAuthServiceProtobufClient) callAdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
-	out := new(AdminLoginResponse)
+func (c *jobAdminServiceProtobufClient) callListJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -4128,26 +11601,26 @@ func (c *adminAuthServiceProtobufClient) callAdminLogin(ctx context.Context, in
 	return out, nil
 }
 
-func (c *adminAuthServiceProtobufClient) AdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+func (c *jobAdminServiceProtobufClient) PauseJob(ctx context.Context, in *PauseJobRequest) (*Job, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
-	caller := c.callAdminLogout
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "PauseJob")
+	caller := c.callPauseJob
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+		caller = func(ctx context.Context, req *PauseJobRequest) (*Job, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLogoutRequest)
+					typedReq, ok := req.(*PauseJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PauseJobRequest) when calling interceptor")
 					}
-					return c.callAdminLogout(ctx, typedReq)
+					return c.callPauseJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLogoutResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4157,8 +11630,8 @@ func (c *adminAuthServiceProtobufClient) AdminLogout(ctx context.Context, in *Ad
 	return caller(ctx, in)
 }
 
-func (c *adminAuthServiceProtobufClient) callAdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
-	out := new(AdminLogoutResponse)
+func (c *jobAdminServiceProtobufClient) callPauseJob(ctx context.Context, in *PauseJobRequest) (*Job, error) {
+	out := new(Job)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -4174,26 +11647,26 @@ func (c *adminAuthServiceProtobufClient) callAdminLogout(ctx context.Context, in
 	return out, nil
 }
 
-func (c *adminAuthServiceProtobufClient) AdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+func (c *jobAdminServiceProtobufClient) ResumeJob(ctx context.Context, in *ResumeJobRequest) (*Job, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
-	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
-	caller := c.callAdminWhoAmI
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResumeJob")
+	caller := c.callResumeJob
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+		caller = func(ctx context.Context, req *ResumeJobRequest) (*Job, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminWhoAmIRequest)
+					typedReq, ok := req.(*ResumeJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResumeJobRequest) when calling interceptor")
 					}
-					return c.callAdminWhoAmI(ctx, typedReq)
+					return c.callResumeJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminWhoAmIResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4203,8 +11676,8 @@ func (c *adminAuthServiceProtobufClient) AdminWhoAmI(ctx context.Context, in *Ad
 	return caller(ctx, in)
 }
 
-func (c *adminAuthServiceProtobufClient) callAdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
-	out := new(AdminWhoAmIResponse)
+func (c *jobAdminServiceProtobufClient) callResumeJob(ctx context.Context, in *ResumeJobRequest) (*Job, error) {
+	out := new(Job)
 	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -4220,20 +11693,66 @@ func (c *adminAuthServiceProtobufClient) callAdminWhoAmI(ctx context.Context, in
 	return out, nil
 }
 
-// ============================
-// AdminAuthService JSON Client
-// ============================
+func (c *jobAdminServiceProtobufClient) TriggerJob(ctx context.Context, in *TriggerJobRequest) (*Job, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "TriggerJob")
+	caller := c.callTriggerJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TriggerJobRequest) (*Job, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TriggerJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TriggerJobRequest) when calling interceptor")
+					}
+					return c.callTriggerJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Job)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
 
-type adminAuthServiceJSONClient struct {
+func (c *jobAdminServiceProtobufClient) callTriggerJob(ctx context.Context, in *TriggerJobRequest) (*Job, error) {
+	out := new(Job)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// JobAdminService JSON Client
+// ===========================
+
+type jobAdminServiceJSONClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [4]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewAdminAuthServiceJSONClient creates a JSON client that implements the AdminAuthService interface.
+// NewJobAdminServiceJSONClient creates a JSON client that implements the JobAdminService interface.
 // It communicates using JSON and can be configured with a custom HTTPClient.
-func NewAdminAuthServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) AdminAuthService {
+func NewJobAdminServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) JobAdminService {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -4253,14 +11772,15 @@ func NewAdminAuthServiceJSONClient(baseURL string, client HTTPClient, opts ...tw
 
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "issues.v1", "AdminAuthService")
-	urls := [3]string{
-		serviceURL + "AdminLogin",
-		serviceURL + "AdminLogout",
-		serviceURL + "AdminWhoAmI",
+	serviceURL += baseServicePath(pathPrefix, "issues.v1", "JobAdminService")
+	urls := [4]string{
+		serviceURL + "ListJobs",
+		serviceURL + "PauseJob",
+		serviceURL + "ResumeJob",
+		serviceURL + "TriggerJob",
 	}
 
-	return &adminAuthServiceJSONClient{
+	return &jobAdminServiceJSONClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -4268,26 +11788,26 @@ func NewAdminAuthServiceJSONClient(baseURL string, client HTTPClient, opts ...tw
 	}
 }
 
-func (c *adminAuthServiceJSONClient) AdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
+func (c *jobAdminServiceJSONClient) ListJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListJobsResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
-	caller := c.callAdminLogin
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
+	caller := c.callListJobs
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+		caller = func(ctx context.Context, req *google_protobuf1.Empty) (*ListJobsResponse, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLoginRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return c.callAdminLogin(ctx, typedReq)
+					return c.callListJobs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLoginResponse)
+				typedResp, ok := resp.(*ListJobsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4297,8 +11817,8 @@ func (c *adminAuthServiceJSONClient) AdminLogin(ctx context.Context, in *AdminLo
 	return caller(ctx, in)
 }
 
-func (c *adminAuthServiceJSONClient) callAdminLogin(ctx context.Context, in *AdminLoginRequest) (*AdminLoginResponse, error) {
-	out := new(AdminLoginResponse)
+func (c *jobAdminServiceJSONClient) callListJobs(ctx context.Context, in *google_protobuf1.Empty) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -4314,26 +11834,26 @@ func (c *adminAuthServiceJSONClient) callAdminLogin(ctx context.Context, in *Adm
 	return out, nil
 }
 
-func (c *adminAuthServiceJSONClient) AdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+func (c *jobAdminServiceJSONClient) PauseJob(ctx context.Context, in *PauseJobRequest) (*Job, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
-	caller := c.callAdminLogout
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "PauseJob")
+	caller := c.callPauseJob
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+		caller = func(ctx context.Context, req *PauseJobRequest) (*Job, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLogoutRequest)
+					typedReq, ok := req.(*PauseJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PauseJobRequest) when calling interceptor")
 					}
-					return c.callAdminLogout(ctx, typedReq)
+					return c.callPauseJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLogoutResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4343,8 +11863,8 @@ func (c *adminAuthServiceJSONClient) AdminLogout(ctx context.Context, in *AdminL
 	return caller(ctx, in)
 }
 
-func (c *adminAuthServiceJSONClient) callAdminLogout(ctx context.Context, in *AdminLogoutRequest) (*AdminLogoutResponse, error) {
-	out := new(AdminLogoutResponse)
+func (c *jobAdminServiceJSONClient) callPauseJob(ctx context.Context, in *PauseJobRequest) (*Job, error) {
+	out := new(Job)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -4360,26 +11880,26 @@ func (c *adminAuthServiceJSONClient) callAdminLogout(ctx context.Context, in *Ad
 	return out, nil
 }
 
-func (c *adminAuthServiceJSONClient) AdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+func (c *jobAdminServiceJSONClient) ResumeJob(ctx context.Context, in *ResumeJobRequest) (*Job, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
-	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
-	caller := c.callAdminWhoAmI
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "ResumeJob")
+	caller := c.callResumeJob
 	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+		caller = func(ctx context.Context, req *ResumeJobRequest) (*Job, error) {
 			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminWhoAmIRequest)
+					typedReq, ok := req.(*ResumeJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResumeJobRequest) when calling interceptor")
 					}
-					return c.callAdminWhoAmI(ctx, typedReq)
+					return c.callResumeJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminWhoAmIResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4389,8 +11909,8 @@ func (c *adminAuthServiceJSONClient) AdminWhoAmI(ctx context.Context, in *AdminW
 	return caller(ctx, in)
 }
 
-func (c *adminAuthServiceJSONClient) callAdminWhoAmI(ctx context.Context, in *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
-	out := new(AdminWhoAmIResponse)
+func (c *jobAdminServiceJSONClient) callResumeJob(ctx context.Context, in *ResumeJobRequest) (*Job, error) {
+	out := new(Job)
 	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
@@ -4406,12 +11926,58 @@ func (c *adminAuthServiceJSONClient) callAdminWhoAmI(ctx context.Context, in *Ad
 	return out, nil
 }
 
-// ===============================
-// AdminAuthService Server Handler
-// ===============================
+func (c *jobAdminServiceJSONClient) TriggerJob(ctx context.Context, in *TriggerJobRequest) (*Job, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
+	ctx = ctxsetters.WithMethodName(ctx, "TriggerJob")
+	caller := c.callTriggerJob
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *TriggerJobRequest) (*Job, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TriggerJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TriggerJobRequest) when calling interceptor")
+					}
+					return c.callTriggerJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Job)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
 
-type adminAuthServiceServer struct {
-	AdminAuthService
+func (c *jobAdminServiceJSONClient) callTriggerJob(ctx context.Context, in *TriggerJobRequest) (*Job, error) {
+	out := new(Job)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// JobAdminService Server Handler
+// ==============================
+
+type jobAdminServiceServer struct {
+	JobAdminService
 	interceptor      twirp.Interceptor
 	hooks            *twirp.ServerHooks
 	pathPrefix       string // prefix for routing
@@ -4419,10 +11985,10 @@ type adminAuthServiceServer struct {
 	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
 }
 
-// NewAdminAuthServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// NewJobAdminServiceServer builds a TwirpServer that can be used as an http.Handler to handle
 // HTTP requests that are routed to the right method in the provided svc implementation.
 // The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewAdminAuthServiceServer(svc AdminAuthService, opts ...interface{}) TwirpServer {
+func NewJobAdminServiceServer(svc JobAdminService, opts ...interface{}) TwirpServer {
 	serverOpts := newServerOpts(opts)
 
 	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
@@ -4435,8 +12001,8 @@ func NewAdminAuthServiceServer(svc AdminAuthService, opts ...interface{}) TwirpS
 		pathPrefix = "/twirp" // default prefix
 	}
 
-	return &adminAuthServiceServer{
-		AdminAuthService: svc,
+	return &jobAdminServiceServer{
+		JobAdminService:  svc,
 		hooks:            serverOpts.Hooks,
 		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
 		pathPrefix:       pathPrefix,
@@ -4447,12 +12013,12 @@ func NewAdminAuthServiceServer(svc AdminAuthService, opts ...interface{}) TwirpS
 
 // writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
 // If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *adminAuthServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+func (s *jobAdminServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
 	writeError(ctx, resp, err, s.hooks)
 }
 
 // handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *adminAuthServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+func (s *jobAdminServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
 	if context.Canceled == ctx.Err() {
 		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
@@ -4464,16 +12030,16 @@ func (s *adminAuthServiceServer) handleRequestBodyError(ctx context.Context, res
 	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-// AdminAuthServicePathPrefix is a convenience constant that may identify URL paths.
+// JobAdminServicePathPrefix is a convenience constant that may identify URL paths.
 // Should be used with caution, it only matches routes generated by Twirp Go clients,
 // with the default "/twirp" prefix and default CamelCase service and method names.
 // More info: https://twitchtv.github.io/twirp/docs/routing.html
-const AdminAuthServicePathPrefix = "/twirp/issues.v1.AdminAuthService/"
+const JobAdminServicePathPrefix = "/twirp/issues.v1.JobAdminService/"
 
-func (s *adminAuthServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 	ctx = ctxsetters.WithPackageName(ctx, "issues.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "AdminAuthService")
+	ctx = ctxsetters.WithServiceName(ctx, "JobAdminService")
 	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
 	var err error
@@ -4491,7 +12057,7 @@ func (s *adminAuthServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.R
 
 	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
 	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "issues.v1.AdminAuthService" {
+	if pkgService != "issues.v1.JobAdminService" {
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
 		return
@@ -4503,14 +12069,17 @@ func (s *adminAuthServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.R
 	}
 
 	switch method {
-	case "AdminLogin":
-		s.serveAdminLogin(ctx, resp, req)
+	case "ListJobs":
+		s.serveListJobs(ctx, resp, req)
 		return
-	case "AdminLogout":
-		s.serveAdminLogout(ctx, resp, req)
+	case "PauseJob":
+		s.servePauseJob(ctx, resp, req)
 		return
-	case "AdminWhoAmI":
-		s.serveAdminWhoAmI(ctx, resp, req)
+	case "ResumeJob":
+		s.serveResumeJob(ctx, resp, req)
+		return
+	case "TriggerJob":
+		s.serveTriggerJob(ctx, resp, req)
 		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
@@ -4519,7 +12088,7 @@ func (s *adminAuthServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.R
 	}
 }
 
-func (s *adminAuthServiceServer) serveAdminLogin(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) serveListJobs(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4527,9 +12096,9 @@ func (s *adminAuthServiceServer) serveAdminLogin(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAdminLoginJSON(ctx, resp, req)
+		s.serveListJobsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAdminLoginProtobuf(ctx, resp, req)
+		s.serveListJobsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4537,9 +12106,9 @@ func (s *adminAuthServiceServer) serveAdminLogin(ctx context.Context, resp http.
 	}
 }
 
-func (s *adminAuthServiceServer) serveAdminLoginJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) serveListJobsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4552,29 +12121,29 @@ func (s *adminAuthServiceServer) serveAdminLoginJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(AdminLoginRequest)
+	reqContent := new(google_protobuf1.Empty)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminAuthService.AdminLogin
+	handler := s.JobAdminService.ListJobs
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListJobsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLoginRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return s.AdminAuthService.AdminLogin(ctx, typedReq)
+					return s.JobAdminService.ListJobs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLoginResponse)
+				typedResp, ok := resp.(*ListJobsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4583,7 +12152,7 @@ func (s *adminAuthServiceServer) serveAdminLoginJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *AdminLoginResponse
+	var respContent *ListJobsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4594,7 +12163,7 @@ func (s *adminAuthServiceServer) serveAdminLoginJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLoginResponse and nil error while calling AdminLogin. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListJobsResponse and nil error while calling ListJobs. nil responses are not supported"))
 		return
 	}
 
@@ -4620,9 +12189,9 @@ func (s *adminAuthServiceServer) serveAdminLoginJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminAuthServiceServer) serveAdminLoginProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) serveListJobsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogin")
+	ctx = ctxsetters.WithMethodName(ctx, "ListJobs")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4634,28 +12203,28 @@ func (s *adminAuthServiceServer) serveAdminLoginProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(AdminLoginRequest)
+	reqContent := new(google_protobuf1.Empty)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminAuthService.AdminLogin
+	handler := s.JobAdminService.ListJobs
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AdminLoginRequest) (*AdminLoginResponse, error) {
+		handler = func(ctx context.Context, req *google_protobuf1.Empty) (*ListJobsResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLoginRequest)
+					typedReq, ok := req.(*google_protobuf1.Empty)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLoginRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*google_protobuf1.Empty) when calling interceptor")
 					}
-					return s.AdminAuthService.AdminLogin(ctx, typedReq)
+					return s.JobAdminService.ListJobs(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLoginResponse)
+				typedResp, ok := resp.(*ListJobsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLoginResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListJobsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4664,7 +12233,7 @@ func (s *adminAuthServiceServer) serveAdminLoginProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *AdminLoginResponse
+	var respContent *ListJobsResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4675,7 +12244,7 @@ func (s *adminAuthServiceServer) serveAdminLoginProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLoginResponse and nil error while calling AdminLogin. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListJobsResponse and nil error while calling ListJobs. nil responses are not supported"))
 		return
 	}
 
@@ -4699,7 +12268,7 @@ func (s *adminAuthServiceServer) serveAdminLoginProtobuf(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminAuthServiceServer) serveAdminLogout(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) servePauseJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4707,9 +12276,9 @@ func (s *adminAuthServiceServer) serveAdminLogout(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAdminLogoutJSON(ctx, resp, req)
+		s.servePauseJobJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAdminLogoutProtobuf(ctx, resp, req)
+		s.servePauseJobProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4717,9 +12286,9 @@ func (s *adminAuthServiceServer) serveAdminLogout(ctx context.Context, resp http
 	}
 }
 
-func (s *adminAuthServiceServer) serveAdminLogoutJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) servePauseJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
+	ctx = ctxsetters.WithMethodName(ctx, "PauseJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4732,29 +12301,29 @@ func (s *adminAuthServiceServer) serveAdminLogoutJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(AdminLogoutRequest)
+	reqContent := new(PauseJobRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminAuthService.AdminLogout
+	handler := s.JobAdminService.PauseJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+		handler = func(ctx context.Context, req *PauseJobRequest) (*Job, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLogoutRequest)
+					typedReq, ok := req.(*PauseJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PauseJobRequest) when calling interceptor")
 					}
-					return s.AdminAuthService.AdminLogout(ctx, typedReq)
+					return s.JobAdminService.PauseJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLogoutResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4763,7 +12332,7 @@ func (s *adminAuthServiceServer) serveAdminLogoutJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *AdminLogoutResponse
+	var respContent *Job
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4774,7 +12343,7 @@ func (s *adminAuthServiceServer) serveAdminLogoutJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLogoutResponse and nil error while calling AdminLogout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Job and nil error while calling PauseJob. nil responses are not supported"))
 		return
 	}
 
@@ -4800,9 +12369,9 @@ func (s *adminAuthServiceServer) serveAdminLogoutJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminAuthServiceServer) serveAdminLogoutProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) servePauseJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AdminLogout")
+	ctx = ctxsetters.WithMethodName(ctx, "PauseJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4814,28 +12383,28 @@ func (s *adminAuthServiceServer) serveAdminLogoutProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(AdminLogoutRequest)
+	reqContent := new(PauseJobRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminAuthService.AdminLogout
+	handler := s.JobAdminService.PauseJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AdminLogoutRequest) (*AdminLogoutResponse, error) {
+		handler = func(ctx context.Context, req *PauseJobRequest) (*Job, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminLogoutRequest)
+					typedReq, ok := req.(*PauseJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminLogoutRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*PauseJobRequest) when calling interceptor")
 					}
-					return s.AdminAuthService.AdminLogout(ctx, typedReq)
+					return s.JobAdminService.PauseJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminLogoutResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminLogoutResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4844,7 +12413,7 @@ func (s *adminAuthServiceServer) serveAdminLogoutProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *AdminLogoutResponse
+	var respContent *Job
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4855,7 +12424,7 @@ func (s *adminAuthServiceServer) serveAdminLogoutProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminLogoutResponse and nil error while calling AdminLogout. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Job and nil error while calling PauseJob. nil responses are not supported"))
 		return
 	}
 
@@ -4879,7 +12448,7 @@ func (s *adminAuthServiceServer) serveAdminLogoutProtobuf(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminAuthServiceServer) serveAdminWhoAmI(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) serveResumeJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -4887,9 +12456,9 @@ func (s *adminAuthServiceServer) serveAdminWhoAmI(ctx context.Context, resp http
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveAdminWhoAmIJSON(ctx, resp, req)
+		s.serveResumeJobJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveAdminWhoAmIProtobuf(ctx, resp, req)
+		s.serveResumeJobProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -4897,9 +12466,9 @@ func (s *adminAuthServiceServer) serveAdminWhoAmI(ctx context.Context, resp http
 	}
 }
 
-func (s *adminAuthServiceServer) serveAdminWhoAmIJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) serveResumeJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
+	ctx = ctxsetters.WithMethodName(ctx, "ResumeJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4912,29 +12481,29 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIJSON(ctx context.Context, resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(AdminWhoAmIRequest)
+	reqContent := new(ResumeJobRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.AdminAuthService.AdminWhoAmI
+	handler := s.JobAdminService.ResumeJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+		handler = func(ctx context.Context, req *ResumeJobRequest) (*Job, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminWhoAmIRequest)
+					typedReq, ok := req.(*ResumeJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResumeJobRequest) when calling interceptor")
 					}
-					return s.AdminAuthService.AdminWhoAmI(ctx, typedReq)
+					return s.JobAdminService.ResumeJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminWhoAmIResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -4943,7 +12512,7 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIJSON(ctx context.Context, resp
 	}
 
 	// Call service method
-	var respContent *AdminWhoAmIResponse
+	var respContent *Job
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -4954,7 +12523,7 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIJSON(ctx context.Context, resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminWhoAmIResponse and nil error while calling AdminWhoAmI. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Job and nil error while calling ResumeJob. nil responses are not supported"))
 		return
 	}
 
@@ -4980,9 +12549,9 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIJSON(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminAuthServiceServer) serveAdminWhoAmIProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *jobAdminServiceServer) serveResumeJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "AdminWhoAmI")
+	ctx = ctxsetters.WithMethodName(ctx, "ResumeJob")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -4994,28 +12563,28 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIProtobuf(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(AdminWhoAmIRequest)
+	reqContent := new(ResumeJobRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.AdminAuthService.AdminWhoAmI
+	handler := s.JobAdminService.ResumeJob
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AdminWhoAmIRequest) (*AdminWhoAmIResponse, error) {
+		handler = func(ctx context.Context, req *ResumeJobRequest) (*Job, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AdminWhoAmIRequest)
+					typedReq, ok := req.(*ResumeJobRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AdminWhoAmIRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ResumeJobRequest) when calling interceptor")
 					}
-					return s.AdminAuthService.AdminWhoAmI(ctx, typedReq)
+					return s.JobAdminService.ResumeJob(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AdminWhoAmIResponse)
+				typedResp, ok := resp.(*Job)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AdminWhoAmIResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -5024,7 +12593,7 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIProtobuf(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *AdminWhoAmIResponse
+	var respContent *Job
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -5035,7 +12604,7 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIProtobuf(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AdminWhoAmIResponse and nil error while calling AdminWhoAmI. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Job and nil error while calling ResumeJob. nil responses are not supported"))
 		return
 	}
 
@@ -5059,19 +12628,199 @@ func (s *adminAuthServiceServer) serveAdminWhoAmIProtobuf(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *adminAuthServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 3
+func (s *jobAdminServiceServer) serveTriggerJob(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveTriggerJobJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveTriggerJobProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-func (s *adminAuthServiceServer) ProtocGenTwirpVersion() string {
+func (s *jobAdminServiceServer) serveTriggerJobJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "TriggerJob")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(TriggerJobRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.JobAdminService.TriggerJob
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TriggerJobRequest) (*Job, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TriggerJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TriggerJobRequest) when calling interceptor")
+					}
+					return s.JobAdminService.TriggerJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Job)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Job
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Job and nil error while calling TriggerJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *jobAdminServiceServer) serveTriggerJobProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "TriggerJob")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(TriggerJobRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.JobAdminService.TriggerJob
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *TriggerJobRequest) (*Job, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*TriggerJobRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*TriggerJobRequest) when calling interceptor")
+					}
+					return s.JobAdminService.TriggerJob(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*Job)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*Job) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *Job
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Job and nil error while calling TriggerJob. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *jobAdminServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 4
+}
+
+func (s *jobAdminServiceServer) ProtocGenTwirpVersion() string {
 	return "v8.1.3"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *adminAuthServiceServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "issues.v1", "AdminAuthService")
+func (s *jobAdminServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "issues.v1", "JobAdminService")
 }
 
 // =====
@@ -5640,145 +13389,381 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 2231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xf1, 0xf7, 0xec, 0x83, 0xdc, 0x2d, 0x8a, 0xaf, 0x16, 0x29, 0x8d, 0x56, 0xa4, 0x44, 0x8c, 0x2d,
-	0x99, 0xa2, 0x21, 0xae, 0x45, 0x03, 0x36, 0x44, 0xff, 0x0f, 0x5a, 0x51, 0xfe, 0xcb, 0x02, 0xa4,
-	0x44, 0x19, 0x4a, 0x08, 0xe0, 0x1c, 0x06, 0xcd, 0x99, 0xe6, 0x72, 0xc0, 0x79, 0x6c, 0xba, 0x7b,
-	0x28, 0xd1, 0xb9, 0x19, 0x08, 0x02, 0xe4, 0x18, 0xfb, 0x12, 0x20, 0x1f, 0x23, 0x48, 0x2e, 0xb9,
-	0xe4, 0x23, 0x24, 0xf9, 0x08, 0xc9, 0x21, 0xb7, 0x5c, 0x72, 0xcc, 0x21, 0xe8, 0xea, 0x9e, 0xdd,
-	0x99, 0xdd, 0xe1, 0x92, 0x54, 0x0c, 0x04, 0xc8, 0x6d, 0xbb, 0xba, 0xba, 0x7f, 0xd5, 0xd5, 0xf5,
-	0xf8, 0xf5, 0x2c, 0xac, 0x86, 0x42, 0x64, 0x4c, 0x74, 0x4f, 0x1e, 0x74, 0xf1, 0xd7, 0xf6, 0x80,
-	0xa7, 0x32, 0x25, 0x6d, 0x2d, 0xde, 0x3e, 0x79, 0xd0, 0x59, 0xeb, 0xa7, 0x69, 0x3f, 0x62, 0x5d,
-	0x3a, 0x08, 0xbb, 0x34, 0x49, 0x52, 0x49, 0x65, 0x98, 0x26, 0x42, 0x2b, 0x76, 0x6e, 0x9a, 0x59,
-	0x1c, 0x1d, 0x64, 0x87, 0x5d, 0x16, 0x0f, 0xe4, 0xa9, 0x99, 0xbc, 0x3d, 0x3e, 0x29, 0xc3, 0x98,
-	0x09, 0x49, 0xe3, 0x81, 0x56, 0x70, 0xbe, 0x6b, 0x42, 0xf3, 0x99, 0x42, 0x22, 0x0b, 0x50, 0x0b,
-	0x03, 0xdb, 0xda, 0xb0, 0x36, 0xeb, 0x6e, 0x2d, 0x0c, 0xc8, 0x35, 0x98, 0x49, 0xb2, 0xf8, 0x80,
-	0x71, 0xbb, 0xb6, 0x61, 0x6d, 0x36, 0x5d, 0x33, 0x22, 0x2b, 0xd0, 0x94, 0xa1, 0x8c, 0x98, 0x5d,
-	0xdf, 0xb0, 0x36, 0xdb, 0xae, 0x1e, 0x10, 0x02, 0x8d, 0x83, 0x34, 0x38, 0xb5, 0x1b, 0x28, 0xc4,
-	0xdf, 0x4a, 0x53, 0x48, 0x2a, 0x99, 0xdd, 0xd4, 0x9a, 0x38, 0x20, 0xef, 0x43, 0x23, 0x13, 0x8c,
-	0xdb, 0x33, 0x1b, 0xd6, 0xe6, 0xdc, 0xce, 0xe2, 0xf6, 0xf0, 0x9c, 0xdb, 0xaf, 0x05, 0xe3, 0x2e,
-	0x4e, 0x92, 0x4d, 0x98, 0x89, 0xe8, 0x01, 0x8b, 0x84, 0x3d, 0xbb, 0x51, 0xdf, 0x9c, 0xdb, 0x59,
-	0x2a, 0xa8, 0x3d, 0x57, 0x13, 0xae, 0x99, 0x27, 0xf7, 0xa1, 0x4d, 0x85, 0x08, 0xfb, 0x09, 0x63,
-	0xc2, 0x6e, 0xa1, 0xf2, 0xc4, 0x9e, 0x23, 0x0d, 0xd2, 0x81, 0x96, 0x9f, 0xc6, 0x31, 0x4b, 0xa4,
-	0xb0, 0xdb, 0x78, 0xae, 0xe1, 0x98, 0x3c, 0x04, 0xf0, 0x39, 0xa3, 0x92, 0x05, 0x1e, 0x95, 0x36,
-	0xa0, 0x7d, 0x9d, 0x6d, 0xed, 0xc1, 0xed, 0xdc, 0x83, 0xdb, 0xaf, 0x72, 0x0f, 0xba, 0x6d, 0xa3,
-	0xdd, 0x93, 0x6a, 0x69, 0x36, 0x08, 0xf2, 0xa5, 0x73, 0xe7, 0x2f, 0x35, 0xda, 0x3d, 0x49, 0x3e,
-	0x83, 0xb6, 0x1f, 0xa5, 0x42, 0xaf, 0xbc, 0x72, 0xee, 0xca, 0x96, 0x56, 0xee, 0x49, 0x72, 0x03,
-	0x5a, 0x47, 0x32, 0x8e, 0xbc, 0x8c, 0x47, 0xf6, 0x3c, 0x7a, 0x78, 0x56, 0x8d, 0x5f, 0xf3, 0x88,
-	0xec, 0x40, 0x3b, 0x0e, 0x23, 0x26, 0x64, 0x9a, 0x30, 0x7b, 0x01, 0xf7, 0x5c, 0x29, 0x38, 0xe5,
-	0x45, 0x3e, 0xe7, 0x8e, 0xd4, 0xd4, 0x7d, 0x47, 0xa9, 0x7f, 0xcc, 0x02, 0x7b, 0x71, 0xc3, 0xda,
-	0x6c, 0xb9, 0x66, 0x44, 0xd6, 0x01, 0x68, 0xe8, 0x89, 0x2c, 0x8e, 0x29, 0x3f, 0xb5, 0x97, 0x10,
-	0xa8, 0x4d, 0xc3, 0x7d, 0x2d, 0x20, 0x8f, 0x60, 0x31, 0x77, 0xa0, 0x17, 0x30, 0x49, 0xc3, 0xc8,
-	0x5e, 0xc6, 0x5b, 0xb8, 0x5e, 0x00, 0xc4, 0x08, 0xdb, 0xd3, 0x6a, 0xee, 0x42, 0xae, 0xff, 0x04,
-	0xd5, 0x55, 0xe8, 0x70, 0x36, 0x48, 0x6d, 0xa2, 0x43, 0x47, 0xfd, 0x76, 0x0e, 0xa1, 0xa1, 0x6e,
-	0x6e, 0x22, 0x28, 0x57, 0xa0, 0x19, 0xa5, 0xfd, 0x30, 0xc1, 0x98, 0x6c, 0xbb, 0x7a, 0x80, 0x26,
-	0x9e, 0x50, 0x49, 0x39, 0xfa, 0xa2, 0x6e, 0x4c, 0x44, 0x89, 0xf2, 0x46, 0xd1, 0x51, 0x8d, 0x92,
-	0xa3, 0x1c, 0x1f, 0x9a, 0x18, 0x4e, 0x13, 0x40, 0x04, 0x1a, 0x09, 0x8d, 0x99, 0xc1, 0xc1, 0xdf,
-	0x0a, 0xdc, 0x4f, 0xa3, 0x94, 0xe7, 0x91, 0x8f, 0x03, 0xb2, 0x01, 0x73, 0x01, 0x13, 0x3e, 0x0f,
-	0x07, 0x2a, 0x2b, 0x0d, 0x40, 0x51, 0xe4, 0xfc, 0xd6, 0x82, 0xf6, 0xd0, 0xe5, 0xff, 0x61, 0x9e,
-	0x9d, 0x8b, 0x76, 0x46, 0xd6, 0x3d, 0x80, 0x99, 0x20, 0x63, 0x5e, 0x9a, 0x98, 0xbc, 0x9b, 0x16,
-	0x62, 0xcd, 0x20, 0x63, 0x3f, 0x4c, 0x9c, 0xbf, 0x5b, 0x70, 0xa5, 0x78, 0x71, 0x55, 0x3e, 0xc2,
-	0x9c, 0xaf, 0x15, 0x72, 0x3e, 0xcf, 0xee, 0xfa, 0xb4, 0xec, 0x2e, 0x27, 0x5a, 0xe3, 0xdd, 0x13,
-	0xad, 0x79, 0x99, 0x44, 0x2b, 0x86, 0xc1, 0x4c, 0x39, 0x0c, 0x3e, 0x84, 0xe5, 0xfd, 0xd3, 0xc4,
-	0xc7, 0xd3, 0x0a, 0x97, 0xfd, 0x34, 0x63, 0x42, 0x0e, 0xe3, 0xd2, 0x2a, 0xc4, 0x25, 0x87, 0x05,
-	0xa5, 0xe8, 0xb2, 0x41, 0xea, 0x32, 0x91, 0x45, 0x95, 0x5a, 0xc4, 0x86, 0xd9, 0x43, 0x26, 0xfd,
-	0x23, 0x16, 0x98, 0x3b, 0xcd, 0x87, 0x64, 0x0d, 0xda, 0x03, 0xc6, 0x45, 0x28, 0x24, 0x0b, 0xd0,
-	0x47, 0x4d, 0x77, 0x24, 0x50, 0x57, 0xc7, 0x38, 0x4f, 0xb9, 0xb9, 0x56, 0x3d, 0x70, 0xfe, 0x68,
-	0x01, 0x29, 0x5a, 0x27, 0x06, 0x69, 0x22, 0x98, 0xf2, 0x84, 0x90, 0x94, 0x1b, 0x4f, 0x58, 0xe7,
-	0x7b, 0xc2, 0x68, 0xf7, 0x24, 0xf9, 0x1c, 0xe6, 0x0e, 0xc3, 0x24, 0x14, 0x47, 0x7a, 0x6d, 0xed,
-	0xdc, 0xb5, 0x90, 0xab, 0xf7, 0x24, 0xf9, 0x04, 0x66, 0x39, 0x1e, 0x5d, 0xd8, 0x75, 0x4c, 0xf4,
-	0x1b, 0x85, 0x4b, 0x2e, 0x3b, 0xc7, 0xcd, 0x35, 0x9d, 0x3f, 0xd5, 0x60, 0xf5, 0x29, 0x93, 0x6a,
-	0x7a, 0x2f, 0x4d, 0x0e, 0xc3, 0xfe, 0xf0, 0x18, 0x36, 0xcc, 0xb2, 0x84, 0x1e, 0x44, 0x4c, 0x47,
-	0x56, 0xcb, 0xcd, 0x87, 0xca, 0x1b, 0xca, 0x9b, 0xc2, 0xae, 0x6d, 0xd4, 0x95, 0x37, 0x70, 0x40,
-	0xee, 0xc1, 0x52, 0x98, 0x48, 0xc6, 0x4f, 0x68, 0xe4, 0x09, 0xe6, 0xa7, 0x49, 0x20, 0x8c, 0x23,
-	0x17, 0x73, 0xf9, 0xbe, 0x16, 0x93, 0x9b, 0xd0, 0x1e, 0xd0, 0x3e, 0xf3, 0x44, 0xf8, 0x35, 0x43,
-	0x97, 0x36, 0xdd, 0x96, 0x12, 0xec, 0x87, 0x5f, 0x33, 0x72, 0x0f, 0x96, 0x63, 0xfa, 0xd6, 0x53,
-	0x63, 0xe1, 0x0d, 0x18, 0xf7, 0x78, 0x96, 0x60, 0x3c, 0x35, 0xdd, 0x85, 0x98, 0xbe, 0x7d, 0xa9,
-	0xe4, 0x2f, 0x19, 0x77, 0xb3, 0x84, 0x7c, 0x0a, 0xd7, 0xb9, 0x8e, 0x09, 0x4f, 0xb5, 0xcf, 0x34,
-	0x93, 0x43, 0xe4, 0x19, 0x5c, 0xb0, 0x6a, 0xa6, 0x5f, 0xe9, 0xd9, 0x1c, 0xff, 0x0e, 0x2c, 0x08,
-	0x99, 0x72, 0x65, 0x42, 0xc0, 0xc3, 0x13, 0xc6, 0xed, 0x59, 0xbc, 0xd7, 0x79, 0x23, 0x7d, 0x82,
-	0x42, 0xb5, 0x7d, 0x3f, 0x94, 0x47, 0xd9, 0x81, 0x27, 0xd3, 0x63, 0x96, 0x78, 0x3e, 0x3a, 0x28,
-	0xe3, 0x2c, 0xb0, 0x5b, 0xe8, 0x91, 0x55, 0x3d, 0xfd, 0x4a, 0xcd, 0xee, 0x0d, 0x27, 0x9d, 0x7f,
-	0x5a, 0x70, 0xfd, 0x35, 0x46, 0x77, 0xd1, 0xad, 0x3a, 0x76, 0xff, 0x77, 0xbd, 0xea, 0xfc, 0xc1,
-	0x82, 0xc5, 0x17, 0x34, 0xa1, 0x7d, 0x16, 0xe4, 0xd1, 0x56, 0x99, 0x84, 0xe5, 0x22, 0x53, 0x7b,
-	0xf7, 0x22, 0x53, 0xbf, 0x4c, 0x91, 0xb9, 0x0d, 0x73, 0x98, 0x0d, 0x9e, 0x9f, 0x66, 0x89, 0x34,
-	0xfe, 0x81, 0x50, 0x97, 0xd1, 0x2c, 0x91, 0xce, 0x4b, 0x58, 0x7b, 0x1e, 0x0a, 0x39, 0x76, 0x82,
-	0x51, 0x5a, 0x7f, 0x9c, 0xdf, 0x8f, 0x85, 0xc9, 0xd5, 0x29, 0xb6, 0xed, 0xf2, 0x1a, 0x73, 0x77,
-	0xce, 0xa7, 0x70, 0xcb, 0x65, 0x83, 0x88, 0xfa, 0x6c, 0x72, 0x53, 0x1d, 0x0d, 0x2b, 0xc5, 0x3d,
-	0xf3, 0x3b, 0x77, 0xfe, 0x65, 0xe9, 0x62, 0xb6, 0x77, 0xc4, 0xfc, 0xe3, 0x41, 0x1a, 0x26, 0xd5,
-	0xc5, 0xec, 0x11, 0x2c, 0x44, 0x54, 0x48, 0x4f, 0x9c, 0x26, 0xfe, 0x45, 0x7d, 0x79, 0x45, 0xad,
-	0xd8, 0xc7, 0x05, 0x3d, 0x49, 0x5e, 0xc0, 0x2a, 0xee, 0xa0, 0x1d, 0x73, 0x29, 0xcf, 0x12, 0xb5,
-	0x10, 0x0b, 0xdf, 0xeb, 0xa1, 0x8b, 0xef, 0xc2, 0x22, 0x6e, 0xc7, 0xb3, 0xc4, 0x53, 0xcd, 0x2d,
-	0x13, 0xa6, 0x5e, 0xce, 0x2b, 0xb1, 0x9b, 0x25, 0xfb, 0x28, 0x54, 0xac, 0x00, 0xf5, 0x74, 0x49,
-	0xd5, 0xdd, 0xb0, 0xad, 0x24, 0x5f, 0x60, 0x59, 0xfd, 0xdd, 0xa8, 0x24, 0xe9, 0x05, 0xc3, 0x2b,
-	0x78, 0x6c, 0x00, 0x2e, 0x55, 0x5e, 0x11, 0x7c, 0x7f, 0x58, 0x62, 0x9f, 0xc0, 0x12, 0xee, 0x71,
-	0xb9, 0x3a, 0x8b, 0x9e, 0xfe, 0xff, 0x51, 0xad, 0xb5, 0x61, 0x96, 0x67, 0x49, 0x12, 0x26, 0x7d,
-	0xf4, 0x55, 0xcb, 0xcd, 0x87, 0xe4, 0xff, 0xe0, 0x8a, 0x76, 0x82, 0x29, 0xc5, 0x8d, 0xf3, 0x4a,
-	0xf1, 0x1c, 0x3a, 0x47, 0x6b, 0xab, 0x06, 0xe0, 0x0f, 0x6f, 0x5d, 0xd8, 0xcd, 0xca, 0xc5, 0xa3,
-	0xb8, 0x70, 0x8b, 0xda, 0x4e, 0x02, 0xcb, 0x2a, 0x82, 0xcf, 0x6d, 0x96, 0x23, 0x26, 0x52, 0x2b,
-	0x32, 0x11, 0x02, 0x0d, 0x55, 0x1e, 0x4c, 0x79, 0xc1, 0xdf, 0x53, 0x6b, 0x8a, 0xf3, 0x4b, 0x0b,
-	0x48, 0x11, 0xd0, 0xdc, 0xd2, 0x26, 0xcc, 0x68, 0x7b, 0x4d, 0xa6, 0x2c, 0x8d, 0xf3, 0x4d, 0xd7,
-	0xcc, 0x0f, 0x11, 0x6b, 0x67, 0x21, 0xd6, 0xc7, 0xaa, 0x98, 0x62, 0x0a, 0x54, 0x78, 0x09, 0x7b,
-	0xab, 0x33, 0xb8, 0xe5, 0xce, 0x1e, 0x51, 0xf1, 0x03, 0xf6, 0x56, 0x3a, 0x47, 0xb0, 0xf8, 0x94,
-	0x69, 0x53, 0xa6, 0x1d, 0xfd, 0x26, 0xb4, 0x74, 0xb4, 0x87, 0x9a, 0x02, 0xd4, 0xbf, 0x7c, 0xcf,
-	0x9d, 0x45, 0xc9, 0xb3, 0x80, 0xd8, 0x43, 0xc6, 0x87, 0xc0, 0x5f, 0xbe, 0x97, 0x73, 0xbe, 0xc7,
-	0x00, 0x2d, 0xc1, 0x22, 0xe6, 0xcb, 0x94, 0x3b, 0xbb, 0xb0, 0x34, 0x42, 0x32, 0x67, 0xbe, 0x0b,
-	0x4d, 0xdc, 0xc4, 0xc4, 0xe3, 0xe4, 0x91, 0xf5, 0xb4, 0xf3, 0xad, 0x05, 0x37, 0x75, 0xc2, 0xa0,
-	0xb8, 0xf7, 0xcc, 0xb0, 0xf5, 0xef, 0xdf, 0xe4, 0xb1, 0xe7, 0x41, 0x63, 0xec, 0x79, 0x50, 0x3a,
-	0xd1, 0x0e, 0xac, 0xed, 0x45, 0x8c, 0xf2, 0x92, 0x4d, 0xe1, 0x74, 0xc2, 0xf5, 0x10, 0xd6, 0xcf,
-	0x58, 0x33, 0xe2, 0x0f, 0xbe, 0x52, 0x30, 0x9d, 0xae, 0xe9, 0xe6, 0x43, 0x67, 0x0f, 0x96, 0x7b,
-	0x41, 0x1c, 0x26, 0xcf, 0xd5, 0x1b, 0xa1, 0x80, 0x81, 0xfc, 0xd4, 0x60, 0x20, 0x1d, 0xed, 0x40,
-	0x6b, 0x40, 0x85, 0x78, 0x93, 0xf2, 0xc0, 0x84, 0xea, 0x70, 0xec, 0xfc, 0xda, 0x02, 0x52, 0xdc,
-	0x65, 0x84, 0x2a, 0x32, 0xdf, 0x67, 0x42, 0xe4, 0xfd, 0xd5, 0x0c, 0xd5, 0x4c, 0xcc, 0x84, 0xc8,
-	0xe3, 0xad, 0xed, 0xe6, 0x43, 0x24, 0xf4, 0xaa, 0x85, 0x0f, 0x09, 0xbd, 0x1a, 0xa8, 0x5e, 0xc3,
-	0xde, 0x0e, 0x42, 0xce, 0xc4, 0x05, 0xb9, 0xb0, 0xd1, 0xee, 0x49, 0x67, 0x6b, 0x64, 0x5a, 0x9a,
-	0xc9, 0x42, 0xb1, 0xd7, 0x30, 0x56, 0x01, 0xc6, 0x79, 0x06, 0x57, 0x4b, 0xba, 0xef, 0x7e, 0x0e,
-	0x67, 0xc5, 0xc0, 0xfe, 0xf8, 0x28, 0xed, 0xc5, 0xcf, 0x0c, 0xac, 0x13, 0x18, 0x80, 0x5c, 0x6a,
-	0x00, 0xaa, 0xfc, 0x5d, 0x3e, 0x72, 0xed, 0x32, 0x47, 0xfe, 0xa6, 0x0e, 0xad, 0x97, 0xae, 0xcb,
-	0x4e, 0x42, 0xf6, 0xa6, 0xea, 0x3d, 0x82, 0xf1, 0x53, 0x2b, 0x44, 0xf5, 0x8d, 0x42, 0x54, 0xd7,
-	0x51, 0x73, 0x18, 0xd3, 0xa3, 0x87, 0x57, 0xa3, 0xf4, 0xf0, 0xba, 0x03, 0x0b, 0x1c, 0x01, 0x86,
-	0x51, 0xad, 0x7b, 0xc7, 0xbc, 0x96, 0xe6, 0x0f, 0xdf, 0x75, 0x00, 0x1e, 0x8a, 0x63, 0x8f, 0x72,
-	0x46, 0x85, 0x79, 0x50, 0xb4, 0x95, 0xa4, 0xa7, 0x04, 0xea, 0xa1, 0x26, 0xb2, 0x7e, 0x9f, 0x09,
-	0xfc, 0x56, 0x63, 0x98, 0x5f, 0x51, 0x44, 0x1c, 0x98, 0xe7, 0xf4, 0x8d, 0x17, 0x84, 0x87, 0x87,
-	0xba, 0x0c, 0xb5, 0xd0, 0x8c, 0x39, 0x4e, 0xdf, 0x3c, 0x09, 0x0f, 0x0f, 0xb1, 0x12, 0xad, 0x03,
-	0xc4, 0x69, 0xc0, 0x22, 0x2f, 0x13, 0x2c, 0xc0, 0x0f, 0x16, 0x6d, 0xb7, 0x8d, 0x92, 0xd7, 0x82,
-	0x05, 0xff, 0x9d, 0x2f, 0x16, 0xce, 0x4f, 0x60, 0x45, 0xd5, 0xe3, 0xfc, 0x1e, 0xa6, 0xf6, 0x80,
-	0xcb, 0xd6, 0x5e, 0xe7, 0x57, 0x16, 0xac, 0x8e, 0xed, 0x6e, 0x42, 0xe9, 0xbe, 0x7a, 0x78, 0xa0,
-	0xc8, 0x54, 0xfc, 0xab, 0x85, 0xf2, 0x97, 0xab, 0xbb, 0xb9, 0xce, 0xf7, 0x5a, 0xf5, 0x1f, 0x01,
-	0x79, 0xca, 0x86, 0x26, 0x4d, 0x3b, 0xef, 0x19, 0xaf, 0x79, 0xe7, 0x31, 0x5c, 0x2d, 0xed, 0x60,
-	0xce, 0xf4, 0x11, 0xcc, 0x68, 0x7b, 0x4d, 0x45, 0xaf, 0x3c, 0x92, 0x51, 0xd9, 0xf9, 0x7d, 0x0b,
-	0x56, 0xb1, 0x0e, 0xaa, 0xee, 0x8c, 0xc9, 0xb6, 0xcf, 0xf8, 0x49, 0xe8, 0x33, 0x92, 0x02, 0x8c,
-	0x5e, 0x88, 0x64, 0x6d, 0xac, 0x91, 0x97, 0x3a, 0x75, 0x67, 0xfd, 0x8c, 0x59, 0x6d, 0x91, 0xf3,
-	0xc1, 0x37, 0x7f, 0xf9, 0xdb, 0xb7, 0xb5, 0x5b, 0xbb, 0xd6, 0x96, 0x73, 0x03, 0xbf, 0x38, 0x9e,
-	0x3c, 0xe8, 0x52, 0x85, 0xa5, 0xbf, 0x4e, 0x8a, 0x5d, 0xc5, 0x03, 0x89, 0x80, 0xf9, 0xd2, 0x73,
-	0x8e, 0x5c, 0x9b, 0x08, 0x9d, 0x2f, 0xe2, 0x81, 0x3c, 0xed, 0x6c, 0x14, 0xd0, 0x2a, 0x1f, 0x80,
-	0xce, 0x26, 0x02, 0x3a, 0x64, 0xa3, 0x0a, 0xad, 0xab, 0xd0, 0xee, 0xeb, 0x17, 0x11, 0xf9, 0xb9,
-	0x05, 0x4b, 0xe3, 0x0f, 0x1e, 0xe2, 0x14, 0x3f, 0x31, 0x54, 0xbf, 0x86, 0x2e, 0x60, 0xc4, 0x47,
-	0x68, 0xc4, 0x9d, 0x5d, 0x6b, 0x6b, 0xe7, 0x7c, 0x3b, 0x7e, 0xa6, 0xe3, 0x7f, 0x9c, 0x6d, 0x9f,
-	0xe9, 0x83, 0x0f, 0x8b, 0x1f, 0x2f, 0xa7, 0x70, 0x7f, 0xc7, 0x41, 0x2b, 0xd6, 0x48, 0xa7, 0xd2,
-	0x04, 0xfd, 0x52, 0xfb, 0xce, 0x82, 0xeb, 0x67, 0xd0, 0x7d, 0x72, 0xaf, 0x00, 0x34, 0xfd, 0x49,
-	0x70, 0x71, 0x9b, 0xee, 0xa0, 0x4d, 0xb7, 0x77, 0xad, 0xad, 0xce, 0x34, 0xb3, 0x46, 0x01, 0x61,
-	0xd8, 0xf7, 0x25, 0x02, 0xa2, 0x4c, 0xbf, 0x2f, 0x12, 0x10, 0x9a, 0xf6, 0x93, 0x5f, 0x58, 0xb0,
-	0x52, 0x45, 0x73, 0xc8, 0xdd, 0x89, 0xa0, 0xa8, 0xe4, 0x41, 0x9d, 0x9b, 0x65, 0x63, 0x4a, 0x64,
-	0xcb, 0xd9, 0x42, 0x3b, 0x3e, 0x50, 0x31, 0x71, 0xbb, 0xd2, 0x14, 0x1a, 0xde, 0x37, 0x1d, 0x82,
-	0xfc, 0xc6, 0x82, 0xd5, 0x4a, 0x9e, 0x42, 0x8a, 0x8e, 0x9e, 0xc6, 0x7e, 0x3a, 0x9b, 0xe7, 0x2b,
-	0x1a, 0xc3, 0x3e, 0x46, 0xc3, 0xb6, 0x54, 0x8a, 0xde, 0x39, 0xc7, 0xb0, 0x5d, 0x24, 0x43, 0x3b,
-	0x7f, 0xb6, 0x60, 0x19, 0xb7, 0xfb, 0x51, 0xc6, 0xf8, 0x69, 0x5e, 0x35, 0x28, 0xc0, 0x88, 0x57,
-	0x97, 0xaa, 0xc6, 0x04, 0xbf, 0x2f, 0x55, 0x8d, 0x49, 0x32, 0xee, 0x5c, 0x43, 0x93, 0x96, 0xc8,
-	0x42, 0x6e, 0x8f, 0xa1, 0xde, 0x5f, 0x41, 0x2b, 0xf7, 0x2b, 0xe9, 0x54, 0x3a, 0xfb, 0x02, 0x17,
-	0xb1, 0x8a, 0x9b, 0x2f, 0x92, 0xf9, 0xd2, 0xe6, 0x3b, 0xff, 0xb0, 0x60, 0x25, 0xaf, 0x91, 0xa5,
-	0x73, 0x25, 0x30, 0x5f, 0xea, 0x20, 0xe4, 0xf6, 0x98, 0xf1, 0xe3, 0x9d, 0xab, 0x14, 0x94, 0x95,
-	0xcd, 0xc7, 0xe9, 0xa0, 0x0d, 0x2b, 0x84, 0xe4, 0x36, 0x0c, 0xf8, 0xfd, 0xbc, 0xd3, 0xf4, 0x61,
-	0xae, 0x50, 0xdb, 0xc9, 0x7a, 0xf9, 0x2c, 0x63, 0x5d, 0xa3, 0x73, 0xeb, 0xac, 0x69, 0x83, 0x74,
-	0x03, 0x91, 0xae, 0x92, 0xe5, 0x09, 0xa4, 0x9d, 0xbf, 0xd6, 0x60, 0x09, 0xeb, 0x7e, 0x2f, 0x93,
-	0x47, 0xa3, 0xd3, 0xc2, 0x88, 0xa0, 0x96, 0x6e, 0x71, 0x82, 0xfd, 0x96, 0x6e, 0x71, 0x92, 0xd5,
-	0x3a, 0xef, 0x23, 0xf4, 0xba, 0x0a, 0x2c, 0xbb, 0x1c, 0x58, 0x34, 0x93, 0x47, 0xbb, 0xfa, 0x63,
-	0x3b, 0x87, 0xb9, 0x02, 0x93, 0x24, 0x55, 0x5b, 0x8e, 0xd8, 0x68, 0xe9, 0xb4, 0x15, 0x04, 0x74,
-	0x4a, 0xbb, 0xc9, 0x21, 0x15, 0xc8, 0xb1, 0xc1, 0xd4, 0xe4, 0x72, 0x12, 0xb3, 0x44, 0x45, 0x27,
-	0x31, 0xcb, 0x9c, 0xd4, 0x59, 0x47, 0xcc, 0xeb, 0x64, 0xb5, 0x02, 0x30, 0x66, 0x8f, 0x1f, 0x7e,
-	0xf5, 0x99, 0xfe, 0xe0, 0xb6, 0xed, 0xa7, 0x71, 0xf7, 0x38, 0x4d, 0xfa, 0xc7, 0x2c, 0xe9, 0x06,
-	0x54, 0x52, 0xc1, 0x4f, 0xba, 0x83, 0xe3, 0xbe, 0xfe, 0x37, 0xad, 0x3b, 0xfc, 0xdf, 0xee, 0x73,
-	0xfd, 0xeb, 0xe4, 0xc1, 0xc1, 0x0c, 0xce, 0x7c, 0xf2, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb5,
-	0x86, 0x6a, 0x75, 0xd4, 0x1b, 0x00, 0x00,
+	// 6006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x5d, 0x8c, 0x24, 0xc9,
+	0x51, 0xb0, 0xab, 0x7f, 0x66, 0xba, 0x63, 0x7a, 0xfe, 0x72, 0x66, 0x76, 0x7b, 0x7b, 0x77, 0x76,
+	0x67, 0x6b, 0x6f, 0xf7, 0xe6, 0xf6, 0xbc, 0x3b, 0x77, 0xe3, 0xcf, 0xf6, 0xb7, 0x73, 0xe7, 0xef,
+	0x6e, 0x76, 0xef, 0x7c, 0xbb, 0xa7, 0x3d, 0x7f, 0x4b, 0xcd, 0x9e, 0x2d, 0x8c, 0xa0, 0x55, 0xdd,
+	0x9d, 0x33, 0x53, 0xb7, 0xdd, 0x55, 0xed, 0xaa, 0xea, 0xb9, 0x1d, 0x0e, 0x0b, 0x61, 0x0b, 0x30,
+	0x20, 0x64, 0x21, 0x5b, 0x60, 0x4b, 0x48, 0x20, 0x19, 0x81, 0xc4, 0x33, 0x02, 0x84, 0x64, 0xde,
+	0x10, 0x7f, 0x42, 0x42, 0xb2, 0x10, 0x48, 0x3c, 0x20, 0x61, 0x1e, 0x78, 0x43, 0xe2, 0x89, 0x07,
+	0x90, 0x50, 0x44, 0x66, 0x56, 0x65, 0x56, 0x57, 0x75, 0xf7, 0xdc, 0x9d, 0x39, 0x30, 0x6f, 0x9d,
+	0x99, 0x51, 0x19, 0x91, 0x11, 0x91, 0x91, 0x91, 0x11, 0x91, 0x0d, 0x1b, 0x5e, 0x14, 0x8d, 0x78,
+	0xb4, 0x73, 0xf2, 0xe2, 0x0e, 0xfd, 0xba, 0x3d, 0x0c, 0x83, 0x38, 0x60, 0x75, 0xd1, 0x7d, 0xfb,
+	0xe4, 0xc5, 0xd6, 0xa5, 0xa3, 0x20, 0x38, 0xea, 0xf3, 0x1d, 0x77, 0xe8, 0xed, 0xb8, 0xbe, 0x1f,
+	0xc4, 0x6e, 0xec, 0x05, 0x7e, 0x24, 0x00, 0x5b, 0x17, 0xe5, 0x28, 0xb5, 0x3a, 0xa3, 0xc3, 0x1d,
+	0x3e, 0x18, 0xc6, 0xa7, 0x72, 0xf0, 0x4a, 0x76, 0x30, 0xf6, 0x06, 0x3c, 0x8a, 0xdd, 0xc1, 0x50,
+	0x00, 0xd8, 0x7f, 0x33, 0x07, 0xd5, 0x07, 0x88, 0x89, 0x2d, 0x41, 0xc9, 0xeb, 0x35, 0xad, 0x2d,
+	0x6b, 0xbb, 0xec, 0x94, 0xbc, 0x1e, 0x3b, 0x07, 0x73, 0xfe, 0x68, 0xd0, 0xe1, 0x61, 0xb3, 0xb4,
+	0x65, 0x6d, 0x57, 0x1d, 0xd9, 0x62, 0xeb, 0x50, 0x8d, 0xbd, 0xb8, 0xcf, 0x9b, 0xe5, 0x2d, 0x6b,
+	0xbb, 0xee, 0x88, 0x06, 0x63, 0x50, 0xe9, 0x04, 0xbd, 0xd3, 0x66, 0x85, 0x3a, 0xe9, 0x37, 0x42,
+	0x46, 0xb1, 0x1b, 0xf3, 0x66, 0x55, 0x40, 0x52, 0x83, 0x5d, 0x83, 0xca, 0x28, 0xe2, 0x61, 0x73,
+	0x6e, 0xcb, 0xda, 0x5e, 0xd8, 0x5d, 0xbe, 0x9d, 0xac, 0xf3, 0xf6, 0xdb, 0x11, 0x0f, 0x1d, 0x1a,
+	0x64, 0xdb, 0x30, 0xd7, 0x77, 0x3b, 0xbc, 0x1f, 0x35, 0xe7, 0xb7, 0xca, 0xdb, 0x0b, 0xbb, 0x2b,
+	0x1a, 0xd8, 0x43, 0x1c, 0x70, 0xe4, 0x38, 0xbb, 0x05, 0x75, 0x37, 0x8a, 0xbc, 0x23, 0x9f, 0xf3,
+	0xa8, 0x59, 0xdb, 0x2a, 0xe7, 0xcd, 0x99, 0x42, 0xb0, 0x16, 0xd4, 0xba, 0xc1, 0x60, 0xc0, 0xfd,
+	0x38, 0x6a, 0xd6, 0x69, 0x5d, 0x49, 0x9b, 0xdd, 0x01, 0xe8, 0x86, 0xdc, 0x8d, 0x79, 0xaf, 0xed,
+	0xc6, 0x4d, 0x20, 0xfa, 0x5a, 0xb7, 0x05, 0x07, 0x6f, 0x2b, 0x0e, 0xde, 0x7e, 0xac, 0x38, 0xe8,
+	0xd4, 0x25, 0xf4, 0x7e, 0x8c, 0x9f, 0x8e, 0x86, 0x3d, 0xf5, 0xe9, 0xc2, 0xf4, 0x4f, 0x25, 0xf4,
+	0x7e, 0xcc, 0x3e, 0x0d, 0xf5, 0x6e, 0x3f, 0x88, 0xc4, 0x97, 0x8d, 0xa9, 0x5f, 0xd6, 0x04, 0xf0,
+	0x7e, 0xcc, 0x2e, 0x40, 0xed, 0x38, 0x1e, 0xf4, 0xdb, 0xa3, 0xb0, 0xdf, 0x5c, 0x24, 0x0e, 0xcf,
+	0x63, 0xfb, 0xed, 0xb0, 0xcf, 0x76, 0xa1, 0x3e, 0xf0, 0xfa, 0x3c, 0x8a, 0x03, 0x9f, 0x37, 0x97,
+	0x68, 0xce, 0x75, 0x8d, 0x29, 0x6f, 0xa9, 0x31, 0x27, 0x05, 0x43, 0x79, 0xf7, 0x83, 0xee, 0x13,
+	0xde, 0x6b, 0x2e, 0x6f, 0x59, 0xdb, 0x35, 0x47, 0xb6, 0xd8, 0x26, 0x80, 0xeb, 0xb5, 0xa3, 0xd1,
+	0x60, 0xe0, 0x86, 0xa7, 0xcd, 0x15, 0x42, 0x54, 0x77, 0xbd, 0x03, 0xd1, 0xc1, 0x5e, 0x85, 0x65,
+	0xc5, 0xc0, 0x76, 0x8f, 0xc7, 0xae, 0xd7, 0x6f, 0xae, 0x92, 0x14, 0xce, 0x6b, 0x08, 0x49, 0xc3,
+	0xee, 0x09, 0x30, 0x67, 0x49, 0xc1, 0xbf, 0x46, 0xe0, 0xa8, 0x3a, 0x21, 0x1f, 0x06, 0x4d, 0x26,
+	0x54, 0x07, 0x7f, 0xb3, 0x4b, 0x50, 0x8f, 0x83, 0x41, 0x47, 0x2c, 0x60, 0x4d, 0xe0, 0x4c, 0x3a,
+	0xd8, 0x2b, 0xb0, 0x98, 0x34, 0x88, 0x6d, 0xeb, 0x53, 0xd9, 0xd6, 0x48, 0x3f, 0xd8, 0x8f, 0xd9,
+	0x75, 0x58, 0x8a, 0x43, 0xd7, 0x8f, 0x0e, 0x79, 0x18, 0xf2, 0x5e, 0x3b, 0x0e, 0x9a, 0x1b, 0x84,
+	0x63, 0x51, 0xeb, 0x7d, 0x1c, 0xb0, 0x3b, 0xd0, 0x18, 0x8e, 0xfa, 0xfd, 0x76, 0xc8, 0xbf, 0x34,
+	0xe2, 0x51, 0xdc, 0x3c, 0x47, 0x68, 0xce, 0x69, 0x0b, 0x7b, 0x34, 0xea, 0xf7, 0x1d, 0x31, 0xea,
+	0x2c, 0x0c, 0xd3, 0x86, 0xfd, 0xc7, 0x15, 0x58, 0xd0, 0x06, 0x91, 0xbb, 0x03, 0x1e, 0x1e, 0x71,
+	0xb1, 0xc3, 0x6a, 0x8e, 0x6c, 0xa1, 0xf4, 0xc5, 0x2f, 0x5c, 0x46, 0x69, 0xba, 0xf4, 0x05, 0xf0,
+	0x7e, 0xcc, 0x2e, 0x26, 0x1f, 0x76, 0x4e, 0xe5, 0x56, 0x94, 0x83, 0x77, 0x69, 0xe7, 0xf5, 0x42,
+	0xf7, 0x30, 0xa6, 0xed, 0x58, 0x73, 0x44, 0x83, 0x14, 0x86, 0xbb, 0xbd, 0x76, 0xc8, 0x0f, 0xe5,
+	0x96, 0x9c, 0xc7, 0xb6, 0xc3, 0x0f, 0x93, 0xa1, 0xe8, 0xd8, 0x6d, 0xce, 0xa5, 0x43, 0x07, 0xc7,
+	0x2e, 0x0e, 0x75, 0xdc, 0x88, 0xd3, 0x57, 0xf3, 0x62, 0x08, 0xdb, 0xf8, 0xd5, 0x25, 0xa8, 0xbb,
+	0xbd, 0x9e, 0x47, 0xd6, 0xa8, 0x59, 0xa3, 0xdd, 0x94, 0x76, 0xe0, 0x68, 0x8f, 0xf7, 0xb9, 0x18,
+	0x15, 0x7b, 0x2d, 0xed, 0x60, 0xd7, 0x60, 0xb1, 0x7b, 0xec, 0xfa, 0xb8, 0x80, 0x43, 0xd4, 0x41,
+	0xda, 0x6f, 0x55, 0xa7, 0x21, 0x3b, 0x3f, 0x8b, 0x7d, 0x6c, 0x07, 0xd6, 0x24, 0xef, 0x39, 0x92,
+	0x7d, 0xe2, 0xf1, 0x77, 0x79, 0x18, 0x35, 0x17, 0xb6, 0xca, 0xdb, 0x75, 0x87, 0x25, 0x43, 0x8e,
+	0x1a, 0x61, 0xcf, 0xc2, 0x72, 0xfa, 0x41, 0xcc, 0xdd, 0x41, 0xd4, 0x6c, 0x10, 0xf0, 0x52, 0xd2,
+	0xfd, 0x18, 0x7b, 0xd9, 0x67, 0x60, 0x5e, 0xcc, 0x17, 0x35, 0x17, 0x49, 0x5d, 0xaf, 0x15, 0x48,
+	0x95, 0x80, 0x3e, 0xcf, 0xc3, 0x9e, 0xd7, 0x8d, 0x1d, 0xf5, 0x8d, 0xc0, 0x83, 0x3f, 0xdb, 0x3d,
+	0xde, 0xf5, 0x22, 0x2f, 0xf0, 0x69, 0x9b, 0x11, 0x1e, 0xec, 0x7e, 0x4d, 0xf6, 0xa2, 0x7c, 0xa3,
+	0x53, 0xbf, 0x2b, 0xe4, 0xbb, 0x3c, 0x5d, 0xbe, 0x02, 0x78, 0x3f, 0xb6, 0x7f, 0xc9, 0x82, 0x66,
+	0x11, 0x1d, 0x68, 0xc5, 0x14, 0x37, 0x48, 0x9f, 0xea, 0x4e, 0xd2, 0x4e, 0xad, 0x6e, 0x49, 0xb7,
+	0xba, 0x9f, 0x81, 0x46, 0x34, 0xea, 0x0c, 0xbc, 0x58, 0x9a, 0xa8, 0xf2, 0x54, 0x52, 0x16, 0x12,
+	0xf8, 0xfd, 0xd8, 0x3e, 0x84, 0x0a, 0x5a, 0xd2, 0xb1, 0x43, 0x62, 0x1d, 0xaa, 0xfd, 0xe0, 0xc8,
+	0xf3, 0x15, 0x32, 0x6a, 0x90, 0xc9, 0x38, 0x71, 0x63, 0x37, 0x24, 0xdb, 0x54, 0x96, 0x26, 0x83,
+	0x7a, 0xd0, 0x3a, 0xe9, 0x86, 0xab, 0x62, 0x18, 0x2e, 0xfb, 0xbb, 0x16, 0x54, 0xc9, 0xbe, 0x8f,
+	0x61, 0x62, 0x50, 0xf1, 0xdd, 0x81, 0x5a, 0x15, 0xfd, 0x46, 0xec, 0xdd, 0xa0, 0x1f, 0x84, 0xea,
+	0x28, 0xa2, 0x06, 0xdb, 0x82, 0x85, 0x1e, 0x8f, 0xba, 0xa1, 0x37, 0x44, 0x4d, 0x93, 0x18, 0xf4,
+	0xae, 0xc4, 0xe2, 0x54, 0x35, 0x8b, 0x73, 0x05, 0x16, 0x82, 0x21, 0xf7, 0xdb, 0x42, 0x0b, 0x68,
+	0x13, 0x54, 0x1d, 0xc0, 0x2e, 0x32, 0x5e, 0x42, 0x61, 0x85, 0x9d, 0x96, 0x20, 0xf3, 0x52, 0x61,
+	0xa9, 0x53, 0x00, 0xd9, 0x7f, 0x5a, 0x82, 0x7a, 0x62, 0x5d, 0x3f, 0xe0, 0x91, 0x3a, 0x7d, 0x1d,
+	0xf9, 0x07, 0xec, 0x8b, 0x30, 0xd7, 0x1b, 0xf1, 0x76, 0xe0, 0x37, 0xe7, 0xa6, 0x0a, 0xb9, 0xda,
+	0x1b, 0xf1, 0xff, 0xef, 0x9b, 0x67, 0xd0, 0xfc, 0x19, 0xce, 0x20, 0xc5, 0xc9, 0x5a, 0x31, 0x27,
+	0xeb, 0xd3, 0x39, 0x09, 0x39, 0x9c, 0xfc, 0x67, 0x0b, 0x1a, 0xfa, 0xb1, 0x91, 0xa7, 0x10, 0xe4,
+	0x71, 0x94, 0x34, 0x8f, 0x43, 0xf9, 0x16, 0xe5, 0x49, 0xbe, 0x85, 0x79, 0xcc, 0x57, 0xde, 0xff,
+	0x31, 0x5f, 0x3d, 0xcb, 0x31, 0xaf, 0x2b, 0xfd, 0x9c, 0xa9, 0xf4, 0xcf, 0xc2, 0xea, 0xc1, 0xa9,
+	0xdf, 0x15, 0x0b, 0x57, 0x07, 0x86, 0xe2, 0xac, 0x95, 0x72, 0xd6, 0x0e, 0x61, 0x09, 0x01, 0x1d,
+	0x3e, 0x0c, 0x1c, 0x1e, 0x8d, 0xfa, 0xb9, 0x50, 0xac, 0x09, 0xf3, 0x87, 0x3c, 0xee, 0x1e, 0xf3,
+	0x9e, 0x54, 0x33, 0xd5, 0x44, 0x8b, 0x3c, 0xe4, 0x61, 0xe4, 0xa1, 0x19, 0x24, 0x1e, 0x55, 0x9d,
+	0xb4, 0x03, 0xb5, 0x89, 0x87, 0x61, 0x10, 0x4a, 0x4d, 0x13, 0x0d, 0xfb, 0xb7, 0x2c, 0x60, 0x3a,
+	0x75, 0xd1, 0x30, 0xf0, 0x23, 0x8e, 0x9c, 0x88, 0x62, 0x37, 0x94, 0x9c, 0xb0, 0xa6, 0x73, 0x42,
+	0x42, 0xef, 0xc7, 0x6c, 0x03, 0xe6, 0xde, 0x09, 0x3a, 0x6d, 0xaf, 0xa7, 0x10, 0xbd, 0x13, 0x74,
+	0x1e, 0xf4, 0xd0, 0xa6, 0xb9, 0x71, 0xec, 0x12, 0xdd, 0x55, 0x3a, 0xb6, 0x92, 0xf6, 0x9b, 0x95,
+	0x5a, 0x69, 0xa5, 0xfc, 0x66, 0xa5, 0x56, 0x5e, 0xa9, 0x38, 0x0b, 0x87, 0x9e, 0xef, 0x45, 0xc7,
+	0x84, 0x18, 0x0d, 0x32, 0xf2, 0x21, 0xb2, 0xaf, 0xc3, 0xda, 0x17, 0xdc, 0xb8, 0x7b, 0x8c, 0xa4,
+	0xbe, 0x19, 0x74, 0x14, 0x17, 0x53, 0xa5, 0xa9, 0xa3, 0xd2, 0xd8, 0xff, 0x60, 0x41, 0x43, 0x82,
+	0xbc, 0x7e, 0xc2, 0x7d, 0x9d, 0x18, 0x4b, 0x27, 0x86, 0x41, 0x25, 0x3e, 0x1d, 0x26, 0xd6, 0x06,
+	0x7f, 0xe3, 0xee, 0x8d, 0xdd, 0xf0, 0x88, 0xc7, 0x72, 0x9b, 0xca, 0x16, 0xc2, 0x0e, 0xdd, 0x23,
+	0x4e, 0xab, 0xa9, 0x3a, 0xf4, 0x5b, 0x97, 0x41, 0x75, 0x82, 0x0c, 0xe6, 0x0a, 0x65, 0x30, 0xaf,
+	0xc9, 0x80, 0xdd, 0x84, 0x92, 0x1b, 0x37, 0x6b, 0x53, 0x99, 0x5c, 0x72, 0x63, 0xfb, 0xe3, 0x70,
+	0xce, 0xe1, 0xdd, 0xc0, 0xef, 0x7a, 0x7d, 0x3e, 0x5d, 0xa3, 0xfe, 0xc0, 0x82, 0xb5, 0x04, 0x7c,
+	0xba, 0x5e, 0x75, 0x8f, 0x39, 0x79, 0x88, 0x52, 0xaf, 0x64, 0x93, 0x5d, 0x06, 0x48, 0xdd, 0x2b,
+	0xa9, 0x58, 0x5a, 0x0f, 0x5a, 0x32, 0xcd, 0xb1, 0x92, 0x8c, 0xd2, 0xbb, 0xc4, 0x81, 0x16, 0xc5,
+	0x41, 0x98, 0x30, 0x2c, 0x69, 0xa7, 0x3c, 0x99, 0xd3, 0xf5, 0xf2, 0x2f, 0x2d, 0x38, 0x3f, 0xb6,
+	0xd0, 0x0f, 0xae, 0x9c, 0x2f, 0x81, 0xae, 0x5f, 0x33, 0x78, 0x64, 0xa0, 0xc0, 0xf7, 0x63, 0xf6,
+	0x7f, 0x41, 0xe9, 0x63, 0xb3, 0x4c, 0x4e, 0xc5, 0x65, 0xcd, 0x02, 0xe5, 0xb0, 0x39, 0x55, 0xdf,
+	0x9f, 0x86, 0x8b, 0x6f, 0x79, 0x47, 0xa1, 0x1b, 0x73, 0xdd, 0xe6, 0x45, 0x9a, 0xf7, 0x18, 0x05,
+	0xa3, 0xb0, 0xcb, 0xa5, 0x40, 0x64, 0x4b, 0x53, 0xc9, 0x52, 0x56, 0x25, 0x49, 0x7c, 0x65, 0xd3,
+	0xa5, 0x0e, 0x4e, 0x78, 0xf8, 0x6e, 0xe8, 0xc5, 0x5c, 0xfa, 0x85, 0x69, 0x87, 0xfd, 0x27, 0x16,
+	0x5c, 0xd4, 0x51, 0x0b, 0x6a, 0xbc, 0xc0, 0x9f, 0xac, 0x10, 0x51, 0xd7, 0xf5, 0xfd, 0x54, 0x21,
+	0x64, 0x13, 0xe9, 0xea, 0x06, 0x43, 0x2f, 0x51, 0x06, 0xd9, 0xc2, 0x2f, 0x06, 0x5e, 0x14, 0x79,
+	0xfe, 0x91, 0x54, 0x02, 0xd5, 0xa4, 0xb9, 0x9e, 0x78, 0xc3, 0x61, 0xba, 0x61, 0x64, 0x13, 0xe7,
+	0x3a, 0x74, 0xbd, 0x7e, 0xb2, 0x5b, 0x64, 0x2b, 0x7f, 0xab, 0xd8, 0x7f, 0x67, 0xc1, 0xa5, 0x7c,
+	0x4e, 0x7e, 0xc4, 0xba, 0xf1, 0x6a, 0x56, 0x37, 0x6e, 0x14, 0xdc, 0x8f, 0x32, 0x9c, 0x4f, 0x75,
+	0xe4, 0x29, 0x5c, 0x79, 0xe8, 0x45, 0xb1, 0x0e, 0xfb, 0x79, 0x34, 0x1c, 0x81, 0x3f, 0x69, 0x8b,
+	0xb3, 0x8b, 0x50, 0x23, 0x44, 0x68, 0xe3, 0x90, 0xe4, 0xf2, 0xfd, 0x8f, 0x39, 0xf3, 0xd4, 0xf3,
+	0x00, 0x05, 0xa2, 0x3c, 0x12, 0x12, 0xd4, 0xfd, 0x8f, 0x29, 0x9f, 0xe4, 0x2e, 0x40, 0x2d, 0xe2,
+	0x7d, 0xde, 0x8d, 0x83, 0xd0, 0xfe, 0x6d, 0x0b, 0xd6, 0x72, 0xd0, 0xa2, 0x9f, 0x77, 0x22, 0x7e,
+	0xa6, 0x06, 0xb4, 0x2e, 0x7b, 0x1e, 0xf4, 0xf0, 0x9a, 0xd6, 0x77, 0xa3, 0xb8, 0x3d, 0x08, 0x7a,
+	0xde, 0xa1, 0xc7, 0x7b, 0x33, 0x70, 0xac, 0x81, 0x1f, 0xbc, 0x25, 0xe1, 0x71, 0x39, 0x91, 0xf7,
+	0x93, 0xc2, 0x2d, 0x2a, 0x3b, 0xf4, 0x1b, 0xef, 0x3d, 0x5e, 0xd4, 0xee, 0xbb, 0x31, 0x8f, 0xd4,
+	0xf5, 0xa6, 0xe6, 0x45, 0x0f, 0xa9, 0x6d, 0xff, 0x04, 0x6c, 0x15, 0xb3, 0x48, 0x2a, 0xc0, 0x1e,
+	0xd4, 0x24, 0x89, 0x51, 0xd3, 0x1a, 0xdb, 0xa5, 0x39, 0x9f, 0x3a, 0x09, 0xbc, 0xfd, 0x6d, 0x0b,
+	0xae, 0x3a, 0xc2, 0x2e, 0xe5, 0x01, 0x7e, 0xe8, 0x52, 0xc8, 0x70, 0xb8, 0x92, 0xe1, 0xb0, 0x21,
+	0xa4, 0x1f, 0x05, 0x7b, 0x12, 0x69, 0x72, 0xf5, 0x9f, 0xd0, 0xe2, 0x1f, 0xd6, 0xe4, 0x7b, 0x7a,
+	0x02, 0x68, 0x7f, 0xbf, 0x02, 0x1b, 0x6f, 0xf0, 0x18, 0x0f, 0xce, 0x7b, 0x81, 0x7f, 0xe8, 0x1d,
+	0x25, 0xd3, 0x35, 0x61, 0x9e, 0xfb, 0x6e, 0xa7, 0x9f, 0xdc, 0x6b, 0x55, 0x13, 0xb7, 0x27, 0x2e,
+	0x3c, 0x6a, 0x96, 0xe8, 0xfe, 0x25, 0x1a, 0xec, 0x39, 0x58, 0xf1, 0xfc, 0x98, 0x87, 0x27, 0x6e,
+	0xbf, 0x1d, 0xa1, 0x41, 0xec, 0x45, 0xd2, 0x44, 0x2c, 0xab, 0xfe, 0x03, 0xd1, 0x8d, 0x82, 0xc6,
+	0xa3, 0xb4, 0x4d, 0x1a, 0x20, 0xac, 0x45, 0x0d, 0x3b, 0x0e, 0x50, 0x0b, 0x9e, 0x83, 0xd5, 0x81,
+	0xfb, 0xb4, 0x8d, 0xed, 0xa8, 0x3d, 0xe4, 0x61, 0x3b, 0x1c, 0xf9, 0xd2, 0x70, 0x2c, 0x0d, 0xdc,
+	0xa7, 0x8f, 0xb0, 0xff, 0x11, 0x0f, 0x9d, 0x91, 0xcf, 0x3e, 0x05, 0xe7, 0xe5, 0xdd, 0xaf, 0x1d,
+	0x7b, 0x03, 0x1e, 0x8c, 0xe2, 0x04, 0xb3, 0x30, 0x28, 0x1b, 0x72, 0xf8, 0xb1, 0x18, 0x55, 0xf8,
+	0xaf, 0xc3, 0x12, 0x72, 0x13, 0x49, 0xe8, 0x85, 0xde, 0x09, 0x57, 0x86, 0x66, 0x51, 0xf6, 0xbe,
+	0x46, 0x9d, 0x38, 0xfd, 0x91, 0x17, 0x1f, 0x8f, 0x3a, 0xed, 0x38, 0x78, 0xc2, 0xfd, 0x76, 0x97,
+	0x18, 0x34, 0xc2, 0x83, 0xac, 0x46, 0x1c, 0xd9, 0x10, 0xc3, 0x8f, 0x71, 0xf4, 0x5e, 0x32, 0x88,
+	0x67, 0x22, 0x9e, 0x07, 0xa3, 0x30, 0xe4, 0x7e, 0xf7, 0x54, 0x7a, 0xc9, 0x7a, 0x17, 0x7b, 0x19,
+	0x5a, 0xa1, 0x3a, 0x33, 0xda, 0x63, 0x5c, 0x13, 0x3e, 0x73, 0x33, 0x81, 0x78, 0x90, 0x61, 0xdf,
+	0xcb, 0xd0, 0xea, 0x79, 0x51, 0x17, 0x2d, 0xfc, 0xe9, 0xf8, 0xd7, 0x0b, 0xe2, 0xeb, 0x04, 0x22,
+	0xfb, 0x75, 0x13, 0xe6, 0xa5, 0x96, 0x51, 0x48, 0xaa, 0xec, 0xa8, 0x26, 0x6a, 0xa4, 0x72, 0x81,
+	0x3b, 0xa7, 0x32, 0xee, 0xa4, 0xdc, 0xdc, 0xbb, 0xa7, 0x19, 0x0f, 0x79, 0xe9, 0x0c, 0x1e, 0xb2,
+	0xfd, 0x0b, 0x15, 0x38, 0xff, 0x36, 0xb5, 0x74, 0x45, 0x13, 0x5b, 0xea, 0x87, 0x5a, 0xcf, 0x0c,
+	0x45, 0x98, 0x97, 0x16, 0x40, 0xef, 0xfc, 0x9a, 0x65, 0xb1, 0xfd, 0x89, 0xda, 0x40, 0xc1, 0x97,
+	0xfb, 0x56, 0xb1, 0x3e, 0xc8, 0x29, 0x26, 0xa8, 0x04, 0x69, 0xe0, 0xfd, 0x52, 0xb1, 0x52, 0xe0,
+	0x14, 0x9a, 0x5e, 0x80, 0xa1, 0x17, 0x77, 0x97, 0xa0, 0xd1, 0xd6, 0x48, 0xbe, 0xbb, 0x09, 0x17,
+	0xdb, 0xc5, 0x04, 0xd3, 0x70, 0x31, 0x31, 0xf6, 0xf7, 0xca, 0xb0, 0xf9, 0x06, 0x17, 0x86, 0x5c,
+	0x46, 0x1a, 0x67, 0xb6, 0x3c, 0x79, 0xb2, 0x2f, 0xe5, 0xcb, 0x7e, 0x13, 0xa0, 0x83, 0xb7, 0x86,
+	0x76, 0x72, 0xcc, 0x54, 0x9d, 0x3a, 0xf5, 0x90, 0xf4, 0x9f, 0x07, 0x86, 0xd2, 0x17, 0xf6, 0x31,
+	0x11, 0xbf, 0xd0, 0x91, 0xe5, 0x81, 0xfb, 0x94, 0xc8, 0x9b, 0x41, 0xfe, 0xd5, 0x49, 0xf2, 0x4f,
+	0x2e, 0xf1, 0x73, 0xfa, 0x25, 0xfe, 0x16, 0xb0, 0xc4, 0x39, 0x6b, 0xf3, 0xa7, 0x5e, 0x14, 0xa3,
+	0xd3, 0x34, 0x4f, 0x2b, 0x5d, 0x4d, 0x46, 0x5e, 0x97, 0x03, 0xe8, 0x3f, 0x0f, 0xc3, 0xe0, 0xc4,
+	0xeb, 0xf1, 0x50, 0xde, 0xc5, 0x93, 0x36, 0x22, 0x18, 0x04, 0x3d, 0xde, 0x27, 0x09, 0xd7, 0x1d,
+	0xd1, 0x28, 0x96, 0x64, 0x66, 0x87, 0x2f, 0x4c, 0xde, 0xe1, 0x8d, 0xb3, 0xec, 0xf0, 0x3f, 0x2f,
+	0xc1, 0x15, 0xb1, 0xc3, 0xf3, 0x04, 0x3b, 0x6d, 0xa7, 0xff, 0x6f, 0x94, 0xab, 0x26, 0xa5, 0x9a,
+	0x21, 0x25, 0xfb, 0x6f, 0xcb, 0x70, 0xe1, 0x0d, 0x1e, 0x3f, 0x72, 0x44, 0x64, 0xf0, 0x23, 0xd8,
+	0x1d, 0xd7, 0x61, 0x99, 0x6c, 0x63, 0x98, 0x65, 0x61, 0x03, 0x2d, 0x63, 0xa8, 0xf8, 0x67, 0xc3,
+	0x22, 0x82, 0xf5, 0xbc, 0xc3, 0x43, 0x31, 0x91, 0xe0, 0xda, 0xc2, 0xc0, 0x7d, 0xfa, 0x9a, 0x77,
+	0x78, 0x48, 0x53, 0xbd, 0x5f, 0xdb, 0xf9, 0x43, 0xb9, 0x4b, 0xfe, 0xaa, 0x04, 0x17, 0xc5, 0x2e,
+	0xc9, 0x0a, 0xf7, 0xbf, 0x6e, 0x87, 0xfc, 0xcf, 0x93, 0x6d, 0xf1, 0x4e, 0x79, 0x08, 0x2d, 0xbc,
+	0x14, 0x1c, 0xf0, 0x18, 0x01, 0xa3, 0x7b, 0x94, 0x60, 0x88, 0x34, 0x6e, 0x46, 0xbc, 0x4b, 0x11,
+	0x56, 0xe1, 0xaf, 0xab, 0x26, 0xc5, 0xb6, 0xbd, 0x81, 0x17, 0x4b, 0x16, 0x8a, 0x86, 0xfd, 0x17,
+	0x16, 0x2c, 0x99, 0x53, 0x4d, 0x98, 0x42, 0x23, 0xaa, 0x64, 0xaa, 0x0f, 0x83, 0x4a, 0xcf, 0x8d,
+	0x5d, 0x75, 0x43, 0xc7, 0xdf, 0x74, 0xd3, 0xf5, 0x78, 0xbf, 0x17, 0x35, 0x2b, 0xe4, 0xcb, 0xc8,
+	0x16, 0xca, 0x4a, 0xa5, 0x4a, 0x3a, 0xa7, 0x32, 0xd6, 0x5b, 0x97, 0x3d, 0x42, 0xd5, 0xd4, 0xb0,
+	0x1b, 0xcf, 0x10, 0xf3, 0x55, 0x9f, 0xee, 0xc7, 0xb6, 0x03, 0x17, 0x73, 0x59, 0x93, 0x5c, 0x16,
+	0xe6, 0x05, 0xac, 0xba, 0x2b, 0x5c, 0xd0, 0xee, 0x0a, 0xe6, 0x47, 0x8e, 0x82, 0xb4, 0xbf, 0x5a,
+	0x82, 0xe5, 0xb7, 0x5c, 0xdf, 0x3d, 0xe2, 0x3d, 0x15, 0xac, 0xcc, 0xbd, 0x11, 0x99, 0x61, 0xd8,
+	0xd2, 0xfb, 0x0f, 0xc3, 0x96, 0xcf, 0x12, 0x86, 0xbd, 0x02, 0x0b, 0xe2, 0x1e, 0xd6, 0x0d, 0x46,
+	0x7e, 0x2c, 0x95, 0x1a, 0x3c, 0x71, 0xef, 0x19, 0xf9, 0xe2, 0xf2, 0x36, 0xea, 0xf3, 0x24, 0x37,
+	0x30, 0xea, 0x53, 0x44, 0x7d, 0x18, 0xf4, 0xbd, 0xee, 0xa9, 0xe4, 0xee, 0x05, 0x23, 0xac, 0x33,
+	0x0c, 0x70, 0x9d, 0x8f, 0x08, 0xc0, 0x91, 0x80, 0xb8, 0x89, 0x97, 0xcc, 0xa1, 0x0f, 0x67, 0xdf,
+	0x26, 0xa7, 0x4a, 0x59, 0x3f, 0x55, 0xae, 0xc3, 0x92, 0xe7, 0x77, 0xfb, 0xa3, 0x1e, 0x6f, 0xcb,
+	0xb4, 0xb9, 0xd0, 0xa0, 0x45, 0xd9, 0x4b, 0x29, 0x15, 0xba, 0xd2, 0xf0, 0xa7, 0x06, 0x58, 0x55,
+	0x80, 0xf1, 0xa7, 0x3a, 0xd8, 0x2e, 0x6c, 0xa8, 0xd9, 0xf4, 0xf4, 0xa7, 0xd8, 0xaf, 0x35, 0x67,
+	0x4d, 0x0e, 0x6a, 0xd9, 0x29, 0x8a, 0xe9, 0x63, 0xea, 0xaa, 0x9d, 0x5c, 0x2e, 0xc5, 0x46, 0x6d,
+	0x44, 0xe4, 0xca, 0xa7, 0x09, 0x76, 0x02, 0x8a, 0x3c, 0xbf, 0xcb, 0x67, 0x88, 0x67, 0x52, 0xea,
+	0xec, 0x00, 0x81, 0xed, 0x1d, 0x3a, 0xed, 0x32, 0x7a, 0x35, 0x29, 0xb2, 0xd9, 0x53, 0x46, 0x34,
+	0x23, 0xa0, 0xe2, 0x4f, 0x34, 0x31, 0x97, 0x66, 0x15, 0xf3, 0x23, 0xb8, 0x84, 0x1b, 0x28, 0x43,
+	0x57, 0xba, 0x83, 0x5e, 0x50, 0xb7, 0x13, 0xb1, 0x7f, 0x5a, 0x7a, 0x12, 0x3e, 0xb3, 0x16, 0x01,
+	0x68, 0x7f, 0x0a, 0x2e, 0x3b, 0x7c, 0xd8, 0x77, 0xbb, 0x7c, 0x7c, 0x52, 0x41, 0xfa, 0xba, 0x3e,
+	0xa7, 0xba, 0xf1, 0xd8, 0x3f, 0x8e, 0xe1, 0xd0, 0x61, 0xf0, 0x9a, 0xf2, 0xa9, 0x9d, 0x51, 0x9f,
+	0x6b, 0xb1, 0x3b, 0x54, 0x69, 0x4b, 0x53, 0x69, 0x8c, 0xc4, 0xb9, 0x46, 0x92, 0x40, 0x36, 0xd3,
+	0xb8, 0x5a, 0x59, 0x8f, 0xab, 0xfd, 0x51, 0x09, 0xb6, 0xd4, 0xdc, 0x85, 0xab, 0xfd, 0xa8, 0x62,
+	0x6b, 0x2f, 0x67, 0x63, 0x6b, 0x76, 0x46, 0x72, 0x39, 0x5c, 0x49, 0xe2, 0x6a, 0xb8, 0x60, 0xb7,
+	0xd7, 0x4b, 0xe2, 0xd2, 0xa2, 0x81, 0x0c, 0x0a, 0xf9, 0x20, 0x38, 0x49, 0x03, 0x92, 0xb2, 0x99,
+	0xca, 0x74, 0x6e, 0x56, 0x99, 0x0e, 0x60, 0xd5, 0xe1, 0xd1, 0x74, 0xa5, 0xc5, 0x3e, 0xf4, 0x3b,
+	0x54, 0xda, 0x01, 0x7f, 0x23, 0x3a, 0xb1, 0x5f, 0xa6, 0xdb, 0x39, 0x01, 0x68, 0x7f, 0xb5, 0x0c,
+	0x75, 0x81, 0xef, 0xcd, 0xa0, 0x93, 0x4d, 0x81, 0x24, 0x78, 0x4b, 0x39, 0x78, 0xcb, 0x79, 0x78,
+	0x2b, 0x33, 0xe2, 0x2d, 0x48, 0x47, 0xae, 0x43, 0x95, 0xae, 0xcf, 0xf2, 0xb4, 0x17, 0x0d, 0x3d,
+	0x41, 0x32, 0x3f, 0x21, 0x41, 0x52, 0xcb, 0x26, 0x48, 0x26, 0xd5, 0xef, 0x24, 0x9a, 0x0b, 0x7a,
+	0xf2, 0xc4, 0x54, 0xca, 0x85, 0x0f, 0xa0, 0x94, 0x8d, 0xb3, 0x28, 0x25, 0x66, 0xa4, 0xde, 0xe0,
+	0x71, 0x22, 0x87, 0xa2, 0x8c, 0xd4, 0x5d, 0x38, 0x87, 0x16, 0x24, 0x81, 0x4b, 0x77, 0xd3, 0x36,
+	0x54, 0xde, 0x09, 0x3a, 0xca, 0x74, 0xac, 0x1b, 0x2a, 0xad, 0x26, 0x25, 0x08, 0xfb, 0x97, 0x2d,
+	0x58, 0x24, 0x9d, 0x1b, 0xf9, 0x8f, 0x45, 0x02, 0x20, 0x4d, 0x0c, 0x58, 0x46, 0x62, 0xe0, 0xfd,
+	0xe6, 0x06, 0xd3, 0x20, 0x7c, 0x25, 0x3f, 0x08, 0x5f, 0xd5, 0x8d, 0xc5, 0xbf, 0x95, 0x60, 0x5e,
+	0xd2, 0x33, 0xa6, 0x7e, 0x17, 0xa0, 0x86, 0x09, 0x37, 0x2d, 0xbb, 0x36, 0xff, 0x4e, 0xd0, 0x79,
+	0x8c, 0x09, 0xb6, 0x26, 0xcc, 0xc7, 0xa1, 0x77, 0x74, 0xc4, 0x95, 0xed, 0x51, 0x4d, 0x44, 0x8f,
+	0xca, 0x34, 0x8a, 0x64, 0x08, 0x54, 0xb6, 0x32, 0xb2, 0xad, 0x7e, 0x00, 0xd9, 0xce, 0x9d, 0xc9,
+	0xe0, 0xbc, 0x5f, 0xed, 0x4d, 0xd9, 0x58, 0xcf, 0x67, 0xa3, 0xa1, 0xb9, 0xbb, 0x30, 0x2f, 0xc4,
+	0x26, 0x2a, 0x5e, 0x16, 0x76, 0x9b, 0xba, 0xfb, 0xa5, 0xcb, 0xdb, 0x51, 0x80, 0xf6, 0x7f, 0x58,
+	0xb0, 0x46, 0x2e, 0x9d, 0x18, 0x4e, 0x0e, 0x0d, 0x9d, 0xed, 0x96, 0xc9, 0xf6, 0x94, 0xb9, 0x25,
+	0x83, 0xb9, 0xaf, 0xc0, 0x62, 0xc2, 0xdc, 0xc3, 0x38, 0xc9, 0xaa, 0x4f, 0x0c, 0xdf, 0x2b, 0xfe,
+	0x22, 0x3c, 0xdb, 0x87, 0x25, 0xd9, 0x6e, 0x77, 0xf8, 0x61, 0x10, 0xce, 0x62, 0x4a, 0x14, 0xca,
+	0xbb, 0xf4, 0x41, 0x92, 0x5b, 0xad, 0x6a, 0xb9, 0x55, 0x23, 0x60, 0x37, 0x67, 0x06, 0xec, 0xec,
+	0x5f, 0xb4, 0x60, 0xdd, 0x5c, 0xbf, 0xdc, 0x4d, 0x37, 0xf0, 0x10, 0x4c, 0x42, 0xfe, 0x6c, 0x9c,
+	0x93, 0x0e, 0x8d, 0x27, 0x18, 0x4b, 0x45, 0x18, 0xcb, 0x26, 0x46, 0x4a, 0xec, 0xbb, 0x51, 0xdb,
+	0xe7, 0x4f, 0x55, 0x3e, 0x62, 0xfe, 0xd8, 0x8d, 0x3e, 0xc7, 0x9f, 0xc6, 0xf6, 0x35, 0x58, 0x95,
+	0x61, 0x73, 0x9c, 0xbf, 0xc0, 0x00, 0xfc, 0xbb, 0x25, 0xb2, 0xfa, 0xf7, 0x30, 0x99, 0x3a, 0x0c,
+	0x3c, 0x3f, 0xff, 0x68, 0x78, 0x15, 0x96, 0x28, 0x99, 0x92, 0x56, 0x13, 0xcd, 0x98, 0x4d, 0x39,
+	0x90, 0x15, 0x45, 0xec, 0x2d, 0xd8, 0xa0, 0x19, 0x84, 0xff, 0x7b, 0x26, 0x07, 0x9a, 0xe1, 0x87,
+	0x14, 0x15, 0x79, 0x3b, 0xf1, 0xa4, 0x6f, 0xc0, 0x32, 0x4d, 0x17, 0x8e, 0xfc, 0xb6, 0xb1, 0x39,
+	0x29, 0xe9, 0xe3, 0x8c, 0xfc, 0x03, 0xea, 0xc4, 0xdb, 0x0b, 0xc1, 0xe9, 0x76, 0xa2, 0x8e, 0x3d,
+	0xaf, 0x93, 0xad, 0xf8, 0xc7, 0x72, 0x92, 0x5b, 0x10, 0x1f, 0x24, 0x12, 0xbb, 0x2b, 0x11, 0x9c,
+	0xc9, 0xa5, 0x20, 0xe4, 0x07, 0xc9, 0x2e, 0x7f, 0x0d, 0x56, 0x68, 0x8e, 0xb3, 0xf9, 0x16, 0xc4,
+	0xe9, 0xcf, 0x1a, 0xdb, 0x3d, 0x1c, 0xf9, 0x3e, 0xde, 0x3f, 0xcb, 0x42, 0xc2, 0xb2, 0xc9, 0x5e,
+	0x86, 0x86, 0x60, 0x82, 0x74, 0x3f, 0x2a, 0xe3, 0xd7, 0x24, 0xa3, 0x60, 0xc3, 0x59, 0x20, 0xe6,
+	0x08, 0x68, 0xb4, 0x41, 0xdd, 0x44, 0xea, 0xc2, 0x19, 0x1f, 0xff, 0x38, 0xd5, 0x0b, 0x47, 0x87,
+	0x46, 0xdb, 0x17, 0xba, 0x31, 0x6f, 0x8b, 0x3b, 0xaa, 0xb2, 0x5f, 0xe9, 0xb7, 0x6f, 0x78, 0xf1,
+	0xfd, 0x51, 0xc7, 0x71, 0x63, 0xfe, 0x10, 0x21, 0x9c, 0x7a, 0xa8, 0x7e, 0xb2, 0x5d, 0x98, 0xeb,
+	0x73, 0xb7, 0x27, 0x53, 0x1a, 0xe6, 0x67, 0x07, 0x68, 0xc6, 0x46, 0x7d, 0x1e, 0x3e, 0x24, 0x08,
+	0x47, 0x42, 0x22, 0xad, 0x29, 0x3a, 0x55, 0x69, 0x3b, 0x09, 0x1f, 0x24, 0xf8, 0xd0, 0x39, 0x5d,
+	0xce, 0xcc, 0x8b, 0x56, 0xe7, 0x38, 0xe8, 0xf7, 0x92, 0x02, 0x36, 0xd9, 0x22, 0x5e, 0xf3, 0x61,
+	0xdf, 0xeb, 0xba, 0xea, 0x78, 0x90, 0x4d, 0x1c, 0x41, 0x5a, 0x34, 0x29, 0xc8, 0xa6, 0xfd, 0xad,
+	0x12, 0x2c, 0x67, 0xd0, 0xa7, 0xb7, 0x77, 0x4b, 0xbb, 0xbd, 0xa3, 0x79, 0x0e, 0xf9, 0xc0, 0xf5,
+	0x48, 0x96, 0x62, 0x8b, 0xa7, 0x1d, 0xec, 0x93, 0x54, 0x85, 0xc0, 0xe3, 0xd9, 0x36, 0xc5, 0x3c,
+	0xc1, 0x8a, 0xa3, 0x24, 0xe8, 0x44, 0x3c, 0x3c, 0x99, 0xb5, 0xa2, 0x08, 0x14, 0xf8, 0x7e, 0x8c,
+	0x85, 0x79, 0x43, 0x77, 0x84, 0xc5, 0x50, 0x23, 0x3f, 0xf6, 0xfa, 0x33, 0x1c, 0x62, 0x0b, 0x02,
+	0xfe, 0x6d, 0x04, 0xc7, 0xd2, 0x8b, 0x6e, 0xc8, 0x7b, 0xdc, 0x8f, 0x3d, 0x57, 0x15, 0x16, 0x69,
+	0x3d, 0xf6, 0xbf, 0xce, 0xc1, 0x6a, 0x92, 0x12, 0x9d, 0x98, 0x27, 0xce, 0xaf, 0x1b, 0x54, 0xe6,
+	0xb0, 0x5c, 0x64, 0x0e, 0xb3, 0x19, 0x93, 0x5b, 0xc0, 0xd4, 0xe5, 0x51, 0xab, 0x09, 0x11, 0x05,
+	0x3d, 0xab, 0x72, 0xe4, 0x71, 0x32, 0x40, 0x55, 0xc7, 0xe2, 0x2a, 0x3a, 0x27, 0x62, 0x1e, 0xa2,
+	0x85, 0xf7, 0x74, 0xfa, 0xd5, 0xa6, 0x6b, 0x89, 0x4c, 0xbd, 0x01, 0x75, 0xbd, 0x85, 0x3d, 0x54,
+	0x2e, 0x24, 0xab, 0xba, 0x55, 0x2c, 0x4f, 0xb5, 0x71, 0x52, 0x77, 0x14, 0x1f, 0x07, 0xa1, 0x0c,
+	0xe6, 0xc9, 0x16, 0x9a, 0x2c, 0x2f, 0x32, 0xee, 0xb4, 0xf2, 0xc0, 0x5d, 0xf4, 0x22, 0xbd, 0x58,
+	0xf7, 0x15, 0x58, 0x4c, 0x42, 0x13, 0x74, 0xf2, 0x4d, 0xf7, 0x1a, 0x1b, 0x2a, 0x3a, 0xa1, 0x4e,
+	0x3e, 0x35, 0x81, 0x3c, 0xf9, 0xa6, 0xfb, 0x8e, 0x0a, 0xa5, 0x3c, 0xf9, 0x5e, 0x81, 0xc5, 0xc4,
+	0x44, 0x13, 0x0d, 0x8b, 0xd3, 0x69, 0x50, 0x61, 0x0e, 0x45, 0x43, 0x12, 0xa0, 0x14, 0x34, 0x4c,
+	0xcf, 0xc6, 0x29, 0x94, 0x92, 0x86, 0xcf, 0x40, 0x43, 0x95, 0x05, 0x12, 0x09, 0xd3, 0xeb, 0x57,
+	0x17, 0x04, 0xbc, 0xa0, 0xe0, 0x95, 0xa4, 0xce, 0x4f, 0x12, 0xb0, 0x32, 0x03, 0x1b, 0xe9, 0x03,
+	0x89, 0xff, 0x19, 0x58, 0xc2, 0xa3, 0x55, 0x2b, 0x3f, 0x5f, 0x25, 0x71, 0x35, 0x8e, 0xdd, 0x68,
+	0x3f, 0xa9, 0x40, 0xd7, 0xaf, 0x04, 0xa2, 0x86, 0x3c, 0x69, 0xa3, 0xfa, 0x46, 0x41, 0x18, 0xcb,
+	0x12, 0x72, 0xfa, 0x4d, 0x75, 0xc9, 0x5e, 0x28, 0x03, 0x76, 0xeb, 0x34, 0x90, 0x76, 0xe0, 0x71,
+	0x45, 0xca, 0x4d, 0xd9, 0x5c, 0x59, 0x16, 0x4e, 0xea, 0x4e, 0x09, 0x5c, 0x8c, 0x73, 0xa4, 0xea,
+	0x1d, 0xbb, 0x7d, 0xaa, 0x09, 0xaf, 0x39, 0x8d, 0x44, 0xb3, 0x63, 0xb7, 0x6f, 0xff, 0x99, 0x05,
+	0x4c, 0xdf, 0x74, 0x89, 0x43, 0x3f, 0x27, 0x0b, 0x1e, 0xad, 0xb1, 0x47, 0x0d, 0x04, 0xea, 0xc8,
+	0xf1, 0x0f, 0xd3, 0x09, 0x41, 0xa5, 0xc7, 0xee, 0xb6, 0xb6, 0x2a, 0x71, 0x08, 0x2f, 0x62, 0xf7,
+	0xa3, 0x64, 0x65, 0x58, 0x84, 0x1a, 0xc4, 0xd2, 0x88, 0x94, 0x1d, 0xd1, 0xc0, 0x32, 0xe4, 0xb5,
+	0x03, 0xee, 0x86, 0xdd, 0x63, 0xd3, 0x82, 0xac, 0x43, 0xf5, 0x4b, 0x23, 0x1e, 0x9e, 0xaa, 0xb2,
+	0x39, 0x6a, 0x9c, 0x9d, 0xee, 0x7c, 0x6b, 0x51, 0x29, 0xb0, 0x16, 0xf6, 0xaf, 0x58, 0xb0, 0x24,
+	0x52, 0x47, 0x44, 0xd2, 0x7d, 0x0f, 0x97, 0x57, 0x25, 0xa6, 0x49, 0xdf, 0x60, 0x9c, 0xa7, 0x62,
+	0x98, 0xcc, 0x5b, 0x37, 0x08, 0x05, 0x6d, 0x96, 0x23, 0x1a, 0x58, 0xc7, 0x4d, 0xc5, 0xb6, 0xed,
+	0x63, 0xef, 0xe8, 0xb8, 0xef, 0x1d, 0x1d, 0xab, 0xe2, 0xbe, 0x25, 0xea, 0xbe, 0xaf, 0x7a, 0x29,
+	0xc6, 0xeb, 0x7b, 0xc3, 0x21, 0x8f, 0x55, 0xc9, 0xb2, 0x6c, 0x22, 0x4d, 0xeb, 0x26, 0x87, 0xa4,
+	0xb8, 0x6f, 0x41, 0xe5, 0xd8, 0x8b, 0xf3, 0x42, 0xa7, 0xe6, 0x12, 0x1c, 0x02, 0xfb, 0x50, 0x1d,
+	0xcf, 0x5f, 0xb3, 0x60, 0x59, 0xa5, 0x4f, 0x7f, 0x00, 0x55, 0x29, 0x67, 0x93, 0x9a, 0x51, 0xa5,
+	0xb2, 0x07, 0x2b, 0x29, 0x61, 0x89, 0x6b, 0x3e, 0x93, 0x08, 0xed, 0x6f, 0x95, 0x01, 0xa8, 0x43,
+	0x94, 0x6e, 0xce, 0x12, 0xd8, 0xb8, 0xa0, 0x2d, 0x50, 0x54, 0x11, 0x25, 0xcb, 0xbb, 0x0a, 0x0d,
+	0x31, 0x24, 0x17, 0x29, 0xab, 0x12, 0xa9, 0xef, 0x73, 0x49, 0x5d, 0x36, 0x47, 0x54, 0xc9, 0xed,
+	0x96, 0xf0, 0x62, 0xbc, 0x08, 0xd7, 0xa2, 0x12, 0x7b, 0xd4, 0xc8, 0x84, 0xb3, 0xe7, 0xcf, 0x12,
+	0xce, 0x46, 0x57, 0x05, 0x0f, 0x36, 0x79, 0x8e, 0x89, 0x86, 0x71, 0xc0, 0xd5, 0x33, 0x07, 0xdc,
+	0x25, 0xfd, 0x7d, 0x8f, 0x38, 0xc2, 0xd2, 0x0e, 0x3c, 0x3b, 0x43, 0xee, 0xbb, 0x03, 0xde, 0x3e,
+	0x0c, 0x83, 0x81, 0xcc, 0x4d, 0x81, 0xe8, 0xfa, 0x6c, 0x18, 0x0c, 0x50, 0xad, 0x24, 0x40, 0x1c,
+	0x34, 0x1b, 0xea, 0xfd, 0x00, 0x76, 0x3c, 0x46, 0x9d, 0xa8, 0xa3, 0xf9, 0xf4, 0x62, 0xe4, 0xd9,
+	0x62, 0x6a, 0x4f, 0xbd, 0xf8, 0x41, 0x4f, 0x2b, 0x44, 0x5c, 0xd2, 0x0b, 0x11, 0xb1, 0x84, 0x19,
+	0x0d, 0x9e, 0x08, 0x20, 0x4f, 0x0a, 0xcb, 0xfe, 0x3f, 0x60, 0x3a, 0x60, 0x6a, 0x19, 0xa5, 0x17,
+	0x60, 0x4d, 0x7e, 0xee, 0x65, 0xef, 0xc3, 0x06, 0x05, 0x5c, 0xd5, 0x62, 0xcf, 0xee, 0xd2, 0xd8,
+	0x9f, 0x83, 0x73, 0xd9, 0x29, 0x24, 0x19, 0xff, 0x07, 0x20, 0xe1, 0x62, 0x5e, 0xdc, 0x25, 0xf9,
+	0xc4, 0xd1, 0xe0, 0xec, 0x3f, 0xb4, 0xc4, 0x84, 0xa9, 0x6a, 0xfe, 0x00, 0xea, 0xf1, 0x90, 0xf3,
+	0xa4, 0x7e, 0x49, 0x72, 0x48, 0xb4, 0xce, 0x7c, 0x43, 0x36, 0x76, 0xe3, 0x37, 0x2d, 0x38, 0x3f,
+	0x46, 0x7a, 0x62, 0xbe, 0x14, 0x42, 0xc1, 0x88, 0x8d, 0xec, 0xb6, 0x24, 0xf8, 0x31, 0x3a, 0x3e,
+	0x0c, 0xf3, 0xf5, 0xbb, 0x56, 0x5a, 0xfd, 0x21, 0xe9, 0xf1, 0x4e, 0xb8, 0xcf, 0xa3, 0x1c, 0xc6,
+	0x96, 0x13, 0xc6, 0xde, 0x86, 0x0a, 0xe9, 0xfb, 0xf4, 0xfb, 0x1d, 0xc1, 0x61, 0x55, 0x75, 0x1c,
+	0xcc, 0xe0, 0xe7, 0x97, 0x62, 0xb2, 0x23, 0x47, 0x61, 0x30, 0x1a, 0x62, 0x02, 0x4e, 0xda, 0x7f,
+	0x6a, 0xdf, 0x3d, 0xb5, 0xbf, 0x52, 0x86, 0x66, 0x0e, 0xa5, 0x6f, 0xe0, 0x30, 0x5b, 0x81, 0xf2,
+	0x13, 0xae, 0x0e, 0x49, 0xfc, 0x89, 0x72, 0x94, 0x4e, 0x80, 0xc8, 0x08, 0xca, 0x96, 0xb8, 0x99,
+	0xe0, 0x04, 0x3d, 0xae, 0x4c, 0x55, 0xda, 0xc1, 0x5e, 0x82, 0xd6, 0xa1, 0x17, 0x8a, 0x8b, 0x26,
+	0x89, 0xa7, 0x3d, 0xfc, 0xe4, 0x0b, 0x49, 0xae, 0xa8, 0x42, 0x47, 0xda, 0x79, 0x82, 0x50, 0xf2,
+	0x7b, 0xf4, 0xc9, 0x17, 0x54, 0xce, 0x28, 0xe7, 0xe3, 0x3b, 0x2f, 0x18, 0x45, 0x0c, 0x63, 0x1f,
+	0xdf, 0x49, 0x3e, 0xc6, 0x52, 0x5e, 0xf2, 0xc9, 0xa4, 0x5f, 0x20, 0x5b, 0xec, 0x26, 0xac, 0xd2,
+	0x2f, 0x83, 0x90, 0x79, 0x9a, 0x6b, 0x99, 0x06, 0x34, 0x02, 0x52, 0x58, 0x0d, 0x6f, 0x4d, 0x87,
+	0x4d, 0xf1, 0x51, 0x25, 0x78, 0x30, 0xe4, 0xbe, 0x0c, 0x92, 0x95, 0x9d, 0xa4, 0x2d, 0xee, 0x86,
+	0xf8, 0x3b, 0x52, 0xd9, 0x78, 0xd9, 0xb4, 0x9f, 0xc2, 0xe5, 0x22, 0x85, 0x91, 0xea, 0xfc, 0x12,
+	0xcc, 0x91, 0xc4, 0x94, 0x3a, 0x5f, 0x1b, 0x3b, 0x65, 0xc6, 0xc5, 0xe7, 0xc8, 0x4f, 0x0c, 0xf1,
+	0x97, 0x4c, 0xf1, 0x7f, 0xc3, 0x52, 0x89, 0x26, 0x9a, 0x65, 0xff, 0x81, 0x74, 0x4b, 0x7f, 0x30,
+	0xc5, 0xa0, 0x9a, 0x2b, 0x5c, 0xc9, 0xbc, 0xc4, 0x34, 0x36, 0xf6, 0x2e, 0x5c, 0xba, 0xd7, 0xe7,
+	0x6e, 0x68, 0xd0, 0xe4, 0x4d, 0x7e, 0x0b, 0x70, 0x07, 0x36, 0x0b, 0xbe, 0x49, 0x8b, 0x4a, 0xba,
+	0x08, 0x20, 0x13, 0x98, 0x55, 0x47, 0x35, 0xed, 0x7b, 0xb0, 0xba, 0xdf, 0x1b, 0x78, 0xfe, 0x43,
+	0x7c, 0xfe, 0xa5, 0xe1, 0xa0, 0xc7, 0x38, 0x12, 0x07, 0xfe, 0x46, 0xe9, 0x0e, 0xdd, 0x28, 0x7a,
+	0x37, 0x08, 0x7b, 0x92, 0x91, 0x49, 0x1b, 0x8b, 0x6b, 0x99, 0x3e, 0x4b, 0x8a, 0x35, 0x1a, 0x75,
+	0xbb, 0x3c, 0x8a, 0x54, 0xda, 0x54, 0x36, 0x71, 0x64, 0xc0, 0xa3, 0x48, 0x59, 0x9d, 0xba, 0xa3,
+	0x9a, 0xc2, 0x97, 0x45, 0x4f, 0x57, 0x3d, 0xa8, 0xc2, 0x06, 0x1e, 0xd1, 0xfc, 0xe9, 0xd0, 0x0b,
+	0x79, 0x34, 0xe3, 0xc3, 0x1f, 0x09, 0xbd, 0x1f, 0xdb, 0x37, 0x53, 0xd2, 0x82, 0x51, 0xac, 0x39,
+	0xc1, 0x02, 0x8d, 0xa5, 0xa1, 0xb1, 0x1f, 0xc0, 0x9a, 0x01, 0xfb, 0xfe, 0xd7, 0x61, 0xaf, 0x4b,
+	0xb4, 0x5f, 0x38, 0x0e, 0xf6, 0x07, 0x0f, 0x24, 0x5a, 0xbb, 0x07, 0x6b, 0x46, 0xaf, 0x44, 0x90,
+	0xc7, 0x6f, 0x73, 0xc9, 0xa5, 0xb3, 0x2c, 0xf9, 0xbb, 0x65, 0xa8, 0xa9, 0x02, 0x94, 0xbc, 0xc7,
+	0x57, 0x67, 0xf1, 0xb5, 0xd2, 0x87, 0x6f, 0x15, 0xe3, 0xe1, 0xdb, 0x75, 0x90, 0xef, 0x25, 0x13,
+	0xad, 0x96, 0x57, 0x13, 0xd1, 0xab, 0x6e, 0x78, 0x9b, 0x00, 0xa1, 0x17, 0x3d, 0x69, 0xbb, 0x21,
+	0x77, 0x23, 0xe9, 0x76, 0xd5, 0xb1, 0x67, 0x1f, 0x3b, 0xb0, 0x94, 0x36, 0x1a, 0x1d, 0x1d, 0xf1,
+	0x48, 0x3c, 0x35, 0x15, 0xb1, 0x02, 0xbd, 0x0b, 0xeb, 0x54, 0x42, 0xf7, 0x5d, 0xad, 0x4e, 0x45,
+	0x44, 0xe6, 0x17, 0x42, 0xf7, 0xdd, 0xa4, 0x4e, 0x65, 0x13, 0x80, 0x6a, 0x7e, 0xda, 0xa3, 0x48,
+	0x9a, 0x1e, 0x74, 0xaa, 0xb0, 0xe7, 0x6d, 0xb4, 0x77, 0x1f, 0xcd, 0xe3, 0xf0, 0xec, 0x0b, 0xe4,
+	0xc6, 0xec, 0x2f, 0x90, 0x7f, 0x43, 0x46, 0xc2, 0x95, 0x0c, 0xa3, 0x29, 0x89, 0xc7, 0xb3, 0x9d,
+	0xde, 0xe6, 0x35, 0xb9, 0x32, 0xf5, 0x9a, 0x5c, 0xcd, 0xb9, 0x26, 0xff, 0xb5, 0x05, 0x1b, 0x19,
+	0x0a, 0x13, 0xdf, 0x23, 0x79, 0x9d, 0x2b, 0xac, 0xf5, 0x9a, 0xbe, 0x62, 0x09, 0x9e, 0xbe, 0xc6,
+	0xfd, 0xef, 0x73, 0x5d, 0x7e, 0x15, 0x98, 0x56, 0x94, 0x37, 0x89, 0xe3, 0x05, 0x6f, 0x41, 0xed,
+	0xbb, 0xb0, 0x66, 0xcc, 0x20, 0x39, 0xf2, 0x3c, 0xcc, 0x89, 0xd5, 0xca, 0x4b, 0x52, 0x2e, 0x43,
+	0x24, 0x88, 0xfd, 0xfb, 0x65, 0x28, 0x63, 0xea, 0x57, 0xbd, 0x99, 0xb5, 0xb4, 0x37, 0xb3, 0x2d,
+	0xa8, 0x45, 0x32, 0x14, 0xab, 0x2c, 0xb0, 0x6a, 0xa3, 0x21, 0xc2, 0x2c, 0x79, 0xdf, 0x1d, 0xaa,
+	0x04, 0x9c, 0x6c, 0x22, 0xb5, 0x22, 0xea, 0x28, 0xd9, 0x25, 0x5b, 0x7a, 0x64, 0xbc, 0x6a, 0x46,
+	0xc6, 0xf7, 0x60, 0x81, 0xf8, 0x88, 0xe9, 0x81, 0xd9, 0xca, 0x92, 0x10, 0xdc, 0x19, 0xf9, 0xfb,
+	0x71, 0x5e, 0xe4, 0x7f, 0xfe, 0xc3, 0x88, 0xfc, 0xd7, 0xce, 0x1c, 0xf9, 0x37, 0x93, 0x17, 0xf5,
+	0x4c, 0xf2, 0x42, 0x84, 0x0f, 0xcc, 0x9a, 0x36, 0x51, 0x97, 0xbf, 0x14, 0x8f, 0x3d, 0x26, 0x78,
+	0xc7, 0x8b, 0x63, 0x1e, 0x66, 0x2a, 0xf0, 0x17, 0x45, 0xaf, 0x04, 0xb3, 0x3f, 0x05, 0x2b, 0xb8,
+	0x21, 0x8c, 0x34, 0xb0, 0x6d, 0xa4, 0x81, 0x97, 0x34, 0xb9, 0xa7, 0x09, 0xe0, 0xeb, 0xb0, 0xfc,
+	0x08, 0x05, 0xa2, 0xe5, 0x99, 0x73, 0x64, 0x6f, 0xdf, 0x80, 0x15, 0x87, 0x47, 0xa3, 0xc1, 0x34,
+	0xb8, 0x67, 0x61, 0xf5, 0xb1, 0xc8, 0xbc, 0x4e, 0x06, 0xdc, 0xfd, 0xfb, 0x8b, 0xb0, 0x21, 0x82,
+	0x19, 0xa7, 0x7e, 0x97, 0xce, 0xa4, 0x03, 0x1e, 0x9e, 0x78, 0x5d, 0xce, 0x02, 0x80, 0xf4, 0xd5,
+	0x28, 0xbb, 0x94, 0xc9, 0x69, 0x18, 0xb1, 0xa4, 0xd6, 0x66, 0xc1, 0xa8, 0x60, 0x80, 0xfd, 0xcc,
+	0x57, 0xbe, 0xf7, 0x4f, 0xdf, 0x28, 0x5d, 0xde, 0xb3, 0x6e, 0xda, 0x17, 0xe8, 0x3f, 0x50, 0x4e,
+	0x5e, 0xdc, 0x71, 0x11, 0x97, 0xf8, 0xbf, 0x94, 0x68, 0x0f, 0x53, 0x62, 0xec, 0xa7, 0xa0, 0xa1,
+	0x3f, 0x00, 0x65, 0xfa, 0xa3, 0x9e, 0x9c, 0x97, 0xa1, 0xad, 0xf3, 0x19, 0xa4, 0xea, 0x45, 0xa8,
+	0xfd, 0x22, 0xa1, 0x7b, 0x9e, 0x3d, 0x97, 0x87, 0x6b, 0x07, 0x71, 0xdd, 0x42, 0x9e, 0xef, 0xbc,
+	0xe7, 0xf5, 0xbe, 0xbc, 0xf7, 0x2e, 0xce, 0xfc, 0x82, 0xc5, 0x22, 0x58, 0x34, 0x1e, 0xc8, 0xb0,
+	0x73, 0x63, 0x4a, 0xf6, 0x3a, 0xfe, 0x2d, 0x4b, 0x6b, 0x4b, 0xcf, 0x98, 0xe4, 0x3d, 0xa9, 0xb1,
+	0xb7, 0x09, 0xbf, 0xcd, 0xb6, 0x8a, 0xf1, 0x8b, 0x37, 0x26, 0xec, 0x67, 0x2d, 0x58, 0xc9, 0x3e,
+	0x98, 0x60, 0x7a, 0xe9, 0x4b, 0xc1, 0x6b, 0x8a, 0x19, 0x88, 0x78, 0x9e, 0x88, 0xb8, 0xbe, 0x67,
+	0xdd, 0xdc, 0x9d, 0x4e, 0xc7, 0xcf, 0x59, 0x94, 0xc2, 0x1b, 0xaf, 0xe9, 0x2e, 0xe4, 0xc2, 0xb6,
+	0x49, 0x40, 0x71, 0x99, 0xbf, 0x22, 0x84, 0x5d, 0xcb, 0xa7, 0x42, 0x7c, 0xa3, 0x08, 0xf9, 0x4d,
+	0x0b, 0x9a, 0x45, 0xf5, 0xe5, 0xec, 0xe6, 0x18, 0x63, 0x0a, 0x8b, 0xd0, 0xcf, 0x40, 0xdf, 0x6d,
+	0xa2, 0x6f, 0x1b, 0x19, 0x35, 0x13, 0x89, 0xa7, 0x94, 0x12, 0x36, 0x0b, 0x7b, 0x0b, 0xd9, 0xf4,
+	0x8c, 0x49, 0x46, 0x7e, 0xad, 0xb7, 0x7d, 0x83, 0x48, 0xd8, 0x62, 0x97, 0x4d, 0xfc, 0xc3, 0xf0,
+	0x96, 0x38, 0x0e, 0x14, 0xea, 0xaf, 0x5b, 0xb0, 0x9e, 0x57, 0x57, 0xcc, 0x6e, 0x8c, 0x71, 0x26,
+	0xb7, 0xf0, 0x78, 0x46, 0x72, 0x9e, 0x23, 0x72, 0xae, 0x21, 0x47, 0xa6, 0x51, 0xf4, 0xab, 0xaa,
+	0x58, 0xc1, 0xac, 0x3f, 0x65, 0xd7, 0xf5, 0xf0, 0x4f, 0x61, 0xe9, 0x6e, 0xeb, 0xc6, 0x34, 0x30,
+	0x49, 0xd1, 0x0b, 0x44, 0xd1, 0x4d, 0xb6, 0x6d, 0x92, 0x13, 0x49, 0xf0, 0x9d, 0xf7, 0x64, 0xb9,
+	0xee, 0x97, 0x77, 0x64, 0x0d, 0x2b, 0x7b, 0x4f, 0xb8, 0x4e, 0xd9, 0x42, 0xb7, 0x42, 0x41, 0x3d,
+	0x9b, 0xa1, 0xa4, 0xa8, 0x42, 0xce, 0xb6, 0x89, 0x94, 0x4b, 0xac, 0x95, 0xab, 0x2b, 0xe2, 0xed,
+	0xd2, 0x37, 0xe9, 0x65, 0x73, 0x6e, 0x09, 0x20, 0x7b, 0xce, 0x2c, 0x6c, 0x9b, 0x50, 0x26, 0x38,
+	0x3b, 0x4d, 0xd7, 0x89, 0xa6, 0x2b, 0x7b, 0xd6, 0xcd, 0xd6, 0x24, 0xb2, 0x52, 0x13, 0x27, 0x13,
+	0xfb, 0x67, 0x30, 0x71, 0x66, 0x66, 0x7f, 0x16, 0x13, 0x27, 0x6b, 0x50, 0x7e, 0x3e, 0xd1, 0x59,
+	0xf3, 0x76, 0x9d, 0xa3, 0xb3, 0xb9, 0xd7, 0xef, 0xd6, 0xc5, 0x9c, 0x9d, 0x9c, 0xd0, 0x71, 0x93,
+	0xe8, 0x78, 0x06, 0x55, 0xf5, 0x4a, 0x2e, 0x29, 0xae, 0x77, 0x4b, 0xee, 0x5f, 0xf6, 0xeb, 0x16,
+	0x6c, 0xe4, 0x5e, 0x8f, 0x99, 0xce, 0xe8, 0x49, 0x97, 0xee, 0xd6, 0xf6, 0x74, 0x40, 0x53, 0x63,
+	0xf1, 0xc8, 0xbb, 0x3e, 0x85, 0xb0, 0x3d, 0xba, 0x83, 0xb3, 0xaf, 0x5a, 0xb0, 0x9c, 0x79, 0x0e,
+	0xcf, 0xae, 0xe6, 0xbd, 0x3e, 0x37, 0x8f, 0x5e, 0x7b, 0x12, 0xc8, 0xd8, 0x86, 0xb6, 0x2f, 0xe7,
+	0x9e, 0xbf, 0xc9, 0x33, 0x2f, 0xf6, 0x33, 0x16, 0xf9, 0xbf, 0xd9, 0xf2, 0xef, 0x8c, 0xe1, 0xc8,
+	0xaf, 0xe2, 0x6d, 0x4d, 0x28, 0xa4, 0x54, 0x34, 0xb0, 0xab, 0xc5, 0x0a, 0xba, 0x23, 0x4a, 0x72,
+	0xd9, 0xd7, 0x12, 0x95, 0xc9, 0xd4, 0x5f, 0x8f, 0xab, 0x4c, 0x6e, 0x69, 0xf0, 0x44, 0x3a, 0x3e,
+	0x4e, 0x74, 0xdc, 0xc0, 0xbd, 0x32, 0x03, 0x29, 0x5f, 0xb7, 0xa0, 0x59, 0x54, 0x34, 0x5b, 0xb8,
+	0x7d, 0x9e, 0xd7, 0xd0, 0x4f, 0xab, 0xb8, 0xd5, 0x8e, 0x1f, 0xfb, 0x5a, 0x31, 0x3d, 0x7b, 0xea,
+	0xa5, 0x1d, 0xf3, 0x01, 0xd2, 0x4a, 0x54, 0xc3, 0x2d, 0x1b, 0x2b, 0x50, 0x6d, 0xe5, 0x56, 0x1c,
+	0x6a, 0x1c, 0xb0, 0x27, 0x70, 0x60, 0x2f, 0xa4, 0x0f, 0x58, 0x04, 0x0d, 0xbd, 0x08, 0xd2, 0xf0,
+	0xca, 0x72, 0xaa, 0x23, 0x0b, 0x70, 0xde, 0x22, 0x9c, 0xcf, 0xb2, 0xeb, 0x05, 0x08, 0x4d, 0xa7,
+	0x8c, 0x8d, 0x60, 0xc9, 0x2c, 0xa9, 0x2c, 0xe4, 0xf5, 0xd5, 0x8c, 0x8d, 0x1c, 0xaf, 0xc2, 0x9c,
+	0x62, 0xab, 0x34, 0xdc, 0x6c, 0x08, 0x0d, 0xbd, 0xf2, 0xcc, 0x58, 0x6b, 0x4e, 0x49, 0x5e, 0xeb,
+	0x4a, 0xe1, 0xb8, 0x44, 0x7d, 0x85, 0x50, 0x5f, 0x60, 0xe7, 0x4d, 0xd4, 0x84, 0x91, 0x6a, 0xd5,
+	0x7a, 0x00, 0x69, 0x7d, 0x99, 0x21, 0xcd, 0xb1, 0xb2, 0xb3, 0x56, 0x4e, 0xc5, 0x9b, 0xf2, 0xac,
+	0xd9, 0xa5, 0x02, 0x04, 0x82, 0x9d, 0xdf, 0xb6, 0x60, 0x3d, 0xef, 0x2f, 0x15, 0x8c, 0x0d, 0x35,
+	0xe1, 0xdf, 0x2b, 0x5a, 0xcf, 0x4e, 0x85, 0x9b, 0xd5, 0xec, 0xa9, 0xbc, 0xfe, 0xde, 0x40, 0x4c,
+	0xc3, 0xbe, 0x63, 0x41, 0xb3, 0xe8, 0xc5, 0xbf, 0xe1, 0xf1, 0x4d, 0xf9, 0xe7, 0x84, 0xd6, 0xf3,
+	0x33, 0xc1, 0x9a, 0xbb, 0x8e, 0xdd, 0x98, 0x48, 0xe4, 0x8e, 0xfa, 0xdb, 0x00, 0xf6, 0x7b, 0x16,
+	0xb4, 0x8a, 0xdf, 0xe6, 0xb3, 0x8f, 0x9b, 0x4a, 0x3f, 0xf9, 0xdf, 0x05, 0x5a, 0xb7, 0x66, 0x84,
+	0x96, 0xb4, 0xde, 0x21, 0x5a, 0x3f, 0x81, 0x3c, 0xbd, 0x3d, 0x1b, 0xb9, 0x7b, 0xf2, 0x9f, 0x57,
+	0x76, 0x7f, 0x67, 0x0e, 0x56, 0x69, 0xea, 0x1f, 0xc1, 0xe4, 0xbe, 0xba, 0xd9, 0xb9, 0x00, 0x09,
+	0x83, 0xcc, 0x9b, 0xdd, 0x58, 0x9d, 0x51, 0x6b, 0xb3, 0x60, 0x54, 0xd2, 0x76, 0x8e, 0x68, 0x5b,
+	0x61, 0x4b, 0x8a, 0x30, 0x01, 0xcd, 0xbe, 0x08, 0x35, 0x75, 0x56, 0xb3, 0x56, 0xee, 0x01, 0x3e,
+	0xc3, 0xe1, 0xbe, 0x41, 0x93, 0x2f, 0xb3, 0x45, 0x63, 0x72, 0xe6, 0x43, 0x43, 0xcf, 0xd6, 0x1b,
+	0xbb, 0x34, 0xa7, 0xd0, 0xa1, 0x75, 0xa5, 0x70, 0x5c, 0xe2, 0xd9, 0x24, 0x3c, 0xe7, 0xd9, 0x86,
+	0xb9, 0x88, 0xbd, 0x88, 0x80, 0xd9, 0x09, 0x2c, 0x67, 0x32, 0x6c, 0xec, 0x6a, 0x1e, 0x57, 0x8c,
+	0xc4, 0x61, 0xcb, 0x9e, 0x04, 0x22, 0x11, 0x5f, 0x22, 0xc4, 0xe7, 0xd8, 0xba, 0x81, 0x78, 0x47,
+	0xe6, 0xe3, 0xa4, 0x98, 0xe4, 0x93, 0x9e, 0xac, 0x98, 0x8c, 0x44, 0x6d, 0x6b, 0xb3, 0x60, 0xb4,
+	0x48, 0x4c, 0xb2, 0x46, 0xeb, 0x4b, 0xc2, 0xce, 0xa6, 0x89, 0x54, 0xb6, 0x95, 0xf5, 0x39, 0xb3,
+	0x69, 0xda, 0xd6, 0xd5, 0x09, 0x10, 0x12, 0x5d, 0x8b, 0xd0, 0xad, 0x33, 0xa6, 0xd0, 0xa5, 0xb9,
+	0x56, 0xf4, 0x8d, 0xcf, 0xe5, 0x27, 0x7a, 0xd8, 0x76, 0xb1, 0x32, 0x98, 0xc9, 0xc3, 0xd6, 0x73,
+	0x33, 0x40, 0x9a, 0xbe, 0x31, 0xdb, 0x34, 0x85, 0xbb, 0x13, 0x1a, 0xe0, 0xbb, 0xff, 0x62, 0xc1,
+	0xba, 0xba, 0x0e, 0x19, 0x9b, 0xc5, 0x87, 0x45, 0x23, 0xc2, 0xc9, 0xb2, 0x46, 0x3f, 0x1b, 0x9d,
+	0x6d, 0x6d, 0x15, 0x03, 0x14, 0xf1, 0x27, 0xb9, 0x57, 0x45, 0xec, 0x08, 0x16, 0xb4, 0x9b, 0x19,
+	0xdb, 0xcc, 0xbf, 0xb1, 0x29, 0x5c, 0x97, 0x8b, 0x86, 0x25, 0xa6, 0x0b, 0x84, 0x69, 0x8d, 0xad,
+	0x8e, 0x61, 0xda, 0xfd, 0x7e, 0x09, 0x56, 0x28, 0xe0, 0xb3, 0x3f, 0x8a, 0x8f, 0xd3, 0xd5, 0x42,
+	0x9a, 0xc0, 0x31, 0x74, 0x6e, 0x2c, 0x3b, 0xd4, 0xda, 0x2c, 0x18, 0x95, 0xa8, 0xaf, 0x11, 0xea,
+	0x4d, 0x34, 0x5b, 0x4d, 0xd3, 0x6c, 0x61, 0x2d, 0xdf, 0x9e, 0xf8, 0x9f, 0xc1, 0x10, 0x16, 0xb4,
+	0x4c, 0x0b, 0xcb, 0x9b, 0x32, 0xcd, 0xd6, 0xb4, 0x2e, 0x17, 0x0d, 0x4f, 0x8d, 0x33, 0x29, 0x94,
+	0x88, 0xe4, 0x09, 0x2c, 0x68, 0xc9, 0x97, 0x71, 0x9c, 0x46, 0xaa, 0xa6, 0x75, 0xb9, 0x68, 0xb8,
+	0xc8, 0x78, 0x68, 0x08, 0x07, 0x7c, 0xf7, 0x3b, 0x65, 0x58, 0x7e, 0x33, 0xe8, 0x18, 0x91, 0xb5,
+	0x1f, 0x83, 0x9a, 0x8a, 0x11, 0x16, 0xfa, 0x35, 0x17, 0x33, 0x4a, 0x64, 0x78, 0x34, 0x63, 0xfa,
+	0x23, 0x70, 0x92, 0x0f, 0xd3, 0x81, 0x9a, 0x0a, 0x24, 0x1a, 0x96, 0x37, 0x13, 0x5d, 0x6c, 0x65,
+	0xc2, 0x90, 0xda, 0x4d, 0xca, 0xbe, 0x32, 0x3e, 0xed, 0xce, 0x7b, 0x18, 0x2d, 0xfc, 0xf2, 0x1e,
+	0x05, 0x8d, 0x19, 0x87, 0x7a, 0x12, 0x85, 0x64, 0x17, 0xcd, 0xd3, 0x6c, 0x34, 0x98, 0x84, 0x25,
+	0x8d, 0x4a, 0xd9, 0x5b, 0xc5, 0x58, 0x42, 0x9a, 0x86, 0x1d, 0x03, 0xa4, 0x41, 0x4c, 0x43, 0x19,
+	0xc7, 0x62, 0x9b, 0x63, 0x88, 0x8a, 0x9d, 0x5c, 0x1d, 0x91, 0x7c, 0x9d, 0x72, 0xf7, 0xce, 0x17,
+	0x3f, 0x2d, 0xfe, 0xe3, 0xe5, 0x76, 0x37, 0x18, 0xec, 0x3c, 0x09, 0xfc, 0xa3, 0x27, 0xdc, 0xdf,
+	0xc1, 0x27, 0xbd, 0x51, 0x78, 0xb2, 0x33, 0x7c, 0x72, 0x24, 0xfe, 0x7e, 0x79, 0x27, 0xf9, 0xa3,
+	0xe7, 0x97, 0xc4, 0xaf, 0x93, 0x17, 0x3b, 0x73, 0x34, 0xf2, 0x89, 0xff, 0x1c, 0x00, 0x37, 0x06,
+	0xfc, 0xd6, 0x05, 0x5a, 0x00, 0x00,
 }
@@ -20,14 +20,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssueSyncAdminService_SyncIssues_FullMethodName              = "/issues.v1.IssueSyncAdminService/SyncIssues"
-	IssueSyncAdminService_GetSyncConfig_FullMethodName           = "/issues.v1.IssueSyncAdminService/GetSyncConfig"
-	IssueSyncAdminService_UpdateSyncConfig_FullMethodName        = "/issues.v1.IssueSyncAdminService/UpdateSyncConfig"
-	IssueSyncAdminService_ListManagedSyncRepos_FullMethodName    = "/issues.v1.IssueSyncAdminService/ListManagedSyncRepos"
-	IssueSyncAdminService_ReplaceManagedSyncRepos_FullMethodName = "/issues.v1.IssueSyncAdminService/ReplaceManagedSyncRepos"
-	IssueSyncAdminService_GetSyncStatus_FullMethodName           = "/issues.v1.IssueSyncAdminService/GetSyncStatus"
-	IssueSyncAdminService_UpdateIssueAISummary_FullMethodName    = "/issues.v1.IssueSyncAdminService/UpdateIssueAISummary"
-	IssueSyncAdminService_ClearIssueAISummaries_FullMethodName   = "/issues.v1.IssueSyncAdminService/ClearIssueAISummaries"
+	IssueSyncAdminService_SyncIssues_FullMethodName                 = "/issues.v1.IssueSyncAdminService/SyncIssues"
+	IssueSyncAdminService_WatchSyncJob_FullMethodName               = "/issues.v1.IssueSyncAdminService/WatchSyncJob"
+	IssueSyncAdminService_GetSyncConfig_FullMethodName              = "/issues.v1.IssueSyncAdminService/GetSyncConfig"
+	IssueSyncAdminService_UpdateSyncConfig_FullMethodName           = "/issues.v1.IssueSyncAdminService/UpdateSyncConfig"
+	IssueSyncAdminService_GetIssueSummaryConfig_FullMethodName      = "/issues.v1.IssueSyncAdminService/GetIssueSummaryConfig"
+	IssueSyncAdminService_UpdateIssueSummaryConfig_FullMethodName   = "/issues.v1.IssueSyncAdminService/UpdateIssueSummaryConfig"
+	IssueSyncAdminService_GetPRReviewConfig_FullMethodName          = "/issues.v1.IssueSyncAdminService/GetPRReviewConfig"
+	IssueSyncAdminService_UpdatePRReviewConfig_FullMethodName       = "/issues.v1.IssueSyncAdminService/UpdatePRReviewConfig"
+	IssueSyncAdminService_ListSettingsChanges_FullMethodName        = "/issues.v1.IssueSyncAdminService/ListSettingsChanges"
+	IssueSyncAdminService_ListManagedSyncRepos_FullMethodName       = "/issues.v1.IssueSyncAdminService/ListManagedSyncRepos"
+	IssueSyncAdminService_ReplaceManagedSyncRepos_FullMethodName    = "/issues.v1.IssueSyncAdminService/ReplaceManagedSyncRepos"
+	IssueSyncAdminService_GetSyncStatus_FullMethodName              = "/issues.v1.IssueSyncAdminService/GetSyncStatus"
+	IssueSyncAdminService_UpdateIssueAISummary_FullMethodName       = "/issues.v1.IssueSyncAdminService/UpdateIssueAISummary"
+	IssueSyncAdminService_ClearIssueAISummaries_FullMethodName      = "/issues.v1.IssueSyncAdminService/ClearIssueAISummaries"
+	IssueSyncAdminService_ReconcileIssues_FullMethodName            = "/issues.v1.IssueSyncAdminService/ReconcileIssues"
+	IssueSyncAdminService_GetManagedSyncRepo_FullMethodName         = "/issues.v1.IssueSyncAdminService/GetManagedSyncRepo"
+	IssueSyncAdminService_UpdateRepoSyncPolicy_FullMethodName       = "/issues.v1.IssueSyncAdminService/UpdateRepoSyncPolicy"
+	IssueSyncAdminService_DiscoverManagedSyncRepos_FullMethodName   = "/issues.v1.IssueSyncAdminService/DiscoverManagedSyncRepos"
+	IssueSyncAdminService_ResyncRepo_FullMethodName                 = "/issues.v1.IssueSyncAdminService/ResyncRepo"
+	IssueSyncAdminService_GetResyncJob_FullMethodName               = "/issues.v1.IssueSyncAdminService/GetResyncJob"
+	IssueSyncAdminService_ListResyncJobs_FullMethodName             = "/issues.v1.IssueSyncAdminService/ListResyncJobs"
+	IssueSyncAdminService_ListSyncRuns_FullMethodName               = "/issues.v1.IssueSyncAdminService/ListSyncRuns"
+	IssueSyncAdminService_GetSyncRun_FullMethodName                 = "/issues.v1.IssueSyncAdminService/GetSyncRun"
+	IssueSyncAdminService_MigrateIssueComments_FullMethodName       = "/issues.v1.IssueSyncAdminService/MigrateIssueComments"
+	IssueSyncAdminService_ListIssueCommentVersions_FullMethodName   = "/issues.v1.IssueSyncAdminService/ListIssueCommentVersions"
+	IssueSyncAdminService_RestoreIssueCommentVersion_FullMethodName = "/issues.v1.IssueSyncAdminService/RestoreIssueCommentVersion"
 )
 
 // IssueSyncAdminServiceClient is the client API for IssueSyncAdminService service.
@@ -35,13 +53,31 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type IssueSyncAdminServiceClient interface {
 	SyncIssues(ctx context.Context, in *SyncIssuesRequest, opts ...grpc.CallOption) (*SyncIssuesResponse, error)
+	WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncJobEvent], error)
 	GetSyncConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSyncConfigResponse, error)
 	UpdateSyncConfig(ctx context.Context, in *UpdateSyncConfigRequest, opts ...grpc.CallOption) (*GetSyncConfigResponse, error)
+	GetIssueSummaryConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetIssueSummaryConfigResponse, error)
+	UpdateIssueSummaryConfig(ctx context.Context, in *UpdateIssueSummaryConfigRequest, opts ...grpc.CallOption) (*GetIssueSummaryConfigResponse, error)
+	GetPRReviewConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPRReviewConfigResponse, error)
+	UpdatePRReviewConfig(ctx context.Context, in *UpdatePRReviewConfigRequest, opts ...grpc.CallOption) (*GetPRReviewConfigResponse, error)
+	ListSettingsChanges(ctx context.Context, in *ListSettingsChangesRequest, opts ...grpc.CallOption) (*ListSettingsChangesResponse, error)
 	ListManagedSyncRepos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListManagedSyncReposResponse, error)
 	ReplaceManagedSyncRepos(ctx context.Context, in *ReplaceManagedSyncReposRequest, opts ...grpc.CallOption) (*ListManagedSyncReposResponse, error)
 	GetSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
 	UpdateIssueAISummary(ctx context.Context, in *UpdateIssueAISummaryRequest, opts ...grpc.CallOption) (*GetIssueResponse, error)
 	ClearIssueAISummaries(ctx context.Context, in *ClearIssueAISummariesRequest, opts ...grpc.CallOption) (*ClearIssueAISummariesResponse, error)
+	ReconcileIssues(ctx context.Context, in *ReconcileIssuesRequest, opts ...grpc.CallOption) (*ReconcileIssuesResponse, error)
+	GetManagedSyncRepo(ctx context.Context, in *GetManagedSyncRepoRequest, opts ...grpc.CallOption) (*ManagedSyncRepo, error)
+	UpdateRepoSyncPolicy(ctx context.Context, in *UpdateRepoSyncPolicyRequest, opts ...grpc.CallOption) (*ManagedSyncRepo, error)
+	DiscoverManagedSyncRepos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiscoverManagedSyncReposResponse, error)
+	ResyncRepo(ctx context.Context, in *ResyncRepoRequest, opts ...grpc.CallOption) (*ResyncJob, error)
+	GetResyncJob(ctx context.Context, in *GetResyncJobRequest, opts ...grpc.CallOption) (*ResyncJob, error)
+	ListResyncJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListResyncJobsResponse, error)
+	ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest, opts ...grpc.CallOption) (*ListSyncRunsResponse, error)
+	GetSyncRun(ctx context.Context, in *GetSyncRunRequest, opts ...grpc.CallOption) (*SyncRun, error)
+	MigrateIssueComments(ctx context.Context, in *MigrateIssueCommentsRequest, opts ...grpc.CallOption) (*MigrateIssueCommentsResponse, error)
+	ListIssueCommentVersions(ctx context.Context, in *ListIssueCommentVersionsRequest, opts ...grpc.CallOption) (*ListIssueCommentVersionsResponse, error)
+	RestoreIssueCommentVersion(ctx context.Context, in *RestoreIssueCommentVersionRequest, opts ...grpc.CallOption) (*RestoreIssueCommentVersionResponse, error)
 }
 
 type issueSyncAdminServiceClient struct {
@@ -62,6 +98,25 @@ func (c *issueSyncAdminServiceClient) SyncIssues(ctx context.Context, in *SyncIs
 	return out, nil
 }
 
+func (c *issueSyncAdminServiceClient) WatchSyncJob(ctx context.Context, in *WatchSyncJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncJobEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssueSyncAdminService_ServiceDesc.Streams[0], IssueSyncAdminService_WatchSyncJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSyncJobRequest, SyncJobEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueSyncAdminService_WatchSyncJobClient = grpc.ServerStreamingClient[SyncJobEvent]
+
 func (c *issueSyncAdminServiceClient) GetSyncConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSyncConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSyncConfigResponse)
@@ -82,6 +137,56 @@ func (c *issueSyncAdminServiceClient) UpdateSyncConfig(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *issueSyncAdminServiceClient) GetIssueSummaryConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetIssueSummaryConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueSummaryConfigResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_GetIssueSummaryConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) UpdateIssueSummaryConfig(ctx context.Context, in *UpdateIssueSummaryConfigRequest, opts ...grpc.CallOption) (*GetIssueSummaryConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueSummaryConfigResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_UpdateIssueSummaryConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) GetPRReviewConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPRReviewConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPRReviewConfigResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_GetPRReviewConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) UpdatePRReviewConfig(ctx context.Context, in *UpdatePRReviewConfigRequest, opts ...grpc.CallOption) (*GetPRReviewConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPRReviewConfigResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_UpdatePRReviewConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) ListSettingsChanges(ctx context.Context, in *ListSettingsChangesRequest, opts ...grpc.CallOption) (*ListSettingsChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettingsChangesResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_ListSettingsChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueSyncAdminServiceClient) ListManagedSyncRepos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListManagedSyncReposResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListManagedSyncReposResponse)
@@ -132,18 +237,156 @@ func (c *issueSyncAdminServiceClient) ClearIssueAISummaries(ctx context.Context,
 	return out, nil
 }
 
+func (c *issueSyncAdminServiceClient) ReconcileIssues(ctx context.Context, in *ReconcileIssuesRequest, opts ...grpc.CallOption) (*ReconcileIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_ReconcileIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) GetManagedSyncRepo(ctx context.Context, in *GetManagedSyncRepoRequest, opts ...grpc.CallOption) (*ManagedSyncRepo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagedSyncRepo)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_GetManagedSyncRepo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) UpdateRepoSyncPolicy(ctx context.Context, in *UpdateRepoSyncPolicyRequest, opts ...grpc.CallOption) (*ManagedSyncRepo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagedSyncRepo)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_UpdateRepoSyncPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) DiscoverManagedSyncRepos(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DiscoverManagedSyncReposResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverManagedSyncReposResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_DiscoverManagedSyncRepos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) ResyncRepo(ctx context.Context, in *ResyncRepoRequest, opts ...grpc.CallOption) (*ResyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResyncJob)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_ResyncRepo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) GetResyncJob(ctx context.Context, in *GetResyncJobRequest, opts ...grpc.CallOption) (*ResyncJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResyncJob)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_GetResyncJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) ListResyncJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListResyncJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResyncJobsResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_ListResyncJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest, opts ...grpc.CallOption) (*ListSyncRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyncRunsResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_ListSyncRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) GetSyncRun(ctx context.Context, in *GetSyncRunRequest, opts ...grpc.CallOption) (*SyncRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncRun)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_GetSyncRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) MigrateIssueComments(ctx context.Context, in *MigrateIssueCommentsRequest, opts ...grpc.CallOption) (*MigrateIssueCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateIssueCommentsResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_MigrateIssueComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) ListIssueCommentVersions(ctx context.Context, in *ListIssueCommentVersionsRequest, opts ...grpc.CallOption) (*ListIssueCommentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueCommentVersionsResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_ListIssueCommentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueSyncAdminServiceClient) RestoreIssueCommentVersion(ctx context.Context, in *RestoreIssueCommentVersionRequest, opts ...grpc.CallOption) (*RestoreIssueCommentVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreIssueCommentVersionResponse)
+	err := c.cc.Invoke(ctx, IssueSyncAdminService_RestoreIssueCommentVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueSyncAdminServiceServer is the server API for IssueSyncAdminService service.
 // All implementations must embed UnimplementedIssueSyncAdminServiceServer
 // for forward compatibility.
 type IssueSyncAdminServiceServer interface {
 	SyncIssues(context.Context, *SyncIssuesRequest) (*SyncIssuesResponse, error)
+	WatchSyncJob(*WatchSyncJobRequest, grpc.ServerStreamingServer[SyncJobEvent]) error
 	GetSyncConfig(context.Context, *emptypb.Empty) (*GetSyncConfigResponse, error)
 	UpdateSyncConfig(context.Context, *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error)
+	GetIssueSummaryConfig(context.Context, *emptypb.Empty) (*GetIssueSummaryConfigResponse, error)
+	UpdateIssueSummaryConfig(context.Context, *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error)
+	GetPRReviewConfig(context.Context, *emptypb.Empty) (*GetPRReviewConfigResponse, error)
+	UpdatePRReviewConfig(context.Context, *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error)
+	ListSettingsChanges(context.Context, *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error)
 	ListManagedSyncRepos(context.Context, *emptypb.Empty) (*ListManagedSyncReposResponse, error)
 	ReplaceManagedSyncRepos(context.Context, *ReplaceManagedSyncReposRequest) (*ListManagedSyncReposResponse, error)
 	GetSyncStatus(context.Context, *emptypb.Empty) (*GetSyncStatusResponse, error)
 	UpdateIssueAISummary(context.Context, *UpdateIssueAISummaryRequest) (*GetIssueResponse, error)
 	ClearIssueAISummaries(context.Context, *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error)
+	ReconcileIssues(context.Context, *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error)
+	GetManagedSyncRepo(context.Context, *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error)
+	UpdateRepoSyncPolicy(context.Context, *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error)
+	DiscoverManagedSyncRepos(context.Context, *emptypb.Empty) (*DiscoverManagedSyncReposResponse, error)
+	ResyncRepo(context.Context, *ResyncRepoRequest) (*ResyncJob, error)
+	GetResyncJob(context.Context, *GetResyncJobRequest) (*ResyncJob, error)
+	ListResyncJobs(context.Context, *emptypb.Empty) (*ListResyncJobsResponse, error)
+	ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error)
+	GetSyncRun(context.Context, *GetSyncRunRequest) (*SyncRun, error)
+	MigrateIssueComments(context.Context, *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error)
+	ListIssueCommentVersions(context.Context, *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error)
+	RestoreIssueCommentVersion(context.Context, *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error)
 	mustEmbedUnimplementedIssueSyncAdminServiceServer()
 }
 
@@ -157,12 +400,30 @@ type UnimplementedIssueSyncAdminServiceServer struct{}
 func (UnimplementedIssueSyncAdminServiceServer) SyncIssues(context.Context, *SyncIssuesRequest) (*SyncIssuesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SyncIssues not implemented")
 }
+func (UnimplementedIssueSyncAdminServiceServer) WatchSyncJob(*WatchSyncJobRequest, grpc.ServerStreamingServer[SyncJobEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchSyncJob not implemented")
+}
 func (UnimplementedIssueSyncAdminServiceServer) GetSyncConfig(context.Context, *emptypb.Empty) (*GetSyncConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSyncConfig not implemented")
 }
 func (UnimplementedIssueSyncAdminServiceServer) UpdateSyncConfig(context.Context, *UpdateSyncConfigRequest) (*GetSyncConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateSyncConfig not implemented")
 }
+func (UnimplementedIssueSyncAdminServiceServer) GetIssueSummaryConfig(context.Context, *emptypb.Empty) (*GetIssueSummaryConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIssueSummaryConfig not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) UpdateIssueSummaryConfig(context.Context, *UpdateIssueSummaryConfigRequest) (*GetIssueSummaryConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateIssueSummaryConfig not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) GetPRReviewConfig(context.Context, *emptypb.Empty) (*GetPRReviewConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPRReviewConfig not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) UpdatePRReviewConfig(context.Context, *UpdatePRReviewConfigRequest) (*GetPRReviewConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePRReviewConfig not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) ListSettingsChanges(context.Context, *ListSettingsChangesRequest) (*ListSettingsChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSettingsChanges not implemented")
+}
 func (UnimplementedIssueSyncAdminServiceServer) ListManagedSyncRepos(context.Context, *emptypb.Empty) (*ListManagedSyncReposResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListManagedSyncRepos not implemented")
 }
@@ -178,6 +439,42 @@ func (UnimplementedIssueSyncAdminServiceServer) UpdateIssueAISummary(context.Con
 func (UnimplementedIssueSyncAdminServiceServer) ClearIssueAISummaries(context.Context, *ClearIssueAISummariesRequest) (*ClearIssueAISummariesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClearIssueAISummaries not implemented")
 }
+func (UnimplementedIssueSyncAdminServiceServer) ReconcileIssues(context.Context, *ReconcileIssuesRequest) (*ReconcileIssuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcileIssues not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) GetManagedSyncRepo(context.Context, *GetManagedSyncRepoRequest) (*ManagedSyncRepo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetManagedSyncRepo not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) UpdateRepoSyncPolicy(context.Context, *UpdateRepoSyncPolicyRequest) (*ManagedSyncRepo, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRepoSyncPolicy not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) DiscoverManagedSyncRepos(context.Context, *emptypb.Empty) (*DiscoverManagedSyncReposResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscoverManagedSyncRepos not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) ResyncRepo(context.Context, *ResyncRepoRequest) (*ResyncJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResyncRepo not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) GetResyncJob(context.Context, *GetResyncJobRequest) (*ResyncJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResyncJob not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) ListResyncJobs(context.Context, *emptypb.Empty) (*ListResyncJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResyncJobs not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSyncRuns not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) GetSyncRun(context.Context, *GetSyncRunRequest) (*SyncRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSyncRun not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) MigrateIssueComments(context.Context, *MigrateIssueCommentsRequest) (*MigrateIssueCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MigrateIssueComments not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) ListIssueCommentVersions(context.Context, *ListIssueCommentVersionsRequest) (*ListIssueCommentVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIssueCommentVersions not implemented")
+}
+func (UnimplementedIssueSyncAdminServiceServer) RestoreIssueCommentVersion(context.Context, *RestoreIssueCommentVersionRequest) (*RestoreIssueCommentVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreIssueCommentVersion not implemented")
+}
 func (UnimplementedIssueSyncAdminServiceServer) mustEmbedUnimplementedIssueSyncAdminServiceServer() {}
 func (UnimplementedIssueSyncAdminServiceServer) testEmbeddedByValue()                               {}
 
@@ -217,6 +514,17 @@ func _IssueSyncAdminService_SyncIssues_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueSyncAdminService_WatchSyncJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSyncJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IssueSyncAdminServiceServer).WatchSyncJob(m, &grpc.GenericServerStream[WatchSyncJobRequest, SyncJobEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueSyncAdminService_WatchSyncJobServer = grpc.ServerStreamingServer[SyncJobEvent]
+
 func _IssueSyncAdminService_GetSyncConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -253,6 +561,96 @@ func _IssueSyncAdminService_UpdateSyncConfig_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueSyncAdminService_GetIssueSummaryConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).GetIssueSummaryConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_GetIssueSummaryConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).GetIssueSummaryConfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_UpdateIssueSummaryConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssueSummaryConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).UpdateIssueSummaryConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_UpdateIssueSummaryConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).UpdateIssueSummaryConfig(ctx, req.(*UpdateIssueSummaryConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_GetPRReviewConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).GetPRReviewConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_GetPRReviewConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).GetPRReviewConfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_UpdatePRReviewConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePRReviewConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).UpdatePRReviewConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_UpdatePRReviewConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).UpdatePRReviewConfig(ctx, req.(*UpdatePRReviewConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_ListSettingsChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettingsChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).ListSettingsChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_ListSettingsChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).ListSettingsChanges(ctx, req.(*ListSettingsChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssueSyncAdminService_ListManagedSyncRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -343,6 +741,222 @@ func _IssueSyncAdminService_ClearIssueAISummaries_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueSyncAdminService_ReconcileIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).ReconcileIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_ReconcileIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).ReconcileIssues(ctx, req.(*ReconcileIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_GetManagedSyncRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManagedSyncRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).GetManagedSyncRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_GetManagedSyncRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).GetManagedSyncRepo(ctx, req.(*GetManagedSyncRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_UpdateRepoSyncPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepoSyncPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).UpdateRepoSyncPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_UpdateRepoSyncPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).UpdateRepoSyncPolicy(ctx, req.(*UpdateRepoSyncPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_DiscoverManagedSyncRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).DiscoverManagedSyncRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_DiscoverManagedSyncRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).DiscoverManagedSyncRepos(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_ResyncRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).ResyncRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_ResyncRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).ResyncRepo(ctx, req.(*ResyncRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_GetResyncJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResyncJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).GetResyncJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_GetResyncJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).GetResyncJob(ctx, req.(*GetResyncJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_ListResyncJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).ListResyncJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_ListResyncJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).ListResyncJobs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_ListSyncRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyncRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).ListSyncRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_ListSyncRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).ListSyncRuns(ctx, req.(*ListSyncRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_GetSyncRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).GetSyncRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_GetSyncRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).GetSyncRun(ctx, req.(*GetSyncRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_MigrateIssueComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateIssueCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).MigrateIssueComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_MigrateIssueComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).MigrateIssueComments(ctx, req.(*MigrateIssueCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_ListIssueCommentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueCommentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).ListIssueCommentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_ListIssueCommentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).ListIssueCommentVersions(ctx, req.(*ListIssueCommentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueSyncAdminService_RestoreIssueCommentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreIssueCommentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueSyncAdminServiceServer).RestoreIssueCommentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueSyncAdminService_RestoreIssueCommentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueSyncAdminServiceServer).RestoreIssueCommentVersion(ctx, req.(*RestoreIssueCommentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueSyncAdminService_ServiceDesc is the grpc.ServiceDesc for IssueSyncAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +976,26 @@ var IssueSyncAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSyncConfig",
 			Handler:    _IssueSyncAdminService_UpdateSyncConfig_Handler,
 		},
+		{
+			MethodName: "GetIssueSummaryConfig",
+			Handler:    _IssueSyncAdminService_GetIssueSummaryConfig_Handler,
+		},
+		{
+			MethodName: "UpdateIssueSummaryConfig",
+			Handler:    _IssueSyncAdminService_UpdateIssueSummaryConfig_Handler,
+		},
+		{
+			MethodName: "GetPRReviewConfig",
+			Handler:    _IssueSyncAdminService_GetPRReviewConfig_Handler,
+		},
+		{
+			MethodName: "UpdatePRReviewConfig",
+			Handler:    _IssueSyncAdminService_UpdatePRReviewConfig_Handler,
+		},
+		{
+			MethodName: "ListSettingsChanges",
+			Handler:    _IssueSyncAdminService_ListSettingsChanges_Handler,
+		},
 		{
 			MethodName: "ListManagedSyncRepos",
 			Handler:    _IssueSyncAdminService_ListManagedSyncRepos_Handler,
@@ -371,25 +1005,84 @@ var IssueSyncAdminService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _IssueSyncAdminService_ReplaceManagedSyncRepos_Handler,
 		},
 		{
-			MethodName: "GetSyncStatus",
-			Handler:    _IssueSyncAdminService_GetSyncStatus_Handler,
+			MethodName: "GetSyncStatus",
+			Handler:    _IssueSyncAdminService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "UpdateIssueAISummary",
+			Handler:    _IssueSyncAdminService_UpdateIssueAISummary_Handler,
+		},
+		{
+			MethodName: "ClearIssueAISummaries",
+			Handler:    _IssueSyncAdminService_ClearIssueAISummaries_Handler,
+		},
+		{
+			MethodName: "ReconcileIssues",
+			Handler:    _IssueSyncAdminService_ReconcileIssues_Handler,
+		},
+		{
+			MethodName: "GetManagedSyncRepo",
+			Handler:    _IssueSyncAdminService_GetManagedSyncRepo_Handler,
+		},
+		{
+			MethodName: "UpdateRepoSyncPolicy",
+			Handler:    _IssueSyncAdminService_UpdateRepoSyncPolicy_Handler,
+		},
+		{
+			MethodName: "DiscoverManagedSyncRepos",
+			Handler:    _IssueSyncAdminService_DiscoverManagedSyncRepos_Handler,
+		},
+		{
+			MethodName: "ResyncRepo",
+			Handler:    _IssueSyncAdminService_ResyncRepo_Handler,
+		},
+		{
+			MethodName: "GetResyncJob",
+			Handler:    _IssueSyncAdminService_GetResyncJob_Handler,
+		},
+		{
+			MethodName: "ListResyncJobs",
+			Handler:    _IssueSyncAdminService_ListResyncJobs_Handler,
+		},
+		{
+			MethodName: "ListSyncRuns",
+			Handler:    _IssueSyncAdminService_ListSyncRuns_Handler,
+		},
+		{
+			MethodName: "GetSyncRun",
+			Handler:    _IssueSyncAdminService_GetSyncRun_Handler,
+		},
+		{
+			MethodName: "MigrateIssueComments",
+			Handler:    _IssueSyncAdminService_MigrateIssueComments_Handler,
+		},
+		{
+			MethodName: "ListIssueCommentVersions",
+			Handler:    _IssueSyncAdminService_ListIssueCommentVersions_Handler,
 		},
 		{
-			MethodName: "UpdateIssueAISummary",
-			Handler:    _IssueSyncAdminService_UpdateIssueAISummary_Handler,
+			MethodName: "RestoreIssueCommentVersion",
+			Handler:    _IssueSyncAdminService_RestoreIssueCommentVersion_Handler,
 		},
+	},
+	Streams: []grpc.StreamDesc{
 		{
-			MethodName: "ClearIssueAISummaries",
-			Handler:    _IssueSyncAdminService_ClearIssueAISummaries_Handler,
+			StreamName:    "WatchSyncJob",
+			Handler:       _IssueSyncAdminService_WatchSyncJob_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "issues/v1/issue.proto",
 }
 
 const (
-	IssueQueryService_ListIssues_FullMethodName = "/issues.v1.IssueQueryService/ListIssues"
-	IssueQueryService_GetIssue_FullMethodName   = "/issues.v1.IssueQueryService/GetIssue"
+	IssueQueryService_ListIssues_FullMethodName             = "/issues.v1.IssueQueryService/ListIssues"
+	IssueQueryService_GetIssue_FullMethodName               = "/issues.v1.IssueQueryService/GetIssue"
+	IssueQueryService_SearchIssues_FullMethodName           = "/issues.v1.IssueQueryService/SearchIssues"
+	IssueQueryService_ListIssueEvents_FullMethodName        = "/issues.v1.IssueQueryService/ListIssueEvents"
+	IssueQueryService_ListLabels_FullMethodName             = "/issues.v1.IssueQueryService/ListLabels"
+	IssueQueryService_ListMilestones_FullMethodName         = "/issues.v1.IssueQueryService/ListMilestones"
+	IssueQueryService_GetIssueResponsiveness_FullMethodName = "/issues.v1.IssueQueryService/GetIssueResponsiveness"
 )
 
 // IssueQueryServiceClient is the client API for IssueQueryService service.
@@ -398,6 +1091,11 @@ const (
 type IssueQueryServiceClient interface {
 	ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error)
 	GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error)
+	SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error)
+	ListIssueEvents(ctx context.Context, in *ListIssueEventsRequest, opts ...grpc.CallOption) (*ListIssueEventsResponse, error)
+	ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error)
+	ListMilestones(ctx context.Context, in *ListMilestonesRequest, opts ...grpc.CallOption) (*ListMilestonesResponse, error)
+	GetIssueResponsiveness(ctx context.Context, in *GetIssueResponsivenessRequest, opts ...grpc.CallOption) (*GetIssueResponsivenessResponse, error)
 }
 
 type issueQueryServiceClient struct {
@@ -428,12 +1126,67 @@ func (c *issueQueryServiceClient) GetIssue(ctx context.Context, in *GetIssueRequ
 	return out, nil
 }
 
+func (c *issueQueryServiceClient) SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueQueryService_SearchIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueQueryServiceClient) ListIssueEvents(ctx context.Context, in *ListIssueEventsRequest, opts ...grpc.CallOption) (*ListIssueEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueEventsResponse)
+	err := c.cc.Invoke(ctx, IssueQueryService_ListIssueEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueQueryServiceClient) ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, IssueQueryService_ListLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueQueryServiceClient) ListMilestones(ctx context.Context, in *ListMilestonesRequest, opts ...grpc.CallOption) (*ListMilestonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMilestonesResponse)
+	err := c.cc.Invoke(ctx, IssueQueryService_ListMilestones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueQueryServiceClient) GetIssueResponsiveness(ctx context.Context, in *GetIssueResponsivenessRequest, opts ...grpc.CallOption) (*GetIssueResponsivenessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueResponsivenessResponse)
+	err := c.cc.Invoke(ctx, IssueQueryService_GetIssueResponsiveness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssueQueryServiceServer is the server API for IssueQueryService service.
 // All implementations must embed UnimplementedIssueQueryServiceServer
 // for forward compatibility.
 type IssueQueryServiceServer interface {
 	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
 	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+	SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error)
+	ListIssueEvents(context.Context, *ListIssueEventsRequest) (*ListIssueEventsResponse, error)
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
+	ListMilestones(context.Context, *ListMilestonesRequest) (*ListMilestonesResponse, error)
+	GetIssueResponsiveness(context.Context, *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error)
 	mustEmbedUnimplementedIssueQueryServiceServer()
 }
 
@@ -450,6 +1203,21 @@ func (UnimplementedIssueQueryServiceServer) ListIssues(context.Context, *ListIss
 func (UnimplementedIssueQueryServiceServer) GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetIssue not implemented")
 }
+func (UnimplementedIssueQueryServiceServer) SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchIssues not implemented")
+}
+func (UnimplementedIssueQueryServiceServer) ListIssueEvents(context.Context, *ListIssueEventsRequest) (*ListIssueEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIssueEvents not implemented")
+}
+func (UnimplementedIssueQueryServiceServer) ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLabels not implemented")
+}
+func (UnimplementedIssueQueryServiceServer) ListMilestones(context.Context, *ListMilestonesRequest) (*ListMilestonesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMilestones not implemented")
+}
+func (UnimplementedIssueQueryServiceServer) GetIssueResponsiveness(context.Context, *GetIssueResponsivenessRequest) (*GetIssueResponsivenessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIssueResponsiveness not implemented")
+}
 func (UnimplementedIssueQueryServiceServer) mustEmbedUnimplementedIssueQueryServiceServer() {}
 func (UnimplementedIssueQueryServiceServer) testEmbeddedByValue()                           {}
 
@@ -507,6 +1275,96 @@ func _IssueQueryService_GetIssue_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueQueryService_SearchIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueQueryServiceServer).SearchIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueQueryService_SearchIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueQueryServiceServer).SearchIssues(ctx, req.(*SearchIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueQueryService_ListIssueEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueQueryServiceServer).ListIssueEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueQueryService_ListIssueEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueQueryServiceServer).ListIssueEvents(ctx, req.(*ListIssueEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueQueryService_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueQueryServiceServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueQueryService_ListLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueQueryServiceServer).ListLabels(ctx, req.(*ListLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueQueryService_ListMilestones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMilestonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueQueryServiceServer).ListMilestones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueQueryService_ListMilestones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueQueryServiceServer).ListMilestones(ctx, req.(*ListMilestonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueQueryService_GetIssueResponsiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueResponsivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueQueryServiceServer).GetIssueResponsiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueQueryService_GetIssueResponsiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueQueryServiceServer).GetIssueResponsiveness(ctx, req.(*GetIssueResponsivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssueQueryService_ServiceDesc is the grpc.ServiceDesc for IssueQueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -522,6 +1380,26 @@ var IssueQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIssue",
 			Handler:    _IssueQueryService_GetIssue_Handler,
 		},
+		{
+			MethodName: "SearchIssues",
+			Handler:    _IssueQueryService_SearchIssues_Handler,
+		},
+		{
+			MethodName: "ListIssueEvents",
+			Handler:    _IssueQueryService_ListIssueEvents_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _IssueQueryService_ListLabels_Handler,
+		},
+		{
+			MethodName: "ListMilestones",
+			Handler:    _IssueQueryService_ListMilestones_Handler,
+		},
+		{
+			MethodName: "GetIssueResponsiveness",
+			Handler:    _IssueQueryService_GetIssueResponsiveness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "issues/v1/issue.proto",
@@ -844,3 +1722,221 @@ var AdminAuthService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "issues/v1/issue.proto",
 }
+
+const (
+	JobAdminService_ListJobs_FullMethodName   = "/issues.v1.JobAdminService/ListJobs"
+	JobAdminService_PauseJob_FullMethodName   = "/issues.v1.JobAdminService/PauseJob"
+	JobAdminService_ResumeJob_FullMethodName  = "/issues.v1.JobAdminService/ResumeJob"
+	JobAdminService_TriggerJob_FullMethodName = "/issues.v1.JobAdminService/TriggerJob"
+)
+
+// JobAdminServiceClient is the client API for JobAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobAdminServiceClient interface {
+	ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*Job, error)
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// TriggerJob starts a run now, on whichever replica receives the request.
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*Job, error)
+}
+
+type jobAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobAdminServiceClient(cc grpc.ClientConnInterface) JobAdminServiceClient {
+	return &jobAdminServiceClient{cc}
+}
+
+func (c *jobAdminServiceClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, JobAdminService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobAdminServiceClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, JobAdminService_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobAdminServiceClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, JobAdminService_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobAdminServiceClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, JobAdminService_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobAdminServiceServer is the server API for JobAdminService service.
+// All implementations must embed UnimplementedJobAdminServiceServer
+// for forward compatibility.
+type JobAdminServiceServer interface {
+	ListJobs(context.Context, *emptypb.Empty) (*ListJobsResponse, error)
+	PauseJob(context.Context, *PauseJobRequest) (*Job, error)
+	ResumeJob(context.Context, *ResumeJobRequest) (*Job, error)
+	// TriggerJob starts a run now, on whichever replica receives the request.
+	TriggerJob(context.Context, *TriggerJobRequest) (*Job, error)
+	mustEmbedUnimplementedJobAdminServiceServer()
+}
+
+// UnimplementedJobAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJobAdminServiceServer struct{}
+
+func (UnimplementedJobAdminServiceServer) ListJobs(context.Context, *emptypb.Empty) (*ListJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedJobAdminServiceServer) PauseJob(context.Context, *PauseJobRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedJobAdminServiceServer) ResumeJob(context.Context, *ResumeJobRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedJobAdminServiceServer) TriggerJob(context.Context, *TriggerJobRequest) (*Job, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedJobAdminServiceServer) mustEmbedUnimplementedJobAdminServiceServer() {}
+func (UnimplementedJobAdminServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeJobAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobAdminServiceServer will
+// result in compilation errors.
+type UnsafeJobAdminServiceServer interface {
+	mustEmbedUnimplementedJobAdminServiceServer()
+}
+
+func RegisterJobAdminServiceServer(s grpc.ServiceRegistrar, srv JobAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedJobAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JobAdminService_ServiceDesc, srv)
+}
+
+func _JobAdminService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobAdminServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobAdminService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobAdminServiceServer).ListJobs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobAdminService_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobAdminServiceServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobAdminService_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobAdminServiceServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobAdminService_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobAdminServiceServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobAdminService_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobAdminServiceServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobAdminService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobAdminServiceServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobAdminService_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobAdminServiceServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobAdminService_ServiceDesc is the grpc.ServiceDesc for JobAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "issues.v1.JobAdminService",
+	HandlerType: (*JobAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobs",
+			Handler:    _JobAdminService_ListJobs_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _JobAdminService_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _JobAdminService_ResumeJob_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _JobAdminService_TriggerJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "issues/v1/issue.proto",
+}
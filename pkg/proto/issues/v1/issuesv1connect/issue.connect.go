@@ -30,6 +30,8 @@ const (
 	PRReviewQueryServiceName = "issues.v1.PRReviewQueryService"
 	// AdminAuthServiceName is the fully-qualified name of the AdminAuthService service.
 	AdminAuthServiceName = "issues.v1.AdminAuthService"
+	// JobAdminServiceName is the fully-qualified name of the JobAdminService service.
+	JobAdminServiceName = "issues.v1.JobAdminService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -43,12 +45,30 @@ const (
 	// IssueSyncAdminServiceSyncIssuesProcedure is the fully-qualified name of the
 	// IssueSyncAdminService's SyncIssues RPC.
 	IssueSyncAdminServiceSyncIssuesProcedure = "/issues.v1.IssueSyncAdminService/SyncIssues"
+	// IssueSyncAdminServiceWatchSyncJobProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's WatchSyncJob RPC.
+	IssueSyncAdminServiceWatchSyncJobProcedure = "/issues.v1.IssueSyncAdminService/WatchSyncJob"
 	// IssueSyncAdminServiceGetSyncConfigProcedure is the fully-qualified name of the
 	// IssueSyncAdminService's GetSyncConfig RPC.
 	IssueSyncAdminServiceGetSyncConfigProcedure = "/issues.v1.IssueSyncAdminService/GetSyncConfig"
 	// IssueSyncAdminServiceUpdateSyncConfigProcedure is the fully-qualified name of the
 	// IssueSyncAdminService's UpdateSyncConfig RPC.
 	IssueSyncAdminServiceUpdateSyncConfigProcedure = "/issues.v1.IssueSyncAdminService/UpdateSyncConfig"
+	// IssueSyncAdminServiceGetIssueSummaryConfigProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's GetIssueSummaryConfig RPC.
+	IssueSyncAdminServiceGetIssueSummaryConfigProcedure = "/issues.v1.IssueSyncAdminService/GetIssueSummaryConfig"
+	// IssueSyncAdminServiceUpdateIssueSummaryConfigProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's UpdateIssueSummaryConfig RPC.
+	IssueSyncAdminServiceUpdateIssueSummaryConfigProcedure = "/issues.v1.IssueSyncAdminService/UpdateIssueSummaryConfig"
+	// IssueSyncAdminServiceGetPRReviewConfigProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's GetPRReviewConfig RPC.
+	IssueSyncAdminServiceGetPRReviewConfigProcedure = "/issues.v1.IssueSyncAdminService/GetPRReviewConfig"
+	// IssueSyncAdminServiceUpdatePRReviewConfigProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's UpdatePRReviewConfig RPC.
+	IssueSyncAdminServiceUpdatePRReviewConfigProcedure = "/issues.v1.IssueSyncAdminService/UpdatePRReviewConfig"
+	// IssueSyncAdminServiceListSettingsChangesProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's ListSettingsChanges RPC.
+	IssueSyncAdminServiceListSettingsChangesProcedure = "/issues.v1.IssueSyncAdminService/ListSettingsChanges"
 	// IssueSyncAdminServiceListManagedSyncReposProcedure is the fully-qualified name of the
 	// IssueSyncAdminService's ListManagedSyncRepos RPC.
 	IssueSyncAdminServiceListManagedSyncReposProcedure = "/issues.v1.IssueSyncAdminService/ListManagedSyncRepos"
@@ -64,12 +84,63 @@ const (
 	// IssueSyncAdminServiceClearIssueAISummariesProcedure is the fully-qualified name of the
 	// IssueSyncAdminService's ClearIssueAISummaries RPC.
 	IssueSyncAdminServiceClearIssueAISummariesProcedure = "/issues.v1.IssueSyncAdminService/ClearIssueAISummaries"
+	// IssueSyncAdminServiceReconcileIssuesProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's ReconcileIssues RPC.
+	IssueSyncAdminServiceReconcileIssuesProcedure = "/issues.v1.IssueSyncAdminService/ReconcileIssues"
+	// IssueSyncAdminServiceGetManagedSyncRepoProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's GetManagedSyncRepo RPC.
+	IssueSyncAdminServiceGetManagedSyncRepoProcedure = "/issues.v1.IssueSyncAdminService/GetManagedSyncRepo"
+	// IssueSyncAdminServiceUpdateRepoSyncPolicyProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's UpdateRepoSyncPolicy RPC.
+	IssueSyncAdminServiceUpdateRepoSyncPolicyProcedure = "/issues.v1.IssueSyncAdminService/UpdateRepoSyncPolicy"
+	// IssueSyncAdminServiceDiscoverManagedSyncReposProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's DiscoverManagedSyncRepos RPC.
+	IssueSyncAdminServiceDiscoverManagedSyncReposProcedure = "/issues.v1.IssueSyncAdminService/DiscoverManagedSyncRepos"
+	// IssueSyncAdminServiceResyncRepoProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's ResyncRepo RPC.
+	IssueSyncAdminServiceResyncRepoProcedure = "/issues.v1.IssueSyncAdminService/ResyncRepo"
+	// IssueSyncAdminServiceGetResyncJobProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's GetResyncJob RPC.
+	IssueSyncAdminServiceGetResyncJobProcedure = "/issues.v1.IssueSyncAdminService/GetResyncJob"
+	// IssueSyncAdminServiceListResyncJobsProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's ListResyncJobs RPC.
+	IssueSyncAdminServiceListResyncJobsProcedure = "/issues.v1.IssueSyncAdminService/ListResyncJobs"
+	// IssueSyncAdminServiceListSyncRunsProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's ListSyncRuns RPC.
+	IssueSyncAdminServiceListSyncRunsProcedure = "/issues.v1.IssueSyncAdminService/ListSyncRuns"
+	// IssueSyncAdminServiceGetSyncRunProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's GetSyncRun RPC.
+	IssueSyncAdminServiceGetSyncRunProcedure = "/issues.v1.IssueSyncAdminService/GetSyncRun"
+	// IssueSyncAdminServiceMigrateIssueCommentsProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's MigrateIssueComments RPC.
+	IssueSyncAdminServiceMigrateIssueCommentsProcedure = "/issues.v1.IssueSyncAdminService/MigrateIssueComments"
+	// IssueSyncAdminServiceListIssueCommentVersionsProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's ListIssueCommentVersions RPC.
+	IssueSyncAdminServiceListIssueCommentVersionsProcedure = "/issues.v1.IssueSyncAdminService/ListIssueCommentVersions"
+	// IssueSyncAdminServiceRestoreIssueCommentVersionProcedure is the fully-qualified name of the
+	// IssueSyncAdminService's RestoreIssueCommentVersion RPC.
+	IssueSyncAdminServiceRestoreIssueCommentVersionProcedure = "/issues.v1.IssueSyncAdminService/RestoreIssueCommentVersion"
 	// IssueQueryServiceListIssuesProcedure is the fully-qualified name of the IssueQueryService's
 	// ListIssues RPC.
 	IssueQueryServiceListIssuesProcedure = "/issues.v1.IssueQueryService/ListIssues"
 	// IssueQueryServiceGetIssueProcedure is the fully-qualified name of the IssueQueryService's
 	// GetIssue RPC.
 	IssueQueryServiceGetIssueProcedure = "/issues.v1.IssueQueryService/GetIssue"
+	// IssueQueryServiceSearchIssuesProcedure is the fully-qualified name of the IssueQueryService's
+	// SearchIssues RPC.
+	IssueQueryServiceSearchIssuesProcedure = "/issues.v1.IssueQueryService/SearchIssues"
+	// IssueQueryServiceListIssueEventsProcedure is the fully-qualified name of the IssueQueryService's
+	// ListIssueEvents RPC.
+	IssueQueryServiceListIssueEventsProcedure = "/issues.v1.IssueQueryService/ListIssueEvents"
+	// IssueQueryServiceListLabelsProcedure is the fully-qualified name of the IssueQueryService's
+	// ListLabels RPC.
+	IssueQueryServiceListLabelsProcedure = "/issues.v1.IssueQueryService/ListLabels"
+	// IssueQueryServiceListMilestonesProcedure is the fully-qualified name of the IssueQueryService's
+	// ListMilestones RPC.
+	IssueQueryServiceListMilestonesProcedure = "/issues.v1.IssueQueryService/ListMilestones"
+	// IssueQueryServiceGetIssueResponsivenessProcedure is the fully-qualified name of the
+	// IssueQueryService's GetIssueResponsiveness RPC.
+	IssueQueryServiceGetIssueResponsivenessProcedure = "/issues.v1.IssueQueryService/GetIssueResponsiveness"
 	// PRReviewQueryServiceListPRReviewsProcedure is the fully-qualified name of the
 	// PRReviewQueryService's ListPRReviews RPC.
 	PRReviewQueryServiceListPRReviewsProcedure = "/issues.v1.PRReviewQueryService/ListPRReviews"
@@ -85,18 +156,48 @@ const (
 	// AdminAuthServiceAdminWhoAmIProcedure is the fully-qualified name of the AdminAuthService's
 	// AdminWhoAmI RPC.
 	AdminAuthServiceAdminWhoAmIProcedure = "/issues.v1.AdminAuthService/AdminWhoAmI"
+	// JobAdminServiceListJobsProcedure is the fully-qualified name of the JobAdminService's ListJobs
+	// RPC.
+	JobAdminServiceListJobsProcedure = "/issues.v1.JobAdminService/ListJobs"
+	// JobAdminServicePauseJobProcedure is the fully-qualified name of the JobAdminService's PauseJob
+	// RPC.
+	JobAdminServicePauseJobProcedure = "/issues.v1.JobAdminService/PauseJob"
+	// JobAdminServiceResumeJobProcedure is the fully-qualified name of the JobAdminService's ResumeJob
+	// RPC.
+	JobAdminServiceResumeJobProcedure = "/issues.v1.JobAdminService/ResumeJob"
+	// JobAdminServiceTriggerJobProcedure is the fully-qualified name of the JobAdminService's
+	// TriggerJob RPC.
+	JobAdminServiceTriggerJobProcedure = "/issues.v1.JobAdminService/TriggerJob"
 )
 
 // IssueSyncAdminServiceClient is a client for the issues.v1.IssueSyncAdminService service.
 type IssueSyncAdminServiceClient interface {
 	SyncIssues(context.Context, *connect.Request[v1.SyncIssuesRequest]) (*connect.Response[v1.SyncIssuesResponse], error)
+	WatchSyncJob(context.Context, *connect.Request[v1.WatchSyncJobRequest]) (*connect.ServerStreamForClient[v1.SyncJobEvent], error)
 	GetSyncConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncConfigResponse], error)
 	UpdateSyncConfig(context.Context, *connect.Request[v1.UpdateSyncConfigRequest]) (*connect.Response[v1.GetSyncConfigResponse], error)
+	GetIssueSummaryConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error)
+	UpdateIssueSummaryConfig(context.Context, *connect.Request[v1.UpdateIssueSummaryConfigRequest]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error)
+	GetPRReviewConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPRReviewConfigResponse], error)
+	UpdatePRReviewConfig(context.Context, *connect.Request[v1.UpdatePRReviewConfigRequest]) (*connect.Response[v1.GetPRReviewConfigResponse], error)
+	ListSettingsChanges(context.Context, *connect.Request[v1.ListSettingsChangesRequest]) (*connect.Response[v1.ListSettingsChangesResponse], error)
 	ListManagedSyncRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListManagedSyncReposResponse], error)
 	ReplaceManagedSyncRepos(context.Context, *connect.Request[v1.ReplaceManagedSyncReposRequest]) (*connect.Response[v1.ListManagedSyncReposResponse], error)
 	GetSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncStatusResponse], error)
 	UpdateIssueAISummary(context.Context, *connect.Request[v1.UpdateIssueAISummaryRequest]) (*connect.Response[v1.GetIssueResponse], error)
 	ClearIssueAISummaries(context.Context, *connect.Request[v1.ClearIssueAISummariesRequest]) (*connect.Response[v1.ClearIssueAISummariesResponse], error)
+	ReconcileIssues(context.Context, *connect.Request[v1.ReconcileIssuesRequest]) (*connect.Response[v1.ReconcileIssuesResponse], error)
+	GetManagedSyncRepo(context.Context, *connect.Request[v1.GetManagedSyncRepoRequest]) (*connect.Response[v1.ManagedSyncRepo], error)
+	UpdateRepoSyncPolicy(context.Context, *connect.Request[v1.UpdateRepoSyncPolicyRequest]) (*connect.Response[v1.ManagedSyncRepo], error)
+	DiscoverManagedSyncRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.DiscoverManagedSyncReposResponse], error)
+	ResyncRepo(context.Context, *connect.Request[v1.ResyncRepoRequest]) (*connect.Response[v1.ResyncJob], error)
+	GetResyncJob(context.Context, *connect.Request[v1.GetResyncJobRequest]) (*connect.Response[v1.ResyncJob], error)
+	ListResyncJobs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListResyncJobsResponse], error)
+	ListSyncRuns(context.Context, *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error)
+	GetSyncRun(context.Context, *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.SyncRun], error)
+	MigrateIssueComments(context.Context, *connect.Request[v1.MigrateIssueCommentsRequest]) (*connect.Response[v1.MigrateIssueCommentsResponse], error)
+	ListIssueCommentVersions(context.Context, *connect.Request[v1.ListIssueCommentVersionsRequest]) (*connect.Response[v1.ListIssueCommentVersionsResponse], error)
+	RestoreIssueCommentVersion(context.Context, *connect.Request[v1.RestoreIssueCommentVersionRequest]) (*connect.Response[v1.RestoreIssueCommentVersionResponse], error)
 }
 
 // NewIssueSyncAdminServiceClient constructs a client for the issues.v1.IssueSyncAdminService
@@ -116,6 +217,12 @@ func NewIssueSyncAdminServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(issueSyncAdminServiceMethods.ByName("SyncIssues")),
 			connect.WithClientOptions(opts...),
 		),
+		watchSyncJob: connect.NewClient[v1.WatchSyncJobRequest, v1.SyncJobEvent](
+			httpClient,
+			baseURL+IssueSyncAdminServiceWatchSyncJobProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("WatchSyncJob")),
+			connect.WithClientOptions(opts...),
+		),
 		getSyncConfig: connect.NewClient[emptypb.Empty, v1.GetSyncConfigResponse](
 			httpClient,
 			baseURL+IssueSyncAdminServiceGetSyncConfigProcedure,
@@ -128,6 +235,36 @@ func NewIssueSyncAdminServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdateSyncConfig")),
 			connect.WithClientOptions(opts...),
 		),
+		getIssueSummaryConfig: connect.NewClient[emptypb.Empty, v1.GetIssueSummaryConfigResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceGetIssueSummaryConfigProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetIssueSummaryConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updateIssueSummaryConfig: connect.NewClient[v1.UpdateIssueSummaryConfigRequest, v1.GetIssueSummaryConfigResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceUpdateIssueSummaryConfigProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdateIssueSummaryConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		getPRReviewConfig: connect.NewClient[emptypb.Empty, v1.GetPRReviewConfigResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceGetPRReviewConfigProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetPRReviewConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePRReviewConfig: connect.NewClient[v1.UpdatePRReviewConfigRequest, v1.GetPRReviewConfigResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceUpdatePRReviewConfigProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdatePRReviewConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		listSettingsChanges: connect.NewClient[v1.ListSettingsChangesRequest, v1.ListSettingsChangesResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceListSettingsChangesProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListSettingsChanges")),
+			connect.WithClientOptions(opts...),
+		),
 		listManagedSyncRepos: connect.NewClient[emptypb.Empty, v1.ListManagedSyncReposResponse](
 			httpClient,
 			baseURL+IssueSyncAdminServiceListManagedSyncReposProcedure,
@@ -158,19 +295,109 @@ func NewIssueSyncAdminServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ClearIssueAISummaries")),
 			connect.WithClientOptions(opts...),
 		),
+		reconcileIssues: connect.NewClient[v1.ReconcileIssuesRequest, v1.ReconcileIssuesResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceReconcileIssuesProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ReconcileIssues")),
+			connect.WithClientOptions(opts...),
+		),
+		getManagedSyncRepo: connect.NewClient[v1.GetManagedSyncRepoRequest, v1.ManagedSyncRepo](
+			httpClient,
+			baseURL+IssueSyncAdminServiceGetManagedSyncRepoProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetManagedSyncRepo")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRepoSyncPolicy: connect.NewClient[v1.UpdateRepoSyncPolicyRequest, v1.ManagedSyncRepo](
+			httpClient,
+			baseURL+IssueSyncAdminServiceUpdateRepoSyncPolicyProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdateRepoSyncPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		discoverManagedSyncRepos: connect.NewClient[emptypb.Empty, v1.DiscoverManagedSyncReposResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceDiscoverManagedSyncReposProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("DiscoverManagedSyncRepos")),
+			connect.WithClientOptions(opts...),
+		),
+		resyncRepo: connect.NewClient[v1.ResyncRepoRequest, v1.ResyncJob](
+			httpClient,
+			baseURL+IssueSyncAdminServiceResyncRepoProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ResyncRepo")),
+			connect.WithClientOptions(opts...),
+		),
+		getResyncJob: connect.NewClient[v1.GetResyncJobRequest, v1.ResyncJob](
+			httpClient,
+			baseURL+IssueSyncAdminServiceGetResyncJobProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetResyncJob")),
+			connect.WithClientOptions(opts...),
+		),
+		listResyncJobs: connect.NewClient[emptypb.Empty, v1.ListResyncJobsResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceListResyncJobsProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListResyncJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		listSyncRuns: connect.NewClient[v1.ListSyncRunsRequest, v1.ListSyncRunsResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceListSyncRunsProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListSyncRuns")),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncRun: connect.NewClient[v1.GetSyncRunRequest, v1.SyncRun](
+			httpClient,
+			baseURL+IssueSyncAdminServiceGetSyncRunProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetSyncRun")),
+			connect.WithClientOptions(opts...),
+		),
+		migrateIssueComments: connect.NewClient[v1.MigrateIssueCommentsRequest, v1.MigrateIssueCommentsResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceMigrateIssueCommentsProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("MigrateIssueComments")),
+			connect.WithClientOptions(opts...),
+		),
+		listIssueCommentVersions: connect.NewClient[v1.ListIssueCommentVersionsRequest, v1.ListIssueCommentVersionsResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceListIssueCommentVersionsProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListIssueCommentVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreIssueCommentVersion: connect.NewClient[v1.RestoreIssueCommentVersionRequest, v1.RestoreIssueCommentVersionResponse](
+			httpClient,
+			baseURL+IssueSyncAdminServiceRestoreIssueCommentVersionProcedure,
+			connect.WithSchema(issueSyncAdminServiceMethods.ByName("RestoreIssueCommentVersion")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // issueSyncAdminServiceClient implements IssueSyncAdminServiceClient.
 type issueSyncAdminServiceClient struct {
-	syncIssues              *connect.Client[v1.SyncIssuesRequest, v1.SyncIssuesResponse]
-	getSyncConfig           *connect.Client[emptypb.Empty, v1.GetSyncConfigResponse]
-	updateSyncConfig        *connect.Client[v1.UpdateSyncConfigRequest, v1.GetSyncConfigResponse]
-	listManagedSyncRepos    *connect.Client[emptypb.Empty, v1.ListManagedSyncReposResponse]
-	replaceManagedSyncRepos *connect.Client[v1.ReplaceManagedSyncReposRequest, v1.ListManagedSyncReposResponse]
-	getSyncStatus           *connect.Client[emptypb.Empty, v1.GetSyncStatusResponse]
-	updateIssueAISummary    *connect.Client[v1.UpdateIssueAISummaryRequest, v1.GetIssueResponse]
-	clearIssueAISummaries   *connect.Client[v1.ClearIssueAISummariesRequest, v1.ClearIssueAISummariesResponse]
+	syncIssues                 *connect.Client[v1.SyncIssuesRequest, v1.SyncIssuesResponse]
+	watchSyncJob               *connect.Client[v1.WatchSyncJobRequest, v1.SyncJobEvent]
+	getSyncConfig              *connect.Client[emptypb.Empty, v1.GetSyncConfigResponse]
+	updateSyncConfig           *connect.Client[v1.UpdateSyncConfigRequest, v1.GetSyncConfigResponse]
+	getIssueSummaryConfig      *connect.Client[emptypb.Empty, v1.GetIssueSummaryConfigResponse]
+	updateIssueSummaryConfig   *connect.Client[v1.UpdateIssueSummaryConfigRequest, v1.GetIssueSummaryConfigResponse]
+	getPRReviewConfig          *connect.Client[emptypb.Empty, v1.GetPRReviewConfigResponse]
+	updatePRReviewConfig       *connect.Client[v1.UpdatePRReviewConfigRequest, v1.GetPRReviewConfigResponse]
+	listSettingsChanges        *connect.Client[v1.ListSettingsChangesRequest, v1.ListSettingsChangesResponse]
+	listManagedSyncRepos       *connect.Client[emptypb.Empty, v1.ListManagedSyncReposResponse]
+	replaceManagedSyncRepos    *connect.Client[v1.ReplaceManagedSyncReposRequest, v1.ListManagedSyncReposResponse]
+	getSyncStatus              *connect.Client[emptypb.Empty, v1.GetSyncStatusResponse]
+	updateIssueAISummary       *connect.Client[v1.UpdateIssueAISummaryRequest, v1.GetIssueResponse]
+	clearIssueAISummaries      *connect.Client[v1.ClearIssueAISummariesRequest, v1.ClearIssueAISummariesResponse]
+	reconcileIssues            *connect.Client[v1.ReconcileIssuesRequest, v1.ReconcileIssuesResponse]
+	getManagedSyncRepo         *connect.Client[v1.GetManagedSyncRepoRequest, v1.ManagedSyncRepo]
+	updateRepoSyncPolicy       *connect.Client[v1.UpdateRepoSyncPolicyRequest, v1.ManagedSyncRepo]
+	discoverManagedSyncRepos   *connect.Client[emptypb.Empty, v1.DiscoverManagedSyncReposResponse]
+	resyncRepo                 *connect.Client[v1.ResyncRepoRequest, v1.ResyncJob]
+	getResyncJob               *connect.Client[v1.GetResyncJobRequest, v1.ResyncJob]
+	listResyncJobs             *connect.Client[emptypb.Empty, v1.ListResyncJobsResponse]
+	listSyncRuns               *connect.Client[v1.ListSyncRunsRequest, v1.ListSyncRunsResponse]
+	getSyncRun                 *connect.Client[v1.GetSyncRunRequest, v1.SyncRun]
+	migrateIssueComments       *connect.Client[v1.MigrateIssueCommentsRequest, v1.MigrateIssueCommentsResponse]
+	listIssueCommentVersions   *connect.Client[v1.ListIssueCommentVersionsRequest, v1.ListIssueCommentVersionsResponse]
+	restoreIssueCommentVersion *connect.Client[v1.RestoreIssueCommentVersionRequest, v1.RestoreIssueCommentVersionResponse]
 }
 
 // SyncIssues calls issues.v1.IssueSyncAdminService.SyncIssues.
@@ -178,6 +405,11 @@ func (c *issueSyncAdminServiceClient) SyncIssues(ctx context.Context, req *conne
 	return c.syncIssues.CallUnary(ctx, req)
 }
 
+// WatchSyncJob calls issues.v1.IssueSyncAdminService.WatchSyncJob.
+func (c *issueSyncAdminServiceClient) WatchSyncJob(ctx context.Context, req *connect.Request[v1.WatchSyncJobRequest]) (*connect.ServerStreamForClient[v1.SyncJobEvent], error) {
+	return c.watchSyncJob.CallServerStream(ctx, req)
+}
+
 // GetSyncConfig calls issues.v1.IssueSyncAdminService.GetSyncConfig.
 func (c *issueSyncAdminServiceClient) GetSyncConfig(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncConfigResponse], error) {
 	return c.getSyncConfig.CallUnary(ctx, req)
@@ -188,6 +420,31 @@ func (c *issueSyncAdminServiceClient) UpdateSyncConfig(ctx context.Context, req
 	return c.updateSyncConfig.CallUnary(ctx, req)
 }
 
+// GetIssueSummaryConfig calls issues.v1.IssueSyncAdminService.GetIssueSummaryConfig.
+func (c *issueSyncAdminServiceClient) GetIssueSummaryConfig(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error) {
+	return c.getIssueSummaryConfig.CallUnary(ctx, req)
+}
+
+// UpdateIssueSummaryConfig calls issues.v1.IssueSyncAdminService.UpdateIssueSummaryConfig.
+func (c *issueSyncAdminServiceClient) UpdateIssueSummaryConfig(ctx context.Context, req *connect.Request[v1.UpdateIssueSummaryConfigRequest]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error) {
+	return c.updateIssueSummaryConfig.CallUnary(ctx, req)
+}
+
+// GetPRReviewConfig calls issues.v1.IssueSyncAdminService.GetPRReviewConfig.
+func (c *issueSyncAdminServiceClient) GetPRReviewConfig(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPRReviewConfigResponse], error) {
+	return c.getPRReviewConfig.CallUnary(ctx, req)
+}
+
+// UpdatePRReviewConfig calls issues.v1.IssueSyncAdminService.UpdatePRReviewConfig.
+func (c *issueSyncAdminServiceClient) UpdatePRReviewConfig(ctx context.Context, req *connect.Request[v1.UpdatePRReviewConfigRequest]) (*connect.Response[v1.GetPRReviewConfigResponse], error) {
+	return c.updatePRReviewConfig.CallUnary(ctx, req)
+}
+
+// ListSettingsChanges calls issues.v1.IssueSyncAdminService.ListSettingsChanges.
+func (c *issueSyncAdminServiceClient) ListSettingsChanges(ctx context.Context, req *connect.Request[v1.ListSettingsChangesRequest]) (*connect.Response[v1.ListSettingsChangesResponse], error) {
+	return c.listSettingsChanges.CallUnary(ctx, req)
+}
+
 // ListManagedSyncRepos calls issues.v1.IssueSyncAdminService.ListManagedSyncRepos.
 func (c *issueSyncAdminServiceClient) ListManagedSyncRepos(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListManagedSyncReposResponse], error) {
 	return c.listManagedSyncRepos.CallUnary(ctx, req)
@@ -213,16 +470,94 @@ func (c *issueSyncAdminServiceClient) ClearIssueAISummaries(ctx context.Context,
 	return c.clearIssueAISummaries.CallUnary(ctx, req)
 }
 
+// ReconcileIssues calls issues.v1.IssueSyncAdminService.ReconcileIssues.
+func (c *issueSyncAdminServiceClient) ReconcileIssues(ctx context.Context, req *connect.Request[v1.ReconcileIssuesRequest]) (*connect.Response[v1.ReconcileIssuesResponse], error) {
+	return c.reconcileIssues.CallUnary(ctx, req)
+}
+
+// GetManagedSyncRepo calls issues.v1.IssueSyncAdminService.GetManagedSyncRepo.
+func (c *issueSyncAdminServiceClient) GetManagedSyncRepo(ctx context.Context, req *connect.Request[v1.GetManagedSyncRepoRequest]) (*connect.Response[v1.ManagedSyncRepo], error) {
+	return c.getManagedSyncRepo.CallUnary(ctx, req)
+}
+
+// UpdateRepoSyncPolicy calls issues.v1.IssueSyncAdminService.UpdateRepoSyncPolicy.
+func (c *issueSyncAdminServiceClient) UpdateRepoSyncPolicy(ctx context.Context, req *connect.Request[v1.UpdateRepoSyncPolicyRequest]) (*connect.Response[v1.ManagedSyncRepo], error) {
+	return c.updateRepoSyncPolicy.CallUnary(ctx, req)
+}
+
+// DiscoverManagedSyncRepos calls issues.v1.IssueSyncAdminService.DiscoverManagedSyncRepos.
+func (c *issueSyncAdminServiceClient) DiscoverManagedSyncRepos(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.DiscoverManagedSyncReposResponse], error) {
+	return c.discoverManagedSyncRepos.CallUnary(ctx, req)
+}
+
+// ResyncRepo calls issues.v1.IssueSyncAdminService.ResyncRepo.
+func (c *issueSyncAdminServiceClient) ResyncRepo(ctx context.Context, req *connect.Request[v1.ResyncRepoRequest]) (*connect.Response[v1.ResyncJob], error) {
+	return c.resyncRepo.CallUnary(ctx, req)
+}
+
+// GetResyncJob calls issues.v1.IssueSyncAdminService.GetResyncJob.
+func (c *issueSyncAdminServiceClient) GetResyncJob(ctx context.Context, req *connect.Request[v1.GetResyncJobRequest]) (*connect.Response[v1.ResyncJob], error) {
+	return c.getResyncJob.CallUnary(ctx, req)
+}
+
+// ListResyncJobs calls issues.v1.IssueSyncAdminService.ListResyncJobs.
+func (c *issueSyncAdminServiceClient) ListResyncJobs(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListResyncJobsResponse], error) {
+	return c.listResyncJobs.CallUnary(ctx, req)
+}
+
+// ListSyncRuns calls issues.v1.IssueSyncAdminService.ListSyncRuns.
+func (c *issueSyncAdminServiceClient) ListSyncRuns(ctx context.Context, req *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error) {
+	return c.listSyncRuns.CallUnary(ctx, req)
+}
+
+// GetSyncRun calls issues.v1.IssueSyncAdminService.GetSyncRun.
+func (c *issueSyncAdminServiceClient) GetSyncRun(ctx context.Context, req *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.SyncRun], error) {
+	return c.getSyncRun.CallUnary(ctx, req)
+}
+
+// MigrateIssueComments calls issues.v1.IssueSyncAdminService.MigrateIssueComments.
+func (c *issueSyncAdminServiceClient) MigrateIssueComments(ctx context.Context, req *connect.Request[v1.MigrateIssueCommentsRequest]) (*connect.Response[v1.MigrateIssueCommentsResponse], error) {
+	return c.migrateIssueComments.CallUnary(ctx, req)
+}
+
+// ListIssueCommentVersions calls issues.v1.IssueSyncAdminService.ListIssueCommentVersions.
+func (c *issueSyncAdminServiceClient) ListIssueCommentVersions(ctx context.Context, req *connect.Request[v1.ListIssueCommentVersionsRequest]) (*connect.Response[v1.ListIssueCommentVersionsResponse], error) {
+	return c.listIssueCommentVersions.CallUnary(ctx, req)
+}
+
+// RestoreIssueCommentVersion calls issues.v1.IssueSyncAdminService.RestoreIssueCommentVersion.
+func (c *issueSyncAdminServiceClient) RestoreIssueCommentVersion(ctx context.Context, req *connect.Request[v1.RestoreIssueCommentVersionRequest]) (*connect.Response[v1.RestoreIssueCommentVersionResponse], error) {
+	return c.restoreIssueCommentVersion.CallUnary(ctx, req)
+}
+
 // IssueSyncAdminServiceHandler is an implementation of the issues.v1.IssueSyncAdminService service.
 type IssueSyncAdminServiceHandler interface {
 	SyncIssues(context.Context, *connect.Request[v1.SyncIssuesRequest]) (*connect.Response[v1.SyncIssuesResponse], error)
+	WatchSyncJob(context.Context, *connect.Request[v1.WatchSyncJobRequest], *connect.ServerStream[v1.SyncJobEvent]) error
 	GetSyncConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncConfigResponse], error)
 	UpdateSyncConfig(context.Context, *connect.Request[v1.UpdateSyncConfigRequest]) (*connect.Response[v1.GetSyncConfigResponse], error)
+	GetIssueSummaryConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error)
+	UpdateIssueSummaryConfig(context.Context, *connect.Request[v1.UpdateIssueSummaryConfigRequest]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error)
+	GetPRReviewConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPRReviewConfigResponse], error)
+	UpdatePRReviewConfig(context.Context, *connect.Request[v1.UpdatePRReviewConfigRequest]) (*connect.Response[v1.GetPRReviewConfigResponse], error)
+	ListSettingsChanges(context.Context, *connect.Request[v1.ListSettingsChangesRequest]) (*connect.Response[v1.ListSettingsChangesResponse], error)
 	ListManagedSyncRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListManagedSyncReposResponse], error)
 	ReplaceManagedSyncRepos(context.Context, *connect.Request[v1.ReplaceManagedSyncReposRequest]) (*connect.Response[v1.ListManagedSyncReposResponse], error)
 	GetSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncStatusResponse], error)
 	UpdateIssueAISummary(context.Context, *connect.Request[v1.UpdateIssueAISummaryRequest]) (*connect.Response[v1.GetIssueResponse], error)
 	ClearIssueAISummaries(context.Context, *connect.Request[v1.ClearIssueAISummariesRequest]) (*connect.Response[v1.ClearIssueAISummariesResponse], error)
+	ReconcileIssues(context.Context, *connect.Request[v1.ReconcileIssuesRequest]) (*connect.Response[v1.ReconcileIssuesResponse], error)
+	GetManagedSyncRepo(context.Context, *connect.Request[v1.GetManagedSyncRepoRequest]) (*connect.Response[v1.ManagedSyncRepo], error)
+	UpdateRepoSyncPolicy(context.Context, *connect.Request[v1.UpdateRepoSyncPolicyRequest]) (*connect.Response[v1.ManagedSyncRepo], error)
+	DiscoverManagedSyncRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.DiscoverManagedSyncReposResponse], error)
+	ResyncRepo(context.Context, *connect.Request[v1.ResyncRepoRequest]) (*connect.Response[v1.ResyncJob], error)
+	GetResyncJob(context.Context, *connect.Request[v1.GetResyncJobRequest]) (*connect.Response[v1.ResyncJob], error)
+	ListResyncJobs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListResyncJobsResponse], error)
+	ListSyncRuns(context.Context, *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error)
+	GetSyncRun(context.Context, *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.SyncRun], error)
+	MigrateIssueComments(context.Context, *connect.Request[v1.MigrateIssueCommentsRequest]) (*connect.Response[v1.MigrateIssueCommentsResponse], error)
+	ListIssueCommentVersions(context.Context, *connect.Request[v1.ListIssueCommentVersionsRequest]) (*connect.Response[v1.ListIssueCommentVersionsResponse], error)
+	RestoreIssueCommentVersion(context.Context, *connect.Request[v1.RestoreIssueCommentVersionRequest]) (*connect.Response[v1.RestoreIssueCommentVersionResponse], error)
 }
 
 // NewIssueSyncAdminServiceHandler builds an HTTP handler from the service implementation. It
@@ -238,6 +573,12 @@ func NewIssueSyncAdminServiceHandler(svc IssueSyncAdminServiceHandler, opts ...c
 		connect.WithSchema(issueSyncAdminServiceMethods.ByName("SyncIssues")),
 		connect.WithHandlerOptions(opts...),
 	)
+	issueSyncAdminServiceWatchSyncJobHandler := connect.NewServerStreamHandler(
+		IssueSyncAdminServiceWatchSyncJobProcedure,
+		svc.WatchSyncJob,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("WatchSyncJob")),
+		connect.WithHandlerOptions(opts...),
+	)
 	issueSyncAdminServiceGetSyncConfigHandler := connect.NewUnaryHandler(
 		IssueSyncAdminServiceGetSyncConfigProcedure,
 		svc.GetSyncConfig,
@@ -250,6 +591,36 @@ func NewIssueSyncAdminServiceHandler(svc IssueSyncAdminServiceHandler, opts ...c
 		connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdateSyncConfig")),
 		connect.WithHandlerOptions(opts...),
 	)
+	issueSyncAdminServiceGetIssueSummaryConfigHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceGetIssueSummaryConfigProcedure,
+		svc.GetIssueSummaryConfig,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetIssueSummaryConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceUpdateIssueSummaryConfigHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceUpdateIssueSummaryConfigProcedure,
+		svc.UpdateIssueSummaryConfig,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdateIssueSummaryConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceGetPRReviewConfigHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceGetPRReviewConfigProcedure,
+		svc.GetPRReviewConfig,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetPRReviewConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceUpdatePRReviewConfigHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceUpdatePRReviewConfigProcedure,
+		svc.UpdatePRReviewConfig,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdatePRReviewConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceListSettingsChangesHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceListSettingsChangesProcedure,
+		svc.ListSettingsChanges,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListSettingsChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
 	issueSyncAdminServiceListManagedSyncReposHandler := connect.NewUnaryHandler(
 		IssueSyncAdminServiceListManagedSyncReposProcedure,
 		svc.ListManagedSyncRepos,
@@ -280,14 +651,98 @@ func NewIssueSyncAdminServiceHandler(svc IssueSyncAdminServiceHandler, opts ...c
 		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ClearIssueAISummaries")),
 		connect.WithHandlerOptions(opts...),
 	)
+	issueSyncAdminServiceReconcileIssuesHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceReconcileIssuesProcedure,
+		svc.ReconcileIssues,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ReconcileIssues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceGetManagedSyncRepoHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceGetManagedSyncRepoProcedure,
+		svc.GetManagedSyncRepo,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetManagedSyncRepo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceUpdateRepoSyncPolicyHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceUpdateRepoSyncPolicyProcedure,
+		svc.UpdateRepoSyncPolicy,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("UpdateRepoSyncPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceDiscoverManagedSyncReposHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceDiscoverManagedSyncReposProcedure,
+		svc.DiscoverManagedSyncRepos,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("DiscoverManagedSyncRepos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceResyncRepoHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceResyncRepoProcedure,
+		svc.ResyncRepo,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ResyncRepo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceGetResyncJobHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceGetResyncJobProcedure,
+		svc.GetResyncJob,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetResyncJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceListResyncJobsHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceListResyncJobsProcedure,
+		svc.ListResyncJobs,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListResyncJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceListSyncRunsHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceListSyncRunsProcedure,
+		svc.ListSyncRuns,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListSyncRuns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceGetSyncRunHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceGetSyncRunProcedure,
+		svc.GetSyncRun,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("GetSyncRun")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceMigrateIssueCommentsHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceMigrateIssueCommentsProcedure,
+		svc.MigrateIssueComments,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("MigrateIssueComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceListIssueCommentVersionsHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceListIssueCommentVersionsProcedure,
+		svc.ListIssueCommentVersions,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("ListIssueCommentVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueSyncAdminServiceRestoreIssueCommentVersionHandler := connect.NewUnaryHandler(
+		IssueSyncAdminServiceRestoreIssueCommentVersionProcedure,
+		svc.RestoreIssueCommentVersion,
+		connect.WithSchema(issueSyncAdminServiceMethods.ByName("RestoreIssueCommentVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/issues.v1.IssueSyncAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IssueSyncAdminServiceSyncIssuesProcedure:
 			issueSyncAdminServiceSyncIssuesHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceWatchSyncJobProcedure:
+			issueSyncAdminServiceWatchSyncJobHandler.ServeHTTP(w, r)
 		case IssueSyncAdminServiceGetSyncConfigProcedure:
 			issueSyncAdminServiceGetSyncConfigHandler.ServeHTTP(w, r)
 		case IssueSyncAdminServiceUpdateSyncConfigProcedure:
 			issueSyncAdminServiceUpdateSyncConfigHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceGetIssueSummaryConfigProcedure:
+			issueSyncAdminServiceGetIssueSummaryConfigHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceUpdateIssueSummaryConfigProcedure:
+			issueSyncAdminServiceUpdateIssueSummaryConfigHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceGetPRReviewConfigProcedure:
+			issueSyncAdminServiceGetPRReviewConfigHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceUpdatePRReviewConfigProcedure:
+			issueSyncAdminServiceUpdatePRReviewConfigHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceListSettingsChangesProcedure:
+			issueSyncAdminServiceListSettingsChangesHandler.ServeHTTP(w, r)
 		case IssueSyncAdminServiceListManagedSyncReposProcedure:
 			issueSyncAdminServiceListManagedSyncReposHandler.ServeHTTP(w, r)
 		case IssueSyncAdminServiceReplaceManagedSyncReposProcedure:
@@ -298,6 +753,30 @@ func NewIssueSyncAdminServiceHandler(svc IssueSyncAdminServiceHandler, opts ...c
 			issueSyncAdminServiceUpdateIssueAISummaryHandler.ServeHTTP(w, r)
 		case IssueSyncAdminServiceClearIssueAISummariesProcedure:
 			issueSyncAdminServiceClearIssueAISummariesHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceReconcileIssuesProcedure:
+			issueSyncAdminServiceReconcileIssuesHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceGetManagedSyncRepoProcedure:
+			issueSyncAdminServiceGetManagedSyncRepoHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceUpdateRepoSyncPolicyProcedure:
+			issueSyncAdminServiceUpdateRepoSyncPolicyHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceDiscoverManagedSyncReposProcedure:
+			issueSyncAdminServiceDiscoverManagedSyncReposHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceResyncRepoProcedure:
+			issueSyncAdminServiceResyncRepoHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceGetResyncJobProcedure:
+			issueSyncAdminServiceGetResyncJobHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceListResyncJobsProcedure:
+			issueSyncAdminServiceListResyncJobsHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceListSyncRunsProcedure:
+			issueSyncAdminServiceListSyncRunsHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceGetSyncRunProcedure:
+			issueSyncAdminServiceGetSyncRunHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceMigrateIssueCommentsProcedure:
+			issueSyncAdminServiceMigrateIssueCommentsHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceListIssueCommentVersionsProcedure:
+			issueSyncAdminServiceListIssueCommentVersionsHandler.ServeHTTP(w, r)
+		case IssueSyncAdminServiceRestoreIssueCommentVersionProcedure:
+			issueSyncAdminServiceRestoreIssueCommentVersionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -311,6 +790,10 @@ func (UnimplementedIssueSyncAdminServiceHandler) SyncIssues(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.SyncIssues is not implemented"))
 }
 
+func (UnimplementedIssueSyncAdminServiceHandler) WatchSyncJob(context.Context, *connect.Request[v1.WatchSyncJobRequest], *connect.ServerStream[v1.SyncJobEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.WatchSyncJob is not implemented"))
+}
+
 func (UnimplementedIssueSyncAdminServiceHandler) GetSyncConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSyncConfigResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.GetSyncConfig is not implemented"))
 }
@@ -319,6 +802,26 @@ func (UnimplementedIssueSyncAdminServiceHandler) UpdateSyncConfig(context.Contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.UpdateSyncConfig is not implemented"))
 }
 
+func (UnimplementedIssueSyncAdminServiceHandler) GetIssueSummaryConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.GetIssueSummaryConfig is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) UpdateIssueSummaryConfig(context.Context, *connect.Request[v1.UpdateIssueSummaryConfigRequest]) (*connect.Response[v1.GetIssueSummaryConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.UpdateIssueSummaryConfig is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) GetPRReviewConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPRReviewConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.GetPRReviewConfig is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) UpdatePRReviewConfig(context.Context, *connect.Request[v1.UpdatePRReviewConfigRequest]) (*connect.Response[v1.GetPRReviewConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.UpdatePRReviewConfig is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) ListSettingsChanges(context.Context, *connect.Request[v1.ListSettingsChangesRequest]) (*connect.Response[v1.ListSettingsChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ListSettingsChanges is not implemented"))
+}
+
 func (UnimplementedIssueSyncAdminServiceHandler) ListManagedSyncRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListManagedSyncReposResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ListManagedSyncRepos is not implemented"))
 }
@@ -339,10 +842,63 @@ func (UnimplementedIssueSyncAdminServiceHandler) ClearIssueAISummaries(context.C
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ClearIssueAISummaries is not implemented"))
 }
 
+func (UnimplementedIssueSyncAdminServiceHandler) ReconcileIssues(context.Context, *connect.Request[v1.ReconcileIssuesRequest]) (*connect.Response[v1.ReconcileIssuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ReconcileIssues is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) GetManagedSyncRepo(context.Context, *connect.Request[v1.GetManagedSyncRepoRequest]) (*connect.Response[v1.ManagedSyncRepo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.GetManagedSyncRepo is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) UpdateRepoSyncPolicy(context.Context, *connect.Request[v1.UpdateRepoSyncPolicyRequest]) (*connect.Response[v1.ManagedSyncRepo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.UpdateRepoSyncPolicy is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) DiscoverManagedSyncRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.DiscoverManagedSyncReposResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.DiscoverManagedSyncRepos is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) ResyncRepo(context.Context, *connect.Request[v1.ResyncRepoRequest]) (*connect.Response[v1.ResyncJob], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ResyncRepo is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) GetResyncJob(context.Context, *connect.Request[v1.GetResyncJobRequest]) (*connect.Response[v1.ResyncJob], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.GetResyncJob is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) ListResyncJobs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListResyncJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ListResyncJobs is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) ListSyncRuns(context.Context, *connect.Request[v1.ListSyncRunsRequest]) (*connect.Response[v1.ListSyncRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ListSyncRuns is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) GetSyncRun(context.Context, *connect.Request[v1.GetSyncRunRequest]) (*connect.Response[v1.SyncRun], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.GetSyncRun is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) MigrateIssueComments(context.Context, *connect.Request[v1.MigrateIssueCommentsRequest]) (*connect.Response[v1.MigrateIssueCommentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.MigrateIssueComments is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) ListIssueCommentVersions(context.Context, *connect.Request[v1.ListIssueCommentVersionsRequest]) (*connect.Response[v1.ListIssueCommentVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.ListIssueCommentVersions is not implemented"))
+}
+
+func (UnimplementedIssueSyncAdminServiceHandler) RestoreIssueCommentVersion(context.Context, *connect.Request[v1.RestoreIssueCommentVersionRequest]) (*connect.Response[v1.RestoreIssueCommentVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueSyncAdminService.RestoreIssueCommentVersion is not implemented"))
+}
+
 // IssueQueryServiceClient is a client for the issues.v1.IssueQueryService service.
 type IssueQueryServiceClient interface {
 	ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.ListIssuesResponse], error)
 	GetIssue(context.Context, *connect.Request[v1.GetIssueRequest]) (*connect.Response[v1.GetIssueResponse], error)
+	SearchIssues(context.Context, *connect.Request[v1.SearchIssuesRequest]) (*connect.Response[v1.SearchIssuesResponse], error)
+	ListIssueEvents(context.Context, *connect.Request[v1.ListIssueEventsRequest]) (*connect.Response[v1.ListIssueEventsResponse], error)
+	ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error)
+	ListMilestones(context.Context, *connect.Request[v1.ListMilestonesRequest]) (*connect.Response[v1.ListMilestonesResponse], error)
+	GetIssueResponsiveness(context.Context, *connect.Request[v1.GetIssueResponsivenessRequest]) (*connect.Response[v1.GetIssueResponsivenessResponse], error)
 }
 
 // NewIssueQueryServiceClient constructs a client for the issues.v1.IssueQueryService service. By
@@ -368,13 +924,48 @@ func NewIssueQueryServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(issueQueryServiceMethods.ByName("GetIssue")),
 			connect.WithClientOptions(opts...),
 		),
+		searchIssues: connect.NewClient[v1.SearchIssuesRequest, v1.SearchIssuesResponse](
+			httpClient,
+			baseURL+IssueQueryServiceSearchIssuesProcedure,
+			connect.WithSchema(issueQueryServiceMethods.ByName("SearchIssues")),
+			connect.WithClientOptions(opts...),
+		),
+		listIssueEvents: connect.NewClient[v1.ListIssueEventsRequest, v1.ListIssueEventsResponse](
+			httpClient,
+			baseURL+IssueQueryServiceListIssueEventsProcedure,
+			connect.WithSchema(issueQueryServiceMethods.ByName("ListIssueEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		listLabels: connect.NewClient[v1.ListLabelsRequest, v1.ListLabelsResponse](
+			httpClient,
+			baseURL+IssueQueryServiceListLabelsProcedure,
+			connect.WithSchema(issueQueryServiceMethods.ByName("ListLabels")),
+			connect.WithClientOptions(opts...),
+		),
+		listMilestones: connect.NewClient[v1.ListMilestonesRequest, v1.ListMilestonesResponse](
+			httpClient,
+			baseURL+IssueQueryServiceListMilestonesProcedure,
+			connect.WithSchema(issueQueryServiceMethods.ByName("ListMilestones")),
+			connect.WithClientOptions(opts...),
+		),
+		getIssueResponsiveness: connect.NewClient[v1.GetIssueResponsivenessRequest, v1.GetIssueResponsivenessResponse](
+			httpClient,
+			baseURL+IssueQueryServiceGetIssueResponsivenessProcedure,
+			connect.WithSchema(issueQueryServiceMethods.ByName("GetIssueResponsiveness")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // issueQueryServiceClient implements IssueQueryServiceClient.
 type issueQueryServiceClient struct {
-	listIssues *connect.Client[v1.ListIssuesRequest, v1.ListIssuesResponse]
-	getIssue   *connect.Client[v1.GetIssueRequest, v1.GetIssueResponse]
+	listIssues             *connect.Client[v1.ListIssuesRequest, v1.ListIssuesResponse]
+	getIssue               *connect.Client[v1.GetIssueRequest, v1.GetIssueResponse]
+	searchIssues           *connect.Client[v1.SearchIssuesRequest, v1.SearchIssuesResponse]
+	listIssueEvents        *connect.Client[v1.ListIssueEventsRequest, v1.ListIssueEventsResponse]
+	listLabels             *connect.Client[v1.ListLabelsRequest, v1.ListLabelsResponse]
+	listMilestones         *connect.Client[v1.ListMilestonesRequest, v1.ListMilestonesResponse]
+	getIssueResponsiveness *connect.Client[v1.GetIssueResponsivenessRequest, v1.GetIssueResponsivenessResponse]
 }
 
 // ListIssues calls issues.v1.IssueQueryService.ListIssues.
@@ -387,10 +978,40 @@ func (c *issueQueryServiceClient) GetIssue(ctx context.Context, req *connect.Req
 	return c.getIssue.CallUnary(ctx, req)
 }
 
+// SearchIssues calls issues.v1.IssueQueryService.SearchIssues.
+func (c *issueQueryServiceClient) SearchIssues(ctx context.Context, req *connect.Request[v1.SearchIssuesRequest]) (*connect.Response[v1.SearchIssuesResponse], error) {
+	return c.searchIssues.CallUnary(ctx, req)
+}
+
+// ListIssueEvents calls issues.v1.IssueQueryService.ListIssueEvents.
+func (c *issueQueryServiceClient) ListIssueEvents(ctx context.Context, req *connect.Request[v1.ListIssueEventsRequest]) (*connect.Response[v1.ListIssueEventsResponse], error) {
+	return c.listIssueEvents.CallUnary(ctx, req)
+}
+
+// ListLabels calls issues.v1.IssueQueryService.ListLabels.
+func (c *issueQueryServiceClient) ListLabels(ctx context.Context, req *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error) {
+	return c.listLabels.CallUnary(ctx, req)
+}
+
+// ListMilestones calls issues.v1.IssueQueryService.ListMilestones.
+func (c *issueQueryServiceClient) ListMilestones(ctx context.Context, req *connect.Request[v1.ListMilestonesRequest]) (*connect.Response[v1.ListMilestonesResponse], error) {
+	return c.listMilestones.CallUnary(ctx, req)
+}
+
+// GetIssueResponsiveness calls issues.v1.IssueQueryService.GetIssueResponsiveness.
+func (c *issueQueryServiceClient) GetIssueResponsiveness(ctx context.Context, req *connect.Request[v1.GetIssueResponsivenessRequest]) (*connect.Response[v1.GetIssueResponsivenessResponse], error) {
+	return c.getIssueResponsiveness.CallUnary(ctx, req)
+}
+
 // IssueQueryServiceHandler is an implementation of the issues.v1.IssueQueryService service.
 type IssueQueryServiceHandler interface {
 	ListIssues(context.Context, *connect.Request[v1.ListIssuesRequest]) (*connect.Response[v1.ListIssuesResponse], error)
 	GetIssue(context.Context, *connect.Request[v1.GetIssueRequest]) (*connect.Response[v1.GetIssueResponse], error)
+	SearchIssues(context.Context, *connect.Request[v1.SearchIssuesRequest]) (*connect.Response[v1.SearchIssuesResponse], error)
+	ListIssueEvents(context.Context, *connect.Request[v1.ListIssueEventsRequest]) (*connect.Response[v1.ListIssueEventsResponse], error)
+	ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error)
+	ListMilestones(context.Context, *connect.Request[v1.ListMilestonesRequest]) (*connect.Response[v1.ListMilestonesResponse], error)
+	GetIssueResponsiveness(context.Context, *connect.Request[v1.GetIssueResponsivenessRequest]) (*connect.Response[v1.GetIssueResponsivenessResponse], error)
 }
 
 // NewIssueQueryServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -412,12 +1033,52 @@ func NewIssueQueryServiceHandler(svc IssueQueryServiceHandler, opts ...connect.H
 		connect.WithSchema(issueQueryServiceMethods.ByName("GetIssue")),
 		connect.WithHandlerOptions(opts...),
 	)
+	issueQueryServiceSearchIssuesHandler := connect.NewUnaryHandler(
+		IssueQueryServiceSearchIssuesProcedure,
+		svc.SearchIssues,
+		connect.WithSchema(issueQueryServiceMethods.ByName("SearchIssues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueQueryServiceListIssueEventsHandler := connect.NewUnaryHandler(
+		IssueQueryServiceListIssueEventsProcedure,
+		svc.ListIssueEvents,
+		connect.WithSchema(issueQueryServiceMethods.ByName("ListIssueEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueQueryServiceListLabelsHandler := connect.NewUnaryHandler(
+		IssueQueryServiceListLabelsProcedure,
+		svc.ListLabels,
+		connect.WithSchema(issueQueryServiceMethods.ByName("ListLabels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueQueryServiceListMilestonesHandler := connect.NewUnaryHandler(
+		IssueQueryServiceListMilestonesProcedure,
+		svc.ListMilestones,
+		connect.WithSchema(issueQueryServiceMethods.ByName("ListMilestones")),
+		connect.WithHandlerOptions(opts...),
+	)
+	issueQueryServiceGetIssueResponsivenessHandler := connect.NewUnaryHandler(
+		IssueQueryServiceGetIssueResponsivenessProcedure,
+		svc.GetIssueResponsiveness,
+		connect.WithSchema(issueQueryServiceMethods.ByName("GetIssueResponsiveness")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/issues.v1.IssueQueryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IssueQueryServiceListIssuesProcedure:
 			issueQueryServiceListIssuesHandler.ServeHTTP(w, r)
 		case IssueQueryServiceGetIssueProcedure:
 			issueQueryServiceGetIssueHandler.ServeHTTP(w, r)
+		case IssueQueryServiceSearchIssuesProcedure:
+			issueQueryServiceSearchIssuesHandler.ServeHTTP(w, r)
+		case IssueQueryServiceListIssueEventsProcedure:
+			issueQueryServiceListIssueEventsHandler.ServeHTTP(w, r)
+		case IssueQueryServiceListLabelsProcedure:
+			issueQueryServiceListLabelsHandler.ServeHTTP(w, r)
+		case IssueQueryServiceListMilestonesProcedure:
+			issueQueryServiceListMilestonesHandler.ServeHTTP(w, r)
+		case IssueQueryServiceGetIssueResponsivenessProcedure:
+			issueQueryServiceGetIssueResponsivenessHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -435,6 +1096,26 @@ func (UnimplementedIssueQueryServiceHandler) GetIssue(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueQueryService.GetIssue is not implemented"))
 }
 
+func (UnimplementedIssueQueryServiceHandler) SearchIssues(context.Context, *connect.Request[v1.SearchIssuesRequest]) (*connect.Response[v1.SearchIssuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueQueryService.SearchIssues is not implemented"))
+}
+
+func (UnimplementedIssueQueryServiceHandler) ListIssueEvents(context.Context, *connect.Request[v1.ListIssueEventsRequest]) (*connect.Response[v1.ListIssueEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueQueryService.ListIssueEvents is not implemented"))
+}
+
+func (UnimplementedIssueQueryServiceHandler) ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueQueryService.ListLabels is not implemented"))
+}
+
+func (UnimplementedIssueQueryServiceHandler) ListMilestones(context.Context, *connect.Request[v1.ListMilestonesRequest]) (*connect.Response[v1.ListMilestonesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueQueryService.ListMilestones is not implemented"))
+}
+
+func (UnimplementedIssueQueryServiceHandler) GetIssueResponsiveness(context.Context, *connect.Request[v1.GetIssueResponsivenessRequest]) (*connect.Response[v1.GetIssueResponsivenessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.IssueQueryService.GetIssueResponsiveness is not implemented"))
+}
+
 // PRReviewQueryServiceClient is a client for the issues.v1.PRReviewQueryService service.
 type PRReviewQueryServiceClient interface {
 	ListPRReviews(context.Context, *connect.Request[v1.ListPRReviewsRequest]) (*connect.Response[v1.ListPRReviewsResponse], error)
@@ -652,3 +1333,153 @@ func (UnimplementedAdminAuthServiceHandler) AdminLogout(context.Context, *connec
 func (UnimplementedAdminAuthServiceHandler) AdminWhoAmI(context.Context, *connect.Request[v1.AdminWhoAmIRequest]) (*connect.Response[v1.AdminWhoAmIResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.AdminAuthService.AdminWhoAmI is not implemented"))
 }
+
+// JobAdminServiceClient is a client for the issues.v1.JobAdminService service.
+type JobAdminServiceClient interface {
+	ListJobs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListJobsResponse], error)
+	PauseJob(context.Context, *connect.Request[v1.PauseJobRequest]) (*connect.Response[v1.Job], error)
+	ResumeJob(context.Context, *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.Job], error)
+	// TriggerJob starts a run now, on whichever replica receives the request.
+	TriggerJob(context.Context, *connect.Request[v1.TriggerJobRequest]) (*connect.Response[v1.Job], error)
+}
+
+// NewJobAdminServiceClient constructs a client for the issues.v1.JobAdminService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJobAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JobAdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	jobAdminServiceMethods := v1.File_issues_v1_issue_proto.Services().ByName("JobAdminService").Methods()
+	return &jobAdminServiceClient{
+		listJobs: connect.NewClient[emptypb.Empty, v1.ListJobsResponse](
+			httpClient,
+			baseURL+JobAdminServiceListJobsProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ListJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseJob: connect.NewClient[v1.PauseJobRequest, v1.Job](
+			httpClient,
+			baseURL+JobAdminServicePauseJobProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("PauseJob")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeJob: connect.NewClient[v1.ResumeJobRequest, v1.Job](
+			httpClient,
+			baseURL+JobAdminServiceResumeJobProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ResumeJob")),
+			connect.WithClientOptions(opts...),
+		),
+		triggerJob: connect.NewClient[v1.TriggerJobRequest, v1.Job](
+			httpClient,
+			baseURL+JobAdminServiceTriggerJobProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("TriggerJob")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// jobAdminServiceClient implements JobAdminServiceClient.
+type jobAdminServiceClient struct {
+	listJobs   *connect.Client[emptypb.Empty, v1.ListJobsResponse]
+	pauseJob   *connect.Client[v1.PauseJobRequest, v1.Job]
+	resumeJob  *connect.Client[v1.ResumeJobRequest, v1.Job]
+	triggerJob *connect.Client[v1.TriggerJobRequest, v1.Job]
+}
+
+// ListJobs calls issues.v1.JobAdminService.ListJobs.
+func (c *jobAdminServiceClient) ListJobs(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListJobsResponse], error) {
+	return c.listJobs.CallUnary(ctx, req)
+}
+
+// PauseJob calls issues.v1.JobAdminService.PauseJob.
+func (c *jobAdminServiceClient) PauseJob(ctx context.Context, req *connect.Request[v1.PauseJobRequest]) (*connect.Response[v1.Job], error) {
+	return c.pauseJob.CallUnary(ctx, req)
+}
+
+// ResumeJob calls issues.v1.JobAdminService.ResumeJob.
+func (c *jobAdminServiceClient) ResumeJob(ctx context.Context, req *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.Job], error) {
+	return c.resumeJob.CallUnary(ctx, req)
+}
+
+// TriggerJob calls issues.v1.JobAdminService.TriggerJob.
+func (c *jobAdminServiceClient) TriggerJob(ctx context.Context, req *connect.Request[v1.TriggerJobRequest]) (*connect.Response[v1.Job], error) {
+	return c.triggerJob.CallUnary(ctx, req)
+}
+
+// JobAdminServiceHandler is an implementation of the issues.v1.JobAdminService service.
+type JobAdminServiceHandler interface {
+	ListJobs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListJobsResponse], error)
+	PauseJob(context.Context, *connect.Request[v1.PauseJobRequest]) (*connect.Response[v1.Job], error)
+	ResumeJob(context.Context, *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.Job], error)
+	// TriggerJob starts a run now, on whichever replica receives the request.
+	TriggerJob(context.Context, *connect.Request[v1.TriggerJobRequest]) (*connect.Response[v1.Job], error)
+}
+
+// NewJobAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJobAdminServiceHandler(svc JobAdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	jobAdminServiceMethods := v1.File_issues_v1_issue_proto.Services().ByName("JobAdminService").Methods()
+	jobAdminServiceListJobsHandler := connect.NewUnaryHandler(
+		JobAdminServiceListJobsProcedure,
+		svc.ListJobs,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ListJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServicePauseJobHandler := connect.NewUnaryHandler(
+		JobAdminServicePauseJobProcedure,
+		svc.PauseJob,
+		connect.WithSchema(jobAdminServiceMethods.ByName("PauseJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceResumeJobHandler := connect.NewUnaryHandler(
+		JobAdminServiceResumeJobProcedure,
+		svc.ResumeJob,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ResumeJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceTriggerJobHandler := connect.NewUnaryHandler(
+		JobAdminServiceTriggerJobProcedure,
+		svc.TriggerJob,
+		connect.WithSchema(jobAdminServiceMethods.ByName("TriggerJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/issues.v1.JobAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JobAdminServiceListJobsProcedure:
+			jobAdminServiceListJobsHandler.ServeHTTP(w, r)
+		case JobAdminServicePauseJobProcedure:
+			jobAdminServicePauseJobHandler.ServeHTTP(w, r)
+		case JobAdminServiceResumeJobProcedure:
+			jobAdminServiceResumeJobHandler.ServeHTTP(w, r)
+		case JobAdminServiceTriggerJobProcedure:
+			jobAdminServiceTriggerJobHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJobAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJobAdminServiceHandler struct{}
+
+func (UnimplementedJobAdminServiceHandler) ListJobs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.JobAdminService.ListJobs is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) PauseJob(context.Context, *connect.Request[v1.PauseJobRequest]) (*connect.Response[v1.Job], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.JobAdminService.PauseJob is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) ResumeJob(context.Context, *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.Job], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.JobAdminService.ResumeJob is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) TriggerJob(context.Context, *connect.Request[v1.TriggerJobRequest]) (*connect.Response[v1.Job], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("issues.v1.JobAdminService.TriggerJob is not implemented"))
+}
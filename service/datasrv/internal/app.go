@@ -23,31 +23,28 @@ import (
 )
 
 var (
-	appLogger             = slog.Default().With("component", "datasrv.app")
-	syncStore             dao.SyncStore
-	feedStore             dao.FeedStore
-	blogStore             dao.BlogStore
-	commentStore          service.IssueCommentStore
-	syncService           *service.IssueSyncService
-	feedSyncService       *service.FeedSyncService
-	issueSummarySvc       *service.IssueSummaryService
-	adminGRPC             *service.IssueSyncAdminGRPCServer
-	adminAuthGRPC         *service.AdminAuthGRPCServer
-	queryGRPC             *service.IssueQueryGRPCServer
-	feedAdminGRPC         *service.FeedSyncAdminGRPCServer
-	feedQueryGRPC         *service.FeedQueryGRPCServer
-	blogAdminGRPC         *service.BlogAdminGRPCServer
-	blogQueryGRPC         *service.BlogQueryGRPCServer
-	prReviewQueryGRPC     *service.PRReviewQueryGRPCServer
-	schedulerStopC        chan struct{}
-	schedulerStop         context.CancelFunc
-	feedSchedulerStopC    chan struct{}
-	feedSchedulerStop     context.CancelFunc
-	summarySchedulerStopC  chan struct{}
-	summarySchedulerStop   context.CancelFunc
-	prReviewSvc            *service.PRReviewService
-	prReviewSchedulerStopC chan struct{}
-	prReviewSchedulerStop  context.CancelFunc
+	appLogger         = slog.Default().With("component", "datasrv.app")
+	syncStore         dao.SyncStore
+	feedStore         dao.FeedStore
+	blogStore         dao.BlogStore
+	commentStore      service.IssueCommentStore
+	syncService       *service.IssueSyncService
+	feedSyncService   *service.FeedSyncService
+	issueSummarySvc   *service.IssueSummaryService
+	responsivenessSvc *service.IssueResponsivenessService
+	adminGRPC         *service.IssueSyncAdminGRPCServer
+	adminAuthGRPC     *service.AdminAuthGRPCServer
+	queryGRPC         *service.IssueQueryGRPCServer
+	feedAdminGRPC     *service.FeedSyncAdminGRPCServer
+	feedQueryGRPC     *service.FeedQueryGRPCServer
+	blogAdminGRPC     *service.BlogAdminGRPCServer
+	blogQueryGRPC     *service.BlogQueryGRPCServer
+	prReviewQueryGRPC *service.PRReviewQueryGRPCServer
+	jobAdminGRPC      *service.JobAdminGRPCServer
+	prReviewSvc       *service.PRReviewService
+	leaderElector     *service.LeaderElector
+	jobScheduler      *service.JobScheduler
+	settingsService   *service.SettingsService
 )
 
 func NewApp() *app.App {
@@ -95,6 +92,9 @@ func registerGRPC(server *grpc.Server) {
 	if prReviewQueryGRPC != nil {
 		issuesv1.RegisterPRReviewQueryServiceServer(server, prReviewQueryGRPC)
 	}
+	if jobAdminGRPC != nil {
+		issuesv1.RegisterJobAdminServiceServer(server, jobAdminGRPC)
+	}
 }
 
 func initSyncComponents() error {
@@ -109,10 +109,6 @@ func initSyncComponents() error {
 
 	var err error
 	var combined interface{}
-	commentStore, err = service.NewIssueCommentStore(conf.Conf.IssueCommentStorage)
-	if err != nil {
-		return fmt.Errorf("init issue comment store: %w", err)
-	}
 	switch driver {
 	case "mongo", "mongodb":
 		uri := storage.MongoURI
@@ -141,9 +137,34 @@ func initSyncComponents() error {
 	if !ok {
 		return fmt.Errorf("store %T does not implement feed store", combined)
 	}
+	commentStore, err = service.NewIssueCommentStore(conf.Conf.IssueCommentStorage, syncStore)
+	if err != nil {
+		return fmt.Errorf("init issue comment store: %w", err)
+	}
+
+	leases, err := service.NewLeaseStore(conf.Conf.Leadership, syncStore)
+	if err != nil {
+		return fmt.Errorf("init leader election: %w", err)
+	}
+	if leases != nil {
+		leaderElector = service.NewLeaderElector(leases, conf.Conf.Leadership)
+	}
+
+	// Settings saved through the admin API override the config file, so they are layered on before
+	// any service reads its config.
+	adminTokenValidator = service.NewRedisAdminTokenStore(conf.Conf)
+	settingsService = service.NewSettingsService(syncStore, adminTokenValidator)
+	if err := settingsService.LayerConfig(context.Background(), conf.Conf); err != nil {
+		return fmt.Errorf("load saved settings: %w", err)
+	}
 
 	conf.Conf.Storage.Driver = driver
+	if err := service.ValidateGitHubConfig(conf.Conf.GitHub); err != nil {
+		return fmt.Errorf("init github client: %w", err)
+	}
 	syncService = service.NewIssueSyncService(syncStore, conf.Conf.GitHub, conf.Conf.GitHubSync, commentStore)
+	syncService.RegisterIssueProvider(service.IssueProviderGitLab, service.NewGitLabIssueProvider(conf.Conf.GitLab))
+	syncService.RegisterIssueProvider(service.IssueProviderGitea, service.NewGiteaIssueProvider(conf.Conf.Gitea))
 	if err := syncService.SeedManagedRepos(context.Background(), conf.Conf.GitHubSync.Repos); err != nil {
 		return fmt.Errorf("seed managed repos: %w", err)
 	}
@@ -184,11 +205,35 @@ func initSyncComponents() error {
 		}
 		issueSummarySvc = service.NewIssueSummaryService(syncStore, commentStore, summarizer, conf.Conf.IssueSummary)
 	}
+	if conf.Conf.IssueResponsiveness.Enabled {
+		responsivenessSvc, err = service.NewIssueResponsivenessService(syncStore, commentStore, conf.Conf.IssueResponsiveness)
+		if err != nil {
+			return fmt.Errorf("init issue responsiveness: %w", err)
+		}
+	}
+	if conf.Conf.GitHubWebhook.Enabled {
+		deliveryStore, ok := combined.(dao.WebhookDeliveryStore)
+		if !ok {
+			return fmt.Errorf("store %T does not implement webhook delivery store", combined)
+		}
+		githubWebhookSvc = service.NewGitHubWebhookService(syncStore, deliveryStore, commentStore, conf.Conf.GitHubWebhook)
+		if !githubWebhookSvc.Enabled() {
+			appLogger.Warn("github webhook receiver enabled without a secret, deliveries will be rejected")
+		}
+	}
 	adminGRPC = service.NewIssueSyncAdminGRPCServer(syncStore, syncService, conf.Conf)
-	adminTokenValidator = service.NewRedisAdminTokenStore(conf.Conf)
+	adminGRPC.SetLeaderElector(leaderElector)
+	jobScheduler = service.NewJobScheduler(leaderElector, conf.Conf.Jobs)
+	adminGRPC.SetJobScheduler(jobScheduler)
+	adminGRPC.SetSettingsService(settingsService)
+	adminGRPC.SetAIServices(issueSummarySvc, prReviewSvc)
+	jobAdminGRPC = service.NewJobAdminGRPCServer(jobScheduler)
 	adminAuthGRPC = service.NewAdminAuthGRPCServer(conf.Conf, adminTokenValidator)
 	queryGRPC = service.NewIssueQueryGRPCServer(syncStore, commentStore)
 	feedAdminGRPC = service.NewFeedSyncAdminGRPCServer(feedStore, feedSyncService, conf.Conf)
+	feedAdminGRPC.SetLeaderElector(leaderElector)
+	feedAdminGRPC.SetJobScheduler(jobScheduler)
+	feedAdminGRPC.SetSettingsService(settingsService)
 	feedQueryGRPC = service.NewFeedQueryGRPCServer(feedStore)
 	if typedPRReviewStore, ok := combined.(dao.PRReviewStore); ok {
 		prReviewQueryGRPC = service.NewPRReviewQueryGRPCServer(typedPRReviewStore)
@@ -203,14 +248,19 @@ func initSyncComponents() error {
 		"issue_comment_storage_enabled", conf.Conf.IssueCommentStorage.Enabled,
 		"issue_comment_storage_provider", conf.Conf.IssueCommentStorage.Provider,
 		"issue_comment_storage_bucket", conf.Conf.IssueCommentStorage.Bucket,
+		"issue_comment_storage_dir", conf.Conf.IssueCommentStorage.Dir,
 		"issue_comment_storage_endpoint", conf.Conf.IssueCommentStorage.Endpoint,
 		"issue_sync_enabled", conf.Conf.GitHubSync.Enabled,
+		"leadership_backend", conf.Conf.Leadership.Backend,
+		"github_app_id", conf.Conf.GitHub.App.AppID,
+		"github_webhook_enabled", githubWebhookSvc.Enabled(),
 		"managed_repo_count", len(managedRepos),
 		"managed_repos", managedRepoNames(managedRepos),
 		"feed_sync_enabled", conf.Conf.FeedSync.Enabled,
 		"issue_summary_enabled", conf.Conf.IssueSummary.Enabled,
 		"issue_summary_provider", conf.Conf.IssueSummary.Provider,
 		"issue_summary_model", conf.Conf.IssueSummary.Model,
+		"issue_responsiveness_enabled", conf.Conf.IssueResponsiveness.Enabled,
 		"pr_review_enabled", conf.Conf.PRReview.Enabled,
 		"pr_review_provider", conf.Conf.PRReview.Provider,
 		"pr_review_model", conf.Conf.PRReview.Model,
@@ -228,212 +278,251 @@ func startSyncScheduler() error {
 		return nil
 	}
 
-	if schedulerStopC != nil {
-		return nil
-	}
-	schedulerStopC = make(chan struct{})
-	var schedulerCtx context.Context
-	schedulerCtx, schedulerStop = context.WithCancel(context.Background())
-
-	interval := time.Duration(cfg.IntervalSeconds) * time.Second
-	if interval <= 0 {
-		interval = 5 * time.Minute
-	}
 	managedRepos, err := syncService.ListManagedRepos(context.Background())
 	if err != nil {
 		return fmt.Errorf("list managed repos before scheduler start: %w", err)
 	}
+	if err := registerJobs(); err != nil {
+		return err
+	}
+	// Settings saved through another replica are picked up before the runs that read them.
+	jobScheduler.SetBeforeRun(reloadSettings)
+	// Every replica schedules the jobs; only the holder of the scheduler lease runs them.
+	leaderElector.Start(context.Background())
+	jobScheduler.Start()
 	appLogger.Info("issue sync scheduler started",
-		"interval", interval.String(),
+		"interval", (time.Duration(cfg.IntervalSeconds) * time.Second).String(),
 		"managed_repo_count", len(managedRepos),
 		"managed_repos", managedRepoNames(managedRepos),
 	)
+	return nil
+}
 
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-ticker.C:
-				appLogger.Info("issue sync tick triggered")
-				summary, err := syncService.RunSync(schedulerCtx, "")
+// registerJobs declares the background jobs. Their intervals are read from the live configs, so
+// that changes made through the admin API apply to the next run.
+func registerJobs() error {
+	jobs := []service.JobSpec{
+		{
+			Name: service.JobIssueSync,
+			// Runs are at most a minute apart so that per-repo intervals shorter than the global one
+			// apply; each run only syncs the repos that are due.
+			Interval: func() time.Duration {
+				return min(time.Duration(syncService.GetConfig().IntervalSeconds)*time.Second, time.Minute)
+			},
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				if syncService.IsRunning() {
+					return nil
+				}
+				summary, err := syncService.RunScheduledSync(ctx)
 				if err != nil {
-					appLogger.Error("issue sync run failed", "error", err)
-					continue
+					return err
 				}
-				logIssueSyncSummary(summary)
-			case <-schedulerStopC:
-				appLogger.Info("issue sync scheduler stopped")
-				return
-			case <-schedulerCtx.Done():
-				appLogger.Info("issue sync scheduler context done")
-				return
-			}
-		}
-	}()
-
+				if len(summary.Results) > 0 {
+					logIssueSyncSummary(summary)
+				}
+				return nil
+			},
+		},
+		{
+			Name: service.JobIssueReconcile,
+			// Zero keeps the sweep off until an interval is set.
+			Interval: func() time.Duration {
+				return time.Duration(syncService.GetConfig().ReconcileIntervalSeconds) * time.Second
+			},
+			Run: func(ctx context.Context) error {
+				summary, err := syncService.RunReconcile(ctx, "")
+				if err != nil {
+					return err
+				}
+				logIssueReconcileSummary(summary)
+				return nil
+			},
+		},
+		{
+			Name: service.JobRepoDiscovery,
+			Interval: func() time.Duration {
+				return time.Duration(syncService.GetConfig().DiscoveryIntervalSeconds) * time.Second
+			},
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				summary, err := syncService.RunRepoDiscovery(ctx)
+				if err != nil {
+					return err
+				}
+				logRepoDiscoverySummary(summary)
+				return nil
+			},
+		},
+		{
+			Name:       service.JobSyncRunPrune,
+			Interval:   func() time.Duration { return time.Hour },
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				removed, err := syncService.PruneSyncRuns(ctx, conf.Conf.SyncRunHistory.RetentionDays)
+				if err != nil {
+					return err
+				}
+				if removed > 0 {
+					appLogger.Info("sync run history pruned", "removed", removed)
+				}
+				return nil
+			},
+		},
+	}
+	// The feed, summary and PR review jobs skip their runs while turned off, as the admin API can
+	// turn them on and off at runtime.
 	if feedSyncService != nil {
-		feedCfg := feedSyncService.GetConfig()
-		if feedCfg.Enabled && feedSchedulerStopC == nil {
-			feedSchedulerStopC = make(chan struct{})
-			var feedSchedulerCtx context.Context
-			feedSchedulerCtx, feedSchedulerStop = context.WithCancel(context.Background())
-
-			interval := time.Duration(feedCfg.IntervalSeconds) * time.Second
-			if interval <= 0 {
-				interval = 5 * time.Minute
-			}
-			appLogger.Info("feed sync scheduler started", "interval", interval.String())
-
-			go func() {
-				ticker := time.NewTicker(interval)
-				defer ticker.Stop()
-				for {
-					select {
-					case <-ticker.C:
-						appLogger.Info("feed sync tick triggered")
-						summary, err := feedSyncService.RunSync(feedSchedulerCtx, "")
-						if err != nil {
-							appLogger.Error("feed sync run failed", "error", err)
-							continue
-						}
-						appLogger.Info("feed sync run finished",
-							"started_at", summary.StartedAt,
-							"finished_at", summary.FinishedAt,
-							"result_count", len(summary.Results),
-						)
-					case <-feedSchedulerStopC:
-						appLogger.Info("feed sync scheduler stopped")
-						return
-					case <-feedSchedulerCtx.Done():
-						appLogger.Info("feed sync scheduler context done")
-						return
-					}
+		jobs = append(jobs, service.JobSpec{
+			Name: service.JobFeedSync,
+			Interval: func() time.Duration {
+				return time.Duration(feedSyncService.GetConfig().IntervalSeconds) * time.Second
+			},
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				if !feedSyncService.GetConfig().Enabled {
+					return nil
 				}
-			}()
-		}
+				summary, err := feedSyncService.RunSync(ctx, "")
+				if err != nil {
+					return err
+				}
+				appLogger.Info("feed sync run finished",
+					"started_at", summary.StartedAt,
+					"finished_at", summary.FinishedAt,
+					"result_count", len(summary.Results),
+				)
+				return nil
+			},
+		})
 	}
-
 	if issueSummarySvc != nil {
-		summaryCfg := issueSummarySvc.GetConfig()
-		if summaryCfg.Enabled && summarySchedulerStopC == nil {
-			summarySchedulerStopC = make(chan struct{})
-			var summarySchedulerCtx context.Context
-			summarySchedulerCtx, summarySchedulerStop = context.WithCancel(context.Background())
-
-			interval := time.Duration(summaryCfg.IntervalSeconds) * time.Second
-			if interval <= 0 {
-				interval = 10 * time.Minute
-			}
-			appLogger.Info("issue summary scheduler started",
-				"interval", interval.String(),
-				"batch_size", summaryCfg.BatchSize,
-				"max_issues_per_run", summaryCfg.MaxIssuesPerRun,
-				"overwrite_existing", summaryCfg.OverwriteExisting,
-				"state", summaryCfg.State,
-			)
-
-			go func() {
-				ticker := time.NewTicker(interval)
-				defer ticker.Stop()
-				for {
-					select {
-					case <-ticker.C:
-						appLogger.Info("issue summary tick triggered")
-						summary, err := issueSummarySvc.Run(summarySchedulerCtx)
-						if err != nil {
-							appLogger.Error("issue summary run failed", "error", err)
-							continue
-						}
-						logIssueSummaryRun(summary)
-					case <-summarySchedulerStopC:
-						appLogger.Info("issue summary scheduler stopped")
-						return
-					case <-summarySchedulerCtx.Done():
-						appLogger.Info("issue summary scheduler context done")
-						return
-					}
+		jobs = append(jobs, service.JobSpec{
+			Name: service.JobIssueSummary,
+			Interval: func() time.Duration {
+				if seconds := issueSummarySvc.GetConfig().IntervalSeconds; seconds > 0 {
+					return time.Duration(seconds) * time.Second
 				}
-			}()
-		}
+				return 10 * time.Minute
+			},
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				if !issueSummarySvc.GetConfig().Enabled {
+					return nil
+				}
+				summary, err := issueSummarySvc.Run(ctx)
+				if err != nil {
+					return err
+				}
+				logIssueSummaryRun(summary)
+				return nil
+			},
+		})
 	}
 	if prReviewSvc != nil {
-		prReviewCfg := prReviewSvc.GetConfig()
-		if prReviewCfg.Enabled && prReviewSchedulerStopC == nil {
-			prReviewSchedulerStopC = make(chan struct{})
-			var prReviewSchedulerCtx context.Context
-			prReviewSchedulerCtx, prReviewSchedulerStop = context.WithCancel(context.Background())
-
-			interval := time.Duration(prReviewCfg.IntervalSeconds) * time.Second
-			if interval <= 0 {
-				interval = 10 * time.Minute
-			}
-			appLogger.Info("pr review scheduler started",
-				"interval", interval.String(),
-				"max_prs_per_run", prReviewCfg.MaxPRsPerRun,
-				"max_diff_size", prReviewCfg.MaxDiffSize,
-			)
-
-			go func() {
-				ticker := time.NewTicker(interval)
-				defer ticker.Stop()
-				for {
-					select {
-					case <-ticker.C:
-						appLogger.Info("pr review tick triggered")
-						summary, err := prReviewSvc.Run(prReviewSchedulerCtx)
-						if err != nil {
-							appLogger.Error("pr review run failed", "error", err)
-							continue
-						}
-						logPRReviewRun(summary)
-					case <-prReviewSchedulerStopC:
-						appLogger.Info("pr review scheduler stopped")
-						return
-					case <-prReviewSchedulerCtx.Done():
-						appLogger.Info("pr review scheduler context done")
-						return
-					}
+		jobs = append(jobs, service.JobSpec{
+			Name: service.JobPRReview,
+			Interval: func() time.Duration {
+				if seconds := prReviewSvc.GetConfig().IntervalSeconds; seconds > 0 {
+					return time.Duration(seconds) * time.Second
+				}
+				return 10 * time.Minute
+			},
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				if !prReviewSvc.GetConfig().Enabled {
+					return nil
+				}
+				summary, err := prReviewSvc.Run(ctx)
+				if err != nil {
+					return err
+				}
+				logPRReviewRun(summary)
+				return nil
+			},
+		})
+	}
+	if responsivenessSvc != nil {
+		jobs = append(jobs, service.JobSpec{
+			Name: service.JobIssueResponsiveness,
+			Interval: func() time.Duration {
+				if seconds := responsivenessSvc.GetConfig().IntervalSeconds; seconds > 0 {
+					return time.Duration(seconds) * time.Second
 				}
-			}()
+				return 10 * time.Minute
+			},
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				summary, err := responsivenessSvc.Run(ctx)
+				if err != nil {
+					return err
+				}
+				logIssueResponsivenessRun(summary)
+				return nil
+			},
+		})
+	}
+	for _, job := range jobs {
+		if err := jobScheduler.Register(job); err != nil {
+			return fmt.Errorf("register job: %w", err)
 		}
 	}
 	return nil
 }
 
-func stopSyncScheduler() error {
-	if schedulerStop != nil {
-		schedulerStop()
-		schedulerStop = nil
-	}
-	if schedulerStopC != nil {
-		close(schedulerStopC)
-		schedulerStopC = nil
+// reloadSettings applies the settings versions saved since this replica last loaded them, and
+// plans the jobs that read them again. Failures keep the settings the replica runs with.
+func reloadSettings(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if cfg, ok := reloadSettingsSection(ctx, service.SettingsGitHubSync, syncService.GetConfig()); ok {
+		conf.Conf.GitHubSync = syncService.UpdateConfig(cfg)
+		rescheduleJobs(service.JobIssueSync, service.JobIssueReconcile, service.JobRepoDiscovery)
 	}
-	if feedSchedulerStop != nil {
-		feedSchedulerStop()
-		feedSchedulerStop = nil
+	if feedSyncService != nil {
+		if cfg, ok := reloadSettingsSection(ctx, service.SettingsFeedSync, feedSyncService.GetConfig()); ok {
+			conf.Conf.FeedSync = feedSyncService.UpdateConfig(cfg)
+			rescheduleJobs(service.JobFeedSync)
+		}
 	}
-	if feedSchedulerStopC != nil {
-		close(feedSchedulerStopC)
-		feedSchedulerStopC = nil
+	if issueSummarySvc != nil {
+		if cfg, ok := reloadSettingsSection(ctx, service.SettingsIssueSummary, issueSummarySvc.GetConfig()); ok {
+			conf.Conf.IssueSummary = issueSummarySvc.UpdateConfig(cfg)
+			rescheduleJobs(service.JobIssueSummary)
+		}
 	}
-	if summarySchedulerStop != nil {
-		summarySchedulerStop()
-		summarySchedulerStop = nil
+	if prReviewSvc != nil {
+		if cfg, ok := reloadSettingsSection(ctx, service.SettingsPRReview, prReviewSvc.GetConfig()); ok {
+			conf.Conf.PRReview = prReviewSvc.UpdateConfig(cfg)
+			rescheduleJobs(service.JobPRReview)
+		}
 	}
-	if summarySchedulerStopC != nil {
-		close(summarySchedulerStopC)
-		summarySchedulerStopC = nil
+}
+
+// reloadSettingsSection returns cfg with the newer saved settings of section decoded over it, and
+// whether there were any.
+func reloadSettingsSection[T any](ctx context.Context, section string, cfg T) (T, bool) {
+	ok, err := settingsService.Reload(ctx, section, &cfg)
+	if err != nil {
+		appLogger.Error("settings reload failed", "section", section, "error", err)
+		return cfg, false
 	}
-	if prReviewSchedulerStop != nil {
-		prReviewSchedulerStop()
-		prReviewSchedulerStop = nil
+	return cfg, ok
+}
+
+func rescheduleJobs(names ...string) {
+	for _, name := range names {
+		_ = jobScheduler.Reschedule(name)
 	}
-	if prReviewSchedulerStopC != nil {
-		close(prReviewSchedulerStopC)
-		prReviewSchedulerStopC = nil
+}
+
+func stopSyncScheduler() error {
+	if jobScheduler != nil {
+		jobScheduler.Stop()
 	}
+	releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	leaderElector.Stop(releaseCtx)
 	return nil
 }
 
@@ -479,6 +568,31 @@ func logIssueSyncSummary(summary service.SyncRunSummary) {
 	}
 }
 
+func logIssueReconcileSummary(summary service.ReconcileRunSummary) {
+	appLogger.Info("issue reconcile run finished",
+		"started_at", summary.StartedAt,
+		"finished_at", summary.FinishedAt,
+		"result_count", len(summary.Results),
+	)
+	for _, result := range summary.Results {
+		if result.Err != "" {
+			appLogger.Error("issue reconcile repo failed",
+				"repo", result.Repo,
+				"checked", result.Checked,
+				"error", result.Err,
+			)
+			continue
+		}
+		appLogger.Info("issue reconcile repo finished",
+			"repo", result.Repo,
+			"checked", result.Checked,
+			"tombstoned", result.Tombstoned,
+			"transferred", result.Transferred,
+			"restored", result.Restored,
+		)
+	}
+}
+
 func logIssueSummaryRun(summary service.IssueSummaryRunSummary) {
 	appLogger.Info("issue summary run finished",
 		"started_at", summary.StartedAt,
@@ -509,6 +623,30 @@ func logIssueSummaryRun(summary service.IssueSummaryRunSummary) {
 	}
 }
 
+func logIssueResponsivenessRun(summary service.IssueResponsivenessRunSummary) {
+	if len(summary.Errors) > 0 {
+		appLogger.Error("issue responsiveness run finished with errors",
+			"started_at", summary.StartedAt,
+			"finished_at", summary.FinishedAt,
+			"scanned", summary.Scanned,
+			"updated", summary.Updated,
+			"skipped", summary.Skipped,
+			"failed", summary.Failed,
+			"stopped", summary.Stopped,
+			"errors", summary.Errors,
+		)
+		return
+	}
+	appLogger.Info("issue responsiveness run finished",
+		"started_at", summary.StartedAt,
+		"finished_at", summary.FinishedAt,
+		"scanned", summary.Scanned,
+		"updated", summary.Updated,
+		"skipped", summary.Skipped,
+		"stopped", summary.Stopped,
+	)
+}
+
 func logPRReviewRun(summary service.PRReviewRunSummary) {
 	appLogger.Info("pr review run finished",
 		"started_at", summary.StartedAt,
@@ -536,3 +674,22 @@ func logPRReviewRun(summary service.PRReviewRunSummary) {
 		)
 	}
 }
+
+func logRepoDiscoverySummary(summary service.RepoDiscoverySummary) {
+	appLogger.Info("repo discovery run finished",
+		"started_at", summary.StartedAt,
+		"finished_at", summary.FinishedAt,
+		"added", summary.Added,
+		"removed", summary.Removed,
+		"repo_count", len(summary.Repos),
+	)
+	for _, result := range summary.Results {
+		if result.Err != "" {
+			appLogger.Error("repo discovery rule failed",
+				"rule", result.Rule,
+				"kept", result.Matched,
+				"error", result.Err,
+			)
+		}
+	}
+}
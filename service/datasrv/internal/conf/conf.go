@@ -24,6 +24,15 @@ type Config struct {
 	// GitHub issue sync job configuration
 	GitHubSync GitHubSyncConfig `yaml:"github_sync" json:"github_sync"`
 
+	// GitLab configures the GitLab instance behind gitlab: managed repos.
+	GitLab GitLabConfig `yaml:"gitlab" json:"gitlab"`
+
+	// Gitea configures the Gitea and Forgejo hosts behind gitea: managed repos.
+	Gitea GiteaConfig `yaml:"gitea" json:"gitea"`
+
+	// GitHubWebhook configures the real-time GitHub webhook receiver.
+	GitHubWebhook GitHubWebhookConfig `yaml:"github_webhook" json:"github_webhook"`
+
 	// IssueCommentStorage controls where full GitHub issue comments are persisted.
 	IssueCommentStorage IssueCommentStorageConfig `yaml:"issue_comment_storage" json:"issue_comment_storage"`
 
@@ -36,6 +45,18 @@ type Config struct {
 	// PRReview controls periodic AI review generation for synced pull requests.
 	PRReview PRReviewConfig `yaml:"pr_review" json:"pr_review"`
 
+	// IssueResponsiveness controls periodic computation of issue response and close times.
+	IssueResponsiveness IssueResponsivenessConfig `yaml:"issue_responsiveness" json:"issue_responsiveness"`
+
+	// SyncRunHistory controls how long finished sync, summary and review runs are kept.
+	SyncRunHistory SyncRunHistoryConfig `yaml:"sync_run_history" json:"sync_run_history"`
+
+	// Leadership elects one replica to run the scheduled jobs.
+	Leadership LeadershipConfig `yaml:"leadership" json:"leadership"`
+
+	// Jobs overrides the schedule of background jobs by name, such as issue_sync or feed_sync.
+	Jobs map[string]JobConfig `yaml:"jobs" json:"jobs"`
+
 	// Server configuration
 	Server ServerConfig `yaml:"server" json:"server"`
 
@@ -76,13 +97,59 @@ type GitHubConfig struct {
 
 	// BaseURL is the GitHub API base URL (for GitHub Enterprise)
 	BaseURL string `yaml:"base_url" json:"base_url"`
+
+	// App authenticates as a GitHub App instead of the personal access token.
+	App GitHubAppConfig `yaml:"app" json:"app"`
+}
+
+// GitHubAppConfig holds GitHub App credentials used to mint installation tokens.
+type GitHubAppConfig struct {
+	// AppID is the numeric GitHub App id used as the JWT issuer.
+	AppID int64 `yaml:"app_id" json:"app_id"`
+
+	// PrivateKey is the PEM-encoded app private key.
+	PrivateKey string `yaml:"private_key" json:"private_key"`
+
+	// PrivateKeyPath loads the PEM key from disk when PrivateKey is empty.
+	PrivateKeyPath string `yaml:"private_key_path" json:"private_key_path"`
+
+	// Installations maps a repo owner (org or user) to its installation id.
+	// Owners without an entry are looked up through the repository installation API.
+	Installations map[string]int64 `yaml:"installations" json:"installations"`
+}
+
+// GitLabConfig holds GitLab API configuration.
+type GitLabConfig struct {
+	// BaseURL is the instance URL, https://gitlab.com by default.
+	BaseURL string `yaml:"base_url" json:"base_url"`
+
+	// Token is a personal, group or project access token with read_api scope.
+	Token string `yaml:"token" json:"token"`
+}
+
+// GiteaConfig holds Gitea and Forgejo API configuration.
+type GiteaConfig struct {
+	// Hosts maps the host of a gitea:host/owner/repo entry to its settings. Hosts without an
+	// entry are reached anonymously over https.
+	Hosts map[string]GiteaHostConfig `yaml:"hosts" json:"hosts"`
+}
+
+// GiteaHostConfig holds the settings of one Gitea or Forgejo host.
+type GiteaHostConfig struct {
+	// BaseURL overrides https://<host>, for plain http or a server below a sub-path.
+	BaseURL string `yaml:"base_url" json:"base_url"`
+
+	// Token is an access token with read:issue and read:repository scopes.
+	Token string `yaml:"token" json:"token"`
 }
 
 // GitHubSyncConfig holds scheduled sync options.
 type GitHubSyncConfig struct {
 	Enabled bool `yaml:"enabled" json:"enabled"`
 
-	// Repos in owner/repo format.
+	// Repos in owner/repo format, or discovery rules such as owner/*, owner/service-* and
+	// owner?topic=backend&archived=false that are expanded through the GitHub repos API.
+	// Repos on other trackers are named gitlab:group/project or gitea:host/owner/repo.
 	Repos []string `yaml:"repos" json:"repos"`
 
 	// IntervalSeconds controls scheduler frequency.
@@ -96,15 +163,36 @@ type GitHubSyncConfig struct {
 
 	// RequestTimeoutSeconds controls the timeout for each outbound GitHub/S3 request.
 	RequestTimeoutSeconds int `yaml:"request_timeout_seconds" json:"request_timeout_seconds"`
+
+	// Concurrency bounds how many repos are synced in parallel.
+	Concurrency int `yaml:"concurrency" json:"concurrency"`
+
+	// ReconcileIntervalSeconds controls how often deleted and transferred issues are swept.
+	// Zero disables the periodic sweep; it can still be triggered through the admin API.
+	ReconcileIntervalSeconds int `yaml:"reconcile_interval_seconds" json:"reconcile_interval_seconds"`
+
+	// DiscoveryIntervalSeconds controls how often discovery rules in Repos are expanded again.
+	DiscoveryIntervalSeconds int `yaml:"discovery_interval_seconds" json:"discovery_interval_seconds"`
+}
+
+// GitHubWebhookConfig holds GitHub webhook receiver options.
+type GitHubWebhookConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// Secret is the shared webhook secret used to verify X-Hub-Signature-256.
+	Secret string `yaml:"secret" json:"secret"`
 }
 
-// IssueCommentStorageConfig stores full GitHub issue comments outside the primary database.
+// IssueCommentStorageConfig controls where full GitHub issue comments are stored.
 type IssueCommentStorageConfig struct {
 	Enabled bool `yaml:"enabled" json:"enabled"`
 
-	// Provider currently supports "s3".
+	// Provider is "s3" (default), "database" for the primary sync store, or "filesystem".
 	Provider string `yaml:"provider" json:"provider"`
 
+	// Dir is the root directory of the filesystem provider.
+	Dir string `yaml:"dir" json:"dir"`
+
 	Bucket string `yaml:"bucket" json:"bucket"`
 	Region string `yaml:"region" json:"region"`
 
@@ -115,6 +203,14 @@ type IssueCommentStorageConfig struct {
 	SecretAccessKey string `yaml:"secret_access_key" json:"secret_access_key"`
 	UsePathStyle    bool   `yaml:"use_path_style" json:"use_path_style"`
 	KeyPrefix       string `yaml:"key_prefix" json:"key_prefix"`
+
+	// Compression encodes S3 comment objects: "none" (default), "gzip" or "zstd". Objects written
+	// with any of them stay readable when it changes.
+	Compression string `yaml:"compression" json:"compression"`
+
+	// Versioning turns on bucket versioning so that previous comment sets can be listed and
+	// restored through the admin API.
+	Versioning bool `yaml:"versioning" json:"versioning"`
 }
 
 // FeedSourceConfig defines a configured RSS/Atom source.
@@ -177,6 +273,33 @@ type IssueSummaryConfig struct {
 	GoogleAPIKey  string `yaml:"google_api_key" json:"google_api_key"`
 }
 
+// IssueResponsivenessConfig holds scheduled issue responsiveness computation options.
+type IssueResponsivenessConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// IntervalSeconds controls scheduler frequency (default 600).
+	IntervalSeconds int `yaml:"interval_seconds" json:"interval_seconds"`
+
+	// BatchSize controls how many issues to load per page.
+	BatchSize int `yaml:"batch_size" json:"batch_size"`
+
+	// MaxIssuesPerRun bounds how many issues are computed in one run.
+	MaxIssuesPerRun int `yaml:"max_issues_per_run" json:"max_issues_per_run"`
+
+	// Maintainers lists the maintainer logins per owner/repo. Logins under "*" count for every repo.
+	Maintainers map[string][]string `yaml:"maintainers" json:"maintainers"`
+
+	// MaintainerAssociations are the GitHub author associations that make a commenter a maintainer
+	// (default OWNER, MEMBER and COLLABORATOR). Set it to ["none"] to rely on Maintainers only.
+	MaintainerAssociations []string `yaml:"maintainer_associations" json:"maintainer_associations"`
+}
+
+// SyncRunHistoryConfig holds run history retention options.
+type SyncRunHistoryConfig struct {
+	// RetentionDays drops runs that started longer ago (default 30).
+	RetentionDays int `yaml:"retention_days" json:"retention_days"`
+}
+
 // PRReviewConfig holds scheduled PR AI review generation options.
 type PRReviewConfig struct {
 	Enabled bool `yaml:"enabled" json:"enabled"`
@@ -213,6 +336,47 @@ type PRReviewConfig struct {
 	GoogleAPIKey  string `yaml:"google_api_key" json:"google_api_key"`
 }
 
+// LeadershipConfig holds scheduler leader election options.
+type LeadershipConfig struct {
+	// Backend is "redis", "database" for Postgres advisory locks on the primary store, or empty to
+	// run the scheduled jobs on every replica.
+	Backend string `yaml:"backend" json:"backend"`
+
+	// RedisName is the named Redis client of the redis backend.
+	RedisName string `yaml:"redis_name" json:"redis_name"`
+
+	// LeaseTTLSeconds bounds how long a lease outlives a replica that stopped renewing it (default 30).
+	// Leases are renewed every third of it.
+	LeaseTTLSeconds int `yaml:"lease_ttl_seconds" json:"lease_ttl_seconds"`
+
+	// ReplicaID names this replica in the lease, the hostname and process id by default.
+	ReplicaID string `yaml:"replica_id" json:"replica_id"`
+}
+
+// JobConfig overrides the built-in schedule of a background job. Zero values keep the defaults.
+type JobConfig struct {
+	// Cron is a five-field cron expression, or a descriptor such as @hourly or @every 10m. It
+	// replaces the interval the job would otherwise take from its own config section.
+	Cron string `yaml:"cron" json:"cron"`
+
+	// JitterSeconds delays each scheduled run by up to this many seconds, picked at random.
+	JitterSeconds int `yaml:"jitter_seconds" json:"jitter_seconds"`
+
+	// RunOnStart runs the job as soon as the scheduler starts instead of waiting for the first
+	// scheduled time.
+	RunOnStart *bool `yaml:"run_on_start" json:"run_on_start"`
+
+	// TimeoutSeconds cancels a run that takes longer.
+	TimeoutSeconds int `yaml:"timeout_seconds" json:"timeout_seconds"`
+
+	// Overlap is what a run due while the previous one is still going does: skip (default),
+	// queue to start once it finishes, or replace to cancel it.
+	Overlap string `yaml:"overlap" json:"overlap"`
+
+	// Paused keeps the job from running on schedule until it is resumed through the admin API.
+	Paused bool `yaml:"paused" json:"paused"`
+}
+
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	// Host is the server host address
@@ -33,6 +33,20 @@ type BlogPostFilter struct {
 	Query  string
 	Offset int
 	Limit  int
+	// After continues a listing after the post it was taken from, in place of Offset.
+	After *BlogPostCursor
+}
+
+// BlogPostCursor is the position of a post in a listing, newest first.
+type BlogPostCursor struct {
+	PublishedAt time.Time
+	CreatedAt   time.Time
+	ID          string
+}
+
+// BlogPostCountStore counts the posts of a filter, ignoring its paging.
+type BlogPostCountStore interface {
+	CountBlogPosts(ctx context.Context, filter BlogPostFilter) (int64, error)
 }
 
 type BlogComment struct {
@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// CommentCheckpoint is the cursor of the repo-wide issue comment listing, kept apart from the
+// issue Checkpoint so that either can advance without the other.
+type CommentCheckpoint struct {
+	Repo string
+	// LastCommentUpdatedAt is the newest comment update merged into the comment store.
+	LastCommentUpdatedAt time.Time
+	LastSyncedAt         time.Time
+	LastError            string
+	UpdatedAt            time.Time
+}
+
+type CommentCheckpointStore interface {
+	// GetCommentCheckpoint returns a zero checkpoint for a repo whose comments were never listed.
+	GetCommentCheckpoint(ctx context.Context, repo string) (CommentCheckpoint, error)
+	SaveCommentCheckpoint(ctx context.Context, cp CommentCheckpoint) error
+}
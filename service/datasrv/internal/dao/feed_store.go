@@ -54,6 +54,19 @@ type FeedContentFilter struct {
 	ContentID    string
 	Offset       int
 	Limit        int
+	// After continues a listing after the content it was taken from, in place of Offset.
+	After *FeedContentCursor
+}
+
+// FeedContentCursor is the position of a content in a listing, newest first.
+type FeedContentCursor struct {
+	PublishedAt time.Time
+	ID          string
+}
+
+// FeedContentCountStore counts the contents of a filter, ignoring its paging.
+type FeedContentCountStore interface {
+	CountFeedContents(ctx context.Context, filter FeedContentFilter) (int64, error)
 }
 
 type FeedCheckpoint struct {
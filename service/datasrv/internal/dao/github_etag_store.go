@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// GitHubETag is the cache validator GitHub returned for one page of a list endpoint.
+type GitHubETag struct {
+	Repo string
+	// Endpoint is the path below the repo, e.g. "issues" or "issues/12/comments".
+	Endpoint     string
+	Page         int
+	ETag         string
+	LastModified string
+	// NextPage lets a sync walk past a 304 response, which carries no pagination links.
+	NextPage  int
+	UpdatedAt time.Time
+}
+
+// GitHubETagStore persists validators so unchanged pages can be requested conditionally.
+type GitHubETagStore interface {
+	// GetGitHubETag returns a zero ETag when the page has not been fetched before.
+	GetGitHubETag(ctx context.Context, repo, endpoint string, page int) (GitHubETag, error)
+	SaveGitHubETag(ctx context.Context, etag GitHubETag) error
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormCommentCheckpoint struct {
+	Repo                 string `gorm:"primaryKey;size:255"`
+	LastCommentUpdatedAt time.Time
+	LastSyncedAt         time.Time
+	LastError            string `gorm:"type:text"`
+	UpdatedAt            time.Time
+}
+
+func (gormCommentCheckpoint) TableName() string { return "github_comment_checkpoints" }
+
+func (g *GormSyncStore) GetCommentCheckpoint(ctx context.Context, repo string) (CommentCheckpoint, error) {
+	var row gormCommentCheckpoint
+	if err := g.db.WithContext(ctx).Where("repo = ?", repo).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return CommentCheckpoint{Repo: repo}, nil
+		}
+		return CommentCheckpoint{}, fmt.Errorf("gorm get comment checkpoint: %w", err)
+	}
+	return CommentCheckpoint{
+		Repo:                 row.Repo,
+		LastCommentUpdatedAt: row.LastCommentUpdatedAt,
+		LastSyncedAt:         row.LastSyncedAt,
+		LastError:            row.LastError,
+		UpdatedAt:            row.UpdatedAt,
+	}, nil
+}
+
+func (g *GormSyncStore) SaveCommentCheckpoint(ctx context.Context, cp CommentCheckpoint) error {
+	row := gormCommentCheckpoint{
+		Repo:                 cp.Repo,
+		LastCommentUpdatedAt: cp.LastCommentUpdatedAt.UTC(),
+		LastSyncedAt:         cp.LastSyncedAt.UTC(),
+		LastError:            cp.LastError,
+		UpdatedAt:            time.Now(),
+	}
+	err := g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "repo"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_comment_updated_at", "last_synced_at", "last_error", "updated_at"}),
+		}).
+		Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("gorm save comment checkpoint: %w", err)
+	}
+	return nil
+}
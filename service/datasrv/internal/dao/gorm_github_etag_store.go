@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormGitHubETag struct {
+	Repo         string `gorm:"primaryKey;size:255"`
+	Endpoint     string `gorm:"primaryKey;size:255"`
+	Page         int    `gorm:"primaryKey"`
+	ETag         string `gorm:"column:etag;size:512"`
+	LastModified string `gorm:"size:512"`
+	NextPage     int    `gorm:"not null"`
+	UpdatedAt    time.Time
+}
+
+func (gormGitHubETag) TableName() string { return "github_request_etags" }
+
+func (g *GormSyncStore) GetGitHubETag(ctx context.Context, repo, endpoint string, page int) (GitHubETag, error) {
+	var row gormGitHubETag
+	err := g.db.WithContext(ctx).
+		Where("repo = ? AND endpoint = ? AND page = ?", repo, endpoint, page).
+		First(&row).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return GitHubETag{Repo: repo, Endpoint: endpoint, Page: page}, nil
+		}
+		return GitHubETag{}, fmt.Errorf("gorm get github etag: %w", err)
+	}
+	return GitHubETag{
+		Repo:         row.Repo,
+		Endpoint:     row.Endpoint,
+		Page:         row.Page,
+		ETag:         row.ETag,
+		LastModified: row.LastModified,
+		NextPage:     row.NextPage,
+		UpdatedAt:    row.UpdatedAt,
+	}, nil
+}
+
+func (g *GormSyncStore) SaveGitHubETag(ctx context.Context, etag GitHubETag) error {
+	row := gormGitHubETag{
+		Repo:         etag.Repo,
+		Endpoint:     etag.Endpoint,
+		Page:         etag.Page,
+		ETag:         etag.ETag,
+		LastModified: etag.LastModified,
+		NextPage:     etag.NextPage,
+		UpdatedAt:    time.Now(),
+	}
+	err := g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "repo"}, {Name: "endpoint"}, {Name: "page"}},
+			DoUpdates: clause.AssignmentColumns([]string{"etag", "last_modified", "next_page", "updated_at"}),
+		}).
+		Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("gorm save github etag: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// gormIssueCounts are the counts shared by the totals and the breakdown groups.
+const gormIssueCounts = "COUNT(*) AS total, " +
+	"COUNT(*) FILTER (WHERE state = 'open') AS open, " +
+	"COUNT(*) FILTER (WHERE state = 'closed') AS closed, " +
+	"COUNT(*) FILTER (WHERE is_pull_request) AS pull_requests, " +
+	"COALESCE(SUM(comments), 0) AS comments"
+
+// gormIssueDimensions maps the breakdown dimensions to the FROM item they add and the key they
+// group by. Label and assignee names are unnested from their JSON columns.
+var gormIssueDimensions = map[IssueDimension]struct{ from, key string }{
+	IssueDimensionLabel:    {", jsonb_array_elements_text(COALESCE(NULLIF(labels_json, ''), '[]')::jsonb) AS dim(value)", "dim.value"},
+	IssueDimensionAssignee: {", jsonb_array_elements_text(COALESCE(NULLIF(assignees_json, ''), '[]')::jsonb) AS dim(value)", "dim.value"},
+	IssueDimensionAuthor:   {"", "author"},
+	IssueDimensionType:     {"", "CASE WHEN is_pull_request THEN '" + IssueTypePullRequest + "' ELSE '" + IssueTypeIssue + "' END"},
+	IssueDimensionRepo:     {"", "repo"},
+	IssueDimensionMonth:    {"", "to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM')"},
+}
+
+type gormIssueTotalsRow struct {
+	Total           int64
+	Open            int64
+	Closed          int64
+	PullRequests    int64
+	WithAISummary   int64
+	Comments        int64
+	Repos           int64
+	LatestCreatedAt *time.Time
+	LatestUpdatedAt *time.Time
+}
+
+type gormIssueActivityRow struct {
+	Start time.Time
+	Count int64
+}
+
+func (g *GormSyncStore) IssueTotals(ctx context.Context, filter IssueAnalyticsFilter) (IssueTotals, error) {
+	var row gormIssueTotalsRow
+	err := g.issueAnalyticsQuery(ctx, "github_issues", filter.Repos, filter.issueFilter()).
+		Select(gormIssueCounts + ", " +
+			"COUNT(*) FILTER (WHERE COALESCE(ai_summary, '') <> '') AS with_ai_summary, " +
+			"COUNT(DISTINCT repo) AS repos, " +
+			"MAX(created_at) AS latest_created_at, " +
+			"MAX(updated_at) AS latest_updated_at").
+		Scan(&row).Error
+	if err != nil {
+		return IssueTotals{}, fmt.Errorf("gorm issue totals: %w", err)
+	}
+	totals := IssueTotals{
+		Total:         row.Total,
+		Open:          row.Open,
+		Closed:        row.Closed,
+		PullRequests:  row.PullRequests,
+		WithAISummary: row.WithAISummary,
+		Comments:      row.Comments,
+		Repos:         row.Repos,
+	}
+	if row.LatestCreatedAt != nil {
+		totals.LatestCreatedAt = row.LatestCreatedAt.UTC()
+	}
+	if row.LatestUpdatedAt != nil {
+		totals.LatestUpdatedAt = row.LatestUpdatedAt.UTC()
+	}
+	return totals, nil
+}
+
+func (g *GormSyncStore) IssueActivity(ctx context.Context, filter IssueAnalyticsFilter, interval IssueInterval) ([]IssueActivityBucket, error) {
+	opened, err := g.issueActivityCounts(ctx, "created_at", filter.Repos, filter.issueFilter(), interval)
+	if err != nil {
+		return nil, err
+	}
+	closed, err := g.issueActivityCounts(ctx, "closed_at", filter.Repos, SyncIssueFilter{ClosedAfter: filter.From, ClosedBefore: filter.To}, interval)
+	if err != nil {
+		return nil, err
+	}
+	return mergeIssueActivity(opened, closed), nil
+}
+
+// issueActivityCounts counts the issues matching conds per interval of column, truncated in UTC.
+func (g *GormSyncStore) issueActivityCounts(ctx context.Context, column string, repos []string, conds SyncIssueFilter, interval IssueInterval) (map[time.Time]int64, error) {
+	bucket := "date_trunc(?, " + column + " AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'"
+	var rows []gormIssueActivityRow
+	err := g.issueAnalyticsQuery(ctx, "github_issues", repos, conds).
+		Select(bucket+" AS start, COUNT(*) AS count", string(interval)).
+		Where(column + " IS NOT NULL").
+		Group("1").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gorm issue activity by %s: %w", column, err)
+	}
+	counts := make(map[time.Time]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Start.UTC()] = row.Count
+	}
+	return counts, nil
+}
+
+func (g *GormSyncStore) OpenIssuesAt(ctx context.Context, filter IssueAnalyticsFilter, at time.Time) (int64, error) {
+	var count int64
+	err := g.issueAnalyticsQuery(ctx, "github_issues", filter.Repos, SyncIssueFilter{CreatedBefore: at}).
+		Where("(closed_at IS NULL OR closed_at >= ?)", at).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("gorm count open issues: %w", err)
+	}
+	return count, nil
+}
+
+func (g *GormSyncStore) IssueBreakdown(ctx context.Context, filter IssueAnalyticsFilter, dimension IssueDimension, limit int) ([]IssueGroupCount, error) {
+	dim, ok := gormIssueDimensions[dimension]
+	if !ok {
+		return nil, fmt.Errorf("gorm issue breakdown: unknown dimension %q", dimension)
+	}
+	query := g.issueAnalyticsQuery(ctx, "github_issues"+dim.from, filter.Repos, filter.issueFilter()).
+		Select(dim.key + " AS key, " + gormIssueCounts).
+		Group(dim.key).
+		Order("total DESC").
+		Order("key")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var rows []IssueGroupCount
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm issue breakdown by %s: %w", dimension, err)
+	}
+	return rows, nil
+}
+
+// issueAnalyticsQuery starts an aggregation over the live issues of repos matching conds.
+func (g *GormSyncStore) issueAnalyticsQuery(ctx context.Context, from string, repos []string, conds SyncIssueFilter) *gorm.DB {
+	query := applyGormIssueFilter(g.db.WithContext(ctx).Table(from), conds)
+	if len(repos) > 0 {
+		query = query.Where("repo IN ?", repos)
+	}
+	return query
+}
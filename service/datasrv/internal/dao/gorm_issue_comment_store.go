@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormIssueCommentThread struct {
+	Repo         string `gorm:"primaryKey;size:255"`
+	IssueID      int64  `gorm:"primaryKey"`
+	Number       int32  `gorm:"not null"`
+	CommentCount int32  `gorm:"not null"`
+	CommentsJSON string `gorm:"type:text"`
+	UpdatedAt    time.Time
+}
+
+func (gormIssueCommentThread) TableName() string { return "github_issue_comments" }
+
+func (g *GormSyncStore) SaveIssueComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []IssueComment) error {
+	if comments == nil {
+		comments = []IssueComment{}
+	}
+	body, err := json.Marshal(comments)
+	if err != nil {
+		return fmt.Errorf("marshal issue comments: %w", err)
+	}
+	row := gormIssueCommentThread{
+		Repo:         repo,
+		IssueID:      issueID,
+		Number:       issueNumber,
+		CommentCount: int32(len(comments)),
+		CommentsJSON: string(body),
+		UpdatedAt:    time.Now().UTC(),
+	}
+	err = g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "repo"}, {Name: "issue_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"number", "comment_count", "comments_json", "updated_at"}),
+		}).
+		Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("gorm save issue comments: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) LoadIssueComments(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueComment, error) {
+	var row gormIssueCommentThread
+	if err := g.db.WithContext(ctx).Where("repo = ? AND issue_id = ?", repo, issueID).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrIssueCommentsNotFound
+		}
+		return nil, fmt.Errorf("gorm load issue comments: %w", err)
+	}
+	var comments []IssueComment
+	if err := json.Unmarshal([]byte(row.CommentsJSON), &comments); err != nil {
+		return nil, fmt.Errorf("unmarshal issue comments for %s#%d: %w", repo, issueNumber, err)
+	}
+	return comments, nil
+}
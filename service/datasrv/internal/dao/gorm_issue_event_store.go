@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type gormIssueEvent struct {
+	Repo        string `gorm:"primaryKey;size:255;index:idx_github_issue_events_number,priority:1"`
+	IssueID     int64  `gorm:"primaryKey"`
+	EventKey    string `gorm:"primaryKey;size:255"`
+	IssueNumber int32  `gorm:"not null;index:idx_github_issue_events_number,priority:2"`
+	Event       string `gorm:"size:64;not null;index"`
+	Actor       string `gorm:"size:255"`
+	CreatedAt   time.Time
+	Label       string `gorm:"size:255"`
+	Assignee    string `gorm:"size:255"`
+	Milestone   string `gorm:"size:255"`
+	RenameFrom  string `gorm:"type:text"`
+	RenameTo    string `gorm:"type:text"`
+	CommitID    string `gorm:"size:64"`
+	Source      string `gorm:"size:512"`
+	SyncedAt    time.Time
+}
+
+func (gormIssueEvent) TableName() string { return "github_issue_events" }
+
+func (g *GormSyncStore) UpsertIssueEvents(ctx context.Context, events []IssueEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	rows := make([]gormIssueEvent, 0, len(events))
+	for _, it := range events {
+		rows = append(rows, gormIssueEvent{
+			Repo:        it.Repo,
+			IssueID:     it.IssueID,
+			EventKey:    it.Key,
+			IssueNumber: it.IssueNumber,
+			Event:       it.Event,
+			Actor:       it.Actor,
+			CreatedAt:   it.CreatedAt,
+			Label:       it.Label,
+			Assignee:    it.Assignee,
+			Milestone:   it.Milestone,
+			RenameFrom:  it.RenameFrom,
+			RenameTo:    it.RenameTo,
+			CommitID:    it.CommitID,
+			Source:      it.Source,
+			SyncedAt:    now,
+		})
+	}
+
+	err := g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "repo"}, {Name: "issue_id"}, {Name: "event_key"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"issue_number", "event", "actor", "created_at", "label", "assignee", "milestone",
+				"rename_from", "rename_to", "commit_id", "source", "synced_at",
+			}),
+		}).
+		Create(&rows).Error
+	if err != nil {
+		return 0, fmt.Errorf("gorm upsert issue events: %w", err)
+	}
+	return len(events), nil
+}
+
+func (g *GormSyncStore) ListIssueEvents(ctx context.Context, filter IssueEventFilter) ([]IssueEvent, error) {
+	query := g.db.WithContext(ctx).Model(&gormIssueEvent{})
+	if filter.Repo != "" {
+		query = query.Where("repo = ?", filter.Repo)
+	}
+	if filter.IssueID > 0 {
+		query = query.Where("issue_id = ?", filter.IssueID)
+	}
+	if filter.IssueNumber > 0 {
+		query = query.Where("issue_number = ?", filter.IssueNumber)
+	}
+	if len(filter.Events) > 0 {
+		query = query.Where("event IN ?", filter.Events)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var rows []gormIssueEvent
+	if err := query.Order("created_at ASC").Order("event_key ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list issue events: %w", err)
+	}
+
+	out := make([]IssueEvent, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, IssueEvent{
+			Repo:        row.Repo,
+			IssueID:     row.IssueID,
+			IssueNumber: row.IssueNumber,
+			Key:         row.EventKey,
+			Event:       row.Event,
+			Actor:       row.Actor,
+			CreatedAt:   row.CreatedAt,
+			Label:       row.Label,
+			Assignee:    row.Assignee,
+			Milestone:   row.Milestone,
+			RenameFrom:  row.RenameFrom,
+			RenameTo:    row.RenameTo,
+			CommitID:    row.CommitID,
+			Source:      row.Source,
+		})
+	}
+	return out, nil
+}
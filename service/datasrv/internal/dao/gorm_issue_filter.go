@@ -0,0 +1,154 @@
+package dao
+
+import (
+	"encoding/json"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gormIssueFilterDDL adds lower-cased jsonb copies of the label and assignee columns, which are kept
+// as JSON text, so that label and assignee filters can use GIN indexes.
+var gormIssueFilterDDL = []string{
+	`ALTER TABLE github_issues ADD COLUMN IF NOT EXISTS labels_lower jsonb
+		GENERATED ALWAYS AS (lower(COALESCE(NULLIF(labels_json, ''), '[]'))::jsonb) STORED`,
+	`ALTER TABLE github_issues ADD COLUMN IF NOT EXISTS assignees_lower jsonb
+		GENERATED ALWAYS AS (lower(COALESCE(NULLIF(assignees_json, ''), '[]'))::jsonb) STORED`,
+	"CREATE INDEX IF NOT EXISTS idx_github_issues_labels_lower ON github_issues USING GIN (labels_lower jsonb_path_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_github_issues_assignees_lower ON github_issues USING GIN (assignees_lower jsonb_path_ops)",
+	"CREATE INDEX IF NOT EXISTS idx_github_issues_author_lower ON github_issues (lower(author))",
+}
+
+// gormIssueSortColumns maps the time and count sorts to their columns.
+var gormIssueSortColumns = map[IssueSort]string{
+	IssueSortCreated:  "created_at",
+	IssueSortUpdated:  "updated_at",
+	IssueSortComments: "comments",
+	IssueSortNumber:   "number",
+}
+
+// gormNeverClosed stands in for the closed_at of open issues, so that they compare equal.
+const gormNeverClosed = "TIMESTAMPTZ '0001-01-01 00:00:00+00'"
+
+// applyGormIssueFilter adds the conditions of filter to a github_issues query, leaving paging
+// and order to the caller.
+func applyGormIssueFilter(query *gorm.DB, filter SyncIssueFilter) *gorm.DB {
+	if filter.Repo != "" {
+		query = query.Where("repo = ?", filter.Repo)
+	}
+	if filter.State != "" && filter.State != "all" {
+		query = query.Where("state = ?", filter.State)
+	}
+	if filter.IssueID > 0 {
+		query = query.Where("issue_id = ?", filter.IssueID)
+	}
+	if filter.Number > 0 {
+		query = query.Where("number = ?", filter.Number)
+	}
+	if !filter.IncludeTombstoned {
+		query = query.Where("COALESCE(tombstone, '') = ''")
+	}
+	if len(filter.Labels) > 0 {
+		if filter.AnyLabel {
+			conds := make([]string, 0, len(filter.Labels))
+			args := make([]any, 0, len(filter.Labels))
+			for _, label := range filter.Labels {
+				conds = append(conds, "labels_lower @> CAST(? AS jsonb)")
+				args = append(args, gormLowerJSONArray(label))
+			}
+			query = query.Where("("+strings.Join(conds, " OR ")+")", args...)
+		} else {
+			query = query.Where("labels_lower @> CAST(? AS jsonb)", gormLowerJSONArray(filter.Labels...))
+		}
+	}
+	if filter.Assignee != "" {
+		query = query.Where("assignees_lower @> CAST(? AS jsonb)", gormLowerJSONArray(filter.Assignee))
+	}
+	if filter.Author != "" {
+		query = query.Where("lower(author) = ?", strings.ToLower(filter.Author))
+	}
+	if filter.IsPullRequest != nil {
+		query = query.Where("is_pull_request = ?", *filter.IsPullRequest)
+	}
+	if filter.HasAISummary != nil {
+		if *filter.HasAISummary {
+			query = query.Where("COALESCE(ai_summary, '') <> ''")
+		} else {
+			query = query.Where("COALESCE(ai_summary, '') = ''")
+		}
+	}
+	if !filter.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		query = query.Where("updated_at >= ?", filter.UpdatedAfter)
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		query = query.Where("updated_at < ?", filter.UpdatedBefore)
+	}
+	if !filter.ClosedAfter.IsZero() {
+		query = query.Where("closed_at >= ?", filter.ClosedAfter)
+	}
+	if !filter.ClosedBefore.IsZero() {
+		query = query.Where("closed_at < ?", filter.ClosedBefore)
+	}
+	if filter.MinComments != nil {
+		query = query.Where("comments >= ?", *filter.MinComments)
+	}
+	if filter.MaxComments != nil {
+		query = query.Where("comments <= ?", *filter.MaxComments)
+	}
+	return query
+}
+
+// gormIssueOrder returns the keys ListIssues orders by. Issues that were never closed sort after
+// the closed ones in both directions. Ties break on repo and issue_id, which are unique together.
+func gormIssueOrder(filter SyncIssueFilter) []gormKeysetColumn {
+	desc := !filter.SortAscending
+	switch filter.Sort {
+	case IssueSortClosed:
+		return []gormKeysetColumn{
+			{expr: "(closed_at IS NULL)"},
+			{expr: "COALESCE(closed_at, " + gormNeverClosed + ")", desc: desc},
+			{expr: "repo", desc: desc},
+			{expr: "issue_id", desc: desc},
+		}
+	case IssueSortCreated, IssueSortUpdated, IssueSortComments, IssueSortNumber:
+		return []gormKeysetColumn{
+			{expr: gormIssueSortColumns[filter.Sort], desc: desc},
+			{expr: "repo", desc: desc},
+			{expr: "issue_id", desc: desc},
+		}
+	default:
+		return []gormKeysetColumn{
+			{expr: "(COALESCE(ai_summary, '') <> '')", desc: true},
+			{expr: "updated_at", desc: true},
+			{expr: "repo", desc: true},
+			{expr: "issue_id", desc: true},
+		}
+	}
+}
+
+// gormIssueCursorValues returns the keys of cursor in the order of gormIssueOrder.
+func gormIssueCursorValues(sort IssueSort, cursor IssueCursor) []any {
+	switch sort {
+	case IssueSortClosed:
+		return []any{cursor.Flag, cursor.Time, cursor.Repo, cursor.IssueID}
+	case IssueSortCreated, IssueSortUpdated:
+		return []any{cursor.Time, cursor.Repo, cursor.IssueID}
+	case IssueSortComments, IssueSortNumber:
+		return []any{cursor.Count, cursor.Repo, cursor.IssueID}
+	default:
+		return []any{cursor.Flag, cursor.Time, cursor.Repo, cursor.IssueID}
+	}
+}
+
+// gormLowerJSONArray encodes values as a lower-cased JSON array, to match the labels_lower and
+// assignees_lower columns.
+func gormLowerJSONArray(values ...string) string {
+	raw, _ := json.Marshal(lowerAll(values))
+	return string(raw)
+}
@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// gormStaleResponsiveness matches the issues whose metrics need computing.
+const gormStaleResponsiveness = "(responsiveness_source_at IS NULL OR responsiveness_source_at < updated_at)"
+
+// gormResponsivenessOrder is the order of ListIssuesWithStaleResponsiveness.
+var gormResponsivenessOrder = []gormKeysetColumn{{expr: "updated_at"}, {expr: "repo"}, {expr: "issue_id"}}
+
+func (g *GormSyncStore) ListIssuesWithStaleResponsiveness(ctx context.Context, after *IssueCursor, limit int) ([]StaleIssue, error) {
+	query := applyGormIssueFilter(g.db.WithContext(ctx).Model(&gormIssue{}), SyncIssueFilter{}).
+		Where(gormStaleResponsiveness)
+	if after != nil {
+		query = gormKeysetAfter(query, gormResponsivenessOrder, []any{after.Time, after.Repo, after.IssueID})
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var rows []gormIssue
+	if err := gormKeysetOrder(query, gormResponsivenessOrder).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list issues with stale responsiveness: %w", err)
+	}
+	out := make([]StaleIssue, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, StaleIssue{Issue: row.toSyncedIssue(), Generation: row.ResponsivenessGeneration})
+	}
+	return out, nil
+}
+
+func (g *GormSyncStore) SetIssueResponsiveness(ctx context.Context, repo string, issueID int64, metrics IssueResponsiveness) (bool, error) {
+	result := g.db.WithContext(ctx).Model(&gormIssue{}).
+		Where("repo = ? AND issue_id = ? AND updated_at = ? AND responsiveness_generation = ?",
+			repo, issueID, metrics.SourceUpdatedAt, metrics.Generation).
+		Updates(map[string]any{
+			"first_response_at":        metrics.FirstResponseAt,
+			"first_responder":          metrics.FirstResponder,
+			"first_response_seconds":   metrics.FirstResponseSeconds,
+			"close_seconds":            metrics.CloseSeconds,
+			"reopen_count":             metrics.ReopenCount,
+			"responsiveness_source_at": metrics.SourceUpdatedAt,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("gorm set issue responsiveness: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (g *GormSyncStore) InvalidateIssueResponsiveness(ctx context.Context, repo string, issueIDs []int64) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+	err := g.db.WithContext(ctx).Model(&gormIssue{}).
+		Where("repo = ? AND issue_id IN ?", repo, issueIDs).
+		Updates(map[string]any{
+			"responsiveness_source_at":  nil,
+			"responsiveness_generation": gorm.Expr("responsiveness_generation + 1"),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("gorm invalidate issue responsiveness: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) IssueResponsivenessStats(ctx context.Context, filter IssueAnalyticsFilter, groupBy IssueDimension) ([]IssueResponsivenessStats, error) {
+	dim, ok := gormIssueDimensions[groupBy]
+	if !ok || (groupBy != IssueDimensionRepo && groupBy != IssueDimensionLabel && groupBy != IssueDimensionMonth) {
+		return nil, fmt.Errorf("gorm issue responsiveness: unknown grouping %q", groupBy)
+	}
+	var rows []IssueResponsivenessStats
+	err := g.issueAnalyticsQuery(ctx, "github_issues"+dim.from, filter.Repos, filter.issueFilter()).
+		Select(dim.key + " AS key, " +
+			"COUNT(*) AS issues, " +
+			"COUNT(first_response_seconds) AS responded, " +
+			"COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY first_response_seconds), 0) AS first_response_p50, " +
+			"COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY first_response_seconds), 0) AS first_response_p90, " +
+			"COUNT(close_seconds) AS closed, " +
+			"COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY close_seconds), 0) AS close_p50, " +
+			"COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY close_seconds), 0) AS close_p90, " +
+			"COUNT(*) FILTER (WHERE reopen_count > 0) AS reopened, " +
+			"COALESCE(SUM(reopen_count), 0) AS reopens").
+		Where("responsiveness_source_at IS NOT NULL").
+		Group(dim.key).
+		Order("key").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gorm issue responsiveness by %s: %w", groupBy, err)
+	}
+	return rows, nil
+}
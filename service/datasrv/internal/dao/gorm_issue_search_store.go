@@ -0,0 +1,123 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// gormIssueSearchVectorDDL adds the generated tsvector behind SearchIssues. Titles weigh most,
+// then AI summaries, bodies and comments.
+const gormIssueSearchVectorDDL = `ALTER TABLE github_issues ADD COLUMN IF NOT EXISTS search_vector tsvector
+	GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(ai_summary, '')), 'B') ||
+		setweight(to_tsvector('english', coalesce(body, '')), 'C') ||
+		setweight(to_tsvector('english', coalesce(comments_text, '')), 'D')
+	) STORED`
+
+const gormSearchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \""
+
+type gormIssueSearchRow struct {
+	gormIssue      `gorm:"embedded"`
+	Score          float64
+	TitleHighlight string
+	Snippet        string
+}
+
+func (g *GormSyncStore) SearchIssues(ctx context.Context, q IssueSearchQuery) ([]IssueSearchHit, error) {
+	query := g.db.WithContext(ctx).Table("github_issues")
+	text := websearchQueryText(q)
+	if text != "" {
+		query = query.
+			Table("github_issues, websearch_to_tsquery('english', ?) AS search_query", text).
+			Select("github_issues.*, "+
+				"ts_rank_cd(search_vector, search_query) AS score, "+
+				"ts_headline('english', title, search_query, ?) AS title_highlight, "+
+				"ts_headline('english', concat_ws(' ', ai_summary, body, comments_text), search_query, ?) AS snippet",
+				"HighlightAll=true, "+gormSearchHeadlineOptions, gormSearchHeadlineOptions).
+			Where("search_vector @@ search_query").
+			Order("score DESC")
+	}
+	query = applyGormIssueFilter(query, q.filter())
+	if q.Offset > 0 {
+		query = query.Offset(q.Offset)
+	}
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+
+	var rows []gormIssueSearchRow
+	if err := query.Order("updated_at DESC").Order("issue_id DESC").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm search issues: %w", err)
+	}
+	out := make([]IssueSearchHit, 0, len(rows))
+	for _, row := range rows {
+		hit := IssueSearchHit{
+			Issue:          row.gormIssue.toSyncedIssue(),
+			Score:          row.Score,
+			TitleHighlight: row.TitleHighlight,
+			Snippet:        row.Snippet,
+		}
+		if !strings.Contains(hit.Snippet, searchMarkStart) {
+			// ts_headline falls back to the start of the text when only the title matched.
+			hit.Snippet = ""
+		}
+		out = append(out, hit)
+	}
+	return out, nil
+}
+
+func (g *GormSyncStore) SetIssueCommentText(ctx context.Context, repo string, issueID int64, text string) error {
+	err := g.db.WithContext(ctx).Model(&gormIssue{}).
+		Where("repo = ? AND issue_id = ?", repo, issueID).
+		Update("comments_text", text).Error
+	if err != nil {
+		return fmt.Errorf("gorm set issue comment text: %w", err)
+	}
+	return nil
+}
+
+// websearchQueryText renders the terms and phrases for websearch_to_tsquery, which ANDs its parts.
+// Every part is quoted so that words like "or" and "-flag" are searched rather than read as
+// operators; quotes inside the parts are dropped, as they would end the part early.
+func websearchQueryText(q IssueSearchQuery) string {
+	parts := make([]string, 0, len(q.Terms)+len(q.Phrases))
+	for _, part := range append(append([]string(nil), q.Terms...), q.Phrases...) {
+		if part = strings.TrimSpace(strings.ReplaceAll(part, `"`, "")); part != "" {
+			parts = append(parts, `"`+part+`"`)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (row gormIssue) toSyncedIssue() SyncedIssue {
+	var assignees []string
+	var labels []string
+	_ = json.Unmarshal([]byte(row.AssigneesJSON), &assignees)
+	_ = json.Unmarshal([]byte(row.LabelsJSON), &labels)
+	return SyncedIssue{
+		Repo:          row.Repo,
+		IssueID:       row.IssueID,
+		Number:        row.Number,
+		Title:         row.Title,
+		Body:          row.Body,
+		State:         row.State,
+		Author:        row.Author,
+		Assignees:     assignees,
+		Labels:        labels,
+		Milestone:     row.Milestone,
+		Comments:      row.Comments,
+		IsPullRequest: row.IsPullRequest,
+		HTMLURL:       row.HTMLURL,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+		ClosedAt:      row.ClosedAt,
+		AISummary:     row.AISummary,
+		Raw:           row.Raw,
+		Tombstone:     row.Tombstone,
+		TombstonedAt:  row.TombstonedAt,
+		TransferredTo: row.TransferredTo,
+	}
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (g *GormSyncStore) TombstoneIssues(ctx context.Context, tombstones []IssueTombstone) (int, error) {
+	if len(tombstones) == 0 {
+		return 0, nil
+	}
+
+	tx := g.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return 0, fmt.Errorf("gorm begin tombstone issues: %w", tx.Error)
+	}
+	updated := 0
+	for _, it := range tombstones {
+		at := it.At
+		if at.IsZero() {
+			at = time.Now().UTC()
+		}
+		result := tx.Model(&gormIssue{}).
+			Where("repo = ? AND issue_id = ?", it.Repo, it.IssueID).
+			Updates(map[string]any{
+				"tombstone":      it.Reason,
+				"tombstoned_at":  at,
+				"transferred_to": it.TransferredTo,
+			})
+		if result.Error != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("gorm tombstone issue: %w", result.Error)
+		}
+		updated += int(result.RowsAffected)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, fmt.Errorf("gorm commit tombstone issues: %w", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// gormKeysetColumn is one key of a listing order. expr may be any SQL expression without
+// placeholders.
+type gormKeysetColumn struct {
+	expr string
+	desc bool
+}
+
+// gormKeysetOrder orders query by columns.
+func gormKeysetOrder(query *gorm.DB, columns []gormKeysetColumn) *gorm.DB {
+	for _, column := range columns {
+		if column.desc {
+			query = query.Order(column.expr + " DESC")
+		} else {
+			query = query.Order(column.expr + " ASC")
+		}
+	}
+	return query
+}
+
+// gormKeysetAfter keeps the rows that come after values, the keys of the last row of the previous
+// page, in the order of columns. Orders in one direction compare as a row, which Postgres can
+// serve from a matching index; mixed orders expand into one term per key.
+func gormKeysetAfter(query *gorm.DB, columns []gormKeysetColumn, values []any) *gorm.DB {
+	sameDirection := true
+	exprs := make([]string, 0, len(columns))
+	marks := make([]string, 0, len(columns))
+	for _, column := range columns {
+		sameDirection = sameDirection && column.desc == columns[0].desc
+		exprs = append(exprs, column.expr)
+		marks = append(marks, "?")
+	}
+	if sameDirection {
+		op := " > "
+		if columns[0].desc {
+			op = " < "
+		}
+		return query.Where("("+strings.Join(exprs, ", ")+")"+op+"("+strings.Join(marks, ", ")+")", values...)
+	}
+
+	terms := make([]string, 0, len(columns))
+	args := make([]any, 0, len(columns)*(len(columns)+1)/2)
+	for i, column := range columns {
+		parts := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			parts = append(parts, columns[j].expr+" = ?")
+			args = append(args, values[j])
+		}
+		if column.desc {
+			parts = append(parts, column.expr+" < ?")
+		} else {
+			parts = append(parts, column.expr+" > ?")
+		}
+		args = append(args, values[i])
+		terms = append(terms, "("+strings.Join(parts, " AND ")+")")
+	}
+	return query.Where("("+strings.Join(terms, " OR ")+")", args...)
+}
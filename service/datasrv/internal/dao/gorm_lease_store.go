@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// leaseLockClass is the first key of every lease advisory lock, keeping them apart from locks
+// other applications take on the same database.
+const leaseLockClass = 0x64617461
+
+// gormLease names the holder of a lease. The advisory lock is what makes the holder exclusive; the
+// row only says who it is.
+type gormLease struct {
+	Name       string `gorm:"primaryKey;size:128"`
+	Holder     string `gorm:"size:255"`
+	AcquiredAt time.Time
+	RenewedAt  time.Time
+}
+
+func (gormLease) TableName() string { return "datasrv_leases" }
+
+func leaseLockKey(name string) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return int32(h.Sum32() & 0x7fffffff)
+}
+
+// AcquireLease takes a session-level advisory lock on a connection set aside for the lease. The lock
+// lasts as long as that session rather than ttl: Postgres drops it when the replica or its
+// connection dies.
+func (g *GormSyncStore) AcquireLease(ctx context.Context, name, holder string, _ time.Duration) (bool, error) {
+	g.leaseMu.Lock()
+	defer g.leaseMu.Unlock()
+
+	if conn, ok := g.leases[name]; ok {
+		if err := conn.PingContext(ctx); err == nil {
+			return true, g.saveLeaseHolder(ctx, name, holder, false)
+		}
+		// The session is gone and the lock with it.
+		_ = conn.Close()
+		delete(g.leases, name)
+	}
+
+	sqlDB, err := g.db.DB()
+	if err != nil {
+		return false, fmt.Errorf("gorm acquire lease: %w", err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("gorm acquire lease: %w", err)
+	}
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1, $2)", leaseLockClass, leaseLockKey(name)).Scan(&locked); err != nil {
+		_ = conn.Close()
+		return false, fmt.Errorf("gorm acquire lease: %w", err)
+	}
+	if !locked {
+		_ = conn.Close()
+		return false, nil
+	}
+	if g.leases == nil {
+		g.leases = make(map[string]*sql.Conn)
+	}
+	g.leases[name] = conn
+	return true, g.saveLeaseHolder(ctx, name, holder, true)
+}
+
+func (g *GormSyncStore) saveLeaseHolder(ctx context.Context, name, holder string, acquired bool) error {
+	now := time.Now().UTC()
+	row := gormLease{Name: name, Holder: holder, AcquiredAt: now, RenewedAt: now}
+	updates := []string{"holder", "renewed_at"}
+	if acquired {
+		updates = append(updates, "acquired_at")
+	}
+	if err := g.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns(updates),
+	}).Create(&row).Error; err != nil {
+		return fmt.Errorf("gorm save lease holder: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) ReleaseLease(ctx context.Context, name, _ string) error {
+	g.leaseMu.Lock()
+	defer g.leaseMu.Unlock()
+
+	conn, ok := g.leases[name]
+	if !ok {
+		return nil
+	}
+	delete(g.leases, name)
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1, $2)", leaseLockClass, leaseLockKey(name)); err != nil {
+		return fmt.Errorf("gorm release lease: %w", err)
+	}
+	return nil
+}
+
+// LeaseHolder only reports the recorded holder while some session holds the lock; pg_locks shows a
+// two-key advisory lock with objsubid 2.
+func (g *GormSyncStore) LeaseHolder(ctx context.Context, name string) (string, error) {
+	var holders []string
+	err := g.db.WithContext(ctx).Raw(`SELECT l.holder FROM datasrv_leases l
+WHERE l.name = ? AND EXISTS (
+	SELECT 1 FROM pg_locks p
+	WHERE p.locktype = 'advisory' AND p.classid = ? AND p.objid = ? AND p.objsubid = 2 AND p.granted
+)`, name, leaseLockClass, leaseLockKey(name)).Scan(&holders).Error
+	if err != nil {
+		return "", fmt.Errorf("gorm get lease holder: %w", err)
+	}
+	if len(holders) == 0 {
+		return "", nil
+	}
+	return holders[0], nil
+}
@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormManagedRepoRule struct {
+	Rule      string `gorm:"primaryKey;size:512"`
+	Position  int    `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (gormManagedRepoRule) TableName() string { return "github_sync_repo_rules" }
+
+func (g *GormSyncStore) ListManagedRepoRules(ctx context.Context) ([]string, error) {
+	var rows []gormManagedRepoRule
+	if err := g.db.WithContext(ctx).Order("position ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list managed repo rules: %w", err)
+	}
+
+	out := make([]string, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.Rule)
+	}
+	return out, nil
+}
+
+func (g *GormSyncStore) ReplaceManagedRepoRules(ctx context.Context, rules []string) error {
+	tx := g.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("gorm begin replace managed repo rules: %w", tx.Error)
+	}
+
+	if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&gormManagedRepoRule{}).Error; err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("gorm clear managed repo rules: %w", err)
+	}
+	if len(rules) > 0 {
+		now := time.Now().UTC()
+		rows := make([]gormManagedRepoRule, 0, len(rules))
+		for i, rule := range rules {
+			rows = append(rows, gormManagedRepoRule{Rule: rule, Position: i, CreatedAt: now})
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("gorm insert managed repo rules: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("gorm commit managed repo rules: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) ReplaceRuleManagedRepos(ctx context.Context, repos []ManagedRepo) ([]ManagedRepo, error) {
+	tx := g.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, fmt.Errorf("gorm begin replace rule managed repos: %w", tx.Error)
+	}
+
+	now := time.Now().UTC()
+	if len(repos) > 0 {
+		names := make([]string, 0, len(repos))
+		rows := make([]gormManagedRepo, 0, len(repos))
+		for _, repo := range repos {
+			names = append(names, repo.Repo)
+			rows = append(rows, gormManagedRepo{
+				Repo:      repo.Repo,
+				Rule:      repo.Rule,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "repo"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rule", "updated_at"}),
+		}).Create(&rows).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("gorm upsert rule managed repos: %w", err)
+		}
+
+		if err := tx.Where("repo NOT IN ?", names).Delete(&gormManagedRepo{}).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("gorm delete unmatched managed repos: %w", err)
+		}
+	} else {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&gormManagedRepo{}).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("gorm clear managed repos: %w", err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("gorm commit rule managed repos: %w", err)
+	}
+	return g.ListManagedRepos(ctx)
+}
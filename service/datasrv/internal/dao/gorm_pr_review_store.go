@@ -70,15 +70,14 @@ func (g *GormSyncStore) GetPRReview(ctx context.Context, repo string, number int
 	return toPRReview(row), nil
 }
 
+// gormPRReviewOrder lists reviews by latest update.
+var gormPRReviewOrder = []gormKeysetColumn{{expr: "updated_at", desc: true}, {expr: "issue_id", desc: true}}
+
 func (g *GormSyncStore) ListPRReviews(ctx context.Context, filter PRReviewFilter) ([]PRReview, error) {
-	query := g.db.WithContext(ctx).Model(&gormPRReview{})
-	if filter.Repo != "" {
-		query = query.Where("repo = ?", filter.Repo)
-	}
-	if filter.Number > 0 {
-		query = query.Where("number = ?", filter.Number)
-	}
-	if filter.Offset > 0 {
+	query := g.gormPRReviewQuery(ctx, filter)
+	if filter.After != nil {
+		query = gormKeysetAfter(query, gormPRReviewOrder, []any{filter.After.UpdatedAt, filter.After.IssueID})
+	} else if filter.Offset > 0 {
 		query = query.Offset(filter.Offset)
 	}
 	if filter.Limit > 0 {
@@ -86,7 +85,7 @@ func (g *GormSyncStore) ListPRReviews(ctx context.Context, filter PRReviewFilter
 	}
 
 	var rows []gormPRReview
-	if err := query.Order("updated_at DESC").Find(&rows).Error; err != nil {
+	if err := gormKeysetOrder(query, gormPRReviewOrder).Find(&rows).Error; err != nil {
 		return nil, fmt.Errorf("gorm list pr reviews: %w", err)
 	}
 
@@ -97,6 +96,25 @@ func (g *GormSyncStore) ListPRReviews(ctx context.Context, filter PRReviewFilter
 	return out, nil
 }
 
+func (g *GormSyncStore) CountPRReviews(ctx context.Context, filter PRReviewFilter) (int64, error) {
+	var count int64
+	if err := g.gormPRReviewQuery(ctx, filter).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("gorm count pr reviews: %w", err)
+	}
+	return count, nil
+}
+
+func (g *GormSyncStore) gormPRReviewQuery(ctx context.Context, filter PRReviewFilter) *gorm.DB {
+	query := g.db.WithContext(ctx).Model(&gormPRReview{})
+	if filter.Repo != "" {
+		query = query.Where("repo = ?", filter.Repo)
+	}
+	if filter.Number > 0 {
+		query = query.Where("number = ?", filter.Number)
+	}
+	return query
+}
+
 func (g *GormSyncStore) ListUnreviewedPRs(ctx context.Context, repos []string, limit int) ([]SyncedIssue, error) {
 	if len(repos) == 0 {
 		return nil, nil
@@ -104,6 +122,7 @@ func (g *GormSyncStore) ListUnreviewedPRs(ctx context.Context, repos []string, l
 
 	query := g.db.WithContext(ctx).Model(&gormIssue{}).
 		Where("is_pull_request = ? AND repo IN ?", true, repos).
+		Where("COALESCE(tombstone, '') = ''").
 		Where("NOT EXISTS (SELECT 1 FROM pr_ai_reviews r WHERE r.repo = github_issues.repo AND r.issue_id = github_issues.issue_id)")
 
 	if limit > 0 {
@@ -0,0 +1,127 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type gormPullRequest struct {
+	Repo                   string `gorm:"primaryKey;size:255"`
+	IssueID                int64  `gorm:"primaryKey;index"`
+	Number                 int32  `gorm:"not null"`
+	Merged                 bool   `gorm:"not null"`
+	MergedAt               *time.Time
+	MergedBy               string `gorm:"size:255"`
+	Draft                  bool   `gorm:"not null"`
+	HeadRef                string `gorm:"size:255"`
+	HeadSHA                string `gorm:"size:64"`
+	BaseRef                string `gorm:"size:255"`
+	Additions              int32  `gorm:"not null"`
+	Deletions              int32  `gorm:"not null"`
+	ChangedFiles           int32  `gorm:"not null"`
+	RequestedReviewersJSON string `gorm:"type:text"`
+	RequestedTeamsJSON     string `gorm:"type:text"`
+	ReviewsJSON            string `gorm:"type:text"`
+	ReviewDecision         string `gorm:"size:32;index"`
+	UpdatedAt              time.Time
+	SyncedAt               time.Time
+}
+
+func (gormPullRequest) TableName() string { return "github_pull_requests" }
+
+func (g *GormSyncStore) UpsertPullRequests(ctx context.Context, prs []PullRequestDetail) (int, error) {
+	if len(prs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	rows := make([]gormPullRequest, 0, len(prs))
+	for _, pr := range prs {
+		reviewersJSON, _ := json.Marshal(pr.RequestedReviewers)
+		teamsJSON, _ := json.Marshal(pr.RequestedTeams)
+		reviewsJSON, _ := json.Marshal(pr.Reviews)
+		rows = append(rows, gormPullRequest{
+			Repo:                   pr.Repo,
+			IssueID:                pr.IssueID,
+			Number:                 pr.Number,
+			Merged:                 pr.Merged,
+			MergedAt:               pr.MergedAt,
+			MergedBy:               pr.MergedBy,
+			Draft:                  pr.Draft,
+			HeadRef:                pr.HeadRef,
+			HeadSHA:                pr.HeadSHA,
+			BaseRef:                pr.BaseRef,
+			Additions:              pr.Additions,
+			Deletions:              pr.Deletions,
+			ChangedFiles:           pr.ChangedFiles,
+			RequestedReviewersJSON: string(reviewersJSON),
+			RequestedTeamsJSON:     string(teamsJSON),
+			ReviewsJSON:            string(reviewsJSON),
+			ReviewDecision:         pr.ReviewDecision,
+			UpdatedAt:              pr.UpdatedAt,
+			SyncedAt:               now,
+		})
+	}
+
+	err := g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "repo"}, {Name: "issue_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"number", "merged", "merged_at", "merged_by", "draft", "head_ref", "head_sha", "base_ref",
+				"additions", "deletions", "changed_files", "requested_reviewers_json", "requested_teams_json",
+				"reviews_json", "review_decision", "updated_at", "synced_at",
+			}),
+		}).
+		Create(&rows).Error
+	if err != nil {
+		return 0, fmt.Errorf("gorm upsert pull requests: %w", err)
+	}
+	return len(prs), nil
+}
+
+func (g *GormSyncStore) ListPullRequests(ctx context.Context, issueIDs []int64) ([]PullRequestDetail, error) {
+	if len(issueIDs) == 0 {
+		return nil, nil
+	}
+
+	var rows []gormPullRequest
+	if err := g.db.WithContext(ctx).Where("issue_id IN ?", issueIDs).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list pull requests: %w", err)
+	}
+
+	out := make([]PullRequestDetail, 0, len(rows))
+	for _, row := range rows {
+		var reviewers []string
+		var teams []string
+		var reviews []PullRequestReviewVerdict
+		_ = json.Unmarshal([]byte(row.RequestedReviewersJSON), &reviewers)
+		_ = json.Unmarshal([]byte(row.RequestedTeamsJSON), &teams)
+		_ = json.Unmarshal([]byte(row.ReviewsJSON), &reviews)
+		out = append(out, PullRequestDetail{
+			Repo:               row.Repo,
+			IssueID:            row.IssueID,
+			Number:             row.Number,
+			Merged:             row.Merged,
+			MergedAt:           row.MergedAt,
+			MergedBy:           row.MergedBy,
+			Draft:              row.Draft,
+			HeadRef:            row.HeadRef,
+			HeadSHA:            row.HeadSHA,
+			BaseRef:            row.BaseRef,
+			Additions:          row.Additions,
+			Deletions:          row.Deletions,
+			ChangedFiles:       row.ChangedFiles,
+			RequestedReviewers: reviewers,
+			RequestedTeams:     teams,
+			Reviews:            reviews,
+			ReviewDecision:     row.ReviewDecision,
+			UpdatedAt:          row.UpdatedAt,
+			SyncedAt:           row.SyncedAt,
+		})
+	}
+	return out, nil
+}
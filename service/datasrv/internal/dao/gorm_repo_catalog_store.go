@@ -0,0 +1,209 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type gormRepoLabel struct {
+	Repo        string `gorm:"primaryKey;size:255"`
+	Name        string `gorm:"primaryKey;size:255"`
+	LabelID     int64  `gorm:"not null"`
+	Color       string `gorm:"size:16"`
+	Description string `gorm:"type:text"`
+	SyncedAt    time.Time
+}
+
+func (gormRepoLabel) TableName() string { return "github_repo_labels" }
+
+type gormRepoMilestone struct {
+	Repo        string `gorm:"primaryKey;size:255"`
+	Number      int32  `gorm:"primaryKey"`
+	MilestoneID int64  `gorm:"not null"`
+	Title       string `gorm:"size:512"`
+	Description string `gorm:"type:text"`
+	State       string `gorm:"size:32"`
+	DueOn       *time.Time
+	ClosedAt    *time.Time
+	SyncedAt    time.Time
+}
+
+func (gormRepoMilestone) TableName() string { return "github_repo_milestones" }
+
+func (g *GormSyncStore) ReplaceRepoLabels(ctx context.Context, repo string, labels []RepoLabel) error {
+	tx := g.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("gorm begin replace repo labels: %w", tx.Error)
+	}
+
+	now := time.Now().UTC()
+	names := make([]string, 0, len(labels))
+	if len(labels) > 0 {
+		rows := make([]gormRepoLabel, 0, len(labels))
+		for _, it := range labels {
+			names = append(names, it.Name)
+			rows = append(rows, gormRepoLabel{
+				Repo:        repo,
+				Name:        it.Name,
+				LabelID:     it.LabelID,
+				Color:       it.Color,
+				Description: it.Description,
+				SyncedAt:    now,
+			})
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "repo"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"label_id", "color", "description", "synced_at"}),
+		}).Create(&rows).Error; err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("gorm upsert repo labels: %w", err)
+		}
+	}
+
+	query := tx.Where("repo = ?", repo)
+	if len(names) > 0 {
+		query = query.Where("name NOT IN ?", names)
+	}
+	if err := query.Delete(&gormRepoLabel{}).Error; err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("gorm delete stale repo labels: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("gorm commit repo labels: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) ReplaceRepoMilestones(ctx context.Context, repo string, milestones []RepoMilestone) error {
+	tx := g.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("gorm begin replace repo milestones: %w", tx.Error)
+	}
+
+	now := time.Now().UTC()
+	numbers := make([]int32, 0, len(milestones))
+	if len(milestones) > 0 {
+		rows := make([]gormRepoMilestone, 0, len(milestones))
+		for _, it := range milestones {
+			numbers = append(numbers, it.Number)
+			rows = append(rows, gormRepoMilestone{
+				Repo:        repo,
+				Number:      it.Number,
+				MilestoneID: it.MilestoneID,
+				Title:       it.Title,
+				Description: it.Description,
+				State:       it.State,
+				DueOn:       it.DueOn,
+				ClosedAt:    it.ClosedAt,
+				SyncedAt:    now,
+			})
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "repo"}, {Name: "number"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"milestone_id", "title", "description", "state", "due_on", "closed_at", "synced_at",
+			}),
+		}).Create(&rows).Error; err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("gorm upsert repo milestones: %w", err)
+		}
+	}
+
+	query := tx.Where("repo = ?", repo)
+	if len(numbers) > 0 {
+		query = query.Where("number NOT IN ?", numbers)
+	}
+	if err := query.Delete(&gormRepoMilestone{}).Error; err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("gorm delete stale repo milestones: %w", err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("gorm commit repo milestones: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) ListRepoLabels(ctx context.Context, repo string) ([]RepoLabel, error) {
+	query := g.db.WithContext(ctx).Model(&gormRepoLabel{})
+	if repo != "" {
+		query = query.Where("repo = ?", repo)
+	}
+	var rows []gormRepoLabel
+	if err := query.Order("repo ASC").Order("name ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list repo labels: %w", err)
+	}
+
+	out := make([]RepoLabel, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, RepoLabel{
+			Repo:        row.Repo,
+			LabelID:     row.LabelID,
+			Name:        row.Name,
+			Color:       row.Color,
+			Description: row.Description,
+			SyncedAt:    row.SyncedAt,
+		})
+	}
+	return out, nil
+}
+
+func (g *GormSyncStore) ListRepoMilestones(ctx context.Context, repo string) ([]RepoMilestone, error) {
+	query := g.db.WithContext(ctx).Model(&gormRepoMilestone{})
+	if repo != "" {
+		query = query.Where("repo = ?", repo)
+	}
+	var rows []gormRepoMilestone
+	if err := query.Order("repo ASC").Order("number ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list repo milestones: %w", err)
+	}
+
+	out := make([]RepoMilestone, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, RepoMilestone{
+			Repo:        row.Repo,
+			MilestoneID: row.MilestoneID,
+			Number:      row.Number,
+			Title:       row.Title,
+			Description: row.Description,
+			State:       row.State,
+			DueOn:       row.DueOn,
+			ClosedAt:    row.ClosedAt,
+			SyncedAt:    row.SyncedAt,
+		})
+	}
+	return out, nil
+}
+
+// gormStateCounts are the open and closed counts of the catalog groups.
+const gormStateCounts = "COUNT(*) FILTER (WHERE state = 'open') AS open, " +
+	"COUNT(*) FILTER (WHERE state = 'closed') AS closed"
+
+func (g *GormSyncStore) CountLabelIssues(ctx context.Context, repo string) ([]RepoLabelCount, error) {
+	var rows []RepoLabelCount
+	err := applyGormIssueFilter(g.db.WithContext(ctx).Table("github_issues, jsonb_array_elements_text(labels_lower) AS label(name)"), SyncIssueFilter{Repo: repo}).
+		Select("repo, label.name AS name, " + gormStateCounts).
+		Group("repo, label.name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gorm count label issues: %w", err)
+	}
+	return rows, nil
+}
+
+func (g *GormSyncStore) CountMilestoneIssues(ctx context.Context, repo string) ([]RepoMilestoneCount, error) {
+	var rows []RepoMilestoneCount
+	err := applyGormIssueFilter(g.db.WithContext(ctx).Table("github_issues"), SyncIssueFilter{Repo: repo}).
+		Select("repo, milestone_number AS number, " + gormStateCounts).
+		Where("milestone_number > 0").
+		Group("repo, milestone_number").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gorm count milestone issues: %w", err)
+	}
+	return rows, nil
+}
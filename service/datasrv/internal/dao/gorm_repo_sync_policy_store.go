@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// gormRepoSyncPolicy is embedded into github_sync_repos with a sync_ column prefix.
+type gormRepoSyncPolicy struct {
+	Disabled            bool   `gorm:"not null;default:false"`
+	IntervalSeconds     int    `gorm:"not null;default:0"`
+	State               string `gorm:"size:16"`
+	IncludeLabelsJSON   string `gorm:"type:text"`
+	ExcludeLabelsJSON   string `gorm:"type:text"`
+	ExcludePullRequests bool   `gorm:"not null;default:false"`
+	SkipComments        bool   `gorm:"not null;default:false"`
+	Since               *time.Time
+}
+
+func (p gormRepoSyncPolicy) toRepoSyncPolicy() RepoSyncPolicy {
+	var include, exclude []string
+	_ = json.Unmarshal([]byte(p.IncludeLabelsJSON), &include)
+	_ = json.Unmarshal([]byte(p.ExcludeLabelsJSON), &exclude)
+	return RepoSyncPolicy{
+		Disabled:            p.Disabled,
+		IntervalSeconds:     p.IntervalSeconds,
+		State:               p.State,
+		IncludeLabels:       include,
+		ExcludeLabels:       exclude,
+		ExcludePullRequests: p.ExcludePullRequests,
+		SkipComments:        p.SkipComments,
+		SyncSince:           p.Since,
+	}
+}
+
+func (g *GormSyncStore) UpdateRepoSyncPolicy(ctx context.Context, repo string, policy RepoSyncPolicy) (ManagedRepo, error) {
+	includeJSON, _ := json.Marshal(policy.IncludeLabels)
+	excludeJSON, _ := json.Marshal(policy.ExcludeLabels)
+	result := g.db.WithContext(ctx).Model(&gormManagedRepo{}).Where("repo = ?", repo).Updates(map[string]any{
+		"sync_disabled":              policy.Disabled,
+		"sync_interval_seconds":      policy.IntervalSeconds,
+		"sync_state":                 policy.State,
+		"sync_include_labels_json":   string(includeJSON),
+		"sync_exclude_labels_json":   string(excludeJSON),
+		"sync_exclude_pull_requests": policy.ExcludePullRequests,
+		"sync_skip_comments":         policy.SkipComments,
+		"sync_since":                 policy.SyncSince,
+		"updated_at":                 time.Now().UTC(),
+	})
+	if result.Error != nil {
+		return ManagedRepo{}, fmt.Errorf("gorm update repo sync policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ManagedRepo{}, ErrManagedRepoNotFound
+	}
+
+	var row gormManagedRepo
+	if err := g.db.WithContext(ctx).Where("repo = ?", repo).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ManagedRepo{}, ErrManagedRepoNotFound
+		}
+		return ManagedRepo{}, fmt.Errorf("gorm get managed repo: %w", err)
+	}
+	return ManagedRepo{
+		Repo:      row.Repo,
+		Rule:      row.Rule,
+		Policy:    row.Policy.toRepoSyncPolicy(),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
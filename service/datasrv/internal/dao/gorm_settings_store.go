@@ -0,0 +1,133 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormSettings struct {
+	Section   string `gorm:"primaryKey;size:64"`
+	Version   int64  `gorm:"not null"`
+	Data      string `gorm:"type:text;not null"`
+	UpdatedBy string `gorm:"size:255"`
+	UpdatedAt time.Time
+}
+
+func (gormSettings) TableName() string { return "datasrv_settings" }
+
+type gormSettingsChange struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement"`
+	Section    string `gorm:"size:64;not null;uniqueIndex:idx_datasrv_settings_history_version,priority:1"`
+	Version    int64  `gorm:"not null;uniqueIndex:idx_datasrv_settings_history_version,priority:2"`
+	Data       string `gorm:"type:text;not null"`
+	FieldsJSON string `gorm:"type:text"`
+	ChangedBy  string `gorm:"size:255"`
+	ChangedAt  time.Time
+}
+
+func (gormSettingsChange) TableName() string { return "datasrv_settings_history" }
+
+func (g *GormSyncStore) GetSettings(ctx context.Context, section string) (SettingsDocument, error) {
+	var row gormSettings
+	if err := g.db.WithContext(ctx).Where("section = ?", section).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return SettingsDocument{}, ErrSettingsNotFound
+		}
+		return SettingsDocument{}, fmt.Errorf("gorm get settings: %w", err)
+	}
+	return SettingsDocument{
+		Section:   row.Section,
+		Version:   row.Version,
+		Data:      row.Data,
+		UpdatedBy: row.UpdatedBy,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+func (g *GormSyncStore) SaveSettings(ctx context.Context, doc SettingsDocument, fields []string) (SettingsDocument, error) {
+	fieldsJSON, _ := json.Marshal(fields)
+	saved := doc
+	saved.Version = doc.Version + 1
+	if saved.UpdatedAt.IsZero() {
+		saved.UpdatedAt = time.Now().UTC()
+	}
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if doc.Version == 0 {
+			err := tx.Create(&gormSettings{
+				Section:   saved.Section,
+				Version:   saved.Version,
+				Data:      saved.Data,
+				UpdatedBy: saved.UpdatedBy,
+				UpdatedAt: saved.UpdatedAt,
+			}).Error
+			if err != nil {
+				if isUniqueViolation(err) {
+					return ErrSettingsVersionConflict
+				}
+				return fmt.Errorf("gorm create settings: %w", err)
+			}
+		} else {
+			result := tx.Model(&gormSettings{}).
+				Where("section = ? AND version = ?", doc.Section, doc.Version).
+				Updates(map[string]any{
+					"version":    saved.Version,
+					"data":       saved.Data,
+					"updated_by": saved.UpdatedBy,
+					"updated_at": saved.UpdatedAt,
+				})
+			if result.Error != nil {
+				return fmt.Errorf("gorm update settings: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return ErrSettingsVersionConflict
+			}
+		}
+		err := tx.Create(&gormSettingsChange{
+			Section:    saved.Section,
+			Version:    saved.Version,
+			Data:       saved.Data,
+			FieldsJSON: string(fieldsJSON),
+			ChangedBy:  saved.UpdatedBy,
+			ChangedAt:  saved.UpdatedAt,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("gorm create settings change: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return SettingsDocument{}, err
+	}
+	return saved, nil
+}
+
+func (g *GormSyncStore) ListSettingsChanges(ctx context.Context, section string, limit int) ([]SettingsChange, error) {
+	var rows []gormSettingsChange
+	err := g.db.WithContext(ctx).
+		Where("section = ?", section).
+		Order("version DESC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("gorm list settings changes: %w", err)
+	}
+	out := make([]SettingsChange, 0, len(rows))
+	for _, row := range rows {
+		var fields []string
+		_ = json.Unmarshal([]byte(row.FieldsJSON), &fields)
+		out = append(out, SettingsChange{
+			Section:   row.Section,
+			Version:   row.Version,
+			Data:      row.Data,
+			Fields:    fields,
+			ChangedBy: row.ChangedBy,
+			ChangedAt: row.ChangedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,123 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormSyncRun struct {
+	ID          string    `gorm:"primaryKey;size:32"`
+	JobType     string    `gorm:"size:32;not null;index:idx_github_sync_runs_job_started,priority:1"`
+	Trigger     string    `gorm:"size:16;not null"`
+	Status      string    `gorm:"size:16;not null;index"`
+	StartedAt   time.Time `gorm:"not null;index;index:idx_github_sync_runs_job_started,priority:2"`
+	FinishedAt  time.Time
+	Fetched     int32
+	Persisted   int32
+	Failed      int32
+	Error       string `gorm:"type:text"`
+	TargetsJSON string `gorm:"type:text"`
+}
+
+func (gormSyncRun) TableName() string { return "github_sync_runs" }
+
+func (r gormSyncRun) toSyncRun() SyncRun {
+	var targets []SyncRunTarget
+	_ = json.Unmarshal([]byte(r.TargetsJSON), &targets)
+	return SyncRun{
+		ID:         r.ID,
+		JobType:    r.JobType,
+		Trigger:    r.Trigger,
+		Status:     r.Status,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+		Fetched:    r.Fetched,
+		Persisted:  r.Persisted,
+		Failed:     r.Failed,
+		Err:        r.Error,
+		Targets:    targets,
+	}
+}
+
+func (g *GormSyncStore) SaveSyncRun(ctx context.Context, run SyncRun) error {
+	if run.ID == "" {
+		return fmt.Errorf("sync run id is empty")
+	}
+	targetsJSON, err := json.Marshal(run.Targets)
+	if err != nil {
+		return fmt.Errorf("encode sync run targets: %w", err)
+	}
+	row := gormSyncRun{
+		ID:          run.ID,
+		JobType:     run.JobType,
+		Trigger:     run.Trigger,
+		Status:      run.Status,
+		StartedAt:   run.StartedAt.UTC(),
+		FinishedAt:  run.FinishedAt.UTC(),
+		Fetched:     run.Fetched,
+		Persisted:   run.Persisted,
+		Failed:      run.Failed,
+		Error:       run.Err,
+		TargetsJSON: string(targetsJSON),
+	}
+	if err := g.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return fmt.Errorf("gorm save sync run: %w", err)
+	}
+	return nil
+}
+
+func (g *GormSyncStore) ListSyncRuns(ctx context.Context, filter SyncRunFilter) ([]SyncRun, error) {
+	query := g.db.WithContext(ctx).Model(&gormSyncRun{})
+	if filter.JobType != "" {
+		query = query.Where("job_type = ?", filter.JobType)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if !filter.StartedAfter.IsZero() {
+		query = query.Where("started_at >= ?", filter.StartedAfter.UTC())
+	}
+	if !filter.StartedBefore.IsZero() {
+		query = query.Where("started_at < ?", filter.StartedBefore.UTC())
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var rows []gormSyncRun
+	if err := query.Order("started_at DESC").Order("id ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("gorm list sync runs: %w", err)
+	}
+	out := make([]SyncRun, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, row.toSyncRun())
+	}
+	return out, nil
+}
+
+func (g *GormSyncStore) GetSyncRun(ctx context.Context, id string) (SyncRun, error) {
+	var row gormSyncRun
+	if err := g.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return SyncRun{}, ErrSyncRunNotFound
+		}
+		return SyncRun{}, fmt.Errorf("gorm get sync run: %w", err)
+	}
+	return row.toSyncRun(), nil
+}
+
+func (g *GormSyncStore) PruneSyncRuns(ctx context.Context, before time.Time) (int, error) {
+	result := g.db.WithContext(ctx).Where("started_at < ?", before.UTC()).Delete(&gormSyncRun{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("gorm prune sync runs: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
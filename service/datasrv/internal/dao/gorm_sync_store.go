@@ -2,9 +2,11 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"gorm.io/driver/postgres"
@@ -23,6 +25,7 @@ type gormIssue struct {
 	Author        string    `gorm:"size:255"`
 	AssigneesJSON string    `gorm:"type:text"`
 	LabelsJSON    string    `gorm:"type:text"`
+	Milestone     int32     `gorm:"column:milestone_number;not null;default:0"`
 	Comments      int32     `gorm:"not null"`
 	IsPullRequest bool      `gorm:"not null"`
 	HTMLURL       string    `gorm:"size:1024"`
@@ -31,6 +34,20 @@ type gormIssue struct {
 	ClosedAt      *time.Time
 	AISummary     string `gorm:"type:text"`
 	Raw           string `gorm:"type:text"`
+	Tombstone     string `gorm:"size:32;index"`
+	TombstonedAt  *time.Time
+	TransferredTo string `gorm:"size:255"`
+	// CommentsText is the comment thread flattened for search; see SetIssueCommentText.
+	CommentsText string `gorm:"type:text"`
+	// Responsiveness metrics; see SetIssueResponsiveness.
+	FirstResponseAt        *time.Time
+	FirstResponder         string `gorm:"size:255"`
+	FirstResponseSeconds   *int64
+	CloseSeconds           *int64
+	ReopenCount            int32 `gorm:"not null;default:0"`
+	ResponsivenessSourceAt *time.Time
+	// ResponsivenessGeneration is bumped by InvalidateIssueResponsiveness.
+	ResponsivenessGeneration int64 `gorm:"not null;default:0"`
 }
 
 func (gormIssue) TableName() string { return "github_issues" }
@@ -48,8 +65,10 @@ func (gormCheckpoint) TableName() string { return "github_issue_checkpoints" }
 
 type gormManagedRepo struct {
 	Repo      string `gorm:"primaryKey;size:255"`
+	Rule      string `gorm:"size:512;index"`
 	CreatedAt time.Time
-	UpdatedAt time.Time `gorm:"index"`
+	UpdatedAt time.Time          `gorm:"index"`
+	Policy    gormRepoSyncPolicy `gorm:"embedded;embeddedPrefix:sync_"`
 }
 
 func (gormManagedRepo) TableName() string { return "github_sync_repos" }
@@ -136,6 +155,10 @@ func (gormBlogComment) TableName() string { return "blog_comments" }
 // GormSyncStore stores synced issue data in PostgreSQL via GORM.
 type GormSyncStore struct {
 	db *gorm.DB
+
+	// leases holds the connection whose session owns each advisory lock lease, guarded by leaseMu.
+	leaseMu sync.Mutex
+	leases  map[string]*sql.Conn
 }
 
 func NewGormSyncStore(dsn string) (*GormSyncStore, error) {
@@ -148,7 +171,7 @@ func NewGormSyncStore(dsn string) (*GormSyncStore, error) {
 		return nil, fmt.Errorf("open gorm postgres: %w", err)
 	}
 
-	if err := db.AutoMigrate(&gormIssue{}, &gormCheckpoint{}, &gormManagedRepo{}, &gormFeedSource{}, &gormFeedContent{}, &gormFeedCheckpoint{}, &gormBlogPost{}, &gormBlogComment{}, &gormPRReview{}); err != nil {
+	if err := db.AutoMigrate(&gormIssue{}, &gormCheckpoint{}, &gormManagedRepo{}, &gormFeedSource{}, &gormFeedContent{}, &gormFeedCheckpoint{}, &gormBlogPost{}, &gormBlogComment{}, &gormPRReview{}, &gormWebhookDelivery{}, &gormGitHubETag{}, &gormPullRequest{}, &gormIssueEvent{}, &gormRepoLabel{}, &gormRepoMilestone{}, &gormManagedRepoRule{}, &gormSyncRun{}, &gormCommentCheckpoint{}, &gormIssueCommentThread{}, &gormLease{}, &gormSettings{}, &gormSettingsChange{}); err != nil {
 		return nil, fmt.Errorf("gorm automigrate: %w", err)
 	}
 
@@ -161,6 +184,17 @@ func NewGormSyncStore(dsn string) (*GormSyncStore, error) {
 	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_blog_comments_post_status_created_at ON blog_comments (post_id, status, created_at ASC, id ASC)").Error; err != nil {
 		return nil, fmt.Errorf("create blog comment index: %w", err)
 	}
+	if err := db.Exec(gormIssueSearchVectorDDL).Error; err != nil {
+		return nil, fmt.Errorf("add issue search vector: %w", err)
+	}
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_github_issues_search_vector ON github_issues USING GIN (search_vector)").Error; err != nil {
+		return nil, fmt.Errorf("create issue search index: %w", err)
+	}
+	for _, ddl := range gormIssueFilterDDL {
+		if err := db.Exec(ddl).Error; err != nil {
+			return nil, fmt.Errorf("add issue filter columns: %w", err)
+		}
+	}
 
 	return &GormSyncStore{db: db}, nil
 }
@@ -184,6 +218,7 @@ func (g *GormSyncStore) UpsertIssues(ctx context.Context, repo string, issues []
 			Author:        it.Author,
 			AssigneesJSON: string(assigneesJSON),
 			LabelsJSON:    string(labelsJSON),
+			Milestone:     it.Milestone,
 			Comments:      it.Comments,
 			IsPullRequest: it.IsPullRequest,
 			HTMLURL:       it.HTMLURL,
@@ -200,7 +235,8 @@ func (g *GormSyncStore) UpsertIssues(ctx context.Context, repo string, issues []
 			Columns: []clause.Column{{Name: "repo"}, {Name: "issue_id"}},
 			DoUpdates: clause.AssignmentColumns([]string{
 				"number", "title", "body", "state", "author", "assignees_json", "labels_json",
-				"comments", "is_pull_request", "html_url", "created_at", "updated_at", "closed_at", "raw",
+				"milestone_number", "comments", "is_pull_request", "html_url", "created_at", "updated_at", "closed_at", "raw",
+				"tombstone", "tombstoned_at", "transferred_to",
 			}),
 		}).
 		Create(&rows).Error
@@ -221,6 +257,8 @@ func (g *GormSyncStore) ListManagedRepos(ctx context.Context) ([]ManagedRepo, er
 	for _, row := range rows {
 		out = append(out, ManagedRepo{
 			Repo:      row.Repo,
+			Rule:      row.Rule,
+			Policy:    row.Policy.toRepoSyncPolicy(),
 			CreatedAt: row.CreatedAt,
 			UpdatedAt: row.UpdatedAt,
 		})
@@ -276,20 +314,11 @@ func (g *GormSyncStore) ReplaceManagedRepos(ctx context.Context, repos []string)
 }
 
 func (g *GormSyncStore) ListIssues(ctx context.Context, filter SyncIssueFilter) ([]SyncedIssue, error) {
-	query := g.db.WithContext(ctx).Model(&gormIssue{})
-	if filter.Repo != "" {
-		query = query.Where("repo = ?", filter.Repo)
-	}
-	if filter.State != "" && filter.State != "all" {
-		query = query.Where("state = ?", filter.State)
-	}
-	if filter.IssueID > 0 {
-		query = query.Where("issue_id = ?", filter.IssueID)
-	}
-	if filter.Number > 0 {
-		query = query.Where("number = ?", filter.Number)
-	}
-	if filter.Offset > 0 {
+	query := applyGormIssueFilter(g.db.WithContext(ctx).Model(&gormIssue{}), filter)
+	order := gormIssueOrder(filter)
+	if filter.After != nil {
+		query = gormKeysetAfter(query, order, gormIssueCursorValues(filter.Sort, *filter.After))
+	} else if filter.Offset > 0 {
 		query = query.Offset(filter.Offset)
 	}
 	if filter.Limit > 0 {
@@ -297,42 +326,25 @@ func (g *GormSyncStore) ListIssues(ctx context.Context, filter SyncIssueFilter)
 	}
 
 	var rows []gormIssue
-	if err := query.
-		Order("CASE WHEN ai_summary IS NOT NULL AND ai_summary <> '' THEN 0 ELSE 1 END ASC").
-		Order("updated_at DESC").
-		Find(&rows).Error; err != nil {
+	if err := gormKeysetOrder(query, order).Find(&rows).Error; err != nil {
 		return nil, fmt.Errorf("gorm list issues: %w", err)
 	}
 
 	out := make([]SyncedIssue, 0, len(rows))
 	for _, row := range rows {
-		var assignees []string
-		var labels []string
-		_ = json.Unmarshal([]byte(row.AssigneesJSON), &assignees)
-		_ = json.Unmarshal([]byte(row.LabelsJSON), &labels)
-		out = append(out, SyncedIssue{
-			Repo:          row.Repo,
-			IssueID:       row.IssueID,
-			Number:        row.Number,
-			Title:         row.Title,
-			Body:          row.Body,
-			State:         row.State,
-			Author:        row.Author,
-			Assignees:     assignees,
-			Labels:        labels,
-			Comments:      row.Comments,
-			IsPullRequest: row.IsPullRequest,
-			HTMLURL:       row.HTMLURL,
-			CreatedAt:     row.CreatedAt,
-			UpdatedAt:     row.UpdatedAt,
-			ClosedAt:      row.ClosedAt,
-			AISummary:     row.AISummary,
-			Raw:           row.Raw,
-		})
+		out = append(out, row.toSyncedIssue())
 	}
 	return out, nil
 }
 
+func (g *GormSyncStore) CountIssues(ctx context.Context, filter SyncIssueFilter) (int64, error) {
+	var count int64
+	if err := applyGormIssueFilter(g.db.WithContext(ctx).Model(&gormIssue{}), filter).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("gorm count issues: %w", err)
+	}
+	return count, nil
+}
+
 func (g *GormSyncStore) UpdateIssueAISummary(ctx context.Context, repo string, issueID int64, number int32, summary string) (SyncedIssue, error) {
 	query := g.db.WithContext(ctx).Model(&gormIssue{}).Where("repo = ?", repo)
 	switch {
@@ -352,7 +364,7 @@ func (g *GormSyncStore) UpdateIssueAISummary(ctx context.Context, repo string, i
 		return SyncedIssue{}, ErrIssueNotFound
 	}
 
-	rows, err := g.ListIssues(ctx, SyncIssueFilter{Repo: repo, IssueID: issueID, Number: number, Limit: 1})
+	rows, err := g.ListIssues(ctx, SyncIssueFilter{Repo: repo, IssueID: issueID, Number: number, Limit: 1, IncludeTombstoned: true})
 	if err != nil {
 		return SyncedIssue{}, err
 	}
@@ -573,23 +585,17 @@ func (g *GormSyncStore) DeleteFeedSource(ctx context.Context, id string) error {
 	return nil
 }
 
+// gormBlogPostOrder lists blog posts newest first.
+var gormBlogPostOrder = []gormKeysetColumn{{expr: "published_at", desc: true}, {expr: "created_at", desc: true}, {expr: "id"}}
+
 func (g *GormSyncStore) ListBlogPosts(ctx context.Context, filter BlogPostFilter) ([]BlogPost, error) {
-	query := g.db.WithContext(ctx).Model(&gormBlogPost{})
-	if filter.Status != "" {
-		query = query.Where("status = ?", filter.Status)
-	}
-	if filter.Tag != "" {
-		tagPattern, err := json.Marshal(filter.Tag)
-		if err != nil {
-			return nil, fmt.Errorf("marshal blog tag: %w", err)
-		}
-		query = query.Where("tags_json LIKE ?", "%"+strings.Trim(string(tagPattern), "\"")+"%")
-	}
-	if queryText := strings.TrimSpace(filter.Query); queryText != "" {
-		like := "%" + queryText + "%"
-		query = query.Where("title ILIKE ? OR slug ILIKE ? OR summary ILIKE ? OR content ILIKE ?", like, like, like, like)
+	query, err := g.gormBlogPostQuery(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
-	if filter.Offset > 0 {
+	if filter.After != nil {
+		query = gormKeysetAfter(query, gormBlogPostOrder, []any{filter.After.PublishedAt, filter.After.CreatedAt, filter.After.ID})
+	} else if filter.Offset > 0 {
 		query = query.Offset(filter.Offset)
 	}
 	if filter.Limit > 0 {
@@ -597,7 +603,7 @@ func (g *GormSyncStore) ListBlogPosts(ctx context.Context, filter BlogPostFilter
 	}
 
 	var rows []gormBlogPost
-	if err := query.Order("published_at DESC").Order("created_at DESC").Order("id ASC").Find(&rows).Error; err != nil {
+	if err := gormKeysetOrder(query, gormBlogPostOrder).Find(&rows).Error; err != nil {
 		return nil, fmt.Errorf("gorm list blog posts: %w", err)
 	}
 
@@ -608,6 +614,37 @@ func (g *GormSyncStore) ListBlogPosts(ctx context.Context, filter BlogPostFilter
 	return out, nil
 }
 
+func (g *GormSyncStore) CountBlogPosts(ctx context.Context, filter BlogPostFilter) (int64, error) {
+	query, err := g.gormBlogPostQuery(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("gorm count blog posts: %w", err)
+	}
+	return count, nil
+}
+
+func (g *GormSyncStore) gormBlogPostQuery(ctx context.Context, filter BlogPostFilter) (*gorm.DB, error) {
+	query := g.db.WithContext(ctx).Model(&gormBlogPost{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Tag != "" {
+		tagPattern, err := json.Marshal(filter.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("marshal blog tag: %w", err)
+		}
+		query = query.Where("tags_json LIKE ?", "%"+strings.Trim(string(tagPattern), "\"")+"%")
+	}
+	if queryText := strings.TrimSpace(filter.Query); queryText != "" {
+		like := "%" + queryText + "%"
+		query = query.Where("title ILIKE ? OR slug ILIKE ? OR summary ILIKE ? OR content ILIKE ?", like, like, like, like)
+	}
+	return query, nil
+}
+
 func (g *GormSyncStore) GetBlogPost(ctx context.Context, id string) (BlogPost, error) {
 	var row gormBlogPost
 	if err := g.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
@@ -955,15 +992,14 @@ func (g *GormSyncStore) UpsertFeedContents(ctx context.Context, sourceID string,
 	return len(contents), nil
 }
 
+// gormFeedContentOrder lists feed contents newest first.
+var gormFeedContentOrder = []gormKeysetColumn{{expr: "published_at", desc: true}, {expr: "id"}}
+
 func (g *GormSyncStore) ListFeedContents(ctx context.Context, filter FeedContentFilter) ([]FeedContent, error) {
-	query := g.db.WithContext(ctx).Model(&gormFeedContent{}).Order("published_at DESC").Order("id ASC")
-	if filter.FeedSourceID != "" {
-		query = query.Where("feed_source_id = ?", filter.FeedSourceID)
-	}
-	if filter.ContentID != "" {
-		query = query.Where("id = ?", filter.ContentID)
-	}
-	if filter.Offset > 0 {
+	query := gormKeysetOrder(g.gormFeedContentQuery(ctx, filter), gormFeedContentOrder)
+	if filter.After != nil {
+		query = gormKeysetAfter(query, gormFeedContentOrder, []any{filter.After.PublishedAt, filter.After.ID})
+	} else if filter.Offset > 0 {
 		query = query.Offset(filter.Offset)
 	}
 	if filter.Limit > 0 {
@@ -996,6 +1032,25 @@ func (g *GormSyncStore) ListFeedContents(ctx context.Context, filter FeedContent
 	return out, nil
 }
 
+func (g *GormSyncStore) CountFeedContents(ctx context.Context, filter FeedContentFilter) (int64, error) {
+	var count int64
+	if err := g.gormFeedContentQuery(ctx, filter).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("gorm count feed contents: %w", err)
+	}
+	return count, nil
+}
+
+func (g *GormSyncStore) gormFeedContentQuery(ctx context.Context, filter FeedContentFilter) *gorm.DB {
+	query := g.db.WithContext(ctx).Model(&gormFeedContent{})
+	if filter.FeedSourceID != "" {
+		query = query.Where("feed_source_id = ?", filter.FeedSourceID)
+	}
+	if filter.ContentID != "" {
+		query = query.Where("id = ?", filter.ContentID)
+	}
+	return query
+}
+
 func (g *GormSyncStore) GetFeedContent(ctx context.Context, id string) (FeedContent, error) {
 	rows, err := g.ListFeedContents(ctx, FeedContentFilter{ContentID: id, Limit: 1})
 	if err != nil {
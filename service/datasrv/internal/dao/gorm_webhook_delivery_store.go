@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type gormWebhookDelivery struct {
+	DeliveryID string    `gorm:"primaryKey;size:64"`
+	Event      string    `gorm:"size:64;not null"`
+	Action     string    `gorm:"size:64"`
+	Repo       string    `gorm:"size:255;index"`
+	ReceivedAt time.Time `gorm:"index"`
+}
+
+func (gormWebhookDelivery) TableName() string { return "github_webhook_deliveries" }
+
+func (g *GormSyncStore) HasWebhookDelivery(ctx context.Context, deliveryID string) (bool, error) {
+	var count int64
+	err := g.db.WithContext(ctx).Model(&gormWebhookDelivery{}).
+		Where("delivery_id = ?", deliveryID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("gorm get webhook delivery: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (g *GormSyncStore) SaveWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error {
+	if delivery.DeliveryID == "" {
+		return fmt.Errorf("webhook delivery id is empty")
+	}
+	if delivery.ReceivedAt.IsZero() {
+		delivery.ReceivedAt = time.Now().UTC()
+	}
+	row := gormWebhookDelivery{
+		DeliveryID: delivery.DeliveryID,
+		Event:      delivery.Event,
+		Action:     delivery.Action,
+		Repo:       delivery.Repo,
+		ReceivedAt: delivery.ReceivedAt,
+	}
+	err := g.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "delivery_id"}},
+			DoNothing: true,
+		}).
+		Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("gorm save webhook delivery: %w", err)
+	}
+	return nil
+}
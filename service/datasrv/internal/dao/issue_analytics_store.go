@@ -0,0 +1,123 @@
+package dao
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// IssueAnalyticsFilter limits issue analytics to some repositories and a time range. Tombstoned
+// issues are never counted.
+type IssueAnalyticsFilter struct {
+	// Repos lists the owner/repo names to count; empty means all repositories.
+	Repos []string
+	// From and To bound the creation time of the counted issues, From inclusive and To exclusive.
+	// Zero leaves that side open.
+	From time.Time
+	To   time.Time
+}
+
+// IssueTotals sums up the issues matching an IssueAnalyticsFilter.
+type IssueTotals struct {
+	Total         int64
+	Open          int64
+	Closed        int64
+	PullRequests  int64
+	WithAISummary int64
+	Comments      int64
+	Repos         int64
+	// LatestCreatedAt and LatestUpdatedAt are zero when nothing matched.
+	LatestCreatedAt time.Time
+	LatestUpdatedAt time.Time
+}
+
+// IssueInterval is the bucket width of an issue time series. Weeks start on Monday, and both
+// intervals are aligned in UTC.
+type IssueInterval string
+
+const (
+	IssueIntervalDay  IssueInterval = "day"
+	IssueIntervalWeek IssueInterval = "week"
+)
+
+// IssueActivityBucket counts the issues opened and closed in the interval starting at Start.
+type IssueActivityBucket struct {
+	Start  time.Time
+	Opened int64
+	Closed int64
+}
+
+// IssueDimension is what an issue breakdown groups by.
+type IssueDimension string
+
+const (
+	IssueDimensionLabel    IssueDimension = "label"
+	IssueDimensionAssignee IssueDimension = "assignee"
+	IssueDimensionAuthor   IssueDimension = "author"
+	// IssueDimensionType splits pull requests from issues, under the keys "pull_request" and
+	// "issue".
+	IssueDimensionType IssueDimension = "type"
+	IssueDimensionRepo IssueDimension = "repo"
+)
+
+const (
+	IssueTypeIssue       = "issue"
+	IssueTypePullRequest = "pull_request"
+)
+
+// IssueGroupCount counts the issues of one value of an IssueDimension.
+type IssueGroupCount struct {
+	Key          string
+	Total        int64
+	Open         int64
+	Closed       int64
+	PullRequests int64
+	Comments     int64
+}
+
+// IssueAnalyticsStore aggregates issues inside the store, so that analytics don't need to load
+// them.
+type IssueAnalyticsStore interface {
+	IssueTotals(ctx context.Context, filter IssueAnalyticsFilter) (IssueTotals, error)
+	// IssueActivity counts the issues opened in the range of filter, and those closed in it, per
+	// interval. Buckets without activity are left out; the others come oldest first.
+	IssueActivity(ctx context.Context, filter IssueAnalyticsFilter, interval IssueInterval) ([]IssueActivityBucket, error)
+	// OpenIssuesAt counts the issues of filter's repos that were created before at and not closed
+	// by then. The range of filter is ignored.
+	OpenIssuesAt(ctx context.Context, filter IssueAnalyticsFilter, at time.Time) (int64, error)
+	// IssueBreakdown returns the limit largest groups of dimension, largest first. Issues count
+	// once for each of their labels or assignees, and not at all when they have none.
+	IssueBreakdown(ctx context.Context, filter IssueAnalyticsFilter, dimension IssueDimension, limit int) ([]IssueGroupCount, error)
+}
+
+// issueFilter returns the range of f as a SyncIssueFilter on creation time, for stores that share
+// the ListIssues conditions. Repos are left to the caller as SyncIssueFilter has a single one.
+func (f IssueAnalyticsFilter) issueFilter() SyncIssueFilter {
+	return SyncIssueFilter{CreatedAfter: f.From, CreatedBefore: f.To}
+}
+
+// mergeIssueActivity combines the opened and closed counts per bucket start, oldest first.
+func mergeIssueActivity(opened, closed map[time.Time]int64) []IssueActivityBucket {
+	buckets := make(map[time.Time]*IssueActivityBucket, len(opened))
+	bucket := func(start time.Time) *IssueActivityBucket {
+		start = start.UTC()
+		if b, ok := buckets[start]; ok {
+			return b
+		}
+		b := &IssueActivityBucket{Start: start}
+		buckets[start] = b
+		return b
+	}
+	for start, n := range opened {
+		bucket(start).Opened += n
+	}
+	for start, n := range closed {
+		bucket(start).Closed += n
+	}
+	out := make([]IssueActivityBucket, 0, len(buckets))
+	for _, b := range buckets {
+		out = append(out, *b)
+	}
+	slices.SortFunc(out, func(a, b IssueActivityBucket) int { return a.Start.Compare(b.Start) })
+	return out
+}
@@ -0,0 +1,17 @@
+package dao
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrIssueCommentsNotFound is returned for an issue whose comment thread was never stored.
+var ErrIssueCommentsNotFound = errors.New("issue comments not found")
+
+// IssueCommentThreadStore keeps the full comment thread of each issue in the primary database, as an
+// alternative to object storage.
+type IssueCommentThreadStore interface {
+	// SaveIssueComments replaces the stored thread of an issue.
+	SaveIssueComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []IssueComment) error
+	LoadIssueComments(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueComment, error)
+}
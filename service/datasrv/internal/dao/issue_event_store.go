@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// Timeline event types kept by the issue sync; GitHub reports many more.
+const (
+	IssueEventLabeled         = "labeled"
+	IssueEventUnlabeled       = "unlabeled"
+	IssueEventAssigned        = "assigned"
+	IssueEventUnassigned      = "unassigned"
+	IssueEventClosed          = "closed"
+	IssueEventReopened        = "reopened"
+	IssueEventReferenced      = "referenced"
+	IssueEventCrossReferenced = "cross-referenced"
+	IssueEventRenamed         = "renamed"
+	IssueEventMilestoned      = "milestoned"
+	IssueEventDemilestoned    = "demilestoned"
+)
+
+// IssueEvent is one entry of an issue timeline.
+type IssueEvent struct {
+	Repo        string
+	IssueID     int64
+	IssueNumber int32
+	// Key identifies the event within its issue. It is the GitHub event id, or a key derived from the
+	// source and time for cross-referenced events, which GitHub returns without an id.
+	Key       string
+	Event     string
+	Actor     string
+	CreatedAt time.Time
+
+	// Only the fields that belong to Event are set.
+	Label      string
+	Assignee   string
+	Milestone  string
+	RenameFrom string
+	RenameTo   string
+	CommitID   string
+	// Source is the referencing issue of a cross-referenced event in owner/repo#number format.
+	Source string
+}
+
+type IssueEventFilter struct {
+	Repo        string
+	IssueID     int64
+	IssueNumber int32
+	// Events limits the result to these event types; empty means all.
+	Events []string
+	Offset int
+	Limit  int
+}
+
+// IssueEventStore persists issue timelines. Events are returned oldest first.
+type IssueEventStore interface {
+	UpsertIssueEvents(ctx context.Context, events []IssueEvent) (int, error)
+	ListIssueEvents(ctx context.Context, filter IssueEventFilter) ([]IssueEvent, error)
+}
@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// IssueDimensionMonth groups issues by the UTC month they were created in, as "2006-01".
+const IssueDimensionMonth IssueDimension = "month"
+
+// IssueResponsiveness holds the response metrics of one issue, computed from its comments and
+// timeline.
+type IssueResponsiveness struct {
+	// FirstResponseAt is the first comment of a maintainer other than the author. It stays nil
+	// until then, and for issues opened by maintainers.
+	FirstResponseAt *time.Time
+	FirstResponder  string
+	// FirstResponseSeconds and CloseSeconds count from the creation of the issue. CloseSeconds is
+	// nil while the issue is open.
+	FirstResponseSeconds *int64
+	CloseSeconds         *int64
+	ReopenCount          int32
+	// SourceUpdatedAt is the UpdatedAt of the issue the metrics were computed for. Issues updated
+	// since are computed again.
+	SourceUpdatedAt time.Time
+	// Generation is the StaleIssue.Generation the metrics were computed from.
+	Generation int64
+}
+
+// StaleIssue is an issue whose responsiveness metrics need computing.
+type StaleIssue struct {
+	Issue SyncedIssue
+	// Generation counts the invalidations of the metrics of the issue. Metrics computed from an
+	// older generation are not stored.
+	Generation int64
+}
+
+// IssueResponsivenessStats aggregates the responsiveness of a group of issues. The percentiles are
+// in seconds and 0 when no issue of the group has the metric.
+type IssueResponsivenessStats struct {
+	Key    string
+	Issues int64
+	// Responded counts the issues with a first response.
+	Responded        int64
+	FirstResponseP50 float64
+	FirstResponseP90 float64
+	Closed           int64
+	CloseP50         float64
+	CloseP90         float64
+	// Reopened counts the issues reopened at least once, and Reopens all their reopenings.
+	Reopened int64
+	Reopens  int64
+}
+
+// IssueResponsivenessStore keeps the responsiveness metrics on the issues and aggregates them.
+type IssueResponsivenessStore interface {
+	// ListIssuesWithStaleResponsiveness returns up to limit live issues whose metrics are missing
+	// or older than their last update, ordered by UpdatedAt then IssueID and starting after
+	// cursor when set. The cursor keys are UpdatedAt and IssueID.
+	ListIssuesWithStaleResponsiveness(ctx context.Context, after *IssueCursor, limit int) ([]StaleIssue, error)
+	// SetIssueResponsiveness stores metrics unless the issue was updated or invalidated since they
+	// were computed, and reports whether it did. Skipped issues stay stale.
+	SetIssueResponsiveness(ctx context.Context, repo string, issueID int64, metrics IssueResponsiveness) (bool, error)
+	// InvalidateIssueResponsiveness marks the metrics of issues as stale and bumps their generation,
+	// for changes that do not move UpdatedAt, such as comment threads stored after their issue.
+	InvalidateIssueResponsiveness(ctx context.Context, repo string, issueIDs []int64) error
+	// IssueResponsivenessStats aggregates the metrics of the issues created in the range of filter
+	// by repo, label or month, groups in key order. Issues count once for each of their labels.
+	IssueResponsivenessStats(ctx context.Context, filter IssueAnalyticsFilter, groupBy IssueDimension) ([]IssueResponsivenessStats, error)
+}
@@ -0,0 +1,185 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IssueSearchQuery is a parsed issue search. Terms and Phrases are matched against the title,
+// body, AI summary and comments; the other fields filter like SyncIssueFilter.
+type IssueSearchQuery struct {
+	// Terms must all match, in any form the store's stemming accepts.
+	Terms []string
+	// Phrases must match as consecutive words.
+	Phrases []string
+
+	Repo     string
+	State    string
+	Author   string
+	Assignee string
+	// Labels must all be set on the issue. Label names match case-insensitively.
+	Labels []string
+	// IsPullRequest limits the hits to pull requests or to issues when set.
+	IsPullRequest *bool
+
+	IncludeTombstoned bool
+	Offset            int
+	Limit             int
+}
+
+// HasText reports whether the query searches text rather than only filtering.
+func (q IssueSearchQuery) HasText() bool {
+	return len(q.Terms) > 0 || len(q.Phrases) > 0
+}
+
+// filter returns the filters of q, for stores that share them with ListIssues.
+func (q IssueSearchQuery) filter() SyncIssueFilter {
+	return SyncIssueFilter{
+		Repo:              q.Repo,
+		State:             q.State,
+		Author:            q.Author,
+		Assignee:          q.Assignee,
+		Labels:            q.Labels,
+		IsPullRequest:     q.IsPullRequest,
+		IncludeTombstoned: q.IncludeTombstoned,
+	}
+}
+
+// IssueSearchHit is an issue matched by a search, best hits first.
+type IssueSearchHit struct {
+	Issue SyncedIssue
+	// Score ranks the hit; 0 for queries without text.
+	Score float64
+	// TitleHighlight is the title with the matches wrapped in <mark> tags.
+	TitleHighlight string
+	// Snippet holds the best matching passages of the AI summary, body and comments.
+	Snippet string
+}
+
+// IssueSearchStore searches the synced issues. Comments are searched through the text stored
+// with SetIssueCommentText, as the threads themselves may live outside the primary store.
+type IssueSearchStore interface {
+	SearchIssues(ctx context.Context, query IssueSearchQuery) ([]IssueSearchHit, error)
+	SetIssueCommentText(ctx context.Context, repo string, issueID int64, text string) error
+}
+
+const (
+	searchMarkStart = "<mark>"
+	searchMarkEnd   = "</mark>"
+	// searchSnippetRunes bounds a snippet built by highlightSnippet.
+	searchSnippetRunes = 240
+)
+
+// searchWords returns the lower-cased words of the terms and phrases, for stores that highlight
+// the matches themselves.
+func searchWords(q IssueSearchQuery) []string {
+	words := make([]string, 0, len(q.Terms))
+	for _, part := range append(append([]string(nil), q.Terms...), q.Phrases...) {
+		for _, word := range strings.FieldsFunc(strings.ToLower(part), isNotSearchWordRune) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func isNotSearchWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// highlightWords wraps the words of text that start with one of words in mark tags. Matching on
+// prefixes lets "crash" mark "crashes", which the text index matched through stemming.
+func highlightWords(text string, words []string) (string, bool) {
+	if text == "" || len(words) == 0 {
+		return text, false
+	}
+	var b strings.Builder
+	matched := false
+	start := -1
+	flush := func(end int) {
+		word := text[start:end]
+		lower := strings.ToLower(word)
+		for _, w := range words {
+			if strings.HasPrefix(lower, w) {
+				b.WriteString(searchMarkStart + word + searchMarkEnd)
+				matched = true
+				return
+			}
+		}
+		b.WriteString(word)
+	}
+	for i, r := range text {
+		if isNotSearchWordRune(r) {
+			if start >= 0 {
+				flush(i)
+				start = -1
+			}
+			b.WriteRune(r)
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		flush(len(text))
+	}
+	return b.String(), matched
+}
+
+// highlightSnippet returns a passage around the first match in the first of texts that has one,
+// with the matches marked.
+func highlightSnippet(texts []string, words []string) string {
+	for _, text := range texts {
+		lower := strings.ToLower(text)
+		at := -1
+		for _, w := range words {
+			if i := strings.Index(lower, w); i >= 0 && (at < 0 || i < at) {
+				at = i
+			}
+		}
+		if at < 0 {
+			continue
+		}
+		passage := snippetAround(text, at)
+		marked, _ := highlightWords(passage, words)
+		return marked
+	}
+	return ""
+}
+
+// snippetAround cuts about searchSnippetRunes runes of text around the byte offset at, on word
+// boundaries, with ellipses where text was cut.
+func snippetAround(text string, at int) string {
+	// at comes from the lower-cased text, which may be shorter or longer for some scripts.
+	at = min(at, len(text))
+	start := at
+	for back := 0; start > 0 && back < searchSnippetRunes/3; back++ {
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+	}
+	end := at
+	for n := 0; end < len(text) && n < searchSnippetRunes*2/3; n++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+	if start > 0 {
+		if i := strings.IndexAny(text[start:at], " \n\t"); i >= 0 {
+			start += i + 1
+		}
+	}
+	if end < len(text) {
+		if i := strings.LastIndexAny(text[at:end], " \n\t"); i > 0 {
+			end = at + i
+		}
+	}
+	passage := strings.Join(strings.Fields(text[start:end]), " ")
+	if start > 0 {
+		passage = "… " + passage
+	}
+	if end < len(text) {
+		passage += " …"
+	}
+	return passage
+}
@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// IssueTombstone records why a synced issue no longer exists in its repository.
+type IssueTombstone struct {
+	Repo    string
+	IssueID int64
+	// Reason is one of the Tombstone* constants.
+	Reason        string
+	TransferredTo string
+	At            time.Time
+}
+
+// IssueTombstoneStore hides issues that reconciliation found gone from GitHub.
+type IssueTombstoneStore interface {
+	// TombstoneIssues marks the given issues and returns how many rows were updated.
+	// A later upsert of the same issue clears its tombstone.
+	TombstoneIssues(ctx context.Context, tombstones []IssueTombstone) (int, error)
+}
@@ -0,0 +1,18 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// LeaseStore is implemented by stores that hand out named leases, so that replicas sharing the
+// store can agree on one of them to run a job.
+type LeaseStore interface {
+	// AcquireLease takes the lease for holder, or renews it when holder already has it, and reports
+	// whether holder owns it afterwards. A lease that is not renewed within ttl may be taken over.
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	// ReleaseLease gives the lease up when holder owns it.
+	ReleaseLease(ctx context.Context, name, holder string) error
+	// LeaseHolder returns the current holder of the lease, or "" when nobody holds it.
+	LeaseHolder(ctx context.Context, name string) (string, error)
+}
@@ -0,0 +1,14 @@
+package dao
+
+import "context"
+
+// ManagedRepoRuleStore keeps the managed repo rules next to the repos they expand to. A rule is
+// either an explicit owner/repo or a pattern such as owner/service-* or owner?topic=backend that
+// repo discovery expands through the GitHub API.
+type ManagedRepoRuleStore interface {
+	// ListManagedRepoRules returns rules in the order they were given.
+	ListManagedRepoRules(ctx context.Context) ([]string, error)
+	ReplaceManagedRepoRules(ctx context.Context, rules []string) error
+	// ReplaceRuleManagedRepos replaces the managed repo set and tags each row with its Rule.
+	ReplaceRuleManagedRepos(ctx context.Context, repos []ManagedRepo) ([]ManagedRepo, error)
+}
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoCommentCheckpointDoc struct {
+	Repo                 string    `bson:"repo"`
+	LastCommentUpdatedAt time.Time `bson:"last_comment_updated_at"`
+	LastSyncedAt         time.Time `bson:"last_synced_at"`
+	LastError            string    `bson:"last_error"`
+	UpdatedAt            time.Time `bson:"updated_at"`
+}
+
+func (m *MongoSyncStore) GetCommentCheckpoint(ctx context.Context, repo string) (CommentCheckpoint, error) {
+	var doc mongoCommentCheckpointDoc
+	if err := m.commentCursorC.FindOne(ctx, bson.M{"repo": repo}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return CommentCheckpoint{Repo: repo}, nil
+		}
+		return CommentCheckpoint{}, fmt.Errorf("get comment checkpoint: %w", err)
+	}
+	return CommentCheckpoint{
+		Repo:                 doc.Repo,
+		LastCommentUpdatedAt: doc.LastCommentUpdatedAt,
+		LastSyncedAt:         doc.LastSyncedAt,
+		LastError:            doc.LastError,
+		UpdatedAt:            doc.UpdatedAt,
+	}, nil
+}
+
+func (m *MongoSyncStore) SaveCommentCheckpoint(ctx context.Context, cp CommentCheckpoint) error {
+	_, err := m.commentCursorC.UpdateOne(ctx,
+		bson.M{"repo": cp.Repo},
+		bson.M{"$set": mongoCommentCheckpointDoc{
+			Repo:                 cp.Repo,
+			LastCommentUpdatedAt: cp.LastCommentUpdatedAt.UTC(),
+			LastSyncedAt:         cp.LastSyncedAt.UTC(),
+			LastError:            cp.LastError,
+			UpdatedAt:            time.Now(),
+		}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("save comment checkpoint: %w", err)
+	}
+	return nil
+}
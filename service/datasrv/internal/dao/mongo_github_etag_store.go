@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoGitHubETagDoc struct {
+	Repo         string    `bson:"repo"`
+	Endpoint     string    `bson:"endpoint"`
+	Page         int       `bson:"page"`
+	ETag         string    `bson:"etag"`
+	LastModified string    `bson:"last_modified"`
+	NextPage     int       `bson:"next_page"`
+	UpdatedAt    time.Time `bson:"updated_at"`
+}
+
+func (m *MongoSyncStore) GetGitHubETag(ctx context.Context, repo, endpoint string, page int) (GitHubETag, error) {
+	var doc mongoGitHubETagDoc
+	err := m.githubETagC.FindOne(ctx, bson.M{"repo": repo, "endpoint": endpoint, "page": page}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return GitHubETag{Repo: repo, Endpoint: endpoint, Page: page}, nil
+		}
+		return GitHubETag{}, fmt.Errorf("get github etag: %w", err)
+	}
+	return GitHubETag{
+		Repo:         doc.Repo,
+		Endpoint:     doc.Endpoint,
+		Page:         doc.Page,
+		ETag:         doc.ETag,
+		LastModified: doc.LastModified,
+		NextPage:     doc.NextPage,
+		UpdatedAt:    doc.UpdatedAt,
+	}, nil
+}
+
+func (m *MongoSyncStore) SaveGitHubETag(ctx context.Context, etag GitHubETag) error {
+	_, err := m.githubETagC.UpdateOne(ctx,
+		bson.M{"repo": etag.Repo, "endpoint": etag.Endpoint, "page": etag.Page},
+		bson.M{"$set": mongoGitHubETagDoc{
+			Repo:         etag.Repo,
+			Endpoint:     etag.Endpoint,
+			Page:         etag.Page,
+			ETag:         etag.ETag,
+			LastModified: etag.LastModified,
+			NextPage:     etag.NextPage,
+			UpdatedAt:    time.Now(),
+		}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("save github etag: %w", err)
+	}
+	return nil
+}
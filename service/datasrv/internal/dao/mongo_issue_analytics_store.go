@@ -0,0 +1,224 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoIssueTotalsDoc struct {
+	Total           int64     `bson:"total"`
+	Open            int64     `bson:"open"`
+	Closed          int64     `bson:"closed"`
+	PullRequests    int64     `bson:"pull_requests"`
+	WithAISummary   int64     `bson:"with_ai_summary"`
+	Comments        int64     `bson:"comments"`
+	Repos           int64     `bson:"repos"`
+	LatestCreatedAt time.Time `bson:"latest_created_at"`
+	LatestUpdatedAt time.Time `bson:"latest_updated_at"`
+}
+
+type mongoIssueGroupDoc struct {
+	Key          string `bson:"_id"`
+	Total        int64  `bson:"total"`
+	Open         int64  `bson:"open"`
+	Closed       int64  `bson:"closed"`
+	PullRequests int64  `bson:"pull_requests"`
+	Comments     int64  `bson:"comments"`
+}
+
+type mongoIssueActivityDoc struct {
+	Start time.Time `bson:"_id"`
+	Count int64     `bson:"count"`
+}
+
+func (m *MongoSyncStore) IssueTotals(ctx context.Context, filter IssueAnalyticsFilter) (IssueTotals, error) {
+	group := append(mongoIssueGroup(nil),
+		bson.E{Key: "with_ai_summary", Value: mongoCountIf(bson.D{{Key: "$gt", Value: bson.A{
+			bson.D{{Key: "$strLenCP", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$ai_summary", ""}}}}},
+			0,
+		}}})},
+		bson.E{Key: "repos", Value: bson.D{{Key: "$addToSet", Value: "$repo"}}},
+		bson.E{Key: "latest_created_at", Value: bson.D{{Key: "$max", Value: "$created_at"}}},
+		bson.E{Key: "latest_updated_at", Value: bson.D{{Key: "$max", Value: "$updated_at"}}},
+	)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: mongoIssueAnalyticsMatch(filter.Repos, filter.issueFilter())}},
+		{{Key: "$group", Value: group}},
+		{{Key: "$set", Value: bson.D{{Key: "repos", Value: bson.D{{Key: "$size", Value: "$repos"}}}}}},
+	}
+	cursor, err := m.issuesCol.Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return IssueTotals{}, fmt.Errorf("mongo issue totals: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var doc mongoIssueTotalsDoc
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&doc); err != nil {
+			return IssueTotals{}, fmt.Errorf("decode issue totals doc: %w", err)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return IssueTotals{}, fmt.Errorf("iterate issue totals docs: %w", err)
+	}
+	return IssueTotals{
+		Total:           doc.Total,
+		Open:            doc.Open,
+		Closed:          doc.Closed,
+		PullRequests:    doc.PullRequests,
+		WithAISummary:   doc.WithAISummary,
+		Comments:        doc.Comments,
+		Repos:           doc.Repos,
+		LatestCreatedAt: doc.LatestCreatedAt,
+		LatestUpdatedAt: doc.LatestUpdatedAt,
+	}, nil
+}
+
+func (m *MongoSyncStore) IssueActivity(ctx context.Context, filter IssueAnalyticsFilter, interval IssueInterval) ([]IssueActivityBucket, error) {
+	opened, err := m.issueActivityCounts(ctx, "created_at", filter.Repos, filter.issueFilter(), interval)
+	if err != nil {
+		return nil, err
+	}
+	closed, err := m.issueActivityCounts(ctx, "closed_at", filter.Repos, SyncIssueFilter{ClosedAfter: filter.From, ClosedBefore: filter.To}, interval)
+	if err != nil {
+		return nil, err
+	}
+	return mergeIssueActivity(opened, closed), nil
+}
+
+// issueActivityCounts counts the issues matching conds per interval of field, truncated in UTC.
+func (m *MongoSyncStore) issueActivityCounts(ctx context.Context, field string, repos []string, conds SyncIssueFilter, interval IssueInterval) (map[time.Time]int64, error) {
+	match := mongoIssueAnalyticsMatch(repos, conds)
+	addMongoRange(match, field, "$ne", nil, true)
+	trunc := bson.D{
+		{Key: "date", Value: "$" + field},
+		{Key: "unit", Value: string(interval)},
+		{Key: "timezone", Value: "UTC"},
+	}
+	if interval == IssueIntervalWeek {
+		trunc = append(trunc, bson.E{Key: "startOfWeek", Value: "monday"})
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$dateTrunc", Value: trunc}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	cursor, err := m.issuesCol.Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return nil, fmt.Errorf("mongo issue activity by %s: %w", field, err)
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[time.Time]int64)
+	for cursor.Next(ctx) {
+		var doc mongoIssueActivityDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode issue activity doc: %w", err)
+		}
+		counts[doc.Start.UTC()] = doc.Count
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate issue activity docs: %w", err)
+	}
+	return counts, nil
+}
+
+func (m *MongoSyncStore) OpenIssuesAt(ctx context.Context, filter IssueAnalyticsFilter, at time.Time) (int64, error) {
+	match := mongoIssueAnalyticsMatch(filter.Repos, SyncIssueFilter{CreatedBefore: at})
+	match["$or"] = bson.A{bson.M{"closed_at": nil}, bson.M{"closed_at": bson.M{"$gte": at}}}
+	count, err := m.issuesCol.CountDocuments(ctx, match)
+	if err != nil {
+		return 0, fmt.Errorf("mongo count open issues: %w", err)
+	}
+	return count, nil
+}
+
+func (m *MongoSyncStore) IssueBreakdown(ctx context.Context, filter IssueAnalyticsFilter, dimension IssueDimension, limit int) ([]IssueGroupCount, error) {
+	unwind, key, ok := mongoIssueDimension(dimension)
+	if !ok {
+		return nil, fmt.Errorf("mongo issue breakdown: unknown dimension %q", dimension)
+	}
+	pipeline := append(mongo.Pipeline{{{Key: "$match", Value: mongoIssueAnalyticsMatch(filter.Repos, filter.issueFilter())}}}, unwind...)
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: mongoIssueGroup(key)}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "total", Value: -1}, {Key: "_id", Value: 1}}}},
+	)
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: int64(limit)}})
+	}
+	cursor, err := m.issuesCol.Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return nil, fmt.Errorf("mongo issue breakdown by %s: %w", dimension, err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]IssueGroupCount, 0)
+	for cursor.Next(ctx) {
+		var doc mongoIssueGroupDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode issue breakdown doc: %w", err)
+		}
+		out = append(out, IssueGroupCount(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate issue breakdown docs: %w", err)
+	}
+	return out, nil
+}
+
+// mongoIssueDimension returns the stages unwinding the values of dimension, if any, and the key
+// grouping by it.
+func mongoIssueDimension(dimension IssueDimension) (mongo.Pipeline, any, bool) {
+	switch dimension {
+	case IssueDimensionLabel:
+		return mongo.Pipeline{{{Key: "$unwind", Value: "$labels"}}}, "$labels", true
+	case IssueDimensionAssignee:
+		return mongo.Pipeline{{{Key: "$unwind", Value: "$assignees"}}}, "$assignees", true
+	case IssueDimensionAuthor:
+		return nil, "$author", true
+	case IssueDimensionType:
+		return nil, bson.D{{Key: "$cond", Value: bson.A{"$is_pull_request", IssueTypePullRequest, IssueTypeIssue}}}, true
+	case IssueDimensionRepo:
+		return nil, "$repo", true
+	case IssueDimensionMonth:
+		return nil, bson.D{{Key: "$dateToString", Value: bson.D{
+			{Key: "format", Value: "%Y-%m"},
+			{Key: "date", Value: "$created_at"},
+			{Key: "timezone", Value: "UTC"},
+		}}}, true
+	}
+	return nil, nil, false
+}
+
+// mongoIssueAnalyticsMatch matches the live issues of repos matching conds.
+func mongoIssueAnalyticsMatch(repos []string, conds SyncIssueFilter) bson.M {
+	match := mongoIssueFilter(conds)
+	if len(repos) > 0 {
+		match["repo"] = bson.M{"$in": repos}
+	}
+	return match
+}
+
+// mongoIssueGroup returns a $group by key with the counts shared by the totals and the breakdown
+// groups.
+func mongoIssueGroup(key any) bson.D {
+	return bson.D{
+		{Key: "_id", Value: key},
+		{Key: "total", Value: bson.D{{Key: "$sum", Value: 1}}},
+		{Key: "open", Value: mongoCountIf(bson.D{{Key: "$eq", Value: bson.A{"$state", "open"}}})},
+		{Key: "closed", Value: mongoCountIf(bson.D{{Key: "$eq", Value: bson.A{"$state", "closed"}}})},
+		{Key: "pull_requests", Value: mongoCountIf("$is_pull_request")},
+		{Key: "comments", Value: bson.D{{Key: "$sum", Value: "$comments"}}},
+	}
+}
+
+func mongoCountIf(cond any) bson.D {
+	return bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{cond, 1, 0}}}}}
+}
@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoIssueComment struct {
+	ID            int64     `bson:"id"`
+	Body          string    `bson:"body"`
+	UserLogin     string    `bson:"user_login"`
+	UserURL       string    `bson:"user_url"`
+	UserAvatarURL string    `bson:"user_avatar_url"`
+	CreatedAt     time.Time `bson:"created_at"`
+	UpdatedAt     time.Time `bson:"updated_at"`
+	HTMLURL       string    `bson:"html_url"`
+	// AuthorAssociation is omitted when empty, as it is for comments of other hosts.
+	AuthorAssociation string `bson:"author_association,omitempty"`
+}
+
+type mongoIssueCommentThreadDoc struct {
+	Repo      string              `bson:"repo"`
+	IssueID   int64               `bson:"issue_id"`
+	Number    int32               `bson:"number"`
+	Comments  []mongoIssueComment `bson:"comments"`
+	UpdatedAt time.Time           `bson:"updated_at"`
+}
+
+func (m *MongoSyncStore) SaveIssueComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []IssueComment) error {
+	doc := mongoIssueCommentThreadDoc{
+		Repo:      repo,
+		IssueID:   issueID,
+		Number:    issueNumber,
+		Comments:  make([]mongoIssueComment, 0, len(comments)),
+		UpdatedAt: time.Now().UTC(),
+	}
+	for _, c := range comments {
+		doc.Comments = append(doc.Comments, mongoIssueComment{
+			ID:            c.ID,
+			Body:          c.Body,
+			UserLogin:     c.UserLogin,
+			UserURL:       c.UserURL,
+			UserAvatarURL: c.UserAvatarURL,
+			CreatedAt:     c.CreatedAt.UTC(),
+			UpdatedAt:     c.UpdatedAt.UTC(),
+			HTMLURL:       c.HTMLURL,
+
+			AuthorAssociation: c.AuthorAssociation,
+		})
+	}
+	_, err := m.issueCommentC.UpdateOne(ctx,
+		bson.M{"repo": repo, "issue_id": issueID},
+		bson.M{"$set": doc},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("save issue comments: %w", err)
+	}
+	return nil
+}
+
+func (m *MongoSyncStore) LoadIssueComments(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueComment, error) {
+	var doc mongoIssueCommentThreadDoc
+	if err := m.issueCommentC.FindOne(ctx, bson.M{"repo": repo, "issue_id": issueID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrIssueCommentsNotFound
+		}
+		return nil, fmt.Errorf("load issue comments for %s#%d: %w", repo, issueNumber, err)
+	}
+	out := make([]IssueComment, 0, len(doc.Comments))
+	for _, c := range doc.Comments {
+		out = append(out, IssueComment{
+			ID:            c.ID,
+			Body:          c.Body,
+			UserLogin:     c.UserLogin,
+			UserURL:       c.UserURL,
+			UserAvatarURL: c.UserAvatarURL,
+			CreatedAt:     c.CreatedAt,
+			UpdatedAt:     c.UpdatedAt,
+			HTMLURL:       c.HTMLURL,
+
+			AuthorAssociation: c.AuthorAssociation,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,124 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoIssueEventDoc struct {
+	Repo        string    `bson:"repo"`
+	IssueID     int64     `bson:"issue_id"`
+	EventKey    string    `bson:"event_key"`
+	IssueNumber int32     `bson:"issue_number"`
+	Event       string    `bson:"event"`
+	Actor       string    `bson:"actor,omitempty"`
+	CreatedAt   time.Time `bson:"created_at"`
+	Label       string    `bson:"label,omitempty"`
+	Assignee    string    `bson:"assignee,omitempty"`
+	Milestone   string    `bson:"milestone,omitempty"`
+	RenameFrom  string    `bson:"rename_from,omitempty"`
+	RenameTo    string    `bson:"rename_to,omitempty"`
+	CommitID    string    `bson:"commit_id,omitempty"`
+	Source      string    `bson:"source,omitempty"`
+	SyncedAt    time.Time `bson:"synced_at"`
+}
+
+func (m *MongoSyncStore) UpsertIssueEvents(ctx context.Context, events []IssueEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	models := make([]mongo.WriteModel, 0, len(events))
+	for _, it := range events {
+		doc := mongoIssueEventDoc{
+			Repo:        it.Repo,
+			IssueID:     it.IssueID,
+			EventKey:    it.Key,
+			IssueNumber: it.IssueNumber,
+			Event:       it.Event,
+			Actor:       it.Actor,
+			CreatedAt:   it.CreatedAt,
+			Label:       it.Label,
+			Assignee:    it.Assignee,
+			Milestone:   it.Milestone,
+			RenameFrom:  it.RenameFrom,
+			RenameTo:    it.RenameTo,
+			CommitID:    it.CommitID,
+			Source:      it.Source,
+			SyncedAt:    now,
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"repo": it.Repo, "issue_id": it.IssueID, "event_key": it.Key}).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+
+	if _, err := m.issueEventC.BulkWrite(ctx, models); err != nil {
+		return 0, fmt.Errorf("mongo bulk upsert issue events: %w", err)
+	}
+	return len(events), nil
+}
+
+func (m *MongoSyncStore) ListIssueEvents(ctx context.Context, filter IssueEventFilter) ([]IssueEvent, error) {
+	query := bson.M{}
+	if filter.Repo != "" {
+		query["repo"] = filter.Repo
+	}
+	if filter.IssueID > 0 {
+		query["issue_id"] = filter.IssueID
+	}
+	if filter.IssueNumber > 0 {
+		query["issue_number"] = filter.IssueNumber
+	}
+	if len(filter.Events) > 0 {
+		query["event"] = bson.M{"$in": filter.Events}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "event_key", Value: 1}})
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+
+	cursor, err := m.issueEventC.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo list issue events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]IssueEvent, 0)
+	for cursor.Next(ctx) {
+		var doc mongoIssueEventDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode issue event doc: %w", err)
+		}
+		out = append(out, IssueEvent{
+			Repo:        doc.Repo,
+			IssueID:     doc.IssueID,
+			IssueNumber: doc.IssueNumber,
+			Key:         doc.EventKey,
+			Event:       doc.Event,
+			Actor:       doc.Actor,
+			CreatedAt:   doc.CreatedAt,
+			Label:       doc.Label,
+			Assignee:    doc.Assignee,
+			Milestone:   doc.Milestone,
+			RenameFrom:  doc.RenameFrom,
+			RenameTo:    doc.RenameTo,
+			CommitID:    doc.CommitID,
+			Source:      doc.Source,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate issue event docs: %w", err)
+	}
+	return out, nil
+}
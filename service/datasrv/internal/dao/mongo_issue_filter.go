@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// mongoIssueSortFields maps the time and count sorts to their fields.
+var mongoIssueSortFields = map[IssueSort]string{
+	IssueSortCreated:  "created_at",
+	IssueSortUpdated:  "updated_at",
+	IssueSortComments: "comments",
+	IssueSortNumber:   "number",
+}
+
+// mongoIssueFilter builds the match of filter. Labels, assignees and authors are matched through
+// their lower-cased copies, which UpsertIssues keeps next to them and which are indexed.
+func mongoIssueFilter(filter SyncIssueFilter) bson.M {
+	q := bson.M{}
+	if filter.Repo != "" {
+		q["repo"] = filter.Repo
+	}
+	if filter.State != "" && filter.State != "all" {
+		q["state"] = filter.State
+	}
+	if filter.IssueID > 0 {
+		q["issue_id"] = filter.IssueID
+	}
+	if filter.Number > 0 {
+		q["number"] = filter.Number
+	}
+	if !filter.IncludeTombstoned {
+		q["tombstone"] = bson.M{"$in": bson.A{nil, ""}}
+	}
+	if len(filter.Labels) > 0 {
+		if filter.AnyLabel {
+			q["labels_lower"] = bson.M{"$in": lowerAll(filter.Labels)}
+		} else {
+			q["labels_lower"] = bson.M{"$all": lowerAll(filter.Labels)}
+		}
+	}
+	if filter.Assignee != "" {
+		q["assignees_lower"] = strings.ToLower(filter.Assignee)
+	}
+	if filter.Author != "" {
+		q["author_lower"] = strings.ToLower(filter.Author)
+	}
+	if filter.IsPullRequest != nil {
+		q["is_pull_request"] = *filter.IsPullRequest
+	}
+	if filter.HasAISummary != nil {
+		if *filter.HasAISummary {
+			q["ai_summary"] = bson.M{"$nin": bson.A{nil, ""}}
+		} else {
+			q["ai_summary"] = bson.M{"$in": bson.A{nil, ""}}
+		}
+	}
+	addMongoRange(q, "created_at", "$gte", filter.CreatedAfter, !filter.CreatedAfter.IsZero())
+	addMongoRange(q, "created_at", "$lt", filter.CreatedBefore, !filter.CreatedBefore.IsZero())
+	addMongoRange(q, "updated_at", "$gte", filter.UpdatedAfter, !filter.UpdatedAfter.IsZero())
+	addMongoRange(q, "updated_at", "$lt", filter.UpdatedBefore, !filter.UpdatedBefore.IsZero())
+	addMongoRange(q, "closed_at", "$gte", filter.ClosedAfter, !filter.ClosedAfter.IsZero())
+	addMongoRange(q, "closed_at", "$lt", filter.ClosedBefore, !filter.ClosedBefore.IsZero())
+	if filter.MinComments != nil {
+		addMongoRange(q, "comments", "$gte", *filter.MinComments, true)
+	}
+	if filter.MaxComments != nil {
+		addMongoRange(q, "comments", "$lte", *filter.MaxComments, true)
+	}
+	return q
+}
+
+func addMongoRange(q bson.M, field, op string, value any, ok bool) {
+	if !ok {
+		return
+	}
+	cond, _ := q[field].(bson.M)
+	if cond == nil {
+		cond = bson.M{}
+		q[field] = cond
+	}
+	cond[op] = value
+}
+
+// mongoIssueOrder returns the keys ListIssues orders by, with the stages adding the computed
+// ones. Like the Postgres store, issues that were never closed sort after the closed ones in both
+// directions; their closed_key is the zero time, so that they compare equal. Ties break on repo and
+// issue_id.
+func mongoIssueOrder(filter SyncIssueFilter) (mongo.Pipeline, []mongoKeysetField) {
+	desc := !filter.SortAscending
+	switch filter.Sort {
+	case IssueSortClosed:
+		stages := mongo.Pipeline{{{Key: "$addFields", Value: bson.D{
+			{Key: "never_closed", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$closed_at", nil}}}, nil}}},
+				1,
+				0,
+			}}}},
+			{Key: "closed_key", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$closed_at", time.Time{}}}}},
+		}}}}
+		return stages, []mongoKeysetField{{name: "never_closed"}, {name: "closed_key", desc: desc}, {name: "repo", desc: desc}, {name: "issue_id", desc: desc}}
+	case IssueSortCreated, IssueSortUpdated, IssueSortComments, IssueSortNumber:
+		return nil, []mongoKeysetField{{name: mongoIssueSortFields[filter.Sort], desc: desc}, {name: "repo", desc: desc}, {name: "issue_id", desc: desc}}
+	default:
+		stages := mongo.Pipeline{{{Key: "$addFields", Value: bson.D{{Key: "has_ai_summary", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$gt", Value: bson.A{
+				bson.D{{Key: "$strLenCP", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$ai_summary", ""}}}}},
+				0,
+			}}},
+			1,
+			0,
+		}}}}}}}}
+		return stages, []mongoKeysetField{{name: "has_ai_summary", desc: true}, {name: "updated_at", desc: true}, {name: "repo", desc: true}, {name: "issue_id", desc: true}}
+	}
+}
+
+// mongoIssueCursorValues returns the keys of cursor in the order of mongoIssueOrder.
+func mongoIssueCursorValues(sort IssueSort, cursor IssueCursor) []any {
+	flag := 0
+	if cursor.Flag {
+		flag = 1
+	}
+	switch sort {
+	case IssueSortClosed:
+		return []any{flag, cursor.Time, cursor.Repo, cursor.IssueID}
+	case IssueSortCreated, IssueSortUpdated:
+		return []any{cursor.Time, cursor.Repo, cursor.IssueID}
+	case IssueSortComments, IssueSortNumber:
+		return []any{cursor.Count, cursor.Repo, cursor.IssueID}
+	default:
+		return []any{flag, cursor.Time, cursor.Repo, cursor.IssueID}
+	}
+}
+
+// backfillIssueFilterFields fills the lower-cased filter fields of issues stored before
+// UpsertIssues kept them.
+func (m *MongoSyncStore) backfillIssueFilterFields(ctx context.Context) error {
+	lowerArray := func(field string) bson.D {
+		return bson.D{{Key: "$map", Value: bson.D{
+			{Key: "input", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$" + field, bson.A{}}}}},
+			{Key: "in", Value: bson.D{{Key: "$toLower", Value: "$$this"}}},
+		}}}
+	}
+	_, err := m.issuesCol.UpdateMany(ctx,
+		bson.M{"author_lower": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.D{
+			{Key: "labels_lower", Value: lowerArray("labels")},
+			{Key: "assignees_lower", Value: lowerArray("assignees")},
+			{Key: "author_lower", Value: bson.D{{Key: "$toLower", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$author", ""}}}}}},
+		}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("backfill issue filter fields: %w", err)
+	}
+	return nil
+}
+
+func lowerAll(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		out = append(out, strings.ToLower(value))
+	}
+	return out
+}
@@ -0,0 +1,176 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// mongoResponsivenessOrder is the order of ListIssuesWithStaleResponsiveness.
+var mongoResponsivenessOrder = []mongoKeysetField{{name: "updated_at"}, {name: "repo"}, {name: "issue_id"}}
+
+type mongoStaleIssueDoc struct {
+	mongoIssueDoc            `bson:",inline"`
+	ResponsivenessGeneration int64 `bson:"responsiveness_generation"`
+}
+
+type mongoIssueResponsivenessDoc struct {
+	Key              string    `bson:"_id"`
+	Issues           int64     `bson:"issues"`
+	Responded        int64     `bson:"responded"`
+	FirstResponsePct []float64 `bson:"first_response_pct"`
+	Closed           int64     `bson:"closed"`
+	ClosePct         []float64 `bson:"close_pct"`
+	Reopened         int64     `bson:"reopened"`
+	Reopens          int64     `bson:"reopens"`
+}
+
+func (m *MongoSyncStore) ListIssuesWithStaleResponsiveness(ctx context.Context, after *IssueCursor, limit int) ([]StaleIssue, error) {
+	conds := bson.A{
+		mongoIssueFilter(SyncIssueFilter{}),
+		bson.M{"$or": bson.A{
+			bson.M{"responsiveness_source_at": nil},
+			bson.M{"$expr": bson.M{"$lt": bson.A{"$responsiveness_source_at", "$updated_at"}}},
+		}},
+	}
+	if after != nil {
+		conds = append(conds, mongoKeysetAfter(mongoResponsivenessOrder, []any{after.Time, after.Repo, after.IssueID}))
+	}
+	opts := options.Find().SetSort(mongoKeysetSort(mongoResponsivenessOrder))
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := m.issuesCol.Find(ctx, bson.M{"$and": conds}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo list issues with stale responsiveness: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]StaleIssue, 0)
+	for cursor.Next(ctx) {
+		var doc mongoStaleIssueDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode issue doc: %w", err)
+		}
+		out = append(out, StaleIssue{Issue: doc.toSyncedIssue(), Generation: doc.ResponsivenessGeneration})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate issue docs: %w", err)
+	}
+	return out, nil
+}
+
+func (m *MongoSyncStore) SetIssueResponsiveness(ctx context.Context, repo string, issueID int64, metrics IssueResponsiveness) (bool, error) {
+	// Issues never invalidated have no generation field.
+	generation := any(metrics.Generation)
+	if metrics.Generation == 0 {
+		generation = bson.M{"$in": bson.A{0, nil}}
+	}
+	result, err := m.issuesCol.UpdateOne(ctx,
+		bson.M{
+			"repo":                      repo,
+			"issue_id":                  issueID,
+			"updated_at":                metrics.SourceUpdatedAt,
+			"responsiveness_generation": generation,
+		},
+		bson.M{"$set": bson.M{
+			"first_response_at":        metrics.FirstResponseAt,
+			"first_responder":          metrics.FirstResponder,
+			"first_response_seconds":   metrics.FirstResponseSeconds,
+			"close_seconds":            metrics.CloseSeconds,
+			"reopen_count":             metrics.ReopenCount,
+			"responsiveness_source_at": metrics.SourceUpdatedAt,
+		}},
+	)
+	if err != nil {
+		return false, fmt.Errorf("set issue responsiveness: %w", err)
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (m *MongoSyncStore) InvalidateIssueResponsiveness(ctx context.Context, repo string, issueIDs []int64) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+	_, err := m.issuesCol.UpdateMany(ctx,
+		bson.M{"repo": repo, "issue_id": bson.M{"$in": issueIDs}},
+		bson.M{
+			"$unset": bson.M{"responsiveness_source_at": ""},
+			"$inc":   bson.M{"responsiveness_generation": 1},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("invalidate issue responsiveness: %w", err)
+	}
+	return nil
+}
+
+// IssueResponsivenessStats needs MongoDB 7.0 or later for $percentile.
+func (m *MongoSyncStore) IssueResponsivenessStats(ctx context.Context, filter IssueAnalyticsFilter, groupBy IssueDimension) ([]IssueResponsivenessStats, error) {
+	unwind, key, ok := mongoIssueDimension(groupBy)
+	if !ok || (groupBy != IssueDimensionRepo && groupBy != IssueDimensionLabel && groupBy != IssueDimensionMonth) {
+		return nil, fmt.Errorf("mongo issue responsiveness: unknown grouping %q", groupBy)
+	}
+	match := mongoIssueAnalyticsMatch(filter.Repos, filter.issueFilter())
+	match["responsiveness_source_at"] = bson.M{"$ne": nil}
+	percentiles := func(field string) bson.D {
+		return bson.D{{Key: "$percentile", Value: bson.D{
+			{Key: "input", Value: "$" + field},
+			{Key: "p", Value: bson.A{0.5, 0.9}},
+			{Key: "method", Value: "approximate"},
+		}}}
+	}
+	has := func(field string) bson.D {
+		return mongoCountIf(bson.D{{Key: "$ne", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$" + field, nil}}}, nil}}})
+	}
+	pipeline := append(mongo.Pipeline{{{Key: "$match", Value: match}}}, unwind...)
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: key},
+			{Key: "issues", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "responded", Value: has("first_response_seconds")},
+			{Key: "first_response_pct", Value: percentiles("first_response_seconds")},
+			{Key: "closed", Value: has("close_seconds")},
+			{Key: "close_pct", Value: percentiles("close_seconds")},
+			{Key: "reopened", Value: mongoCountIf(bson.D{{Key: "$gt", Value: bson.A{"$reopen_count", 0}}})},
+			{Key: "reopens", Value: bson.D{{Key: "$sum", Value: "$reopen_count"}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	)
+	cursor, err := m.issuesCol.Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return nil, fmt.Errorf("mongo issue responsiveness by %s: %w", groupBy, err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]IssueResponsivenessStats, 0)
+	for cursor.Next(ctx) {
+		var doc mongoIssueResponsivenessDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode issue responsiveness doc: %w", err)
+		}
+		stats := IssueResponsivenessStats{
+			Key:       doc.Key,
+			Issues:    doc.Issues,
+			Responded: doc.Responded,
+			Closed:    doc.Closed,
+			Reopened:  doc.Reopened,
+			Reopens:   doc.Reopens,
+		}
+		// $percentile yields nulls, decoded as 0, when no document of the group has the field.
+		if len(doc.FirstResponsePct) == 2 {
+			stats.FirstResponseP50, stats.FirstResponseP90 = doc.FirstResponsePct[0], doc.FirstResponsePct[1]
+		}
+		if len(doc.ClosePct) == 2 {
+			stats.CloseP50, stats.CloseP90 = doc.ClosePct[0], doc.ClosePct[1]
+		}
+		out = append(out, stats)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate issue responsiveness docs: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoIssueSearchDoc struct {
+	mongoIssueDoc `bson:",inline"`
+	Score         float64 `bson:"score"`
+}
+
+// SearchIssues runs the query against the issues_text index. Mongo has no highlighting, so the
+// matches are marked here by word prefix.
+func (m *MongoSyncStore) SearchIssues(ctx context.Context, q IssueSearchQuery) ([]IssueSearchHit, error) {
+	filter := mongoIssueFilter(q.filter())
+	opts := options.Find()
+	if text := mongoTextSearch(q); text != "" {
+		filter["$text"] = bson.M{"$search": text}
+		opts.SetProjection(bson.D{{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}}})
+		opts.SetSort(bson.D{
+			{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}},
+			{Key: "updated_at", Value: -1},
+			{Key: "issue_id", Value: -1},
+		})
+	} else {
+		opts.SetSort(bson.D{{Key: "updated_at", Value: -1}, {Key: "issue_id", Value: -1}})
+	}
+	if q.Offset > 0 {
+		opts.SetSkip(int64(q.Offset))
+	}
+	if q.Limit > 0 {
+		opts.SetLimit(int64(q.Limit))
+	}
+
+	cursor, err := m.issuesCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo search issues: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	words := searchWords(q)
+	out := make([]IssueSearchHit, 0)
+	for cursor.Next(ctx) {
+		var doc mongoIssueSearchDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode issue search doc: %w", err)
+		}
+		title, _ := highlightWords(doc.Title, words)
+		out = append(out, IssueSearchHit{
+			Issue:          doc.toSyncedIssue(),
+			Score:          doc.Score,
+			TitleHighlight: title,
+			Snippet:        highlightSnippet([]string{doc.AISummary, doc.Body, doc.CommentsText}, words),
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate issue search docs: %w", err)
+	}
+	return out, nil
+}
+
+func (m *MongoSyncStore) SetIssueCommentText(ctx context.Context, repo string, issueID int64, text string) error {
+	_, err := m.issuesCol.UpdateOne(ctx,
+		bson.M{"repo": repo, "issue_id": issueID},
+		bson.M{"$set": bson.M{"comments_text": text}},
+	)
+	if err != nil {
+		return fmt.Errorf("set issue comment text: %w", err)
+	}
+	return nil
+}
+
+// mongoTextSearch renders the terms and phrases for $text. Every part is quoted, as $text ORs bare
+// words but requires all quoted phrases to match.
+func mongoTextSearch(q IssueSearchQuery) string {
+	parts := make([]string, 0, len(q.Terms)+len(q.Phrases))
+	for _, part := range append(append([]string(nil), q.Terms...), q.Phrases...) {
+		if part = strings.TrimSpace(strings.ReplaceAll(part, `"`, "")); part != "" {
+			parts = append(parts, `"`+part+`"`)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (doc mongoIssueDoc) toSyncedIssue() SyncedIssue {
+	return SyncedIssue{
+		Repo:          doc.Repo,
+		IssueID:       doc.IssueID,
+		Number:        doc.Number,
+		Title:         doc.Title,
+		Body:          doc.Body,
+		State:         doc.State,
+		Author:        doc.Author,
+		Assignees:     doc.Assignees,
+		Labels:        doc.Labels,
+		Milestone:     doc.Milestone,
+		Comments:      doc.Comments,
+		IsPullRequest: doc.IsPullRequest,
+		HTMLURL:       doc.HTMLURL,
+		CreatedAt:     doc.CreatedAt,
+		UpdatedAt:     doc.UpdatedAt,
+		ClosedAt:      doc.ClosedAt,
+		AISummary:     doc.AISummary,
+		Raw:           doc.Raw,
+		Tombstone:     doc.Tombstone,
+		TombstonedAt:  doc.TombstonedAt,
+		TransferredTo: doc.TransferredTo,
+	}
+}
@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (m *MongoSyncStore) TombstoneIssues(ctx context.Context, tombstones []IssueTombstone) (int, error) {
+	if len(tombstones) == 0 {
+		return 0, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(tombstones))
+	for _, it := range tombstones {
+		at := it.At
+		if at.IsZero() {
+			at = time.Now().UTC()
+		}
+		set := bson.M{
+			"tombstone":      it.Reason,
+			"tombstoned_at":  at,
+			"transferred_to": it.TransferredTo,
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"repo": it.Repo, "issue_id": it.IssueID}).
+			SetUpdate(bson.M{"$set": set}))
+	}
+
+	result, err := m.issuesCol.BulkWrite(ctx, models)
+	if err != nil {
+		return 0, fmt.Errorf("mongo bulk tombstone issues: %w", err)
+	}
+	return int(result.ModifiedCount), nil
+}
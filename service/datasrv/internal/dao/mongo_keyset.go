@@ -0,0 +1,41 @@
+package dao
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+// mongoKeysetField is one key of a listing order.
+type mongoKeysetField struct {
+	name string
+	desc bool
+}
+
+// mongoKeysetSort returns the sort of fields.
+func mongoKeysetSort(fields []mongoKeysetField) bson.D {
+	sort := make(bson.D, 0, len(fields))
+	for _, field := range fields {
+		direction := 1
+		if field.desc {
+			direction = -1
+		}
+		sort = append(sort, bson.E{Key: field.name, Value: direction})
+	}
+	return sort
+}
+
+// mongoKeysetAfter matches the documents that come after values, the keys of the last document of
+// the previous page, in the order of fields.
+func mongoKeysetAfter(fields []mongoKeysetField, values []any) bson.M {
+	terms := make(bson.A, 0, len(fields))
+	for i, field := range fields {
+		term := bson.M{}
+		for j := 0; j < i; j++ {
+			term[fields[j].name] = values[j]
+		}
+		if field.desc {
+			term[field.name] = bson.M{"$lt": values[i]}
+		} else {
+			term[field.name] = bson.M{"$gt": values[i]}
+		}
+		terms = append(terms, term)
+	}
+	return bson.M{"$or": terms}
+}
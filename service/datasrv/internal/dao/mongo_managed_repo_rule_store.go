@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoManagedRepoRuleDoc struct {
+	Rule      string    `bson:"rule"`
+	Position  int       `bson:"position"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+func (m *MongoSyncStore) ListManagedRepoRules(ctx context.Context) ([]string, error) {
+	cursor, err := m.managedRuleC.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "position", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("list managed repo rules: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]string, 0)
+	for cursor.Next(ctx) {
+		var doc mongoManagedRepoRuleDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode managed repo rule: %w", err)
+		}
+		out = append(out, doc.Rule)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate managed repo rules: %w", err)
+	}
+	return out, nil
+}
+
+func (m *MongoSyncStore) ReplaceManagedRepoRules(ctx context.Context, rules []string) error {
+	if _, err := m.managedRuleC.DeleteMany(ctx, bson.M{}); err != nil {
+		return fmt.Errorf("clear managed repo rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	docs := make([]any, 0, len(rules))
+	for i, rule := range rules {
+		docs = append(docs, mongoManagedRepoRuleDoc{Rule: rule, Position: i, CreatedAt: now})
+	}
+	if _, err := m.managedRuleC.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("insert managed repo rules: %w", err)
+	}
+	return nil
+}
+
+func (m *MongoSyncStore) ReplaceRuleManagedRepos(ctx context.Context, repos []ManagedRepo) ([]ManagedRepo, error) {
+	now := time.Now().UTC()
+	names := make([]string, 0, len(repos))
+	if len(repos) > 0 {
+		models := make([]mongo.WriteModel, 0, len(repos))
+		for _, repo := range repos {
+			names = append(names, repo.Repo)
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"repo": repo.Repo}).
+				SetUpdate(bson.M{
+					"$set": bson.M{
+						"repo":       repo.Repo,
+						"rule":       repo.Rule,
+						"updated_at": now,
+					},
+					"$setOnInsert": bson.M{
+						"created_at": now,
+					},
+				}).
+				SetUpsert(true))
+		}
+		if _, err := m.managedRepoC.BulkWrite(ctx, models); err != nil {
+			return nil, fmt.Errorf("replace rule managed repos: %w", err)
+		}
+	}
+	if _, err := m.managedRepoC.DeleteMany(ctx, bson.M{"repo": bson.M{"$nin": names}}); err != nil {
+		return nil, fmt.Errorf("delete unmatched managed repos: %w", err)
+	}
+	return m.ListManagedRepos(ctx)
+}
@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type mongoPullRequestDoc struct {
+	Repo               string                     `bson:"repo"`
+	IssueID            int64                      `bson:"issue_id"`
+	Number             int32                      `bson:"number"`
+	Merged             bool                       `bson:"merged"`
+	MergedAt           *time.Time                 `bson:"merged_at,omitempty"`
+	MergedBy           string                     `bson:"merged_by,omitempty"`
+	Draft              bool                       `bson:"draft"`
+	HeadRef            string                     `bson:"head_ref"`
+	HeadSHA            string                     `bson:"head_sha"`
+	BaseRef            string                     `bson:"base_ref"`
+	Additions          int32                      `bson:"additions"`
+	Deletions          int32                      `bson:"deletions"`
+	ChangedFiles       int32                      `bson:"changed_files"`
+	RequestedReviewers []string                   `bson:"requested_reviewers"`
+	RequestedTeams     []string                   `bson:"requested_teams"`
+	Reviews            []PullRequestReviewVerdict `bson:"reviews"`
+	ReviewDecision     string                     `bson:"review_decision"`
+	UpdatedAt          time.Time                  `bson:"updated_at"`
+	SyncedAt           time.Time                  `bson:"synced_at"`
+}
+
+func (m *MongoSyncStore) UpsertPullRequests(ctx context.Context, prs []PullRequestDetail) (int, error) {
+	if len(prs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	models := make([]mongo.WriteModel, 0, len(prs))
+	for _, pr := range prs {
+		doc := mongoPullRequestDoc{
+			Repo:               pr.Repo,
+			IssueID:            pr.IssueID,
+			Number:             pr.Number,
+			Merged:             pr.Merged,
+			MergedAt:           pr.MergedAt,
+			MergedBy:           pr.MergedBy,
+			Draft:              pr.Draft,
+			HeadRef:            pr.HeadRef,
+			HeadSHA:            pr.HeadSHA,
+			BaseRef:            pr.BaseRef,
+			Additions:          pr.Additions,
+			Deletions:          pr.Deletions,
+			ChangedFiles:       pr.ChangedFiles,
+			RequestedReviewers: pr.RequestedReviewers,
+			RequestedTeams:     pr.RequestedTeams,
+			Reviews:            pr.Reviews,
+			ReviewDecision:     pr.ReviewDecision,
+			UpdatedAt:          pr.UpdatedAt,
+			SyncedAt:           now,
+		}
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"repo": pr.Repo, "issue_id": pr.IssueID}).
+			SetReplacement(doc).
+			SetUpsert(true))
+	}
+
+	if _, err := m.pullRequestC.BulkWrite(ctx, models); err != nil {
+		return 0, fmt.Errorf("mongo bulk upsert pull requests: %w", err)
+	}
+	return len(prs), nil
+}
+
+func (m *MongoSyncStore) ListPullRequests(ctx context.Context, issueIDs []int64) ([]PullRequestDetail, error) {
+	if len(issueIDs) == 0 {
+		return nil, nil
+	}
+
+	cursor, err := m.pullRequestC.Find(ctx, bson.M{"issue_id": bson.M{"$in": issueIDs}})
+	if err != nil {
+		return nil, fmt.Errorf("mongo list pull requests: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]PullRequestDetail, 0)
+	for cursor.Next(ctx) {
+		var doc mongoPullRequestDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode pull request doc: %w", err)
+		}
+		out = append(out, PullRequestDetail{
+			Repo:               doc.Repo,
+			IssueID:            doc.IssueID,
+			Number:             doc.Number,
+			Merged:             doc.Merged,
+			MergedAt:           doc.MergedAt,
+			MergedBy:           doc.MergedBy,
+			Draft:              doc.Draft,
+			HeadRef:            doc.HeadRef,
+			HeadSHA:            doc.HeadSHA,
+			BaseRef:            doc.BaseRef,
+			Additions:          doc.Additions,
+			Deletions:          doc.Deletions,
+			ChangedFiles:       doc.ChangedFiles,
+			RequestedReviewers: doc.RequestedReviewers,
+			RequestedTeams:     doc.RequestedTeams,
+			Reviews:            doc.Reviews,
+			ReviewDecision:     doc.ReviewDecision,
+			UpdatedAt:          doc.UpdatedAt,
+			SyncedAt:           doc.SyncedAt,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate pull request docs: %w", err)
+	}
+	return out, nil
+}
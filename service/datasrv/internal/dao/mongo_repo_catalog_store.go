@@ -0,0 +1,232 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoRepoLabelDoc struct {
+	Repo        string    `bson:"repo"`
+	Name        string    `bson:"name"`
+	LabelID     int64     `bson:"label_id"`
+	Color       string    `bson:"color"`
+	Description string    `bson:"description,omitempty"`
+	SyncedAt    time.Time `bson:"synced_at"`
+}
+
+type mongoRepoMilestoneDoc struct {
+	Repo        string     `bson:"repo"`
+	Number      int32      `bson:"number"`
+	MilestoneID int64      `bson:"milestone_id"`
+	Title       string     `bson:"title"`
+	Description string     `bson:"description,omitempty"`
+	State       string     `bson:"state"`
+	DueOn       *time.Time `bson:"due_on,omitempty"`
+	ClosedAt    *time.Time `bson:"closed_at,omitempty"`
+	SyncedAt    time.Time  `bson:"synced_at"`
+}
+
+func (m *MongoSyncStore) ReplaceRepoLabels(ctx context.Context, repo string, labels []RepoLabel) error {
+	now := time.Now().UTC()
+	names := make([]string, 0, len(labels))
+	if len(labels) > 0 {
+		models := make([]mongo.WriteModel, 0, len(labels))
+		for _, it := range labels {
+			names = append(names, it.Name)
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(bson.M{"repo": repo, "name": it.Name}).
+				SetReplacement(mongoRepoLabelDoc{
+					Repo:        repo,
+					Name:        it.Name,
+					LabelID:     it.LabelID,
+					Color:       it.Color,
+					Description: it.Description,
+					SyncedAt:    now,
+				}).
+				SetUpsert(true))
+		}
+		if _, err := m.repoLabelC.BulkWrite(ctx, models); err != nil {
+			return fmt.Errorf("replace repo labels: %w", err)
+		}
+	}
+	if _, err := m.repoLabelC.DeleteMany(ctx, bson.M{"repo": repo, "name": bson.M{"$nin": names}}); err != nil {
+		return fmt.Errorf("delete stale repo labels: %w", err)
+	}
+	return nil
+}
+
+func (m *MongoSyncStore) ReplaceRepoMilestones(ctx context.Context, repo string, milestones []RepoMilestone) error {
+	now := time.Now().UTC()
+	numbers := make([]int32, 0, len(milestones))
+	if len(milestones) > 0 {
+		models := make([]mongo.WriteModel, 0, len(milestones))
+		for _, it := range milestones {
+			numbers = append(numbers, it.Number)
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(bson.M{"repo": repo, "number": it.Number}).
+				SetReplacement(mongoRepoMilestoneDoc{
+					Repo:        repo,
+					Number:      it.Number,
+					MilestoneID: it.MilestoneID,
+					Title:       it.Title,
+					Description: it.Description,
+					State:       it.State,
+					DueOn:       it.DueOn,
+					ClosedAt:    it.ClosedAt,
+					SyncedAt:    now,
+				}).
+				SetUpsert(true))
+		}
+		if _, err := m.repoMilestoneC.BulkWrite(ctx, models); err != nil {
+			return fmt.Errorf("replace repo milestones: %w", err)
+		}
+	}
+	if _, err := m.repoMilestoneC.DeleteMany(ctx, bson.M{"repo": repo, "number": bson.M{"$nin": numbers}}); err != nil {
+		return fmt.Errorf("delete stale repo milestones: %w", err)
+	}
+	return nil
+}
+
+func (m *MongoSyncStore) ListRepoLabels(ctx context.Context, repo string) ([]RepoLabel, error) {
+	q := bson.M{}
+	if repo != "" {
+		q["repo"] = repo
+	}
+	cursor, err := m.repoLabelC.Find(ctx, q, options.Find().SetSort(bson.D{{Key: "repo", Value: 1}, {Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("mongo list repo labels: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]RepoLabel, 0)
+	for cursor.Next(ctx) {
+		var doc mongoRepoLabelDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode repo label doc: %w", err)
+		}
+		out = append(out, RepoLabel{
+			Repo:        doc.Repo,
+			LabelID:     doc.LabelID,
+			Name:        doc.Name,
+			Color:       doc.Color,
+			Description: doc.Description,
+			SyncedAt:    doc.SyncedAt,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate repo label docs: %w", err)
+	}
+	return out, nil
+}
+
+func (m *MongoSyncStore) ListRepoMilestones(ctx context.Context, repo string) ([]RepoMilestone, error) {
+	q := bson.M{}
+	if repo != "" {
+		q["repo"] = repo
+	}
+	cursor, err := m.repoMilestoneC.Find(ctx, q, options.Find().SetSort(bson.D{{Key: "repo", Value: 1}, {Key: "number", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("mongo list repo milestones: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]RepoMilestone, 0)
+	for cursor.Next(ctx) {
+		var doc mongoRepoMilestoneDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode repo milestone doc: %w", err)
+		}
+		out = append(out, RepoMilestone{
+			Repo:        doc.Repo,
+			MilestoneID: doc.MilestoneID,
+			Number:      doc.Number,
+			Title:       doc.Title,
+			Description: doc.Description,
+			State:       doc.State,
+			DueOn:       doc.DueOn,
+			ClosedAt:    doc.ClosedAt,
+			SyncedAt:    doc.SyncedAt,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate repo milestone docs: %w", err)
+	}
+	return out, nil
+}
+
+type mongoCatalogCountDoc struct {
+	ID struct {
+		Repo string `bson:"repo"`
+		Name string `bson:"name"`
+		// Number is set on milestone groups.
+		Number int32 `bson:"number"`
+	} `bson:"_id"`
+	Open   int64 `bson:"open"`
+	Closed int64 `bson:"closed"`
+}
+
+func (m *MongoSyncStore) CountLabelIssues(ctx context.Context, repo string) ([]RepoLabelCount, error) {
+	docs, err := m.countCatalogIssues(ctx, mongoIssueFilter(SyncIssueFilter{Repo: repo}),
+		bson.D{{Key: "$unwind", Value: "$labels_lower"}},
+		bson.D{{Key: "repo", Value: "$repo"}, {Key: "name", Value: "$labels_lower"}})
+	if err != nil {
+		return nil, fmt.Errorf("mongo count label issues: %w", err)
+	}
+	out := make([]RepoLabelCount, 0, len(docs))
+	for _, doc := range docs {
+		out = append(out, RepoLabelCount{Repo: doc.ID.Repo, Name: doc.ID.Name, Open: doc.Open, Closed: doc.Closed})
+	}
+	return out, nil
+}
+
+func (m *MongoSyncStore) CountMilestoneIssues(ctx context.Context, repo string) ([]RepoMilestoneCount, error) {
+	match := mongoIssueFilter(SyncIssueFilter{Repo: repo})
+	match["milestone"] = bson.M{"$gt": 0}
+	docs, err := m.countCatalogIssues(ctx, match, nil,
+		bson.D{{Key: "repo", Value: "$repo"}, {Key: "number", Value: "$milestone"}})
+	if err != nil {
+		return nil, fmt.Errorf("mongo count milestone issues: %w", err)
+	}
+	out := make([]RepoMilestoneCount, 0, len(docs))
+	for _, doc := range docs {
+		out = append(out, RepoMilestoneCount{Repo: doc.ID.Repo, Number: doc.ID.Number, Open: doc.Open, Closed: doc.Closed})
+	}
+	return out, nil
+}
+
+// countCatalogIssues groups the issues matching match by key, after the unwind stage when there is
+// one, counting the open and closed ones.
+func (m *MongoSyncStore) countCatalogIssues(ctx context.Context, match bson.M, unwind bson.D, key bson.D) ([]mongoCatalogCountDoc, error) {
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+	if unwind != nil {
+		pipeline = append(pipeline, unwind)
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$group", Value: bson.D{
+		{Key: "_id", Value: key},
+		{Key: "open", Value: mongoCountIf(bson.D{{Key: "$eq", Value: bson.A{"$state", "open"}}})},
+		{Key: "closed", Value: mongoCountIf(bson.D{{Key: "$eq", Value: bson.A{"$state", "closed"}}})},
+	}}})
+	cursor, err := m.issuesCol.Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]mongoCatalogCountDoc, 0)
+	for cursor.Next(ctx) {
+		var doc mongoCatalogCountDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode catalog count doc: %w", err)
+		}
+		out = append(out, doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate catalog count docs: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+type mongoRepoSyncPolicy struct {
+	Disabled            bool       `bson:"disabled,omitempty"`
+	IntervalSeconds     int        `bson:"interval_seconds,omitempty"`
+	State               string     `bson:"state,omitempty"`
+	IncludeLabels       []string   `bson:"include_labels,omitempty"`
+	ExcludeLabels       []string   `bson:"exclude_labels,omitempty"`
+	ExcludePullRequests bool       `bson:"exclude_pull_requests,omitempty"`
+	SkipComments        bool       `bson:"skip_comments,omitempty"`
+	SyncSince           *time.Time `bson:"sync_since,omitempty"`
+}
+
+func (p mongoRepoSyncPolicy) toRepoSyncPolicy() RepoSyncPolicy {
+	return RepoSyncPolicy{
+		Disabled:            p.Disabled,
+		IntervalSeconds:     p.IntervalSeconds,
+		State:               p.State,
+		IncludeLabels:       p.IncludeLabels,
+		ExcludeLabels:       p.ExcludeLabels,
+		ExcludePullRequests: p.ExcludePullRequests,
+		SkipComments:        p.SkipComments,
+		SyncSince:           p.SyncSince,
+	}
+}
+
+func (m *MongoSyncStore) UpdateRepoSyncPolicy(ctx context.Context, repo string, policy RepoSyncPolicy) (ManagedRepo, error) {
+	result, err := m.managedRepoC.UpdateOne(ctx, bson.M{"repo": repo}, bson.M{"$set": bson.M{
+		"policy": mongoRepoSyncPolicy{
+			Disabled:            policy.Disabled,
+			IntervalSeconds:     policy.IntervalSeconds,
+			State:               policy.State,
+			IncludeLabels:       policy.IncludeLabels,
+			ExcludeLabels:       policy.ExcludeLabels,
+			ExcludePullRequests: policy.ExcludePullRequests,
+			SkipComments:        policy.SkipComments,
+			SyncSince:           policy.SyncSince,
+		},
+		"updated_at": time.Now().UTC(),
+	}})
+	if err != nil {
+		return ManagedRepo{}, fmt.Errorf("update repo sync policy: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ManagedRepo{}, ErrManagedRepoNotFound
+	}
+
+	var doc mongoManagedRepoDoc
+	if err := m.managedRepoC.FindOne(ctx, bson.M{"repo": repo}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ManagedRepo{}, ErrManagedRepoNotFound
+		}
+		return ManagedRepo{}, fmt.Errorf("get managed repo: %w", err)
+	}
+	return ManagedRepo{
+		Repo:      doc.Repo,
+		Rule:      doc.Rule,
+		Policy:    doc.Policy.toRepoSyncPolicy(),
+		CreatedAt: doc.CreatedAt,
+		UpdatedAt: doc.UpdatedAt,
+	}, nil
+}
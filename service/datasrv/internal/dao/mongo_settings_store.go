@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoSettingsDoc struct {
+	Section   string    `bson:"section"`
+	Version   int64     `bson:"version"`
+	Data      string    `bson:"data"`
+	UpdatedBy string    `bson:"updated_by,omitempty"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+type mongoSettingsChangeDoc struct {
+	Section   string    `bson:"section"`
+	Version   int64     `bson:"version"`
+	Data      string    `bson:"data"`
+	Fields    []string  `bson:"fields,omitempty"`
+	ChangedBy string    `bson:"changed_by,omitempty"`
+	ChangedAt time.Time `bson:"changed_at"`
+}
+
+func (m *MongoSyncStore) GetSettings(ctx context.Context, section string) (SettingsDocument, error) {
+	var doc mongoSettingsDoc
+	if err := m.settingsC.FindOne(ctx, bson.M{"section": section}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return SettingsDocument{}, ErrSettingsNotFound
+		}
+		return SettingsDocument{}, fmt.Errorf("get settings: %w", err)
+	}
+	return SettingsDocument{
+		Section:   doc.Section,
+		Version:   doc.Version,
+		Data:      doc.Data,
+		UpdatedBy: doc.UpdatedBy,
+		UpdatedAt: doc.UpdatedAt,
+	}, nil
+}
+
+// SaveSettings relies on the unique section index and the version filter to reject concurrent
+// saves. The change is recorded after the document is saved, without a transaction.
+func (m *MongoSyncStore) SaveSettings(ctx context.Context, doc SettingsDocument, fields []string) (SettingsDocument, error) {
+	saved := doc
+	saved.Version = doc.Version + 1
+	if saved.UpdatedAt.IsZero() {
+		saved.UpdatedAt = time.Now().UTC()
+	}
+	next := mongoSettingsDoc{
+		Section:   saved.Section,
+		Version:   saved.Version,
+		Data:      saved.Data,
+		UpdatedBy: saved.UpdatedBy,
+		UpdatedAt: saved.UpdatedAt,
+	}
+	if doc.Version == 0 {
+		if _, err := m.settingsC.InsertOne(ctx, next); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return SettingsDocument{}, ErrSettingsVersionConflict
+			}
+			return SettingsDocument{}, fmt.Errorf("insert settings: %w", err)
+		}
+	} else {
+		result, err := m.settingsC.ReplaceOne(ctx, bson.M{"section": doc.Section, "version": doc.Version}, next)
+		if err != nil {
+			return SettingsDocument{}, fmt.Errorf("replace settings: %w", err)
+		}
+		if result.MatchedCount == 0 {
+			return SettingsDocument{}, ErrSettingsVersionConflict
+		}
+	}
+	_, err := m.settingsHistoryC.InsertOne(ctx, mongoSettingsChangeDoc{
+		Section:   saved.Section,
+		Version:   saved.Version,
+		Data:      saved.Data,
+		Fields:    fields,
+		ChangedBy: saved.UpdatedBy,
+		ChangedAt: saved.UpdatedAt,
+	})
+	if err != nil {
+		return SettingsDocument{}, fmt.Errorf("insert settings change: %w", err)
+	}
+	return saved, nil
+}
+
+func (m *MongoSyncStore) ListSettingsChanges(ctx context.Context, section string, limit int) ([]SettingsChange, error) {
+	cursor, err := m.settingsHistoryC.Find(ctx, bson.M{"section": section},
+		options.Find().SetSort(bson.D{{Key: "version", Value: -1}}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, fmt.Errorf("list settings changes: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]SettingsChange, 0)
+	for cursor.Next(ctx) {
+		var doc mongoSettingsChangeDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode settings change doc: %w", err)
+		}
+		out = append(out, SettingsChange{
+			Section:   doc.Section,
+			Version:   doc.Version,
+			Data:      doc.Data,
+			Fields:    doc.Fields,
+			ChangedBy: doc.ChangedBy,
+			ChangedAt: doc.ChangedAt,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate settings changes: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoSyncRunTarget struct {
+	Target    string `bson:"target"`
+	Fetched   int32  `bson:"fetched"`
+	Persisted int32  `bson:"persisted"`
+	Failed    int32  `bson:"failed"`
+	Error     string `bson:"error,omitempty"`
+}
+
+type mongoSyncRunDoc struct {
+	ID         string               `bson:"_id"`
+	JobType    string               `bson:"job_type"`
+	Trigger    string               `bson:"trigger"`
+	Status     string               `bson:"status"`
+	StartedAt  time.Time            `bson:"started_at"`
+	FinishedAt time.Time            `bson:"finished_at"`
+	Fetched    int32                `bson:"fetched"`
+	Persisted  int32                `bson:"persisted"`
+	Failed     int32                `bson:"failed"`
+	Error      string               `bson:"error,omitempty"`
+	Targets    []mongoSyncRunTarget `bson:"targets"`
+}
+
+func (d mongoSyncRunDoc) toSyncRun() SyncRun {
+	targets := make([]SyncRunTarget, 0, len(d.Targets))
+	for _, t := range d.Targets {
+		targets = append(targets, SyncRunTarget{
+			Target:    t.Target,
+			Fetched:   t.Fetched,
+			Persisted: t.Persisted,
+			Failed:    t.Failed,
+			Err:       t.Error,
+		})
+	}
+	return SyncRun{
+		ID:         d.ID,
+		JobType:    d.JobType,
+		Trigger:    d.Trigger,
+		Status:     d.Status,
+		StartedAt:  d.StartedAt,
+		FinishedAt: d.FinishedAt,
+		Fetched:    d.Fetched,
+		Persisted:  d.Persisted,
+		Failed:     d.Failed,
+		Err:        d.Error,
+		Targets:    targets,
+	}
+}
+
+func (m *MongoSyncStore) SaveSyncRun(ctx context.Context, run SyncRun) error {
+	if run.ID == "" {
+		return fmt.Errorf("sync run id is empty")
+	}
+	doc := mongoSyncRunDoc{
+		ID:         run.ID,
+		JobType:    run.JobType,
+		Trigger:    run.Trigger,
+		Status:     run.Status,
+		StartedAt:  run.StartedAt.UTC(),
+		FinishedAt: run.FinishedAt.UTC(),
+		Fetched:    run.Fetched,
+		Persisted:  run.Persisted,
+		Failed:     run.Failed,
+		Error:      run.Err,
+		Targets:    make([]mongoSyncRunTarget, 0, len(run.Targets)),
+	}
+	for _, t := range run.Targets {
+		doc.Targets = append(doc.Targets, mongoSyncRunTarget{
+			Target:    t.Target,
+			Fetched:   t.Fetched,
+			Persisted: t.Persisted,
+			Failed:    t.Failed,
+			Error:     t.Err,
+		})
+	}
+	if _, err := m.syncRunC.InsertOne(ctx, doc); err != nil {
+		return fmt.Errorf("save sync run: %w", err)
+	}
+	return nil
+}
+
+func (m *MongoSyncStore) ListSyncRuns(ctx context.Context, filter SyncRunFilter) ([]SyncRun, error) {
+	query := bson.M{}
+	if filter.JobType != "" {
+		query["job_type"] = filter.JobType
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	started := bson.M{}
+	if !filter.StartedAfter.IsZero() {
+		started["$gte"] = filter.StartedAfter.UTC()
+	}
+	if !filter.StartedBefore.IsZero() {
+		started["$lt"] = filter.StartedBefore.UTC()
+	}
+	if len(started) > 0 {
+		query["started_at"] = started
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "started_at", Value: -1}, {Key: "_id", Value: 1}})
+	if filter.Offset > 0 {
+		opts.SetSkip(int64(filter.Offset))
+	}
+	if filter.Limit > 0 {
+		opts.SetLimit(int64(filter.Limit))
+	}
+
+	cursor, err := m.syncRunC.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo list sync runs: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	out := make([]SyncRun, 0)
+	for cursor.Next(ctx) {
+		var doc mongoSyncRunDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode sync run doc: %w", err)
+		}
+		out = append(out, doc.toSyncRun())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("iterate sync runs: %w", err)
+	}
+	return out, nil
+}
+
+func (m *MongoSyncStore) GetSyncRun(ctx context.Context, id string) (SyncRun, error) {
+	var doc mongoSyncRunDoc
+	if err := m.syncRunC.FindOne(ctx, bson.M{"_id": id}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return SyncRun{}, ErrSyncRunNotFound
+		}
+		return SyncRun{}, fmt.Errorf("get sync run: %w", err)
+	}
+	return doc.toSyncRun(), nil
+}
+
+func (m *MongoSyncStore) PruneSyncRuns(ctx context.Context, before time.Time) (int, error) {
+	result, err := m.syncRunC.DeleteMany(ctx, bson.M{"started_at": bson.M{"$lt": before.UTC()}})
+	if err != nil {
+		return 0, fmt.Errorf("prune sync runs: %w", err)
+	}
+	return int(result.DeletedCount), nil
+}
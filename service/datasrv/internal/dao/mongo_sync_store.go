@@ -21,6 +21,7 @@ type mongoIssueDoc struct {
 	Author        string     `bson:"author"`
 	Assignees     []string   `bson:"assignees"`
 	Labels        []string   `bson:"labels"`
+	Milestone     int32      `bson:"milestone,omitempty"`
 	Comments      int32      `bson:"comments"`
 	IsPullRequest bool       `bson:"is_pull_request"`
 	HTMLURL       string     `bson:"html_url"`
@@ -29,6 +30,11 @@ type mongoIssueDoc struct {
 	ClosedAt      *time.Time `bson:"closed_at,omitempty"`
 	AISummary     string     `bson:"ai_summary,omitempty"`
 	Raw           string     `bson:"raw,omitempty"`
+	Tombstone     string     `bson:"tombstone,omitempty"`
+	TombstonedAt  *time.Time `bson:"tombstoned_at,omitempty"`
+	TransferredTo string     `bson:"transferred_to,omitempty"`
+	// CommentsText is the comment thread flattened for search; see SetIssueCommentText.
+	CommentsText string `bson:"comments_text,omitempty"`
 }
 
 type mongoCheckpointDoc struct {
@@ -41,9 +47,11 @@ type mongoCheckpointDoc struct {
 }
 
 type mongoManagedRepoDoc struct {
-	Repo      string    `bson:"repo"`
-	CreatedAt time.Time `bson:"created_at"`
-	UpdatedAt time.Time `bson:"updated_at"`
+	Repo      string              `bson:"repo"`
+	Rule      string              `bson:"rule,omitempty"`
+	Policy    mongoRepoSyncPolicy `bson:"policy"`
+	CreatedAt time.Time           `bson:"created_at"`
+	UpdatedAt time.Time           `bson:"updated_at"`
 }
 
 type mongoFeedSourceDoc struct {
@@ -92,14 +100,26 @@ type mongoFeedCheckpointDoc struct {
 
 // MongoSyncStore stores synced issue data in MongoDB.
 type MongoSyncStore struct {
-	client          *mongo.Client
-	db              *mongo.Database
-	issuesCol       *mongo.Collection
-	checkpointC     *mongo.Collection
-	managedRepoC    *mongo.Collection
-	feedSourceC     *mongo.Collection
-	feedContentC    *mongo.Collection
-	feedCheckpointC *mongo.Collection
+	client           *mongo.Client
+	db               *mongo.Database
+	issuesCol        *mongo.Collection
+	checkpointC      *mongo.Collection
+	managedRepoC     *mongo.Collection
+	feedSourceC      *mongo.Collection
+	feedContentC     *mongo.Collection
+	feedCheckpointC  *mongo.Collection
+	webhookDeliveryC *mongo.Collection
+	githubETagC      *mongo.Collection
+	pullRequestC     *mongo.Collection
+	issueEventC      *mongo.Collection
+	repoLabelC       *mongo.Collection
+	repoMilestoneC   *mongo.Collection
+	managedRuleC     *mongo.Collection
+	syncRunC         *mongo.Collection
+	commentCursorC   *mongo.Collection
+	issueCommentC    *mongo.Collection
+	settingsC        *mongo.Collection
+	settingsHistoryC *mongo.Collection
 }
 
 func NewMongoSyncStore(uri, dbName string) (*MongoSyncStore, error) {
@@ -117,20 +137,36 @@ func NewMongoSyncStore(uri, dbName string) (*MongoSyncStore, error) {
 
 	db := client.Database(dbName)
 	store := &MongoSyncStore{
-		client:          client,
-		db:              db,
-		issuesCol:       db.Collection("github_issues"),
-		checkpointC:     db.Collection("github_issue_checkpoints"),
-		managedRepoC:    db.Collection("github_sync_repos"),
-		feedSourceC:     db.Collection("rss_feed_sources"),
-		feedContentC:    db.Collection("rss_feed_contents"),
-		feedCheckpointC: db.Collection("rss_feed_checkpoints"),
+		client:           client,
+		db:               db,
+		issuesCol:        db.Collection("github_issues"),
+		checkpointC:      db.Collection("github_issue_checkpoints"),
+		managedRepoC:     db.Collection("github_sync_repos"),
+		feedSourceC:      db.Collection("rss_feed_sources"),
+		feedContentC:     db.Collection("rss_feed_contents"),
+		feedCheckpointC:  db.Collection("rss_feed_checkpoints"),
+		webhookDeliveryC: db.Collection("github_webhook_deliveries"),
+		githubETagC:      db.Collection("github_request_etags"),
+		pullRequestC:     db.Collection("github_pull_requests"),
+		issueEventC:      db.Collection("github_issue_events"),
+		repoLabelC:       db.Collection("github_repo_labels"),
+		repoMilestoneC:   db.Collection("github_repo_milestones"),
+		managedRuleC:     db.Collection("github_sync_repo_rules"),
+		syncRunC:         db.Collection("github_sync_runs"),
+		commentCursorC:   db.Collection("github_comment_checkpoints"),
+		issueCommentC:    db.Collection("github_issue_comments"),
+		settingsC:        db.Collection("datasrv_settings"),
+		settingsHistoryC: db.Collection("datasrv_settings_history"),
 	}
 
 	if err := store.ensureIndexes(context.Background()); err != nil {
 		_ = client.Disconnect(context.Background())
 		return nil, err
 	}
+	if err := store.backfillIssueFilterFields(context.Background()); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, err
+	}
 
 	return store, nil
 }
@@ -139,6 +175,21 @@ func (m *MongoSyncStore) ensureIndexes(ctx context.Context) error {
 	_, err := m.issuesCol.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{Keys: bson.D{{Key: "repo", Value: 1}, {Key: "issue_id", Value: 1}}, Options: options.Index().SetUnique(true)},
 		{Keys: bson.D{{Key: "repo", Value: 1}, {Key: "updated_at", Value: -1}}},
+		{Keys: bson.D{{Key: "repo", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "labels_lower", Value: 1}}},
+		{Keys: bson.D{{Key: "assignees_lower", Value: 1}}},
+		{Keys: bson.D{{Key: "author_lower", Value: 1}}},
+		{
+			Keys: bson.D{
+				{Key: "title", Value: "text"},
+				{Key: "ai_summary", Value: "text"},
+				{Key: "body", Value: "text"},
+				{Key: "comments_text", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("issues_text").
+				SetWeights(bson.D{{Key: "title", Value: 10}, {Key: "ai_summary", Value: 5}, {Key: "body", Value: 3}, {Key: "comments_text", Value: 1}}),
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("create issues indexes: %w", err)
@@ -182,6 +233,109 @@ func (m *MongoSyncStore) ensureIndexes(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("create feed checkpoint indexes: %w", err)
 	}
+
+	_, err = m.webhookDeliveryC.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "delivery_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "received_at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("create webhook delivery indexes: %w", err)
+	}
+
+	_, err = m.githubETagC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "repo", Value: 1}, {Key: "endpoint", Value: 1}, {Key: "page", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create github etag indexes: %w", err)
+	}
+
+	_, err = m.pullRequestC.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "repo", Value: 1}, {Key: "issue_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "issue_id", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("create pull request indexes: %w", err)
+	}
+
+	_, err = m.issueEventC.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "repo", Value: 1}, {Key: "issue_id", Value: 1}, {Key: "event_key", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "repo", Value: 1}, {Key: "issue_number", Value: 1}, {Key: "created_at", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("create issue event indexes: %w", err)
+	}
+
+	_, err = m.repoLabelC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "repo", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create repo label indexes: %w", err)
+	}
+
+	_, err = m.repoMilestoneC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "repo", Value: 1}, {Key: "number", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create repo milestone indexes: %w", err)
+	}
+
+	_, err = m.managedRepoC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "rule", Value: 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("create managed repo tag indexes: %w", err)
+	}
+
+	_, err = m.managedRuleC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "rule", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create managed repo rule indexes: %w", err)
+	}
+
+	_, err = m.syncRunC.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "started_at", Value: -1}}},
+		{Keys: bson.D{{Key: "job_type", Value: 1}, {Key: "started_at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("create sync run indexes: %w", err)
+	}
+
+	_, err = m.commentCursorC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "repo", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create comment checkpoint indexes: %w", err)
+	}
+
+	_, err = m.issueCommentC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "repo", Value: 1}, {Key: "issue_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create issue comment indexes: %w", err)
+	}
+
+	_, err = m.settingsC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "section", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create settings indexes: %w", err)
+	}
+
+	_, err = m.settingsHistoryC.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "section", Value: 1}, {Key: "version", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("create settings history indexes: %w", err)
+	}
 	return nil
 }
 
@@ -201,8 +355,12 @@ func (m *MongoSyncStore) UpsertIssues(ctx context.Context, repo string, issues [
 			"body":            it.Body,
 			"state":           it.State,
 			"author":          it.Author,
+			"author_lower":    strings.ToLower(it.Author),
 			"assignees":       it.Assignees,
+			"assignees_lower": lowerAll(it.Assignees),
 			"labels":          it.Labels,
+			"labels_lower":    lowerAll(it.Labels),
+			"milestone":       it.Milestone,
 			"comments":        it.Comments,
 			"is_pull_request": it.IsPullRequest,
 			"html_url":        it.HTMLURL,
@@ -216,6 +374,11 @@ func (m *MongoSyncStore) UpsertIssues(ctx context.Context, repo string, issues [
 			"$setOnInsert": bson.M{
 				"ai_summary": it.AISummary,
 			},
+			"$unset": bson.M{
+				"tombstone":      "",
+				"tombstoned_at":  "",
+				"transferred_to": "",
+			},
 		}).SetUpsert(true))
 	}
 
@@ -240,6 +403,8 @@ func (m *MongoSyncStore) ListManagedRepos(ctx context.Context) ([]ManagedRepo, e
 		}
 		out = append(out, ManagedRepo{
 			Repo:      doc.Repo,
+			Rule:      doc.Rule,
+			Policy:    doc.Policy.toRepoSyncPolicy(),
 			CreatedAt: doc.CreatedAt,
 			UpdatedAt: doc.UpdatedAt,
 		})
@@ -284,48 +449,14 @@ func (m *MongoSyncStore) ReplaceManagedRepos(ctx context.Context, repos []string
 }
 
 func (m *MongoSyncStore) ListIssues(ctx context.Context, filter SyncIssueFilter) ([]SyncedIssue, error) {
-	q := bson.M{}
-	if filter.Repo != "" {
-		q["repo"] = filter.Repo
-	}
-	if filter.State != "" && filter.State != "all" {
-		q["state"] = filter.State
-	}
-	if filter.IssueID > 0 {
-		q["issue_id"] = filter.IssueID
-	}
-	if filter.Number > 0 {
-		q["number"] = filter.Number
-	}
-
-	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: q}},
-		{{
-			Key: "$addFields",
-			Value: bson.D{{
-				Key: "has_ai_summary",
-				Value: bson.D{{
-					Key: "$cond",
-					Value: bson.A{
-						bson.D{{
-							Key: "$gt",
-							Value: bson.A{
-								bson.D{{
-									Key:   "$strLenCP",
-									Value: bson.D{{Key: "$ifNull", Value: bson.A{"$ai_summary", ""}}},
-								}},
-								0,
-							},
-						}},
-						1,
-						0,
-					},
-				}},
-			}},
-		}},
-		{{Key: "$sort", Value: bson.D{{Key: "has_ai_summary", Value: -1}, {Key: "updated_at", Value: -1}}}},
-	}
-	if filter.Offset > 0 {
+	stages, order := mongoIssueOrder(filter)
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: mongoIssueFilter(filter)}}}
+	pipeline = append(pipeline, stages...)
+	if filter.After != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: mongoKeysetAfter(order, mongoIssueCursorValues(filter.Sort, *filter.After))}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: mongoKeysetSort(order)}})
+	if filter.After == nil && filter.Offset > 0 {
 		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(filter.Offset)}})
 	}
 	if filter.Limit > 0 {
@@ -344,25 +475,7 @@ func (m *MongoSyncStore) ListIssues(ctx context.Context, filter SyncIssueFilter)
 		if err := cursor.Decode(&doc); err != nil {
 			return nil, fmt.Errorf("decode issue doc: %w", err)
 		}
-		out = append(out, SyncedIssue{
-			Repo:          doc.Repo,
-			IssueID:       doc.IssueID,
-			Number:        doc.Number,
-			Title:         doc.Title,
-			Body:          doc.Body,
-			State:         doc.State,
-			Author:        doc.Author,
-			Assignees:     doc.Assignees,
-			Labels:        doc.Labels,
-			Comments:      doc.Comments,
-			IsPullRequest: doc.IsPullRequest,
-			HTMLURL:       doc.HTMLURL,
-			CreatedAt:     doc.CreatedAt,
-			UpdatedAt:     doc.UpdatedAt,
-			ClosedAt:      doc.ClosedAt,
-			AISummary:     doc.AISummary,
-			Raw:           doc.Raw,
-		})
+		out = append(out, doc.toSyncedIssue())
 	}
 	if err := cursor.Err(); err != nil {
 		return nil, fmt.Errorf("iterate issue docs: %w", err)
@@ -370,6 +483,14 @@ func (m *MongoSyncStore) ListIssues(ctx context.Context, filter SyncIssueFilter)
 	return out, nil
 }
 
+func (m *MongoSyncStore) CountIssues(ctx context.Context, filter SyncIssueFilter) (int64, error) {
+	count, err := m.issuesCol.CountDocuments(ctx, mongoIssueFilter(filter))
+	if err != nil {
+		return 0, fmt.Errorf("mongo count issues: %w", err)
+	}
+	return count, nil
+}
+
 func (m *MongoSyncStore) GetRepoCheckpoint(ctx context.Context, repo string) (Checkpoint, error) {
 	var doc mongoCheckpointDoc
 	err := m.checkpointC.FindOne(ctx, bson.M{"repo": repo}).Decode(&doc)
@@ -408,7 +529,7 @@ func (m *MongoSyncStore) UpdateIssueAISummary(ctx context.Context, repo string,
 		return SyncedIssue{}, ErrIssueNotFound
 	}
 
-	rows, err := m.ListIssues(ctx, SyncIssueFilter{Repo: repo, IssueID: issueID, Number: number, Limit: 1})
+	rows, err := m.ListIssues(ctx, SyncIssueFilter{Repo: repo, IssueID: issueID, Number: number, Limit: 1, IncludeTombstoned: true})
 	if err != nil {
 		return SyncedIssue{}, err
 	}
@@ -642,16 +763,15 @@ func (m *MongoSyncStore) UpsertFeedContents(ctx context.Context, sourceID string
 	return len(contents), nil
 }
 
+// mongoFeedContentOrder lists feed contents newest first.
+var mongoFeedContentOrder = []mongoKeysetField{{name: "published_at", desc: true}, {name: "id"}}
+
 func (m *MongoSyncStore) ListFeedContents(ctx context.Context, filter FeedContentFilter) ([]FeedContent, error) {
-	query := bson.M{}
-	if filter.FeedSourceID != "" {
-		query["feed_source_id"] = filter.FeedSourceID
-	}
-	if filter.ContentID != "" {
-		query["id"] = filter.ContentID
-	}
-	opts := options.Find().SetSort(bson.D{{Key: "published_at", Value: -1}, {Key: "id", Value: 1}})
-	if filter.Offset > 0 {
+	query := mongoFeedContentFilter(filter)
+	opts := options.Find().SetSort(mongoKeysetSort(mongoFeedContentOrder))
+	if filter.After != nil {
+		query = bson.M{"$and": bson.A{query, mongoKeysetAfter(mongoFeedContentOrder, []any{filter.After.PublishedAt, filter.After.ID})}}
+	} else if filter.Offset > 0 {
 		opts.SetSkip(int64(filter.Offset))
 	}
 	if filter.Limit > 0 {
@@ -691,6 +811,25 @@ func (m *MongoSyncStore) ListFeedContents(ctx context.Context, filter FeedConten
 	return out, nil
 }
 
+func (m *MongoSyncStore) CountFeedContents(ctx context.Context, filter FeedContentFilter) (int64, error) {
+	count, err := m.feedContentC.CountDocuments(ctx, mongoFeedContentFilter(filter))
+	if err != nil {
+		return 0, fmt.Errorf("count feed contents: %w", err)
+	}
+	return count, nil
+}
+
+func mongoFeedContentFilter(filter FeedContentFilter) bson.M {
+	query := bson.M{}
+	if filter.FeedSourceID != "" {
+		query["feed_source_id"] = filter.FeedSourceID
+	}
+	if filter.ContentID != "" {
+		query["id"] = filter.ContentID
+	}
+	return query
+}
+
 func (m *MongoSyncStore) GetFeedContent(ctx context.Context, id string) (FeedContent, error) {
 	rows, err := m.ListFeedContents(ctx, FeedContentFilter{ContentID: id, Limit: 1})
 	if err != nil {
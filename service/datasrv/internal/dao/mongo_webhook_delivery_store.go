@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type mongoWebhookDeliveryDoc struct {
+	DeliveryID string    `bson:"delivery_id"`
+	Event      string    `bson:"event"`
+	Action     string    `bson:"action"`
+	Repo       string    `bson:"repo"`
+	ReceivedAt time.Time `bson:"received_at"`
+}
+
+func (m *MongoSyncStore) HasWebhookDelivery(ctx context.Context, deliveryID string) (bool, error) {
+	count, err := m.webhookDeliveryC.CountDocuments(ctx, bson.M{"delivery_id": deliveryID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("get webhook delivery: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (m *MongoSyncStore) SaveWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error {
+	if delivery.DeliveryID == "" {
+		return fmt.Errorf("webhook delivery id is empty")
+	}
+	if delivery.ReceivedAt.IsZero() {
+		delivery.ReceivedAt = time.Now().UTC()
+	}
+	_, err := m.webhookDeliveryC.UpdateOne(ctx,
+		bson.M{"delivery_id": delivery.DeliveryID},
+		bson.M{"$setOnInsert": mongoWebhookDeliveryDoc{
+			DeliveryID: delivery.DeliveryID,
+			Event:      delivery.Event,
+			Action:     delivery.Action,
+			Repo:       delivery.Repo,
+			ReceivedAt: delivery.ReceivedAt,
+		}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("save webhook delivery: %w", err)
+	}
+	return nil
+}
@@ -28,6 +28,19 @@ type PRReviewFilter struct {
 	Number int32
 	Offset int
 	Limit  int
+	// After continues a listing after the review it was taken from, in place of Offset.
+	After *PRReviewCursor
+}
+
+// PRReviewCursor is the position of a review in a listing, latest update first.
+type PRReviewCursor struct {
+	UpdatedAt time.Time
+	IssueID   int64
+}
+
+// PRReviewCountStore counts the reviews of a filter, ignoring its paging.
+type PRReviewCountStore interface {
+	CountPRReviews(ctx context.Context, filter PRReviewFilter) (int64, error)
 }
 
 // PRReviewStore is the abstraction over persistence for PR AI reviews.
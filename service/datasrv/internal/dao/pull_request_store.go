@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// Review decisions derived from the latest verdict of each reviewer.
+const (
+	ReviewDecisionApproved         = "approved"
+	ReviewDecisionChangesRequested = "changes_requested"
+	ReviewDecisionReviewRequired   = "review_required"
+)
+
+// PullRequestDetail holds the pull request fields the issues API does not return.
+type PullRequestDetail struct {
+	Repo    string
+	IssueID int64
+	Number  int32
+
+	Merged   bool
+	MergedAt *time.Time
+	MergedBy string
+	Draft    bool
+
+	HeadRef string
+	HeadSHA string
+	BaseRef string
+
+	Additions    int32
+	Deletions    int32
+	ChangedFiles int32
+
+	// RequestedReviewers and RequestedTeams are still waiting to review.
+	RequestedReviewers []string
+	RequestedTeams     []string
+	// Reviews keeps the latest submitted verdict per reviewer.
+	Reviews        []PullRequestReviewVerdict
+	ReviewDecision string
+
+	// UpdatedAt is the GitHub update time of the pull request.
+	UpdatedAt time.Time
+	SyncedAt  time.Time
+}
+
+// PullRequestReviewVerdict is one reviewer's latest submitted review state, such as
+// APPROVED, CHANGES_REQUESTED, COMMENTED or DISMISSED.
+type PullRequestReviewVerdict struct {
+	Reviewer    string    `json:"reviewer" bson:"reviewer"`
+	State       string    `json:"state" bson:"state"`
+	SubmittedAt time.Time `json:"submitted_at" bson:"submitted_at"`
+}
+
+// PullRequestStore persists pull request details next to the synced issue rows.
+type PullRequestStore interface {
+	UpsertPullRequests(ctx context.Context, prs []PullRequestDetail) (int, error)
+	// ListPullRequests returns the details stored for the given issue ids; ids without details are skipped.
+	ListPullRequests(ctx context.Context, issueIDs []int64) ([]PullRequestDetail, error)
+}
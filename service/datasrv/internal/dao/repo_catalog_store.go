@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// RepoLabel is one label defined in a repository.
+type RepoLabel struct {
+	Repo        string
+	LabelID     int64
+	Name        string
+	Color       string
+	Description string
+	SyncedAt    time.Time
+}
+
+// RepoMilestone is one milestone defined in a repository.
+type RepoMilestone struct {
+	Repo        string
+	MilestoneID int64
+	Number      int32
+	Title       string
+	Description string
+	State       string
+	DueOn       *time.Time
+	ClosedAt    *time.Time
+	SyncedAt    time.Time
+}
+
+// RepoCatalogStore keeps the label and milestone catalog of each repository. Replace calls
+// drop entries that are no longer in the given list, so deleted labels and milestones disappear.
+type RepoCatalogStore interface {
+	ReplaceRepoLabels(ctx context.Context, repo string, labels []RepoLabel) error
+	ReplaceRepoMilestones(ctx context.Context, repo string, milestones []RepoMilestone) error
+	// ListRepoLabels returns labels ordered by repo and name; an empty repo lists every repo.
+	ListRepoLabels(ctx context.Context, repo string) ([]RepoLabel, error)
+	// ListRepoMilestones returns milestones ordered by repo and number; an empty repo lists every repo.
+	ListRepoMilestones(ctx context.Context, repo string) ([]RepoMilestone, error)
+}
+
+// RepoLabelCount counts the open and closed issues of a repo carrying a label. Name is lower-cased.
+type RepoLabelCount struct {
+	Repo   string
+	Name   string
+	Open   int64
+	Closed int64
+}
+
+// RepoMilestoneCount counts the open and closed issues of a repo in a milestone.
+type RepoMilestoneCount struct {
+	Repo   string
+	Number int32
+	Open   int64
+	Closed int64
+}
+
+// RepoCatalogCountStore counts the live issues per label and milestone inside the store, so that
+// catalog listings don't need to load them. An empty repo counts every repo.
+type RepoCatalogCountStore interface {
+	CountLabelIssues(ctx context.Context, repo string) ([]RepoLabelCount, error)
+	CountMilestoneIssues(ctx context.Context, repo string) ([]RepoMilestoneCount, error)
+}
@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrManagedRepoNotFound = errors.New("managed repo not found")
+
+// RepoSyncPolicy overrides the global sync config for one managed repo. The zero value keeps the
+// global behavior, which is why the flags are stored in their negative form.
+type RepoSyncPolicy struct {
+	// Disabled keeps the repo out of scheduled and all-repo runs.
+	Disabled bool
+	// IntervalSeconds overrides the sync interval of the repo; zero follows the global interval.
+	IntervalSeconds int
+	// State limits the listing to open or closed issues; empty lists all.
+	State string
+	// IncludeLabels keeps only issues carrying at least one of the labels.
+	IncludeLabels []string
+	// ExcludeLabels drops issues carrying any of the labels.
+	ExcludeLabels       []string
+	ExcludePullRequests bool
+	SkipComments        bool
+	// SyncSince skips issues last updated before it.
+	SyncSince *time.Time
+}
+
+// RepoSyncPolicyStore keeps the sync policy of each managed repo. Policies are returned with the
+// managed repos from ListManagedRepos and survive ReplaceManagedRepos for repos that stay managed.
+type RepoSyncPolicyStore interface {
+	// UpdateRepoSyncPolicy replaces the policy of repo, or returns ErrManagedRepoNotFound.
+	UpdateRepoSyncPolicy(ctx context.Context, repo string, policy RepoSyncPolicy) (ManagedRepo, error)
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrSettingsNotFound is returned by GetSettings for a section that was never saved.
+	ErrSettingsNotFound = errors.New("settings not found")
+	// ErrSettingsVersionConflict is returned by SaveSettings when another change was saved first.
+	ErrSettingsVersionConflict = errors.New("settings version conflict")
+)
+
+// SettingsDocument is the saved runtime config of one section, such as github_sync.
+type SettingsDocument struct {
+	Section string
+	// Version counts the saves of the section, starting at 1.
+	Version int64
+	// Data is the section as a JSON object.
+	Data      string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// SettingsChange is one saved version of a section in the audit trail.
+type SettingsChange struct {
+	Section string
+	Version int64
+	Data    string
+	// Fields names the settings that differ from the previous version.
+	Fields    []string
+	ChangedBy string
+	ChangedAt time.Time
+}
+
+// SettingsStore keeps the runtime config changed through the admin API, with every version kept
+// as a SettingsChange.
+type SettingsStore interface {
+	GetSettings(ctx context.Context, section string) (SettingsDocument, error)
+	// SaveSettings stores doc as version doc.Version+1 of its section and records the change. It
+	// returns ErrSettingsVersionConflict unless doc.Version is the stored version, 0 for a section
+	// never saved.
+	SaveSettings(ctx context.Context, doc SettingsDocument, fields []string) (SettingsDocument, error)
+	// ListSettingsChanges returns the latest changes of section, newest first.
+	ListSettingsChanges(ctx context.Context, section string, limit int) ([]SettingsChange, error)
+}
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Job types recorded in the sync run history.
+const (
+	SyncRunJobIssueSync    = "issue_sync"
+	SyncRunJobFeedSync     = "feed_sync"
+	SyncRunJobIssueSummary = "issue_summary"
+	SyncRunJobPRReview     = "pr_review"
+)
+
+// What started a sync run.
+const (
+	SyncRunTriggerScheduler = "scheduler"
+	SyncRunTriggerManual    = "manual"
+	SyncRunTriggerWebhook   = "webhook"
+)
+
+// Sync run outcomes. A partial run finished with some of its targets failing.
+const (
+	SyncRunStatusSuccess = "success"
+	SyncRunStatusPartial = "partial"
+	SyncRunStatusFailed  = "failed"
+)
+
+// ErrSyncRunNotFound is returned by GetSyncRun for an unknown or pruned run id.
+var ErrSyncRunNotFound = errors.New("sync run not found")
+
+// SyncRunTarget is the outcome of one repo or feed source within a run.
+type SyncRunTarget struct {
+	Target    string
+	Fetched   int32
+	Persisted int32
+	// Failed counts items that could not be processed; a target that stopped on an error counts one.
+	Failed int32
+	Err    string
+}
+
+// SyncRun is one finished issue sync, feed sync, issue summary or PR review run. The counts are the
+// sums over Targets.
+type SyncRun struct {
+	ID         string
+	JobType    string
+	Trigger    string
+	Status     string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Fetched    int32
+	Persisted  int32
+	Failed     int32
+	// Err is set when the run failed before or outside of its targets.
+	Err     string
+	Targets []SyncRunTarget
+}
+
+type SyncRunFilter struct {
+	JobType string
+	Status  string
+	// StartedAfter and StartedBefore bound StartedAt; zero values leave the range open.
+	StartedAfter  time.Time
+	StartedBefore time.Time
+	Offset        int
+	Limit         int
+}
+
+// SyncRunStore keeps the run history. Runs are returned newest first.
+type SyncRunStore interface {
+	SaveSyncRun(ctx context.Context, run SyncRun) error
+	ListSyncRuns(ctx context.Context, filter SyncRunFilter) ([]SyncRun, error)
+	GetSyncRun(ctx context.Context, id string) (SyncRun, error)
+	// PruneSyncRuns deletes runs started before the cutoff and returns how many were removed.
+	PruneSyncRuns(ctx context.Context, before time.Time) (int, error)
+}
@@ -8,6 +8,17 @@ import (
 
 var ErrIssueNotFound = errors.New("issue not found")
 
+// Tombstone reasons recorded by issue reconciliation.
+const (
+	// TombstoneDeleted marks an issue GitHub answers with 410 Gone.
+	TombstoneDeleted = "deleted"
+	// TombstoneTransferred marks an issue that moved to another repository.
+	TombstoneTransferred = "transferred"
+	// TombstoneMissing marks an issue GitHub answers with 404, such as one converted
+	// to a discussion or hidden from the sync credentials.
+	TombstoneMissing = "missing"
+)
+
 // SyncedIssue is the normalized persistence model used by sync workers.
 type SyncedIssue struct {
 	Repo          string
@@ -19,6 +30,7 @@ type SyncedIssue struct {
 	Author        string
 	Assignees     []string
 	Labels        []string
+	Milestone     int32
 	Comments      int32
 	IsPullRequest bool
 	HTMLURL       string
@@ -27,6 +39,12 @@ type SyncedIssue struct {
 	ClosedAt      *time.Time
 	AISummary     string
 	Raw           string
+
+	// Tombstone is empty for live issues and one of the Tombstone* reasons otherwise.
+	Tombstone    string
+	TombstonedAt *time.Time
+	// TransferredTo is the owner/repo#number a transferred issue moved to, when known.
+	TransferredTo string
 }
 
 type IssueComment struct {
@@ -38,6 +56,9 @@ type IssueComment struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	HTMLURL       string
+	// AuthorAssociation is GitHub's relation of the author to the repo, such as OWNER, MEMBER,
+	// COLLABORATOR or NONE. Empty for other hosts and for comments stored before it was kept.
+	AuthorAssociation string
 }
 
 // Checkpoint tracks last sync state per repository.
@@ -51,7 +72,10 @@ type Checkpoint struct {
 }
 
 type ManagedRepo struct {
-	Repo      string
+	Repo string
+	// Rule is the managed repo rule that produced the row; empty for rows stored before rules existed.
+	Rule      string
+	Policy    RepoSyncPolicy
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -64,8 +88,94 @@ type SyncIssueFilter struct {
 	Number  int32
 	Offset  int
 	Limit   int
+
+	// IncludeTombstoned also returns issues reconciliation found deleted or transferred.
+	IncludeTombstoned bool
+
+	// Labels keeps the issues that have every label, or any of them with AnyLabel. Label names
+	// match case-insensitively, as do Assignee and Author.
+	Labels   []string
+	AnyLabel bool
+	Assignee string
+	Author   string
+	// IsPullRequest and HasAISummary filter when set.
+	IsPullRequest *bool
+	HasAISummary  *bool
+	// The time ranges include their After bound and exclude their Before bound; zero times are
+	// unbounded.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	ClosedAfter   time.Time
+	ClosedBefore  time.Time
+	// MinComments and MaxComments bound the comment count inclusively when set.
+	MinComments *int32
+	MaxComments *int32
+
+	// Sort is one of the IssueSort values; the default lists issues with an AI summary first,
+	// then by last update.
+	Sort          IssueSort
+	SortAscending bool
+	// After continues a listing after the issue it was taken from, in place of Offset. It must
+	// come from a listing with the same Sort.
+	After *IssueCursor
 }
 
+// IssueCursor is the position of an issue in a listing, for keyset paging. Flag is whether the
+// issue has an AI summary for the default sort and whether it was never closed for IssueSortClosed;
+// Time and Count hold the sort key of time and count sorts. Repo and IssueID break ties, since
+// issue IDs are only unique within a repo.
+type IssueCursor struct {
+	Flag    bool
+	Time    time.Time
+	Count   int64
+	Repo    string
+	IssueID int64
+}
+
+// NewIssueCursor returns the position of issue in a listing ordered by sort.
+func NewIssueCursor(sort IssueSort, issue SyncedIssue) IssueCursor {
+	cursor := IssueCursor{Repo: issue.Repo, IssueID: issue.IssueID}
+	switch sort {
+	case IssueSortCreated:
+		cursor.Time = issue.CreatedAt
+	case IssueSortUpdated:
+		cursor.Time = issue.UpdatedAt
+	case IssueSortClosed:
+		cursor.Flag = issue.ClosedAt == nil
+		if issue.ClosedAt != nil {
+			cursor.Time = *issue.ClosedAt
+		}
+	case IssueSortComments:
+		cursor.Count = int64(issue.Comments)
+	case IssueSortNumber:
+		cursor.Count = int64(issue.Number)
+	default:
+		cursor.Flag = issue.AISummary != ""
+		cursor.Time = issue.UpdatedAt
+	}
+	return cursor
+}
+
+// IssueCountStore counts the issues of a filter, ignoring its paging.
+type IssueCountStore interface {
+	CountIssues(ctx context.Context, filter SyncIssueFilter) (int64, error)
+}
+
+// IssueSort names the field ListIssues orders by. Ties are broken by issue id, in the same
+// direction.
+type IssueSort string
+
+const (
+	IssueSortDefault  IssueSort = ""
+	IssueSortCreated  IssueSort = "created"
+	IssueSortUpdated  IssueSort = "updated"
+	IssueSortClosed   IssueSort = "closed"
+	IssueSortComments IssueSort = "comments"
+	IssueSortNumber   IssueSort = "number"
+)
+
 // SyncStore is the abstraction over persistence backends used by sync logic.
 type SyncStore interface {
 	UpsertIssues(ctx context.Context, repo string, issues []SyncedIssue) (int, error)
@@ -0,0 +1,21 @@
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookDelivery records a processed GitHub webhook delivery.
+type WebhookDelivery struct {
+	DeliveryID string
+	Event      string
+	Action     string
+	Repo       string
+	ReceivedAt time.Time
+}
+
+// WebhookDeliveryStore remembers processed delivery IDs so GitHub redeliveries are idempotent.
+type WebhookDeliveryStore interface {
+	HasWebhookDelivery(ctx context.Context, deliveryID string) (bool, error)
+	SaveWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error
+}
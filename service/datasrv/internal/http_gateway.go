@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/go-github/v82/github"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	blogv1 "github.com/kongken/datasrv/pkg/proto/blog/v1"
 	feedsv1 "github.com/kongken/datasrv/pkg/proto/feeds/v1"
@@ -26,18 +28,46 @@ type gatewayRegistrar func(context.Context, *runtime.ServeMux, string, []grpc.Di
 
 var gatewayHandler http.Handler
 var adminTokenValidator service.AdminTokenStore
+var githubWebhookSvc *service.GitHubWebhookService
 
 type issueStatsResponse struct {
-	Total           int32  `json:"total"`
-	Open            int32  `json:"open"`
-	Closed          int32  `json:"closed"`
-	WithAISummary   int32  `json:"withAiSummary"`
-	TotalComments   int32  `json:"totalComments"`
-	RepoCount       int32  `json:"repoCount"`
+	Total           int64  `json:"total"`
+	Open            int64  `json:"open"`
+	Closed          int64  `json:"closed"`
+	PullRequests    int64  `json:"pullRequests"`
+	WithAISummary   int64  `json:"withAiSummary"`
+	TotalComments   int64  `json:"totalComments"`
+	RepoCount       int64  `json:"repoCount"`
 	LatestCreatedAt string `json:"latestCreatedAt,omitempty"`
 	LatestUpdatedAt string `json:"latestUpdatedAt,omitempty"`
 }
 
+type issueSeriesPoint struct {
+	Start   string `json:"start"`
+	Opened  int64  `json:"opened"`
+	Closed  int64  `json:"closed"`
+	Backlog int64  `json:"backlog"`
+}
+
+type issueSeriesResponse struct {
+	Interval string             `json:"interval"`
+	Points   []issueSeriesPoint `json:"points"`
+}
+
+type issueBreakdownGroup struct {
+	Key          string `json:"key"`
+	Total        int64  `json:"total"`
+	Open         int64  `json:"open"`
+	Closed       int64  `json:"closed"`
+	PullRequests int64  `json:"pullRequests"`
+	Comments     int64  `json:"comments"`
+}
+
+type issueBreakdownResponse struct {
+	By     string                `json:"by"`
+	Groups []issueBreakdownGroup `json:"groups"`
+}
+
 func initGatewayHandler() error {
 	handler, err := newGatewayMux(context.Background(), grpcGatewayEndpoint, []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -49,6 +79,7 @@ func initGatewayHandler() error {
 		blogv1.RegisterBlogAdminServiceHandlerFromEndpoint,
 		blogv1.RegisterBlogQueryServiceHandlerFromEndpoint,
 		issuesv1.RegisterPRReviewQueryServiceHandlerFromEndpoint,
+		issuesv1.RegisterJobAdminServiceHandlerFromEndpoint,
 	)
 	if err != nil {
 		return fmt.Errorf("init grpc gateway: %w", err)
@@ -71,7 +102,11 @@ func registerHTTPRoutes(r *gin.Engine, gateway http.Handler, tokens service.Admi
 	r.Use(corsMiddleware())
 	r.GET("/ads.txt", serveAdsTxt)
 	r.GET("/sitemap.xml", serveSitemapXML)
-	r.GET("/api/v1/issues/stats", issueStatsHandler(syncStore))
+	analytics := service.NewIssueAnalyticsService(syncStore)
+	r.GET("/api/v1/issues/stats", issueStatsHandler(analytics))
+	r.GET("/api/v1/issues/stats/series", issueSeriesHandler(analytics))
+	r.GET("/api/v1/issues/stats/breakdown", issueBreakdownHandler(analytics))
+	r.POST("/api/v1/webhooks/github", githubWebhookHandler(githubWebhookSvc))
 
 	if gateway == nil {
 		r.NoRoute(func(c *gin.Context) {
@@ -105,63 +140,179 @@ func setupHTTPRouter(r *gin.Engine) {
 	registerHTTPRoutes(r, gatewayHandler, adminTokenValidator)
 }
 
-func issueStatsHandler(store dao.SyncStore) gin.HandlerFunc {
+func issueStatsHandler(analytics *service.IssueAnalyticsService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if store == nil {
-			writeAdminAuthError(c, http.StatusServiceUnavailable, "issue_stats_store_unavailable", "issue stats store is not initialized")
+		filter, ok := issueAnalyticsRequest(c, analytics)
+		if !ok {
+			return
+		}
+		totals, err := analytics.Totals(c.Request.Context(), filter)
+		if err != nil {
+			writeIssueAnalyticsError(c, err)
 			return
 		}
+		c.JSON(http.StatusOK, buildIssueStatsResponse(totals))
+	}
+}
 
-		rows, err := store.ListIssues(c.Request.Context(), dao.SyncIssueFilter{
-			Repo:  strings.TrimSpace(c.Query("repo")),
-			State: "all",
-		})
+func issueSeriesHandler(analytics *service.IssueAnalyticsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter, ok := issueAnalyticsRequest(c, analytics)
+		if !ok {
+			return
+		}
+		interval := dao.IssueInterval(strings.TrimSpace(c.DefaultQuery("interval", string(dao.IssueIntervalDay))))
+		points, err := analytics.Series(c.Request.Context(), filter, interval)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"code":    "issue_stats_query_failed",
-				"message": fmt.Sprintf("query issue stats: %v", err),
-			})
+			writeIssueAnalyticsError(c, err)
 			return
 		}
+		resp := issueSeriesResponse{Interval: string(interval), Points: make([]issueSeriesPoint, 0, len(points))}
+		for _, point := range points {
+			resp.Points = append(resp.Points, issueSeriesPoint{
+				Start:   point.Start.UTC().Format(time.RFC3339),
+				Opened:  point.Opened,
+				Closed:  point.Closed,
+				Backlog: point.Backlog,
+			})
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
 
-		stats := buildIssueStatsResponse(rows)
-		c.JSON(http.StatusOK, stats)
+func issueBreakdownHandler(analytics *service.IssueAnalyticsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filter, ok := issueAnalyticsRequest(c, analytics)
+		if !ok {
+			return
+		}
+		limit := 0
+		if raw := strings.TrimSpace(c.Query("limit")); raw != "" {
+			var err error
+			if limit, err = strconv.Atoi(raw); err != nil {
+				writeAdminAuthError(c, http.StatusBadRequest, "issue_stats_invalid_request", fmt.Sprintf("invalid limit %q", raw))
+				return
+			}
+		}
+		by := strings.TrimSpace(c.Query("by"))
+		groups, err := analytics.Breakdown(c.Request.Context(), filter, dao.IssueDimension(by), limit)
+		if err != nil {
+			writeIssueAnalyticsError(c, err)
+			return
+		}
+		resp := issueBreakdownResponse{By: by, Groups: make([]issueBreakdownGroup, 0, len(groups))}
+		for _, group := range groups {
+			resp.Groups = append(resp.Groups, issueBreakdownGroup(group))
+		}
+		c.JSON(http.StatusOK, resp)
 	}
 }
 
-func buildIssueStatsResponse(rows []dao.SyncedIssue) issueStatsResponse {
-	stats := issueStatsResponse{}
-	repos := make(map[string]struct{}, len(rows))
-	var latestCreatedAt time.Time
-	var latestUpdatedAt time.Time
-	for _, row := range rows {
-		stats.Total++
-		switch row.State {
-		case "open":
-			stats.Open++
-		case "closed":
-			stats.Closed++
+// issueAnalyticsRequest reads the repo, from and to query parameters shared by the issue stats
+// routes, writing the error response itself when it returns false. repo may repeat; from and to
+// take RFC 3339 times or dates.
+func issueAnalyticsRequest(c *gin.Context, analytics *service.IssueAnalyticsService) (dao.IssueAnalyticsFilter, bool) {
+	if !analytics.Enabled() {
+		writeAdminAuthError(c, http.StatusServiceUnavailable, "issue_stats_store_unavailable", "issue stats store is not initialized")
+		return dao.IssueAnalyticsFilter{}, false
+	}
+	var filter dao.IssueAnalyticsFilter
+	for _, repo := range c.QueryArray("repo") {
+		if repo = strings.TrimSpace(repo); repo != "" {
+			filter.Repos = append(filter.Repos, repo)
+		}
+	}
+	for _, bound := range []struct {
+		name string
+		dst  *time.Time
+	}{{"from", &filter.From}, {"to", &filter.To}} {
+		raw := strings.TrimSpace(c.Query(bound.name))
+		if raw == "" {
+			continue
 		}
-		if strings.TrimSpace(row.AISummary) != "" {
-			stats.WithAISummary++
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, raw); err != nil {
+				writeAdminAuthError(c, http.StatusBadRequest, "issue_stats_invalid_request", fmt.Sprintf("invalid %s %q: want an RFC 3339 time or a date", bound.name, raw))
+				return dao.IssueAnalyticsFilter{}, false
+			}
 		}
-		stats.TotalComments += row.Comments
-		if row.Repo != "" {
-			repos[row.Repo] = struct{}{}
+		*bound.dst = t
+	}
+	return filter, true
+}
+
+func writeIssueAnalyticsError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrIssueAnalyticsInvalid) {
+		writeAdminAuthError(c, http.StatusBadRequest, "issue_stats_invalid_request", err.Error())
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    "issue_stats_query_failed",
+		"message": fmt.Sprintf("query issue stats: %v", err),
+	})
+}
+
+func githubWebhookHandler(svc *service.GitHubWebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !svc.Enabled() {
+			writeWebhookError(c, http.StatusServiceUnavailable, "github_webhook_disabled", "github webhook receiver is not enabled")
+			return
 		}
-		if row.CreatedAt.After(latestCreatedAt) {
-			latestCreatedAt = row.CreatedAt
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, service.MaxWebhookPayloadBytes)
+		payload, err := svc.VerifyRequest(c.Request)
+		if err != nil {
+			if errors.Is(err, service.ErrWebhookPayloadTooLarge) {
+				writeWebhookError(c, http.StatusRequestEntityTooLarge, "github_webhook_payload_too_large", err.Error())
+				return
+			}
+			if errors.Is(err, service.ErrWebhookPayloadInvalid) {
+				writeWebhookError(c, http.StatusBadRequest, "github_webhook_invalid_payload", err.Error())
+				return
+			}
+			writeWebhookError(c, http.StatusUnauthorized, "github_webhook_invalid_signature", err.Error())
+			return
 		}
-		if row.UpdatedAt.After(latestUpdatedAt) {
-			latestUpdatedAt = row.UpdatedAt
+
+		result, err := svc.HandleDelivery(c.Request.Context(), github.WebHookType(c.Request), github.DeliveryID(c.Request), payload)
+		if err != nil {
+			if errors.Is(err, service.ErrWebhookPayloadInvalid) {
+				writeWebhookError(c, http.StatusBadRequest, "github_webhook_invalid_payload", err.Error())
+				return
+			}
+			writeWebhookError(c, http.StatusInternalServerError, "github_webhook_failed", fmt.Sprintf("handle github webhook: %v", err))
+			return
 		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"deliveryId": result.DeliveryID,
+			"event":      result.Event,
+			"action":     result.Action,
+			"repo":       result.Repo,
+			"duplicate":  result.Duplicate,
+			"ignored":    result.Ignored,
+			"reason":     result.Reason,
+			"persisted":  result.Persisted,
+		})
 	}
-	stats.RepoCount = int32(len(repos))
-	if !latestCreatedAt.IsZero() {
-		stats.LatestCreatedAt = latestCreatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+func buildIssueStatsResponse(totals dao.IssueTotals) issueStatsResponse {
+	stats := issueStatsResponse{
+		Total:         totals.Total,
+		Open:          totals.Open,
+		Closed:        totals.Closed,
+		PullRequests:  totals.PullRequests,
+		WithAISummary: totals.WithAISummary,
+		TotalComments: totals.Comments,
+		RepoCount:     totals.Repos,
+	}
+	if !totals.LatestCreatedAt.IsZero() {
+		stats.LatestCreatedAt = totals.LatestCreatedAt.UTC().Format(time.RFC3339Nano)
 	}
-	if !latestUpdatedAt.IsZero() {
-		stats.LatestUpdatedAt = latestUpdatedAt.UTC().Format(time.RFC3339Nano)
+	if !totals.LatestUpdatedAt.IsZero() {
+		stats.LatestUpdatedAt = totals.LatestUpdatedAt.UTC().Format(time.RFC3339Nano)
 	}
 	return stats
 }
@@ -259,6 +410,15 @@ func gatewayHeaderMatcher(key string) (string, bool) {
 	return runtime.DefaultHeaderMatcher(key)
 }
 
+// writeWebhookError answers a failed webhook delivery with a github_webhook_* code, which GitHub
+// shows on the delivery.
+func writeWebhookError(c *gin.Context, statusCode int, code, message string) {
+	c.JSON(statusCode, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
+
 func writeAdminAuthError(c *gin.Context, statusCode int, code, message string) {
 	c.JSON(statusCode, gin.H{
 		"code":    code,
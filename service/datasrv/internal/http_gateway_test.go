@@ -1,7 +1,11 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -137,8 +141,8 @@ func TestRegisterHTTPRoutesServesIssueStatsByRepo(t *testing.T) {
 	if rec.Code != http.StatusOK {
 		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
 	}
-	if got := syncStore.(*stubIssueStatsStore).lastFilter.Repo; got != "o/r2" {
-		t.Fatalf("repo filter = %q, want o/r2", got)
+	if got := syncStore.(*stubIssueStatsStore).lastAnalytics.Repos; len(got) != 1 || got[0] != "o/r2" {
+		t.Fatalf("repo filter = %q, want [o/r2]", got)
 	}
 }
 
@@ -168,6 +172,110 @@ func TestRegisterHTTPRoutesIssueStatsHandlesStoreError(t *testing.T) {
 	}
 }
 
+func TestRegisterHTTPRoutesIssueStatsRejectsInvalidRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	prevStore := syncStore
+	syncStore = &stubIssueStatsStore{}
+	t.Cleanup(func() {
+		syncStore = prevStore
+	})
+
+	router := gin.New()
+	registerHTTPRoutes(router, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("gateway should not be called for %s", r.URL.Path)
+	}), &fakeAdminTokenValidator{})
+
+	for _, target := range []string{
+		"/api/v1/issues/stats?from=yesterday",
+		"/api/v1/issues/stats?from=2026-03-02&to=2026-03-01",
+		"/api/v1/issues/stats/series?interval=month",
+		"/api/v1/issues/stats/series?interval=day&from=2020-01-01&to=2026-01-01",
+		"/api/v1/issues/stats/breakdown?by=milestone",
+	} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s status = %d, want %d", target, rec.Code, http.StatusBadRequest)
+		}
+		if !strings.Contains(rec.Body.String(), "issue_stats_invalid_request") {
+			t.Fatalf("%s body = %q, want invalid request code", target, rec.Body.String())
+		}
+	}
+}
+
+func TestRegisterHTTPRoutesRejectsGitHubWebhookWhenDisabled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	prevSvc := githubWebhookSvc
+	githubWebhookSvc = nil
+	t.Cleanup(func() {
+		githubWebhookSvc = prevSvc
+	})
+
+	router := gin.New()
+	registerHTTPRoutes(router, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("gateway should not be called for %s", r.URL.Path)
+	}), &fakeAdminTokenValidator{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+	assertAdminAuthError(t, rec, "github_webhook_disabled", "github webhook receiver is not enabled")
+}
+
+func TestRegisterHTTPRoutesRejectsUnsignedGitHubWebhook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	prevSvc := githubWebhookSvc
+	githubWebhookSvc = service.NewGitHubWebhookService(&stubIssueStatsStore{}, nil, nil, conf.GitHubWebhookConfig{Enabled: true, Secret: "s3cret"})
+	t.Cleanup(func() {
+		githubWebhookSvc = prevSvc
+	})
+
+	router := gin.New()
+	registerHTTPRoutes(router, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("gateway should not be called for %s", r.URL.Path)
+	}), &fakeAdminTokenValidator{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "issues")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	if !strings.Contains(rec.Body.String(), "github_webhook_invalid_signature") {
+		t.Fatalf("body = %q, want invalid signature code", rec.Body.String())
+	}
+
+	// A correctly signed body that is not JSON is the sender's mistake, not a bad signature.
+	body := []byte(`{"action":`)
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "issues")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("unparseable payload status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if !strings.Contains(rec.Body.String(), "github_webhook_invalid_payload") {
+		t.Fatalf("unparseable payload body = %q, want invalid payload code", rec.Body.String())
+	}
+}
+
 func TestRegisterHTTPRoutesProtectsAdminEndpoints(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -389,9 +497,10 @@ type fakeAdminTokenValidator struct {
 }
 
 type stubIssueStatsStore struct {
-	rows       []dao.SyncedIssue
-	err        error
-	lastFilter dao.SyncIssueFilter
+	rows          []dao.SyncedIssue
+	err           error
+	lastFilter    dao.SyncIssueFilter
+	lastAnalytics dao.IssueAnalyticsFilter
 }
 
 func assertAdminAuthError(t *testing.T, rec *httptest.ResponseRecorder, code, message string) {
@@ -440,6 +549,55 @@ func (s *stubIssueStatsStore) ListIssues(_ context.Context, filter dao.SyncIssue
 	return append([]dao.SyncedIssue(nil), s.rows...), nil
 }
 
+func (s *stubIssueStatsStore) IssueTotals(_ context.Context, filter dao.IssueAnalyticsFilter) (dao.IssueTotals, error) {
+	s.lastAnalytics = filter
+	if s.err != nil {
+		return dao.IssueTotals{}, s.err
+	}
+	totals := dao.IssueTotals{}
+	repos := map[string]struct{}{}
+	for _, row := range s.rows {
+		totals.Total++
+		switch row.State {
+		case "open":
+			totals.Open++
+		case "closed":
+			totals.Closed++
+		}
+		if row.IsPullRequest {
+			totals.PullRequests++
+		}
+		if row.AISummary != "" {
+			totals.WithAISummary++
+		}
+		totals.Comments += int64(row.Comments)
+		repos[row.Repo] = struct{}{}
+		if row.CreatedAt.After(totals.LatestCreatedAt) {
+			totals.LatestCreatedAt = row.CreatedAt
+		}
+		if row.UpdatedAt.After(totals.LatestUpdatedAt) {
+			totals.LatestUpdatedAt = row.UpdatedAt
+		}
+	}
+	totals.Repos = int64(len(repos))
+	return totals, nil
+}
+
+func (s *stubIssueStatsStore) IssueActivity(_ context.Context, filter dao.IssueAnalyticsFilter, _ dao.IssueInterval) ([]dao.IssueActivityBucket, error) {
+	s.lastAnalytics = filter
+	return nil, s.err
+}
+
+func (s *stubIssueStatsStore) OpenIssuesAt(_ context.Context, filter dao.IssueAnalyticsFilter, _ time.Time) (int64, error) {
+	s.lastAnalytics = filter
+	return 0, s.err
+}
+
+func (s *stubIssueStatsStore) IssueBreakdown(_ context.Context, filter dao.IssueAnalyticsFilter, _ dao.IssueDimension, _ int) ([]dao.IssueGroupCount, error) {
+	s.lastAnalytics = filter
+	return nil, s.err
+}
+
 func (s *stubIssueStatsStore) UpdateIssueAISummary(context.Context, string, int64, int32, string) (dao.SyncedIssue, error) {
 	return dao.SyncedIssue{}, nil
 }
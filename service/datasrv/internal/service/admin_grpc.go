@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"sync"
+	"time"
 
 	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
 	"github.com/kongken/datasrv/service/datasrv/internal/conf"
@@ -20,95 +23,611 @@ type IssueSyncAdminGRPCServer struct {
 	store   dao.SyncStore
 	syncSvc *IssueSyncService
 	cfg     *conf.Config
+	// runs is nil when the store keeps no run history; the run history RPCs are then unimplemented.
+	runs dao.SyncRunStore
+	// commentVersions is nil when comment storage keeps no previous threads; the comment version
+	// RPCs are then unimplemented.
+	commentVersions IssueCommentVersionStore
+	// leader is nil when leader election is off; GetSyncStatus then reports no leader.
+	leader *LeaderElector
+	// jobs is nil when no jobs are scheduled; UpdateSyncConfig then only stores the settings.
+	jobs *JobScheduler
+	// settings is nil when the store keeps no settings; config updates then last until restart.
+	settings *SettingsService
+	// summarySvc and prReviewSvc are nil when the features were off at startup; their config
+	// updates then only take effect after a restart.
+	summarySvc  *IssueSummaryService
+	prReviewSvc *PRReviewService
 
 	statusMu sync.RWMutex
 	lastRun  SyncRunSummary
 }
 
 func NewIssueSyncAdminGRPCServer(store dao.SyncStore, syncSvc *IssueSyncService, cfg *conf.Config) *IssueSyncAdminGRPCServer {
-	return &IssueSyncAdminGRPCServer{store: store, syncSvc: syncSvc, cfg: cfg}
+	srv := &IssueSyncAdminGRPCServer{store: store, syncSvc: syncSvc, cfg: cfg}
+	if runs, ok := store.(dao.SyncRunStore); ok {
+		srv.runs = runs
+	}
+	if syncSvc != nil {
+		if versions, ok := syncSvc.commentStore.(IssueCommentVersionStore); ok {
+			srv.commentVersions = versions
+		}
+	}
+	return srv
 }
 
 func (s *IssueSyncAdminGRPCServer) SyncIssues(ctx context.Context, req *issuesv1.SyncIssuesRequest) (*issuesv1.SyncIssuesResponse, error) {
-	summary, err := s.syncSvc.RunSync(ctx, req.GetRepo())
+	job, attached := s.syncSvc.StartSync(ctx, req.GetRepo(), func(summary SyncRunSummary, err error) {
+		if err != nil {
+			return
+		}
+		s.statusMu.Lock()
+		s.lastRun = summary
+		s.statusMu.Unlock()
+	})
+	return &issuesv1.SyncIssuesResponse{
+		StartedAt: timestamppb.New(job.StartedAt),
+		JobId:     job.ID,
+		Attached:  attached,
+	}, nil
+}
+
+func (s *IssueSyncAdminGRPCServer) WatchSyncJob(req *issuesv1.WatchSyncJobRequest, stream issuesv1.IssueSyncAdminService_WatchSyncJobServer) error {
+	if req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	err := s.syncSvc.WatchSyncJob(stream.Context(), req.GetId(), func(event SyncJobEvent) error {
+		return stream.Send(&issuesv1.SyncJobEvent{
+			JobId:     event.JobID,
+			Type:      event.Type,
+			Target:    event.Target,
+			Page:      event.Page,
+			Fetched:   event.Fetched,
+			Persisted: event.Persisted,
+			Error:     event.Err,
+			At:        timestamppb.New(event.At),
+		})
+	})
+	if errors.Is(err, ErrSyncJobNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func (s *IssueSyncAdminGRPCServer) ReconcileIssues(ctx context.Context, req *issuesv1.ReconcileIssuesRequest) (*issuesv1.ReconcileIssuesResponse, error) {
+	summary, err := s.syncSvc.RunReconcile(ctx, req.GetRepo())
 	if err != nil {
 		return nil, err
 	}
 
-	s.statusMu.Lock()
-	s.lastRun = summary
-	s.statusMu.Unlock()
+	results := make([]*issuesv1.ReconcileRepoResult, 0, len(summary.Results))
+	for _, it := range summary.Results {
+		results = append(results, &issuesv1.ReconcileRepoResult{
+			Repo:        it.Repo,
+			Checked:     it.Checked,
+			Tombstoned:  it.Tombstoned,
+			Transferred: it.Transferred,
+			Restored:    it.Restored,
+			Error:       it.Err,
+		})
+	}
+
+	return &issuesv1.ReconcileIssuesResponse{
+		StartedAt:  timestamppb.New(summary.StartedAt),
+		FinishedAt: timestamppb.New(summary.FinishedAt),
+		Results:    results,
+	}, nil
+}
+
+func (s *IssueSyncAdminGRPCServer) MigrateIssueComments(ctx context.Context, req *issuesv1.MigrateIssueCommentsRequest) (*issuesv1.MigrateIssueCommentsResponse, error) {
+	if strings.TrimSpace(req.GetSource()) == "" || strings.TrimSpace(req.GetTarget()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "source and target are required")
+	}
+	source := normalizeIssueCommentProvider(req.GetSource())
+	target := normalizeIssueCommentProvider(req.GetTarget())
+	for _, provider := range []string{source, target} {
+		switch provider {
+		case IssueCommentProviderS3, IssueCommentProviderDatabase, IssueCommentProviderFilesystem:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported issue comment storage provider %q", provider)
+		}
+	}
+	if source == target {
+		return nil, status.Error(codes.InvalidArgument, "source and target must differ")
+	}
+	from, err := newIssueCommentStoreFor(source, s.cfg.IssueCommentStorage, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "init source: %v", err)
+	}
+	to, err := newIssueCommentStoreFor(target, s.cfg.IssueCommentStorage, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "init target: %v", err)
+	}
+
+	repos := []string{strings.TrimSpace(req.GetRepo())}
+	if repos[0] == "" {
+		managed, err := s.store.ListManagedRepos(ctx)
+		if err != nil {
+			return nil, err
+		}
+		repos = repos[:0]
+		for _, repo := range managed {
+			repos = append(repos, repo.Repo)
+		}
+	}
 
-	results := make([]*issuesv1.SyncRepoResult, 0, len(summary.Results))
+	summary := MigrateIssueComments(ctx, s.store, from, to, repos, req.GetOverwrite())
+	results := make([]*issuesv1.IssueCommentMigrationResult, 0, len(summary.Results))
 	for _, it := range summary.Results {
-		results = append(results, &issuesv1.SyncRepoResult{
-			Repo:      it.Repo,
-			Fetched:   it.Fetched,
-			Persisted: it.Persisted,
-			Error:     it.Err,
+		results = append(results, &issuesv1.IssueCommentMigrationResult{
+			Repo:    it.Repo,
+			Scanned: it.Scanned,
+			Copied:  it.Copied,
+			Missing: it.Missing,
+			Skipped: it.Skipped,
+			Failed:  it.Failed,
+			Error:   it.Err,
 		})
 	}
+	return &issuesv1.MigrateIssueCommentsResponse{
+		StartedAt:  timestamppb.New(summary.StartedAt),
+		FinishedAt: timestamppb.New(summary.FinishedAt),
+		Results:    results,
+	}, nil
+}
 
-	return &issuesv1.SyncIssuesResponse{
+func (s *IssueSyncAdminGRPCServer) ListIssueCommentVersions(ctx context.Context, req *issuesv1.ListIssueCommentVersionsRequest) (*issuesv1.ListIssueCommentVersionsResponse, error) {
+	if s.commentVersions == nil {
+		return nil, status.Error(codes.Unimplemented, "comment versions are not supported by the configured comment storage")
+	}
+	issue, err := s.findIssue(ctx, req.GetRepo(), req.GetIssueId(), req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.commentVersions.ListCommentVersions(ctx, issue.Repo, issue.IssueID, issue.Number)
+	if err != nil {
+		return nil, commentVersionError("list issue comment versions", err)
+	}
+	out := make([]*issuesv1.IssueCommentVersion, 0, len(versions))
+	for _, v := range versions {
+		out = append(out, &issuesv1.IssueCommentVersion{
+			VersionId:    v.VersionID,
+			LastModified: timestamppb.New(v.LastModified),
+			Size:         v.Size,
+			IsLatest:     v.IsLatest,
+		})
+	}
+	return &issuesv1.ListIssueCommentVersionsResponse{Versions: out}, nil
+}
+
+func (s *IssueSyncAdminGRPCServer) RestoreIssueCommentVersion(ctx context.Context, req *issuesv1.RestoreIssueCommentVersionRequest) (*issuesv1.RestoreIssueCommentVersionResponse, error) {
+	if s.commentVersions == nil {
+		return nil, status.Error(codes.Unimplemented, "comment versions are not supported by the configured comment storage")
+	}
+	if req.GetVersionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "version_id is required")
+	}
+	issue, err := s.findIssue(ctx, req.GetRepo(), req.GetIssueId(), req.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+	comments, err := s.commentVersions.RestoreCommentVersion(ctx, issue.Repo, issue.IssueID, issue.Number, req.GetVersionId())
+	if err != nil {
+		return nil, commentVersionError("restore issue comment version", err)
+	}
+	return &issuesv1.RestoreIssueCommentVersionResponse{Comments: toProtoIssueComments(comments)}, nil
+}
+
+// findIssue resolves the stored issue an admin request names by id or number, tombstoned or not.
+func (s *IssueSyncAdminGRPCServer) findIssue(ctx context.Context, repo string, issueID int64, number int32) (dao.SyncedIssue, error) {
+	if repo == "" {
+		return dao.SyncedIssue{}, status.Error(codes.InvalidArgument, "repo is required")
+	}
+	if issueID <= 0 && number <= 0 {
+		return dao.SyncedIssue{}, status.Error(codes.InvalidArgument, "either issue_id or number is required")
+	}
+	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{Repo: repo, IssueID: issueID, Number: number, Limit: 1, IncludeTombstoned: true})
+	if err != nil {
+		return dao.SyncedIssue{}, status.Errorf(codes.Internal, "get issue: %v", err)
+	}
+	if len(rows) == 0 {
+		return dao.SyncedIssue{}, status.Error(codes.NotFound, dao.ErrIssueNotFound.Error())
+	}
+	return rows[0], nil
+}
+
+func commentVersionError(op string, err error) error {
+	switch {
+	case errors.Is(err, ErrIssueCommentVersioningDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrIssueCommentVersionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", op, err)
+	}
+}
+
+func (s *IssueSyncAdminGRPCServer) DiscoverManagedSyncRepos(ctx context.Context, _ *emptypb.Empty) (*issuesv1.DiscoverManagedSyncReposResponse, error) {
+	summary, err := s.syncSvc.RunRepoDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*issuesv1.RepoDiscoveryRuleResult, 0, len(summary.Results))
+	for _, it := range summary.Results {
+		results = append(results, &issuesv1.RepoDiscoveryRuleResult{
+			Rule:    it.Rule,
+			Matched: it.Matched,
+			Error:   it.Err,
+		})
+	}
+	repos, err := s.toProtoManagedRepos(ctx, summary.Repos)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issuesv1.DiscoverManagedSyncReposResponse{
 		StartedAt:  timestamppb.New(summary.StartedAt),
 		FinishedAt: timestamppb.New(summary.FinishedAt),
 		Results:    results,
+		Added:      summary.Added,
+		Removed:    summary.Removed,
+		Repos:      repos,
+	}, nil
+}
+
+func (s *IssueSyncAdminGRPCServer) ResyncRepo(ctx context.Context, req *issuesv1.ResyncRepoRequest) (*issuesv1.ResyncJob, error) {
+	if req.GetRepo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repo is required")
+	}
+	var since time.Time
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+	job, err := s.syncSvc.ResyncRepo(ctx, req.GetRepo(), req.GetMode(), since)
+	if err != nil {
+		return nil, resyncError(err)
+	}
+	return toProtoResyncJob(job), nil
+}
+
+func (s *IssueSyncAdminGRPCServer) GetResyncJob(_ context.Context, req *issuesv1.GetResyncJobRequest) (*issuesv1.ResyncJob, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	job, err := s.syncSvc.GetResyncJob(req.GetId())
+	if err != nil {
+		return nil, resyncError(err)
+	}
+	return toProtoResyncJob(job), nil
+}
+
+func (s *IssueSyncAdminGRPCServer) ListResyncJobs(context.Context, *emptypb.Empty) (*issuesv1.ListResyncJobsResponse, error) {
+	jobs := s.syncSvc.ListResyncJobs()
+	items := make([]*issuesv1.ResyncJob, 0, len(jobs))
+	for _, job := range jobs {
+		items = append(items, toProtoResyncJob(job))
+	}
+	return &issuesv1.ListResyncJobsResponse{Jobs: items}, nil
+}
+
+func (s *IssueSyncAdminGRPCServer) ListSyncRuns(ctx context.Context, req *issuesv1.ListSyncRunsRequest) (*issuesv1.ListSyncRunsResponse, error) {
+	if s.runs == nil {
+		return nil, status.Error(codes.Unimplemented, "sync run history is not supported by the configured store")
+	}
+	switch req.GetJobType() {
+	case "", dao.SyncRunJobIssueSync, dao.SyncRunJobFeedSync, dao.SyncRunJobIssueSummary, dao.SyncRunJobPRReview:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown job_type %q", req.GetJobType())
+	}
+	switch req.GetStatus() {
+	case "", dao.SyncRunStatusSuccess, dao.SyncRunStatusPartial, dao.SyncRunStatusFailed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %q", req.GetStatus())
+	}
+
+	filter := dao.SyncRunFilter{JobType: req.GetJobType(), Status: req.GetStatus()}
+	if req.GetStartedAfter() != nil {
+		filter.StartedAfter = req.GetStartedAfter().AsTime()
+	}
+	if req.GetStartedBefore() != nil {
+		filter.StartedBefore = req.GetStartedBefore().AsTime()
+	}
+	page := req.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	filter.Offset = int((page - 1) * pageSize)
+	filter.Limit = int(pageSize + 1)
+
+	runs, err := s.runs.ListSyncRuns(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list sync runs: %v", err)
+	}
+	hasNext := len(runs) > int(pageSize)
+	if hasNext {
+		runs = runs[:pageSize]
+	}
+	items := make([]*issuesv1.SyncRun, 0, len(runs))
+	for _, run := range runs {
+		items = append(items, toProtoSyncRun(run))
+	}
+	return &issuesv1.ListSyncRunsResponse{
+		Runs:     items,
+		Page:     page,
+		PageSize: pageSize,
+		HasNext:  hasNext,
 	}, nil
 }
 
-func (s *IssueSyncAdminGRPCServer) GetSyncConfig(context.Context, *emptypb.Empty) (*issuesv1.GetSyncConfigResponse, error) {
-	cfg := s.syncSvc.GetConfig()
-	repos, err := s.syncSvc.ListManagedRepos(context.Background())
+func (s *IssueSyncAdminGRPCServer) GetSyncRun(ctx context.Context, req *issuesv1.GetSyncRunRequest) (*issuesv1.SyncRun, error) {
+	if s.runs == nil {
+		return nil, status.Error(codes.Unimplemented, "sync run history is not supported by the configured store")
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	run, err := s.runs.GetSyncRun(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, dao.ErrSyncRunNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "get sync run: %v", err)
+	}
+	return toProtoSyncRun(run), nil
+}
+
+func (s *IssueSyncAdminGRPCServer) GetSyncConfig(ctx context.Context, _ *emptypb.Empty) (*issuesv1.GetSyncConfigResponse, error) {
+	repos, err := s.syncSvc.ListManagedRepoRules(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list managed repos: %v", err)
+	}
+	doc, err := s.settings.Current(ctx, SettingsGitHubSync)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get settings: %v", err)
+	}
+	return s.toProtoSyncConfig(s.syncSvc.GetConfig(), repos, doc), nil
+}
+
+func (s *IssueSyncAdminGRPCServer) UpdateSyncConfig(ctx context.Context, req *issuesv1.UpdateSyncConfigRequest) (*issuesv1.GetSyncConfigResponse, error) {
+	current := s.syncSvc.GetConfig()
+	next := normalizeSyncConfig(conf.GitHubSyncConfig{
+		Enabled:                  req.GetEnabled(),
+		Repos:                    current.Repos,
+		IntervalSeconds:          int(req.GetIntervalSeconds()),
+		PageSize:                 int(req.GetPageSize()),
+		MaxPagesPerRun:           int(req.GetMaxPagesPerRun()),
+		RequestTimeoutSeconds:    int(req.GetRequestTimeoutSeconds()),
+		Concurrency:              int(optionalInt32(req.Concurrency, int32(current.Concurrency))),
+		ReconcileIntervalSeconds: int(optionalInt32(req.ReconcileIntervalSeconds, int32(current.ReconcileIntervalSeconds))),
+		DiscoveryIntervalSeconds: int(optionalInt32(req.DiscoveryIntervalSeconds, int32(current.DiscoveryIntervalSeconds))),
+	})
+	// The repos are replaced before the settings are stored, so that a stored version never comes
+	// without its repo list. A stale or invalid request fails before either changes.
+	if req.Repos != nil {
+		if err := s.syncSvc.ValidateManagedRepos(req.GetRepos()); err != nil {
+			return nil, replaceManagedReposError(err)
+		}
+		if req.GetVersion() != 0 {
+			stored, err := s.settings.Current(ctx, SettingsGitHubSync)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "get settings: %v", err)
+			}
+			if stored.Version != req.GetVersion() {
+				return nil, settingsError(dao.ErrSettingsVersionConflict)
+			}
+		}
+		if _, err := s.syncSvc.ReplaceManagedRepos(ctx, req.GetRepos()); err != nil {
+			return nil, replaceManagedReposError(err)
+		}
+	}
+	doc, err := s.settings.Save(ctx, SettingsGitHubSync, req.GetVersion(),
+		sectionSettings[GitHubSyncSettings](current), sectionSettings[GitHubSyncSettings](next))
+	if err != nil {
+		return nil, settingsError(err)
+	}
+	updated := s.syncSvc.UpdateConfig(next)
+	managedRepos, err := s.syncSvc.ListManagedRepoRules(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list managed repos: %v", err)
 	}
+
+	s.cfg.GitHubSync = updated
+	if s.jobs != nil {
+		// The sync jobs take their intervals from the config just stored.
+		for _, name := range []string{JobIssueSync, JobIssueReconcile, JobRepoDiscovery} {
+			_ = s.jobs.Reschedule(name)
+		}
+	}
+	return s.toProtoSyncConfig(updated, managedRepos, doc), nil
+}
+
+// optionalInt32 returns the value of an optional request field, or current when it is unset.
+func optionalInt32(value *int32, current int32) int32 {
+	if value == nil {
+		return current
+	}
+	return *value
+}
+
+func (s *IssueSyncAdminGRPCServer) toProtoSyncConfig(cfg conf.GitHubSyncConfig, repos []string, doc dao.SettingsDocument) *issuesv1.GetSyncConfigResponse {
 	return &issuesv1.GetSyncConfigResponse{
+		Enabled:                  cfg.Enabled,
+		Repos:                    repos,
+		IntervalSeconds:          int32(cfg.IntervalSeconds),
+		PageSize:                 int32(cfg.PageSize),
+		MaxPagesPerRun:           int32(cfg.MaxPagesPerRun),
+		RequestTimeoutSeconds:    int32(cfg.RequestTimeoutSeconds),
+		StorageDriver:            s.cfg.Storage.Driver,
+		GithubTokenConfigured:    s.cfg.GitHub.Token != "",
+		Concurrency:              int32(cfg.Concurrency),
+		ReconcileIntervalSeconds: int32(cfg.ReconcileIntervalSeconds),
+		DiscoveryIntervalSeconds: int32(cfg.DiscoveryIntervalSeconds),
+		Version:                  doc.Version,
+		UpdatedBy:                doc.UpdatedBy,
+		UpdatedAt:                maybeTimestamp(doc.UpdatedAt),
+	}
+}
+
+func (s *IssueSyncAdminGRPCServer) GetIssueSummaryConfig(ctx context.Context, _ *emptypb.Empty) (*issuesv1.GetIssueSummaryConfigResponse, error) {
+	doc, err := s.settings.Current(ctx, SettingsIssueSummary)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get settings: %v", err)
+	}
+	return s.toProtoIssueSummaryConfig(s.issueSummaryConfig(), doc), nil
+}
+
+// UpdateIssueSummaryConfig changes the summary run settings. Turning summaries on for a server
+// started with them off takes a restart, as the summarizer is only built at startup.
+func (s *IssueSyncAdminGRPCServer) UpdateIssueSummaryConfig(ctx context.Context, req *issuesv1.UpdateIssueSummaryConfigRequest) (*issuesv1.GetIssueSummaryConfigResponse, error) {
+	current := s.issueSummaryConfig()
+	next := IssueSummarySettings{
+		Enabled:               req.GetEnabled(),
+		IntervalSeconds:       int(req.GetIntervalSeconds()),
+		BatchSize:             int(req.GetBatchSize()),
+		MaxIssuesPerRun:       int(req.GetMaxIssuesPerRun()),
+		RequestTimeoutSeconds: int(req.GetRequestTimeoutSeconds()),
+		State:                 strings.ToLower(strings.TrimSpace(req.GetState())),
+		OverwriteExisting:     req.GetOverwriteExisting(),
+	}
+	if err := validateSettings(next); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	doc, err := s.settings.Save(ctx, SettingsIssueSummary, req.GetVersion(), sectionSettings[IssueSummarySettings](current), next)
+	if err != nil {
+		return nil, settingsError(err)
+	}
+	updated := current
+	applySettings(next, &updated)
+	if s.summarySvc != nil {
+		updated = s.summarySvc.UpdateConfig(updated)
+	}
+	s.cfg.IssueSummary = updated
+	if s.jobs != nil {
+		_ = s.jobs.Reschedule(JobIssueSummary)
+	}
+	return s.toProtoIssueSummaryConfig(updated, doc), nil
+}
+
+func (s *IssueSyncAdminGRPCServer) issueSummaryConfig() conf.IssueSummaryConfig {
+	if s.summarySvc != nil {
+		return s.summarySvc.GetConfig()
+	}
+	return s.cfg.IssueSummary
+}
+
+func (s *IssueSyncAdminGRPCServer) toProtoIssueSummaryConfig(cfg conf.IssueSummaryConfig, doc dao.SettingsDocument) *issuesv1.GetIssueSummaryConfigResponse {
+	return &issuesv1.GetIssueSummaryConfigResponse{
 		Enabled:               cfg.Enabled,
-		Repos:                 managedRepoNames(repos),
 		IntervalSeconds:       int32(cfg.IntervalSeconds),
-		PageSize:              int32(cfg.PageSize),
-		MaxPagesPerRun:        int32(cfg.MaxPagesPerRun),
+		BatchSize:             int32(cfg.BatchSize),
+		MaxIssuesPerRun:       int32(cfg.MaxIssuesPerRun),
 		RequestTimeoutSeconds: int32(cfg.RequestTimeoutSeconds),
-		StorageDriver:         s.cfg.Storage.Driver,
-		GithubTokenConfigured: s.cfg.GitHub.Token != "",
-	}, nil
+		State:                 cfg.State,
+		OverwriteExisting:     cfg.OverwriteExisting,
+		Provider:              cfg.Provider,
+		Model:                 cfg.Model,
+		Version:               doc.Version,
+		UpdatedBy:             doc.UpdatedBy,
+		UpdatedAt:             maybeTimestamp(doc.UpdatedAt),
+	}
 }
 
-func (s *IssueSyncAdminGRPCServer) UpdateSyncConfig(ctx context.Context, req *issuesv1.UpdateSyncConfigRequest) (*issuesv1.GetSyncConfigResponse, error) {
-	updated := s.syncSvc.UpdateConfig(conf.GitHubSyncConfig{
+func (s *IssueSyncAdminGRPCServer) GetPRReviewConfig(ctx context.Context, _ *emptypb.Empty) (*issuesv1.GetPRReviewConfigResponse, error) {
+	doc, err := s.settings.Current(ctx, SettingsPRReview)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get settings: %v", err)
+	}
+	return toProtoPRReviewConfig(s.prReviewConfig(), doc), nil
+}
+
+// UpdatePRReviewConfig changes the PR review run settings. Like summaries, reviews turned on for
+// a server started with them off begin after a restart.
+func (s *IssueSyncAdminGRPCServer) UpdatePRReviewConfig(ctx context.Context, req *issuesv1.UpdatePRReviewConfigRequest) (*issuesv1.GetPRReviewConfigResponse, error) {
+	current := s.prReviewConfig()
+	next := PRReviewSettings{
 		Enabled:               req.GetEnabled(),
-		Repos:                 s.syncSvc.GetConfig().Repos,
 		IntervalSeconds:       int(req.GetIntervalSeconds()),
-		PageSize:              int(req.GetPageSize()),
-		MaxPagesPerRun:        int(req.GetMaxPagesPerRun()),
+		BatchSize:             int(req.GetBatchSize()),
+		MaxPRsPerRun:          int(req.GetMaxPrsPerRun()),
+		MaxDiffSize:           int(req.GetMaxDiffSize()),
 		RequestTimeoutSeconds: int(req.GetRequestTimeoutSeconds()),
-	})
+		OverwriteExisting:     req.GetOverwriteExisting(),
+	}
+	if err := validateSettings(next); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	doc, err := s.settings.Save(ctx, SettingsPRReview, req.GetVersion(), sectionSettings[PRReviewSettings](current), next)
+	if err != nil {
+		return nil, settingsError(err)
+	}
+	updated := current
+	applySettings(next, &updated)
+	if s.prReviewSvc != nil {
+		updated = s.prReviewSvc.UpdateConfig(updated)
+	}
+	s.cfg.PRReview = updated
+	if s.jobs != nil {
+		_ = s.jobs.Reschedule(JobPRReview)
+	}
+	return toProtoPRReviewConfig(updated, doc), nil
+}
 
-	var managedRepos []dao.ManagedRepo
-	var err error
-	if req.Repos != nil {
-		managedRepos, err = s.syncSvc.ReplaceManagedRepos(ctx, req.GetRepos())
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "replace managed repos: %v", err)
-		}
-	} else {
-		managedRepos, err = s.syncSvc.ListManagedRepos(ctx)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "list managed repos: %v", err)
-		}
+func (s *IssueSyncAdminGRPCServer) prReviewConfig() conf.PRReviewConfig {
+	if s.prReviewSvc != nil {
+		return s.prReviewSvc.GetConfig()
 	}
+	return s.cfg.PRReview
+}
 
-	s.cfg.GitHubSync = updated
-	return &issuesv1.GetSyncConfigResponse{
-		Enabled:               updated.Enabled,
-		Repos:                 managedRepoNames(managedRepos),
-		IntervalSeconds:       int32(updated.IntervalSeconds),
-		PageSize:              int32(updated.PageSize),
-		MaxPagesPerRun:        int32(updated.MaxPagesPerRun),
-		RequestTimeoutSeconds: int32(updated.RequestTimeoutSeconds),
-		StorageDriver:         s.cfg.Storage.Driver,
-		GithubTokenConfigured: s.cfg.GitHub.Token != "",
-	}, nil
+func toProtoPRReviewConfig(cfg conf.PRReviewConfig, doc dao.SettingsDocument) *issuesv1.GetPRReviewConfigResponse {
+	return &issuesv1.GetPRReviewConfigResponse{
+		Enabled:               cfg.Enabled,
+		IntervalSeconds:       int32(cfg.IntervalSeconds),
+		BatchSize:             int32(cfg.BatchSize),
+		MaxPrsPerRun:          int32(cfg.MaxPRsPerRun),
+		MaxDiffSize:           int32(cfg.MaxDiffSize),
+		RequestTimeoutSeconds: int32(cfg.RequestTimeoutSeconds),
+		OverwriteExisting:     cfg.OverwriteExisting,
+		Provider:              cfg.Provider,
+		Model:                 cfg.Model,
+		Version:               doc.Version,
+		UpdatedBy:             doc.UpdatedBy,
+		UpdatedAt:             maybeTimestamp(doc.UpdatedAt),
+	}
+}
+
+func (s *IssueSyncAdminGRPCServer) ListSettingsChanges(ctx context.Context, req *issuesv1.ListSettingsChangesRequest) (*issuesv1.ListSettingsChangesResponse, error) {
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0 || limit > 100:
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 0 and 100")
+	case limit == 0:
+		limit = 20
+	}
+	changes, err := s.settings.Changes(ctx, req.GetSection(), limit)
+	if err != nil {
+		return nil, settingsError(err)
+	}
+	items := make([]*issuesv1.SettingsChange, 0, len(changes))
+	for _, change := range changes {
+		items = append(items, &issuesv1.SettingsChange{
+			Section:   change.Section,
+			Version:   change.Version,
+			Data:      change.Data,
+			Fields:    change.Fields,
+			ChangedBy: change.ChangedBy,
+			ChangedAt: maybeTimestamp(change.ChangedAt),
+		})
+	}
+	return &issuesv1.ListSettingsChangesResponse{Changes: items}, nil
 }
 
 func (s *IssueSyncAdminGRPCServer) ListManagedSyncRepos(ctx context.Context, _ *emptypb.Empty) (*issuesv1.ListManagedSyncReposResponse, error) {
@@ -126,7 +645,7 @@ func (s *IssueSyncAdminGRPCServer) ListManagedSyncRepos(ctx context.Context, _ *
 func (s *IssueSyncAdminGRPCServer) ReplaceManagedSyncRepos(ctx context.Context, req *issuesv1.ReplaceManagedSyncReposRequest) (*issuesv1.ListManagedSyncReposResponse, error) {
 	repos, err := s.syncSvc.ReplaceManagedRepos(ctx, req.GetRepos())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "replace managed repos: %v", err)
+		return nil, replaceManagedReposError(err)
 	}
 	items, err := s.toProtoManagedRepos(ctx, repos)
 	if err != nil {
@@ -135,6 +654,39 @@ func (s *IssueSyncAdminGRPCServer) ReplaceManagedSyncRepos(ctx context.Context,
 	return &issuesv1.ListManagedSyncReposResponse{Repos: items}, nil
 }
 
+func (s *IssueSyncAdminGRPCServer) GetManagedSyncRepo(ctx context.Context, req *issuesv1.GetManagedSyncRepoRequest) (*issuesv1.ManagedSyncRepo, error) {
+	if req.GetRepo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repo is required")
+	}
+	repo, err := s.syncSvc.GetManagedRepo(ctx, req.GetRepo())
+	if err != nil {
+		return nil, managedRepoPolicyError(err)
+	}
+	items, err := s.toProtoManagedRepos(ctx, []dao.ManagedRepo{repo})
+	if err != nil {
+		return nil, err
+	}
+	return items[0], nil
+}
+
+func (s *IssueSyncAdminGRPCServer) UpdateRepoSyncPolicy(ctx context.Context, req *issuesv1.UpdateRepoSyncPolicyRequest) (*issuesv1.ManagedSyncRepo, error) {
+	if req.GetRepo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repo is required")
+	}
+	if req.GetPolicy() == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+	repo, err := s.syncSvc.UpdateRepoSyncPolicy(ctx, req.GetRepo(), fromProtoRepoSyncPolicy(req.GetPolicy()))
+	if err != nil {
+		return nil, managedRepoPolicyError(err)
+	}
+	items, err := s.toProtoManagedRepos(ctx, []dao.ManagedRepo{repo})
+	if err != nil {
+		return nil, err
+	}
+	return items[0], nil
+}
+
 func (s *IssueSyncAdminGRPCServer) GetSyncStatus(ctx context.Context, _ *emptypb.Empty) (*issuesv1.GetSyncStatusResponse, error) {
 	s.statusMu.RLock()
 	lastRun := s.lastRun
@@ -177,9 +729,62 @@ func (s *IssueSyncAdminGRPCServer) GetSyncStatus(ctx context.Context, _ *emptypb
 	if !lastRun.FinishedAt.IsZero() {
 		resp.LastFinishedAt = timestamppb.New(lastRun.FinishedAt)
 	}
+	if limits := s.syncSvc.RateLimits(); len(limits) > 0 {
+		lowest, _ := lowestGitHubRateLimit(limits)
+		resp.RateLimit = toProtoGitHubRateLimit(lowest)
+		for _, rl := range limits {
+			resp.RateLimits = append(resp.RateLimits, toProtoGitHubRateLimit(rl))
+		}
+	}
+	if s.leader != nil {
+		leader, err := s.leader.Status(ctx)
+		if err != nil {
+			leaderLogger.Warn("scheduler leader lookup failed", "error", err)
+		}
+		resp.Leader = &issuesv1.SchedulerLeader{Holder: leader.Holder, Replica: leader.Replica, Leading: leader.Leading}
+	}
 	return resp, nil
 }
 
+// SetLeaderElector makes GetSyncStatus report the scheduler leader.
+func (s *IssueSyncAdminGRPCServer) SetLeaderElector(leader *LeaderElector) {
+	s.leader = leader
+}
+
+// SetJobScheduler makes UpdateSyncConfig reschedule the sync jobs when their intervals change.
+func (s *IssueSyncAdminGRPCServer) SetJobScheduler(jobs *JobScheduler) {
+	s.jobs = jobs
+}
+
+// SetSettingsService makes the config updates persist across restarts.
+func (s *IssueSyncAdminGRPCServer) SetSettingsService(settings *SettingsService) {
+	s.settings = settings
+}
+
+// SetAIServices lets the summary and PR review config updates reach the running services.
+func (s *IssueSyncAdminGRPCServer) SetAIServices(summarySvc *IssueSummaryService, prReviewSvc *PRReviewService) {
+	s.summarySvc = summarySvc
+	s.prReviewSvc = prReviewSvc
+}
+
+func toProtoGitHubRateLimit(in GitHubRateLimitStatus) *issuesv1.GitHubRateLimit {
+	out := &issuesv1.GitHubRateLimit{
+		Limit:      int32(in.Limit),
+		Remaining:  int32(in.Remaining),
+		Credential: in.Credential,
+	}
+	if !in.ResetAt.IsZero() {
+		out.ResetAt = timestamppb.New(in.ResetAt)
+	}
+	if !in.ObservedAt.IsZero() {
+		out.ObservedAt = timestamppb.New(in.ObservedAt)
+	}
+	if !in.PausedUntil.IsZero() {
+		out.PausedUntil = timestamppb.New(in.PausedUntil)
+	}
+	return out
+}
+
 func (s *IssueSyncAdminGRPCServer) UpdateIssueAISummary(ctx context.Context, req *issuesv1.UpdateIssueAISummaryRequest) (*issuesv1.GetIssueResponse, error) {
 	if req.GetRepo() == "" {
 		return nil, status.Error(codes.InvalidArgument, "repo is required")
@@ -224,6 +829,8 @@ func (s *IssueSyncAdminGRPCServer) toProtoManagedRepos(ctx context.Context, repo
 		item := &issuesv1.ManagedSyncRepo{
 			Repo:       repo.Repo,
 			IssueCount: int32(len(rows)),
+			Rule:       repo.Rule,
+			Policy:     toProtoRepoSyncPolicy(repo.Policy),
 		}
 		if !repo.CreatedAt.IsZero() {
 			item.CreatedAt = timestamppb.New(repo.CreatedAt)
@@ -236,6 +843,127 @@ func (s *IssueSyncAdminGRPCServer) toProtoManagedRepos(ctx context.Context, repo
 	return out, nil
 }
 
+func toProtoRepoSyncPolicy(in dao.RepoSyncPolicy) *issuesv1.RepoSyncPolicy {
+	out := &issuesv1.RepoSyncPolicy{
+		Enabled:             !in.Disabled,
+		IntervalSeconds:     int32(in.IntervalSeconds),
+		State:               issueListState(in),
+		IncludeLabels:       in.IncludeLabels,
+		ExcludeLabels:       in.ExcludeLabels,
+		IncludePullRequests: !in.ExcludePullRequests,
+		SyncComments:        !in.SkipComments,
+	}
+	if in.SyncSince != nil {
+		out.SyncSince = timestamppb.New(*in.SyncSince)
+	}
+	return out
+}
+
+func fromProtoRepoSyncPolicy(in *issuesv1.RepoSyncPolicy) dao.RepoSyncPolicy {
+	out := dao.RepoSyncPolicy{
+		Disabled:            !in.GetEnabled(),
+		IntervalSeconds:     int(in.GetIntervalSeconds()),
+		State:               in.GetState(),
+		IncludeLabels:       in.GetIncludeLabels(),
+		ExcludeLabels:       in.GetExcludeLabels(),
+		ExcludePullRequests: !in.GetIncludePullRequests(),
+		SkipComments:        !in.GetSyncComments(),
+	}
+	if in.GetSyncSince() != nil {
+		since := in.GetSyncSince().AsTime()
+		out.SyncSince = &since
+	}
+	return out
+}
+
+func managedRepoPolicyError(err error) error {
+	switch {
+	case errors.Is(err, dao.ErrManagedRepoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidRepoSyncPolicy):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Errorf(codes.Internal, "managed repo policy: %v", err)
+}
+
+func toProtoResyncJob(in ResyncJob) *issuesv1.ResyncJob {
+	out := &issuesv1.ResyncJob{
+		Id:        in.ID,
+		Repo:      in.Repo,
+		Mode:      in.Mode,
+		State:     in.State,
+		Pages:     in.Pages,
+		Fetched:   in.Fetched,
+		Persisted: in.Persisted,
+		Comments:  in.Comments,
+		Error:     in.Err,
+		StartedAt: timestamppb.New(in.StartedAt),
+	}
+	if !in.Since.IsZero() {
+		out.Since = timestamppb.New(in.Since)
+	}
+	if !in.FinishedAt.IsZero() {
+		out.FinishedAt = timestamppb.New(in.FinishedAt)
+	}
+	return out
+}
+
+func toProtoSyncRun(in dao.SyncRun) *issuesv1.SyncRun {
+	out := &issuesv1.SyncRun{
+		Id:         in.ID,
+		JobType:    in.JobType,
+		Trigger:    in.Trigger,
+		Status:     in.Status,
+		StartedAt:  timestamppb.New(in.StartedAt),
+		FinishedAt: timestamppb.New(in.FinishedAt),
+		Fetched:    in.Fetched,
+		Persisted:  in.Persisted,
+		Failed:     in.Failed,
+		Error:      in.Err,
+		Targets:    make([]*issuesv1.SyncRunTarget, 0, len(in.Targets)),
+	}
+	for _, target := range in.Targets {
+		out.Targets = append(out.Targets, &issuesv1.SyncRunTarget{
+			Target:    target.Target,
+			Fetched:   target.Fetched,
+			Persisted: target.Persisted,
+			Failed:    target.Failed,
+			Error:     target.Err,
+		})
+	}
+	return out
+}
+
+func resyncError(err error) error {
+	switch {
+	case errors.Is(err, dao.ErrManagedRepoNotFound), errors.Is(err, ErrResyncJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidResyncRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrResyncInProgress):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "resync: %v", err)
+}
+
+func settingsError(err error) error {
+	switch {
+	case errors.Is(err, dao.ErrSettingsVersionConflict):
+		return status.Error(codes.Aborted, "settings were changed since the given version")
+	case errors.Is(err, ErrUnknownSettingsSection), errors.Is(err, ErrInvalidSettings):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "settings: %v", err)
+	}
+}
+
+func replaceManagedReposError(err error) error {
+	if errors.Is(err, ErrInvalidRepoRule) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Errorf(codes.Internal, "replace managed repos: %v", err)
+}
+
 func managedRepoNames(repos []dao.ManagedRepo) []string {
 	out := make([]string, 0, len(repos))
 	for _, repo := range repos {
@@ -9,6 +9,9 @@ import (
 	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
 	"github.com/kongken/datasrv/service/datasrv/internal/conf"
 	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -34,12 +37,15 @@ func TestIssueSyncAdminGRPCServer_GetAndUpdateSyncConfig(t *testing.T) {
 	}
 
 	updated, err := srv.UpdateSyncConfig(context.Background(), &issuesv1.UpdateSyncConfigRequest{
-		Enabled:               true,
-		Repos:                 []string{"octo/repo"},
-		IntervalSeconds:       60,
-		PageSize:              50,
-		MaxPagesPerRun:        5,
-		RequestTimeoutSeconds: 8,
+		Enabled:                  true,
+		Repos:                    []string{"octo/repo"},
+		IntervalSeconds:          60,
+		PageSize:                 50,
+		MaxPagesPerRun:           5,
+		RequestTimeoutSeconds:    8,
+		Concurrency:              proto.Int32(2),
+		ReconcileIntervalSeconds: proto.Int32(3600),
+		DiscoveryIntervalSeconds: proto.Int32(600),
 	})
 	if err != nil {
 		t.Fatalf("UpdateSyncConfig() error = %v", err)
@@ -47,6 +53,15 @@ func TestIssueSyncAdminGRPCServer_GetAndUpdateSyncConfig(t *testing.T) {
 	if len(updated.Repos) != 1 || updated.Repos[0] != "octo/repo" {
 		t.Fatalf("updated repos = %#v, want [octo/repo]", updated.Repos)
 	}
+	if updated.Concurrency != 2 || syncSvc.GetConfig().Concurrency != 2 {
+		t.Fatalf("concurrency = %d, want 2", updated.Concurrency)
+	}
+	if updated.ReconcileIntervalSeconds != 3600 || syncSvc.GetConfig().ReconcileIntervalSeconds != 3600 {
+		t.Fatalf("reconcile_interval_seconds = %d, want 3600", updated.ReconcileIntervalSeconds)
+	}
+	if updated.DiscoveryIntervalSeconds != 600 || syncSvc.GetConfig().DiscoveryIntervalSeconds != 600 {
+		t.Fatalf("discovery_interval_seconds = %d, want 600", updated.DiscoveryIntervalSeconds)
+	}
 }
 
 func TestIssueSyncAdminGRPCServer_ListAndReplaceManagedSyncRepos(t *testing.T) {
@@ -79,6 +94,13 @@ func TestIssueSyncAdminGRPCServer_ListAndReplaceManagedSyncRepos(t *testing.T) {
 	if len(replaced.Repos) != 2 {
 		t.Fatalf("replaced repos len = %d, want 2", len(replaced.Repos))
 	}
+
+	_, err = srv.ReplaceManagedSyncRepos(context.Background(), &issuesv1.ReplaceManagedSyncReposRequest{
+		Repos: []string{"o?stars=10"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ReplaceManagedSyncRepos() invalid rule error = %v, want InvalidArgument", err)
+	}
 }
 
 func TestIssueSyncAdminGRPCServer_SyncIssuesAndStatus(t *testing.T) {
@@ -92,15 +114,23 @@ func TestIssueSyncAdminGRPCServer_SyncIssuesAndStatus(t *testing.T) {
 		Repos:                 []string{"owner/repo"},
 		RequestTimeoutSeconds: 5,
 	}, nil)
+	reset := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
 	syncSvc.client = &fakeGitHubIssueClient{
-		responses: []fakeGitHubResponse{{issues: []*github.Issue{ghIssue(10, 10, time.Now().UTC())}, nextPage: 0}},
+		responses: []fakeGitHubResponse{{
+			issues:   []*github.Issue{ghIssue(10, 10, time.Now().UTC())},
+			nextPage: 0,
+			rate:     github.Rate{Limit: 5000, Remaining: 4321, Reset: github.Timestamp{Time: reset}},
+		}},
 	}
 
 	srv := NewIssueSyncAdminGRPCServer(store, syncSvc, cfg)
-	_, err := srv.SyncIssues(context.Background(), &issuesv1.SyncIssuesRequest{})
+	resp, err := srv.SyncIssues(context.Background(), &issuesv1.SyncIssuesRequest{})
 	if err != nil {
 		t.Fatalf("SyncIssues() error = %v", err)
 	}
+	if err := srv.WatchSyncJob(&issuesv1.WatchSyncJobRequest{Id: resp.GetJobId()}, &fakeWatchStream[issuesv1.SyncJobEvent]{ctx: context.Background()}); err != nil {
+		t.Fatalf("WatchSyncJob() error = %v", err)
+	}
 
 	status, err := srv.GetSyncStatus(context.Background(), &emptypb.Empty{})
 	if err != nil {
@@ -115,6 +145,10 @@ func TestIssueSyncAdminGRPCServer_SyncIssuesAndStatus(t *testing.T) {
 	if len(status.Checkpoints) != 1 {
 		t.Fatalf("Checkpoints len = %d, want 1", len(status.Checkpoints))
 	}
+	rl := status.GetRateLimit()
+	if rl.GetLimit() != 5000 || rl.GetRemaining() != 4321 || !rl.GetResetAt().AsTime().Equal(reset) {
+		t.Fatalf("RateLimit = %v, want 4321/5000 until %v", rl, reset)
+	}
 }
 
 func TestIssueSyncAdminGRPCServer_GetSyncStatusError(t *testing.T) {
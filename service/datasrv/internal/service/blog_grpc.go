@@ -39,13 +39,28 @@ func (s *BlogQueryGRPCServer) ListPosts(ctx context.Context, req *blogv1.ListBlo
 	if statusFilter == "" {
 		statusFilter = "published"
 	}
-	rows, err := s.store.ListBlogPosts(ctx, dao.BlogPostFilter{
+	filter := dao.BlogPostFilter{
 		Status: statusFilter,
 		Tag:    req.GetTag(),
 		Query:  req.GetQuery(),
 		Offset: offset,
 		Limit:  int(pageSize + 1),
-	})
+	}
+	if req.GetPageToken() != "" {
+		filter.After = &dao.BlogPostCursor{}
+		if err := decodePageToken(req.GetPageToken(), filter.After); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		page, filter.Offset = 0, 0
+	}
+	var counter dao.BlogPostCountStore
+	if req.GetIncludeTotal() {
+		var ok bool
+		if counter, ok = s.store.(dao.BlogPostCountStore); !ok {
+			return nil, status.Error(codes.Unimplemented, "blog post totals are not supported by the configured store")
+		}
+	}
+	rows, err := s.store.ListBlogPosts(ctx, filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list blog posts: %v", err)
 	}
@@ -61,12 +76,22 @@ func (s *BlogQueryGRPCServer) ListPosts(ctx context.Context, req *blogv1.ListBlo
 		posts = append(posts, toProtoBlogPost(row))
 	}
 
-	return &blogv1.ListBlogPostsResponse{
+	resp := &blogv1.ListBlogPostsResponse{
 		Posts:    posts,
 		Page:     page,
 		PageSize: pageSize,
 		HasNext:  hasNext,
-	}, nil
+	}
+	if hasNext {
+		last := rows[len(rows)-1]
+		resp.NextPageToken = encodePageToken(dao.BlogPostCursor{PublishedAt: last.PublishedAt, CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	if counter != nil {
+		if resp.Total, err = counter.CountBlogPosts(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.Internal, "count blog posts: %v", err)
+		}
+	}
+	return resp, nil
 }
 
 func (s *BlogQueryGRPCServer) GetPost(ctx context.Context, req *blogv1.GetBlogPostRequest) (*blogv1.GetBlogPostResponse, error) {
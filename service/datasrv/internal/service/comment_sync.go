@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// syncRepoComments brings the stored comment threads of repo up to date once its changed issues are
+// persisted. Comments created or edited since the comment checkpoint come from the repo-wide listing
+// and are merged into the stored threads. A changed issue whose thread still disagrees with the
+// comment count GitHub reports, which is how deletions show up, is fetched whole.
+//
+// Comment failures are logged and keep the checkpoint where it was; they never fail the repo, whose
+// issues are already stored.
+func (s *IssueSyncService) syncRepoComments(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, changed []dao.SyncedIssue, run repoSyncRun) {
+	if s.commentCursors == nil {
+		for i := range changed {
+			if err := s.syncIssueComments(ctx, cfg, repo, owner, name, &changed[i]); err != nil {
+				logIssueCommentFailure(repo, changed[i], err)
+			}
+		}
+		return
+	}
+
+	startedAt := time.Now()
+	cp, err := s.commentCursors.GetCommentCheckpoint(ctx, repo)
+	if err != nil {
+		issueSyncLogger.Error("issue sync comment checkpoint load failed", "repo", repo, "error", err)
+		return
+	}
+
+	var failure error
+	// A repo without a cursor starts at this run: the threads of its changed issues are fetched
+	// whole below, and older threads are left as they were stored.
+	cursor := startedAt
+	counts := make(map[int32]int, len(changed))
+	if !cp.LastCommentUpdatedAt.IsZero() {
+		listed, maxSeen, err := s.listRepoComments(ctx, cfg, owner, name, cp.LastCommentUpdatedAt, run.unbounded)
+		if err != nil {
+			issueSyncLogger.Error("issue sync repo comments failed", "repo", repo, "error", err)
+			failure = err
+		}
+		cursor = maxSeen
+
+		byNumber := make(map[int32]dao.SyncedIssue, len(changed))
+		for _, issue := range changed {
+			byNumber[issue.Number] = issue
+		}
+		for number, comments := range listed {
+			issue, ok := byNumber[number]
+			if !ok {
+				issue, ok, err = s.storedIssue(ctx, repo, number)
+				if err != nil {
+					logIssueCommentFailure(repo, dao.SyncedIssue{Number: number}, err)
+					failure = err
+					continue
+				}
+				if !ok {
+					// Issues the sync skipped, such as those excluded by the repo policy.
+					continue
+				}
+			}
+			count, err := s.mergeIssueComments(ctx, cfg, repo, owner, name, issue, comments)
+			if err != nil {
+				logIssueCommentFailure(repo, issue, err)
+				failure = err
+				continue
+			}
+			counts[number] = count
+		}
+	}
+
+	for i := range changed {
+		if err := s.reconcileIssueComments(ctx, cfg, repo, owner, name, &changed[i], counts, run.unconditional); err != nil {
+			logIssueCommentFailure(repo, changed[i], err)
+			failure = err
+		}
+	}
+
+	next := dao.CommentCheckpoint{
+		Repo:                 repo,
+		LastCommentUpdatedAt: cp.LastCommentUpdatedAt,
+		LastSyncedAt:         time.Now(),
+	}
+	if failure != nil {
+		next.LastError = failure.Error()
+	} else {
+		next.LastCommentUpdatedAt = cursor
+	}
+	if err := s.commentCursors.SaveCommentCheckpoint(ctx, next); err != nil {
+		issueSyncLogger.Error("issue sync comment checkpoint save failed", "repo", repo, "error", err)
+	}
+}
+
+// listRepoComments pages through the comments of a repo updated since the cursor, oldest first, and
+// groups them by issue number. It returns what was listed before an error together with the latest
+// update seen.
+func (s *IssueSyncService) listRepoComments(ctx context.Context, cfg conf.GitHubSyncConfig, owner, name string, since time.Time, unbounded bool) (map[int32][]dao.IssueComment, time.Time, error) {
+	repo := owner + "/" + name
+	byIssue := make(map[int32][]dao.IssueComment)
+	maxSeen := since
+	currentPage := 1
+	for page := 0; unbounded || page < cfg.MaxPagesPerRun; page++ {
+		pageStartedAt := time.Now()
+		var items []*github.IssueComment
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, repo, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(ctx, cfg)
+			defer cancel()
+			var err error
+			items, resp, err = s.client.ListComments(requestCtx, owner, name, 0, &github.IssueListCommentsOptions{
+				Sort:      github.Ptr("updated"),
+				Direction: github.Ptr("asc"),
+				Since:     github.Ptr(since),
+				ListOptions: github.ListOptions{
+					Page:    currentPage,
+					PerPage: githubCommentsPerPage,
+				},
+			})
+			return resp, err
+		})
+		if err != nil {
+			return byIssue, maxSeen, fmt.Errorf("list repo comments for %s: %w", repo, err)
+		}
+		issueSyncLogger.Info("issue sync repo comments page fetched",
+			"repo", repo,
+			"page", currentPage,
+			"comment_count", len(items),
+			"since", since,
+			"duration_ms", time.Since(pageStartedAt).Milliseconds(),
+		)
+
+		for _, item := range items {
+			comment := toIssueComment(item)
+			if comment.UpdatedAt.After(maxSeen) {
+				maxSeen = comment.UpdatedAt
+			}
+			number, ok := commentIssueNumber(item)
+			if !ok {
+				continue
+			}
+			byIssue[number] = append(byIssue[number], comment)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		currentPage = resp.NextPage
+	}
+	return byIssue, maxSeen, nil
+}
+
+// mergeIssueComments folds listed comments into the stored thread of issue and returns its new length.
+// A thread that cannot be loaded is fetched whole instead.
+func (s *IssueSyncService) mergeIssueComments(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, issue dao.SyncedIssue, comments []dao.IssueComment) (int, error) {
+	stored, err := s.commentStore.LoadComments(ctx, repo, issue.IssueID, issue.Number)
+	if err != nil {
+		issueSyncLogger.Warn("issue sync stored comments unavailable, refetching thread",
+			"repo", repo,
+			"issue_number", issue.Number,
+			"error", err,
+		)
+		if err := s.syncIssueComments(ctx, cfg, repo, owner, name, &issue); err != nil {
+			return 0, err
+		}
+		return int(issue.Comments), nil
+	}
+
+	merged := stored
+	for _, comment := range comments {
+		merged = mergeIssueComment(merged, comment, false)
+	}
+	saveCtx, cancel := requestTimeout(ctx, cfg)
+	defer cancel()
+	if err := s.commentStore.SaveComments(saveCtx, repo, issue.IssueID, issue.Number, merged); err != nil {
+		return 0, fmt.Errorf("save issue comments for %s#%d: %w", repo, issue.Number, err)
+	}
+	issueSyncLogger.Info("issue sync comments merged",
+		"repo", repo,
+		"issue_number", issue.Number,
+		"issue_id", issue.IssueID,
+		"listed", len(comments),
+		"comment_count", len(merged),
+	)
+	return len(merged), nil
+}
+
+// reconcileIssueComments compares the stored thread of a changed issue with the comment count
+// GitHub reports and fetches the thread whole when they differ, or always when refetch is set.
+// counts holds the thread lengths already known from merging.
+func (s *IssueSyncService) reconcileIssueComments(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, issue *dao.SyncedIssue, counts map[int32]int, refetch bool) error {
+	if !refetch {
+		count, ok := counts[issue.Number]
+		if !ok {
+			stored, err := s.commentStore.LoadComments(ctx, repo, issue.IssueID, issue.Number)
+			switch {
+			case err != nil && issue.Comments == 0:
+				// Nothing stored and nothing to store.
+				return nil
+			case err != nil:
+				count = -1
+			default:
+				count = len(stored)
+			}
+		}
+		if count == int(issue.Comments) {
+			return nil
+		}
+	}
+
+	if issue.Comments > 0 {
+		return s.syncIssueComments(ctx, cfg, repo, owner, name, issue)
+	}
+	saveCtx, cancel := requestTimeout(ctx, cfg)
+	defer cancel()
+	if err := s.commentStore.SaveComments(saveCtx, repo, issue.IssueID, issue.Number, []dao.IssueComment{}); err != nil {
+		return fmt.Errorf("save issue comments for %s#%d: %w", repo, issue.Number, err)
+	}
+	return nil
+}
+
+func (s *IssueSyncService) storedIssue(ctx context.Context, repo string, number int32) (dao.SyncedIssue, bool, error) {
+	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{Repo: repo, Number: number, Limit: 1})
+	if err != nil {
+		return dao.SyncedIssue{}, false, fmt.Errorf("load issue %s#%d: %w", repo, number, err)
+	}
+	if len(rows) == 0 {
+		return dao.SyncedIssue{}, false, nil
+	}
+	return rows[0], true, nil
+}
+
+// commentIssueNumber reads the issue number off the issue_url of a repo-wide listed comment.
+func commentIssueNumber(comment *github.IssueComment) (int32, bool) {
+	issueURL := comment.GetIssueURL()
+	number, err := strconv.ParseInt(issueURL[strings.LastIndex(issueURL, "/")+1:], 10, 32)
+	if err != nil || number <= 0 {
+		return 0, false
+	}
+	return int32(number), true
+}
+
+func logIssueCommentFailure(repo string, issue dao.SyncedIssue, err error) {
+	issueSyncLogger.Error("issue sync comments failed",
+		"repo", repo,
+		"issue_number", issue.Number,
+		"issue_id", issue.IssueID,
+		"comment_count", issue.Comments,
+		"error", err,
+	)
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+func ghRepoComment(id int64, issueNumber int, body string, created, updated time.Time) *github.IssueComment {
+	return &github.IssueComment{
+		ID:        github.Ptr(id),
+		Body:      github.Ptr(body),
+		IssueURL:  github.Ptr(fmt.Sprintf("https://api.github.com/repos/owner/repo/issues/%d", issueNumber)),
+		CreatedAt: &github.Timestamp{Time: created},
+		UpdatedAt: &github.Timestamp{Time: updated},
+	}
+}
+
+func TestRunSyncMergesRepoWideCommentsSinceCheckpoint(t *testing.T) {
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	cursor := time.Now().UTC().Add(-time.Hour).Round(time.Second)
+	store.cursors["owner/repo"] = dao.CommentCheckpoint{Repo: "owner/repo", LastCommentUpdatedAt: cursor}
+	store.issues["owner/repo"] = []dao.SyncedIssue{{Repo: "owner/repo", IssueID: 50, Number: 5, State: "open", Comments: 1}}
+	commentStore.saved["owner/repo/50-5.json"] = []dao.IssueComment{{ID: 501, Body: "before", CreatedAt: cursor.Add(-time.Hour)}}
+	commentStore.saved["owner/repo/10-1.json"] = []dao.IssueComment{{ID: 101}}
+
+	edited := cursor.Add(2 * time.Minute)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(10, 1, time.Now().UTC())}},
+		},
+		commentResponses: map[int][]fakeGitHubCommentResponse{
+			0: {{comments: []*github.IssueComment{
+				ghRepoComment(501, 5, "after", cursor.Add(-time.Hour), cursor.Add(time.Minute)),
+				ghRepoComment(502, 5, "new", edited, edited),
+				ghRepoComment(601, 6, "not synced", edited, edited),
+			}}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Persisted != 1 {
+		t.Fatalf("result = %#v, want issue 1 persisted", res)
+	}
+	if len(client.repoCommentCalls) != 1 {
+		t.Fatalf("repo comment calls = %d, want 1", len(client.repoCommentCalls))
+	}
+	if opts := client.repoCommentCalls[0]; !opts.GetSince().Equal(cursor) || opts.GetSort() != "updated" || opts.GetDirection() != "asc" {
+		t.Fatalf("repo comment options = %#v, want updated asc since %v", opts, cursor)
+	}
+
+	saved := commentStore.saved["owner/repo/50-5.json"]
+	if len(saved) != 2 || saved[0].ID != 501 || saved[0].Body != "after" || saved[1].ID != 502 {
+		t.Fatalf("issue 5 comments = %#v, want the edit and the new comment merged", saved)
+	}
+	if _, ok := commentStore.saved["owner/repo/0-6.json"]; ok {
+		t.Fatalf("comments of an issue that is not stored were saved")
+	}
+	if got := commentStore.saved["owner/repo/10-1.json"]; len(got) != 1 || got[0].ID != 101 {
+		t.Fatalf("issue 1 comments = %#v, want the stored thread kept since the count matches", got)
+	}
+	if cp := store.cursors["owner/repo"]; !cp.LastCommentUpdatedAt.Equal(edited) || cp.LastError != "" {
+		t.Fatalf("comment checkpoint = %#v, want cursor at %v", cp, edited)
+	}
+}
+
+func TestRunSyncReconcilesDeletedComments(t *testing.T) {
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	store.cursors["owner/repo"] = dao.CommentCheckpoint{Repo: "owner/repo", LastCommentUpdatedAt: time.Now().UTC().Add(-time.Hour)}
+	commentStore.saved["owner/repo/10-1.json"] = []dao.IssueComment{{ID: 101}}
+	commentStore.saved["owner/repo/20-2.json"] = []dao.IssueComment{{ID: 201}, {ID: 202}}
+
+	emptied := ghIssue(10, 1, time.Now().UTC())
+	emptied.Comments = github.Ptr(0)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{emptied, ghIssue(20, 2, time.Now().UTC())}},
+		},
+		commentResponses: map[int][]fakeGitHubCommentResponse{
+			2: {{comments: []*github.IssueComment{{ID: github.Ptr(int64(202))}}}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.client = client
+
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if got, ok := commentStore.saved["owner/repo/10-1.json"]; !ok || len(got) != 0 {
+		t.Fatalf("issue 1 comments = %#v, want the thread emptied", got)
+	}
+	if got := commentStore.saved["owner/repo/20-2.json"]; len(got) != 1 || got[0].ID != 202 {
+		t.Fatalf("issue 2 comments = %#v, want the thread refetched without the deleted comment", got)
+	}
+}
+
+func TestRunSyncKeepsThreadOfIssueUpdatedWithoutComments(t *testing.T) {
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	store.cursors["owner/repo"] = dao.CommentCheckpoint{Repo: "owner/repo", LastCommentUpdatedAt: time.Now().UTC().Add(-time.Hour)}
+	commentStore.saved["owner/repo/10-1.json"] = []dao.IssueComment{{ID: 101}}
+
+	// Issue 1 was only relabeled: its update lists no comments and its count still matches.
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(10, 1, time.Now().UTC())}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.client = client
+
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(client.commentCalls) != 0 {
+		t.Fatalf("issue comment requests = %v, want none for a relabeled issue", client.commentCalls)
+	}
+	if got := commentStore.saved["owner/repo/10-1.json"]; len(got) != 1 || got[0].ID != 101 {
+		t.Fatalf("issue 1 comments = %#v, want the stored thread kept", got)
+	}
+}
+
+func TestRunSyncCommentFailureKeepsRepoSynced(t *testing.T) {
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	commentStore.saveErr = errors.New("object store unavailable")
+	cursor := time.Now().UTC().Add(-time.Hour).Round(time.Second)
+	store.cursors["owner/repo"] = dao.CommentCheckpoint{Repo: "owner/repo", LastCommentUpdatedAt: cursor}
+	updated := time.Now().UTC().Round(time.Second)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(10, 1, updated)}},
+		},
+		commentResponses: map[int][]fakeGitHubCommentResponse{
+			0: {{comments: []*github.IssueComment{ghRepoComment(101, 1, "hi", updated, updated)}}},
+			1: {{comments: []*github.IssueComment{{ID: github.Ptr(int64(101))}}}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Persisted != 1 {
+		t.Fatalf("result = %#v, want the issue persisted despite the comment failure", res)
+	}
+	if cp := store.checkpoints["owner/repo"]; cp.LastRunStatus != "success" || !cp.LastIssueUpdatedAt.Equal(updated) {
+		t.Fatalf("issue checkpoint = %#v, want success at %v", cp, updated)
+	}
+	if cp := store.cursors["owner/repo"]; !cp.LastCommentUpdatedAt.Equal(cursor) || cp.LastError == "" {
+		t.Fatalf("comment checkpoint = %#v, want cursor kept at %v with the error", cp, cursor)
+	}
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// DatabaseIssueCommentStore keeps comment threads in the primary sync store, so that comments need
+// no storage beyond the database.
+type DatabaseIssueCommentStore struct {
+	threads dao.IssueCommentThreadStore
+}
+
+func (s *DatabaseIssueCommentStore) SaveComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
+	return s.threads.SaveIssueComments(ctx, repo, issueID, issueNumber, comments)
+}
+
+func (s *DatabaseIssueCommentStore) LoadComments(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]dao.IssueComment, error) {
+	return s.threads.LoadIssueComments(ctx, repo, issueID, issueNumber)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	feedsv1 "github.com/kongken/datasrv/pkg/proto/feeds/v1"
@@ -19,6 +20,12 @@ type FeedSyncAdminGRPCServer struct {
 	store   dao.FeedStore
 	syncSvc *FeedSyncService
 	cfg     *conf.Config
+	// leader is nil when leader election is off; GetFeedSyncStatus then reports no leader.
+	leader *LeaderElector
+	// jobs is nil when no jobs are scheduled; a new sync interval then applies after a restart.
+	jobs *JobScheduler
+	// settings is nil when the store keeps no settings; config updates then last until restart.
+	settings *SettingsService
 
 	statusMu sync.RWMutex
 	lastRun  FeedSyncRunSummary
@@ -98,28 +105,40 @@ func (s *FeedSyncAdminGRPCServer) SyncFeeds(ctx context.Context, req *feedsv1.Sy
 			return nil, status.Errorf(codes.Internal, "get feed source: %v", err)
 		}
 	}
-	summary, err := s.syncSvc.RunSync(ctx, req.GetFeedSourceId())
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "sync feeds: %v", err)
-	}
-	s.statusMu.Lock()
-	s.lastRun = summary
-	s.statusMu.Unlock()
+	job, attached := s.syncSvc.StartSync(ctx, req.GetFeedSourceId(), func(summary FeedSyncRunSummary, err error) {
+		if err != nil {
+			return
+		}
+		s.statusMu.Lock()
+		s.lastRun = summary
+		s.statusMu.Unlock()
+	})
+	return &feedsv1.SyncFeedsResponse{
+		StartedAt: timestamppb.New(job.StartedAt),
+		JobId:     job.ID,
+		Attached:  attached,
+	}, nil
+}
 
-	results := make([]*feedsv1.FeedSyncResult, 0, len(summary.Results))
-	for _, result := range summary.Results {
-		results = append(results, &feedsv1.FeedSyncResult{
-			FeedSourceId: result.FeedSourceID,
-			Fetched:      result.Fetched,
-			Persisted:    result.Persisted,
-			Error:        result.Error,
+func (s *FeedSyncAdminGRPCServer) WatchSyncJob(req *feedsv1.WatchSyncJobRequest, stream feedsv1.FeedSyncAdminService_WatchSyncJobServer) error {
+	if req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	err := s.syncSvc.WatchSyncJob(stream.Context(), req.GetId(), func(event SyncJobEvent) error {
+		return stream.Send(&feedsv1.SyncJobEvent{
+			JobId:     event.JobID,
+			Type:      event.Type,
+			Target:    event.Target,
+			Fetched:   event.Fetched,
+			Persisted: event.Persisted,
+			Error:     event.Err,
+			At:        timestamppb.New(event.At),
 		})
+	})
+	if errors.Is(err, ErrSyncJobNotFound) {
+		return status.Error(codes.NotFound, err.Error())
 	}
-	return &feedsv1.SyncFeedsResponse{
-		StartedAt:  timestamppb.New(summary.StartedAt),
-		FinishedAt: timestamppb.New(summary.FinishedAt),
-		Results:    results,
-	}, nil
+	return err
 }
 
 func (s *FeedSyncAdminGRPCServer) GetFeedSyncStatus(ctx context.Context, _ *emptypb.Empty) (*feedsv1.GetFeedSyncStatusResponse, error) {
@@ -166,9 +185,70 @@ func (s *FeedSyncAdminGRPCServer) GetFeedSyncStatus(ctx context.Context, _ *empt
 	if !lastRun.FinishedAt.IsZero() {
 		resp.LastFinishedAt = timestamppb.New(lastRun.FinishedAt)
 	}
+	if s.leader != nil {
+		leader, err := s.leader.Status(ctx)
+		if err != nil {
+			leaderLogger.Warn("scheduler leader lookup failed", "error", err)
+		}
+		resp.Leader = &feedsv1.SchedulerLeader{Holder: leader.Holder, Replica: leader.Replica, Leading: leader.Leading}
+	}
 	return resp, nil
 }
 
+// SetLeaderElector makes GetFeedSyncStatus report the scheduler leader.
+func (s *FeedSyncAdminGRPCServer) SetLeaderElector(leader *LeaderElector) {
+	s.leader = leader
+}
+
+func (s *FeedSyncAdminGRPCServer) GetFeedSyncConfig(ctx context.Context, _ *emptypb.Empty) (*feedsv1.GetFeedSyncConfigResponse, error) {
+	doc, err := s.settings.Current(ctx, SettingsFeedSync)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get settings: %v", err)
+	}
+	return toProtoFeedSyncConfig(s.syncSvc.GetConfig(), doc), nil
+}
+
+func (s *FeedSyncAdminGRPCServer) UpdateFeedSyncConfig(ctx context.Context, req *feedsv1.UpdateFeedSyncConfigRequest) (*feedsv1.GetFeedSyncConfigResponse, error) {
+	current := s.syncSvc.GetConfig()
+	next := current
+	next.Enabled = req.GetEnabled()
+	next.IntervalSeconds = int(req.GetIntervalSeconds())
+	next.RequestTimeoutSeconds = int(req.GetRequestTimeoutSeconds())
+	next = normalizeFeedSyncConfig(next)
+	doc, err := s.settings.Save(ctx, SettingsFeedSync, req.GetVersion(),
+		sectionSettings[FeedSyncSettings](current), sectionSettings[FeedSyncSettings](next))
+	if err != nil {
+		return nil, settingsError(err)
+	}
+	updated := s.syncSvc.UpdateConfig(next)
+	s.cfg.FeedSync = updated
+	if s.jobs != nil {
+		_ = s.jobs.Reschedule(JobFeedSync)
+	}
+	return toProtoFeedSyncConfig(updated, doc), nil
+}
+
+func toProtoFeedSyncConfig(cfg conf.FeedSyncConfig, doc dao.SettingsDocument) *feedsv1.GetFeedSyncConfigResponse {
+	return &feedsv1.GetFeedSyncConfigResponse{
+		Enabled:               cfg.Enabled,
+		IntervalSeconds:       int32(cfg.IntervalSeconds),
+		RequestTimeoutSeconds: int32(cfg.RequestTimeoutSeconds),
+		Version:               doc.Version,
+		UpdatedBy:             doc.UpdatedBy,
+		UpdatedAt:             maybeTimestamp(doc.UpdatedAt),
+	}
+}
+
+// SetJobScheduler makes UpdateFeedSyncConfig reschedule the feed sync job.
+func (s *FeedSyncAdminGRPCServer) SetJobScheduler(jobs *JobScheduler) {
+	s.jobs = jobs
+}
+
+// SetSettingsService makes the feed sync config updates persist across restarts.
+func (s *FeedSyncAdminGRPCServer) SetSettingsService(settings *SettingsService) {
+	s.settings = settings
+}
+
 func upsertFeedSourceRequest(ctx context.Context, store dao.FeedStore, in *feedsv1.FeedSource, requireID bool) (dao.FeedSource, error) {
 	if in == nil {
 		return dao.FeedSource{}, status.Error(codes.InvalidArgument, "source is required")
@@ -62,8 +62,19 @@ func TestFeedSyncAdminGRPCServer_SyncAndStatus(t *testing.T) {
 	if err != nil {
 		t.Fatalf("SyncFeeds() error = %v", err)
 	}
-	if len(resp.GetResults()) != 1 {
-		t.Fatalf("results len = %d, want 1", len(resp.GetResults()))
+	if resp.GetJobId() == "" || resp.GetAttached() {
+		t.Fatalf("SyncFeeds() = %#v, want a new job", resp)
+	}
+	stream := &fakeWatchStream[feedsv1.SyncJobEvent]{ctx: context.Background()}
+	if err := srv.WatchSyncJob(&feedsv1.WatchSyncJobRequest{Id: resp.GetJobId()}, stream); err != nil {
+		t.Fatalf("WatchSyncJob() error = %v", err)
+	}
+	events := stream.sent
+	if len(events) != 3 || events[1].GetType() != SyncJobEventTargetFinished || events[1].GetTarget() != "feed-1" || events[1].GetPersisted() != 1 {
+		t.Fatalf("events = %#v, want started, feed-1 and finished", events)
+	}
+	if last := events[len(events)-1]; last.GetType() != SyncJobEventFinished || last.GetFetched() != 1 || last.GetError() != "" {
+		t.Fatalf("finished event = %#v, want 1 fetched", last)
 	}
 
 	status, err := srv.GetFeedSyncStatus(context.Background(), &emptypb.Empty{})
@@ -49,11 +49,26 @@ func (s *FeedQueryGRPCServer) ListFeedContents(ctx context.Context, req *feedsv1
 		return nil, status.Errorf(codes.Internal, "get feed source: %v", err)
 	}
 	page, pageSize, offset := normalizePagination(req.GetPage(), req.GetPageSize())
-	rows, err := s.store.ListFeedContents(ctx, dao.FeedContentFilter{
+	filter := dao.FeedContentFilter{
 		FeedSourceID: req.GetFeedSourceId(),
 		Offset:       offset,
 		Limit:        int(pageSize + 1),
-	})
+	}
+	if req.GetPageToken() != "" {
+		filter.After = &dao.FeedContentCursor{}
+		if err := decodePageToken(req.GetPageToken(), filter.After); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		page, filter.Offset = 0, 0
+	}
+	var counter dao.FeedContentCountStore
+	if req.GetIncludeTotal() {
+		var ok bool
+		if counter, ok = s.store.(dao.FeedContentCountStore); !ok {
+			return nil, status.Error(codes.Unimplemented, "feed content totals are not supported by the configured store")
+		}
+	}
+	rows, err := s.store.ListFeedContents(ctx, filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list feed contents: %v", err)
 	}
@@ -66,7 +81,17 @@ func (s *FeedQueryGRPCServer) ListFeedContents(ctx context.Context, req *feedsv1
 	for _, row := range rows {
 		contents = append(contents, toProtoFeedContent(row))
 	}
-	return &feedsv1.ListFeedContentsResponse{Contents: contents, Page: page, PageSize: pageSize, HasNext: hasNext}, nil
+	resp := &feedsv1.ListFeedContentsResponse{Contents: contents, Page: page, PageSize: pageSize, HasNext: hasNext}
+	if hasNext {
+		last := rows[len(rows)-1]
+		resp.NextPageToken = encodePageToken(dao.FeedContentCursor{PublishedAt: last.PublishedAt, ID: last.ID})
+	}
+	if counter != nil {
+		if resp.Total, err = counter.CountFeedContents(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.Internal, "count feed contents: %v", err)
+		}
+	}
+	return resp, nil
 }
 
 func (s *FeedQueryGRPCServer) GetFeedContent(ctx context.Context, req *feedsv1.GetFeedContentRequest) (*feedsv1.GetFeedContentResponse, error) {
@@ -49,17 +49,26 @@ type FeedSyncService struct {
 
 	store   dao.FeedStore
 	fetcher FeedFetcher
+	// runs is nil when the store keeps no run history; runs are then only logged.
+	runs dao.SyncRunStore
+	// job is the run in progress while running is set, guarded by mu.
+	job  *syncJob
+	jobs syncJobRegistry
 }
 
 func NewFeedSyncService(store dao.FeedStore, cfg conf.FeedSyncConfig, fetcher FeedFetcher) *FeedSyncService {
 	if fetcher == nil {
 		fetcher = NewHTTPFeedFetcher(normalizeFeedSyncConfig(cfg))
 	}
-	return &FeedSyncService{
+	svc := &FeedSyncService{
 		cfg:     normalizeFeedSyncConfig(cfg),
 		store:   store,
 		fetcher: fetcher,
 	}
+	if runs, ok := store.(dao.SyncRunStore); ok {
+		svc.runs = runs
+	}
+	return svc
 }
 
 func normalizeFeedSyncConfig(in conf.FeedSyncConfig) conf.FeedSyncConfig {
@@ -92,19 +101,73 @@ func (s *FeedSyncService) IsRunning() bool {
 }
 
 func (s *FeedSyncService) RunSync(ctx context.Context, onlySourceID string) (FeedSyncRunSummary, error) {
+	job, started := s.claimSync()
+	if !started {
+		return FeedSyncRunSummary{}, fmt.Errorf("feed sync is already running")
+	}
+	return s.runSyncJob(ctx, job, onlySourceID, nil)
+}
+
+// StartSync starts a sync of onlySourceID, or of every enabled source, in the background and
+// returns its job. When a feed sync is already running, the job of that run is returned with
+// attached set. done, if not nil, is called with the outcome of a started run.
+func (s *FeedSyncService) StartSync(ctx context.Context, onlySourceID string, done func(FeedSyncRunSummary, error)) (job SyncJob, attached bool) {
+	running, started := s.claimSync()
+	if !started {
+		return running.SyncJob, true
+	}
+	go func() {
+		_, _ = s.runSyncJob(context.WithoutCancel(ctx), running, onlySourceID, done)
+	}()
+	return running.SyncJob, false
+}
+
+// WatchSyncJob sends the events of a running or recently finished feed sync job, then follows it
+// until it finishes.
+func (s *FeedSyncService) WatchSyncJob(ctx context.Context, id string, send func(SyncJobEvent) error) error {
+	return s.jobs.watch(ctx, id, send)
+}
+
+func (s *FeedSyncService) claimSync() (*syncJob, bool) {
 	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.running {
-		s.mu.Unlock()
-		return FeedSyncRunSummary{}, fmt.Errorf("feed sync is already running")
+		return s.job, false
 	}
 	s.running = true
+	s.job = newSyncJob(dao.SyncRunJobFeedSync)
+	s.jobs.add(s.job)
+	return s.job, true
+}
+
+// runSyncJob runs a claimed feed sync, releasing the service before the finished event like
+// IssueSyncService.runSyncJob.
+func (s *FeedSyncService) runSyncJob(ctx context.Context, job *syncJob, onlySourceID string, done func(FeedSyncRunSummary, error)) (FeedSyncRunSummary, error) {
+	job.publish(SyncJobEvent{Type: SyncJobEventStarted, Target: onlySourceID, At: job.StartedAt})
+	summary, err := s.syncSources(withSyncJob(ctx, job), onlySourceID)
+	recordSyncRun(ctx, s.runs, feedSyncRunRecord(summary, err))
+
+	s.mu.Lock()
+	s.running = false
+	s.job = nil
 	s.mu.Unlock()
-	defer func() {
-		s.mu.Lock()
-		s.running = false
-		s.mu.Unlock()
-	}()
+	if done != nil {
+		done(summary, err)
+	}
 
+	finished := SyncJobEvent{Type: SyncJobEventFinished}
+	for _, result := range summary.Results {
+		finished.Fetched += result.Fetched
+		finished.Persisted += result.Persisted
+	}
+	if err != nil {
+		finished.Err = err.Error()
+	}
+	job.publish(finished)
+	return summary, err
+}
+
+func (s *FeedSyncService) syncSources(ctx context.Context, onlySourceID string) (FeedSyncRunSummary, error) {
 	cfg := s.GetConfig()
 	summary := FeedSyncRunSummary{StartedAt: time.Now()}
 	if !cfg.Enabled {
@@ -129,7 +192,17 @@ func (s *FeedSyncService) RunSync(ctx context.Context, onlySourceID string) (Fee
 		if !source.Enabled {
 			continue
 		}
-		summary.Results = append(summary.Results, s.syncOneSource(ctx, cfg, source))
+		result := s.syncOneSource(ctx, cfg, source)
+		if job := syncJobFrom(ctx); job != nil {
+			job.publish(SyncJobEvent{
+				Type:      SyncJobEventTargetFinished,
+				Target:    result.FeedSourceID,
+				Fetched:   result.Fetched,
+				Persisted: result.Persisted,
+				Err:       result.Error,
+			})
+		}
+		summary.Results = append(summary.Results, result)
 	}
 
 	summary.FinishedAt = time.Now()
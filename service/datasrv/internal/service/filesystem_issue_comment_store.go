@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// FilesystemIssueCommentStore writes comment threads as JSON files under a local directory, using the
+// same layout as the object store. It suits single-node deployments and tests.
+type FilesystemIssueCommentStore struct {
+	dir string
+}
+
+func newFilesystemIssueCommentStore(cfg conf.IssueCommentStorageConfig) (*FilesystemIssueCommentStore, error) {
+	dir := strings.TrimSpace(cfg.Dir)
+	if dir == "" {
+		return nil, fmt.Errorf("issue comment storage dir is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create issue comment dir: %w", err)
+	}
+	return &FilesystemIssueCommentStore{dir: dir}, nil
+}
+
+func (s *FilesystemIssueCommentStore) SaveComments(_ context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
+	body, err := json.Marshal(comments)
+	if err != nil {
+		return fmt.Errorf("marshal issue comments: %w", err)
+	}
+
+	path := s.path(repo, issueID, issueNumber)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create comments dir: %w", err)
+	}
+	// Write to a sibling and rename so that readers never see a partial thread.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create comments file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write comments file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write comments file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replace comments file: %w", err)
+	}
+	return nil
+}
+
+func (s *FilesystemIssueCommentStore) LoadComments(_ context.Context, repo string, issueID int64, issueNumber int32) ([]dao.IssueComment, error) {
+	body, err := os.ReadFile(s.path(repo, issueID, issueNumber))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, dao.ErrIssueCommentsNotFound
+		}
+		return nil, fmt.Errorf("read comments file: %w", err)
+	}
+
+	var comments []dao.IssueComment
+	if err := json.Unmarshal(body, &comments); err != nil {
+		return nil, fmt.Errorf("unmarshal comments file: %w", err)
+	}
+	return comments, nil
+}
+
+func (s *FilesystemIssueCommentStore) path(repo string, issueID int64, issueNumber int32) string {
+	return filepath.Join(s.dir, filepath.FromSlash(issueCommentKey(repo, issueID, issueNumber)))
+}
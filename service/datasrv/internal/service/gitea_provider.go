@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// GiteaIssueProvider reads issues and comments through the Gitea REST API v1, which Forgejo serves
+// unchanged. Projects are host/owner/repo, so one provider covers every host.
+type GiteaIssueProvider struct {
+	hosts  map[string]conf.GiteaHostConfig
+	client *http.Client
+}
+
+func NewGiteaIssueProvider(cfg conf.GiteaConfig) *GiteaIssueProvider {
+	hosts := make(map[string]conf.GiteaHostConfig, len(cfg.Hosts))
+	for host, hostCfg := range cfg.Hosts {
+		hosts[strings.ToLower(strings.TrimSpace(host))] = hostCfg
+	}
+	return &GiteaIssueProvider{hosts: hosts, client: http.DefaultClient}
+}
+
+type giteaUser struct {
+	Login     string `json:"login"`
+	HTMLURL   string `json:"html_url"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type giteaIssue struct {
+	ID        int64       `json:"id"`
+	Number    int32       `json:"number"`
+	Title     string      `json:"title"`
+	Body      string      `json:"body"`
+	State     string      `json:"state"`
+	User      giteaUser   `json:"user"`
+	Assignees []giteaUser `json:"assignees"`
+	Labels    []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Milestone *struct {
+		ID int64 `json:"id"`
+	} `json:"milestone"`
+	Comments    int32           `json:"comments"`
+	PullRequest json.RawMessage `json:"pull_request"`
+	HTMLURL     string          `json:"html_url"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	ClosedAt    *time.Time      `json:"closed_at"`
+}
+
+type giteaComment struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	User      giteaUser `json:"user"`
+	HTMLURL   string    `json:"html_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListIssues lists the issues of a repo, pull requests excluded. Gitea has no sort option on this
+// listing, so the page is not in update order.
+func (p *GiteaIssueProvider) ListIssues(ctx context.Context, project string, opts IssueListOptions) (IssueListPage, error) {
+	host, repo := p.splitProject(project)
+	query := url.Values{}
+	query.Set("type", "issues")
+	query.Set("state", opts.State)
+	query.Set("page", strconv.Itoa(opts.Page))
+	query.Set("limit", strconv.Itoa(opts.PerPage))
+	if !opts.Since.IsZero() {
+		query.Set("since", opts.Since.UTC().Format(time.RFC3339))
+	}
+
+	var items []json.RawMessage
+	header, err := getProviderJSON(ctx, p.client, p.repoURL(host, repo, "issues", query), p.authorizer(host), &items)
+	if err != nil {
+		return IssueListPage{}, fmt.Errorf("list gitea issues for %s: %w", project, err)
+	}
+	page := IssueListPage{NextPage: linkNextPage(header)}
+	for _, item := range items {
+		var issue giteaIssue
+		if err := json.Unmarshal(item, &issue); err != nil {
+			return IssueListPage{}, fmt.Errorf("decode gitea issue of %s: %w", project, err)
+		}
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			continue
+		}
+		page.Issues = append(page.Issues, issue.toSyncedIssue(string(item)))
+	}
+	return page, nil
+}
+
+// ListComments returns the comments of an issue, which Gitea sends in one unpaginated response.
+func (p *GiteaIssueProvider) ListComments(ctx context.Context, project string, issue dao.SyncedIssue) ([]dao.IssueComment, error) {
+	host, repo := p.splitProject(project)
+	var items []giteaComment
+	resource := fmt.Sprintf("issues/%d/comments", issue.Number)
+	if _, err := getProviderJSON(ctx, p.client, p.repoURL(host, repo, resource, nil), p.authorizer(host), &items); err != nil {
+		return nil, fmt.Errorf("list gitea comments for %s#%d: %w", project, issue.Number, err)
+	}
+	out := make([]dao.IssueComment, 0, len(items))
+	for _, item := range items {
+		out = append(out, dao.IssueComment{
+			ID:            item.ID,
+			Body:          item.Body,
+			UserLogin:     item.User.Login,
+			UserURL:       item.User.HTMLURL,
+			UserAvatarURL: item.User.AvatarURL,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			HTMLURL:       item.HTMLURL,
+		})
+	}
+	return out, nil
+}
+
+// splitProject splits host/owner/repo; parseProviderRepo has already checked the shape.
+func (p *GiteaIssueProvider) splitProject(project string) (string, string) {
+	host, repo, _ := strings.Cut(project, "/")
+	return strings.ToLower(host), repo
+}
+
+func (p *GiteaIssueProvider) repoURL(host, repo, resource string, query url.Values) string {
+	baseURL := strings.TrimRight(strings.TrimSpace(p.hosts[host].BaseURL), "/")
+	if baseURL == "" {
+		baseURL = "https://" + host
+	}
+	endpoint := fmt.Sprintf("%s/api/v1/repos/%s/%s", baseURL, repo, resource)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	return endpoint
+}
+
+func (p *GiteaIssueProvider) authorizer(host string) func(*http.Request) {
+	token := p.hosts[host].Token
+	return func(req *http.Request) {
+		if token != "" {
+			req.Header.Set("Authorization", "token "+token)
+		}
+	}
+}
+
+// linkNextPage reads the page query parameter of the rel="next" entry of a Link header, 0 when
+// there is none.
+func linkNextPage(header http.Header) int {
+	for _, link := range strings.Split(header.Get("Link"), ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+		next, err := url.Parse(strings.Trim(strings.TrimSpace(target), "<>"))
+		if err != nil {
+			return 0
+		}
+		page, _ := strconv.Atoi(next.Query().Get("page"))
+		return page
+	}
+	return 0
+}
+
+func (in giteaIssue) toSyncedIssue(raw string) dao.SyncedIssue {
+	assignees := make([]string, 0, len(in.Assignees))
+	for _, a := range in.Assignees {
+		assignees = append(assignees, a.Login)
+	}
+	labels := make([]string, 0, len(in.Labels))
+	for _, l := range in.Labels {
+		labels = append(labels, l.Name)
+	}
+	// Gitea has no per-repo milestone index: its web and API paths address milestones by ID, which
+	// is what serves as the milestone number. IDs past int32 are left out rather than truncated into
+	// the number of another milestone.
+	var milestone int32
+	if in.Milestone != nil && in.Milestone.ID > 0 && in.Milestone.ID <= math.MaxInt32 {
+		milestone = int32(in.Milestone.ID)
+	}
+	return dao.SyncedIssue{
+		IssueID:   in.ID,
+		Number:    in.Number,
+		Title:     in.Title,
+		Body:      in.Body,
+		State:     in.State,
+		Author:    in.User.Login,
+		Assignees: assignees,
+		Labels:    labels,
+		Milestone: milestone,
+		Comments:  in.Comments,
+		HTMLURL:   in.HTMLURL,
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		ClosedAt:  in.ClosedAt,
+		Raw:       raw,
+	}
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// GitHub rejects app JWTs that live longer than ten minutes.
+	githubAppJWTTTL = 9 * time.Minute
+	// Installation tokens are refreshed this long before GitHub expires them.
+	githubInstallationTokenSkew = 5 * time.Minute
+)
+
+type githubInstallationToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// githubAppAuth mints app JWTs and caches installation tokens per owner.
+type githubAppAuth struct {
+	appID int64
+	key   *rsa.PrivateKey
+
+	// appClient authenticates with the app JWT and is used for installation APIs only.
+	appClient *github.Client
+	now       func() time.Time
+
+	mu            sync.Mutex
+	installations map[string]int64
+	tokens        map[int64]githubInstallationToken
+}
+
+func newGitHubAppAuth(cfg conf.GitHubAppConfig, baseURL string, base http.RoundTripper) (*githubAppAuth, error) {
+	if cfg.AppID <= 0 {
+		return nil, fmt.Errorf("github app id is required")
+	}
+	key, err := loadGitHubAppPrivateKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	installations := make(map[string]int64, len(cfg.Installations))
+	for owner, id := range cfg.Installations {
+		owner = strings.ToLower(strings.TrimSpace(owner))
+		if owner == "" || id <= 0 {
+			continue
+		}
+		installations[owner] = id
+	}
+
+	auth := &githubAppAuth{
+		appID:         cfg.AppID,
+		key:           key,
+		now:           time.Now,
+		installations: installations,
+		tokens:        map[int64]githubInstallationToken{},
+	}
+	appClient, err := withGitHubBaseURL(github.NewClient(&http.Client{
+		Transport: &githubAppJWTTransport{auth: auth, base: base},
+	}), baseURL)
+	if err != nil {
+		return nil, err
+	}
+	auth.appClient = appClient
+	return auth, nil
+}
+
+func loadGitHubAppPrivateKey(cfg conf.GitHubAppConfig) (*rsa.PrivateKey, error) {
+	pemData := []byte(strings.TrimSpace(cfg.PrivateKey))
+	if len(pemData) == 0 && strings.TrimSpace(cfg.PrivateKeyPath) != "" {
+		data, err := os.ReadFile(strings.TrimSpace(cfg.PrivateKeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("read github app private key: %w", err)
+		}
+		pemData = data
+	}
+	if len(pemData) == 0 {
+		return nil, fmt.Errorf("github app private key is required")
+	}
+
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("github app private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse github app private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("github app private key is %T, want RSA", parsed)
+	}
+	return key, nil
+}
+
+// appJWT returns a short-lived RS256 token identifying the app itself.
+func (a *githubAppAuth) appJWT() (string, error) {
+	now := a.now()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]any{
+		// Backdate issuance to tolerate clock drift with GitHub.
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(githubAppJWTTTL).Unix(),
+		"iss": fmt.Sprintf("%d", a.appID),
+	})
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("sign github app jwt: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (a *githubAppAuth) installationID(ctx context.Context, target githubAPITarget) (int64, error) {
+	key := strings.ToLower(target.owner)
+	a.mu.Lock()
+	id, ok := a.installations[key]
+	a.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	var installation *github.Installation
+	var err error
+	switch {
+	case target.repo != "":
+		installation, _, err = a.appClient.Apps.FindRepositoryInstallation(ctx, target.owner, target.repo)
+	case target.org:
+		installation, _, err = a.appClient.Apps.FindOrganizationInstallation(ctx, target.owner)
+	default:
+		installation, _, err = a.appClient.Apps.FindUserInstallation(ctx, target.owner)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("find github app installation for %s: %w", target, err)
+	}
+	id = installation.GetID()
+	if id <= 0 {
+		return 0, fmt.Errorf("github app is not installed for %s", target)
+	}
+	a.mu.Lock()
+	a.installations[key] = id
+	a.mu.Unlock()
+	return id, nil
+}
+
+// installationToken returns a cached installation token for the owner of target, minting a new one
+// near expiry.
+func (a *githubAppAuth) installationToken(ctx context.Context, target githubAPITarget) (string, error) {
+	id, err := a.installationID(ctx, target)
+	if err != nil {
+		return "", err
+	}
+
+	a.mu.Lock()
+	cached, ok := a.tokens[id]
+	a.mu.Unlock()
+	if ok && a.now().Add(githubInstallationTokenSkew).Before(cached.expiresAt) {
+		return cached.token, nil
+	}
+
+	token, _, err := a.appClient.Apps.CreateInstallationToken(ctx, id, nil)
+	if err != nil {
+		return "", fmt.Errorf("create github app installation token for %s: %w", target.owner, err)
+	}
+	fresh := githubInstallationToken{
+		token:     token.GetToken(),
+		expiresAt: token.GetExpiresAt().Time,
+	}
+	if fresh.expiresAt.IsZero() {
+		fresh.expiresAt = a.now().Add(time.Hour)
+	}
+	a.mu.Lock()
+	a.tokens[id] = fresh
+	a.mu.Unlock()
+	githubClientLogger.Info("github app installation token refreshed",
+		"owner", target.owner,
+		"installation_id", id,
+		"expires_at", fresh.expiresAt,
+	)
+	return fresh.token, nil
+}
+
+// githubAppJWTTransport authenticates app-level API calls with a fresh JWT.
+type githubAppJWTTransport struct {
+	auth *githubAppAuth
+	base http.RoundTripper
+}
+
+func (t *githubAppJWTTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.auth.appJWT()
+	if err != nil {
+		return nil, err
+	}
+	out := req.Clone(req.Context())
+	out.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(out)
+}
+
+// githubAppTransport picks the installation token for the owner in each /repos/{owner}/{repo} request.
+// Requests outside a repository fall back to the personal token when one is configured.
+type githubAppTransport struct {
+	auth     *githubAppAuth
+	fallback oauth2.TokenSource
+	base     http.RoundTripper
+}
+
+func (t *githubAppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	target := targetFromAPIPath(req.URL.Path)
+	switch {
+	case target.owner != "":
+		token, err := t.auth.installationToken(req.Context(), target)
+		if err != nil {
+			if t.fallback == nil {
+				return nil, err
+			}
+			githubClientLogger.Warn("github app token unavailable, using personal token",
+				"owner", target.owner,
+				"repo", target.repo,
+				"error", err,
+			)
+			return t.withFallback(out)
+		}
+		out.Header.Set("Authorization", "token "+token)
+	case t.fallback != nil:
+		return t.withFallback(out)
+	}
+	return t.base.RoundTrip(out)
+}
+
+func (t *githubAppTransport) withFallback(req *http.Request) (*http.Response, error) {
+	token, err := t.fallback.Token()
+	if err != nil {
+		return nil, err
+	}
+	token.SetAuthHeader(req)
+	return t.base.RoundTrip(req)
+}
+
+// githubAPITarget is the account a REST call acts on, with its repo when the path names one.
+type githubAPITarget struct {
+	owner string
+	repo  string
+	// org tells organizations from users when there is no repo.
+	org bool
+}
+
+func (t githubAPITarget) String() string {
+	if t.repo != "" {
+		return t.owner + "/" + t.repo
+	}
+	return t.owner
+}
+
+// targetFromAPIPath extracts the target of repo paths, and of account paths such as
+// /orgs/{org}/repos or /users/{user}/repos that repo discovery lists.
+func targetFromAPIPath(path string) githubAPITarget {
+	if owner, repo := repoFromAPIPath(path); owner != "" {
+		return githubAPITarget{owner: owner, repo: repo}
+	}
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if (parts[i] == "orgs" || parts[i] == "users") && parts[i+1] != "" {
+			return githubAPITarget{owner: parts[i+1], org: parts[i] == "orgs"}
+		}
+	}
+	return githubAPITarget{}
+}
+
+// repoFromAPIPath extracts owner and repo from REST paths such as
+// /repos/{owner}/{repo}/issues or /api/v3/repos/{owner}/{repo}/pulls/1.
+func repoFromAPIPath(path string) (string, string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+2 < len(parts); i++ {
+		if parts[i] == "repos" && parts[i+1] != "" && parts[i+2] != "" {
+			return parts[i+1], parts[i+2]
+		}
+	}
+	return "", ""
+}
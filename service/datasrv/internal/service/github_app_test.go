@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+)
+
+func testGitHubAppKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	block := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return key, string(block)
+}
+
+func TestRepoFromAPIPath(t *testing.T) {
+	cases := map[string][2]string{
+		"/repos/acme/widgets/issues":         {"acme", "widgets"},
+		"/api/v3/repos/acme/widgets/pulls/1": {"acme", "widgets"},
+		"/repos/acme":                        {"", ""},
+		"/app/installations/1/access_tokens": {"", ""},
+		"/rate_limit":                        {"", ""},
+	}
+	for path, want := range cases {
+		owner, repo := repoFromAPIPath(path)
+		if owner != want[0] || repo != want[1] {
+			t.Fatalf("repoFromAPIPath(%q) = %q/%q, want %q/%q", path, owner, repo, want[0], want[1])
+		}
+	}
+}
+
+func TestTargetFromAPIPath(t *testing.T) {
+	cases := map[string]githubAPITarget{
+		"/repos/acme/widgets/issues": {owner: "acme", repo: "widgets"},
+		"/api/v3/orgs/acme/repos":    {owner: "acme", org: true},
+		"/users/bob/repos":           {owner: "bob"},
+		"/orgs/":                     {},
+		"/rate_limit":                {},
+	}
+	for path, want := range cases {
+		if got := targetFromAPIPath(path); got != want {
+			t.Fatalf("targetFromAPIPath(%q) = %+v, want %+v", path, got, want)
+		}
+	}
+}
+
+func TestGitHubAppJWTIsSignedWithAppKey(t *testing.T) {
+	key, pemKey := testGitHubAppKey(t)
+	auth, err := newGitHubAppAuth(conf.GitHubAppConfig{AppID: 1234, PrivateKey: pemKey}, "", http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("newGitHubAppAuth() error = %v", err)
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	auth.now = func() time.Time { return now }
+
+	token, err := auth.appJWT()
+	if err != nil {
+		t.Fatalf("appJWT() error = %v", err)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("jwt parts = %d, want 3", len(parts))
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("decode signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Fatalf("signature does not verify: %v", err)
+	}
+
+	rawClaims, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims struct {
+		Iat int64  `json:"iat"`
+		Exp int64  `json:"exp"`
+		Iss string `json:"iss"`
+	}
+	if err := json.Unmarshal(rawClaims, &claims); err != nil {
+		t.Fatalf("unmarshal claims: %v", err)
+	}
+	if claims.Iss != "1234" || claims.Iat != now.Add(-time.Minute).Unix() || claims.Exp != now.Add(githubAppJWTTTL).Unix() {
+		t.Fatalf("unexpected claims: %#v", claims)
+	}
+}
+
+func TestGitHubAppConfigRequiresValidKey(t *testing.T) {
+	if err := ValidateGitHubConfig(conf.GitHubConfig{Token: "pat"}); err != nil {
+		t.Fatalf("ValidateGitHubConfig() without app error = %v", err)
+	}
+	if err := ValidateGitHubConfig(conf.GitHubConfig{App: conf.GitHubAppConfig{AppID: 1, PrivateKey: "not a key"}}); err == nil {
+		t.Fatal("ValidateGitHubConfig() should reject a non-PEM private key")
+	}
+	if err := ValidateGitHubConfig(conf.GitHubConfig{App: conf.GitHubAppConfig{PrivateKey: "x"}}); err == nil {
+		t.Fatal("ValidateGitHubConfig() should require an app id")
+	}
+}
+
+func TestGitHubAppClientCachesInstallationTokensPerOwner(t *testing.T) {
+	_, pemKey := testGitHubAppKey(t)
+
+	var mu sync.Mutex
+	minted := map[string]int{}
+	repoAuth := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		path := strings.TrimPrefix(r.URL.Path, "/api/v3")
+		switch {
+		case path == "/repos/other/thing/installation":
+			if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+				t.Errorf("installation lookup auth = %q, want app jwt", r.Header.Get("Authorization"))
+			}
+			fmt.Fprint(w, `{"id": 7}`)
+		case strings.HasPrefix(path, "/app/installations/"):
+			if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+				t.Errorf("token mint auth = %q, want app jwt", r.Header.Get("Authorization"))
+			}
+			id := strings.Split(path, "/")[3]
+			minted[id]++
+			fmt.Fprintf(w, `{"token": "inst-%s", "expires_at": %q}`, id, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		case strings.HasPrefix(path, "/repos/"):
+			owner, _ := repoFromAPIPath(path)
+			repoAuth[owner] = r.Header.Get("Authorization")
+			fmt.Fprint(w, `[]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newGitHubClient(conf.GitHubConfig{
+		BaseURL: server.URL + "/",
+		App: conf.GitHubAppConfig{
+			AppID:         1,
+			PrivateKey:    pemKey,
+			Installations: map[string]int64{"Acme": 42},
+		},
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Issues.ListByRepo(context.Background(), "acme", "widgets", &github.IssueListByRepoOptions{}); err != nil {
+			t.Fatalf("ListByRepo(acme) error = %v", err)
+		}
+	}
+	if _, _, err := client.Issues.ListByRepo(context.Background(), "other", "thing", &github.IssueListByRepoOptions{}); err != nil {
+		t.Fatalf("ListByRepo(other) error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if minted["42"] != 1 || minted["7"] != 1 {
+		t.Fatalf("minted tokens = %#v, want one per installation", minted)
+	}
+	if repoAuth["acme"] != "token inst-42" || repoAuth["other"] != "token inst-7" {
+		t.Fatalf("repo auth headers = %#v", repoAuth)
+	}
+}
+
+func TestGitHubAppClientUsesInstallationTokensForAccountListings(t *testing.T) {
+	_, pemKey := testGitHubAppKey(t)
+
+	var mu sync.Mutex
+	listAuth := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		path := strings.TrimPrefix(r.URL.Path, "/api/v3")
+		switch {
+		case path == "/orgs/acme/installation":
+			fmt.Fprint(w, `{"id": 9}`)
+		case path == "/users/bob/installation":
+			fmt.Fprint(w, `{"id": 11}`)
+		case strings.HasPrefix(path, "/app/installations/"):
+			id := strings.Split(path, "/")[3]
+			fmt.Fprintf(w, `{"token": "inst-%s", "expires_at": %q}`, id, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		case path == "/orgs/acme/repos" || path == "/users/bob/repos":
+			listAuth[path] = r.Header.Get("Authorization")
+			fmt.Fprint(w, `[]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newGitHubClient(conf.GitHubConfig{
+		BaseURL: server.URL + "/",
+		App:     conf.GitHubAppConfig{AppID: 1, PrivateKey: pemKey},
+	})
+	if _, _, err := client.Repositories.ListByOrg(context.Background(), "acme", nil); err != nil {
+		t.Fatalf("ListByOrg(acme) error = %v", err)
+	}
+	if _, _, err := client.Repositories.ListByUser(context.Background(), "bob", nil); err != nil {
+		t.Fatalf("ListByUser(bob) error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if listAuth["/orgs/acme/repos"] != "token inst-9" || listAuth["/users/bob/repos"] != "token inst-11" {
+		t.Fatalf("listing auth headers = %#v", listAuth)
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"golang.org/x/oauth2"
+)
+
+var githubClientLogger = slog.Default().With("component", "datasrv.github_client")
+
+// ValidateGitHubConfig reports configuration errors that would otherwise only surface on the first request.
+func ValidateGitHubConfig(cfg conf.GitHubConfig) error {
+	if !githubAppConfigured(cfg.App) {
+		return nil
+	}
+	if _, err := newGitHubAppAuth(cfg.App, cfg.BaseURL, http.DefaultTransport); err != nil {
+		return fmt.Errorf("github app: %w", err)
+	}
+	return nil
+}
+
+func githubAppConfigured(cfg conf.GitHubAppConfig) bool {
+	return cfg.AppID > 0 || cfg.PrivateKey != "" || cfg.PrivateKeyPath != ""
+}
+
+// newGitHubClient builds an API client authenticated as the configured GitHub App,
+// falling back to the personal access token when no app is configured.
+func newGitHubClient(ghCfg conf.GitHubConfig) *github.Client {
+	var fallback oauth2.TokenSource
+	if ghCfg.Token != "" {
+		fallback = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: ghCfg.Token})
+	}
+
+	httpClient := http.DefaultClient
+	switch {
+	case githubAppConfigured(ghCfg.App):
+		auth, err := newGitHubAppAuth(ghCfg.App, ghCfg.BaseURL, http.DefaultTransport)
+		if err != nil {
+			githubClientLogger.Error("github app auth disabled", "app_id", ghCfg.App.AppID, "error", err)
+			if fallback != nil {
+				httpClient = oauth2.NewClient(context.Background(), fallback)
+			}
+			break
+		}
+		httpClient = &http.Client{Transport: &githubAppTransport{
+			auth:     auth,
+			fallback: fallback,
+			base:     http.DefaultTransport,
+		}}
+	case fallback != nil:
+		httpClient = oauth2.NewClient(context.Background(), fallback)
+	}
+
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	ghClient := github.NewClient(&http.Client{Transport: &githubConditionalTransport{base: base}})
+	if enterpriseClient, err := withGitHubBaseURL(ghClient, ghCfg.BaseURL); err == nil {
+		ghClient = enterpriseClient
+	}
+	return ghClient
+}
+
+func withGitHubBaseURL(client *github.Client, baseURL string) (*github.Client, error) {
+	if baseURL == "" {
+		return client, nil
+	}
+	enterpriseClient, err := client.WithEnterpriseURLs(baseURL, baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("github enterprise url: %w", err)
+	}
+	return enterpriseClient, nil
+}
+
+type githubETagKey struct{}
+
+// withGitHubETag makes requests sent with the returned context conditional on a stored validator.
+func withGitHubETag(ctx context.Context, etag dao.GitHubETag) context.Context {
+	if etag.ETag == "" && etag.LastModified == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, githubETagKey{}, etag)
+}
+
+type githubETagsDisabledKey struct{}
+
+// withoutGitHubETags makes lookups under the returned context skip stored validators, so a forced
+// resync refetches pages GitHub would otherwise answer with 304.
+func withoutGitHubETags(ctx context.Context) context.Context {
+	return context.WithValue(ctx, githubETagsDisabledKey{}, true)
+}
+
+func githubETagsDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(githubETagsDisabledKey{}).(bool)
+	return disabled
+}
+
+// githubConditionalTransport sends If-None-Match/If-Modified-Since for requests carrying a validator.
+// go-github has no per-request headers, so the validator travels in the request context.
+type githubConditionalTransport struct {
+	base http.RoundTripper
+}
+
+func (t *githubConditionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	etag, ok := req.Context().Value(githubETagKey{}).(dao.GitHubETag)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+	out := req.Clone(req.Context())
+	if etag.ETag != "" {
+		out.Header.Set("If-None-Match", etag.ETag)
+	}
+	if etag.LastModified != "" {
+		out.Header.Set("If-Modified-Since", etag.LastModified)
+	}
+	return t.base.RoundTrip(out)
+}
+
+// githubNotModified reports a 304 answer to a conditional request. go-github surfaces it as an error.
+func githubNotModified(resp *github.Response) bool {
+	return resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotModified
+}
+
+// githubETagFrom returns the validator GitHub sent with resp.
+func githubETagFrom(resp *github.Response) (string, string) {
+	if resp == nil || resp.Response == nil {
+		return "", ""
+	}
+	return resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+}
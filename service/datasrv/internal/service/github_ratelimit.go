@@ -0,0 +1,270 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+)
+
+const (
+	// githubMaxRateLimitWaits bounds how often one request waits out a rate limit before failing.
+	githubMaxRateLimitWaits = 10
+	// githubSecondaryRateLimitWait applies when a secondary rate limit response carries no Retry-After.
+	githubSecondaryRateLimitWait = time.Minute
+)
+
+// Rate limit credentials. GitHub App installation quotas are keyed by the owner they belong to.
+const (
+	githubRateLimitPAT                = "pat"
+	githubRateLimitInstallationPrefix = "installation:"
+)
+
+// GitHubRateLimitStatus is the GitHub quota of one credential as last seen by the sync workers.
+type GitHubRateLimitStatus struct {
+	// Credential is "pat" for the personal token, or "installation:{owner}" for the GitHub App
+	// installation of owner.
+	Credential string
+	Limit      int
+	Remaining  int
+	ResetAt    time.Time
+	// ObservedAt is when GitHub last reported the quota; zero before the first response.
+	ObservedAt time.Time
+	// PausedUntil is set while workers back off after a secondary rate limit.
+	PausedUntil time.Time
+}
+
+// githubRateLimiter keeps a token bucket per credential, shared by all sync workers. Each bucket is
+// refilled from the X-RateLimit-* headers of the responses to its credential and spent locally
+// before each request, so parallel workers stop at an exhausted quota instead of each discovering
+// it through a 403, and one exhausted installation does not hold back the others.
+type githubRateLimiter struct {
+	// app is set when requests authenticate as the GitHub App installation of their owner.
+	app bool
+
+	mu      sync.Mutex
+	buckets map[string]*GitHubRateLimitStatus
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newGitHubRateLimiter(app bool) *githubRateLimiter {
+	return &githubRateLimiter{
+		app:     app,
+		buckets: map[string]*GitHubRateLimitStatus{},
+		now:     time.Now,
+		sleep:   sleepContext,
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// credential returns the credential charged for requests about target, an owner/repo or an owner.
+func (l *githubRateLimiter) credential(target string) string {
+	if !l.app {
+		return githubRateLimitPAT
+	}
+	owner, _, _ := strings.Cut(target, "/")
+	return githubRateLimitInstallationPrefix + strings.ToLower(owner)
+}
+
+// bucket returns the state of credential; callers hold mu.
+func (l *githubRateLimiter) bucket(credential string) *GitHubRateLimitStatus {
+	b, ok := l.buckets[credential]
+	if !ok {
+		b = &GitHubRateLimitStatus{Credential: credential}
+		l.buckets[credential] = b
+	}
+	return b
+}
+
+// acquire blocks until a request may be sent under the known quota of credential.
+func (l *githubRateLimiter) acquire(ctx context.Context, credential string) error {
+	for {
+		wait := l.reserve(credential)
+		if wait <= 0 {
+			return nil
+		}
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait before trying again.
+func (l *githubRateLimiter) reserve(credential string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.bucket(credential)
+
+	now := l.now()
+	if now.Before(b.PausedUntil) {
+		return b.PausedUntil.Sub(now)
+	}
+	// Unknown quota or an elapsed window: send and let the response report the new window.
+	if b.Limit == 0 || !now.Before(b.ResetAt) {
+		return 0
+	}
+	if b.Remaining > 0 {
+		b.Remaining--
+		return 0
+	}
+	return b.ResetAt.Sub(now)
+}
+
+// observe records the quota reported with a response to credential.
+func (l *githubRateLimiter) observe(credential string, rate github.Rate) {
+	if rate.Limit <= 0 {
+		return
+	}
+	reset := rate.Reset.Time
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.bucket(credential)
+	switch {
+	case reset.Before(b.ResetAt):
+		// A slow response from a window that has already rolled over.
+		return
+	case reset.Equal(b.ResetAt) && b.Remaining < rate.Remaining:
+		// Responses within one window can arrive out of order; keep the lowest count,
+		// which also accounts for tokens taken by requests still in flight.
+	default:
+		b.Remaining = rate.Remaining
+	}
+	b.Limit = rate.Limit
+	b.ResetAt = reset
+	b.ObservedAt = l.now()
+}
+
+// backoff inspects a request error and, for primary and secondary rate limits, holds the workers
+// using credential until GitHub allows requests again. It reports the wait and whether err was a
+// rate limit.
+func (l *githubRateLimiter) backoff(credential string, err error) (time.Duration, bool) {
+	var rateErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	now := l.now()
+
+	var until time.Time
+	switch {
+	case errors.As(err, &rateErr):
+		l.observe(credential, rateErr.Rate)
+		until = rateErr.Rate.Reset.Time
+	case errors.As(err, &abuseErr):
+		wait := abuseErr.GetRetryAfter()
+		if wait <= 0 {
+			wait = githubSecondaryRateLimitWait
+		}
+		until = now.Add(wait)
+	default:
+		return 0, false
+	}
+	if !until.After(now) {
+		until = now.Add(time.Second)
+	}
+
+	l.mu.Lock()
+	if b := l.bucket(credential); until.After(b.PausedUntil) {
+		b.PausedUntil = until
+	}
+	l.mu.Unlock()
+	return until.Sub(now), true
+}
+
+// release returns a token for a request GitHub did not charge, such as a 304 answer.
+func (l *githubRateLimiter) release(credential string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b := l.bucket(credential); b.Limit > 0 && b.Remaining < b.Limit {
+		b.Remaining++
+	}
+}
+
+// snapshot returns the buckets that have seen a quota or a pause, by credential.
+func (l *githubRateLimiter) snapshot() []GitHubRateLimitStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	out := make([]GitHubRateLimitStatus, 0, len(l.buckets))
+	for _, b := range l.buckets {
+		if b.Limit > 0 || !b.PausedUntil.IsZero() {
+			out = append(out, *b)
+		}
+	}
+	slices.SortFunc(out, func(a, b GitHubRateLimitStatus) int { return strings.Compare(a.Credential, b.Credential) })
+	return out
+}
+
+// lowestGitHubRateLimit returns the most constrained of statuses: the fewest remaining requests,
+// then the longest pause. ok is false when statuses is empty.
+func lowestGitHubRateLimit(statuses []GitHubRateLimitStatus) (GitHubRateLimitStatus, bool) {
+	if len(statuses) == 0 {
+		return GitHubRateLimitStatus{}, false
+	}
+	return slices.MinFunc(statuses, func(a, b GitHubRateLimitStatus) int {
+		if c := cmp.Compare(a.Remaining, b.Remaining); c != 0 {
+			return c
+		}
+		return b.PausedUntil.Compare(a.PausedUntil)
+	}), true
+}
+
+// withGitHubRateLimit runs call under the quota of the credential used for repo, an owner/repo or
+// an owner. Rate limit responses are slept through and retried without counting against the
+// caller's own retry budget.
+func (s *IssueSyncService) withGitHubRateLimit(ctx context.Context, repo string, call func() (*github.Response, error)) error {
+	credential := s.rateLimiter.credential(repo)
+	for waits := 0; ; waits++ {
+		if err := s.rateLimiter.acquire(ctx, credential); err != nil {
+			return err
+		}
+		resp, err := call()
+		if resp != nil {
+			s.rateLimiter.observe(credential, resp.Rate)
+		}
+		if githubNotModified(resp) {
+			s.rateLimiter.release(credential)
+		}
+		wait, limited := s.rateLimiter.backoff(credential, err)
+		if !limited || waits >= githubMaxRateLimitWaits {
+			return err
+		}
+		issueSyncLogger.Warn("issue sync github rate limited",
+			"repo", repo,
+			"credential", credential,
+			"wait_ms", wait.Milliseconds(),
+			"error", err,
+		)
+	}
+}
+
+// githubTransientError reports whether err came from the transport or the rate limit rather than
+// from the one resource asked for, and so would fail the requests after it as well.
+func githubTransientError(err error) bool {
+	var rateErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &rateErr) || errors.As(err, &abuseErr) {
+		return true
+	}
+	var apiErr *github.ErrorResponse
+	if errors.As(err, &apiErr) {
+		return apiErr.Response == nil || apiErr.Response.StatusCode >= http.StatusInternalServerError
+	}
+	// Without an API answer: timeouts, cancellations and connection failures.
+	return true
+}
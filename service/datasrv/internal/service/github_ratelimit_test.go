@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+)
+
+// fakeRateLimitClock replaces the limiter clock and records sleeps instead of blocking.
+type fakeRateLimitClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeRateLimitClock) install(l *githubRateLimiter) {
+	l.now = func() time.Time { return c.now }
+	l.sleep = func(_ context.Context, d time.Duration) error {
+		c.sleeps = append(c.sleeps, d)
+		c.now = c.now.Add(d)
+		return nil
+	}
+}
+
+func githubErrorResponse() *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusForbidden,
+		Request:    httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/owner/repo/issues", nil),
+	}
+}
+
+func TestGitHubRateLimiterWaitsForResetWhenQuotaIsSpent(t *testing.T) {
+	clock := &fakeRateLimitClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := newGitHubRateLimiter(false)
+	clock.install(limiter)
+	pat := limiter.credential("owner/repo")
+
+	if err := limiter.acquire(context.Background(), pat); err != nil {
+		t.Fatalf("acquire() with unknown quota error = %v", err)
+	}
+	reset := clock.now.Add(10 * time.Minute)
+	limiter.observe(pat, github.Rate{Limit: 5000, Remaining: 2, Reset: github.Timestamp{Time: reset}})
+	// A late response from the same window must not hand back tokens.
+	limiter.observe(pat, github.Rate{Limit: 5000, Remaining: 3, Reset: github.Timestamp{Time: reset}})
+
+	for i := 0; i < 3; i++ {
+		if err := limiter.acquire(context.Background(), pat); err != nil {
+			t.Fatalf("acquire() #%d error = %v", i, err)
+		}
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != 10*time.Minute {
+		t.Fatalf("sleeps = %v, want one wait until reset", clock.sleeps)
+	}
+	got := limiter.snapshot()
+	if len(got) != 1 || got[0].Credential != "pat" || got[0].Remaining != 0 || !got[0].ResetAt.Equal(reset) {
+		t.Fatalf("snapshot = %#v, want the spent pat quota until %v", got, reset)
+	}
+}
+
+func TestGitHubRateLimiterKeepsInstallationsApart(t *testing.T) {
+	clock := &fakeRateLimitClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := newGitHubRateLimiter(true)
+	clock.install(limiter)
+	acme, other := limiter.credential("Acme/api"), limiter.credential("other")
+	if acme != "installation:acme" || other != "installation:other" {
+		t.Fatalf("credentials = %q, %q", acme, other)
+	}
+
+	// acme is nearly spent in a window that resets before the one other reported.
+	limiter.observe(other, github.Rate{Limit: 5000, Remaining: 4000, Reset: github.Timestamp{Time: clock.now.Add(50 * time.Minute)}})
+	limiter.observe(acme, github.Rate{Limit: 5000, Remaining: 1, Reset: github.Timestamp{Time: clock.now.Add(5 * time.Minute)}})
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.acquire(context.Background(), acme); err != nil {
+			t.Fatalf("acquire(acme) #%d error = %v", i, err)
+		}
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != 5*time.Minute {
+		t.Fatalf("sleeps = %v, want acme to wait for its own reset", clock.sleeps)
+	}
+
+	limiter.backoff(acme, &github.AbuseRateLimitError{Response: githubErrorResponse(), RetryAfter: github.Ptr(time.Minute)})
+	if err := limiter.acquire(context.Background(), other); err != nil || len(clock.sleeps) != 1 {
+		t.Fatalf("acquire(other) = %v after %v, want no wait behind acme's pause", err, clock.sleeps)
+	}
+
+	lowest, ok := lowestGitHubRateLimit(limiter.snapshot())
+	if !ok || lowest.Credential != acme {
+		t.Fatalf("lowest = %#v, want %s", lowest, acme)
+	}
+}
+
+func TestRunSyncWaitsOutGitHubRateLimits(t *testing.T) {
+	store := newFakeSyncStore()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reset := start.Add(45*time.Second + 30*time.Minute)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{err: &github.AbuseRateLimitError{
+				Response:   githubErrorResponse(),
+				Message:    "You have exceeded a secondary rate limit.",
+				RetryAfter: github.Ptr(45 * time.Second),
+			}},
+			{err: &github.RateLimitError{
+				Rate:     github.Rate{Limit: 5000, Remaining: 0, Reset: github.Timestamp{Time: reset}},
+				Response: githubErrorResponse(),
+				Message:  "API rate limit exceeded",
+			}},
+			{issues: []*github.Issue{ghIssue(3, 3, start)}, nextPage: 0},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+	clock := &fakeRateLimitClock{now: start}
+	clock.install(svc.rateLimiter)
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if summary.Results[0].Err != "" || summary.Results[0].Persisted != 1 {
+		t.Fatalf("result = %#v, want one persisted issue", summary.Results[0])
+	}
+	if len(client.calls) != 3 {
+		t.Fatalf("calls = %d, want 3", len(client.calls))
+	}
+	want := []time.Duration{45 * time.Second, 30 * time.Minute}
+	if len(clock.sleeps) != len(want) || clock.sleeps[0] != want[0] || clock.sleeps[1] != want[1] {
+		t.Fatalf("sleeps = %v, want %v", clock.sleeps, want)
+	}
+	if got := svc.RateLimit(); got.Limit != 5000 || !got.PausedUntil.Equal(reset) {
+		t.Fatalf("RateLimit() = %#v, want paused until %v", got, reset)
+	}
+}
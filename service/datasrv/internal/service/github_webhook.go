@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var githubWebhookLogger = slog.Default().With("component", "datasrv.github_webhook")
+
+const githubSignatureHeader = "X-Hub-Signature-256"
+
+var (
+	// ErrWebhookSignatureInvalid is returned when X-Hub-Signature-256 does not match the payload.
+	ErrWebhookSignatureInvalid = errors.New("invalid webhook signature")
+	// ErrWebhookPayloadInvalid is returned when a delivery cannot be decoded for its event type.
+	ErrWebhookPayloadInvalid = errors.New("invalid webhook payload")
+	// ErrWebhookPayloadTooLarge is returned when a body goes past MaxWebhookPayloadBytes.
+	ErrWebhookPayloadTooLarge = errors.New("webhook payload too large")
+)
+
+// MaxWebhookPayloadBytes is the largest body read from a delivery, the 25 MB GitHub caps payloads at.
+const MaxWebhookPayloadBytes = 25 << 20
+
+// WebhookDeliveryResult describes how one webhook delivery was handled.
+type WebhookDeliveryResult struct {
+	DeliveryID string
+	Event      string
+	Action     string
+	Repo       string
+	Number     int32
+	Duplicate  bool
+	Ignored    bool
+	Reason     string
+	Persisted  int
+}
+
+// GitHubWebhookService applies GitHub issues, issue_comment and pull_request deliveries to the sync store.
+// Scheduled polling in IssueSyncService remains the reconciliation fallback for missed deliveries.
+type GitHubWebhookService struct {
+	store        dao.SyncStore
+	deliveries   dao.WebhookDeliveryStore
+	commentStore IssueCommentStore
+	cfg          conf.GitHubWebhookConfig
+	// runs is nil when the store keeps no run history; applied deliveries are then only logged.
+	runs dao.SyncRunStore
+}
+
+func NewGitHubWebhookService(store dao.SyncStore, deliveries dao.WebhookDeliveryStore, commentStore IssueCommentStore, cfg conf.GitHubWebhookConfig) *GitHubWebhookService {
+	svc := &GitHubWebhookService{
+		store:        store,
+		deliveries:   deliveries,
+		commentStore: commentStore,
+		cfg:          cfg,
+	}
+	if runs, ok := store.(dao.SyncRunStore); ok {
+		svc.runs = runs
+	}
+	return svc
+}
+
+func (s *GitHubWebhookService) Enabled() bool {
+	return s != nil && s.cfg.Enabled && strings.TrimSpace(s.cfg.Secret) != ""
+}
+
+// VerifyRequest checks the request signature against the configured secret and returns the raw payload.
+// Bodies that cannot be read or decoded fail with ErrWebhookPayloadInvalid rather than as bad signatures,
+// and bodies a http.MaxBytesReader cut off with ErrWebhookPayloadTooLarge.
+func (s *GitHubWebhookService) VerifyRequest(r *http.Request) ([]byte, error) {
+	signature := strings.TrimSpace(r.Header.Get(githubSignatureHeader))
+	if signature == "" {
+		return nil, fmt.Errorf("%w: missing %s header", ErrWebhookSignatureInvalid, githubSignatureHeader)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, fmt.Errorf("%w: over %d bytes", ErrWebhookPayloadTooLarge, tooLarge.Limit)
+		}
+		return nil, fmt.Errorf("%w: read body: %v", ErrWebhookPayloadInvalid, err)
+	}
+	// GitHub signs the body as sent, whatever its content type.
+	if err := github.ValidateSignature(signature, body, []byte(s.cfg.Secret)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWebhookSignatureInvalid, err)
+	}
+	return webhookPayload(r.Header.Get("Content-Type"), body)
+}
+
+// webhookPayload returns the JSON payload of a body sent as application/json or, when the webhook
+// is configured for it, as application/x-www-form-urlencoded.
+func webhookPayload(contentType string, body []byte) ([]byte, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: content type %q: %v", ErrWebhookPayloadInvalid, contentType, err)
+	}
+	switch mediaType {
+	case "application/json":
+		return body, nil
+	case "application/x-www-form-urlencoded":
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("%w: parse form: %v", ErrWebhookPayloadInvalid, err)
+		}
+		payload := form.Get("payload")
+		if payload == "" {
+			return nil, fmt.Errorf("%w: form without payload", ErrWebhookPayloadInvalid)
+		}
+		return []byte(payload), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported content type %q", ErrWebhookPayloadInvalid, mediaType)
+	}
+}
+
+// HandleDelivery applies one verified delivery. Deliveries already recorded are skipped.
+func (s *GitHubWebhookService) HandleDelivery(ctx context.Context, event, deliveryID string, payload []byte) (WebhookDeliveryResult, error) {
+	result := WebhookDeliveryResult{DeliveryID: deliveryID, Event: event}
+	if deliveryID != "" && s.deliveries != nil {
+		seen, err := s.deliveries.HasWebhookDelivery(ctx, deliveryID)
+		if err != nil {
+			return result, err
+		}
+		if seen {
+			result.Duplicate = true
+			githubWebhookLogger.Info("github webhook delivery already processed",
+				"delivery_id", deliveryID,
+				"event", event,
+			)
+			return result, nil
+		}
+	}
+
+	parsed, err := github.ParseWebHook(event, payload)
+	if err != nil {
+		switch event {
+		case "issues", "issue_comment", "pull_request":
+			return result, fmt.Errorf("%w: %v", ErrWebhookPayloadInvalid, err)
+		}
+		result.Ignored = true
+		result.Reason = "unsupported event"
+		githubWebhookLogger.Info("github webhook event ignored",
+			"delivery_id", deliveryID,
+			"event", event,
+			"error", err,
+		)
+		return result, nil
+	}
+
+	startedAt := time.Now()
+	switch e := parsed.(type) {
+	case *github.PingEvent:
+		result.Ignored = true
+		result.Reason = "ping"
+	case *github.IssuesEvent:
+		err = s.handleIssuesEvent(ctx, e, &result)
+	case *github.IssueCommentEvent:
+		err = s.handleIssueCommentEvent(ctx, e, &result)
+	case *github.PullRequestEvent:
+		err = s.handlePullRequestEvent(ctx, e, &result)
+	default:
+		result.Ignored = true
+		result.Reason = "unsupported event"
+	}
+	if !result.Ignored {
+		s.recordDelivery(ctx, result, startedAt, err)
+	}
+	if err != nil {
+		githubWebhookLogger.Error("github webhook delivery failed",
+			"delivery_id", deliveryID,
+			"event", event,
+			"action", result.Action,
+			"repo", result.Repo,
+			"number", result.Number,
+			"error", err,
+		)
+		return result, err
+	}
+
+	if deliveryID != "" && s.deliveries != nil {
+		if err := s.deliveries.SaveWebhookDelivery(ctx, dao.WebhookDelivery{
+			DeliveryID: deliveryID,
+			Event:      event,
+			Action:     result.Action,
+			Repo:       result.Repo,
+			ReceivedAt: time.Now().UTC(),
+		}); err != nil {
+			return result, err
+		}
+	}
+	githubWebhookLogger.Info("github webhook delivery processed",
+		"delivery_id", deliveryID,
+		"event", event,
+		"action", result.Action,
+		"repo", result.Repo,
+		"number", result.Number,
+		"ignored", result.Ignored,
+		"reason", result.Reason,
+		"persisted", result.Persisted,
+	)
+	return result, nil
+}
+
+// recordDelivery adds an applied delivery to the run history as a one-repo issue sync.
+func (s *GitHubWebhookService) recordDelivery(ctx context.Context, result WebhookDeliveryResult, startedAt time.Time, err error) {
+	target := dao.SyncRunTarget{
+		Target:    result.Repo,
+		Fetched:   1,
+		Persisted: int32(result.Persisted),
+	}
+	if err != nil {
+		target.Failed = 1
+		target.Err = err.Error()
+	}
+	recordSyncRun(ctx, s.runs, dao.SyncRun{
+		JobType:   dao.SyncRunJobIssueSync,
+		Trigger:   dao.SyncRunTriggerWebhook,
+		StartedAt: startedAt,
+		Targets:   []dao.SyncRunTarget{target},
+	})
+}
+
+func (s *GitHubWebhookService) handleIssuesEvent(ctx context.Context, e *github.IssuesEvent, result *WebhookDeliveryResult) error {
+	result.Action = e.GetAction()
+	result.Repo = e.GetRepo().GetFullName()
+	if e.Issue == nil {
+		return fmt.Errorf("%w: issues event without issue", ErrWebhookPayloadInvalid)
+	}
+	result.Number = int32(e.Issue.GetNumber())
+	if ok, err := s.isManagedRepo(ctx, result.Repo); err != nil || !ok {
+		return s.ignoreUnmanaged(result, err)
+	}
+	switch result.Action {
+	case "deleted", "transferred":
+		// Removal is left to IssueSyncService.RunReconcile, which tombstones the row.
+		result.Ignored = true
+		result.Reason = "action handled by polling"
+		return nil
+	}
+
+	return s.upsertIssue(ctx, toSyncedIssue(result.Repo, e.Issue), result)
+}
+
+func (s *GitHubWebhookService) handleIssueCommentEvent(ctx context.Context, e *github.IssueCommentEvent, result *WebhookDeliveryResult) error {
+	result.Action = e.GetAction()
+	result.Repo = e.GetRepo().GetFullName()
+	if e.Issue == nil || e.Comment == nil {
+		return fmt.Errorf("%w: issue_comment event without issue or comment", ErrWebhookPayloadInvalid)
+	}
+	result.Number = int32(e.Issue.GetNumber())
+	if ok, err := s.isManagedRepo(ctx, result.Repo); err != nil || !ok {
+		return s.ignoreUnmanaged(result, err)
+	}
+
+	record := toSyncedIssue(result.Repo, e.Issue)
+	if err := s.upsertIssue(ctx, record, result); err != nil {
+		return err
+	}
+	if s.commentStore == nil || result.Ignored {
+		return nil
+	}
+
+	existing, err := s.commentStore.LoadComments(ctx, result.Repo, record.IssueID, record.Number)
+	if err != nil {
+		// Without the current comment set a partial write would drop history.
+		// The issue's updated_at moved forward, so the next poll refetches all comments.
+		githubWebhookLogger.Warn("github webhook comment merge skipped",
+			"repo", result.Repo,
+			"issue_number", record.Number,
+			"issue_id", record.IssueID,
+			"error", err,
+		)
+		return nil
+	}
+	merged := mergeIssueComment(existing, toIssueComment(e.Comment), result.Action == "deleted")
+	if err := s.commentStore.SaveComments(ctx, result.Repo, record.IssueID, record.Number, merged); err != nil {
+		return fmt.Errorf("save issue comments for %s#%d: %w", result.Repo, record.Number, err)
+	}
+	return nil
+}
+
+func (s *GitHubWebhookService) handlePullRequestEvent(ctx context.Context, e *github.PullRequestEvent, result *WebhookDeliveryResult) error {
+	result.Action = e.GetAction()
+	result.Repo = e.GetRepo().GetFullName()
+	pr := e.GetPullRequest()
+	if pr == nil {
+		return fmt.Errorf("%w: pull_request event without pull request", ErrWebhookPayloadInvalid)
+	}
+	result.Number = int32(pr.GetNumber())
+	if ok, err := s.isManagedRepo(ctx, result.Repo); err != nil || !ok {
+		return s.ignoreUnmanaged(result, err)
+	}
+
+	// Pull request payloads carry the PR id, not the issue id used as the row key,
+	// so only rows already created by the issues API are updated here.
+	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{Repo: result.Repo, Number: result.Number, Limit: 1})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		result.Ignored = true
+		result.Reason = "pull request not synced yet"
+		return nil
+	}
+	return s.upsertIssue(ctx, applyPullRequest(rows[0], pr), result)
+}
+
+func (s *GitHubWebhookService) upsertIssue(ctx context.Context, record dao.SyncedIssue, result *WebhookDeliveryResult) error {
+	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{Repo: record.Repo, IssueID: record.IssueID, Limit: 1, IncludeTombstoned: true})
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 && rows[0].UpdatedAt.After(record.UpdatedAt) {
+		// Deliveries can arrive out of order; never overwrite a newer row.
+		result.Ignored = true
+		result.Reason = "stale delivery"
+		return nil
+	}
+	persisted, err := s.store.UpsertIssues(ctx, record.Repo, []dao.SyncedIssue{record})
+	if err != nil {
+		return err
+	}
+	result.Persisted += persisted
+	return nil
+}
+
+func (s *GitHubWebhookService) isManagedRepo(ctx context.Context, repo string) (bool, error) {
+	if repo == "" {
+		return false, nil
+	}
+	managed, err := s.store.ListManagedRepos(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list managed repos: %w", err)
+	}
+	for _, item := range managed {
+		if strings.EqualFold(item.Repo, repo) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *GitHubWebhookService) ignoreUnmanaged(result *WebhookDeliveryResult, err error) error {
+	if err != nil {
+		return err
+	}
+	result.Ignored = true
+	result.Reason = "repo is not managed"
+	return nil
+}
+
+func applyPullRequest(row dao.SyncedIssue, pr *github.PullRequest) dao.SyncedIssue {
+	assignees := make([]string, 0, len(pr.Assignees))
+	for _, a := range pr.Assignees {
+		assignees = append(assignees, a.GetLogin())
+	}
+	labels := make([]string, 0, len(pr.Labels))
+	for _, l := range pr.Labels {
+		labels = append(labels, l.GetName())
+	}
+
+	row.Title = pr.GetTitle()
+	row.Body = pr.GetBody()
+	row.State = pr.GetState()
+	row.Author = pr.GetUser().GetLogin()
+	row.Assignees = assignees
+	row.Labels = labels
+	row.Comments = int32(pr.GetComments())
+	row.IsPullRequest = true
+	if pr.HTMLURL != nil {
+		row.HTMLURL = pr.GetHTMLURL()
+	}
+	if pr.UpdatedAt != nil {
+		row.UpdatedAt = pr.GetUpdatedAt().Time
+	}
+	row.ClosedAt = nil
+	if pr.ClosedAt != nil {
+		v := pr.ClosedAt.Time
+		row.ClosedAt = &v
+	}
+	return row
+}
+
+func mergeIssueComment(existing []dao.IssueComment, comment dao.IssueComment, deleted bool) []dao.IssueComment {
+	out := make([]dao.IssueComment, 0, len(existing)+1)
+	for _, item := range existing {
+		if item.ID == comment.ID {
+			continue
+		}
+		out = append(out, item)
+	}
+	if !deleted {
+		out = append(out, comment)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].CreatedAt.Before(out[j].CreatedAt)
+	})
+	return out
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+type fakeWebhookDeliveryStore struct {
+	saved map[string]dao.WebhookDelivery
+}
+
+func newFakeWebhookDeliveryStore() *fakeWebhookDeliveryStore {
+	return &fakeWebhookDeliveryStore{saved: map[string]dao.WebhookDelivery{}}
+}
+
+func (f *fakeWebhookDeliveryStore) HasWebhookDelivery(_ context.Context, deliveryID string) (bool, error) {
+	_, ok := f.saved[deliveryID]
+	return ok, nil
+}
+
+func (f *fakeWebhookDeliveryStore) SaveWebhookDelivery(_ context.Context, delivery dao.WebhookDelivery) error {
+	f.saved[delivery.DeliveryID] = delivery
+	return nil
+}
+
+func newTestWebhookService(t *testing.T) (*GitHubWebhookService, *fakeSyncStore, *fakeIssueCommentStore, *fakeWebhookDeliveryStore) {
+	t.Helper()
+	store := newFakeSyncStore()
+	if _, err := store.ReplaceManagedRepos(context.Background(), []string{"owner/repo"}); err != nil {
+		t.Fatalf("ReplaceManagedRepos() error = %v", err)
+	}
+	comments := newFakeIssueCommentStore()
+	deliveries := newFakeWebhookDeliveryStore()
+	svc := NewGitHubWebhookService(store, deliveries, comments, conf.GitHubWebhookConfig{Enabled: true, Secret: "s3cret"})
+	return svc, store, comments, deliveries
+}
+
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+const webhookIssuePayload = `{
+  "action": "edited",
+  "issue": {
+    "id": 11,
+    "number": 5,
+    "title": "from webhook",
+    "state": "open",
+    "comments": 1,
+    "user": {"login": "alice"},
+    "labels": [{"name": "bug"}],
+    "created_at": "2026-01-01T00:00:00Z",
+    "updated_at": "2026-01-02T00:00:00Z"
+  },
+  "repository": {"full_name": "owner/repo"}
+}`
+
+func TestGitHubWebhookVerifyRequest(t *testing.T) {
+	svc, _, _, _ := newTestWebhookService(t)
+	payload := []byte(webhookIssuePayload)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hub-Signature-256", signWebhookPayload("s3cret", payload))
+	got, err := svc.VerifyRequest(req)
+	if err != nil {
+		t.Fatalf("VerifyRequest() error = %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("payload mismatch")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hub-Signature-256", signWebhookPayload("other", payload))
+	if _, err := svc.VerifyRequest(req); !errors.Is(err, ErrWebhookSignatureInvalid) {
+		t.Fatalf("VerifyRequest() error = %v, want ErrWebhookSignatureInvalid", err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	if _, err := svc.VerifyRequest(req); !errors.Is(err, ErrWebhookSignatureInvalid) {
+		t.Fatalf("VerifyRequest() without signature error = %v, want ErrWebhookSignatureInvalid", err)
+	}
+
+	form := []byte("payload=" + url.QueryEscape(webhookIssuePayload))
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(form))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Hub-Signature-256", signWebhookPayload("s3cret", form))
+	if got, err := svc.VerifyRequest(req); err != nil || !bytes.Equal(got, payload) {
+		t.Fatalf("VerifyRequest(form) = %q, %v, want the form payload", got, err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("X-Hub-Signature-256", signWebhookPayload("s3cret", payload))
+	if _, err := svc.VerifyRequest(req); !errors.Is(err, ErrWebhookPayloadInvalid) {
+		t.Fatalf("VerifyRequest(text/plain) error = %v, want ErrWebhookPayloadInvalid", err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/github", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Hub-Signature-256", signWebhookPayload("s3cret", payload))
+	req.Body = http.MaxBytesReader(httptest.NewRecorder(), req.Body, int64(len(payload)-1))
+	if _, err := svc.VerifyRequest(req); !errors.Is(err, ErrWebhookPayloadTooLarge) {
+		t.Fatalf("VerifyRequest(over limit) error = %v, want ErrWebhookPayloadTooLarge", err)
+	}
+}
+
+func TestGitHubWebhookIssuesEventUpsertsIssueOnce(t *testing.T) {
+	svc, store, _, deliveries := newTestWebhookService(t)
+
+	result, err := svc.HandleDelivery(context.Background(), "issues", "delivery-1", []byte(webhookIssuePayload))
+	if err != nil {
+		t.Fatalf("HandleDelivery() error = %v", err)
+	}
+	if result.Persisted != 1 || result.Ignored || result.Duplicate {
+		t.Fatalf("unexpected result: %#v", result)
+	}
+	rows, _ := store.ListIssues(context.Background(), dao.SyncIssueFilter{Repo: "owner/repo", Number: 5})
+	if len(rows) != 1 || rows[0].Title != "from webhook" || rows[0].IssueID != 11 {
+		t.Fatalf("unexpected rows: %#v", rows)
+	}
+	if _, ok := deliveries.saved["delivery-1"]; !ok {
+		t.Fatalf("delivery was not recorded")
+	}
+
+	result, err = svc.HandleDelivery(context.Background(), "issues", "delivery-1", []byte(webhookIssuePayload))
+	if err != nil {
+		t.Fatalf("HandleDelivery() redelivery error = %v", err)
+	}
+	if !result.Duplicate || result.Persisted != 0 {
+		t.Fatalf("redelivery result = %#v, want duplicate", result)
+	}
+}
+
+func TestGitHubWebhookSkipsStaleAndUnmanagedDeliveries(t *testing.T) {
+	svc, store, _, _ := newTestWebhookService(t)
+	newer := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	_, _ = store.UpsertIssues(context.Background(), "owner/repo", []dao.SyncedIssue{{
+		Repo: "owner/repo", IssueID: 11, Number: 5, Title: "newer", UpdatedAt: newer,
+	}})
+
+	result, err := svc.HandleDelivery(context.Background(), "issues", "delivery-2", []byte(webhookIssuePayload))
+	if err != nil {
+		t.Fatalf("HandleDelivery() error = %v", err)
+	}
+	if !result.Ignored || result.Reason != "stale delivery" {
+		t.Fatalf("result = %#v, want stale delivery", result)
+	}
+	rows, _ := store.ListIssues(context.Background(), dao.SyncIssueFilter{Repo: "owner/repo", Number: 5})
+	if rows[0].Title != "newer" {
+		t.Fatalf("title = %q, want newer", rows[0].Title)
+	}
+
+	unmanaged := bytes.ReplaceAll([]byte(webhookIssuePayload), []byte("owner/repo"), []byte("other/repo"))
+	result, err = svc.HandleDelivery(context.Background(), "issues", "delivery-3", unmanaged)
+	if err != nil {
+		t.Fatalf("HandleDelivery() unmanaged error = %v", err)
+	}
+	if !result.Ignored || result.Reason != "repo is not managed" {
+		t.Fatalf("result = %#v, want unmanaged", result)
+	}
+}
+
+func TestGitHubWebhookIssueCommentEventMergesComments(t *testing.T) {
+	svc, _, comments, _ := newTestWebhookService(t)
+	comments.saved["owner/repo/11-5.json"] = []dao.IssueComment{
+		{ID: 1, Body: "first", CreatedAt: time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)},
+		{ID: 2, Body: "second", CreatedAt: time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)},
+	}
+
+	payload := `{
+  "action": "edited",
+  "issue": {"id": 11, "number": 5, "title": "t", "state": "open", "comments": 2,
+    "created_at": "2026-01-01T00:00:00Z", "updated_at": "2026-01-02T00:00:00Z"},
+  "comment": {"id": 2, "body": "second (edited)", "user": {"login": "bob"},
+    "created_at": "2026-01-01T02:00:00Z", "updated_at": "2026-01-02T00:00:00Z"},
+  "repository": {"full_name": "owner/repo"}
+}`
+	if _, err := svc.HandleDelivery(context.Background(), "issue_comment", "delivery-4", []byte(payload)); err != nil {
+		t.Fatalf("HandleDelivery() error = %v", err)
+	}
+	got := comments.saved["owner/repo/11-5.json"]
+	if len(got) != 2 || got[1].Body != "second (edited)" || got[1].UserLogin != "bob" {
+		t.Fatalf("merged comments = %#v", got)
+	}
+
+	deleted := bytes.Replace([]byte(payload), []byte(`"action": "edited"`), []byte(`"action": "deleted"`), 1)
+	if _, err := svc.HandleDelivery(context.Background(), "issue_comment", "delivery-5", deleted); err != nil {
+		t.Fatalf("HandleDelivery() delete error = %v", err)
+	}
+	got = comments.saved["owner/repo/11-5.json"]
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("comments after delete = %#v", got)
+	}
+}
+
+func TestGitHubWebhookIgnoresUnsupportedEvents(t *testing.T) {
+	svc, _, _, _ := newTestWebhookService(t)
+	result, err := svc.HandleDelivery(context.Background(), "ping", "delivery-6", []byte(`{"zen":"Keep it logically awesome."}`))
+	if err != nil {
+		t.Fatalf("HandleDelivery() error = %v", err)
+	}
+	if !result.Ignored {
+		t.Fatalf("ping should be ignored: %#v", result)
+	}
+
+	if _, err := svc.HandleDelivery(context.Background(), "issues", "delivery-7", []byte(`{`)); !errors.Is(err, ErrWebhookPayloadInvalid) {
+		t.Fatalf("HandleDelivery() malformed error = %v, want ErrWebhookPayloadInvalid", err)
+	}
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+const (
+	defaultGitLabBaseURL   = "https://gitlab.com"
+	gitlabNotesPerPage     = 100
+	gitlabIssueStateOpened = "opened"
+	gitlabIssueStateClosed = "closed"
+)
+
+// GitLabIssueProvider reads issues and their notes through the GitLab REST API v4.
+type GitLabIssueProvider struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func NewGitLabIssueProvider(cfg conf.GitLabConfig) *GitLabIssueProvider {
+	baseURL := strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if baseURL == "" {
+		baseURL = defaultGitLabBaseURL
+	}
+	return &GitLabIssueProvider{
+		baseURL: baseURL,
+		token:   cfg.Token,
+		client:  http.DefaultClient,
+	}
+}
+
+type gitlabUser struct {
+	Username  string `json:"username"`
+	WebURL    string `json:"web_url"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type gitlabIssue struct {
+	ID          int64        `json:"id"`
+	IID         int32        `json:"iid"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	State       string       `json:"state"`
+	Author      gitlabUser   `json:"author"`
+	Assignees   []gitlabUser `json:"assignees"`
+	Labels      []string     `json:"labels"`
+	Milestone   *struct {
+		IID int32 `json:"iid"`
+	} `json:"milestone"`
+	UserNotesCount int32      `json:"user_notes_count"`
+	WebURL         string     `json:"web_url"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	ClosedAt       *time.Time `json:"closed_at"`
+}
+
+type gitlabNote struct {
+	ID        int64      `json:"id"`
+	Body      string     `json:"body"`
+	Author    gitlabUser `json:"author"`
+	System    bool       `json:"system"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ListIssues lists the issues of a project by last update, oldest first. Merge requests are a
+// separate API on GitLab and never show up here.
+func (p *GitLabIssueProvider) ListIssues(ctx context.Context, project string, opts IssueListOptions) (IssueListPage, error) {
+	query := url.Values{}
+	query.Set("scope", "all")
+	query.Set("order_by", "updated_at")
+	query.Set("sort", "asc")
+	query.Set("page", strconv.Itoa(opts.Page))
+	query.Set("per_page", strconv.Itoa(opts.PerPage))
+	switch opts.State {
+	case "open":
+		query.Set("state", gitlabIssueStateOpened)
+	case "closed":
+		query.Set("state", gitlabIssueStateClosed)
+	}
+	if !opts.Since.IsZero() {
+		query.Set("updated_after", opts.Since.UTC().Format(time.RFC3339))
+	}
+
+	var items []json.RawMessage
+	header, err := getProviderJSON(ctx, p.client, p.projectURL(project, "issues", query), p.authorize, &items)
+	if err != nil {
+		return IssueListPage{}, fmt.Errorf("list gitlab issues for %s: %w", project, err)
+	}
+	page := IssueListPage{UpdateOrdered: true}
+	page.NextPage, _ = strconv.Atoi(header.Get("X-Next-Page"))
+	for _, item := range items {
+		var issue gitlabIssue
+		if err := json.Unmarshal(item, &issue); err != nil {
+			return IssueListPage{}, fmt.Errorf("decode gitlab issue of %s: %w", project, err)
+		}
+		page.Issues = append(page.Issues, issue.toSyncedIssue(string(item)))
+	}
+	return page, nil
+}
+
+// ListComments returns the notes people left on an issue. System notes, such as label changes, are
+// events rather than comments and are left out, as GitLab does in user_notes_count.
+func (p *GitLabIssueProvider) ListComments(ctx context.Context, project string, issue dao.SyncedIssue) ([]dao.IssueComment, error) {
+	out := make([]dao.IssueComment, 0, issue.Comments)
+	for page := 1; page != 0; {
+		query := url.Values{}
+		query.Set("sort", "asc")
+		query.Set("order_by", "created_at")
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(gitlabNotesPerPage))
+
+		var notes []gitlabNote
+		header, err := getProviderJSON(ctx, p.client, p.projectURL(project, fmt.Sprintf("issues/%d/notes", issue.Number), query), p.authorize, &notes)
+		if err != nil {
+			return nil, fmt.Errorf("list gitlab notes for %s#%d: %w", project, issue.Number, err)
+		}
+		for _, note := range notes {
+			if note.System {
+				continue
+			}
+			out = append(out, dao.IssueComment{
+				ID:            note.ID,
+				Body:          note.Body,
+				UserLogin:     note.Author.Username,
+				UserURL:       note.Author.WebURL,
+				UserAvatarURL: note.Author.AvatarURL,
+				CreatedAt:     note.CreatedAt,
+				UpdatedAt:     note.UpdatedAt,
+				HTMLURL:       fmt.Sprintf("%s#note_%d", issue.HTMLURL, note.ID),
+			})
+		}
+		page, _ = strconv.Atoi(header.Get("X-Next-Page"))
+	}
+	return out, nil
+}
+
+// projectURL addresses a project by its full path, which GitLab takes URL-encoded in place of the id.
+func (p *GitLabIssueProvider) projectURL(project, resource string, query url.Values) string {
+	return fmt.Sprintf("%s/api/v4/projects/%s/%s?%s", p.baseURL, url.PathEscape(project), resource, query.Encode())
+}
+
+func (p *GitLabIssueProvider) authorize(req *http.Request) {
+	if p.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", p.token)
+	}
+}
+
+func (in gitlabIssue) toSyncedIssue(raw string) dao.SyncedIssue {
+	assignees := make([]string, 0, len(in.Assignees))
+	for _, a := range in.Assignees {
+		assignees = append(assignees, a.Username)
+	}
+	labels := in.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	state := "open"
+	if in.State == gitlabIssueStateClosed {
+		state = "closed"
+	}
+	var milestone int32
+	if in.Milestone != nil {
+		milestone = in.Milestone.IID
+	}
+	return dao.SyncedIssue{
+		IssueID:   in.ID,
+		Number:    in.IID,
+		Title:     in.Title,
+		Body:      in.Description,
+		State:     state,
+		Author:    in.Author.Username,
+		Assignees: assignees,
+		Labels:    labels,
+		Milestone: milestone,
+		Comments:  in.UserNotesCount,
+		HTMLURL:   in.WebURL,
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		ClosedAt:  in.ClosedAt,
+		Raw:       raw,
+	}
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// ErrIssueAnalyticsInvalid is returned for analytics requests with an unknown interval or
+// dimension, or an unusable range.
+var ErrIssueAnalyticsInvalid = errors.New("invalid issue analytics request")
+
+const (
+	defaultIssueSeriesDays     = 30
+	defaultIssueSeriesWeeks    = 26
+	maxIssueSeriesPoints       = 366
+	defaultIssueBreakdownLimit = 20
+	maxIssueBreakdownLimit     = 100
+)
+
+// IssueSeriesPoint is one interval of an issue time series. Backlog is the number of issues open at
+// the end of the interval.
+type IssueSeriesPoint struct {
+	Start   time.Time
+	Opened  int64
+	Closed  int64
+	Backlog int64
+}
+
+// IssueAnalyticsService answers issue statistics with aggregations run by the store.
+type IssueAnalyticsService struct {
+	// store is nil when the sync store cannot aggregate issues.
+	store dao.IssueAnalyticsStore
+	now   func() time.Time
+}
+
+func NewIssueAnalyticsService(store dao.SyncStore) *IssueAnalyticsService {
+	svc := &IssueAnalyticsService{now: time.Now}
+	if analytics, ok := store.(dao.IssueAnalyticsStore); ok {
+		svc.store = analytics
+	}
+	return svc
+}
+
+// Enabled reports whether the configured store supports issue analytics.
+func (s *IssueAnalyticsService) Enabled() bool {
+	return s != nil && s.store != nil
+}
+
+// Totals counts the issues created in the range of filter.
+func (s *IssueAnalyticsService) Totals(ctx context.Context, filter dao.IssueAnalyticsFilter) (dao.IssueTotals, error) {
+	if err := validateIssueAnalyticsRange(filter); err != nil {
+		return dao.IssueTotals{}, err
+	}
+	return s.store.IssueTotals(ctx, filter)
+}
+
+// Series returns the issues opened and closed in every interval of the range of filter, empty ones
+// included, with the backlog after each. A zero To means now and a zero From goes back 30 days or
+// 26 weeks; From is moved back to the start of its interval.
+func (s *IssueAnalyticsService) Series(ctx context.Context, filter dao.IssueAnalyticsFilter, interval dao.IssueInterval) ([]IssueSeriesPoint, error) {
+	if interval == "" {
+		interval = dao.IssueIntervalDay
+	}
+	if interval != dao.IssueIntervalDay && interval != dao.IssueIntervalWeek {
+		return nil, fmt.Errorf("%w: interval must be day or week", ErrIssueAnalyticsInvalid)
+	}
+	if filter.To.IsZero() {
+		filter.To = s.now()
+	}
+	if filter.From.IsZero() {
+		if interval == dao.IssueIntervalWeek {
+			filter.From = filter.To.AddDate(0, 0, -7*defaultIssueSeriesWeeks)
+		} else {
+			filter.From = filter.To.AddDate(0, 0, -defaultIssueSeriesDays)
+		}
+	}
+	filter.From = issueIntervalStart(filter.From, interval)
+	if err := validateIssueAnalyticsRange(filter); err != nil {
+		return nil, err
+	}
+
+	var starts []time.Time
+	for start := filter.From; start.Before(filter.To); start = nextIssueInterval(start, interval) {
+		if len(starts) == maxIssueSeriesPoints {
+			return nil, fmt.Errorf("%w: range spans more than %d intervals", ErrIssueAnalyticsInvalid, maxIssueSeriesPoints)
+		}
+		starts = append(starts, start)
+	}
+
+	backlog, err := s.store.OpenIssuesAt(ctx, filter, filter.From)
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := s.store.IssueActivity(ctx, filter, interval)
+	if err != nil {
+		return nil, err
+	}
+	activity := make(map[time.Time]dao.IssueActivityBucket, len(buckets))
+	for _, bucket := range buckets {
+		activity[bucket.Start.UTC()] = bucket
+	}
+	points := make([]IssueSeriesPoint, 0, len(starts))
+	for _, start := range starts {
+		bucket := activity[start]
+		backlog += bucket.Opened - bucket.Closed
+		points = append(points, IssueSeriesPoint{Start: start, Opened: bucket.Opened, Closed: bucket.Closed, Backlog: backlog})
+	}
+	return points, nil
+}
+
+// Breakdown counts the issues created in the range of filter per value of dimension, largest
+// groups first. limit defaults to 20 and is capped at 100.
+func (s *IssueAnalyticsService) Breakdown(ctx context.Context, filter dao.IssueAnalyticsFilter, dimension dao.IssueDimension, limit int) ([]dao.IssueGroupCount, error) {
+	switch dimension {
+	case dao.IssueDimensionLabel, dao.IssueDimensionAssignee, dao.IssueDimensionAuthor, dao.IssueDimensionType, dao.IssueDimensionRepo, dao.IssueDimensionMonth:
+	default:
+		return nil, fmt.Errorf("%w: unknown breakdown %q", ErrIssueAnalyticsInvalid, dimension)
+	}
+	if err := validateIssueAnalyticsRange(filter); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultIssueBreakdownLimit
+	}
+	return s.store.IssueBreakdown(ctx, filter, dimension, min(limit, maxIssueBreakdownLimit))
+}
+
+func validateIssueAnalyticsRange(filter dao.IssueAnalyticsFilter) error {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return fmt.Errorf("%w: from must be before to", ErrIssueAnalyticsInvalid)
+	}
+	return nil
+}
+
+// issueIntervalStart returns the start of the UTC day, or Monday, containing t.
+func issueIntervalStart(t time.Time, interval dao.IssueInterval) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if interval == dao.IssueIntervalWeek {
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+func nextIssueInterval(start time.Time, interval dao.IssueInterval) time.Time {
+	if interval == dao.IssueIntervalWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+type fakeIssueAnalyticsStore struct {
+	*fakeSyncStore
+	activity   []dao.IssueActivityBucket
+	openAt     int64
+	lastFilter dao.IssueAnalyticsFilter
+	lastOpenAt time.Time
+	lastLimit  int
+}
+
+func (s *fakeIssueAnalyticsStore) IssueTotals(_ context.Context, filter dao.IssueAnalyticsFilter) (dao.IssueTotals, error) {
+	s.lastFilter = filter
+	return dao.IssueTotals{}, nil
+}
+
+func (s *fakeIssueAnalyticsStore) IssueActivity(_ context.Context, filter dao.IssueAnalyticsFilter, _ dao.IssueInterval) ([]dao.IssueActivityBucket, error) {
+	s.lastFilter = filter
+	return s.activity, nil
+}
+
+func (s *fakeIssueAnalyticsStore) OpenIssuesAt(_ context.Context, _ dao.IssueAnalyticsFilter, at time.Time) (int64, error) {
+	s.lastOpenAt = at
+	return s.openAt, nil
+}
+
+func (s *fakeIssueAnalyticsStore) IssueBreakdown(_ context.Context, filter dao.IssueAnalyticsFilter, _ dao.IssueDimension, limit int) ([]dao.IssueGroupCount, error) {
+	s.lastFilter = filter
+	s.lastLimit = limit
+	return nil, nil
+}
+
+func TestIssueAnalyticsServiceEnabled(t *testing.T) {
+	if NewIssueAnalyticsService(newFakeSyncStore()).Enabled() {
+		t.Fatal("Enabled() = true for a store without analytics")
+	}
+	if !NewIssueAnalyticsService(&fakeIssueAnalyticsStore{fakeSyncStore: newFakeSyncStore()}).Enabled() {
+		t.Fatal("Enabled() = false for an analytics store")
+	}
+}
+
+func TestIssueAnalyticsServiceSeriesFillsIntervalsAndBacklog(t *testing.T) {
+	// 2026-03-04 is a Wednesday; the weekly series starts on Monday 2026-03-02.
+	from := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 23, 0, 0, 0, 0, time.UTC)
+	week := func(day int) time.Time { return time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC) }
+	store := &fakeIssueAnalyticsStore{
+		fakeSyncStore: newFakeSyncStore(),
+		openAt:        10,
+		activity: []dao.IssueActivityBucket{
+			{Start: week(2), Opened: 5, Closed: 2},
+			{Start: week(16), Opened: 1, Closed: 4},
+		},
+	}
+	svc := NewIssueAnalyticsService(store)
+
+	points, err := svc.Series(context.Background(), dao.IssueAnalyticsFilter{Repos: []string{"o/r"}, From: from, To: to}, dao.IssueIntervalWeek)
+	if err != nil {
+		t.Fatalf("Series() error = %v", err)
+	}
+	want := []IssueSeriesPoint{
+		{Start: week(2), Opened: 5, Closed: 2, Backlog: 13},
+		{Start: week(9), Backlog: 13},
+		{Start: week(16), Opened: 1, Closed: 4, Backlog: 10},
+	}
+	if len(points) != len(want) {
+		t.Fatalf("points = %+v, want %+v", points, want)
+	}
+	for i := range want {
+		if !points[i].Start.Equal(want[i].Start) || points[i].Opened != want[i].Opened || points[i].Closed != want[i].Closed || points[i].Backlog != want[i].Backlog {
+			t.Fatalf("points[%d] = %+v, want %+v", i, points[i], want[i])
+		}
+	}
+	if !store.lastOpenAt.Equal(week(2)) || !store.lastFilter.From.Equal(week(2)) {
+		t.Fatalf("backlog at %v from %v, want both at %v", store.lastOpenAt, store.lastFilter.From, week(2))
+	}
+}
+
+func TestIssueAnalyticsServiceSeriesDefaultsToLastThirtyDays(t *testing.T) {
+	store := &fakeIssueAnalyticsStore{fakeSyncStore: newFakeSyncStore()}
+	svc := NewIssueAnalyticsService(store)
+	svc.now = func() time.Time { return time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC) }
+
+	points, err := svc.Series(context.Background(), dao.IssueAnalyticsFilter{}, "")
+	if err != nil {
+		t.Fatalf("Series() error = %v", err)
+	}
+	if len(points) != 31 {
+		t.Fatalf("points = %d, want 31", len(points))
+	}
+	if want := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC); !points[0].Start.Equal(want) {
+		t.Fatalf("first point = %v, want %v", points[0].Start, want)
+	}
+}
+
+func TestIssueAnalyticsServiceRejectsInvalidRequests(t *testing.T) {
+	svc := NewIssueAnalyticsService(&fakeIssueAnalyticsStore{fakeSyncStore: newFakeSyncStore()})
+	ctx := context.Background()
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := svc.Series(ctx, dao.IssueAnalyticsFilter{}, "month"); !errors.Is(err, ErrIssueAnalyticsInvalid) {
+		t.Fatalf("Series(month) error = %v, want ErrIssueAnalyticsInvalid", err)
+	}
+	if _, err := svc.Series(ctx, dao.IssueAnalyticsFilter{From: day.AddDate(-2, 0, 0), To: day}, dao.IssueIntervalDay); !errors.Is(err, ErrIssueAnalyticsInvalid) {
+		t.Fatalf("Series(two years of days) error = %v, want ErrIssueAnalyticsInvalid", err)
+	}
+	if _, err := svc.Totals(ctx, dao.IssueAnalyticsFilter{From: day, To: day}); !errors.Is(err, ErrIssueAnalyticsInvalid) {
+		t.Fatalf("Totals(empty range) error = %v, want ErrIssueAnalyticsInvalid", err)
+	}
+	if _, err := svc.Breakdown(ctx, dao.IssueAnalyticsFilter{}, "milestone", 0); !errors.Is(err, ErrIssueAnalyticsInvalid) {
+		t.Fatalf("Breakdown(milestone) error = %v, want ErrIssueAnalyticsInvalid", err)
+	}
+}
+
+func TestIssueAnalyticsServiceBreakdownCapsLimit(t *testing.T) {
+	store := &fakeIssueAnalyticsStore{fakeSyncStore: newFakeSyncStore()}
+	svc := NewIssueAnalyticsService(store)
+
+	for _, tc := range []struct{ limit, want int }{{0, 20}, {5, 5}, {500, 100}} {
+		if _, err := svc.Breakdown(context.Background(), dao.IssueAnalyticsFilter{}, dao.IssueDimensionRepo, tc.limit); err != nil {
+			t.Fatalf("Breakdown(limit %d) error = %v", tc.limit, err)
+		}
+		if store.lastLimit != tc.want {
+			t.Fatalf("Breakdown(limit %d) store limit = %d, want %d", tc.limit, store.lastLimit, tc.want)
+		}
+	}
+}
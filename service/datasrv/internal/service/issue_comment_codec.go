@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Content encodings of stored comment objects.
+const (
+	issueCommentEncodingNone = ""
+	issueCommentEncodingGzip = "gzip"
+	issueCommentEncodingZstd = "zstd"
+)
+
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+func normalizeIssueCommentEncoding(compression string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(compression)) {
+	case "", "none", "identity":
+		return issueCommentEncodingNone, nil
+	case "gzip":
+		return issueCommentEncodingGzip, nil
+	case "zstd":
+		return issueCommentEncodingZstd, nil
+	default:
+		return "", fmt.Errorf("unsupported issue comment compression %q", compression)
+	}
+}
+
+// issueCommentContentHash identifies a comment payload by its uncompressed JSON, so that the same
+// comments hash alike whatever encoding they were written with.
+func issueCommentContentHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func encodeIssueComments(body []byte, encoding string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch encoding {
+	case issueCommentEncodingNone:
+		return body, nil
+	case issueCommentEncodingGzip:
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(body); err != nil {
+			return nil, fmt.Errorf("gzip comments: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("gzip comments: %w", err)
+		}
+	case issueCommentEncodingZstd:
+		w, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, fmt.Errorf("zstd comments: %w", err)
+		}
+		if _, err := w.Write(body); err != nil {
+			w.Close()
+			return nil, fmt.Errorf("zstd comments: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, fmt.Errorf("zstd comments: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported issue comment encoding %q", encoding)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeIssueComments undoes the encoding of a stored object. It goes by the magic bytes rather than
+// the Content-Encoding header, since HTTP clients may already have decompressed a gzip body; plain
+// JSON never starts with either magic.
+func decodeIssueComments(body []byte) ([]byte, error) {
+	switch {
+	case len(body) >= 2 && body[0] == 0x1f && body[1] == 0x8b:
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("gunzip comments: %w", err)
+		}
+		defer r.Close()
+		out, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("gunzip comments: %w", err)
+		}
+		return out, nil
+	case bytes.HasPrefix(body, zstdMagic):
+		r, err := zstd.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("unzstd comments: %w", err)
+		}
+		defer r.Close()
+		out, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("unzstd comments: %w", err)
+		}
+		return out, nil
+	default:
+		return body, nil
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// issueCommentMigrationBatch is the number of stored issues read per page during a migration.
+const issueCommentMigrationBatch = 200
+
+// IssueCommentMigrationResult counts the comment threads of one repo copied between providers.
+type IssueCommentMigrationResult struct {
+	Repo    string
+	Scanned int32
+	Copied  int32
+	// Missing counts issues the source holds no thread for.
+	Missing int32
+	// Skipped counts threads the target already had; they are only replaced with overwrite.
+	Skipped int32
+	Failed  int32
+	Err     string
+}
+
+type IssueCommentMigrationSummary struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Results    []IssueCommentMigrationResult
+}
+
+// MigrateIssueComments copies the comment thread of every stored issue of repos, tombstoned ones
+// included, from one comment store to another. Threads that fail to copy are logged and counted; the
+// migration can be run again and, without overwrite, only fills in what the target still lacks.
+func MigrateIssueComments(ctx context.Context, store dao.SyncStore, from, to IssueCommentStore, repos []string, overwrite bool) IssueCommentMigrationSummary {
+	summary := IssueCommentMigrationSummary{StartedAt: time.Now()}
+	for _, repo := range repos {
+		summary.Results = append(summary.Results, migrateRepoIssueComments(ctx, store, from, to, repo, overwrite))
+	}
+	summary.FinishedAt = time.Now()
+	return summary
+}
+
+func migrateRepoIssueComments(ctx context.Context, store dao.SyncStore, from, to IssueCommentStore, repo string, overwrite bool) IssueCommentMigrationResult {
+	result := IssueCommentMigrationResult{Repo: repo}
+	for offset := 0; ; offset += issueCommentMigrationBatch {
+		rows, err := store.ListIssues(ctx, dao.SyncIssueFilter{
+			Repo:              repo,
+			Offset:            offset,
+			Limit:             issueCommentMigrationBatch,
+			IncludeTombstoned: true,
+		})
+		if err != nil {
+			result.Err = fmt.Sprintf("list issues: %v", err)
+			return result
+		}
+		for _, issue := range rows {
+			result.Scanned++
+			copied, err := copyIssueComments(ctx, from, to, issue, overwrite)
+			switch {
+			case errors.Is(err, dao.ErrIssueCommentsNotFound):
+				result.Missing++
+			case err != nil:
+				result.Failed++
+				issueSyncLogger.Error("issue comment migration failed",
+					"repo", repo,
+					"issue_number", issue.Number,
+					"issue_id", issue.IssueID,
+					"error", err,
+				)
+			case copied:
+				result.Copied++
+			default:
+				result.Skipped++
+			}
+		}
+		if len(rows) < issueCommentMigrationBatch {
+			break
+		}
+	}
+	issueSyncLogger.Info("issue comment migration repo finished",
+		"repo", repo,
+		"scanned", result.Scanned,
+		"copied", result.Copied,
+		"missing", result.Missing,
+		"skipped", result.Skipped,
+		"failed", result.Failed,
+	)
+	return result
+}
+
+// copyIssueComments reports whether the thread of issue was written to the target. A thread the
+// source does not have comes back as dao.ErrIssueCommentsNotFound.
+func copyIssueComments(ctx context.Context, from, to IssueCommentStore, issue dao.SyncedIssue, overwrite bool) (bool, error) {
+	if !overwrite {
+		_, err := to.LoadComments(ctx, issue.Repo, issue.IssueID, issue.Number)
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, dao.ErrIssueCommentsNotFound) {
+			return false, fmt.Errorf("check target: %w", err)
+		}
+	}
+	comments, err := from.LoadComments(ctx, issue.Repo, issue.IssueID, issue.Number)
+	if err != nil {
+		return false, err
+	}
+	if err := to.SaveComments(ctx, issue.Repo, issue.IssueID, issue.Number, comments); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/kongken/datasrv/service/datasrv/internal/conf"
 	"github.com/kongken/datasrv/service/datasrv/internal/dao"
 )
@@ -21,21 +23,69 @@ type IssueCommentStore interface {
 	LoadComments(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]dao.IssueComment, error)
 }
 
+// s3CommentHashMetadata is the object metadata key holding the SHA-256 of the uncompressed JSON.
+const s3CommentHashMetadata = "content-sha256"
+
 type S3IssueCommentStore struct {
 	client    *s3.Client
 	bucket    string
 	keyPrefix string
+	encoding  string
+	// versioning is set when the bucket keeps previous objects; see ListCommentVersions.
+	versioning bool
 }
 
-func NewIssueCommentStore(cfg conf.IssueCommentStorageConfig) (IssueCommentStore, error) {
+// Issue comment storage providers.
+const (
+	IssueCommentProviderS3         = "s3"
+	IssueCommentProviderDatabase   = "database"
+	IssueCommentProviderFilesystem = "filesystem"
+)
+
+// NewIssueCommentStore returns the configured comment store, or nil when comment storage is
+// disabled. The database provider keeps comments in primary, which must implement
+// dao.IssueCommentThreadStore. When primary implements dao.IssueSearchStore, saved threads are
+// also indexed for issue search.
+func NewIssueCommentStore(cfg conf.IssueCommentStorageConfig, primary dao.SyncStore) (IssueCommentStore, error) {
 	if !cfg.Enabled {
 		return nil, nil
 	}
-	switch strings.ToLower(strings.TrimSpace(cfg.Provider)) {
+	return newIssueCommentStoreFor(cfg.Provider, cfg, primary)
+}
+
+func newIssueCommentStoreFor(provider string, cfg conf.IssueCommentStorageConfig, primary dao.SyncStore) (IssueCommentStore, error) {
+	var store IssueCommentStore
+	var err error
+	switch normalizeIssueCommentProvider(provider) {
+	case IssueCommentProviderS3:
+		store, err = newS3IssueCommentStore(cfg)
+	case IssueCommentProviderDatabase:
+		threads, ok := primary.(dao.IssueCommentThreadStore)
+		if !ok {
+			return nil, fmt.Errorf("store %T does not implement issue comment store", primary)
+		}
+		store = &DatabaseIssueCommentStore{threads: threads}
+	case IssueCommentProviderFilesystem:
+		store, err = newFilesystemIssueCommentStore(cfg)
+	default:
+		return nil, fmt.Errorf("unsupported issue comment storage provider %q", provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return withIssueSearchIndex(withIssueResponsivenessInvalidation(store, primary), primary), nil
+}
+
+func normalizeIssueCommentProvider(provider string) string {
+	switch strings.ToLower(strings.TrimSpace(provider)) {
 	case "", "s3":
-		return newS3IssueCommentStore(cfg)
+		return IssueCommentProviderS3
+	case "database", "db":
+		return IssueCommentProviderDatabase
+	case "filesystem", "fs", "local":
+		return IssueCommentProviderFilesystem
 	default:
-		return nil, fmt.Errorf("unsupported issue comment storage provider %q", cfg.Provider)
+		return provider
 	}
 }
 
@@ -43,6 +93,10 @@ func newS3IssueCommentStore(cfg conf.IssueCommentStorageConfig) (*S3IssueComment
 	if strings.TrimSpace(cfg.Bucket) == "" {
 		return nil, fmt.Errorf("issue comment storage bucket is required")
 	}
+	encoding, err := normalizeIssueCommentEncoding(cfg.Compression)
+	if err != nil {
+		return nil, err
+	}
 	region := strings.TrimSpace(cfg.Region)
 	if region == "" {
 		region = "us-east-1"
@@ -71,47 +125,91 @@ func newS3IssueCommentStore(cfg conf.IssueCommentStorageConfig) (*S3IssueComment
 		o.UsePathStyle = cfg.UsePathStyle
 	})
 
-	return &S3IssueCommentStore{
-		client:    client,
-		bucket:    cfg.Bucket,
-		keyPrefix: strings.Trim(strings.TrimSpace(cfg.KeyPrefix), "/"),
-	}, nil
+	store := &S3IssueCommentStore{
+		client:     client,
+		bucket:     cfg.Bucket,
+		keyPrefix:  strings.Trim(strings.TrimSpace(cfg.KeyPrefix), "/"),
+		encoding:   encoding,
+		versioning: cfg.Versioning,
+	}
+	if cfg.Versioning {
+		if err := store.enableVersioning(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
 }
 
+// SaveComments writes the comments encoded as configured, tagged with the hash of their JSON. A
+// payload whose hash matches the stored object is not written again. The stored hash is read from
+// the object each time, as other replicas write the same keys.
 func (s *S3IssueCommentStore) SaveComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
 	body, err := json.Marshal(comments)
 	if err != nil {
 		return fmt.Errorf("marshal issue comments: %w", err)
 	}
+	hash := issueCommentContentHash(body)
 
 	key := s.objectKey(repo, issueID, issueNumber)
-	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
+	stored, err := s.storedHash(ctx, key)
+	if err != nil {
+		return err
+	}
+	if stored == hash {
+		return nil
+	}
+
+	encoded, err := encodeIssueComments(body, s.encoding)
+	if err != nil {
+		return err
+	}
+	input := &s3.PutObjectInput{
 		Bucket:      aws.String(s.bucket),
 		Key:         aws.String(key),
-		Body:        bytes.NewReader(body),
+		Body:        bytes.NewReader(encoded),
 		ContentType: aws.String("application/json"),
-	})
-	if err != nil {
+		Metadata:    map[string]string{s3CommentHashMetadata: hash},
+	}
+	if s.encoding != issueCommentEncodingNone {
+		input.ContentEncoding = aws.String(s.encoding)
+	}
+	if _, err := s.client.PutObject(ctx, input); err != nil {
 		return fmt.Errorf("put comments object: %w", err)
 	}
 	return nil
 }
 
 func (s *S3IssueCommentStore) LoadComments(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]dao.IssueComment, error) {
-	key := s.objectKey(repo, issueID, issueNumber)
-	resp, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+	return s.loadVersion(ctx, s.objectKey(repo, issueID, issueNumber), "")
+}
+
+// loadVersion reads one version of an object, or the current one when versionID is empty.
+func (s *S3IssueCommentStore) loadVersion(ctx context.Context, key, versionID string) ([]dao.IssueComment, error) {
+	input := &s3.GetObjectInput{
 		Bucket: aws.String(s.bucket),
 		Key:    aws.String(key),
-	})
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+	resp, err := s.client.GetObject(ctx, input)
 	if err != nil {
+		var noKey *types.NoSuchKey
+		if errors.As(err, &noKey) {
+			return nil, dao.ErrIssueCommentsNotFound
+		}
 		return nil, fmt.Errorf("get comments object: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	raw, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("read comments object: %w", err)
 	}
+	body, err := decodeIssueComments(raw)
+	if err != nil {
+		return nil, err
+	}
 
 	var comments []dao.IssueComment
 	if err := json.Unmarshal(body, &comments); err != nil {
@@ -120,11 +218,33 @@ func (s *S3IssueCommentStore) LoadComments(ctx context.Context, repo string, iss
 	return comments, nil
 }
 
+// storedHash returns the content hash of the current object at key, or "" when there is none or it
+// predates hashing.
+func (s *S3IssueCommentStore) storedHash(ctx context.Context, key string) (string, error) {
+	head, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("head comments object: %w", err)
+	}
+	return head.Metadata[s3CommentHashMetadata], nil
+}
+
 func (s *S3IssueCommentStore) objectKey(repo string, issueID int64, issueNumber int32) string {
-	repo = strings.Trim(strings.TrimSpace(repo), "/")
-	key := fmt.Sprintf("github-issue-comments/%s/%d-%d.json", repo, issueID, issueNumber)
+	key := issueCommentKey(repo, issueID, issueNumber)
 	if s.keyPrefix == "" {
 		return key
 	}
 	return s.keyPrefix + "/" + key
 }
+
+// issueCommentKey is the relative path of a comment thread, shared by the object and filesystem stores.
+func issueCommentKey(repo string, issueID int64, issueNumber int32) string {
+	repo = strings.Trim(strings.TrimSpace(repo), "/")
+	return fmt.Sprintf("github-issue-comments/%s/%d-%d.json", repo, issueID, issueNumber)
+}
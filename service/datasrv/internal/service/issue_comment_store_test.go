@@ -0,0 +1,227 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewIssueCommentStoreProviders(t *testing.T) {
+	store := newFakeSyncStore()
+
+	db, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Enabled: true, Provider: "database"}, store)
+	if err != nil {
+		t.Fatalf("database provider error = %v", err)
+	}
+	if _, ok := db.(*DatabaseIssueCommentStore); !ok {
+		t.Fatalf("database provider = %T, want *DatabaseIssueCommentStore", db)
+	}
+	fs, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Enabled: true, Provider: "fs", Dir: t.TempDir()}, store)
+	if err != nil {
+		t.Fatalf("filesystem provider error = %v", err)
+	}
+	if _, ok := fs.(*FilesystemIssueCommentStore); !ok {
+		t.Fatalf("filesystem provider = %T, want *FilesystemIssueCommentStore", fs)
+	}
+	if _, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Enabled: true, Provider: "filesystem"}, store); err == nil {
+		t.Fatalf("filesystem provider without dir error = nil")
+	}
+	if _, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Enabled: true, Provider: "ftp"}, store); err == nil {
+		t.Fatalf("unknown provider error = nil")
+	}
+	if disabled, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Provider: "database"}, store); err != nil || disabled != nil {
+		t.Fatalf("disabled storage = %v, %v, want nil", disabled, err)
+	}
+}
+
+func TestFilesystemIssueCommentStoreRoundTrip(t *testing.T) {
+	store, err := newFilesystemIssueCommentStore(conf.IssueCommentStorageConfig{Dir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("newFilesystemIssueCommentStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := store.LoadComments(ctx, "owner/repo", 7, 77); !errors.Is(err, dao.ErrIssueCommentsNotFound) {
+		t.Fatalf("LoadComments() missing error = %v, want ErrIssueCommentsNotFound", err)
+	}
+	if err := store.SaveComments(ctx, "owner/repo", 7, 77, []dao.IssueComment{{ID: 1, Body: "first"}}); err != nil {
+		t.Fatalf("SaveComments() error = %v", err)
+	}
+	if err := store.SaveComments(ctx, "owner/repo", 7, 77, []dao.IssueComment{{ID: 1, Body: "edited"}, {ID: 2}}); err != nil {
+		t.Fatalf("SaveComments() replace error = %v", err)
+	}
+	got, err := store.LoadComments(ctx, "owner/repo", 7, 77)
+	if err != nil {
+		t.Fatalf("LoadComments() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Body != "edited" || got[1].ID != 2 {
+		t.Fatalf("LoadComments() = %#v, want the replaced thread", got)
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_MigrateIssueComments(t *testing.T) {
+	store := newFakeSyncStore()
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo"}
+	store.issues["owner/repo"] = []dao.SyncedIssue{
+		{Repo: "owner/repo", IssueID: 10, Number: 1, State: "open"},
+		{Repo: "owner/repo", IssueID: 20, Number: 2, State: "closed", Tombstone: "deleted"},
+		{Repo: "owner/repo", IssueID: 30, Number: 3, State: "open"},
+	}
+	store.threads["owner/repo/10-1"] = []dao.IssueComment{{ID: 101}}
+	store.threads["owner/repo/20-2"] = []dao.IssueComment{{ID: 201}, {ID: 202}}
+	dir := t.TempDir()
+	cfg := &conf.Config{IssueCommentStorage: conf.IssueCommentStorageConfig{Dir: dir}}
+	srv := NewIssueSyncAdminGRPCServer(store, NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil), cfg)
+	ctx := context.Background()
+
+	resp, err := srv.MigrateIssueComments(ctx, &issuesv1.MigrateIssueCommentsRequest{Source: "database", Target: "filesystem"})
+	if err != nil {
+		t.Fatalf("MigrateIssueComments() error = %v", err)
+	}
+	if len(resp.Results) != 1 {
+		t.Fatalf("results = %#v, want owner/repo", resp.Results)
+	}
+	if res := resp.Results[0]; res.Scanned != 3 || res.Copied != 2 || res.Missing != 1 || res.Failed != 0 {
+		t.Fatalf("result = %#v, want 2 threads copied and 1 missing", res)
+	}
+	fs, _ := newFilesystemIssueCommentStore(conf.IssueCommentStorageConfig{Dir: dir})
+	if got, err := fs.LoadComments(ctx, "owner/repo", 20, 2); err != nil || len(got) != 2 {
+		t.Fatalf("tombstoned issue comments = %#v, %v, want copied", got, err)
+	}
+
+	store.threads["owner/repo/10-1"] = []dao.IssueComment{{ID: 101}, {ID: 102}}
+	resp, err = srv.MigrateIssueComments(ctx, &issuesv1.MigrateIssueCommentsRequest{Source: "db", Target: "fs", Repo: "owner/repo"})
+	if err != nil {
+		t.Fatalf("second MigrateIssueComments() error = %v", err)
+	}
+	if res := resp.Results[0]; res.Copied != 0 || res.Skipped != 2 {
+		t.Fatalf("second result = %#v, want existing threads skipped", res)
+	}
+	resp, err = srv.MigrateIssueComments(ctx, &issuesv1.MigrateIssueCommentsRequest{Source: "database", Target: "filesystem", Overwrite: true})
+	if err != nil {
+		t.Fatalf("overwrite MigrateIssueComments() error = %v", err)
+	}
+	if res := resp.Results[0]; res.Copied != 2 {
+		t.Fatalf("overwrite result = %#v, want both threads replaced", res)
+	}
+	if got, _ := fs.LoadComments(ctx, "owner/repo", 10, 1); len(got) != 2 {
+		t.Fatalf("overwritten comments = %#v, want 2", got)
+	}
+
+	for _, req := range []*issuesv1.MigrateIssueCommentsRequest{
+		{Source: "database"},
+		{Source: "database", Target: "db"},
+		{Source: "database", Target: "ftp"},
+	} {
+		if _, err := srv.MigrateIssueComments(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("MigrateIssueComments(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestIssueCommentCodecRoundTrip(t *testing.T) {
+	body := []byte(`[{"ID":1,"Body":"` + strings.Repeat("hello ", 200) + `"}]`)
+	for _, compression := range []string{"none", "gzip", "zstd"} {
+		encoding, err := normalizeIssueCommentEncoding(compression)
+		if err != nil {
+			t.Fatalf("normalizeIssueCommentEncoding(%q) error = %v", compression, err)
+		}
+		encoded, err := encodeIssueComments(body, encoding)
+		if err != nil {
+			t.Fatalf("encode %s error = %v", compression, err)
+		}
+		if encoding != issueCommentEncodingNone && len(encoded) >= len(body) {
+			t.Fatalf("%s encoded %d bytes, want less than %d", compression, len(encoded), len(body))
+		}
+		decoded, err := decodeIssueComments(encoded)
+		if err != nil {
+			t.Fatalf("decode %s error = %v", compression, err)
+		}
+		if !bytes.Equal(decoded, body) {
+			t.Fatalf("%s round trip changed the payload", compression)
+		}
+	}
+	if _, err := normalizeIssueCommentEncoding("brotli"); err == nil {
+		t.Fatalf("unknown compression error = nil")
+	}
+	if issueCommentContentHash(body) != issueCommentContentHash(append([]byte(nil), body...)) {
+		t.Fatalf("content hash differs for identical payloads")
+	}
+}
+
+type fakeVersionedCommentStore struct {
+	*fakeIssueCommentStore
+	versions map[string][]dao.IssueComment
+	restored string
+}
+
+func (f *fakeVersionedCommentStore) ListCommentVersions(_ context.Context, repo string, issueID int64, issueNumber int32) ([]IssueCommentVersion, error) {
+	return []IssueCommentVersion{{VersionID: "v2", IsLatest: true, Size: 40}, {VersionID: "v1", Size: 20}}, nil
+}
+
+func (f *fakeVersionedCommentStore) RestoreCommentVersion(ctx context.Context, repo string, issueID int64, issueNumber int32, versionID string) ([]dao.IssueComment, error) {
+	comments, ok := f.versions[versionID]
+	if !ok {
+		return nil, ErrIssueCommentVersionNotFound
+	}
+	f.restored = versionID
+	return comments, f.SaveComments(ctx, repo, issueID, issueNumber, comments)
+}
+
+func TestIssueSyncAdminGRPCServer_IssueCommentVersions(t *testing.T) {
+	store := newFakeSyncStore()
+	store.issues["owner/repo"] = []dao.SyncedIssue{{Repo: "owner/repo", IssueID: 10, Number: 1, State: "open"}}
+	comments := &fakeVersionedCommentStore{
+		fakeIssueCommentStore: newFakeIssueCommentStore(),
+		versions:              map[string][]dao.IssueComment{"v1": {{ID: 101, Body: "original"}}},
+	}
+	srv := NewIssueSyncAdminGRPCServer(store, NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, comments), &conf.Config{})
+	ctx := context.Background()
+
+	listed, err := srv.ListIssueCommentVersions(ctx, &issuesv1.ListIssueCommentVersionsRequest{Repo: "owner/repo", Selector: &issuesv1.ListIssueCommentVersionsRequest_Number{Number: 1}})
+	if err != nil {
+		t.Fatalf("ListIssueCommentVersions() error = %v", err)
+	}
+	if len(listed.Versions) != 2 || !listed.Versions[0].GetIsLatest() || listed.Versions[1].GetVersionId() != "v1" {
+		t.Fatalf("versions = %#v, want v2 then v1", listed.Versions)
+	}
+
+	restored, err := srv.RestoreIssueCommentVersion(ctx, &issuesv1.RestoreIssueCommentVersionRequest{
+		Repo:      "owner/repo",
+		Selector:  &issuesv1.RestoreIssueCommentVersionRequest_IssueId{IssueId: 10},
+		VersionId: "v1",
+	})
+	if err != nil {
+		t.Fatalf("RestoreIssueCommentVersion() error = %v", err)
+	}
+	if len(restored.Comments) != 1 || restored.Comments[0].GetBody() != "original" {
+		t.Fatalf("restored comments = %#v, want v1", restored.Comments)
+	}
+	if got := comments.saved["owner/repo/10-1.json"]; comments.restored != "v1" || len(got) != 1 {
+		t.Fatalf("saved comments = %#v, want v1 current again", got)
+	}
+
+	if _, err := srv.RestoreIssueCommentVersion(ctx, &issuesv1.RestoreIssueCommentVersionRequest{
+		Repo: "owner/repo", Selector: &issuesv1.RestoreIssueCommentVersionRequest_Number{Number: 1}, VersionId: "v9",
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unknown version error = %v, want NotFound", err)
+	}
+	if _, err := srv.ListIssueCommentVersions(ctx, &issuesv1.ListIssueCommentVersionsRequest{
+		Repo: "owner/repo", Selector: &issuesv1.ListIssueCommentVersionsRequest_Number{Number: 2},
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unknown issue error = %v, want NotFound", err)
+	}
+
+	plain := NewIssueSyncAdminGRPCServer(store, NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, newFakeIssueCommentStore()), &conf.Config{})
+	if _, err := plain.ListIssueCommentVersions(ctx, &issuesv1.ListIssueCommentVersionsRequest{Repo: "owner/repo"}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("unversioned store error = %v, want Unimplemented", err)
+	}
+}
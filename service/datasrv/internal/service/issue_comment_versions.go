@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var (
+	ErrIssueCommentVersioningDisabled = errors.New("issue comment versioning is not enabled")
+	ErrIssueCommentVersionNotFound    = errors.New("issue comment version not found")
+)
+
+// IssueCommentVersion is one stored revision of the comment thread of an issue.
+type IssueCommentVersion struct {
+	VersionID    string
+	LastModified time.Time
+	Size         int64
+	IsLatest     bool
+}
+
+// IssueCommentVersionStore is implemented by comment stores that keep previous comment threads.
+type IssueCommentVersionStore interface {
+	// ListCommentVersions returns the revisions of a thread, newest first.
+	ListCommentVersions(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueCommentVersion, error)
+	// RestoreCommentVersion makes a previous revision the current thread again and returns it.
+	RestoreCommentVersion(ctx context.Context, repo string, issueID int64, issueNumber int32, versionID string) ([]dao.IssueComment, error)
+}
+
+func (s *S3IssueCommentStore) enableVersioning(ctx context.Context) error {
+	current, err := s.client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: aws.String(s.bucket)})
+	if err != nil {
+		return fmt.Errorf("get comment bucket versioning: %w", err)
+	}
+	if current.Status == types.BucketVersioningStatusEnabled {
+		return nil
+	}
+	_, err = s.client.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(s.bucket),
+		VersioningConfiguration: &types.VersioningConfiguration{Status: types.BucketVersioningStatusEnabled},
+	})
+	if err != nil {
+		return fmt.Errorf("enable comment bucket versioning: %w", err)
+	}
+	return nil
+}
+
+func (s *S3IssueCommentStore) ListCommentVersions(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueCommentVersion, error) {
+	if !s.versioning {
+		return nil, ErrIssueCommentVersioningDisabled
+	}
+	key := s.objectKey(repo, issueID, issueNumber)
+	out := make([]IssueCommentVersion, 0)
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(key),
+	}
+	for {
+		page, err := s.client.ListObjectVersions(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("list comment object versions: %w", err)
+		}
+		for _, v := range page.Versions {
+			// The prefix also matches longer keys of other issues.
+			if aws.ToString(v.Key) != key {
+				continue
+			}
+			out = append(out, IssueCommentVersion{
+				VersionID:    aws.ToString(v.VersionId),
+				LastModified: aws.ToTime(v.LastModified),
+				Size:         aws.ToInt64(v.Size),
+				IsLatest:     aws.ToBool(v.IsLatest),
+			})
+		}
+		if !aws.ToBool(page.IsTruncated) {
+			break
+		}
+		input.KeyMarker = page.NextKeyMarker
+		input.VersionIdMarker = page.NextVersionIdMarker
+	}
+	return out, nil
+}
+
+// RestoreCommentVersion writes the comments of a previous version as a new current version, so the
+// history keeps every state the thread went through.
+func (s *S3IssueCommentStore) RestoreCommentVersion(ctx context.Context, repo string, issueID int64, issueNumber int32, versionID string) ([]dao.IssueComment, error) {
+	if !s.versioning {
+		return nil, ErrIssueCommentVersioningDisabled
+	}
+	comments, err := s.loadVersion(ctx, s.objectKey(repo, issueID, issueNumber), versionID)
+	if err != nil {
+		var apiErr interface{ ErrorCode() string }
+		if errors.Is(err, dao.ErrIssueCommentsNotFound) || (errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchVersion") {
+			return nil, ErrIssueCommentVersionNotFound
+		}
+		return nil, err
+	}
+	if err := s.SaveComments(ctx, repo, issueID, issueNumber, comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
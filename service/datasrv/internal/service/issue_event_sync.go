@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// issueTimelinePageSize is the page size used when listing issue timelines.
+const issueTimelinePageSize = 100
+
+// syncedIssueEvents are the timeline event types kept; commits, reviews and the like are dropped.
+var syncedIssueEvents = map[string]struct{}{
+	dao.IssueEventLabeled:         {},
+	dao.IssueEventUnlabeled:       {},
+	dao.IssueEventAssigned:        {},
+	dao.IssueEventUnassigned:      {},
+	dao.IssueEventClosed:          {},
+	dao.IssueEventReopened:        {},
+	dao.IssueEventReferenced:      {},
+	dao.IssueEventCrossReferenced: {},
+	dao.IssueEventRenamed:         {},
+	dao.IssueEventMilestoned:      {},
+	dao.IssueEventDemilestoned:    {},
+}
+
+type issueEventFetch struct {
+	events []dao.IssueEvent
+	etags  []dao.GitHubETag
+}
+
+// fetchIssueEvents lists the timeline of a changed issue, sending each page conditionally. The
+// timeline only grows at its end, so pages GitHub answers with 304 hold events already stored and
+// only the pages after them are read. Events never change once recorded, so storing a page again is
+// an idempotent upsert.
+func (s *IssueSyncService) fetchIssueEvents(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, issue dao.SyncedIssue) (issueEventFetch, error) {
+	number := int(issue.Number)
+	endpoint := fmt.Sprintf("issues/%d/timeline", number)
+	var out issueEventFetch
+	page := 1
+	for {
+		cached := s.lookupETag(ctx, repo, endpoint, page)
+		var items []*github.Timeline
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, repo, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(withGitHubETag(ctx, cached), cfg)
+			defer cancel()
+			var err error
+			items, resp, err = s.client.ListIssueTimeline(requestCtx, owner, name, number, &github.ListOptions{
+				Page:    page,
+				PerPage: issueTimelinePageSize,
+			})
+			return resp, err
+		})
+		if err != nil && !githubNotModified(resp) {
+			return issueEventFetch{}, fmt.Errorf("list timeline %s#%d: %w", repo, number, err)
+		}
+
+		nextPage := cached.NextPage
+		if !githubNotModified(resp) {
+			for _, item := range items {
+				if event, ok := toIssueEvent(repo, issue, item); ok {
+					out.events = append(out.events, event)
+				}
+			}
+			nextPage = 0
+			if len(items) > 0 && resp != nil {
+				nextPage = resp.NextPage
+			}
+			if etag, ok := githubETagFor(repo, endpoint, page, resp); ok {
+				out.etags = append(out.etags, etag)
+			}
+		}
+		if nextPage == 0 {
+			return out, nil
+		}
+		page = nextPage
+	}
+}
+
+func toIssueEvent(repo string, issue dao.SyncedIssue, in *github.Timeline) (dao.IssueEvent, bool) {
+	kind := in.GetEvent()
+	if _, ok := syncedIssueEvents[kind]; !ok {
+		return dao.IssueEvent{}, false
+	}
+	out := dao.IssueEvent{
+		Repo:        repo,
+		IssueID:     issue.IssueID,
+		IssueNumber: issue.Number,
+		Event:       kind,
+		Actor:       in.GetActor().GetLogin(),
+		CreatedAt:   in.GetCreatedAt().Time,
+		Label:       in.GetLabel().GetName(),
+		Assignee:    in.GetAssignee().GetLogin(),
+		Milestone:   in.GetMilestone().GetTitle(),
+		RenameFrom:  in.GetRename().GetFrom(),
+		RenameTo:    in.GetRename().GetTo(),
+		CommitID:    in.GetCommitID(),
+	}
+	if src := in.GetSource().GetIssue(); src != nil {
+		if srcRepo := githubRepoFromURL(src.GetRepositoryURL()); srcRepo != "" {
+			out.Source = fmt.Sprintf("%s#%d", srcRepo, src.GetNumber())
+		}
+		if out.Actor == "" {
+			out.Actor = in.GetSource().GetActor().GetLogin()
+		}
+	}
+	if id := in.GetID(); id != 0 {
+		out.Key = strconv.FormatInt(id, 10)
+	} else {
+		out.Key = fmt.Sprintf("%s:%d:%s", kind, out.CreatedAt.Unix(), out.Source)
+	}
+	return out, true
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listIssuesFilter turns the filters and order of a ListIssues request into a store filter,
+// leaving paging to the caller.
+func listIssuesFilter(req *issuesv1.ListIssuesRequest) (dao.SyncIssueFilter, error) {
+	filter := dao.SyncIssueFilter{
+		Repo:              req.GetRepo(),
+		State:             req.GetState(),
+		IncludeTombstoned: req.GetIncludeTombstoned(),
+		Assignee:          strings.TrimSpace(req.GetAssignee()),
+		Author:            strings.TrimSpace(req.GetAuthor()),
+		CreatedAfter:      timeOrZero(req.GetCreatedAfter()),
+		CreatedBefore:     timeOrZero(req.GetCreatedBefore()),
+		UpdatedAfter:      timeOrZero(req.GetUpdatedAfter()),
+		UpdatedBefore:     timeOrZero(req.GetUpdatedBefore()),
+		ClosedAfter:       timeOrZero(req.GetClosedAfter()),
+		ClosedBefore:      timeOrZero(req.GetClosedBefore()),
+	}
+	for _, label := range req.GetLabels() {
+		if label = strings.TrimSpace(label); label != "" {
+			filter.Labels = append(filter.Labels, label)
+		}
+	}
+	switch strings.ToLower(req.GetLabelMatch()) {
+	case "", "all":
+	case "any":
+		filter.AnyLabel = true
+	default:
+		return dao.SyncIssueFilter{}, fmt.Errorf("label_match must be all or any, got %q", req.GetLabelMatch())
+	}
+
+	var err error
+	if filter.IsPullRequest, err = parseOptionalBool("is_pull_request", req.GetIsPullRequest()); err != nil {
+		return dao.SyncIssueFilter{}, err
+	}
+	if filter.HasAISummary, err = parseOptionalBool("has_ai_summary", req.GetHasAiSummary()); err != nil {
+		return dao.SyncIssueFilter{}, err
+	}
+	if filter.MinComments, filter.MaxComments, err = parseCountRange(req.GetComments()); err != nil {
+		return dao.SyncIssueFilter{}, fmt.Errorf("comments: %w", err)
+	}
+
+	switch sort := dao.IssueSort(strings.ToLower(req.GetSort())); sort {
+	case dao.IssueSortDefault, dao.IssueSortCreated, dao.IssueSortUpdated, dao.IssueSortClosed, dao.IssueSortComments, dao.IssueSortNumber:
+		filter.Sort = sort
+	default:
+		return dao.SyncIssueFilter{}, fmt.Errorf("sort must be created, updated, closed, comments or number, got %q", req.GetSort())
+	}
+	switch strings.ToLower(req.GetDirection()) {
+	case "", "desc":
+	case "asc":
+		filter.SortAscending = true
+	default:
+		return dao.SyncIssueFilter{}, fmt.Errorf("direction must be asc or desc, got %q", req.GetDirection())
+	}
+	return filter, nil
+}
+
+// parseOptionalBool reads a true/false filter, where empty leaves the filter unset.
+func parseOptionalBool(name, value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be true or false, got %q", name, value)
+	}
+	return &b, nil
+}
+
+// parseCountRange reads a count range such as 5, >5, >=5, <5, <=5 or 2..10 into inclusive bounds,
+// nil where unbounded.
+func parseCountRange(value string) (*int32, *int32, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil, nil
+	}
+	count := func(s string) (int32, error) {
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid count %q", s)
+		}
+		return int32(n), nil
+	}
+	if lo, hi, ok := strings.Cut(value, ".."); ok {
+		from, err := count(lo)
+		if err != nil {
+			return nil, nil, err
+		}
+		to, err := count(hi)
+		if err != nil {
+			return nil, nil, err
+		}
+		if from > to {
+			return nil, nil, fmt.Errorf("range %q is empty", value)
+		}
+		return &from, &to, nil
+	}
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		rest, ok := strings.CutPrefix(value, op)
+		if !ok {
+			continue
+		}
+		n, err := count(rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch op {
+		case ">=":
+			return &n, nil, nil
+		case ">":
+			n++
+			return &n, nil, nil
+		case "<=":
+			return nil, &n, nil
+		default:
+			if n == 0 {
+				return nil, nil, fmt.Errorf("range %q is empty", value)
+			}
+			n--
+			return nil, &n, nil
+		}
+	}
+	n, err := count(value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &n, &n, nil
+}
+
+func timeOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseCountRange(t *testing.T) {
+	tests := []struct {
+		in       string
+		min, max int32
+		hasMin   bool
+		hasMax   bool
+		wantErr  bool
+	}{
+		{in: ""},
+		{in: "5", min: 5, max: 5, hasMin: true, hasMax: true},
+		{in: ">5", min: 6, hasMin: true},
+		{in: ">=5", min: 5, hasMin: true},
+		{in: "<5", max: 4, hasMax: true},
+		{in: "<=5", max: 5, hasMax: true},
+		{in: "2..10", min: 2, max: 10, hasMin: true, hasMax: true},
+		{in: "<0", wantErr: true},
+		{in: "10..2", wantErr: true},
+		{in: "-1", wantErr: true},
+		{in: "many", wantErr: true},
+	}
+	for _, tt := range tests {
+		lo, hi, err := parseCountRange(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("parseCountRange(%q) error = %v, wantErr %t", tt.in, err, tt.wantErr)
+		}
+		if tt.wantErr {
+			continue
+		}
+		if (lo != nil) != tt.hasMin || (lo != nil && *lo != tt.min) {
+			t.Fatalf("parseCountRange(%q) min = %v, want %d (set %t)", tt.in, lo, tt.min, tt.hasMin)
+		}
+		if (hi != nil) != tt.hasMax || (hi != nil && *hi != tt.max) {
+			t.Fatalf("parseCountRange(%q) max = %v, want %d (set %t)", tt.in, hi, tt.max, tt.hasMax)
+		}
+	}
+}
+
+func TestListIssuesFilter(t *testing.T) {
+	after := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter, err := listIssuesFilter(&issuesv1.ListIssuesRequest{
+		Repo:          "o/r",
+		Labels:        []string{"bug", " ", "ui"},
+		LabelMatch:    "any",
+		Author:        "alice",
+		IsPullRequest: "false",
+		HasAiSummary:  "true",
+		CreatedAfter:  timestamppb.New(after),
+		Comments:      ">=3",
+		Sort:          "Comments",
+		Direction:     "asc",
+	})
+	if err != nil {
+		t.Fatalf("listIssuesFilter() error = %v", err)
+	}
+	if len(filter.Labels) != 2 || !filter.AnyLabel || filter.Author != "alice" {
+		t.Fatalf("filter = %+v, want labels bug and ui matched by any", filter)
+	}
+	if filter.IsPullRequest == nil || *filter.IsPullRequest || filter.HasAISummary == nil || !*filter.HasAISummary {
+		t.Fatalf("filter = %+v, want issues with an AI summary", filter)
+	}
+	if !filter.CreatedAfter.Equal(after) || !filter.UpdatedAfter.IsZero() {
+		t.Fatalf("filter created after = %v, updated after = %v", filter.CreatedAfter, filter.UpdatedAfter)
+	}
+	if filter.MinComments == nil || *filter.MinComments != 3 || filter.MaxComments != nil {
+		t.Fatalf("filter comments = %v..%v, want 3..", filter.MinComments, filter.MaxComments)
+	}
+	if filter.Sort != dao.IssueSortComments || !filter.SortAscending {
+		t.Fatalf("filter sort = %q ascending %t", filter.Sort, filter.SortAscending)
+	}
+
+	for _, req := range []*issuesv1.ListIssuesRequest{
+		{LabelMatch: "some"},
+		{IsPullRequest: "maybe"},
+		{Comments: "x"},
+		{Sort: "title"},
+		{Direction: "up"},
+	} {
+		if _, err := listIssuesFilter(req); err == nil {
+			t.Fatalf("listIssuesFilter(%v) error = nil", req)
+		}
+	}
+}
+
+func TestIssueQueryGRPCServer_ListIssuesFilters(t *testing.T) {
+	store := newFakeSyncStore()
+	srv := NewIssueQueryGRPCServer(store, nil)
+
+	_, err := srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", Sort: "title"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ListIssues(sort title) code = %v, want InvalidArgument", status.Code(err))
+	}
+
+	for _, req := range []*issuesv1.ListIssuesRequest{
+		{Repo: "o/r", Labels: []string{"bug"}},
+		{Repo: "o/r", Labels: []string{"ui"}},
+		{Repo: "o/r", Labels: []string{"ui"}},
+	} {
+		if _, err := srv.ListIssues(context.Background(), req); err != nil {
+			t.Fatalf("ListIssues(%v) error = %v", req, err)
+		}
+	}
+	if store.listCalls != 2 {
+		t.Fatalf("list calls = %d, want 2 as only the repeated filter is cached", store.listCalls)
+	}
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// Issue trackers other than GitHub, named by the prefix of their managed repos.
+const (
+	IssueProviderGitLab = "gitlab"
+	IssueProviderGitea  = "gitea"
+)
+
+// IssueListOptions selects one page of the issue listing of a project.
+type IssueListOptions struct {
+	// State is open, closed or all.
+	State string
+	// Since drops issues last updated before it when set.
+	Since   time.Time
+	Page    int
+	PerPage int
+}
+
+// IssueListPage is one page of the issue listing of a project.
+type IssueListPage struct {
+	Issues []dao.SyncedIssue
+	// NextPage is 0 on the last page.
+	NextPage int
+	// UpdateOrdered is set when the listing runs from the oldest update to the newest, so that a run
+	// may stop after any page and resume from the latest update it saw.
+	UpdateOrdered bool
+}
+
+// IssueProvider reads the issues of a project on a tracker other than GitHub. The project is the
+// managed repo without its provider prefix; returned issues leave Repo to the caller.
+type IssueProvider interface {
+	ListIssues(ctx context.Context, project string, opts IssueListOptions) (IssueListPage, error)
+	// ListComments returns every comment of an issue, oldest first.
+	ListComments(ctx context.Context, project string, issue dao.SyncedIssue) ([]dao.IssueComment, error)
+}
+
+// providerRepo is a managed repo on another tracker, such as gitlab:group/project.
+type providerRepo struct {
+	provider string
+	project  string
+}
+
+// parseProviderRepo splits a prefixed managed repo. ok is false for GitHub repos, which carry no
+// prefix; GitHub owners cannot contain a colon.
+func parseProviderRepo(repo string) (providerRepo, bool, error) {
+	prefix, project, found := strings.Cut(strings.TrimSpace(repo), ":")
+	if !found {
+		return providerRepo{}, false, nil
+	}
+	prefix = strings.ToLower(prefix)
+	segments := strings.Split(project, "/")
+	for _, segment := range segments {
+		if segment == "" || strings.ContainsAny(segment, "?*[") {
+			return providerRepo{}, true, fmt.Errorf("invalid repo %q, expect gitlab:group/project or gitea:host/owner/repo", repo)
+		}
+	}
+	switch prefix {
+	case IssueProviderGitLab:
+		if len(segments) < 2 {
+			return providerRepo{}, true, fmt.Errorf("invalid repo %q, expect gitlab:group/project", repo)
+		}
+	case IssueProviderGitea:
+		if len(segments) != 3 {
+			return providerRepo{}, true, fmt.Errorf("invalid repo %q, expect gitea:host/owner/repo", repo)
+		}
+	default:
+		return providerRepo{}, true, fmt.Errorf("invalid repo %q: unknown issue provider %q", repo, prefix)
+	}
+	return providerRepo{provider: prefix, project: project}, true, nil
+}
+
+// RegisterIssueProvider syncs the managed repos prefixed with name through provider. It is meant to
+// be called at startup, before the first run.
+func (s *IssueSyncService) RegisterIssueProvider(name string, provider IssueProvider) {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	if s.providers == nil {
+		s.providers = make(map[string]IssueProvider)
+	}
+	s.providers[strings.ToLower(name)] = provider
+}
+
+func (s *IssueSyncService) issueProvider(target providerRepo) (IssueProvider, error) {
+	s.cfgMu.RLock()
+	defer s.cfgMu.RUnlock()
+	provider, ok := s.providers[target.provider]
+	if !ok {
+		return nil, fmt.Errorf("no issue provider is registered for %s", target.provider)
+	}
+	return provider, nil
+}
+
+// validateSyncRepo reports whether repo can be synced: owner/repo on GitHub, or a prefixed repo
+// whose provider is registered.
+func (s *IssueSyncService) validateSyncRepo(repo string) error {
+	target, ok, err := parseProviderRepo(repo)
+	if err != nil {
+		return err
+	}
+	if ok {
+		_, err := s.issueProvider(target)
+		return err
+	}
+	_, _, err = splitRepo(repo)
+	return err
+}
+
+// syncProviderRepo is the issue pass of a repo on another tracker. It follows syncRepoIssues
+// without the GitHub-only stages: validators, pull request details, timelines and the catalog.
+func (s *IssueSyncService) syncProviderRepo(ctx context.Context, cfg conf.GitHubSyncConfig, repo string, target providerRepo, policy dao.RepoSyncPolicy, run repoSyncRun) SyncRepoResult {
+	result := SyncRepoResult{Repo: repo}
+	cp, err := s.store.GetRepoCheckpoint(ctx, repo)
+	if err != nil {
+		result.Err = err.Error()
+		return result
+	}
+	fail := func(err error) SyncRepoResult {
+		result.Err = err.Error()
+		_ = s.store.SaveRepoCheckpoint(ctx, dao.Checkpoint{
+			Repo:               repo,
+			LastSyncedAt:       time.Now(),
+			LastIssueUpdatedAt: cp.LastIssueUpdatedAt,
+			LastRunStatus:      "failed",
+			LastError:          result.Err,
+		})
+		return result
+	}
+	provider, err := s.issueProvider(target)
+	if err != nil {
+		return fail(err)
+	}
+
+	maxSeenUpdate := cp.LastIssueUpdatedAt
+	since := cp.LastIssueUpdatedAt
+	if run.since != nil {
+		since = *run.since
+	}
+	if policy.SyncSince != nil && policy.SyncSince.After(since) {
+		since = *policy.SyncSince
+	}
+	issueSyncLogger.Info("issue sync repo started",
+		"repo", repo,
+		"provider", target.provider,
+		"timeout_seconds", cfg.RequestTimeoutSeconds,
+		"page_size", cfg.PageSize,
+		"max_pages_per_run", cfg.MaxPagesPerRun,
+		"last_issue_updated_at", cp.LastIssueUpdatedAt,
+		"since", since,
+		"state", issueListState(policy),
+		"unbounded", run.unbounded,
+	)
+	var pages int32
+	var changed []dao.SyncedIssue
+	for currentPage := 1; currentPage != 0; {
+		pageStartedAt := time.Now()
+		requestCtx, cancel := requestTimeout(ctx, cfg)
+		listing, err := provider.ListIssues(requestCtx, target.project, IssueListOptions{
+			State:   issueListState(policy),
+			Since:   since,
+			Page:    currentPage,
+			PerPage: cfg.PageSize,
+		})
+		cancel()
+		if err != nil {
+			issueSyncLogger.Error("issue sync list issues failed",
+				"repo", repo,
+				"page", currentPage,
+				"duration_ms", time.Since(pageStartedAt).Milliseconds(),
+				"error", err,
+			)
+			return fail(err)
+		}
+		if len(listing.Issues) == 0 {
+			currentPage = listing.NextPage
+			continue
+		}
+
+		normalized := make([]dao.SyncedIssue, 0, len(listing.Issues))
+		for _, record := range listing.Issues {
+			record.Repo = repo
+			if record.UpdatedAt.After(maxSeenUpdate) {
+				maxSeenUpdate = record.UpdatedAt
+			}
+			if policySkipsIssue(policy, record) {
+				continue
+			}
+			normalized = append(normalized, record)
+			result.Fetched++
+		}
+		persisted, err := s.store.UpsertIssues(ctx, repo, normalized)
+		if err != nil {
+			issueSyncLogger.Error("issue sync persist failed",
+				"repo", repo,
+				"page", currentPage,
+				"issue_count", len(normalized),
+				"error", err,
+			)
+			return fail(err)
+		}
+		result.Persisted += int32(persisted)
+		changed = append(changed, normalized...)
+		issueSyncLogger.Info("issue sync page persisted",
+			"repo", repo,
+			"page", currentPage,
+			"issue_count", len(normalized),
+			"persisted", persisted,
+			"duration_ms", time.Since(pageStartedAt).Milliseconds(),
+			"next_page", listing.NextPage,
+		)
+		pages++
+		if run.progress != nil {
+			run.progress(pages, result)
+		}
+
+		currentPage = listing.NextPage
+		// Without update order the latest update seen is only a safe cursor once the listing is read
+		// to the end, so the page bound does not apply.
+		if !run.unbounded && listing.UpdateOrdered && int(pages) >= cfg.MaxPagesPerRun {
+			break
+		}
+	}
+
+	if s.commentStore != nil && !policy.SkipComments {
+		for i := range changed {
+			if err := s.syncProviderComments(ctx, cfg, provider, target, &changed[i]); err != nil {
+				logIssueCommentFailure(repo, changed[i], err)
+			}
+		}
+	}
+
+	_ = s.store.SaveRepoCheckpoint(ctx, dao.Checkpoint{
+		Repo:               repo,
+		LastSyncedAt:       time.Now(),
+		LastIssueUpdatedAt: maxSeenUpdate,
+		LastRunStatus:      "success",
+		LastError:          "",
+	})
+	return result
+}
+
+// syncProviderComments stores the comment thread of a changed issue. Neither tracker offers
+// conditional requests or a repo-wide comment cursor, so the thread is read again in full.
+func (s *IssueSyncService) syncProviderComments(ctx context.Context, cfg conf.GitHubSyncConfig, provider IssueProvider, target providerRepo, issue *dao.SyncedIssue) error {
+	comments := make([]dao.IssueComment, 0)
+	if issue.Comments > 0 {
+		requestCtx, cancel := requestTimeout(ctx, cfg)
+		listed, err := provider.ListComments(requestCtx, target.project, *issue)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("list issue comments for %s#%d: %w", issue.Repo, issue.Number, err)
+		}
+		comments = listed
+	} else if stored, err := s.commentStore.LoadComments(ctx, issue.Repo, issue.IssueID, issue.Number); err != nil || len(stored) == 0 {
+		// Nothing stored and nothing to store.
+		return nil
+	}
+
+	saveCtx, cancel := requestTimeout(ctx, cfg)
+	defer cancel()
+	if err := s.commentStore.SaveComments(saveCtx, issue.Repo, issue.IssueID, issue.Number, comments); err != nil {
+		return fmt.Errorf("save issue comments for %s#%d: %w", issue.Repo, issue.Number, err)
+	}
+	issueSyncLogger.Info("issue sync comments stored",
+		"repo", issue.Repo,
+		"issue_number", issue.Number,
+		"issue_id", issue.IssueID,
+		"comment_count", len(comments),
+	)
+	return nil
+}
+
+// getProviderJSON sends an authenticated GET and decodes the JSON response into out.
+func getProviderJSON(ctx context.Context, client *http.Client, endpoint string, authorize func(*http.Request), out any) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	authorize(req)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("get %s: unexpected status %d: %s", req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", req.URL.Path, err)
+	}
+	return resp.Header, nil
+}
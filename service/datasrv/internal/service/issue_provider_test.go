@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+func TestParseRepoRuleProviderRepos(t *testing.T) {
+	for _, raw := range []string{"gitlab:group/project", "gitlab:group/sub/project", "gitea:git.example.com/owner/repo", "gitea:git.example.com:3000/owner/repo"} {
+		rule, err := parseRepoRule(raw)
+		if err != nil {
+			t.Fatalf("parseRepoRule(%q) error = %v", raw, err)
+		}
+		if !rule.explicit || rule.owner+"/"+rule.name != raw {
+			t.Fatalf("parseRepoRule(%q) = %+v, want an explicit rule claiming the entry", raw, rule)
+		}
+	}
+	for _, raw := range []string{"gitlab:project", "gitlab:group//project", "gitea:owner/repo", "gitea:host/owner/*", "bitbucket:owner/repo"} {
+		if _, err := parseRepoRule(raw); !errors.Is(err, ErrInvalidRepoRule) {
+			t.Fatalf("parseRepoRule(%q) error = %v, want ErrInvalidRepoRule", raw, err)
+		}
+	}
+}
+
+func TestRunSyncGitLabProject(t *testing.T) {
+	updated := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	since := updated.Add(-24 * time.Hour)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != "glpat" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Fsub%2Fproject/issues":
+			q := r.URL.Query()
+			if q.Get("order_by") != "updated_at" || q.Get("sort") != "asc" || q.Get("updated_after") != since.Format(time.RFC3339) {
+				http.Error(w, "unexpected query "+r.URL.RawQuery, http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `[{"id":9001,"iid":7,"title":"Broken build","description":"details","state":"closed",
+				"author":{"username":"alice"},"assignees":[{"username":"bob"}],"labels":["bug"],"milestone":{"iid":2},
+				"user_notes_count":1,"web_url":"https://gitlab.example/group/sub/project/-/issues/7",
+				"created_at":"2026-02-01T10:00:00Z","updated_at":%q,"closed_at":%q}]`, updated.Format(time.RFC3339), updated.Format(time.RFC3339))
+		case "/api/v4/projects/group%2Fsub%2Fproject/issues/7/notes":
+			fmt.Fprint(w, `[{"id":1,"body":"added ~bug label","system":true,"author":{"username":"alice"}},
+				{"id":2,"body":"fixed in main","author":{"username":"bob","web_url":"https://gitlab.example/bob"},
+				"created_at":"2026-03-01T09:00:00Z","updated_at":"2026-03-01T09:00:00Z"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	repo := "gitlab:group/sub/project"
+	store := newFakeSyncStore()
+	store.checkpoints[repo] = dao.Checkpoint{Repo: repo, LastIssueUpdatedAt: since}
+	commentStore := newFakeIssueCommentStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{repo},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.RegisterIssueProvider(IssueProviderGitLab, NewGitLabIssueProvider(conf.GitLabConfig{BaseURL: srv.URL, Token: "glpat"}))
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Persisted != 1 {
+		t.Fatalf("result = %#v, want one issue persisted", res)
+	}
+	got := store.issues[repo]
+	if len(got) != 1 {
+		t.Fatalf("stored issues = %#v, want one", got)
+	}
+	issue := got[0]
+	if issue.IssueID != 9001 || issue.Number != 7 || issue.State != "closed" || issue.Author != "alice" ||
+		issue.Milestone != 2 || issue.Comments != 1 || issue.ClosedAt == nil || len(issue.Labels) != 1 || issue.Raw == "" {
+		t.Fatalf("stored issue = %#v", issue)
+	}
+	comments := commentStore.saved[repo+"/9001-7.json"]
+	if len(comments) != 1 || comments[0].ID != 2 || comments[0].UserLogin != "bob" ||
+		comments[0].HTMLURL != "https://gitlab.example/group/sub/project/-/issues/7#note_2" {
+		t.Fatalf("stored comments = %#v, want the one user note", comments)
+	}
+	if cp := store.checkpoints[repo]; cp.LastRunStatus != "success" || !cp.LastIssueUpdatedAt.Equal(updated) {
+		t.Fatalf("checkpoint = %#v, want success at %v", cp, updated)
+	}
+}
+
+func TestRunSyncGiteaRepoReadsEveryPage(t *testing.T) {
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token gt" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/repos/owner/repo/issues":
+			if r.URL.Query().Get("type") != "issues" {
+				http.Error(w, "unexpected query "+r.URL.RawQuery, http.StatusBadRequest)
+				return
+			}
+			// Gitea lists newest first; the newer issue comes on the first page.
+			if r.URL.Query().Get("page") == "1" {
+				w.Header().Set("Link", fmt.Sprintf(`<%s/api/v1/repos/owner/repo/issues?page=2&limit=1>; rel="next", <%s/api/v1/repos/owner/repo/issues?page=2&limit=1>; rel="last"`, srvURL, srvURL))
+				fmt.Fprint(w, `[{"id":22,"number":2,"title":"second","state":"open","user":{"login":"carol"},"comments":0,"updated_at":"2026-03-02T00:00:00Z"}]`)
+				return
+			}
+			fmt.Fprint(w, `[{"id":11,"number":1,"title":"first","state":"closed","user":{"login":"dave"},"labels":[{"name":"infra"}],"comments":1,"updated_at":"2026-03-01T00:00:00Z"},
+				{"id":12,"number":3,"title":"a pull","state":"open","pull_request":{"merged":false},"updated_at":"2026-03-01T00:00:00Z"}]`)
+		case "/api/v1/repos/owner/repo/issues/1/comments":
+			fmt.Fprint(w, `[{"id":101,"body":"done","user":{"login":"carol"},"html_url":"https://git.example.com/owner/repo/issues/1#issuecomment-101"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	repo := "gitea:git.example.com/owner/repo"
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{repo},
+		PageSize:              1,
+		MaxPagesPerRun:        1,
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.RegisterIssueProvider(IssueProviderGitea, NewGiteaIssueProvider(conf.GiteaConfig{
+		Hosts: map[string]conf.GiteaHostConfig{"git.example.com": {BaseURL: srv.URL, Token: "gt"}},
+	}))
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Persisted != 2 {
+		t.Fatalf("result = %#v, want both issues persisted past the page bound", res)
+	}
+	for _, issue := range store.issues[repo] {
+		if issue.IsPullRequest || issue.Number == 3 {
+			t.Fatalf("stored issues = %#v, want the pull request left out", store.issues[repo])
+		}
+	}
+	if got := commentStore.saved[repo+"/11-1.json"]; len(got) != 1 || got[0].ID != 101 {
+		t.Fatalf("issue 1 comments = %#v", got)
+	}
+	if _, ok := commentStore.saved[repo+"/22-2.json"]; ok {
+		t.Fatalf("an empty thread was stored for an issue without comments")
+	}
+	want := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	if cp := store.checkpoints[repo]; cp.LastRunStatus != "success" || !cp.LastIssueUpdatedAt.Equal(want) {
+		t.Fatalf("checkpoint = %#v, want success at %v", cp, want)
+	}
+}
+
+func TestGiteaIssueMilestoneSkipsOverflowingIDs(t *testing.T) {
+	var issue giteaIssue
+	if err := json.Unmarshal([]byte(`{"id":1,"number":1,"milestone":{"id":42}}`), &issue); err != nil {
+		t.Fatalf("unmarshal issue: %v", err)
+	}
+	if got := issue.toSyncedIssue("").Milestone; got != 42 {
+		t.Fatalf("milestone = %d, want 42", got)
+	}
+	issue.Milestone.ID = math.MaxInt32 + 1
+	if got := issue.toSyncedIssue("").Milestone; got != 0 {
+		t.Fatalf("milestone past int32 = %d, want 0", got)
+	}
+}
+
+func TestRunSyncProviderRepoWithoutProvider(t *testing.T) {
+	repo := "gitlab:group/project"
+	store := newFakeSyncStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{repo},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err == "" {
+		t.Fatalf("result = %#v, want an error for the unregistered provider", res)
+	}
+	if cp := store.checkpoints[repo]; cp.LastRunStatus != "failed" {
+		t.Fatalf("checkpoint = %#v, want failed", cp)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,10 +23,18 @@ type IssueQueryGRPCServer struct {
 	issuesv1.UnimplementedIssueQueryServiceServer
 	store        dao.SyncStore
 	commentStore IssueCommentStore
-	cacheTTL     time.Duration
-	mu           sync.RWMutex
-	listCache    map[string]cachedListIssuesResponse
-	detailCache  map[string]cachedGetIssueResponse
+	pullRequests dao.PullRequestStore
+	events       dao.IssueEventStore
+	catalog      dao.RepoCatalogStore
+	// catalogCounts is nil when the store can't count issues per label and milestone itself.
+	catalogCounts dao.RepoCatalogCountStore
+	search        dao.IssueSearchStore
+	// responsiveness is nil when the store keeps no responsiveness metrics.
+	responsiveness dao.IssueResponsivenessStore
+	cacheTTL       time.Duration
+	mu             sync.RWMutex
+	listCache      map[string]cachedListIssuesResponse
+	detailCache    map[string]cachedGetIssueResponse
 }
 
 type cachedListIssuesResponse struct {
@@ -40,13 +50,32 @@ type cachedGetIssueResponse struct {
 const defaultIssueQueryCacheTTL = 15 * time.Second
 
 func NewIssueQueryGRPCServer(store dao.SyncStore, commentStore IssueCommentStore) *IssueQueryGRPCServer {
-	return &IssueQueryGRPCServer{
+	s := &IssueQueryGRPCServer{
 		store:        store,
 		commentStore: commentStore,
 		cacheTTL:     defaultIssueQueryCacheTTL,
 		listCache:    make(map[string]cachedListIssuesResponse),
 		detailCache:  make(map[string]cachedGetIssueResponse),
 	}
+	if prStore, ok := store.(dao.PullRequestStore); ok {
+		s.pullRequests = prStore
+	}
+	if events, ok := store.(dao.IssueEventStore); ok {
+		s.events = events
+	}
+	if catalog, ok := store.(dao.RepoCatalogStore); ok {
+		s.catalog = catalog
+	}
+	if catalogCounts, ok := store.(dao.RepoCatalogCountStore); ok {
+		s.catalogCounts = catalogCounts
+	}
+	if search, ok := store.(dao.IssueSearchStore); ok {
+		s.search = search
+	}
+	if responsiveness, ok := store.(dao.IssueResponsivenessStore); ok {
+		s.responsiveness = responsiveness
+	}
+	return s
 }
 
 func (s *IssueQueryGRPCServer) ListIssues(ctx context.Context, req *issuesv1.ListIssuesRequest) (*issuesv1.ListIssuesResponse, error) {
@@ -54,6 +83,7 @@ func (s *IssueQueryGRPCServer) ListIssues(ctx context.Context, req *issuesv1.Lis
 		"operation", "list_issues",
 		"repo", req.GetRepo(),
 		"state", req.GetState(),
+		"include_tombstoned", req.GetIncludeTombstoned(),
 	)
 	page := req.GetPage()
 	if page <= 0 {
@@ -68,22 +98,37 @@ func (s *IssueQueryGRPCServer) ListIssues(ctx context.Context, req *issuesv1.Lis
 		pageSize = 100
 	}
 	logger = logger.With("page", page, "page_size", pageSize)
+	filter, err := listIssuesFilter(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "list issues: %v", err)
+	}
+	if req.GetPageToken() != "" {
+		if filter.After, err = decodeIssuePageToken(req.GetPageToken(), filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "list issues: %v", err)
+		}
+		page = 0
+	}
+	var counter dao.IssueCountStore
+	if req.GetIncludeTotal() {
+		var ok bool
+		if counter, ok = s.store.(dao.IssueCountStore); !ok {
+			return nil, status.Error(codes.Unimplemented, "issue totals are not supported by the configured store")
+		}
+	}
 
-	cacheKey := buildListIssuesCacheKey(req.GetRepo(), req.GetState(), page, pageSize)
+	cacheKey := buildListIssuesCacheKey(req, page, pageSize)
 	if cached, ok := s.getCachedListIssues(cacheKey, logger); ok {
 		logger.Debug("issue query list cache hit")
 		return cached, nil
 	}
 	logger.Debug("issue query list cache miss")
 
-	offset := int((page - 1) * pageSize)
-	logger.Debug("issue query list loading from store", "offset", offset, "limit", pageSize+1)
-	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{
-		Repo:   req.GetRepo(),
-		State:  req.GetState(),
-		Offset: offset,
-		Limit:  int(pageSize + 1),
-	})
+	if filter.After == nil {
+		filter.Offset = int((page - 1) * pageSize)
+	}
+	filter.Limit = int(pageSize + 1)
+	logger.Debug("issue query list loading from store", "offset", filter.Offset, "keyset", filter.After != nil, "limit", filter.Limit)
+	rows, err := s.store.ListIssues(ctx, filter)
 	if err != nil {
 		logger.Error("issue query list store query failed", "error", err)
 		return nil, status.Errorf(codes.Internal, "list issues: %v", err)
@@ -99,6 +144,8 @@ func (s *IssueQueryGRPCServer) ListIssues(ctx context.Context, req *issuesv1.Lis
 	for _, row := range rows {
 		issues = append(issues, toProtoIssue(row))
 	}
+	s.attachPullRequests(ctx, rows, issues, logger)
+	s.attachCatalog(ctx, rows, issues, logger)
 
 	resp := &issuesv1.ListIssuesResponse{
 		Issues:   issues,
@@ -106,21 +153,100 @@ func (s *IssueQueryGRPCServer) ListIssues(ctx context.Context, req *issuesv1.Lis
 		PageSize: pageSize,
 		HasNext:  hasNext,
 	}
+	if hasNext {
+		resp.NextPageToken = encodeIssuePageToken(filter, rows[len(rows)-1])
+	}
+	if counter != nil {
+		if resp.Total, err = counter.CountIssues(ctx, filter); err != nil {
+			logger.Error("issue query list count failed", "error", err)
+			return nil, status.Errorf(codes.Internal, "count issues: %v", err)
+		}
+	}
 	s.setCachedListIssues(cacheKey, resp)
 	logger.Debug("issue query list completed", "issue_count", len(resp.GetIssues()), "has_next", hasNext)
 	return resp, nil
 }
 
+// SearchIssues runs a full-text search over titles, bodies, AI summaries and comments. Results
+// are not cached, as queries rarely repeat.
+func (s *IssueQueryGRPCServer) SearchIssues(ctx context.Context, req *issuesv1.SearchIssuesRequest) (*issuesv1.SearchIssuesResponse, error) {
+	logger := issueQueryLogger(ctx).With("operation", "search_issues", "include_tombstoned", req.GetIncludeTombstoned())
+	if s.search == nil {
+		return nil, status.Error(codes.Unimplemented, "issue search is not supported by the configured store")
+	}
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	query, err := parseIssueSearchQuery(req.GetQuery())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "query: %v", err)
+	}
+
+	page := req.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	logger = logger.With("page", page, "page_size", pageSize)
+	query.IncludeTombstoned = req.GetIncludeTombstoned()
+	query.Offset = int((page - 1) * pageSize)
+	query.Limit = int(pageSize + 1)
+
+	hits, err := s.search.SearchIssues(ctx, query)
+	if err != nil {
+		logger.Error("issue query search failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "search issues: %v", err)
+	}
+
+	hasNext := false
+	if len(hits) > int(pageSize) {
+		hasNext = true
+		hits = hits[:pageSize]
+	}
+
+	rows := make([]dao.SyncedIssue, 0, len(hits))
+	issues := make([]*issuesv1.Issue, 0, len(hits))
+	for _, hit := range hits {
+		rows = append(rows, hit.Issue)
+		issues = append(issues, toProtoIssue(hit.Issue))
+	}
+	s.attachPullRequests(ctx, rows, issues, logger)
+	s.attachCatalog(ctx, rows, issues, logger)
+
+	out := make([]*issuesv1.IssueSearchHit, 0, len(hits))
+	for i, hit := range hits {
+		out = append(out, &issuesv1.IssueSearchHit{
+			Issue:          issues[i],
+			Score:          hit.Score,
+			TitleHighlight: hit.TitleHighlight,
+			Snippet:        hit.Snippet,
+		})
+	}
+	logger.Debug("issue query search completed", "hit_count", len(out), "has_next", hasNext)
+	return &issuesv1.SearchIssuesResponse{
+		Hits:     out,
+		Page:     page,
+		PageSize: pageSize,
+		HasNext:  hasNext,
+	}, nil
+}
+
 func (s *IssueQueryGRPCServer) GetIssue(ctx context.Context, req *issuesv1.GetIssueRequest) (*issuesv1.GetIssueResponse, error) {
 	logger := issueQueryLogger(ctx).With("operation", "get_issue", "repo", req.GetRepo())
-	cacheKey := buildGetIssueCacheKey(req.GetRepo(), req.GetIssueId(), req.GetNumber())
+	cacheKey := buildGetIssueCacheKey(req.GetRepo(), req.GetIssueId(), req.GetNumber(), req.GetIncludeTombstoned())
 	if cached, ok := s.getCachedGetIssue(cacheKey, logger); ok {
 		logger.Debug("issue query detail cache hit", "issue_id", req.GetIssueId(), "number", req.GetNumber())
 		return cached, nil
 	}
 	logger.Debug("issue query detail cache miss", "issue_id", req.GetIssueId(), "number", req.GetNumber())
 
-	filter := dao.SyncIssueFilter{Repo: req.GetRepo(), Limit: 1}
+	filter := dao.SyncIssueFilter{Repo: req.GetRepo(), Limit: 1, IncludeTombstoned: req.GetIncludeTombstoned()}
 	switch {
 	case req.GetIssueId() > 0:
 		filter.IssueID = req.GetIssueId()
@@ -147,6 +273,8 @@ func (s *IssueQueryGRPCServer) GetIssue(ctx context.Context, req *issuesv1.GetIs
 	}
 
 	issue := toProtoIssue(rows[0])
+	s.attachPullRequests(ctx, rows[:1], []*issuesv1.Issue{issue}, logger)
+	s.attachCatalog(ctx, rows[:1], []*issuesv1.Issue{issue}, logger)
 	if s.commentStore != nil && rows[0].Comments > 0 {
 		logger.Debug("issue query detail loading comments", "comment_count", rows[0].Comments)
 		comments, err := s.commentStore.LoadComments(ctx, rows[0].Repo, rows[0].IssueID, rows[0].Number)
@@ -164,12 +292,322 @@ func (s *IssueQueryGRPCServer) GetIssue(ctx context.Context, req *issuesv1.GetIs
 	return resp, nil
 }
 
-func buildListIssuesCacheKey(repo, state string, page, pageSize int32) string {
-	return fmt.Sprintf("repo=%s|state=%s|page=%d|page_size=%d", repo, state, page, pageSize)
+func (s *IssueQueryGRPCServer) ListIssueEvents(ctx context.Context, req *issuesv1.ListIssueEventsRequest) (*issuesv1.ListIssueEventsResponse, error) {
+	logger := issueQueryLogger(ctx).With("operation", "list_issue_events", "repo", req.GetRepo())
+	if s.events == nil {
+		return nil, status.Error(codes.Unimplemented, "issue events are not supported by the configured store")
+	}
+
+	filter := dao.IssueEventFilter{Repo: req.GetRepo(), Events: req.GetEvents()}
+	switch {
+	case req.GetIssueId() > 0:
+		filter.IssueID = req.GetIssueId()
+		logger = logger.With("issue_id", req.GetIssueId())
+	case req.GetNumber() > 0:
+		if req.GetRepo() == "" {
+			return nil, status.Error(codes.InvalidArgument, "repo is required when querying by number")
+		}
+		filter.IssueNumber = req.GetNumber()
+		logger = logger.With("number", req.GetNumber())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either issue_id or number is required")
+	}
+
+	page := req.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	filter.Offset = int((page - 1) * pageSize)
+	filter.Limit = int(pageSize + 1)
+
+	rows, err := s.events.ListIssueEvents(ctx, filter)
+	if err != nil {
+		logger.Error("issue query events store query failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "list issue events: %v", err)
+	}
+
+	hasNext := false
+	if len(rows) > int(pageSize) {
+		hasNext = true
+		rows = rows[:pageSize]
+	}
+
+	events := make([]*issuesv1.IssueEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toProtoIssueEvent(row))
+	}
+	logger.Debug("issue query events completed", "event_count", len(events), "has_next", hasNext)
+	return &issuesv1.ListIssueEventsResponse{
+		Events:   events,
+		Page:     page,
+		PageSize: pageSize,
+		HasNext:  hasNext,
+	}, nil
 }
 
-func buildGetIssueCacheKey(repo string, issueID int64, number int32) string {
-	return fmt.Sprintf("repo=%s|issue_id=%d|number=%d", repo, issueID, number)
+func (s *IssueQueryGRPCServer) ListLabels(ctx context.Context, req *issuesv1.ListLabelsRequest) (*issuesv1.ListLabelsResponse, error) {
+	logger := issueQueryLogger(ctx).With("operation", "list_labels", "repo", req.GetRepo())
+	if s.catalog == nil {
+		return nil, status.Error(codes.Unimplemented, "labels are not supported by the configured store")
+	}
+
+	labels, err := s.catalog.ListRepoLabels(ctx, req.GetRepo())
+	if err != nil {
+		logger.Error("issue query labels store query failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "list labels: %v", err)
+	}
+	counts, err := s.countLabelIssues(ctx, req.GetRepo())
+	if err != nil {
+		logger.Error("issue query label counts failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "count label issues: %v", err)
+	}
+
+	out := make([]*issuesv1.Label, 0, len(labels))
+	for _, label := range labels {
+		item := toProtoLabel(label)
+		item.Repo = label.Repo
+		c := counts[catalogKey(label.Repo, strings.ToLower(label.Name))]
+		item.OpenIssues, item.ClosedIssues = c.open, c.closed
+		out = append(out, item)
+	}
+	logger.Debug("issue query labels completed", "label_count", len(out))
+	return &issuesv1.ListLabelsResponse{Labels: out}, nil
+}
+
+func (s *IssueQueryGRPCServer) ListMilestones(ctx context.Context, req *issuesv1.ListMilestonesRequest) (*issuesv1.ListMilestonesResponse, error) {
+	logger := issueQueryLogger(ctx).With("operation", "list_milestones", "repo", req.GetRepo(), "state", req.GetState())
+	if s.catalog == nil {
+		return nil, status.Error(codes.Unimplemented, "milestones are not supported by the configured store")
+	}
+
+	milestones, err := s.catalog.ListRepoMilestones(ctx, req.GetRepo())
+	if err != nil {
+		logger.Error("issue query milestones store query failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "list milestones: %v", err)
+	}
+	counts, err := s.countMilestoneIssues(ctx, req.GetRepo())
+	if err != nil {
+		logger.Error("issue query milestone counts failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "count milestone issues: %v", err)
+	}
+
+	out := make([]*issuesv1.Milestone, 0, len(milestones))
+	for _, milestone := range milestones {
+		if req.GetState() != "" && req.GetState() != "all" && milestone.State != req.GetState() {
+			continue
+		}
+		item := toProtoMilestone(milestone)
+		item.Repo = milestone.Repo
+		c := counts[catalogKey(milestone.Repo, strconv.Itoa(int(milestone.Number)))]
+		item.OpenIssues, item.ClosedIssues = c.open, c.closed
+		out = append(out, item)
+	}
+	logger.Debug("issue query milestones completed", "milestone_count", len(out))
+	return &issuesv1.ListMilestonesResponse{Milestones: out}, nil
+}
+
+func (s *IssueQueryGRPCServer) GetIssueResponsiveness(ctx context.Context, req *issuesv1.GetIssueResponsivenessRequest) (*issuesv1.GetIssueResponsivenessResponse, error) {
+	logger := issueQueryLogger(ctx).With("operation", "get_issue_responsiveness", "repos", req.GetRepo(), "group_by", req.GetGroupBy())
+	if s.responsiveness == nil {
+		return nil, status.Error(codes.Unimplemented, "issue responsiveness is not supported by the configured store")
+	}
+
+	groupBy := dao.IssueDimension(req.GetGroupBy())
+	switch groupBy {
+	case "":
+		groupBy = dao.IssueDimensionRepo
+	case dao.IssueDimensionRepo, dao.IssueDimensionLabel, dao.IssueDimensionMonth:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "group_by must be repo, label or month, got %q", req.GetGroupBy())
+	}
+	filter := dao.IssueAnalyticsFilter{Repos: req.GetRepo()}
+	if req.GetFrom() != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+	if err := validateIssueAnalyticsRange(filter); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rows, err := s.responsiveness.IssueResponsivenessStats(ctx, filter, groupBy)
+	if err != nil {
+		logger.Error("issue query responsiveness store query failed", "error", err)
+		return nil, status.Errorf(codes.Internal, "issue responsiveness: %v", err)
+	}
+	groups := make([]*issuesv1.IssueResponsivenessGroup, 0, len(rows))
+	for _, row := range rows {
+		groups = append(groups, toProtoIssueResponsivenessGroup(row))
+	}
+	logger.Debug("issue query responsiveness completed", "group_count", len(groups))
+	return &issuesv1.GetIssueResponsivenessResponse{Groups: groups, GroupBy: string(groupBy)}, nil
+}
+
+type issueStateCount struct {
+	open   int32
+	closed int32
+}
+
+// countLabelIssues tallies stored issues per repo and lower-cased label, keyed by catalogKey.
+// Stores without catalog counts fall back to counting over ListIssues.
+func (s *IssueQueryGRPCServer) countLabelIssues(ctx context.Context, repo string) (map[string]issueStateCount, error) {
+	counts := make(map[string]issueStateCount)
+	if s.catalogCounts != nil {
+		rows, err := s.catalogCounts.CountLabelIssues(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			counts[catalogKey(row.Repo, row.Name)] = issueStateCount{open: int32(row.Open), closed: int32(row.Closed)}
+		}
+		return counts, nil
+	}
+	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{Repo: repo, State: "all"})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		for _, name := range row.Labels {
+			addIssueStateCount(counts, catalogKey(row.Repo, strings.ToLower(name)), row.State)
+		}
+	}
+	return counts, nil
+}
+
+// countMilestoneIssues tallies stored issues per repo and milestone number, keyed by catalogKey.
+// Stores without catalog counts fall back to counting over ListIssues.
+func (s *IssueQueryGRPCServer) countMilestoneIssues(ctx context.Context, repo string) (map[string]issueStateCount, error) {
+	counts := make(map[string]issueStateCount)
+	if s.catalogCounts != nil {
+		rows, err := s.catalogCounts.CountMilestoneIssues(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			counts[catalogKey(row.Repo, strconv.Itoa(int(row.Number)))] = issueStateCount{open: int32(row.Open), closed: int32(row.Closed)}
+		}
+		return counts, nil
+	}
+	rows, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{Repo: repo, State: "all"})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if row.Milestone > 0 {
+			addIssueStateCount(counts, catalogKey(row.Repo, strconv.Itoa(int(row.Milestone))), row.State)
+		}
+	}
+	return counts, nil
+}
+
+func addIssueStateCount(counts map[string]issueStateCount, key, state string) {
+	c := counts[key]
+	if state == "closed" {
+		c.closed++
+	} else {
+		c.open++
+	}
+	counts[key] = c
+}
+
+func catalogKey(repo, name string) string {
+	return repo + "|" + name
+}
+
+// attachCatalog replaces the name-only labels and number-only milestone of each issue with the
+// catalog entries of its repository. issues must be converted from rows in the same order.
+func (s *IssueQueryGRPCServer) attachCatalog(ctx context.Context, rows []dao.SyncedIssue, issues []*issuesv1.Issue, logger *slog.Logger) {
+	if s.catalog == nil {
+		return
+	}
+	labels := make(map[string]dao.RepoLabel)
+	milestones := make(map[string]dao.RepoMilestone)
+	loaded := make(map[string]struct{})
+	for _, row := range rows {
+		if _, ok := loaded[row.Repo]; ok {
+			continue
+		}
+		loaded[row.Repo] = struct{}{}
+		repoLabels, err := s.catalog.ListRepoLabels(ctx, row.Repo)
+		if err != nil {
+			logger.Warn("issue query labels load failed; continue with label names", "repo", row.Repo, "error", err)
+		}
+		for _, label := range repoLabels {
+			labels[catalogKey(label.Repo, label.Name)] = label
+		}
+		repoMilestones, err := s.catalog.ListRepoMilestones(ctx, row.Repo)
+		if err != nil {
+			logger.Warn("issue query milestones load failed; continue with milestone numbers", "repo", row.Repo, "error", err)
+		}
+		for _, milestone := range repoMilestones {
+			milestones[catalogKey(milestone.Repo, strconv.Itoa(int(milestone.Number)))] = milestone
+		}
+	}
+
+	for i, row := range rows {
+		for j, name := range row.Labels {
+			if label, ok := labels[catalogKey(row.Repo, name)]; ok && j < len(issues[i].Labels) {
+				issues[i].Labels[j] = toProtoLabel(label)
+			}
+		}
+		if row.Milestone > 0 {
+			if milestone, ok := milestones[catalogKey(row.Repo, strconv.Itoa(int(row.Milestone)))]; ok {
+				issues[i].Milestone = toProtoMilestone(milestone)
+			}
+		}
+	}
+}
+
+// attachPullRequests fills pull_request on the pull request rows from the synced details.
+// issues must be converted from rows in the same order.
+func (s *IssueQueryGRPCServer) attachPullRequests(ctx context.Context, rows []dao.SyncedIssue, issues []*issuesv1.Issue, logger *slog.Logger) {
+	if s.pullRequests == nil {
+		return
+	}
+	var ids []int64
+	for _, row := range rows {
+		if row.IsPullRequest {
+			ids = append(ids, row.IssueID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	details, err := s.pullRequests.ListPullRequests(ctx, ids)
+	if err != nil {
+		logger.Warn("issue query pull request details load failed; continue without pull_request", "error", err)
+		return
+	}
+	byID := make(map[int64]dao.PullRequestDetail, len(details))
+	for _, detail := range details {
+		byID[detail.IssueID] = detail
+	}
+	for i, row := range rows {
+		if detail, ok := byID[row.IssueID]; ok {
+			issues[i].PullRequest = toProtoPullRequest(detail)
+		}
+	}
+}
+
+// buildListIssuesCacheKey keys a list by every field of the request, with the page and page size
+// it was served with.
+func buildListIssuesCacheKey(req *issuesv1.ListIssuesRequest, page, pageSize int32) string {
+	keyReq := proto.Clone(req).(*issuesv1.ListIssuesRequest)
+	keyReq.Page, keyReq.PageSize = page, pageSize
+	raw, _ := proto.MarshalOptions{Deterministic: true}.Marshal(keyReq)
+	return string(raw)
+}
+
+func buildGetIssueCacheKey(repo string, issueID int64, number int32, includeTombstoned bool) string {
+	return fmt.Sprintf("repo=%s|issue_id=%d|number=%d|tombstoned=%t", repo, issueID, number, includeTombstoned)
 }
 
 func (s *IssueQueryGRPCServer) getCachedListIssues(key string, logger *slog.Logger) (*issuesv1.ListIssuesResponse, bool) {
@@ -281,6 +719,113 @@ func toProtoIssue(in dao.SyncedIssue) *issuesv1.Issue {
 	if in.ClosedAt != nil {
 		out.ClosedAt = timestamppb.New(*in.ClosedAt)
 	}
+	if in.Milestone > 0 {
+		out.Milestone = &issuesv1.Milestone{Number: in.Milestone}
+	}
+	if in.Tombstone != "" {
+		out.Tombstone = in.Tombstone
+		out.TransferredTo = in.TransferredTo
+		if in.TombstonedAt != nil {
+			out.TombstonedAt = timestamppb.New(*in.TombstonedAt)
+		}
+	}
+	return out
+}
+
+func toProtoPullRequest(in dao.PullRequestDetail) *issuesv1.PullRequest {
+	out := &issuesv1.PullRequest{
+		Merged:             in.Merged,
+		MergedBy:           in.MergedBy,
+		Draft:              in.Draft,
+		HeadRef:            in.HeadRef,
+		HeadSha:            in.HeadSHA,
+		BaseRef:            in.BaseRef,
+		Additions:          in.Additions,
+		Deletions:          in.Deletions,
+		ChangedFiles:       in.ChangedFiles,
+		RequestedReviewers: in.RequestedReviewers,
+		RequestedTeams:     in.RequestedTeams,
+		ReviewDecision:     in.ReviewDecision,
+	}
+	if in.MergedAt != nil {
+		out.MergedAt = timestamppb.New(*in.MergedAt)
+	}
+	if !in.SyncedAt.IsZero() {
+		out.SyncedAt = timestamppb.New(in.SyncedAt)
+	}
+	for _, review := range in.Reviews {
+		verdict := &issuesv1.PullRequestReviewVerdict{
+			Reviewer: review.Reviewer,
+			State:    review.State,
+		}
+		if !review.SubmittedAt.IsZero() {
+			verdict.SubmittedAt = timestamppb.New(review.SubmittedAt)
+		}
+		out.Reviews = append(out.Reviews, verdict)
+	}
+	return out
+}
+
+func toProtoLabel(in dao.RepoLabel) *issuesv1.Label {
+	return &issuesv1.Label{
+		Id:          in.LabelID,
+		Name:        in.Name,
+		Color:       in.Color,
+		Description: in.Description,
+	}
+}
+
+func toProtoMilestone(in dao.RepoMilestone) *issuesv1.Milestone {
+	out := &issuesv1.Milestone{
+		Id:          in.MilestoneID,
+		Number:      in.Number,
+		Title:       in.Title,
+		Description: in.Description,
+		State:       in.State,
+	}
+	if in.DueOn != nil {
+		out.DueOn = timestamppb.New(*in.DueOn)
+	}
+	if in.ClosedAt != nil {
+		out.ClosedAt = timestamppb.New(*in.ClosedAt)
+	}
+	return out
+}
+
+func toProtoIssueResponsivenessGroup(in dao.IssueResponsivenessStats) *issuesv1.IssueResponsivenessGroup {
+	return &issuesv1.IssueResponsivenessGroup{
+		Key:                     in.Key,
+		Issues:                  in.Issues,
+		Responded:               in.Responded,
+		FirstResponseP50Seconds: in.FirstResponseP50,
+		FirstResponseP90Seconds: in.FirstResponseP90,
+		Closed:                  in.Closed,
+		CloseP50Seconds:         in.CloseP50,
+		CloseP90Seconds:         in.CloseP90,
+		Reopened:                in.Reopened,
+		Reopens:                 in.Reopens,
+	}
+}
+
+func toProtoIssueEvent(in dao.IssueEvent) *issuesv1.IssueEvent {
+	out := &issuesv1.IssueEvent{
+		Id:          in.Key,
+		Repo:        in.Repo,
+		IssueId:     in.IssueID,
+		IssueNumber: in.IssueNumber,
+		Event:       in.Event,
+		Actor:       in.Actor,
+		Label:       in.Label,
+		Assignee:    in.Assignee,
+		Milestone:   in.Milestone,
+		RenameFrom:  in.RenameFrom,
+		RenameTo:    in.RenameTo,
+		CommitId:    in.CommitID,
+		Source:      in.Source,
+	}
+	if !in.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(in.CreatedAt)
+	}
 	return out
 }
 
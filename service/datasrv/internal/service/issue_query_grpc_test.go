@@ -8,6 +8,8 @@ import (
 
 	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
 	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestIssueQueryGRPCServer_ListIssues(t *testing.T) {
@@ -258,3 +260,224 @@ func TestIssueQueryGRPCServer_GetIssueUsesCache(t *testing.T) {
 		t.Fatalf("comment load calls = %d, want 1 when second call hits cache", commentStore.loadCnt)
 	}
 }
+
+func TestIssueQueryGRPCServer_HidesTombstonedIssues(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC()
+	_, _ = store.UpsertIssues(context.Background(), "o/r", []dao.SyncedIssue{
+		{Repo: "o/r", IssueID: 1, Number: 1, Title: "live", State: "open", UpdatedAt: now},
+		{Repo: "o/r", IssueID: 2, Number: 2, Title: "moved", State: "open", UpdatedAt: now},
+	})
+	_, _ = store.TombstoneIssues(context.Background(), []dao.IssueTombstone{
+		{Repo: "o/r", IssueID: 2, Reason: dao.TombstoneTransferred, TransferredTo: "o/other#7", At: now},
+	})
+
+	srv := NewIssueQueryGRPCServer(store, nil)
+	listed, err := srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", State: "all"})
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	if len(listed.GetIssues()) != 1 || listed.GetIssues()[0].GetNumber() != 1 {
+		t.Fatalf("issues = %v, want only the live issue", listed.GetIssues())
+	}
+	if _, err := srv.GetIssue(context.Background(), &issuesv1.GetIssueRequest{Repo: "o/r", Selector: &issuesv1.GetIssueRequest_Number{Number: 2}}); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetIssue() tombstoned error = %v, want NotFound", err)
+	}
+
+	listed, err = srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", State: "all", IncludeTombstoned: true})
+	if err != nil {
+		t.Fatalf("ListIssues(include_tombstoned) error = %v", err)
+	}
+	if len(listed.GetIssues()) != 2 {
+		t.Fatalf("issues len = %d, want 2", len(listed.GetIssues()))
+	}
+	got, err := srv.GetIssue(context.Background(), &issuesv1.GetIssueRequest{
+		Repo:              "o/r",
+		Selector:          &issuesv1.GetIssueRequest_Number{Number: 2},
+		IncludeTombstoned: true,
+	})
+	if err != nil {
+		t.Fatalf("GetIssue(include_tombstoned) error = %v", err)
+	}
+	if got.GetIssue().GetTombstone() != dao.TombstoneTransferred || got.GetIssue().GetTransferredTo() != "o/other#7" || got.GetIssue().GetTombstonedAt() == nil {
+		t.Fatalf("issue = %v, want transferred tombstone", got.GetIssue())
+	}
+}
+
+func TestIssueQueryGRPCServer_AttachesPullRequestDetails(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC()
+	_, _ = store.UpsertIssues(context.Background(), "o/r", []dao.SyncedIssue{
+		{Repo: "o/r", IssueID: 1, Number: 1, Title: "issue", State: "open", UpdatedAt: now},
+		{Repo: "o/r", IssueID: 2, Number: 2, Title: "pr", State: "open", IsPullRequest: true, UpdatedAt: now},
+	})
+	_, _ = store.UpsertPullRequests(context.Background(), []dao.PullRequestDetail{{
+		Repo:           "o/r",
+		IssueID:        2,
+		Number:         2,
+		Draft:          true,
+		HeadRef:        "feature",
+		BaseRef:        "main",
+		Reviews:        []dao.PullRequestReviewVerdict{{Reviewer: "bob", State: "APPROVED", SubmittedAt: now}},
+		ReviewDecision: dao.ReviewDecisionApproved,
+	}})
+
+	srv := NewIssueQueryGRPCServer(store, nil)
+	listed, err := srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", State: "all"})
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	for _, issue := range listed.GetIssues() {
+		pr := issue.GetPullRequest()
+		switch issue.GetNumber() {
+		case 1:
+			if pr != nil {
+				t.Fatalf("issue #1 pull_request = %v, want nil", pr)
+			}
+		case 2:
+			if !pr.GetDraft() || pr.GetHeadRef() != "feature" || pr.GetReviewDecision() != dao.ReviewDecisionApproved || len(pr.GetReviews()) != 1 {
+				t.Fatalf("issue #2 pull_request = %v", pr)
+			}
+		}
+	}
+
+	got, err := srv.GetIssue(context.Background(), &issuesv1.GetIssueRequest{Repo: "o/r", Selector: &issuesv1.GetIssueRequest_Number{Number: 2}})
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if got.GetIssue().GetPullRequest().GetBaseRef() != "main" {
+		t.Fatalf("pull_request = %v, want base_ref main", got.GetIssue().GetPullRequest())
+	}
+}
+
+func TestIssueQueryGRPCServer_ListIssueEvents(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC()
+	_, _ = store.UpsertIssueEvents(context.Background(), []dao.IssueEvent{
+		{Repo: "o/r", IssueID: 1, IssueNumber: 1, Key: "1", Event: dao.IssueEventLabeled, Actor: "alice", Label: "bug", CreatedAt: now.Add(-2 * time.Hour)},
+		{Repo: "o/r", IssueID: 1, IssueNumber: 1, Key: "2", Event: dao.IssueEventClosed, Actor: "bob", CreatedAt: now.Add(-time.Hour)},
+		{Repo: "o/r", IssueID: 2, IssueNumber: 2, Key: "3", Event: dao.IssueEventClosed, Actor: "carol", CreatedAt: now},
+	})
+
+	srv := NewIssueQueryGRPCServer(store, nil)
+	resp, err := srv.ListIssueEvents(context.Background(), &issuesv1.ListIssueEventsRequest{
+		Repo:     "o/r",
+		Selector: &issuesv1.ListIssueEventsRequest_Number{Number: 1},
+	})
+	if err != nil {
+		t.Fatalf("ListIssueEvents() error = %v", err)
+	}
+	if len(resp.GetEvents()) != 2 || resp.GetEvents()[0].GetLabel() != "bug" || resp.GetEvents()[1].GetActor() != "bob" {
+		t.Fatalf("events = %v, want labeled then closed by bob", resp.GetEvents())
+	}
+
+	closed, err := srv.ListIssueEvents(context.Background(), &issuesv1.ListIssueEventsRequest{
+		Selector: &issuesv1.ListIssueEventsRequest_IssueId{IssueId: 1},
+		Events:   []string{dao.IssueEventClosed},
+	})
+	if err != nil {
+		t.Fatalf("ListIssueEvents(closed) error = %v", err)
+	}
+	if len(closed.GetEvents()) != 1 || closed.GetEvents()[0].GetEvent() != dao.IssueEventClosed {
+		t.Fatalf("events = %v, want only the close", closed.GetEvents())
+	}
+
+	if _, err := srv.ListIssueEvents(context.Background(), &issuesv1.ListIssueEventsRequest{
+		Selector: &issuesv1.ListIssueEventsRequest_Number{Number: 1},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ListIssueEvents() without repo error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestIssueQueryGRPCServer_LabelAndMilestoneCatalog(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC()
+	_, _ = store.UpsertIssues(context.Background(), "o/r", []dao.SyncedIssue{
+		{Repo: "o/r", IssueID: 1, Number: 1, State: "open", Labels: []string{"bug", "unknown"}, Milestone: 3, UpdatedAt: now},
+		{Repo: "o/r", IssueID: 2, Number: 2, State: "closed", Labels: []string{"bug"}, Milestone: 3, UpdatedAt: now},
+	})
+	_ = store.ReplaceRepoLabels(context.Background(), "o/r", []dao.RepoLabel{
+		{Repo: "o/r", LabelID: 10, Name: "bug", Color: "d73a4a", Description: "Something is broken"},
+		{Repo: "o/r", LabelID: 11, Name: "docs", Color: "0075ca"},
+	})
+	_ = store.ReplaceRepoMilestones(context.Background(), "o/r", []dao.RepoMilestone{
+		{Repo: "o/r", MilestoneID: 30, Number: 3, Title: "v1.0", State: "open"},
+		{Repo: "o/r", MilestoneID: 31, Number: 4, Title: "v0.9", State: "closed"},
+	})
+
+	srv := NewIssueQueryGRPCServer(store, nil)
+	got, err := srv.GetIssue(context.Background(), &issuesv1.GetIssueRequest{Repo: "o/r", Selector: &issuesv1.GetIssueRequest_Number{Number: 1}})
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	labels := got.GetIssue().GetLabels()
+	if len(labels) != 2 || labels[0].GetColor() != "d73a4a" || labels[0].GetId() != 10 || labels[1].GetName() != "unknown" {
+		t.Fatalf("labels = %v, want bug from the catalog and unknown by name", labels)
+	}
+	if got.GetIssue().GetMilestone().GetTitle() != "v1.0" {
+		t.Fatalf("milestone = %v, want v1.0", got.GetIssue().GetMilestone())
+	}
+
+	listedLabels, err := srv.ListLabels(context.Background(), &issuesv1.ListLabelsRequest{Repo: "o/r"})
+	if err != nil {
+		t.Fatalf("ListLabels() error = %v", err)
+	}
+	if len(listedLabels.GetLabels()) != 2 {
+		t.Fatalf("labels len = %d, want 2", len(listedLabels.GetLabels()))
+	}
+	bug := listedLabels.GetLabels()[0]
+	if bug.GetName() != "bug" || bug.GetOpenIssues() != 1 || bug.GetClosedIssues() != 1 || bug.GetRepo() != "o/r" {
+		t.Fatalf("bug label = %v, want 1 open and 1 closed", bug)
+	}
+
+	listedMilestones, err := srv.ListMilestones(context.Background(), &issuesv1.ListMilestonesRequest{Repo: "o/r", State: "open"})
+	if err != nil {
+		t.Fatalf("ListMilestones() error = %v", err)
+	}
+	if len(listedMilestones.GetMilestones()) != 1 {
+		t.Fatalf("milestones len = %d, want only the open one", len(listedMilestones.GetMilestones()))
+	}
+	if m := listedMilestones.GetMilestones()[0]; m.GetTitle() != "v1.0" || m.GetOpenIssues() != 1 || m.GetClosedIssues() != 1 {
+		t.Fatalf("milestone = %v, want v1.0 with 1 open and 1 closed", m)
+	}
+}
+
+// catalogCountingStore counts catalog issues itself and fails issue listings, which the counts
+// must not need.
+type catalogCountingStore struct {
+	*fakeSyncStore
+}
+
+func (catalogCountingStore) ListIssues(context.Context, dao.SyncIssueFilter) ([]dao.SyncedIssue, error) {
+	return nil, errors.New("issues listed")
+}
+
+func (catalogCountingStore) CountLabelIssues(_ context.Context, repo string) ([]dao.RepoLabelCount, error) {
+	return []dao.RepoLabelCount{{Repo: repo, Name: "bug", Open: 4, Closed: 2}}, nil
+}
+
+func (catalogCountingStore) CountMilestoneIssues(_ context.Context, repo string) ([]dao.RepoMilestoneCount, error) {
+	return []dao.RepoMilestoneCount{{Repo: repo, Number: 3, Open: 1, Closed: 5}}, nil
+}
+
+func TestIssueQueryGRPCServer_CatalogCountsFromStore(t *testing.T) {
+	store := catalogCountingStore{newFakeSyncStore()}
+	_ = store.ReplaceRepoLabels(context.Background(), "o/r", []dao.RepoLabel{{Repo: "o/r", LabelID: 10, Name: "Bug"}})
+	_ = store.ReplaceRepoMilestones(context.Background(), "o/r", []dao.RepoMilestone{{Repo: "o/r", MilestoneID: 30, Number: 3, Title: "v1.0", State: "open"}})
+	srv := NewIssueQueryGRPCServer(store, nil)
+
+	labels, err := srv.ListLabels(context.Background(), &issuesv1.ListLabelsRequest{Repo: "o/r"})
+	if err != nil {
+		t.Fatalf("ListLabels() error = %v", err)
+	}
+	if got := labels.GetLabels(); len(got) != 1 || got[0].GetOpenIssues() != 4 || got[0].GetClosedIssues() != 2 {
+		t.Fatalf("labels = %v, want Bug with the store counts of bug", got)
+	}
+	milestones, err := srv.ListMilestones(context.Background(), &issuesv1.ListMilestonesRequest{Repo: "o/r"})
+	if err != nil {
+		t.Fatalf("ListMilestones() error = %v", err)
+	}
+	if got := milestones.GetMilestones(); len(got) != 1 || got[0].GetOpenIssues() != 1 || got[0].GetClosedIssues() != 5 {
+		t.Fatalf("milestones = %v, want v1.0 with the store counts", got)
+	}
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	corelog "butterfly.orx.me/core/log"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var issueResponsivenessLogger = slog.Default().With("component", "datasrv.issue_responsiveness")
+
+// defaultMaintainerAssociations are the GitHub author associations of maintainers when none are
+// configured.
+var defaultMaintainerAssociations = []string{"OWNER", "MEMBER", "COLLABORATOR"}
+
+const defaultIssueResponsivenessBatchSize = 100
+
+// IssueResponsivenessService computes the first response time, close time and reopen count of the
+// synced issues, and recomputes them when an issue, its thread or its timeline changes.
+type IssueResponsivenessService struct {
+	metrics      dao.IssueResponsivenessStore
+	commentStore IssueCommentStore
+	// events is nil when the store keeps no issue timelines; reopen counts then stay 0.
+	events dao.IssueEventStore
+	cfg    conf.IssueResponsivenessConfig
+}
+
+type IssueResponsivenessRunSummary struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Scanned    int
+	Updated    int
+	// Skipped counts issues updated or invalidated while their metrics were computed. They stay
+	// stale for the next run.
+	Skipped int
+	Failed  int
+	// Stopped is set when the run reached MaxIssuesPerRun.
+	Stopped bool
+	Errors  []string
+}
+
+// NewIssueResponsivenessService fails when store cannot keep responsiveness metrics. Without a
+// comment store no issue gets a first response.
+func NewIssueResponsivenessService(store dao.SyncStore, commentStore IssueCommentStore, cfg conf.IssueResponsivenessConfig) (*IssueResponsivenessService, error) {
+	metrics, ok := store.(dao.IssueResponsivenessStore)
+	if !ok {
+		return nil, fmt.Errorf("store %T does not implement issue responsiveness store", store)
+	}
+	svc := &IssueResponsivenessService{
+		metrics:      metrics,
+		commentStore: commentStore,
+		cfg:          cfg,
+	}
+	if events, ok := store.(dao.IssueEventStore); ok {
+		svc.events = events
+	}
+	return svc, nil
+}
+
+func (s *IssueResponsivenessService) GetConfig() conf.IssueResponsivenessConfig {
+	return s.cfg
+}
+
+// Run computes the metrics of the issues updated since they were last computed, least recently
+// updated first.
+func (s *IssueResponsivenessService) Run(ctx context.Context) (IssueResponsivenessRunSummary, error) {
+	summary := IssueResponsivenessRunSummary{StartedAt: time.Now().UTC()}
+	logger := corelog.FromContext(ctx).With("component", "datasrv.issue_responsiveness")
+
+	batchSize := s.cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultIssueResponsivenessBatchSize
+	}
+	var after *dao.IssueCursor
+	for {
+		limit := batchSize
+		if s.cfg.MaxIssuesPerRun > 0 {
+			remaining := s.cfg.MaxIssuesPerRun - summary.Scanned
+			if remaining <= 0 {
+				summary.Stopped = true
+				break
+			}
+			limit = min(limit, remaining)
+		}
+		rows, err := s.metrics.ListIssuesWithStaleResponsiveness(ctx, after, limit)
+		if err != nil {
+			summary.FinishedAt = time.Now().UTC()
+			return summary, fmt.Errorf("list issues with stale responsiveness: %w", err)
+		}
+		for _, row := range rows {
+			summary.Scanned++
+			issue := row.Issue
+			saved, err := s.computeIssue(ctx, row)
+			if err != nil {
+				summary.Failed++
+				summary.Errors = append(summary.Errors, fmt.Sprintf("%s#%d: %v", issue.Repo, issue.Number, err))
+				logger.Error("issue responsiveness compute failed", "repo", issue.Repo, "number", issue.Number, "error", err)
+				continue
+			}
+			if !saved {
+				summary.Skipped++
+				continue
+			}
+			summary.Updated++
+		}
+		if len(rows) < limit {
+			break
+		}
+		// Updated issues leave the stale set, failed and skipped ones stay; the cursor moves past all.
+		last := rows[len(rows)-1].Issue
+		after = &dao.IssueCursor{Time: last.UpdatedAt, Repo: last.Repo, IssueID: last.IssueID}
+	}
+	summary.FinishedAt = time.Now().UTC()
+	return summary, nil
+}
+
+// computeIssue reports whether the metrics were stored, which they are not when the issue changed
+// since it was listed.
+func (s *IssueResponsivenessService) computeIssue(ctx context.Context, stale dao.StaleIssue) (bool, error) {
+	issue := stale.Issue
+	var comments []dao.IssueComment
+	if s.commentStore != nil && issue.Comments > 0 {
+		loaded, err := s.commentStore.LoadComments(ctx, issue.Repo, issue.IssueID, issue.Number)
+		// A thread not stored yet is computed again once it is saved.
+		if err != nil && !errors.Is(err, dao.ErrIssueCommentsNotFound) {
+			return false, fmt.Errorf("load comments: %w", err)
+		}
+		comments = loaded
+	}
+	var events []dao.IssueEvent
+	if s.events != nil {
+		loaded, err := s.events.ListIssueEvents(ctx, dao.IssueEventFilter{
+			Repo:    issue.Repo,
+			IssueID: issue.IssueID,
+			Events:  []string{dao.IssueEventReopened},
+		})
+		if err != nil {
+			return false, fmt.Errorf("list reopened events: %w", err)
+		}
+		events = loaded
+	}
+	metrics := computeIssueResponsiveness(issue, comments, events, newIssueMaintainers(s.cfg, issue.Repo))
+	metrics.Generation = stale.Generation
+	saved, err := s.metrics.SetIssueResponsiveness(ctx, issue.Repo, issue.IssueID, metrics)
+	if err != nil {
+		return false, fmt.Errorf("save responsiveness: %w", err)
+	}
+	return saved, nil
+}
+
+// issueMaintainers tells the maintainers of one repo apart, by login or by author association.
+type issueMaintainers struct {
+	logins       map[string]struct{}
+	associations map[string]struct{}
+}
+
+func newIssueMaintainers(cfg conf.IssueResponsivenessConfig, repo string) issueMaintainers {
+	m := issueMaintainers{logins: make(map[string]struct{}), associations: make(map[string]struct{})}
+	for _, key := range []string{"*", repo} {
+		for _, login := range cfg.Maintainers[key] {
+			if login = strings.ToLower(strings.TrimSpace(login)); login != "" {
+				m.logins[login] = struct{}{}
+			}
+		}
+	}
+	associations := cfg.MaintainerAssociations
+	if len(associations) == 0 {
+		associations = defaultMaintainerAssociations
+	}
+	for _, association := range associations {
+		m.associations[strings.ToUpper(strings.TrimSpace(association))] = struct{}{}
+	}
+	return m
+}
+
+func (m issueMaintainers) is(login, association string) bool {
+	if _, ok := m.logins[strings.ToLower(login)]; ok {
+		return true
+	}
+	_, ok := m.associations[strings.ToUpper(association)]
+	return ok && association != ""
+}
+
+// computeIssueResponsiveness derives the metrics of issue from its thread and its reopened events.
+// The first response is the first comment of a maintainer other than the author; issues opened by
+// maintainers get none. The close time runs to the last close.
+func computeIssueResponsiveness(issue dao.SyncedIssue, comments []dao.IssueComment, events []dao.IssueEvent, maintainers issueMaintainers) dao.IssueResponsiveness {
+	out := dao.IssueResponsiveness{SourceUpdatedAt: issue.UpdatedAt}
+	if !maintainers.is(issue.Author, issueAuthorAssociation(issue.Raw)) {
+		comments = slices.Clone(comments)
+		slices.SortStableFunc(comments, func(a, b dao.IssueComment) int { return a.CreatedAt.Compare(b.CreatedAt) })
+		for _, comment := range comments {
+			if strings.EqualFold(comment.UserLogin, issue.Author) || !maintainers.is(comment.UserLogin, comment.AuthorAssociation) {
+				continue
+			}
+			at := comment.CreatedAt.UTC()
+			out.FirstResponseAt = &at
+			out.FirstResponder = comment.UserLogin
+			out.FirstResponseSeconds = issueSecondsSinceCreated(issue, at)
+			break
+		}
+	}
+	if issue.ClosedAt != nil {
+		out.CloseSeconds = issueSecondsSinceCreated(issue, *issue.ClosedAt)
+	}
+	for _, event := range events {
+		if event.Event == dao.IssueEventReopened {
+			out.ReopenCount++
+		}
+	}
+	return out
+}
+
+func issueSecondsSinceCreated(issue dao.SyncedIssue, at time.Time) *int64 {
+	seconds := max(int64(at.Sub(issue.CreatedAt)/time.Second), 0)
+	return &seconds
+}
+
+// issueAuthorAssociation reads the author association GitHub reports in the raw issue. Other
+// hosts have none.
+func issueAuthorAssociation(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	var issue struct {
+		AuthorAssociation string `json:"author_association"`
+	}
+	if err := json.Unmarshal([]byte(raw), &issue); err != nil {
+		return ""
+	}
+	return issue.AuthorAssociation
+}
+
+// withIssueResponsivenessInvalidation makes store mark the metrics of an issue stale whenever its
+// thread is saved, since threads are stored after their issue and do not move its UpdatedAt.
+func withIssueResponsivenessInvalidation(store IssueCommentStore, primary dao.SyncStore) IssueCommentStore {
+	metrics, ok := primary.(dao.IssueResponsivenessStore)
+	if !ok {
+		return store
+	}
+	invalidating := &responsivenessInvalidatingCommentStore{IssueCommentStore: store, metrics: metrics}
+	if versions, ok := store.(IssueCommentVersionStore); ok {
+		return &responsivenessInvalidatingVersionedCommentStore{responsivenessInvalidatingCommentStore: invalidating, versions: versions}
+	}
+	return invalidating
+}
+
+type responsivenessInvalidatingCommentStore struct {
+	IssueCommentStore
+	metrics dao.IssueResponsivenessStore
+}
+
+// SaveComments saves the thread, then invalidates the metrics of its issue. Failures to invalidate
+// are logged rather than returned, as the thread itself was saved.
+func (s *responsivenessInvalidatingCommentStore) SaveComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
+	if err := s.IssueCommentStore.SaveComments(ctx, repo, issueID, issueNumber, comments); err != nil {
+		return err
+	}
+	s.invalidate(ctx, repo, issueID)
+	return nil
+}
+
+func (s *responsivenessInvalidatingCommentStore) invalidate(ctx context.Context, repo string, issueID int64) {
+	if err := s.metrics.InvalidateIssueResponsiveness(ctx, repo, []int64{issueID}); err != nil {
+		issueResponsivenessLogger.Warn("invalidate issue responsiveness failed", "repo", repo, "issue_id", issueID, "error", err)
+	}
+}
+
+type responsivenessInvalidatingVersionedCommentStore struct {
+	*responsivenessInvalidatingCommentStore
+	versions IssueCommentVersionStore
+}
+
+func (s *responsivenessInvalidatingVersionedCommentStore) ListCommentVersions(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueCommentVersion, error) {
+	return s.versions.ListCommentVersions(ctx, repo, issueID, issueNumber)
+}
+
+func (s *responsivenessInvalidatingVersionedCommentStore) RestoreCommentVersion(ctx context.Context, repo string, issueID int64, issueNumber int32, versionID string) ([]dao.IssueComment, error) {
+	comments, err := s.versions.RestoreCommentVersion(ctx, repo, issueID, issueNumber, versionID)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx, repo, issueID)
+	return comments, nil
+}
+
+// issueEventIssueIDs returns the distinct issues of events, in order of first appearance.
+func issueEventIssueIDs(events []dao.IssueEvent) []int64 {
+	seen := make(map[int64]struct{}, len(events))
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		if _, ok := seen[event.IssueID]; ok {
+			continue
+		}
+		seen[event.IssueID] = struct{}{}
+		ids = append(ids, event.IssueID)
+	}
+	return ids
+}
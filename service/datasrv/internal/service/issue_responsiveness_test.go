@@ -0,0 +1,281 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeResponsivenessStore struct {
+	*fakeSyncStore
+	metrics     map[int64]dao.IssueResponsiveness
+	generations map[int64]int64
+	invalidated []int64
+	// beforeSet runs at the start of SetIssueResponsiveness, to change issues after they were listed.
+	beforeSet   func(issueID int64)
+	stats       []dao.IssueResponsivenessStats
+	lastFilter  dao.IssueAnalyticsFilter
+	lastGroupBy dao.IssueDimension
+}
+
+func newFakeResponsivenessStore() *fakeResponsivenessStore {
+	return &fakeResponsivenessStore{fakeSyncStore: newFakeSyncStore(), metrics: map[int64]dao.IssueResponsiveness{}, generations: map[int64]int64{}}
+}
+
+func (f *fakeResponsivenessStore) ListIssuesWithStaleResponsiveness(_ context.Context, after *dao.IssueCursor, limit int) ([]dao.StaleIssue, error) {
+	var stale []dao.StaleIssue
+	for _, issues := range f.issues {
+		for _, issue := range issues {
+			metrics, ok := f.metrics[issue.IssueID]
+			if ok && !metrics.SourceUpdatedAt.Before(issue.UpdatedAt) {
+				continue
+			}
+			if after != nil && compareStaleIssue(issue, *after) <= 0 {
+				continue
+			}
+			stale = append(stale, dao.StaleIssue{Issue: issue, Generation: f.generations[issue.IssueID]})
+		}
+	}
+	slices.SortFunc(stale, func(a, b dao.StaleIssue) int {
+		return compareStaleIssue(a.Issue, dao.NewIssueCursor(dao.IssueSortUpdated, b.Issue))
+	})
+	if limit > 0 && len(stale) > limit {
+		stale = stale[:limit]
+	}
+	return stale, nil
+}
+
+// compareStaleIssue orders issue against cursor like the stores order stale issues.
+func compareStaleIssue(issue dao.SyncedIssue, cursor dao.IssueCursor) int {
+	if c := issue.UpdatedAt.Compare(cursor.Time); c != 0 {
+		return c
+	}
+	if c := strings.Compare(issue.Repo, cursor.Repo); c != 0 {
+		return c
+	}
+	return cmp.Compare(issue.IssueID, cursor.IssueID)
+}
+
+func (f *fakeResponsivenessStore) SetIssueResponsiveness(_ context.Context, repo string, issueID int64, metrics dao.IssueResponsiveness) (bool, error) {
+	if f.beforeSet != nil {
+		f.beforeSet(issueID)
+	}
+	if metrics.Generation != f.generations[issueID] {
+		return false, nil
+	}
+	for _, issue := range f.issues[repo] {
+		if issue.IssueID == issueID && !issue.UpdatedAt.Equal(metrics.SourceUpdatedAt) {
+			return false, nil
+		}
+	}
+	f.metrics[issueID] = metrics
+	return true, nil
+}
+
+func (f *fakeResponsivenessStore) InvalidateIssueResponsiveness(_ context.Context, _ string, issueIDs []int64) error {
+	for _, id := range issueIDs {
+		delete(f.metrics, id)
+		f.generations[id]++
+	}
+	f.invalidated = append(f.invalidated, issueIDs...)
+	return nil
+}
+
+func (f *fakeResponsivenessStore) IssueResponsivenessStats(_ context.Context, filter dao.IssueAnalyticsFilter, groupBy dao.IssueDimension) ([]dao.IssueResponsivenessStats, error) {
+	f.lastFilter = filter
+	f.lastGroupBy = groupBy
+	return f.stats, nil
+}
+
+func TestComputeIssueResponsiveness(t *testing.T) {
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	closed := created.Add(48 * time.Hour)
+	maintainers := newIssueMaintainers(conf.IssueResponsivenessConfig{
+		Maintainers: map[string][]string{"o/r": {"Carol"}},
+	}, "o/r")
+	issue := dao.SyncedIssue{
+		Repo:      "o/r",
+		IssueID:   1,
+		Author:    "alice",
+		CreatedAt: created,
+		UpdatedAt: closed,
+		ClosedAt:  &closed,
+		Raw:       `{"author_association":"NONE"}`,
+	}
+	comments := []dao.IssueComment{
+		{UserLogin: "dave", AuthorAssociation: "MEMBER", CreatedAt: created.Add(3 * time.Hour)},
+		{UserLogin: "alice", AuthorAssociation: "OWNER", CreatedAt: created.Add(time.Hour)},
+		{UserLogin: "bob", AuthorAssociation: "CONTRIBUTOR", CreatedAt: created.Add(90 * time.Minute)},
+		{UserLogin: "carol", CreatedAt: created.Add(2 * time.Hour)},
+	}
+	events := []dao.IssueEvent{{Event: dao.IssueEventReopened}, {Event: dao.IssueEventReopened}}
+
+	got := computeIssueResponsiveness(issue, comments, events, maintainers)
+	if got.FirstResponder != "carol" || got.FirstResponseAt == nil || !got.FirstResponseAt.Equal(created.Add(2*time.Hour)) {
+		t.Fatalf("first response = %q at %v, want carol after two hours", got.FirstResponder, got.FirstResponseAt)
+	}
+	if got.FirstResponseSeconds == nil || *got.FirstResponseSeconds != 7200 {
+		t.Fatalf("first response seconds = %v, want 7200", got.FirstResponseSeconds)
+	}
+	if got.CloseSeconds == nil || *got.CloseSeconds != 48*3600 {
+		t.Fatalf("close seconds = %v, want %d", got.CloseSeconds, 48*3600)
+	}
+	if got.ReopenCount != 2 || !got.SourceUpdatedAt.Equal(closed) {
+		t.Fatalf("reopens = %d, source = %v", got.ReopenCount, got.SourceUpdatedAt)
+	}
+
+	issue.Raw = `{"author_association":"COLLABORATOR"}`
+	issue.ClosedAt = nil
+	got = computeIssueResponsiveness(issue, comments, nil, maintainers)
+	if got.FirstResponseAt != nil || got.FirstResponseSeconds != nil || got.CloseSeconds != nil {
+		t.Fatalf("maintainer issue = %+v, want no first response and open", got)
+	}
+}
+
+func TestIssueResponsivenessServiceRunComputesStaleIssues(t *testing.T) {
+	store := newFakeResponsivenessStore()
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for id := int64(1); id <= 3; id++ {
+		store.issues["o/r"] = append(store.issues["o/r"], dao.SyncedIssue{
+			Repo: "o/r", IssueID: id, Number: int32(id), Author: "alice", Comments: 1,
+			CreatedAt: created, UpdatedAt: created.Add(time.Duration(id) * time.Hour),
+		})
+	}
+	store.events = []dao.IssueEvent{{Repo: "o/r", IssueID: 2, Event: dao.IssueEventReopened}}
+	comments := newFakeIssueCommentStore()
+	_ = comments.SaveComments(context.Background(), "o/r", 1, 1, []dao.IssueComment{
+		{UserLogin: "bob", AuthorAssociation: "MEMBER", CreatedAt: created.Add(30 * time.Minute)},
+	})
+	svc, err := NewIssueResponsivenessService(store, comments, conf.IssueResponsivenessConfig{BatchSize: 2})
+	if err != nil {
+		t.Fatalf("NewIssueResponsivenessService() error = %v", err)
+	}
+
+	summary, err := svc.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if summary.Scanned != 3 || summary.Updated != 3 || summary.Failed != 0 {
+		t.Fatalf("summary = %+v, want 3 issues updated", summary)
+	}
+	if got := store.metrics[1]; got.FirstResponder != "bob" || *got.FirstResponseSeconds != 1800 {
+		t.Fatalf("issue 1 metrics = %+v, want bob after 1800s", got)
+	}
+	if got := store.metrics[2]; got.ReopenCount != 1 || got.FirstResponseAt != nil {
+		t.Fatalf("issue 2 metrics = %+v, want one reopen and no response", got)
+	}
+
+	summary, err = svc.Run(context.Background())
+	if err != nil || summary.Scanned != 0 {
+		t.Fatalf("second Run() = %+v, %v, want nothing stale", summary, err)
+	}
+
+	svc.cfg.MaxIssuesPerRun = 1
+	_ = store.InvalidateIssueResponsiveness(context.Background(), "o/r", []int64{1, 2})
+	summary, err = svc.Run(context.Background())
+	if err != nil || summary.Scanned != 1 || !summary.Stopped {
+		t.Fatalf("capped Run() = %+v, %v, want one issue then stopped", summary, err)
+	}
+
+	if _, err := NewIssueResponsivenessService(newFakeSyncStore(), comments, conf.IssueResponsivenessConfig{}); err == nil {
+		t.Fatal("NewIssueResponsivenessService() without responsiveness store error = nil")
+	}
+}
+
+func TestIssueResponsivenessServiceRunSkipsIssuesInvalidatedWhileComputing(t *testing.T) {
+	store := newFakeResponsivenessStore()
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for id := int64(1); id <= 2; id++ {
+		store.issues["o/r"] = append(store.issues["o/r"], dao.SyncedIssue{
+			Repo: "o/r", IssueID: id, Number: int32(id), Author: "alice",
+			CreatedAt: created, UpdatedAt: created.Add(time.Duration(id) * time.Hour),
+		})
+	}
+	// A thread of issue 1 is saved after the issue was listed, before its metrics are stored.
+	store.beforeSet = func(issueID int64) {
+		if issueID == 1 {
+			store.beforeSet = nil
+			_ = store.InvalidateIssueResponsiveness(context.Background(), "o/r", []int64{1})
+		}
+	}
+	svc, err := NewIssueResponsivenessService(store, nil, conf.IssueResponsivenessConfig{})
+	if err != nil {
+		t.Fatalf("NewIssueResponsivenessService() error = %v", err)
+	}
+
+	summary, err := svc.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if summary.Scanned != 2 || summary.Updated != 1 || summary.Skipped != 1 {
+		t.Fatalf("summary = %+v, want issue 1 skipped and issue 2 updated", summary)
+	}
+	if _, ok := store.metrics[1]; ok {
+		t.Fatal("issue 1 metrics stored despite the invalidation")
+	}
+
+	summary, err = svc.Run(context.Background())
+	if err != nil || summary.Scanned != 1 || summary.Updated != 1 {
+		t.Fatalf("second Run() = %+v, %v, want issue 1 recomputed", summary, err)
+	}
+	if got := store.metrics[1]; got.Generation != 1 {
+		t.Fatalf("issue 1 metrics generation = %d, want 1", got.Generation)
+	}
+}
+
+func TestIssueCommentStoreInvalidatesResponsiveness(t *testing.T) {
+	store := newFakeResponsivenessStore()
+	comments, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Enabled: true, Provider: "fs", Dir: t.TempDir()}, store)
+	if err != nil {
+		t.Fatalf("NewIssueCommentStore() error = %v", err)
+	}
+	if err := comments.SaveComments(context.Background(), "o/r", 7, 7, []dao.IssueComment{{ID: 1, Body: "reply"}}); err != nil {
+		t.Fatalf("SaveComments() error = %v", err)
+	}
+	if !slices.Equal(store.invalidated, []int64{7}) {
+		t.Fatalf("invalidated = %v, want [7]", store.invalidated)
+	}
+}
+
+func TestIssueQueryGRPCServer_GetIssueResponsiveness(t *testing.T) {
+	store := newFakeResponsivenessStore()
+	store.stats = []dao.IssueResponsivenessStats{{Key: "o/r", Issues: 4, Responded: 3, FirstResponseP50: 3600, FirstResponseP90: 7200}}
+	srv := NewIssueQueryGRPCServer(store, nil)
+	ctx := context.Background()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := srv.GetIssueResponsiveness(ctx, &issuesv1.GetIssueResponsivenessRequest{Repo: []string{"o/r"}, From: timestamppb.New(from)})
+	if err != nil {
+		t.Fatalf("GetIssueResponsiveness() error = %v", err)
+	}
+	if resp.GetGroupBy() != "repo" || len(resp.GetGroups()) != 1 || resp.GetGroups()[0].GetFirstResponseP90Seconds() != 7200 {
+		t.Fatalf("response = %v", resp)
+	}
+	if store.lastGroupBy != dao.IssueDimensionRepo || !store.lastFilter.From.Equal(from) || !slices.Equal(store.lastFilter.Repos, []string{"o/r"}) {
+		t.Fatalf("store called with %+v by %q", store.lastFilter, store.lastGroupBy)
+	}
+
+	for _, req := range []*issuesv1.GetIssueResponsivenessRequest{
+		{GroupBy: "assignee"},
+		{From: timestamppb.New(from), To: timestamppb.New(from)},
+	} {
+		if _, err := srv.GetIssueResponsiveness(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("GetIssueResponsiveness(%v) code = %v, want InvalidArgument", req, status.Code(err))
+		}
+	}
+
+	_, err = NewIssueQueryGRPCServer(newFakeSyncStore(), nil).GetIssueResponsiveness(ctx, &issuesv1.GetIssueResponsivenessRequest{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("GetIssueResponsiveness() without responsiveness store code = %v, want Unimplemented", status.Code(err))
+	}
+}
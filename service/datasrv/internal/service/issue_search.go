@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var issueSearchLogger = slog.Default().With("component", "datasrv.issue_search")
+
+// maxIssueSearchCommentText bounds the comment text indexed per issue. Postgres refuses tsvectors
+// over 1MB, and the first comments of long threads are the ones worth finding.
+const maxIssueSearchCommentText = 256 << 10
+
+// parseIssueSearchQuery splits a search like `label:bug author:alice is:pr "exact phrase" crash`
+// into its qualifiers, quoted phrases and words. Qualifier values may be quoted, as in
+// label:"good first issue". Unknown qualifiers are searched as words.
+func parseIssueSearchQuery(raw string) (dao.IssueSearchQuery, error) {
+	var q dao.IssueSearchQuery
+	for _, tok := range splitIssueSearchQuery(raw) {
+		if tok.quoteAt == 0 {
+			if phrase := strings.TrimSpace(tok.text); phrase != "" {
+				q.Phrases = append(q.Phrases, phrase)
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(tok.text, ":")
+		if !ok || value == "" || (tok.quoteAt > 0 && len(key) >= tok.quoteAt) {
+			q.Terms = append(q.Terms, tok.text)
+			continue
+		}
+		switch strings.ToLower(key) {
+		case "repo":
+			q.Repo = value
+		case "label":
+			q.Labels = append(q.Labels, value)
+		case "author":
+			q.Author = value
+		case "assignee":
+			q.Assignee = value
+		case "state":
+			state := strings.ToLower(value)
+			if state != "open" && state != "closed" && state != "all" {
+				return dao.IssueSearchQuery{}, fmt.Errorf("state must be open, closed or all, got %q", value)
+			}
+			q.State = state
+		case "is":
+			switch strings.ToLower(value) {
+			case "open", "closed":
+				q.State = strings.ToLower(value)
+			case "pr":
+				isPR := true
+				q.IsPullRequest = &isPR
+			case "issue":
+				isPR := false
+				q.IsPullRequest = &isPR
+			default:
+				return dao.IssueSearchQuery{}, fmt.Errorf("is must be open, closed, pr or issue, got %q", value)
+			}
+		default:
+			q.Terms = append(q.Terms, tok.text)
+		}
+	}
+	return q, nil
+}
+
+type issueSearchToken struct {
+	text string
+	// quoteAt is the offset in text where the first quoted part began, -1 when nothing was quoted.
+	quoteAt int
+}
+
+// splitIssueSearchQuery splits raw on whitespace outside double quotes, dropping the quotes. An
+// unterminated quote runs to the end of the query.
+func splitIssueSearchQuery(raw string) []issueSearchToken {
+	var out []issueSearchToken
+	var b strings.Builder
+	quoteAt := -1
+	inQuote := false
+	started := false
+	flush := func() {
+		if started {
+			out = append(out, issueSearchToken{text: b.String(), quoteAt: quoteAt})
+		}
+		b.Reset()
+		quoteAt = -1
+		started = false
+	}
+	for _, r := range raw {
+		switch {
+		case r == '"':
+			if !inQuote && quoteAt < 0 {
+				quoteAt = b.Len()
+			}
+			inQuote = !inQuote
+			started = true
+		case unicode.IsSpace(r) && !inQuote:
+			flush()
+		default:
+			b.WriteRune(r)
+			started = true
+		}
+	}
+	flush()
+	return out
+}
+
+// withIssueSearchIndex makes store copy the text of every saved thread into the search index of
+// primary, so that issue search covers comments wherever they are stored.
+func withIssueSearchIndex(store IssueCommentStore, primary dao.SyncStore) IssueCommentStore {
+	index, ok := primary.(dao.IssueSearchStore)
+	if !ok {
+		return store
+	}
+	indexed := &searchIndexedCommentStore{IssueCommentStore: store, index: index}
+	if versions, ok := store.(IssueCommentVersionStore); ok {
+		return &searchIndexedVersionedCommentStore{searchIndexedCommentStore: indexed, versions: versions}
+	}
+	return indexed
+}
+
+type searchIndexedCommentStore struct {
+	IssueCommentStore
+	index dao.IssueSearchStore
+}
+
+// SaveComments saves the thread, then indexes it. Indexing failures are logged rather than
+// returned, as the thread itself was saved.
+func (s *searchIndexedCommentStore) SaveComments(ctx context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
+	if err := s.IssueCommentStore.SaveComments(ctx, repo, issueID, issueNumber, comments); err != nil {
+		return err
+	}
+	s.reindex(ctx, repo, issueID, comments)
+	return nil
+}
+
+func (s *searchIndexedCommentStore) reindex(ctx context.Context, repo string, issueID int64, comments []dao.IssueComment) {
+	if err := s.index.SetIssueCommentText(ctx, repo, issueID, issueSearchCommentText(comments)); err != nil {
+		issueSearchLogger.Warn("index issue comments failed", "repo", repo, "issue_id", issueID, "error", err)
+	}
+}
+
+type searchIndexedVersionedCommentStore struct {
+	*searchIndexedCommentStore
+	versions IssueCommentVersionStore
+}
+
+func (s *searchIndexedVersionedCommentStore) ListCommentVersions(ctx context.Context, repo string, issueID int64, issueNumber int32) ([]IssueCommentVersion, error) {
+	return s.versions.ListCommentVersions(ctx, repo, issueID, issueNumber)
+}
+
+func (s *searchIndexedVersionedCommentStore) RestoreCommentVersion(ctx context.Context, repo string, issueID int64, issueNumber int32, versionID string) ([]dao.IssueComment, error) {
+	comments, err := s.versions.RestoreCommentVersion(ctx, repo, issueID, issueNumber, versionID)
+	if err != nil {
+		return nil, err
+	}
+	s.reindex(ctx, repo, issueID, comments)
+	return comments, nil
+}
+
+// issueSearchCommentText joins the comment bodies, cut at maxIssueSearchCommentText bytes.
+func issueSearchCommentText(comments []dao.IssueComment) string {
+	var b strings.Builder
+	for _, comment := range comments {
+		body := strings.TrimSpace(comment.Body)
+		if body == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(body)
+		if b.Len() >= maxIssueSearchCommentText {
+			break
+		}
+	}
+	text := b.String()
+	if len(text) <= maxIssueSearchCommentText {
+		return text
+	}
+	text = text[:maxIssueSearchCommentText]
+	for !utf8.ValidString(text) {
+		text = text[:len(text)-1]
+	}
+	return text
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSearchStore returns the stored issues of the queried repo as hits and records the indexed
+// comment text.
+type fakeSearchStore struct {
+	*fakeSyncStore
+	queries      []dao.IssueSearchQuery
+	commentTexts map[int64]string
+}
+
+func newFakeSearchStore() *fakeSearchStore {
+	return &fakeSearchStore{fakeSyncStore: newFakeSyncStore(), commentTexts: map[int64]string{}}
+}
+
+func (f *fakeSearchStore) SearchIssues(ctx context.Context, q dao.IssueSearchQuery) ([]dao.IssueSearchHit, error) {
+	f.queries = append(f.queries, q)
+	rows, err := f.ListIssues(ctx, dao.SyncIssueFilter{Repo: q.Repo, State: q.State, Offset: q.Offset, Limit: q.Limit})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]dao.IssueSearchHit, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, dao.IssueSearchHit{Issue: row, Score: 0.5, TitleHighlight: "<mark>" + row.Title + "</mark>"})
+	}
+	return out, nil
+}
+
+func (f *fakeSearchStore) SetIssueCommentText(_ context.Context, _ string, issueID int64, text string) error {
+	f.commentTexts[issueID] = text
+	return nil
+}
+
+func TestParseIssueSearchQuery(t *testing.T) {
+	isPR := true
+	got, err := parseIssueSearchQuery(`label:bug author:alice is:pr "exact phrase" crash label:"good first issue" repo:o/r foo:bar`)
+	if err != nil {
+		t.Fatalf("parseIssueSearchQuery() error = %v", err)
+	}
+	want := dao.IssueSearchQuery{
+		Terms:         []string{"crash", "foo:bar"},
+		Phrases:       []string{"exact phrase"},
+		Repo:          "o/r",
+		Author:        "alice",
+		Labels:        []string{"bug", "good first issue"},
+		IsPullRequest: &isPR,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseIssueSearchQuery() = %+v, want %+v", got, want)
+	}
+
+	got, err = parseIssueSearchQuery(`is:closed is:issue "a:b" "unterminated phrase`)
+	if err != nil {
+		t.Fatalf("parseIssueSearchQuery() error = %v", err)
+	}
+	if got.State != "closed" || got.IsPullRequest == nil || *got.IsPullRequest || !reflect.DeepEqual(got.Phrases, []string{"a:b", "unterminated phrase"}) {
+		t.Fatalf("parseIssueSearchQuery() = %+v", got)
+	}
+
+	for _, raw := range []string{"is:draft", "state:merged"} {
+		if _, err := parseIssueSearchQuery(raw); err == nil {
+			t.Fatalf("parseIssueSearchQuery(%q) error = nil", raw)
+		}
+	}
+}
+
+func TestIssueQueryGRPCServer_SearchIssues(t *testing.T) {
+	store := newFakeSearchStore()
+	_, _ = store.UpsertIssues(context.Background(), "o/r", []dao.SyncedIssue{
+		{Repo: "o/r", IssueID: 1, Number: 1, Title: "crash on start", State: "open"},
+		{Repo: "o/r", IssueID: 2, Number: 2, Title: "crash on exit", State: "open"},
+	})
+	srv := NewIssueQueryGRPCServer(store, nil)
+
+	resp, err := srv.SearchIssues(context.Background(), &issuesv1.SearchIssuesRequest{Query: "repo:o/r is:open crash", PageSize: 1})
+	if err != nil {
+		t.Fatalf("SearchIssues() error = %v", err)
+	}
+	if len(resp.GetHits()) != 1 || !resp.GetHasNext() {
+		t.Fatalf("hits = %d has_next = %t, want 1 hit and a next page", len(resp.GetHits()), resp.GetHasNext())
+	}
+	hit := resp.GetHits()[0]
+	if hit.GetIssue().GetRepo() != "o/r" || hit.GetScore() != 0.5 || !strings.HasPrefix(hit.GetTitleHighlight(), "<mark>") {
+		t.Fatalf("hit = %v", hit)
+	}
+	if q := store.queries[0]; q.Limit != 2 || q.State != "open" || !reflect.DeepEqual(q.Terms, []string{"crash"}) {
+		t.Fatalf("store query = %+v", q)
+	}
+
+	for _, query := range []string{"  ", "is:draft"} {
+		_, err := srv.SearchIssues(context.Background(), &issuesv1.SearchIssuesRequest{Query: query})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("SearchIssues(%q) code = %v, want InvalidArgument", query, status.Code(err))
+		}
+	}
+
+	_, err = NewIssueQueryGRPCServer(newFakeSyncStore(), nil).SearchIssues(context.Background(), &issuesv1.SearchIssuesRequest{Query: "crash"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("SearchIssues() without search store code = %v, want Unimplemented", status.Code(err))
+	}
+}
+
+func TestIssueCommentStoreIndexesComments(t *testing.T) {
+	store := newFakeSearchStore()
+	comments, err := NewIssueCommentStore(conf.IssueCommentStorageConfig{Enabled: true, Provider: "fs", Dir: t.TempDir()}, store)
+	if err != nil {
+		t.Fatalf("NewIssueCommentStore() error = %v", err)
+	}
+	err = comments.SaveComments(context.Background(), "o/r", 7, 7, []dao.IssueComment{
+		{ID: 1, Body: "first reply"},
+		{ID: 2, Body: "  "},
+		{ID: 3, Body: "second reply"},
+	})
+	if err != nil {
+		t.Fatalf("SaveComments() error = %v", err)
+	}
+	if got := store.commentTexts[7]; got != "first reply\n\nsecond reply" {
+		t.Fatalf("indexed comment text = %q", got)
+	}
+	loaded, err := comments.LoadComments(context.Background(), "o/r", 7, 7)
+	if err != nil || len(loaded) != 3 {
+		t.Fatalf("LoadComments() = %d comments, %v, want the saved thread", len(loaded), err)
+	}
+}
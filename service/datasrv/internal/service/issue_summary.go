@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	corelog "butterfly.orx.me/core/log"
@@ -20,7 +21,11 @@ type IssueSummaryService struct {
 	store        dao.SyncStore
 	commentStore IssueCommentStore
 	summarizer   IssueSummarizer
-	cfg          conf.IssueSummaryConfig
+
+	cfgMu sync.RWMutex
+	cfg   conf.IssueSummaryConfig
+	// runs is nil when the store keeps no run history; runs are then only logged.
+	runs dao.SyncRunStore
 }
 
 type IssueSummaryRunSummary struct {
@@ -40,19 +45,42 @@ type IssueSummaryRepoResult struct {
 }
 
 func NewIssueSummaryService(store dao.SyncStore, commentStore IssueCommentStore, summarizer IssueSummarizer, cfg conf.IssueSummaryConfig) *IssueSummaryService {
-	return &IssueSummaryService{
+	svc := &IssueSummaryService{
 		store:        store,
 		commentStore: commentStore,
 		summarizer:   summarizer,
 		cfg:          cfg,
 	}
+	if runs, ok := store.(dao.SyncRunStore); ok {
+		svc.runs = runs
+	}
+	return svc
 }
 
 func (s *IssueSummaryService) GetConfig() conf.IssueSummaryConfig {
+	s.cfgMu.RLock()
+	defer s.cfgMu.RUnlock()
+	return s.cfg
+}
+
+// UpdateConfig replaces the config read by the next run. The provider, model and prompt stay
+// those the summarizer was built with.
+func (s *IssueSummaryService) UpdateConfig(cfg conf.IssueSummaryConfig) conf.IssueSummaryConfig {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.cfg = cfg
 	return s.cfg
 }
 
 func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary, error) {
+	summary, err := s.run(ctx)
+	if s != nil {
+		recordSyncRun(ctx, s.runs, issueSummaryRunRecord(summary, err))
+	}
+	return summary, err
+}
+
+func (s *IssueSummaryService) run(ctx context.Context) (IssueSummaryRunSummary, error) {
 	summary := IssueSummaryRunSummary{
 		StartedAt: time.Now().UTC(),
 	}
@@ -66,6 +94,7 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 	if s.summarizer == nil {
 		return summary, fmt.Errorf("issue summarizer is not initialized")
 	}
+	cfg := s.GetConfig()
 
 	repos, err := s.store.ListManagedRepos(ctx)
 	if err != nil {
@@ -74,10 +103,10 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 	logger := corelog.FromContext(ctx).With("component", "datasrv.issue_summary")
 	logger.Info("issue summary run started",
 		"repo_count", len(repos),
-		"batch_size", s.cfg.BatchSize,
-		"max_issues_per_run", s.cfg.MaxIssuesPerRun,
-		"state", s.cfg.State,
-		"overwrite_existing", s.cfg.OverwriteExisting,
+		"batch_size", cfg.BatchSize,
+		"max_issues_per_run", cfg.MaxIssuesPerRun,
+		"state", cfg.State,
+		"overwrite_existing", cfg.OverwriteExisting,
 		"processing_order", "latest_first",
 	)
 
@@ -86,11 +115,11 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 		managedRepoSet[repo.Repo] = struct{}{}
 	}
 
-	batchSize := s.cfg.BatchSize
+	batchSize := cfg.BatchSize
 	if batchSize <= 0 {
 		batchSize = 20
 	}
-	state := strings.TrimSpace(s.cfg.State)
+	state := strings.TrimSpace(cfg.State)
 	if state == "" {
 		state = "all"
 	}
@@ -107,12 +136,12 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 		return result
 	}
 
-	remaining := s.cfg.MaxIssuesPerRun
-	limited := s.cfg.MaxIssuesPerRun > 0
+	remaining := cfg.MaxIssuesPerRun
+	limited := cfg.MaxIssuesPerRun > 0
 	for offset := 0; ; offset += batchSize {
 		if limited && remaining == 0 {
 			logger.Info("issue summary run stopped by max issues per run",
-				"max_issues_per_run", s.cfg.MaxIssuesPerRun,
+				"max_issues_per_run", cfg.MaxIssuesPerRun,
 			)
 			break
 		}
@@ -158,7 +187,7 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 				logger.Info("issue summary run stopped mid-page by max issues per run",
 					"repo", row.Repo,
 					"number", row.Number,
-					"max_issues_per_run", s.cfg.MaxIssuesPerRun,
+					"max_issues_per_run", cfg.MaxIssuesPerRun,
 				)
 				break
 			}
@@ -177,7 +206,7 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 				"remaining", remainingValue(limited, remaining),
 			)
 
-			if !s.cfg.OverwriteExisting && strings.TrimSpace(row.AISummary) != "" {
+			if !cfg.OverwriteExisting && strings.TrimSpace(row.AISummary) != "" {
 				result.Skipped++
 				issueLogger.Debug("issue summary skipped existing summary")
 				continue
@@ -196,7 +225,7 @@ func (s *IssueSummaryService) Run(ctx context.Context) (IssueSummaryRunSummary,
 
 			callCtx := ctx
 			cancel := func() {}
-			if timeout := time.Duration(s.cfg.RequestTimeoutSeconds) * time.Second; timeout > 0 {
+			if timeout := time.Duration(cfg.RequestTimeoutSeconds) * time.Second; timeout > 0 {
 				callCtx, cancel = context.WithTimeout(ctx, timeout)
 			}
 
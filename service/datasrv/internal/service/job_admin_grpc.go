@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type JobAdminGRPCServer struct {
+	issuesv1.UnimplementedJobAdminServiceServer
+
+	jobs *JobScheduler
+}
+
+func NewJobAdminGRPCServer(jobs *JobScheduler) *JobAdminGRPCServer {
+	return &JobAdminGRPCServer{jobs: jobs}
+}
+
+func (s *JobAdminGRPCServer) ListJobs(context.Context, *emptypb.Empty) (*issuesv1.ListJobsResponse, error) {
+	statuses := s.jobs.List()
+	jobs := make([]*issuesv1.Job, 0, len(statuses))
+	for _, job := range statuses {
+		jobs = append(jobs, toProtoJob(job))
+	}
+	return &issuesv1.ListJobsResponse{Jobs: jobs}, nil
+}
+
+func (s *JobAdminGRPCServer) PauseJob(_ context.Context, req *issuesv1.PauseJobRequest) (*issuesv1.Job, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	job, err := s.jobs.Pause(req.GetName())
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func (s *JobAdminGRPCServer) ResumeJob(_ context.Context, req *issuesv1.ResumeJobRequest) (*issuesv1.Job, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	job, err := s.jobs.Resume(req.GetName())
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func (s *JobAdminGRPCServer) TriggerJob(_ context.Context, req *issuesv1.TriggerJobRequest) (*issuesv1.Job, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	job, err := s.jobs.Trigger(req.GetName())
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return toProtoJob(job), nil
+}
+
+func jobError(err error) error {
+	switch {
+	case errors.Is(err, ErrJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrJobRunning), errors.Is(err, ErrJobNotLeader):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoJob(job JobStatus) *issuesv1.Job {
+	return &issuesv1.Job{
+		Name:           job.Name,
+		Schedule:       job.Schedule,
+		Overlap:        job.Overlap,
+		Paused:         job.Paused,
+		Running:        job.Running,
+		NextRunAt:      maybeTimestamp(job.NextRunAt),
+		LastStartedAt:  maybeTimestamp(job.LastStartedAt),
+		LastFinishedAt: maybeTimestamp(job.LastFinishedAt),
+		LastError:      job.LastError,
+		TimeoutSeconds: int32(job.Timeout.Seconds()),
+		JitterSeconds:  int32(job.Jitter.Seconds()),
+	}
+}
@@ -0,0 +1,515 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var jobLogger = slog.Default().With("component", "datasrv.jobs")
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	ErrJobRunning  = errors.New("job is already running")
+	// ErrJobNotLeader is returned when a run is triggered on a replica that does not hold the
+	// scheduler lease.
+	ErrJobNotLeader = errors.New("replica does not hold the scheduler lease")
+)
+
+// Names of the background jobs registered at startup.
+const (
+	JobIssueSync           = "issue_sync"
+	JobIssueReconcile      = "issue_reconcile"
+	JobRepoDiscovery       = "repo_discovery"
+	JobSyncRunPrune        = "sync_run_prune"
+	JobFeedSync            = "feed_sync"
+	JobIssueSummary        = "issue_summary"
+	JobPRReview            = "pr_review"
+	JobIssueResponsiveness = "issue_responsiveness"
+)
+
+// Overlap policies, applied when a run is due while the previous one is still going.
+const (
+	JobOverlapSkip    = "skip"
+	JobOverlapQueue   = "queue"
+	JobOverlapReplace = "replace"
+)
+
+// JobSpec declares a background job.
+type JobSpec struct {
+	Name string
+	// Cron is a schedule accepted by ParseSchedule. It takes precedence over Interval.
+	Cron string
+	// Interval returns the time between the starts of two runs. It is called each time the next run
+	// is planned, so that it can follow settings changed at runtime; zero leaves the job without
+	// scheduled runs until it changes.
+	Interval   func() time.Duration
+	Jitter     time.Duration
+	RunOnStart bool
+	// Timeout cancels a run that takes longer, when set.
+	Timeout time.Duration
+	// Overlap is one of the JobOverlap policies, skip when empty.
+	Overlap string
+	Run     func(ctx context.Context) error
+}
+
+// JobStatus is the state of a registered job.
+type JobStatus struct {
+	Name     string
+	Schedule string
+	Overlap  string
+	Paused   bool
+	Running  bool
+	// NextRunAt is zero while the job is paused or has no schedule.
+	NextRunAt      time.Time
+	LastStartedAt  time.Time
+	LastFinishedAt time.Time
+	LastError      string
+	Timeout        time.Duration
+	Jitter         time.Duration
+}
+
+type schedulerJob struct {
+	spec     JobSpec
+	schedule Schedule
+	// wake makes the job plan its next run again.
+	wake chan struct{}
+
+	// Guarded by JobScheduler.mu.
+	paused  bool
+	running bool
+	// anchor is when the job last started or was due; interval jobs count from it.
+	anchor    time.Time
+	next      time.Time
+	cancelRun context.CancelFunc
+	// queued is set when a run is to start once the current one finishes; queuedManual when one
+	// of the queued requests came through Trigger and runs even while the job is paused.
+	queued       bool
+	queuedManual bool
+	lastStarted  time.Time
+	lastFinished time.Time
+	lastError    string
+}
+
+// JobScheduler runs the registered jobs on their schedules. All runs, scheduled or started through
+// Trigger, only happen while the replica leads.
+type JobScheduler struct {
+	leader    *LeaderElector
+	overrides map[string]conf.JobConfig
+	// beforeRun is called ahead of each run, see SetBeforeRun.
+	beforeRun func(ctx context.Context)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	jobs    map[string]*schedulerJob
+	order   []string
+	started bool
+	stopped bool
+}
+
+// NewJobScheduler returns a scheduler whose jobs take the settings in overrides over the ones they
+// are registered with.
+func NewJobScheduler(leader *LeaderElector, overrides map[string]conf.JobConfig) *JobScheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &JobScheduler{
+		leader:    leader,
+		overrides: overrides,
+		ctx:       ctx,
+		cancel:    cancel,
+		jobs:      make(map[string]*schedulerJob),
+	}
+}
+
+// SetBeforeRun sets a function called ahead of each run, once it is known to go ahead on this
+// replica, such as one bringing in settings saved through another replica. Call it before Start.
+func (s *JobScheduler) SetBeforeRun(fn func(ctx context.Context)) {
+	s.beforeRun = fn
+}
+
+// Register adds a job. Jobs registered after Start are scheduled right away.
+func (s *JobScheduler) Register(spec JobSpec) error {
+	spec.Name = strings.TrimSpace(spec.Name)
+	if spec.Name == "" || spec.Run == nil {
+		return errors.New("job needs a name and a run function")
+	}
+	override := s.overrides[spec.Name]
+	if override.Cron != "" {
+		spec.Cron = override.Cron
+	}
+	if override.JitterSeconds > 0 {
+		spec.Jitter = time.Duration(override.JitterSeconds) * time.Second
+	}
+	if override.RunOnStart != nil {
+		spec.RunOnStart = *override.RunOnStart
+	}
+	if override.TimeoutSeconds > 0 {
+		spec.Timeout = time.Duration(override.TimeoutSeconds) * time.Second
+	}
+	if override.Overlap != "" {
+		spec.Overlap = override.Overlap
+	}
+	spec.Overlap = strings.ToLower(strings.TrimSpace(spec.Overlap))
+	switch spec.Overlap {
+	case "":
+		spec.Overlap = JobOverlapSkip
+	case JobOverlapSkip, JobOverlapQueue, JobOverlapReplace:
+	default:
+		return fmt.Errorf("job %s: unknown overlap policy %q", spec.Name, spec.Overlap)
+	}
+
+	job := &schedulerJob{spec: spec, wake: make(chan struct{}, 1), paused: override.Paused, anchor: time.Now()}
+	if spec.Cron != "" {
+		schedule, err := ParseSchedule(spec.Cron)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", spec.Name, err)
+		}
+		job.schedule = schedule
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[spec.Name]; ok {
+		return fmt.Errorf("job %s is already registered", spec.Name)
+	}
+	s.jobs[spec.Name] = job
+	s.order = append(s.order, spec.Name)
+	if s.started && !s.stopped {
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+	return nil
+}
+
+// Start schedules the registered jobs until Stop.
+func (s *JobScheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started || s.stopped {
+		return
+	}
+	s.started = true
+	now := time.Now()
+	for _, name := range s.order {
+		s.jobs[name].anchor = now
+		s.wg.Add(1)
+		go s.loop(s.jobs[name])
+	}
+	jobLogger.Info("job scheduler started", "jobs", s.order)
+}
+
+// Stop cancels the runs in progress and waits for them to return.
+func (s *JobScheduler) Stop() {
+	s.mu.Lock()
+	s.stopped = true
+	s.mu.Unlock()
+	s.cancel()
+	s.wg.Wait()
+}
+
+// Reschedule plans the next run of a job again, after the settings its Interval reads changed.
+func (s *JobScheduler) Reschedule(name string) error {
+	s.mu.Lock()
+	job, ok := s.jobs[name]
+	s.mu.Unlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+	job.notify()
+	return nil
+}
+
+// List returns the status of every job in registration order.
+func (s *JobScheduler) List() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]JobStatus, 0, len(s.order))
+	for _, name := range s.order {
+		out = append(out, s.status(s.jobs[name]))
+	}
+	return out
+}
+
+// Get returns the status of one job.
+func (s *JobScheduler) Get(name string) (JobStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[name]
+	if !ok {
+		return JobStatus{}, ErrJobNotFound
+	}
+	return s.status(job), nil
+}
+
+// Pause stops the scheduled runs of a job. A run in progress is left to finish, and Trigger still
+// starts runs.
+func (s *JobScheduler) Pause(name string) (JobStatus, error) {
+	return s.setPaused(name, true)
+}
+
+// Resume schedules a paused job again. An interval job that became due while paused runs right away.
+func (s *JobScheduler) Resume(name string) (JobStatus, error) {
+	return s.setPaused(name, false)
+}
+
+func (s *JobScheduler) setPaused(name string, paused bool) (JobStatus, error) {
+	s.mu.Lock()
+	job, ok := s.jobs[name]
+	if !ok {
+		s.mu.Unlock()
+		return JobStatus{}, ErrJobNotFound
+	}
+	if job.paused != paused {
+		job.paused = paused
+		jobLogger.Info("job schedule changed", "job", name, "paused", paused)
+	}
+	if paused || s.started {
+		s.planLocked(job)
+	}
+	out := s.status(job)
+	s.mu.Unlock()
+	job.notify()
+	return out, nil
+}
+
+// Trigger starts a run of a job now, also while it is paused. A replica that does not lead returns
+// ErrJobNotLeader naming the one that does. The overlap policy of the job applies when a run is in
+// progress; with skip, ErrJobRunning is returned.
+func (s *JobScheduler) Trigger(name string) (JobStatus, error) {
+	s.mu.Lock()
+	job, ok := s.jobs[name]
+	s.mu.Unlock()
+	if !ok {
+		return JobStatus{}, ErrJobNotFound
+	}
+	if err := s.start(job, false); err != nil {
+		return JobStatus{}, err
+	}
+	return s.Get(name)
+}
+
+func (s *JobScheduler) status(job *schedulerJob) JobStatus {
+	out := JobStatus{
+		Name:           job.spec.Name,
+		Overlap:        job.spec.Overlap,
+		Paused:         job.paused,
+		Running:        job.running,
+		NextRunAt:      job.next,
+		LastStartedAt:  job.lastStarted,
+		LastFinishedAt: job.lastFinished,
+		LastError:      job.lastError,
+		Timeout:        job.spec.Timeout,
+		Jitter:         job.spec.Jitter,
+	}
+	if job.schedule != nil {
+		out.Schedule = job.schedule.String()
+	} else if every := job.interval(); every > 0 {
+		out.Schedule = intervalSchedule(every).String()
+	}
+	return out
+}
+
+func (j *schedulerJob) interval() time.Duration {
+	if j.spec.Interval == nil {
+		return 0
+	}
+	return j.spec.Interval()
+}
+
+func (j *schedulerJob) notify() {
+	select {
+	case j.wake <- struct{}{}:
+	default:
+	}
+}
+
+// loop waits for the next run of a job until the scheduler stops.
+func (s *JobScheduler) loop(job *schedulerJob) {
+	defer s.wg.Done()
+	if job.spec.RunOnStart {
+		s.startScheduled(job)
+	}
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		var due <-chan time.Time
+		if next := s.plan(job); !next.IsZero() {
+			timer.Reset(time.Until(next))
+			due = timer.C
+		}
+		select {
+		case <-due:
+			s.startScheduled(job)
+		case <-job.wake:
+		case <-s.ctx.Done():
+			return
+		}
+		timer.Stop()
+	}
+}
+
+// plan picks the next scheduled run of a job, the zero time when there is none.
+func (s *JobScheduler) plan(job *schedulerJob) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.planLocked(job)
+}
+
+func (s *JobScheduler) planLocked(job *schedulerJob) time.Time {
+	job.next = time.Time{}
+	if job.paused {
+		return job.next
+	}
+	now := time.Now()
+	switch {
+	case job.schedule != nil:
+		job.next = job.schedule.Next(now)
+	case job.interval() > 0:
+		job.next = job.anchor.Add(job.interval())
+	default:
+		return job.next
+	}
+	if job.next.IsZero() {
+		return job.next
+	}
+	if job.spec.Jitter > 0 {
+		job.next = job.next.Add(rand.N(job.spec.Jitter))
+	}
+	// An interval shortened at runtime may already have passed.
+	if job.next.Before(now) {
+		job.next = now
+	}
+	return job.next
+}
+
+func (s *JobScheduler) startScheduled(job *schedulerJob) {
+	s.mu.Lock()
+	job.anchor = time.Now()
+	s.mu.Unlock()
+	if err := s.start(job, true); err != nil {
+		jobLogger.Info("scheduled job run skipped", "job", job.spec.Name, "error", err)
+	}
+}
+
+// start runs a job in the background, or applies its overlap policy when a run is in progress.
+// Scheduled runs are dropped while the job is paused or the replica does not lead; manual ones
+// fail with ErrJobNotLeader on a replica that does not lead.
+func (s *JobScheduler) start(job *schedulerJob, scheduled bool) error {
+	ctx, done, leading := s.leader.Scope(s.ctx)
+	if !leading {
+		if !scheduled {
+			return s.notLeading()
+		}
+		jobLogger.Debug("scheduled job run left to the leader", "job", job.spec.Name)
+		return nil
+	}
+	if s.beforeRun != nil {
+		s.beforeRun(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		done()
+		return errors.New("job scheduler is stopped")
+	}
+	if scheduled && job.paused {
+		done()
+		return nil
+	}
+	if job.running {
+		done()
+		switch job.spec.Overlap {
+		case JobOverlapReplace:
+			job.cancelRun()
+			fallthrough
+		case JobOverlapQueue:
+			job.queued = true
+			job.queuedManual = job.queuedManual || !scheduled
+			return nil
+		default:
+			return ErrJobRunning
+		}
+	}
+
+	trigger := dao.SyncRunTriggerManual
+	if scheduled {
+		trigger = dao.SyncRunTriggerScheduler
+	}
+	ctx = WithSyncTrigger(ctx, trigger)
+	var runCtx context.Context
+	var cancel context.CancelFunc
+	if job.spec.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, job.spec.Timeout)
+	} else {
+		runCtx, cancel = context.WithCancel(ctx)
+	}
+	job.running = true
+	job.cancelRun = cancel
+	job.anchor = time.Now()
+	job.lastStarted = job.anchor
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		jobLogger.Info("job run started", "job", job.spec.Name, "trigger", trigger)
+		err := job.spec.Run(runCtx)
+		cancel()
+		done()
+		s.finish(job, err)
+	}()
+	return nil
+}
+
+// notLeading returns ErrJobNotLeader naming the replica holding the scheduler lease, if any.
+func (s *JobScheduler) notLeading() error {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+	leader, err := s.leader.Status(ctx)
+	switch {
+	case err != nil:
+		return fmt.Errorf("%w; lease holder unknown: %v", ErrJobNotLeader, err)
+	case leader.Holder == "":
+		return fmt.Errorf("%w; no replica holds it", ErrJobNotLeader)
+	default:
+		return fmt.Errorf("%w; trigger it on %s", ErrJobNotLeader, leader.Holder)
+	}
+}
+
+func (s *JobScheduler) finish(job *schedulerJob, err error) {
+	s.mu.Lock()
+	job.running = false
+	job.cancelRun = nil
+	job.lastFinished = time.Now()
+	job.lastError = ""
+	if err != nil {
+		job.lastError = err.Error()
+	}
+	queued, manual := job.queued, job.queuedManual
+	job.queued, job.queuedManual = false, false
+	duration := job.lastFinished.Sub(job.lastStarted)
+	s.mu.Unlock()
+
+	if err != nil {
+		jobLogger.Error("job run failed", "job", job.spec.Name, "duration_ms", duration.Milliseconds(), "error", err)
+	} else {
+		jobLogger.Info("job run finished", "job", job.spec.Name, "duration_ms", duration.Milliseconds())
+	}
+	if queued {
+		if err := s.start(job, !manual); err != nil {
+			jobLogger.Info("queued job run skipped", "job", job.spec.Name, "error", err)
+		}
+	}
+	// Interval jobs count from the start of this run, which may have been a triggered one.
+	job.notify()
+}
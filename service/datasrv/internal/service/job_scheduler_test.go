@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseScheduleNext(t *testing.T) {
+	from := time.Date(2026, 10, 16, 10, 7, 30, 0, time.UTC) // a Friday
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2026, 10, 16, 10, 15, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, 10, 16, 10, 25, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted.
+		{"30 4 1,15 * fri", time.Date(2026, 10, 23, 4, 30, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"@every 90s", from.Add(90 * time.Second)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, tc := range cases {
+		schedule, err := ParseSchedule(tc.expr)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) error = %v", tc.expr, err)
+		}
+		if got := schedule.Next(from); !got.Equal(tc.want) {
+			t.Fatalf("ParseSchedule(%q).Next() = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+	for _, expr := range []string{"* * *", "60 * * * *", "5-1 * * * *", "*/0 * * * *", "0 0 * foo *", "@every 0s"} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Fatalf("ParseSchedule(%q) error = nil, want an error", expr)
+		}
+	}
+}
+
+func TestJobSchedulerRunOnStartAndTrigger(t *testing.T) {
+	runs := make(chan struct{}, 4)
+	jobs := NewJobScheduler(nil, nil)
+	if err := jobs.Register(JobSpec{
+		Name:       "sync",
+		RunOnStart: true,
+		Run: func(context.Context) error {
+			runs <- struct{}{}
+			return errors.New("upstream down")
+		},
+	}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	jobs.Start()
+	defer jobs.Stop()
+
+	waitJobRun(t, runs)
+	waitJobIdle(t, jobs, "sync")
+	if _, err := jobs.Trigger("sync"); err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	waitJobRun(t, runs)
+	status := waitJobIdle(t, jobs, "sync")
+	if status.LastError != "upstream down" || status.LastFinishedAt.IsZero() || !status.NextRunAt.IsZero() {
+		t.Fatalf("status = %+v, want the run error and no scheduled run", status)
+	}
+	if _, err := jobs.Trigger("missing"); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("Trigger(missing) error = %v, want ErrJobNotFound", err)
+	}
+}
+
+func TestJobSchedulerCallsBeforeRunAheadOfEachRun(t *testing.T) {
+	runs := make(chan struct{}, 4)
+	var calls atomic.Int32
+	jobs := NewJobScheduler(nil, nil)
+	jobs.SetBeforeRun(func(context.Context) { calls.Add(1) })
+	if err := jobs.Register(JobSpec{
+		Name:       "sync",
+		RunOnStart: true,
+		Run: func(context.Context) error {
+			if calls.Load() == 0 {
+				t.Errorf("job ran before the before-run hook")
+			}
+			runs <- struct{}{}
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	jobs.Start()
+	defer jobs.Stop()
+
+	waitJobRun(t, runs)
+	waitJobIdle(t, jobs, "sync")
+	if _, err := jobs.Trigger("sync"); err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	waitJobRun(t, runs)
+	waitJobIdle(t, jobs, "sync")
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("before-run calls = %d, want 2", got)
+	}
+}
+
+func TestJobSchedulerOverlapPolicies(t *testing.T) {
+	release := make(chan struct{})
+	var skipRuns, queueRuns atomic.Int32
+	jobs := NewJobScheduler(nil, map[string]conf.JobConfig{"queued": {Overlap: JobOverlapQueue}})
+	blocking := func(count *atomic.Int32) func(context.Context) error {
+		return func(ctx context.Context) error {
+			if count.Add(1) == 1 {
+				<-release
+			}
+			return nil
+		}
+	}
+	_ = jobs.Register(JobSpec{Name: "skipped", Run: blocking(&skipRuns)})
+	_ = jobs.Register(JobSpec{Name: "queued", Run: blocking(&queueRuns)})
+	defer jobs.Stop()
+
+	for _, name := range []string{"skipped", "queued"} {
+		if _, err := jobs.Trigger(name); err != nil {
+			t.Fatalf("Trigger(%s) error = %v", name, err)
+		}
+	}
+	if _, err := jobs.Trigger("skipped"); !errors.Is(err, ErrJobRunning) {
+		t.Fatalf("Trigger(skipped) during a run error = %v, want ErrJobRunning", err)
+	}
+	if _, err := jobs.Trigger("queued"); err != nil {
+		t.Fatalf("Trigger(queued) during a run error = %v", err)
+	}
+	close(release)
+	waitJobIdle(t, jobs, "skipped")
+	deadline := time.Now().Add(time.Second)
+	for queueRuns.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if skipRuns.Load() != 1 || queueRuns.Load() != 2 {
+		t.Fatalf("runs = %d skipped, %d queued; want 1 and 2", skipRuns.Load(), queueRuns.Load())
+	}
+}
+
+func TestJobSchedulerPauseAndReschedule(t *testing.T) {
+	var every atomic.Int64
+	every.Store(int64(time.Hour))
+	runs := make(chan struct{}, 4)
+	jobs := NewJobScheduler(nil, nil)
+	_ = jobs.Register(JobSpec{
+		Name:     "sync",
+		Interval: func() time.Duration { return time.Duration(every.Load()) },
+		Run: func(context.Context) error {
+			runs <- struct{}{}
+			return nil
+		},
+	})
+	jobs.Start()
+	defer jobs.Stop()
+
+	status, err := jobs.Pause("sync")
+	if err != nil || !status.Paused || !status.NextRunAt.IsZero() {
+		t.Fatalf("Pause() = %+v, %v, want paused without a next run", status, err)
+	}
+	status, err = jobs.Resume("sync")
+	if err != nil || status.Paused || time.Until(status.NextRunAt) < 50*time.Minute || status.Schedule != "@every 1h0m0s" {
+		t.Fatalf("Resume() = %+v, %v, want the next run an hour out", status, err)
+	}
+
+	every.Store(int64(10 * time.Millisecond))
+	if err := jobs.Reschedule("sync"); err != nil {
+		t.Fatalf("Reschedule() error = %v", err)
+	}
+	waitJobRun(t, runs)
+}
+
+func TestJobSchedulerLeavesScheduledRunsToTheLeader(t *testing.T) {
+	store := newFakeLeaseStore()
+	store.holders[schedulerLeaseName] = "pod-a"
+	follower := NewLeaderElector(store, conf.LeadershipConfig{ReplicaID: "pod-b", LeaseTTLSeconds: 60})
+	follower.Start(context.Background())
+	defer follower.Stop(context.Background())
+
+	var runs atomic.Int32
+	jobs := NewJobScheduler(follower, nil)
+	_ = jobs.Register(JobSpec{
+		Name:       "sync",
+		RunOnStart: true,
+		Interval:   func() time.Duration { return 10 * time.Millisecond },
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+	jobs.Start()
+	defer jobs.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	if runs.Load() != 0 {
+		t.Fatalf("follower ran %d scheduled runs", runs.Load())
+	}
+	_, err := jobs.Trigger("sync")
+	if !errors.Is(err, ErrJobNotLeader) || !strings.Contains(err.Error(), "pod-a") {
+		t.Fatalf("Trigger() on the follower error = %v, want ErrJobNotLeader naming pod-a", err)
+	}
+	if status.Code(jobError(err)) != codes.FailedPrecondition {
+		t.Fatalf("Trigger() on the follower code = %v, want FailedPrecondition", status.Code(jobError(err)))
+	}
+	if runs.Load() != 0 {
+		t.Fatalf("runs = %d, want none on the follower", runs.Load())
+	}
+}
+
+func waitJobRun(t *testing.T, runs <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-runs:
+	case <-time.After(time.Second):
+		t.Fatalf("job did not run")
+	}
+}
+
+func waitJobIdle(t *testing.T, jobs *JobScheduler, name string) JobStatus {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		status, err := jobs.Get(name)
+		if err != nil {
+			t.Fatalf("Get(%s) error = %v", name, err)
+		}
+		if !status.Running && !status.LastFinishedAt.IsZero() {
+			return status
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s did not finish: %+v", name, status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
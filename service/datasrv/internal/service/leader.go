@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var leaderLogger = slog.Default().With("component", "datasrv.leader")
+
+// schedulerLeaseName is the lease whose holder runs the scheduled jobs.
+const schedulerLeaseName = "datasrv-scheduler"
+
+const defaultLeaseTTL = 30 * time.Second
+
+// Leadership backends.
+const (
+	LeaseBackendRedis    = "redis"
+	LeaseBackendDatabase = "database"
+)
+
+// NewLeaseStore returns the configured lease store, or nil when leadership is off and every replica
+// runs the scheduled jobs. The database backend takes leases in primary, which must implement
+// dao.LeaseStore.
+func NewLeaseStore(cfg conf.LeadershipConfig, primary dao.SyncStore) (dao.LeaseStore, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Backend)) {
+	case "", "none":
+		return nil, nil
+	case LeaseBackendRedis:
+		return NewRedisLeaseStore(cfg.RedisName), nil
+	case LeaseBackendDatabase, "postgres":
+		leases, ok := primary.(dao.LeaseStore)
+		if !ok {
+			return nil, fmt.Errorf("store %T does not implement lease store", primary)
+		}
+		return leases, nil
+	default:
+		return nil, fmt.Errorf("unsupported leadership backend %q", cfg.Backend)
+	}
+}
+
+// LeaderStatus describes the scheduler lease as seen by one replica.
+type LeaderStatus struct {
+	// Holder is the replica holding the lease, empty when nobody does.
+	Holder string
+	// Replica is the id of this replica.
+	Replica string
+	Leading bool
+}
+
+// LeaderElector keeps the claim of this replica on the scheduler lease. Jobs only run while it
+// leads, whether scheduled or started through the admin API. A nil elector always leads.
+type LeaderElector struct {
+	store   dao.LeaseStore
+	replica string
+	ttl     time.Duration
+
+	mu      sync.RWMutex
+	leading bool
+	// term is canceled when the lease is lost, ending the jobs started under it.
+	term   context.Context
+	cancel context.CancelFunc
+	stop   context.CancelFunc
+	done   chan struct{}
+}
+
+func NewLeaderElector(store dao.LeaseStore, cfg conf.LeadershipConfig) *LeaderElector {
+	ttl := time.Duration(cfg.LeaseTTLSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = defaultLeaseTTL
+	}
+	replica := strings.TrimSpace(cfg.ReplicaID)
+	if replica == "" {
+		host, _ := os.Hostname()
+		replica = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	return &LeaderElector{store: store, replica: replica, ttl: ttl}
+}
+
+// Start tries to take the lease once, so that jobs started right after know whether they lead,
+// and then renews it every third of the ttl until Stop.
+func (e *LeaderElector) Start(ctx context.Context) {
+	if e == nil || e.done != nil {
+		return
+	}
+	ctx, e.stop = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+	e.renew(ctx)
+	go func() {
+		defer close(e.done)
+		ticker := time.NewTicker(e.ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				e.renew(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the renewals and hands the lease over right away instead of letting it expire.
+func (e *LeaderElector) Stop(ctx context.Context) {
+	if e == nil || e.done == nil {
+		return
+	}
+	e.stop()
+	<-e.done
+	e.done = nil
+	e.step(false)
+	if err := e.store.ReleaseLease(ctx, schedulerLeaseName, e.replica); err != nil {
+		leaderLogger.Warn("scheduler lease release failed", "replica", e.replica, "error", err)
+	}
+}
+
+func (e *LeaderElector) renew(ctx context.Context) {
+	requestCtx, cancel := context.WithTimeout(ctx, e.ttl/3)
+	defer cancel()
+	leading, err := e.store.AcquireLease(requestCtx, schedulerLeaseName, e.replica, e.ttl)
+	if err != nil {
+		// Another replica may take the lease once it expires, so an unconfirmed lease is given up.
+		leaderLogger.Warn("scheduler lease renewal failed", "replica", e.replica, "error", err)
+		leading = false
+	}
+	e.step(leading)
+}
+
+func (e *LeaderElector) step(leading bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch {
+	case leading && !e.leading:
+		e.term, e.cancel = context.WithCancel(context.Background())
+		leaderLogger.Info("scheduler leadership acquired", "replica", e.replica)
+	case !leading && e.leading:
+		e.cancel()
+		leaderLogger.Info("scheduler leadership lost", "replica", e.replica)
+	}
+	e.leading = leading
+}
+
+// Leading reports whether this replica holds the lease.
+func (e *LeaderElector) Leading() bool {
+	if e == nil {
+		return true
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leading
+}
+
+// Scope derives a context from ctx for a job run that is also canceled when the lease is lost. It returns false, and ctx unchanged, when this replica does not lead.
+func (e *LeaderElector) Scope(ctx context.Context) (context.Context, context.CancelFunc, bool) {
+	if e == nil {
+		return ctx, func() {}, true
+	}
+	e.mu.RLock()
+	leading, term := e.leading, e.term
+	e.mu.RUnlock()
+	if !leading {
+		return ctx, func() {}, false
+	}
+	scoped, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(term, cancel)
+	return scoped, func() {
+		stop()
+		cancel()
+	}, true
+}
+
+// Status looks up the current holder of the lease. When the lookup fails the status still tells
+// whether this replica leads.
+func (e *LeaderElector) Status(ctx context.Context) (LeaderStatus, error) {
+	out := LeaderStatus{Replica: e.replica, Leading: e.Leading()}
+	holder, err := e.store.LeaseHolder(ctx, schedulerLeaseName)
+	if err != nil {
+		return out, fmt.Errorf("get scheduler lease holder: %w", err)
+	}
+	out.Holder = holder
+	return out, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeLeaseStore struct {
+	mu      sync.Mutex
+	holders map[string]string
+	err     error
+}
+
+func newFakeLeaseStore() *fakeLeaseStore {
+	return &fakeLeaseStore{holders: map[string]string{}}
+}
+
+func (f *fakeLeaseStore) AcquireLease(_ context.Context, name, holder string, _ time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return false, f.err
+	}
+	if current, ok := f.holders[name]; ok && current != holder {
+		return false, nil
+	}
+	f.holders[name] = holder
+	return true, nil
+}
+
+func (f *fakeLeaseStore) ReleaseLease(_ context.Context, name, holder string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.holders[name] == holder {
+		delete(f.holders, name)
+	}
+	return nil
+}
+
+func (f *fakeLeaseStore) LeaseHolder(_ context.Context, name string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.holders[name], f.err
+}
+
+func TestLeaderElectorOneReplicaLeads(t *testing.T) {
+	store := newFakeLeaseStore()
+	first := NewLeaderElector(store, conf.LeadershipConfig{ReplicaID: "pod-a", LeaseTTLSeconds: 60})
+	second := NewLeaderElector(store, conf.LeadershipConfig{ReplicaID: "pod-b", LeaseTTLSeconds: 60})
+	first.Start(context.Background())
+	second.Start(context.Background())
+	defer second.Stop(context.Background())
+
+	if !first.Leading() || second.Leading() {
+		t.Fatalf("leading = %v/%v, want only the first replica", first.Leading(), second.Leading())
+	}
+	if _, _, ok := second.Scope(context.Background()); ok {
+		t.Fatalf("Scope() on the follower = true, want false")
+	}
+	status, err := second.Status(context.Background())
+	if err != nil || status.Holder != "pod-a" || status.Replica != "pod-b" || status.Leading {
+		t.Fatalf("Status() = %+v, %v, want pod-a holding as seen from pod-b", status, err)
+	}
+
+	first.Stop(context.Background())
+	second.renew(context.Background())
+	if !second.Leading() {
+		t.Fatalf("second replica did not take over the released lease")
+	}
+}
+
+func TestLeaderElectorScopeEndsWithLease(t *testing.T) {
+	store := newFakeLeaseStore()
+	elector := NewLeaderElector(store, conf.LeadershipConfig{ReplicaID: "pod-a", LeaseTTLSeconds: 60})
+	elector.Start(context.Background())
+	defer elector.Stop(context.Background())
+
+	ctx, done, ok := elector.Scope(context.Background())
+	if !ok {
+		t.Fatalf("Scope() = false, want the leader to run the job")
+	}
+	defer done()
+
+	store.mu.Lock()
+	store.err = errors.New("redis unavailable")
+	store.mu.Unlock()
+	elector.renew(context.Background())
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("job context survived the lost lease")
+	}
+	if elector.Leading() {
+		t.Fatalf("Leading() = true after a failed renewal")
+	}
+}
+
+func TestNilLeaderElectorAlwaysLeads(t *testing.T) {
+	var elector *LeaderElector
+	elector.Start(context.Background())
+	if _, _, ok := elector.Scope(context.Background()); !ok || !elector.Leading() {
+		t.Fatalf("nil elector does not lead")
+	}
+	elector.Stop(context.Background())
+}
+
+func TestGetSyncStatusReportsLeader(t *testing.T) {
+	store := newFakeSyncStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil)
+	srv := NewIssueSyncAdminGRPCServer(store, svc, &conf.Config{})
+	elector := NewLeaderElector(newFakeLeaseStore(), conf.LeadershipConfig{ReplicaID: "pod-a"})
+	elector.Start(context.Background())
+	defer elector.Stop(context.Background())
+	srv.SetLeaderElector(elector)
+
+	resp, err := srv.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetSyncStatus() error = %v", err)
+	}
+	if leader := resp.GetLeader(); leader.GetHolder() != "pod-a" || leader.GetReplica() != "pod-a" || !leader.GetLeading() {
+		t.Fatalf("leader = %+v, want pod-a leading", leader)
+	}
+}
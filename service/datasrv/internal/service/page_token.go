@@ -0,0 +1,58 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var errInvalidPageToken = errors.New("invalid page_token")
+
+// Page tokens are the keyset cursor of the last item of a page, encoded as URL-safe base64 JSON.
+// They are opaque to clients and only valid for the listing that returned them.
+
+// issuePageToken also records the order the cursor belongs to, as the cursor keys depend on it.
+type issuePageToken struct {
+	Sort      dao.IssueSort `json:"s,omitempty"`
+	Ascending bool          `json:"a,omitempty"`
+	dao.IssueCursor
+}
+
+func encodePageToken(cursor any) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(token string, cursor any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, cursor); err != nil {
+		return errInvalidPageToken
+	}
+	return nil
+}
+
+// decodeIssuePageToken returns the cursor of token, which must come from a listing in the order
+// of filter.
+func decodeIssuePageToken(token string, filter dao.SyncIssueFilter) (*dao.IssueCursor, error) {
+	var decoded issuePageToken
+	if err := decodePageToken(token, &decoded); err != nil {
+		return nil, err
+	}
+	if decoded.Sort != filter.Sort || decoded.Ascending != filter.SortAscending {
+		return nil, errors.New("page_token belongs to another sort order")
+	}
+	return &decoded.IssueCursor, nil
+}
+
+func encodeIssuePageToken(filter dao.SyncIssueFilter, last dao.SyncedIssue) string {
+	return encodePageToken(issuePageToken{
+		Sort:        filter.Sort,
+		Ascending:   filter.SortAscending,
+		IssueCursor: dao.NewIssueCursor(filter.Sort, last),
+	})
+}
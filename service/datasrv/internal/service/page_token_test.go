@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIssuePageTokenRoundTrip(t *testing.T) {
+	closedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	filter := dao.SyncIssueFilter{Sort: dao.IssueSortClosed, SortAscending: true}
+	token := encodeIssuePageToken(filter, dao.SyncedIssue{Repo: "o/r", IssueID: 42, ClosedAt: &closedAt})
+
+	cursor, err := decodeIssuePageToken(token, filter)
+	if err != nil {
+		t.Fatalf("decodeIssuePageToken() error = %v", err)
+	}
+	if cursor.Repo != "o/r" || cursor.IssueID != 42 || cursor.Flag || !cursor.Time.Equal(closedAt) {
+		t.Fatalf("cursor = %+v, want o/r issue 42 closed at %v", cursor, closedAt)
+	}
+
+	if _, err := decodeIssuePageToken(token, dao.SyncIssueFilter{Sort: dao.IssueSortClosed}); err == nil {
+		t.Fatal("decodeIssuePageToken(other direction) error = nil")
+	}
+	if _, err := decodeIssuePageToken("not a token!", filter); err != errInvalidPageToken {
+		t.Fatalf("decodeIssuePageToken(garbage) error = %v, want %v", err, errInvalidPageToken)
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	want := dao.FeedContentCursor{PublishedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), ID: "entry-1"}
+	var got dao.FeedContentCursor
+	if err := decodePageToken(encodePageToken(want), &got); err != nil {
+		t.Fatalf("decodePageToken() error = %v", err)
+	}
+	if got.ID != want.ID || !got.PublishedAt.Equal(want.PublishedAt) {
+		t.Fatalf("cursor = %+v, want %+v", got, want)
+	}
+}
+
+func TestIssueQueryGRPCServer_ListIssuesPageToken(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC()
+	_, _ = store.UpsertIssues(context.Background(), "o/r", []dao.SyncedIssue{
+		{Repo: "o/r", IssueID: 1, Number: 1, Title: "one", State: "open", UpdatedAt: now},
+		{Repo: "o/r", IssueID: 2, Number: 2, Title: "two", State: "open", UpdatedAt: now.Add(-time.Hour)},
+	})
+	srv := NewIssueQueryGRPCServer(store, nil)
+
+	resp, err := srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListIssues() error = %v", err)
+	}
+	if !resp.GetHasNext() || resp.GetNextPageToken() == "" {
+		t.Fatalf("ListIssues() has_next = %v, next_page_token = %q", resp.GetHasNext(), resp.GetNextPageToken())
+	}
+	cursor, err := decodeIssuePageToken(resp.GetNextPageToken(), dao.SyncIssueFilter{})
+	if err != nil {
+		t.Fatalf("decodeIssuePageToken() error = %v", err)
+	}
+	if cursor.IssueID != 1 {
+		t.Fatalf("cursor issue = %d, want 1", cursor.IssueID)
+	}
+
+	_, err = srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", PageToken: resp.GetNextPageToken(), Sort: "number"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ListIssues(token of another sort) code = %v, want InvalidArgument", status.Code(err))
+	}
+	_, err = srv.ListIssues(context.Background(), &issuesv1.ListIssuesRequest{Repo: "o/r", IncludeTotal: true})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("ListIssues(include_total) code = %v, want Unimplemented", status.Code(err))
+	}
+}
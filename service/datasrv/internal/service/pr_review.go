@@ -3,8 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	corelog "butterfly.orx.me/core/log"
@@ -12,7 +12,6 @@ import (
 	genpkg "github.com/kongken/datasrv/pkg/gen"
 	"github.com/kongken/datasrv/service/datasrv/internal/conf"
 	"github.com/kongken/datasrv/service/datasrv/internal/dao"
-	"golang.org/x/oauth2"
 )
 
 const defaultMaxDiffSize = 100 * 1024 // 100KB
@@ -23,7 +22,11 @@ type PRReviewService struct {
 	prStore  dao.PRReviewStore
 	reviewer *genpkg.PRReviewer
 	ghClient *github.Client
-	cfg      conf.PRReviewConfig
+
+	cfgMu sync.RWMutex
+	cfg   conf.PRReviewConfig
+	// runs is nil when the store keeps no run history; runs are then only logged.
+	runs dao.SyncRunStore
 }
 
 type PRReviewRunSummary struct {
@@ -42,32 +45,45 @@ type PRReviewRepoResult struct {
 }
 
 func NewPRReviewService(store dao.SyncStore, prStore dao.PRReviewStore, reviewer *genpkg.PRReviewer, ghCfg conf.GitHubConfig, cfg conf.PRReviewConfig) *PRReviewService {
-	httpClient := http.DefaultClient
-	if ghCfg.Token != "" {
-		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: ghCfg.Token})
-		httpClient = oauth2.NewClient(context.Background(), ts)
-	}
-	ghClient := github.NewClient(httpClient)
-	if ghCfg.BaseURL != "" {
-		if enterpriseClient, err := github.NewClient(httpClient).WithEnterpriseURLs(ghCfg.BaseURL, ghCfg.BaseURL); err == nil {
-			ghClient = enterpriseClient
-		}
-	}
+	ghClient := newGitHubClient(ghCfg)
 
-	return &PRReviewService{
+	svc := &PRReviewService{
 		store:    store,
 		prStore:  prStore,
 		reviewer: reviewer,
 		ghClient: ghClient,
 		cfg:      cfg,
 	}
+	if runs, ok := store.(dao.SyncRunStore); ok {
+		svc.runs = runs
+	}
+	return svc
 }
 
 func (s *PRReviewService) GetConfig() conf.PRReviewConfig {
+	s.cfgMu.RLock()
+	defer s.cfgMu.RUnlock()
+	return s.cfg
+}
+
+// UpdateConfig replaces the config read by the next run. The provider, model and prompt stay
+// those the reviewer was built with.
+func (s *PRReviewService) UpdateConfig(cfg conf.PRReviewConfig) conf.PRReviewConfig {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.cfg = cfg
 	return s.cfg
 }
 
 func (s *PRReviewService) Run(ctx context.Context) (PRReviewRunSummary, error) {
+	summary, err := s.run(ctx)
+	if s != nil {
+		recordSyncRun(ctx, s.runs, prReviewRunRecord(summary, err))
+	}
+	return summary, err
+}
+
+func (s *PRReviewService) run(ctx context.Context) (PRReviewRunSummary, error) {
 	summary := PRReviewRunSummary{
 		StartedAt: time.Now().UTC(),
 	}
@@ -81,6 +97,7 @@ func (s *PRReviewService) Run(ctx context.Context) (PRReviewRunSummary, error) {
 	if s.reviewer == nil {
 		return summary, fmt.Errorf("pr reviewer is not initialized")
 	}
+	cfg := s.GetConfig()
 
 	logger := corelog.FromContext(ctx).With("component", "datasrv.pr_review")
 
@@ -94,7 +111,7 @@ func (s *PRReviewService) Run(ctx context.Context) (PRReviewRunSummary, error) {
 		repoNames = append(repoNames, repo.Repo)
 	}
 
-	maxPRs := s.cfg.MaxPRsPerRun
+	maxPRs := cfg.MaxPRsPerRun
 	if maxPRs <= 0 {
 		maxPRs = 20
 	}
@@ -111,7 +128,7 @@ func (s *PRReviewService) Run(ctx context.Context) (PRReviewRunSummary, error) {
 
 	logger.Info("pr review found unreviewed prs", "count", len(prs))
 
-	maxDiffSize := s.cfg.MaxDiffSize
+	maxDiffSize := cfg.MaxDiffSize
 	if maxDiffSize <= 0 {
 		maxDiffSize = defaultMaxDiffSize
 	}
@@ -162,7 +179,7 @@ func (s *PRReviewService) Run(ctx context.Context) (PRReviewRunSummary, error) {
 		// Generate review with timeout
 		callCtx := ctx
 		cancel := func() {}
-		if timeout := time.Duration(s.cfg.RequestTimeoutSeconds) * time.Second; timeout > 0 {
+		if timeout := time.Duration(cfg.RequestTimeoutSeconds) * time.Second; timeout > 0 {
 			callCtx, cancel = context.WithTimeout(ctx, timeout)
 		}
 
@@ -184,7 +201,7 @@ func (s *PRReviewService) Run(ctx context.Context) (PRReviewRunSummary, error) {
 			RiskAreas:     reviewResult.RiskAreas,
 			Suggestions:   reviewResult.Suggestions,
 			RawDiffSize:   len(diff),
-			ModelUsed:     s.cfg.Model,
+			ModelUsed:     cfg.Model,
 		}
 
 		if err := s.prStore.UpsertPRReview(ctx, review); err != nil {
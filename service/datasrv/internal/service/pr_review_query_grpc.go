@@ -14,11 +14,16 @@ import (
 // PRReviewQueryGRPCServer implements issues.v1.PRReviewQueryService.
 type PRReviewQueryGRPCServer struct {
 	issuesv1.UnimplementedPRReviewQueryServiceServer
-	prStore dao.PRReviewStore
+	prStore      dao.PRReviewStore
+	pullRequests dao.PullRequestStore
 }
 
 func NewPRReviewQueryGRPCServer(prStore dao.PRReviewStore) *PRReviewQueryGRPCServer {
-	return &PRReviewQueryGRPCServer{prStore: prStore}
+	s := &PRReviewQueryGRPCServer{prStore: prStore}
+	if pullRequests, ok := prStore.(dao.PullRequestStore); ok {
+		s.pullRequests = pullRequests
+	}
+	return s
 }
 
 func (s *PRReviewQueryGRPCServer) ListPRReviews(ctx context.Context, req *issuesv1.ListPRReviewsRequest) (*issuesv1.ListPRReviewsResponse, error) {
@@ -36,12 +41,26 @@ func (s *PRReviewQueryGRPCServer) ListPRReviews(ctx context.Context, req *issues
 		pageSize = 100
 	}
 
-	offset := int((page - 1) * pageSize)
-	rows, err := s.prStore.ListPRReviews(ctx, dao.PRReviewFilter{
+	filter := dao.PRReviewFilter{
 		Repo:   req.GetRepo(),
-		Offset: offset,
+		Offset: int((page - 1) * pageSize),
 		Limit:  int(pageSize + 1),
-	})
+	}
+	if req.GetPageToken() != "" {
+		filter.After = &dao.PRReviewCursor{}
+		if err := decodePageToken(req.GetPageToken(), filter.After); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		page, filter.Offset = 0, 0
+	}
+	var counter dao.PRReviewCountStore
+	if req.GetIncludeTotal() {
+		var ok bool
+		if counter, ok = s.prStore.(dao.PRReviewCountStore); !ok {
+			return nil, status.Error(codes.Unimplemented, "pr review totals are not supported by the configured store")
+		}
+	}
+	rows, err := s.prStore.ListPRReviews(ctx, filter)
 	if err != nil {
 		logger.Error("list pr reviews failed", "error", err)
 		return nil, status.Errorf(codes.Internal, "list pr reviews: %v", err)
@@ -57,13 +76,25 @@ func (s *PRReviewQueryGRPCServer) ListPRReviews(ctx context.Context, req *issues
 	for _, row := range rows {
 		reviews = append(reviews, toProtoPRReview(row))
 	}
+	s.attachPullRequests(ctx, rows, reviews)
 
-	return &issuesv1.ListPRReviewsResponse{
+	resp := &issuesv1.ListPRReviewsResponse{
 		Reviews:  reviews,
 		Page:     page,
 		PageSize: pageSize,
 		HasNext:  hasNext,
-	}, nil
+	}
+	if hasNext {
+		last := rows[len(rows)-1]
+		resp.NextPageToken = encodePageToken(dao.PRReviewCursor{UpdatedAt: last.UpdatedAt, IssueID: last.IssueID})
+	}
+	if counter != nil {
+		if resp.Total, err = counter.CountPRReviews(ctx, filter); err != nil {
+			logger.Error("count pr reviews failed", "error", err)
+			return nil, status.Errorf(codes.Internal, "count pr reviews: %v", err)
+		}
+	}
+	return resp, nil
 }
 
 func (s *PRReviewQueryGRPCServer) GetPRReview(ctx context.Context, req *issuesv1.GetPRReviewRequest) (*issuesv1.GetPRReviewResponse, error) {
@@ -82,11 +113,39 @@ func (s *PRReviewQueryGRPCServer) GetPRReview(ctx context.Context, req *issuesv1
 		return nil, status.Errorf(codes.Internal, "get pr review: %v", err)
 	}
 
+	out := toProtoPRReview(review)
+	s.attachPullRequests(ctx, []dao.PRReview{review}, []*issuesv1.PRReview{out})
 	return &issuesv1.GetPRReviewResponse{
-		Review: toProtoPRReview(review),
+		Review: out,
 	}, nil
 }
 
+// attachPullRequests fills pull_request from the synced details; reviews must follow rows.
+func (s *PRReviewQueryGRPCServer) attachPullRequests(ctx context.Context, rows []dao.PRReview, reviews []*issuesv1.PRReview) {
+	if s.pullRequests == nil || len(rows) == 0 {
+		return
+	}
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.IssueID)
+	}
+	details, err := s.pullRequests.ListPullRequests(ctx, ids)
+	if err != nil {
+		corelog.FromContext(ctx).With("component", "datasrv.pr_review_query").
+			Warn("pull request details load failed; continue without pull_request", "error", err)
+		return
+	}
+	byID := make(map[int64]dao.PullRequestDetail, len(details))
+	for _, detail := range details {
+		byID[detail.IssueID] = detail
+	}
+	for i, row := range rows {
+		if detail, ok := byID[row.IssueID]; ok {
+			reviews[i].PullRequest = toProtoPullRequest(detail)
+		}
+	}
+}
+
 func toProtoPRReview(in dao.PRReview) *issuesv1.PRReview {
 	out := &issuesv1.PRReview{
 		Repo:          in.Repo,
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// pullRequestReviewPageSize is the page size used when listing review verdicts.
+const pullRequestReviewPageSize = 100
+
+type pullRequestFetch struct {
+	detail dao.PullRequestDetail
+	// notModified is set when GitHub answered the pull request and all its review pages with 304.
+	notModified bool
+	etags       []dao.GitHubETag
+}
+
+// fetchPullRequestDetail loads the merge state, branches, requested reviewers and review verdicts
+// the issues API leaves out for a pull request, sending each request conditionally. When only one of
+// the pull request and its reviews changed, the other is read again unconditionally: the review
+// decision needs both.
+func (s *IssueSyncService) fetchPullRequestDetail(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, issue dao.SyncedIssue) (pullRequestFetch, error) {
+	number := int(issue.Number)
+	var out pullRequestFetch
+	pr, prNotModified, etags, err := s.getPullRequest(ctx, cfg, repo, owner, name, number)
+	if err != nil {
+		return pullRequestFetch{}, err
+	}
+	out.etags = append(out.etags, etags...)
+	reviews, reviewsNotModified, etags, err := s.listPullRequestReviews(ctx, cfg, repo, owner, name, number)
+	if err != nil {
+		return pullRequestFetch{}, err
+	}
+	out.etags = append(out.etags, etags...)
+
+	switch {
+	case prNotModified && reviewsNotModified:
+		out.notModified = true
+		return out, nil
+	case prNotModified:
+		if pr, _, etags, err = s.getPullRequest(withoutGitHubETags(ctx), cfg, repo, owner, name, number); err != nil {
+			return pullRequestFetch{}, err
+		}
+		out.etags = append(out.etags, etags...)
+	case reviewsNotModified:
+		if reviews, _, etags, err = s.listPullRequestReviews(withoutGitHubETags(ctx), cfg, repo, owner, name, number); err != nil {
+			return pullRequestFetch{}, err
+		}
+		out.etags = append(out.etags, etags...)
+	}
+
+	out.detail = toPullRequestDetail(repo, issue.IssueID, pr, reviews)
+	if out.detail.UpdatedAt.IsZero() {
+		out.detail.UpdatedAt = issue.UpdatedAt
+	}
+	return out, nil
+}
+
+func (s *IssueSyncService) getPullRequest(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, number int) (*github.PullRequest, bool, []dao.GitHubETag, error) {
+	endpoint := fmt.Sprintf("pulls/%d", number)
+	cached := s.lookupETag(ctx, repo, endpoint, 1)
+	var pr *github.PullRequest
+	var resp *github.Response
+	err := s.withGitHubRateLimit(ctx, repo, func() (*github.Response, error) {
+		requestCtx, cancel := requestTimeout(withGitHubETag(ctx, cached), cfg)
+		defer cancel()
+		var err error
+		pr, resp, err = s.client.GetPullRequest(requestCtx, owner, name, number)
+		return resp, err
+	})
+	if githubNotModified(resp) {
+		return nil, true, nil, nil
+	}
+	if err != nil {
+		return nil, false, nil, fmt.Errorf("get pull request %s#%d: %w", repo, number, err)
+	}
+	var etags []dao.GitHubETag
+	if etag, ok := githubETagFor(repo, endpoint, 1, resp); ok {
+		etags = append(etags, etag)
+	}
+	return pr, false, etags, nil
+}
+
+// listPullRequestReviews lists every review page conditionally. Verdicts are folded across pages, so
+// unless GitHub answers all of them with 304 the listing is read again unconditionally.
+func (s *IssueSyncService) listPullRequestReviews(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string, number int) ([]*github.PullRequestReview, bool, []dao.GitHubETag, error) {
+	endpoint := fmt.Sprintf("pulls/%d/reviews", number)
+	var reviews []*github.PullRequestReview
+	var etags []dao.GitHubETag
+	pages, unchanged := 0, 0
+	page := 1
+	for {
+		cached := s.lookupETag(ctx, repo, endpoint, page)
+		var pageReviews []*github.PullRequestReview
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, repo, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(withGitHubETag(ctx, cached), cfg)
+			defer cancel()
+			var err error
+			pageReviews, resp, err = s.client.ListPullRequestReviews(requestCtx, owner, name, number, &github.ListOptions{
+				Page:    page,
+				PerPage: pullRequestReviewPageSize,
+			})
+			return resp, err
+		})
+		if err != nil && !githubNotModified(resp) {
+			return nil, false, nil, fmt.Errorf("list reviews %s#%d: %w", repo, number, err)
+		}
+		pages++
+
+		nextPage := cached.NextPage
+		if githubNotModified(resp) {
+			unchanged++
+		} else {
+			reviews = append(reviews, pageReviews...)
+			nextPage = 0
+			if len(pageReviews) > 0 && resp != nil {
+				nextPage = resp.NextPage
+			}
+			if etag, ok := githubETagFor(repo, endpoint, page, resp); ok {
+				etags = append(etags, etag)
+			}
+		}
+		if nextPage == 0 {
+			break
+		}
+		page = nextPage
+	}
+
+	switch {
+	case unchanged == pages:
+		return nil, true, nil, nil
+	case unchanged > 0:
+		return s.listPullRequestReviews(withoutGitHubETags(ctx), cfg, repo, owner, name, number)
+	}
+	return reviews, false, etags, nil
+}
+
+func toPullRequestDetail(repo string, issueID int64, pr *github.PullRequest, reviews []*github.PullRequestReview) dao.PullRequestDetail {
+	detail := dao.PullRequestDetail{
+		Repo:         repo,
+		IssueID:      issueID,
+		Number:       int32(pr.GetNumber()),
+		Merged:       pr.GetMerged(),
+		MergedBy:     pr.GetMergedBy().GetLogin(),
+		Draft:        pr.GetDraft(),
+		HeadRef:      pr.GetHead().GetRef(),
+		HeadSHA:      pr.GetHead().GetSHA(),
+		BaseRef:      pr.GetBase().GetRef(),
+		Additions:    int32(pr.GetAdditions()),
+		Deletions:    int32(pr.GetDeletions()),
+		ChangedFiles: int32(pr.GetChangedFiles()),
+		UpdatedAt:    pr.GetUpdatedAt().Time,
+		SyncedAt:     time.Now().UTC(),
+	}
+	if pr.MergedAt != nil {
+		t := pr.GetMergedAt().Time
+		detail.MergedAt = &t
+	}
+	for _, u := range pr.RequestedReviewers {
+		if login := u.GetLogin(); login != "" {
+			detail.RequestedReviewers = append(detail.RequestedReviewers, login)
+		}
+	}
+	for _, team := range pr.RequestedTeams {
+		if slug := team.GetSlug(); slug != "" {
+			detail.RequestedTeams = append(detail.RequestedTeams, slug)
+		}
+	}
+	detail.Reviews = latestReviewVerdicts(reviews)
+	detail.ReviewDecision = reviewDecision(detail)
+	return detail
+}
+
+// latestReviewVerdicts keeps the latest verdict of each reviewer in submission order. Pending reviews
+// are not visible to others yet, and a later comment-only review does not withdraw an approval or a
+// change request.
+func latestReviewVerdicts(reviews []*github.PullRequestReview) []dao.PullRequestReviewVerdict {
+	var verdicts []dao.PullRequestReviewVerdict
+	index := make(map[string]int)
+	for _, r := range reviews {
+		reviewer := r.GetUser().GetLogin()
+		state := strings.ToUpper(r.GetState())
+		if reviewer == "" || state == "" || state == "PENDING" {
+			continue
+		}
+		verdict := dao.PullRequestReviewVerdict{
+			Reviewer:    reviewer,
+			State:       state,
+			SubmittedAt: r.GetSubmittedAt().Time,
+		}
+		i, ok := index[reviewer]
+		if !ok {
+			index[reviewer] = len(verdicts)
+			verdicts = append(verdicts, verdict)
+			continue
+		}
+		if state == "COMMENTED" && verdicts[i].State != "COMMENTED" {
+			continue
+		}
+		verdicts[i] = verdict
+	}
+	return verdicts
+}
+
+// reviewDecision mirrors how GitHub summarizes reviews: an outstanding change request wins, then
+// pending review requests, then approvals.
+func reviewDecision(detail dao.PullRequestDetail) string {
+	approved := false
+	for _, v := range detail.Reviews {
+		switch v.State {
+		case "CHANGES_REQUESTED":
+			return dao.ReviewDecisionChangesRequested
+		case "APPROVED":
+			approved = true
+		}
+	}
+	if len(detail.RequestedReviewers) > 0 || len(detail.RequestedTeams) > 0 {
+		return dao.ReviewDecisionReviewRequired
+	}
+	if approved {
+		return dao.ReviewDecisionApproved
+	}
+	return dao.ReviewDecisionReviewRequired
+}
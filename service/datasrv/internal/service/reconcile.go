@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// reconcileStoredPageSize bounds how many stored issues are held per ListIssues call.
+const reconcileStoredPageSize = 500
+
+type ReconcileRepoResult struct {
+	Repo string
+	// Checked counts stored issues compared against GitHub.
+	Checked     int32
+	Tombstoned  int32
+	Transferred int32
+	// Restored counts tombstoned issues GitHub lists again.
+	Restored int32
+	Err      string
+}
+
+type ReconcileRunSummary struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Results    []ReconcileRepoResult
+}
+
+// issueProbe is what GitHub reports for a stored issue missing from the repo listing.
+type issueProbe struct {
+	// reason is empty when the issue still lives in the repo.
+	reason        string
+	transferredTo string
+	// moved is the destination issue when GitHub followed a transfer redirect.
+	moved *github.Issue
+}
+
+func (s *IssueSyncService) IsReconciling() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reconciling
+}
+
+// RunReconcile walks the full issue set of each repo and tombstones stored issues GitHub no
+// longer lists: deleted (410), transferred (301 or a different repository) or missing (404),
+// which covers issues converted to discussions. Repos are reconciled one at a time since every
+// run lists each repo completely.
+func (s *IssueSyncService) RunReconcile(ctx context.Context, onlyRepo string) (ReconcileRunSummary, error) {
+	if s.tombstones == nil {
+		return ReconcileRunSummary{}, fmt.Errorf("sync store does not support issue tombstones")
+	}
+	s.mu.Lock()
+	if s.reconciling {
+		s.mu.Unlock()
+		return ReconcileRunSummary{}, fmt.Errorf("reconcile is already running")
+	}
+	s.reconciling = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.reconciling = false
+		s.mu.Unlock()
+	}()
+
+	cfg := s.GetConfig()
+	summary := ReconcileRunSummary{StartedAt: time.Now()}
+	if !cfg.Enabled {
+		summary.FinishedAt = time.Now()
+		return summary, nil
+	}
+
+	managed, err := s.loadReposForRun(ctx, cfg, "")
+	if err != nil {
+		return ReconcileRunSummary{}, err
+	}
+	repos := managed
+	if onlyRepo != "" {
+		repos = []string{strings.TrimSpace(onlyRepo)}
+	}
+	managedSet := make(map[string]struct{}, len(managed))
+	for _, repo := range managed {
+		managedSet[strings.ToLower(repo)] = struct{}{}
+	}
+
+	for _, repo := range repos {
+		// The sweep relies on GitHub transfer and deletion semantics; other trackers are left out.
+		if _, ok, _ := parseProviderRepo(repo); ok && onlyRepo == "" {
+			continue
+		}
+		summary.Results = append(summary.Results, s.reconcileOneRepo(ctx, cfg, repo, managedSet))
+	}
+	summary.FinishedAt = time.Now()
+	return summary, nil
+}
+
+func (s *IssueSyncService) reconcileOneRepo(ctx context.Context, cfg conf.GitHubSyncConfig, repo string, managed map[string]struct{}) ReconcileRepoResult {
+	result := ReconcileRepoResult{Repo: repo}
+	if _, ok, _ := parseProviderRepo(repo); ok {
+		result.Err = "reconcile only sweeps GitHub repos"
+		return result
+	}
+	owner, name, err := splitRepo(repo)
+	if err != nil {
+		result.Err = err.Error()
+		return result
+	}
+
+	startedAt := time.Now()
+	live, err := s.listLiveIssues(ctx, cfg, owner, name)
+	if err != nil {
+		issueSyncLogger.Error("issue reconcile list issues failed", "repo", repo, "error", err)
+		result.Err = err.Error()
+		return result
+	}
+
+	var restored []dao.SyncedIssue
+	var tombstones []dao.IssueTombstone
+	for offset := 0; ; offset += reconcileStoredPageSize {
+		stored, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{
+			Repo:              repo,
+			State:             "all",
+			Offset:            offset,
+			Limit:             reconcileStoredPageSize,
+			IncludeTombstoned: true,
+		})
+		if err != nil {
+			result.Err = err.Error()
+			return result
+		}
+
+		for _, row := range stored {
+			if listed, ok := live[row.Number]; ok {
+				if row.Tombstone != "" {
+					restored = append(restored, toSyncedIssue(repo, listed))
+				}
+				continue
+			}
+			if row.Tombstone != "" {
+				continue
+			}
+			result.Checked++
+
+			probe, err := s.probeIssue(ctx, cfg, owner, name, int(row.Number))
+			if err != nil {
+				issueSyncLogger.Error("issue reconcile probe failed",
+					"repo", repo,
+					"issue_number", row.Number,
+					"error", err,
+				)
+				result.Err = err.Error()
+				return result
+			}
+			if probe.reason == "" {
+				continue
+			}
+			if probe.moved != nil {
+				if err := s.moveTransferredIssue(ctx, row, probe, managed); err != nil {
+					result.Err = err.Error()
+					return result
+				}
+			}
+			tombstones = append(tombstones, dao.IssueTombstone{
+				Repo:          repo,
+				IssueID:       row.IssueID,
+				Reason:        probe.reason,
+				TransferredTo: probe.transferredTo,
+				At:            time.Now().UTC(),
+			})
+			if probe.reason == dao.TombstoneTransferred {
+				result.Transferred++
+			}
+		}
+		if len(stored) < reconcileStoredPageSize {
+			break
+		}
+	}
+
+	if len(restored) > 0 {
+		n, err := s.store.UpsertIssues(ctx, repo, restored)
+		if err != nil {
+			result.Err = err.Error()
+			return result
+		}
+		result.Restored = int32(n)
+	}
+	if len(tombstones) > 0 {
+		n, err := s.tombstones.TombstoneIssues(ctx, tombstones)
+		if err != nil {
+			result.Err = err.Error()
+			return result
+		}
+		result.Tombstoned = int32(n)
+	}
+	issueSyncLogger.Info("issue reconcile repo finished",
+		"repo", repo,
+		"live_count", len(live),
+		"checked", result.Checked,
+		"tombstoned", result.Tombstoned,
+		"transferred", result.Transferred,
+		"restored", result.Restored,
+		"duration_ms", time.Since(startedAt).Milliseconds(),
+	)
+	return result
+}
+
+// listLiveIssues pages through every issue and pull request GitHub lists for a repo, keyed by number.
+// The walk is unconditional so that a cached page cannot hide a deletion.
+func (s *IssueSyncService) listLiveIssues(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string) (map[int32]*github.Issue, error) {
+	live := make(map[int32]*github.Issue)
+	currentPage := 1
+	for {
+		issues, resp, err := s.listByRepoWithRetry(ctx, cfg, owner, repo, &github.IssueListByRepoOptions{
+			State:       "all",
+			Sort:        "created",
+			Direction:   "asc",
+			ListOptions: github.ListOptions{Page: currentPage, PerPage: cfg.PageSize},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range issues {
+			live[int32(it.GetNumber())] = it
+		}
+		if len(issues) == 0 || resp == nil || resp.NextPage == 0 {
+			return live, nil
+		}
+		currentPage = resp.NextPage
+	}
+}
+
+// probeIssue fetches one issue directly to learn why the repo listing no longer contains it.
+func (s *IssueSyncService) probeIssue(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string, number int) (issueProbe, error) {
+	var issue *github.Issue
+	var resp *github.Response
+	err := s.withGitHubRateLimit(ctx, owner+"/"+repo, func() (*github.Response, error) {
+		requestCtx, cancel := requestTimeout(ctx, cfg)
+		defer cancel()
+		var err error
+		issue, resp, err = s.client.Get(requestCtx, owner, repo, number)
+		return resp, err
+	})
+
+	var redirect *github.RedirectionError
+	switch {
+	case errors.As(err, &redirect):
+		return issueProbe{reason: dao.TombstoneTransferred, transferredTo: githubIssueRefFromURL(redirect.Location)}, nil
+	case err != nil && resp != nil && resp.StatusCode == http.StatusGone:
+		return issueProbe{reason: dao.TombstoneDeleted}, nil
+	case err != nil && resp != nil && resp.StatusCode == http.StatusNotFound:
+		return issueProbe{reason: dao.TombstoneMissing}, nil
+	case err != nil:
+		return issueProbe{}, fmt.Errorf("get issue %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	// GitHub answers a transferred issue with a redirect the client follows to the new repository.
+	dest := githubRepoFromURL(issue.GetRepositoryURL())
+	if dest == "" || strings.EqualFold(dest, owner+"/"+repo) {
+		return issueProbe{}, nil
+	}
+	return issueProbe{
+		reason:        dao.TombstoneTransferred,
+		transferredTo: fmt.Sprintf("%s#%d", dest, issue.GetNumber()),
+		moved:         issue,
+	}, nil
+}
+
+// moveTransferredIssue stores the destination of a transfer into another managed repo right away,
+// keeping the AI summary generated for the original.
+func (s *IssueSyncService) moveTransferredIssue(ctx context.Context, row dao.SyncedIssue, probe issueProbe, managed map[string]struct{}) error {
+	dest := githubRepoFromURL(probe.moved.GetRepositoryURL())
+	if _, ok := managed[strings.ToLower(dest)]; !ok {
+		return nil
+	}
+	moved := toSyncedIssue(dest, probe.moved)
+	moved.AISummary = row.AISummary
+	if _, err := s.store.UpsertIssues(ctx, dest, []dao.SyncedIssue{moved}); err != nil {
+		return fmt.Errorf("store transferred issue %s: %w", probe.transferredTo, err)
+	}
+	return nil
+}
+
+// githubRepoFromURL extracts owner/repo from an API URL such as https://api.github.com/repos/o/r/issues/1.
+func githubRepoFromURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+2 < len(parts); i++ {
+		if parts[i] == "repos" {
+			return parts[i+1] + "/" + parts[i+2]
+		}
+	}
+	return ""
+}
+
+// githubIssueRefFromURL renders an issue API URL as owner/repo#number, or returns "" when it is not one.
+func githubIssueRefFromURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	repo := githubRepoFromURL(u.String())
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if repo == "" || len(parts) < 2 || parts[len(parts)-2] != "issues" {
+		return ""
+	}
+	if _, err := strconv.Atoi(parts[len(parts)-1]); err != nil {
+		return ""
+	}
+	return repo + "#" + parts[len(parts)-1]
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+func TestRunReconcileTombstonesMissingIssues(t *testing.T) {
+	store := newFakeSyncStore()
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo"}
+	store.managed["owner/other"] = dao.ManagedRepo{Repo: "owner/other"}
+	now := time.Now().UTC()
+	_, _ = store.UpsertIssues(context.Background(), "owner/repo", []dao.SyncedIssue{
+		{Repo: "owner/repo", IssueID: 1, Number: 1, UpdatedAt: now},
+		{Repo: "owner/repo", IssueID: 2, Number: 2, UpdatedAt: now},
+		{Repo: "owner/repo", IssueID: 3, Number: 3, UpdatedAt: now},
+		{Repo: "owner/repo", IssueID: 4, Number: 4, UpdatedAt: now, AISummary: "moved summary"},
+		{Repo: "owner/repo", IssueID: 5, Number: 5, UpdatedAt: now},
+	})
+	_, _ = store.TombstoneIssues(context.Background(), []dao.IssueTombstone{
+		{Repo: "owner/repo", IssueID: 5, Reason: dao.TombstoneMissing, At: now},
+	})
+
+	moved := ghIssue(40, 9, now)
+	moved.RepositoryURL = github.Ptr("https://api.github.com/repos/owner/other")
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(1, 1, now), ghIssue(5, 5, now)}},
+		},
+		getResponses: map[int]fakeGitHubGetResponse{
+			2: {status: http.StatusGone},
+			3: {status: http.StatusNotFound},
+			4: {issue: moved},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	summary, err := svc.RunReconcile(context.Background(), "owner/repo")
+	if err != nil {
+		t.Fatalf("RunReconcile() error = %v", err)
+	}
+	want := ReconcileRepoResult{Repo: "owner/repo", Checked: 3, Tombstoned: 3, Transferred: 1, Restored: 1}
+	if len(summary.Results) != 1 || summary.Results[0] != want {
+		t.Fatalf("results = %#v, want %#v", summary.Results, want)
+	}
+	if len(client.calls) != 1 || client.calls[0].opts.Since != (time.Time{}) || client.calls[0].opts.State != "all" {
+		t.Fatalf("list calls = %#v, want one full listing", client.calls)
+	}
+	slices.Sort(client.getCalls)
+	if !slices.Equal(client.getCalls, []int{2, 3, 4}) {
+		t.Fatalf("probed = %v, want [2 3 4]", client.getCalls)
+	}
+
+	visible, _ := store.ListIssues(context.Background(), dao.SyncIssueFilter{Repo: "owner/repo"})
+	if len(visible) != 2 {
+		t.Fatalf("visible issues = %#v, want #1 and restored #5", visible)
+	}
+	all, _ := store.ListIssues(context.Background(), dao.SyncIssueFilter{Repo: "owner/repo", IncludeTombstoned: true})
+	reasons := map[int32]string{}
+	for _, row := range all {
+		reasons[row.Number] = row.Tombstone + "|" + row.TransferredTo
+	}
+	wantReasons := map[int32]string{
+		1: "|",
+		2: dao.TombstoneDeleted + "|",
+		3: dao.TombstoneMissing + "|",
+		4: dao.TombstoneTransferred + "|owner/other#9",
+		5: "|",
+	}
+	for number, reason := range wantReasons {
+		if reasons[number] != reason {
+			t.Fatalf("issue #%d tombstone = %q, want %q", number, reasons[number], reason)
+		}
+	}
+
+	dest, _ := store.ListIssues(context.Background(), dao.SyncIssueFilter{Repo: "owner/other"})
+	if len(dest) != 1 || dest[0].Number != 9 || dest[0].AISummary != "moved summary" {
+		t.Fatalf("destination issues = %#v, want #9 carrying the summary", dest)
+	}
+}
+
+func TestRunReconcileKeepsIssuesWhenListingFails(t *testing.T) {
+	store := newFakeSyncStore()
+	_, _ = store.UpsertIssues(context.Background(), "owner/repo", []dao.SyncedIssue{
+		{Repo: "owner/repo", IssueID: 1, Number: 1, UpdatedAt: time.Now().UTC()},
+	})
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{err: context.DeadlineExceeded},
+			{err: context.DeadlineExceeded},
+			{err: context.DeadlineExceeded},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	summary, err := svc.RunReconcile(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunReconcile() error = %v", err)
+	}
+	if summary.Results[0].Err == "" || summary.Results[0].Tombstoned != 0 {
+		t.Fatalf("result = %#v, want a listing error and no tombstones", summary.Results[0])
+	}
+	if len(client.getCalls) != 0 {
+		t.Fatalf("probed = %v, want none", client.getCalls)
+	}
+}
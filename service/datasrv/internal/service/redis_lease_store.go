@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	redisstore "butterfly.orx.me/core/store/redis"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultLeaseRedisName = "default"
+	leaseKeyPrefix        = "datasrv:lease:"
+)
+
+// acquireLeaseScript extends the lease when ARGV[1] already holds it and takes it when it is free.
+var acquireLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript deletes the lease only when ARGV[1] holds it, so a replica whose lease already
+// expired cannot drop its successor's.
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisLeaseStore keeps leases as Redis keys that expire unless their holder renews them.
+type RedisLeaseStore struct {
+	client *redis.Client
+}
+
+func NewRedisLeaseStore(name string) *RedisLeaseStore {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = defaultLeaseRedisName
+	}
+	return &RedisLeaseStore{client: redisstore.GetClient(name)}
+}
+
+func (s *RedisLeaseStore) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	if s.client == nil {
+		return false, errors.New("redis client is not configured")
+	}
+	acquired, err := acquireLeaseScript.Run(ctx, s.client, []string{leaseKeyPrefix + name}, holder, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return acquired == 1, nil
+}
+
+func (s *RedisLeaseStore) ReleaseLease(ctx context.Context, name, holder string) error {
+	if s.client == nil {
+		return errors.New("redis client is not configured")
+	}
+	return releaseLeaseScript.Run(ctx, s.client, []string{leaseKeyPrefix + name}, holder).Err()
+}
+
+func (s *RedisLeaseStore) LeaseHolder(ctx context.Context, name string) (string, error) {
+	if s.client == nil {
+		return "", errors.New("redis client is not configured")
+	}
+	holder, err := s.client.Get(ctx, leaseKeyPrefix+name).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return holder, err
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+const (
+	githubLabelsEndpoint     = "labels"
+	githubMilestonesEndpoint = "milestones"
+	githubCatalogPerPage     = 100
+)
+
+// syncRepoCatalog replaces the stored labels and milestones of a repo. Both lists are requested
+// conditionally and left alone when GitHub reports them unchanged.
+func (s *IssueSyncService) syncRepoCatalog(ctx context.Context, cfg conf.GitHubSyncConfig, repo, owner, name string) error {
+	labels, labelETags, err := fetchCatalogPages(ctx, s, repo, githubLabelsEndpoint, func(ctx context.Context, page int) ([]*github.Label, *github.Response, error) {
+		var items []*github.Label
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, repo, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(ctx, cfg)
+			defer cancel()
+			var err error
+			items, resp, err = s.client.ListLabels(requestCtx, owner, name, &github.ListOptions{Page: page, PerPage: githubCatalogPerPage})
+			return resp, err
+		})
+		return items, resp, err
+	})
+	if err != nil {
+		return fmt.Errorf("list labels for %s: %w", repo, err)
+	}
+	if labels != nil {
+		rows := make([]dao.RepoLabel, 0, len(labels))
+		for _, it := range labels {
+			rows = append(rows, dao.RepoLabel{
+				Repo:        repo,
+				LabelID:     it.GetID(),
+				Name:        it.GetName(),
+				Color:       it.GetColor(),
+				Description: it.GetDescription(),
+			})
+		}
+		if err := s.catalog.ReplaceRepoLabels(ctx, repo, rows); err != nil {
+			return fmt.Errorf("store labels for %s: %w", repo, err)
+		}
+		for _, etag := range labelETags {
+			s.saveETag(ctx, etag)
+		}
+	}
+
+	milestones, milestoneETags, err := fetchCatalogPages(ctx, s, repo, githubMilestonesEndpoint, func(ctx context.Context, page int) ([]*github.Milestone, *github.Response, error) {
+		var items []*github.Milestone
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, repo, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(ctx, cfg)
+			defer cancel()
+			var err error
+			items, resp, err = s.client.ListMilestones(requestCtx, owner, name, &github.MilestoneListOptions{
+				State:       "all",
+				ListOptions: github.ListOptions{Page: page, PerPage: githubCatalogPerPage},
+			})
+			return resp, err
+		})
+		return items, resp, err
+	})
+	if err != nil {
+		return fmt.Errorf("list milestones for %s: %w", repo, err)
+	}
+	if milestones != nil {
+		rows := make([]dao.RepoMilestone, 0, len(milestones))
+		for _, it := range milestones {
+			rows = append(rows, toRepoMilestone(repo, it))
+		}
+		if err := s.catalog.ReplaceRepoMilestones(ctx, repo, rows); err != nil {
+			return fmt.Errorf("store milestones for %s: %w", repo, err)
+		}
+		for _, etag := range milestoneETags {
+			s.saveETag(ctx, etag)
+		}
+	}
+
+	issueSyncLogger.Info("issue sync catalog finished",
+		"repo", repo,
+		"labels_changed", labels != nil,
+		"label_count", len(labels),
+		"milestones_changed", milestones != nil,
+		"milestone_count", len(milestones),
+	)
+	return nil
+}
+
+// fetchCatalogPages lists every page of a catalog endpoint with stored validators. It returns nil
+// items when every page was answered with 304; otherwise 304 pages are fetched again without
+// validators so the returned list is complete. The validators are returned for saving once the
+// list is stored.
+func fetchCatalogPages[T any](ctx context.Context, s *IssueSyncService, repo, endpoint string, list func(ctx context.Context, page int) ([]T, *github.Response, error)) ([]T, []dao.GitHubETag, error) {
+	var pages [][]T
+	var unchanged []int
+	var etags []dao.GitHubETag
+	currentPage := 1
+	for {
+		cached := s.lookupETag(ctx, repo, endpoint, currentPage)
+		items, resp, err := list(withGitHubETag(ctx, cached), currentPage)
+		if err != nil && !githubNotModified(resp) {
+			return nil, nil, err
+		}
+
+		nextPage := cached.NextPage
+		if githubNotModified(resp) {
+			unchanged = append(unchanged, len(pages))
+			pages = append(pages, nil)
+		} else {
+			pages = append(pages, items)
+			nextPage = 0
+			if resp != nil {
+				nextPage = resp.NextPage
+			}
+			if etag, ok := githubETagFor(repo, endpoint, currentPage, resp); ok {
+				etags = append(etags, etag)
+			}
+		}
+		if nextPage == 0 {
+			break
+		}
+		currentPage = nextPage
+	}
+
+	if len(unchanged) == len(pages) {
+		return nil, nil, nil
+	}
+	for _, idx := range unchanged {
+		items, _, err := list(ctx, idx+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		pages[idx] = items
+	}
+	out := make([]T, 0)
+	for _, page := range pages {
+		out = append(out, page...)
+	}
+	return out, etags, nil
+}
+
+func toRepoMilestone(repo string, in *github.Milestone) dao.RepoMilestone {
+	out := dao.RepoMilestone{
+		Repo:        repo,
+		MilestoneID: in.GetID(),
+		Number:      int32(in.GetNumber()),
+		Title:       in.GetTitle(),
+		Description: in.GetDescription(),
+		State:       in.GetState(),
+	}
+	if in.DueOn != nil {
+		t := in.GetDueOn().Time
+		out.DueOn = &t
+	}
+	if in.ClosedAt != nil {
+		t := in.GetClosedAt().Time
+		out.ClosedAt = &t
+	}
+	return out
+}
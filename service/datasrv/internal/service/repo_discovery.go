@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// ErrInvalidRepoRule reports a managed repo entry that is neither owner/repo nor a discovery rule.
+var ErrInvalidRepoRule = errors.New("invalid managed repo rule")
+
+// repoRule is one managed repo entry. Explicit rules name a single repo; the others are expanded
+// against the repos of owner by repo discovery.
+type repoRule struct {
+	raw   string
+	owner string
+	// name is a repo name or a path.Match pattern; "?" always starts the filter query.
+	name     string
+	explicit bool
+	// topics must all be present on a matching repo.
+	topics []string
+	// archived and fork are nil when not given: archived repos are then skipped, forks are not.
+	archived *bool
+	fork     *bool
+}
+
+// parseRepoRule accepts owner/repo, owner/<glob> and either form without a name followed by
+// filters, as in owner?topic=backend&archived=false&fork=false, as well as repos on other trackers
+// such as gitlab:group/project.
+func parseRepoRule(raw string) (repoRule, error) {
+	raw = strings.TrimSpace(raw)
+	if _, ok, err := parseProviderRepo(raw); ok {
+		if err != nil {
+			return repoRule{}, fmt.Errorf("%w: %v", ErrInvalidRepoRule, err)
+		}
+		// Repos on other trackers are always explicit; discovery only lists GitHub repos.
+		slash := strings.LastIndex(raw, "/")
+		return repoRule{raw: raw, owner: raw[:slash], name: raw[slash+1:], explicit: true}, nil
+	}
+	spec, query, hasQuery := strings.Cut(raw, "?")
+	owner, name, hasName := strings.Cut(spec, "/")
+	if !hasName && hasQuery {
+		name = "*"
+	}
+	if owner == "" || name == "" || strings.Contains(name, "/") {
+		return repoRule{}, fmt.Errorf("%w %q, expect owner/repo, owner/<glob> or owner?<filters>", ErrInvalidRepoRule, raw)
+	}
+	if _, err := path.Match(strings.ToLower(name), ""); err != nil {
+		return repoRule{}, fmt.Errorf("%w %q: %v", ErrInvalidRepoRule, raw, err)
+	}
+
+	rule := repoRule{raw: raw, owner: owner, name: name}
+	if !hasQuery {
+		rule.explicit = !strings.ContainsAny(name, "*[")
+		return rule, nil
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return repoRule{}, fmt.Errorf("%w %q: %v", ErrInvalidRepoRule, raw, err)
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		vals := values[key]
+		switch key {
+		case "topic":
+			for _, v := range vals {
+				if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+					rule.topics = append(rule.topics, v)
+				}
+			}
+		case "archived", "fork":
+			b, err := strconv.ParseBool(vals[len(vals)-1])
+			if err != nil {
+				return repoRule{}, fmt.Errorf("%w %q: %s must be true or false", ErrInvalidRepoRule, raw, key)
+			}
+			if key == "archived" {
+				rule.archived = &b
+			} else {
+				rule.fork = &b
+			}
+		default:
+			return repoRule{}, fmt.Errorf("%w %q: unknown filter %q", ErrInvalidRepoRule, raw, key)
+		}
+	}
+	return rule, nil
+}
+
+// parseRepoRules normalizes and parses managed repo entries, failing on the first invalid one.
+func parseRepoRules(entries []string) ([]repoRule, error) {
+	normalized := normalizeManagedRepos(entries)
+	rules := make([]repoRule, 0, len(normalized))
+	for _, entry := range normalized {
+		rule, err := parseRepoRule(entry)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (r repoRule) matches(repo *github.Repository) bool {
+	if ok, _ := path.Match(strings.ToLower(r.name), strings.ToLower(repo.GetName())); !ok {
+		return false
+	}
+	wantArchived := false
+	if r.archived != nil {
+		wantArchived = *r.archived
+	}
+	if repo.GetArchived() != wantArchived {
+		return false
+	}
+	if r.fork != nil && repo.GetFork() != *r.fork {
+		return false
+	}
+	for _, topic := range r.topics {
+		found := false
+		for _, have := range repo.Topics {
+			if strings.EqualFold(have, topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveManagedRepos builds the managed repo set for rules. expanded holds the repos each
+// pattern matched in this run; a pattern missing from it keeps the repos it is tagged with in
+// current. Explicit rules win over patterns, then patterns claim repos in rule order.
+func resolveManagedRepos(rules []repoRule, current []dao.ManagedRepo, expanded map[string][]string) []dao.ManagedRepo {
+	claimed := make(map[string]struct{})
+	var out []dao.ManagedRepo
+	claim := func(repo, rule string) {
+		key := strings.ToLower(repo)
+		if _, ok := claimed[key]; ok {
+			return
+		}
+		claimed[key] = struct{}{}
+		out = append(out, dao.ManagedRepo{Repo: repo, Rule: rule})
+	}
+
+	for _, rule := range rules {
+		if rule.explicit {
+			claim(rule.owner+"/"+rule.name, rule.raw)
+		}
+	}
+	for _, rule := range rules {
+		if rule.explicit {
+			continue
+		}
+		if repos, ok := expanded[rule.raw]; ok {
+			for _, repo := range repos {
+				claim(repo, rule.raw)
+			}
+			continue
+		}
+		for _, row := range current {
+			if row.Rule == rule.raw {
+				claim(row.Repo, rule.raw)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Repo < out[j].Repo })
+	return out
+}
+
+type RepoDiscoveryRuleResult struct {
+	Rule string
+	// Matched counts the repos the rule contributed to the managed set.
+	Matched int32
+	Err     string
+}
+
+type RepoDiscoverySummary struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Results    []RepoDiscoveryRuleResult
+	Added      int32
+	Removed    int32
+	Repos      []dao.ManagedRepo
+}
+
+func (s *IssueSyncService) IsDiscovering() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.discovering
+}
+
+// RunRepoDiscovery expands the pattern rules through the GitHub repos API and replaces the managed
+// repo set with the result, dropping repos that no longer match. A rule that cannot be expanded
+// keeps the repos it found before, so an API outage does not unmanage them.
+func (s *IssueSyncService) RunRepoDiscovery(ctx context.Context) (RepoDiscoverySummary, error) {
+	if s.repoRules == nil {
+		return RepoDiscoverySummary{}, fmt.Errorf("sync store does not support repo discovery")
+	}
+	s.mu.Lock()
+	if s.discovering {
+		s.mu.Unlock()
+		return RepoDiscoverySummary{}, fmt.Errorf("repo discovery is already running")
+	}
+	s.discovering = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.discovering = false
+		s.mu.Unlock()
+	}()
+
+	cfg := s.GetConfig()
+	summary := RepoDiscoverySummary{StartedAt: time.Now()}
+	entries, err := s.repoRules.ListManagedRepoRules(ctx)
+	if err != nil {
+		return RepoDiscoverySummary{}, fmt.Errorf("list managed repo rules: %w", err)
+	}
+	current, err := s.store.ListManagedRepos(ctx)
+	if err != nil {
+		return RepoDiscoverySummary{}, fmt.Errorf("list managed repos: %w", err)
+	}
+	if len(entries) == 0 {
+		// Repos stored before rules existed are left alone.
+		summary.Repos = current
+		summary.FinishedAt = time.Now()
+		return summary, nil
+	}
+	rules, err := parseRepoRules(entries)
+	if err != nil {
+		return RepoDiscoverySummary{}, err
+	}
+
+	expanded := make(map[string][]string)
+	failed := make(map[string]string)
+	owners := make(map[string][]*github.Repository)
+	ownerErrs := make(map[string]error)
+	for _, rule := range rules {
+		if rule.explicit {
+			continue
+		}
+		key := strings.ToLower(rule.owner)
+		listed, ok := owners[key]
+		if !ok && ownerErrs[key] == nil {
+			listed, err = s.listOwnerRepos(ctx, cfg, rule.owner)
+			if err != nil {
+				issueSyncLogger.Error("repo discovery list repos failed", "owner", rule.owner, "error", err)
+				ownerErrs[key] = err
+			} else {
+				owners[key] = listed
+			}
+		}
+		if err := ownerErrs[key]; err != nil {
+			failed[rule.raw] = err.Error()
+			continue
+		}
+		names := make([]string, 0)
+		for _, repo := range listed {
+			if !rule.matches(repo) {
+				continue
+			}
+			name := repo.GetFullName()
+			if name == "" {
+				name = rule.owner + "/" + repo.GetName()
+			}
+			names = append(names, name)
+		}
+		expanded[rule.raw] = names
+	}
+
+	repos := resolveManagedRepos(rules, current, expanded)
+	stored, err := s.repoRules.ReplaceRuleManagedRepos(ctx, repos)
+	if err != nil {
+		return RepoDiscoverySummary{}, fmt.Errorf("replace managed repos: %w", err)
+	}
+
+	matched := make(map[string]int32, len(rules))
+	after := make(map[string]struct{}, len(repos))
+	for _, repo := range repos {
+		matched[repo.Rule]++
+		after[strings.ToLower(repo.Repo)] = struct{}{}
+	}
+	before := make(map[string]struct{}, len(current))
+	for _, repo := range current {
+		key := strings.ToLower(repo.Repo)
+		before[key] = struct{}{}
+		if _, ok := after[key]; !ok {
+			summary.Removed++
+		}
+	}
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			summary.Added++
+		}
+	}
+	for _, rule := range rules {
+		summary.Results = append(summary.Results, RepoDiscoveryRuleResult{
+			Rule:    rule.raw,
+			Matched: matched[rule.raw],
+			Err:     failed[rule.raw],
+		})
+	}
+	summary.Repos = stored
+	summary.FinishedAt = time.Now()
+	issueSyncLogger.Info("repo discovery finished",
+		"rule_count", len(rules),
+		"repo_count", len(repos),
+		"added", summary.Added,
+		"removed", summary.Removed,
+		"failed_rules", len(failed),
+	)
+	return summary, nil
+}
+
+// listOwnerRepos pages through the repositories of an organization, falling back to the user repos
+// endpoint when owner turns out to be a personal account.
+func (s *IssueSyncService) listOwnerRepos(ctx context.Context, cfg conf.GitHubSyncConfig, owner string) ([]*github.Repository, error) {
+	var out []*github.Repository
+	org := true
+	page := 1
+	for {
+		var repos []*github.Repository
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, owner, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(ctx, cfg)
+			defer cancel()
+			var err error
+			listOpts := github.ListOptions{Page: page, PerPage: cfg.PageSize}
+			if org {
+				repos, resp, err = s.client.ListOrgRepos(requestCtx, owner, &github.RepositoryListByOrgOptions{
+					Type:        "all",
+					ListOptions: listOpts,
+				})
+			} else {
+				repos, resp, err = s.client.ListUserRepos(requestCtx, owner, &github.RepositoryListByUserOptions{
+					Type:        "owner",
+					ListOptions: listOpts,
+				})
+			}
+			return resp, err
+		})
+		if err != nil && org && page == 1 && resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotFound {
+			org = false
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list repos of %s: %w", owner, err)
+		}
+		out = append(out, repos...)
+		if len(repos) == 0 || resp == nil || resp.NextPage == 0 {
+			return out, nil
+		}
+		page = resp.NextPage
+	}
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+func TestParseRepoRule(t *testing.T) {
+	tests := []struct {
+		raw      string
+		explicit bool
+		name     string
+		topics   []string
+		wantErr  bool
+	}{
+		{raw: "owner/repo", explicit: true, name: "repo"},
+		{raw: "owner/*", name: "*"},
+		{raw: "owner/service-*", name: "service-*"},
+		{raw: "owner?topic=backend&topic=Go&archived=false", name: "*", topics: []string{"backend", "go"}},
+		{raw: "owner/api-*?fork=false", name: "api-*"},
+		{raw: "owner", wantErr: true},
+		{raw: "owner/", wantErr: true},
+		{raw: "owner/a/b", wantErr: true},
+		{raw: "owner/[", wantErr: true},
+		{raw: "owner?visibility=private", wantErr: true},
+		{raw: "owner?archived=maybe", wantErr: true},
+	}
+	for _, tt := range tests {
+		rule, err := parseRepoRule(tt.raw)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidRepoRule) {
+				t.Fatalf("parseRepoRule(%q) error = %v, want ErrInvalidRepoRule", tt.raw, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parseRepoRule(%q) error = %v", tt.raw, err)
+		}
+		if rule.owner != "owner" || rule.name != tt.name || rule.explicit != tt.explicit || !slices.Equal(rule.topics, tt.topics) {
+			t.Fatalf("parseRepoRule(%q) = %+v", tt.raw, rule)
+		}
+	}
+}
+
+func TestRepoRuleMatches(t *testing.T) {
+	repo := func(name string, archived, fork bool, topics ...string) *github.Repository {
+		return &github.Repository{Name: github.Ptr(name), Archived: github.Ptr(archived), Fork: github.Ptr(fork), Topics: topics}
+	}
+	tests := []struct {
+		raw  string
+		repo *github.Repository
+		want bool
+	}{
+		{"owner/service-*", repo("Service-API", false, false), true},
+		{"owner/service-*", repo("web", false, false), false},
+		{"owner/*", repo("old", true, false), false},
+		{"owner?archived=true", repo("old", true, false), true},
+		{"owner?fork=false", repo("copy", false, true), false},
+		{"owner/*", repo("copy", false, true), true},
+		{"owner?topic=backend&topic=go", repo("api", false, false, "Backend", "go"), true},
+		{"owner?topic=backend&topic=go", repo("api", false, false, "backend"), false},
+	}
+	for _, tt := range tests {
+		rule, err := parseRepoRule(tt.raw)
+		if err != nil {
+			t.Fatalf("parseRepoRule(%q) error = %v", tt.raw, err)
+		}
+		if got := rule.matches(tt.repo); got != tt.want {
+			t.Fatalf("%q matches %q = %v, want %v", tt.raw, tt.repo.GetName(), got, tt.want)
+		}
+	}
+}
+
+func TestRunRepoDiscoveryExpandsRules(t *testing.T) {
+	store := newFakeSyncStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{RequestTimeoutSeconds: 5}, nil)
+	ghRepo := func(owner, name string, topics ...string) *github.Repository {
+		return &github.Repository{Name: github.Ptr(name), FullName: github.Ptr(owner + "/" + name), Topics: topics}
+	}
+	client := &fakeGitHubIssueClient{
+		orgRepos: map[string][]*github.Repository{
+			"org": {ghRepo("org", "service-a"), ghRepo("org", "service-b", "backend"), ghRepo("org", "web", "backend")},
+		},
+		userRepos: map[string][]*github.Repository{
+			"alice": {ghRepo("alice", "dotfiles"), ghRepo("alice", "tool", "backend")},
+		},
+	}
+	svc.client = client
+
+	// ghost/* was discovered earlier and cannot be listed now, so its repos must be kept.
+	store.managed["ghost/kept"] = dao.ManagedRepo{Repo: "ghost/kept", Rule: "ghost/*"}
+	store.managed["org/service-old"] = dao.ManagedRepo{Repo: "org/service-old", Rule: "org/service-*"}
+	if _, err := svc.ReplaceManagedRepos(context.Background(), []string{
+		"org/service-*", "org?topic=backend", "alice?topic=backend", "ghost/*", "org/service-b",
+	}); err != nil {
+		t.Fatalf("ReplaceManagedRepos() error = %v", err)
+	}
+
+	summary, err := svc.RunRepoDiscovery(context.Background())
+	if err != nil {
+		t.Fatalf("RunRepoDiscovery() error = %v", err)
+	}
+
+	got := make(map[string]string)
+	for _, repo := range summary.Repos {
+		got[repo.Repo] = repo.Rule
+	}
+	want := map[string]string{
+		"alice/tool":    "alice?topic=backend",
+		"ghost/kept":    "ghost/*",
+		"org/service-a": "org/service-*",
+		"org/service-b": "org/service-b",
+		"org/web":       "org?topic=backend",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("repos = %#v, want %#v", got, want)
+	}
+	for repo, rule := range want {
+		if got[repo] != rule {
+			t.Fatalf("repo %s rule = %q, want %q (all: %#v)", repo, got[repo], rule, got)
+		}
+	}
+	if summary.Added != 3 || summary.Removed != 1 {
+		t.Fatalf("added/removed = %d/%d, want 3/1", summary.Added, summary.Removed)
+	}
+	for _, result := range summary.Results {
+		switch result.Rule {
+		case "ghost/*":
+			if result.Err == "" || result.Matched != 1 {
+				t.Fatalf("ghost result = %#v, want error and 1 kept repo", result)
+			}
+		default:
+			if result.Err != "" || result.Matched != 1 {
+				t.Fatalf("result = %#v, want 1 match", result)
+			}
+		}
+	}
+	if len(store.managed) != len(want) {
+		t.Fatalf("stored managed repos = %d, want %d", len(store.managed), len(want))
+	}
+}
+
+func TestReplaceManagedReposRejectsInvalidRules(t *testing.T) {
+	store := newFakeSyncStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil)
+	if _, err := svc.ReplaceManagedRepos(context.Background(), []string{"owner/repo", "owner?stars=10"}); !errors.Is(err, ErrInvalidRepoRule) {
+		t.Fatalf("ReplaceManagedRepos() error = %v, want ErrInvalidRepoRule", err)
+	}
+	if len(store.rules) != 0 || len(store.managed) != 0 {
+		t.Fatalf("store changed after invalid rule: rules=%v managed=%v", store.rules, store.managed)
+	}
+}
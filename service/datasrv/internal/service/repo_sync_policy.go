@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// ErrInvalidRepoSyncPolicy reports a repo sync policy with an unknown state or a negative interval.
+var ErrInvalidRepoSyncPolicy = errors.New("invalid repo sync policy")
+
+func normalizeRepoSyncPolicy(in dao.RepoSyncPolicy) (dao.RepoSyncPolicy, error) {
+	switch in.State = strings.ToLower(strings.TrimSpace(in.State)); in.State {
+	case "all":
+		in.State = ""
+	case "", "open", "closed":
+	default:
+		return dao.RepoSyncPolicy{}, fmt.Errorf("%w: state %q, expect open, closed or all", ErrInvalidRepoSyncPolicy, in.State)
+	}
+	if in.IntervalSeconds < 0 {
+		return dao.RepoSyncPolicy{}, fmt.Errorf("%w: interval_seconds must not be negative", ErrInvalidRepoSyncPolicy)
+	}
+	in.IncludeLabels = normalizeLabelNames(in.IncludeLabels)
+	in.ExcludeLabels = normalizeLabelNames(in.ExcludeLabels)
+	if in.SyncSince != nil {
+		since := in.SyncSince.UTC()
+		in.SyncSince = &since
+	}
+	return in, nil
+}
+
+func normalizeLabelNames(labels []string) []string {
+	var out []string
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" || slices.ContainsFunc(out, func(have string) bool { return strings.EqualFold(have, label) }) {
+			continue
+		}
+		out = append(out, label)
+	}
+	return out
+}
+
+// GetManagedRepo returns one managed repo with its sync policy.
+func (s *IssueSyncService) GetManagedRepo(ctx context.Context, repo string) (dao.ManagedRepo, error) {
+	repos, err := s.store.ListManagedRepos(ctx)
+	if err != nil {
+		return dao.ManagedRepo{}, err
+	}
+	for _, it := range repos {
+		if strings.EqualFold(it.Repo, strings.TrimSpace(repo)) {
+			return it, nil
+		}
+	}
+	return dao.ManagedRepo{}, dao.ErrManagedRepoNotFound
+}
+
+// UpdateRepoSyncPolicy replaces the sync policy of a managed repo. The policy takes effect with the
+// next run and only for issues updated after the repo checkpoint.
+func (s *IssueSyncService) UpdateRepoSyncPolicy(ctx context.Context, repo string, policy dao.RepoSyncPolicy) (dao.ManagedRepo, error) {
+	if s.policies == nil {
+		return dao.ManagedRepo{}, fmt.Errorf("sync store does not support repo sync policies")
+	}
+	current, err := s.GetManagedRepo(ctx, repo)
+	if err != nil {
+		return dao.ManagedRepo{}, err
+	}
+	normalized, err := normalizeRepoSyncPolicy(policy)
+	if err != nil {
+		return dao.ManagedRepo{}, err
+	}
+	return s.policies.UpdateRepoSyncPolicy(ctx, current.Repo, normalized)
+}
+
+// repoSyncPolicies maps managed repos to their sync policy, keyed by lower-cased owner/repo.
+func (s *IssueSyncService) repoSyncPolicies(ctx context.Context) (map[string]dao.RepoSyncPolicy, error) {
+	repos, err := s.store.ListManagedRepos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list managed repos: %w", err)
+	}
+	out := make(map[string]dao.RepoSyncPolicy, len(repos))
+	for _, repo := range repos {
+		out[strings.ToLower(repo.Repo)] = repo.Policy
+	}
+	return out, nil
+}
+
+// repoSyncDue reports whether the repo interval has elapsed since its last run. A tenth of the
+// interval is forgiven since the checkpoint is written when the previous run ends, not when it starts.
+func (s *IssueSyncService) repoSyncDue(ctx context.Context, cfg conf.GitHubSyncConfig, repo string, policy dao.RepoSyncPolicy, now time.Time) bool {
+	interval := time.Duration(cfg.IntervalSeconds) * time.Second
+	if policy.IntervalSeconds > 0 {
+		interval = time.Duration(policy.IntervalSeconds) * time.Second
+	}
+	cp, err := s.store.GetRepoCheckpoint(ctx, repo)
+	if err != nil || cp.LastSyncedAt.IsZero() {
+		return true
+	}
+	return now.Sub(cp.LastSyncedAt) >= interval-interval/10
+}
+
+// issueListState is the state passed to the GitHub issue listing for a policy.
+func issueListState(policy dao.RepoSyncPolicy) string {
+	if policy.State == "" {
+		return "all"
+	}
+	return policy.State
+}
+
+// policySkipsIssue reports whether the policy keeps a listed issue out of the store.
+func policySkipsIssue(policy dao.RepoSyncPolicy, issue dao.SyncedIssue) bool {
+	if policy.ExcludePullRequests && issue.IsPullRequest {
+		return true
+	}
+	hasLabel := func(labels []string) bool {
+		for _, label := range issue.Labels {
+			if slices.ContainsFunc(labels, func(want string) bool { return strings.EqualFold(want, label) }) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(policy.IncludeLabels) > 0 && !hasLabel(policy.IncludeLabels) {
+		return true
+	}
+	return hasLabel(policy.ExcludeLabels)
+}
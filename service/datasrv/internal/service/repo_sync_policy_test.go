@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+func TestRunSyncHonorsRepoSyncPolicy(t *testing.T) {
+	store := newFakeSyncStore()
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo", Policy: dao.RepoSyncPolicy{
+		State:               "open",
+		ExcludeLabels:       []string{"wontfix"},
+		ExcludePullRequests: true,
+		SkipComments:        true,
+		SyncSince:           &since,
+	}}
+	comments := newFakeIssueCommentStore()
+	now := time.Now().UTC()
+	pr := ghIssue(2, 2, now)
+	pr.PullRequestLinks = &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/2")}
+	noisy := ghIssue(3, 3, now.Add(time.Minute))
+	noisy.Labels = []*github.Label{{Name: github.Ptr("WontFix")}}
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{{issues: []*github.Issue{ghIssue(1, 1, now), pr, noisy}}},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		RequestTimeoutSeconds: 5,
+	}, comments)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(summary.Results) != 1 || summary.Results[0].Fetched != 1 || summary.Results[0].Err != "" {
+		t.Fatalf("results = %#v, want one fetched issue", summary.Results)
+	}
+	if len(client.calls) != 1 || client.calls[0].opts.State != "open" || !client.calls[0].opts.Since.Equal(since) {
+		t.Fatalf("list calls = %#v, want state open since %v", client.calls, since)
+	}
+	if rows := store.issues["owner/repo"]; len(rows) != 1 || rows[0].Number != 1 {
+		t.Fatalf("stored issues = %#v, want only #1", rows)
+	}
+	if len(comments.saved) != 0 {
+		t.Fatalf("saved comments = %#v, want none", comments.saved)
+	}
+	if cp := store.checkpoints["owner/repo"]; !cp.LastIssueUpdatedAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("checkpoint = %v, want skipped issues to advance it", cp.LastIssueUpdatedAt)
+	}
+}
+
+func TestRunSyncSkipsDisabledAndNotDueRepos(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC()
+	store.managed["owner/off"] = dao.ManagedRepo{Repo: "owner/off", Policy: dao.RepoSyncPolicy{Disabled: true}}
+	store.managed["owner/fast"] = dao.ManagedRepo{Repo: "owner/fast", Policy: dao.RepoSyncPolicy{IntervalSeconds: 60}}
+	store.managed["owner/slow"] = dao.ManagedRepo{Repo: "owner/slow"}
+	store.checkpoints["owner/fast"] = dao.Checkpoint{Repo: "owner/fast", LastSyncedAt: now.Add(-2 * time.Minute)}
+	store.checkpoints["owner/slow"] = dao.Checkpoint{Repo: "owner/slow", LastSyncedAt: now.Add(-2 * time.Minute)}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		IntervalSeconds:       300,
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = &fakeGitHubIssueClient{}
+
+	scheduled, err := svc.RunScheduledSync(context.Background())
+	if err != nil {
+		t.Fatalf("RunScheduledSync() error = %v", err)
+	}
+	if len(scheduled.Results) != 1 || scheduled.Results[0].Repo != "owner/fast" {
+		t.Fatalf("scheduled results = %#v, want only owner/fast", scheduled.Results)
+	}
+
+	all, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(all.Results) != 2 {
+		t.Fatalf("all-repo results = %#v, want owner/fast and owner/slow", all.Results)
+	}
+
+	one, err := svc.RunSync(context.Background(), "owner/off")
+	if err != nil {
+		t.Fatalf("RunSync(owner/off) error = %v", err)
+	}
+	if len(one.Results) != 1 || one.Results[0].Repo != "owner/off" {
+		t.Fatalf("single repo results = %#v, want owner/off", one.Results)
+	}
+}
+
+func TestUpdateRepoSyncPolicy(t *testing.T) {
+	store := newFakeSyncStore()
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo"}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil)
+
+	updated, err := svc.UpdateRepoSyncPolicy(context.Background(), "Owner/Repo", dao.RepoSyncPolicy{
+		State:         "ALL",
+		IncludeLabels: []string{" bug ", "Bug", ""},
+	})
+	if err != nil {
+		t.Fatalf("UpdateRepoSyncPolicy() error = %v", err)
+	}
+	if updated.Policy.State != "" || len(updated.Policy.IncludeLabels) != 1 || updated.Policy.IncludeLabels[0] != "bug" {
+		t.Fatalf("policy = %#v, want normalized state and labels", updated.Policy)
+	}
+
+	if _, err := svc.UpdateRepoSyncPolicy(context.Background(), "owner/repo", dao.RepoSyncPolicy{State: "merged"}); !errors.Is(err, ErrInvalidRepoSyncPolicy) {
+		t.Fatalf("invalid state error = %v, want ErrInvalidRepoSyncPolicy", err)
+	}
+	if _, err := svc.UpdateRepoSyncPolicy(context.Background(), "owner/missing", dao.RepoSyncPolicy{}); !errors.Is(err, dao.ErrManagedRepoNotFound) {
+		t.Fatalf("missing repo error = %v, want ErrManagedRepoNotFound", err)
+	}
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+const (
+	// ResyncModeFull relists every issue of the repo from the beginning.
+	ResyncModeFull = "full"
+	// ResyncModeSince relists the issues updated at or after a given time.
+	ResyncModeSince = "since"
+	// ResyncModeComments refetches the comments of every stored issue without relisting issues.
+	ResyncModeComments = "comments"
+)
+
+const (
+	ResyncJobRunning   = "running"
+	ResyncJobSucceeded = "succeeded"
+	ResyncJobFailed    = "failed"
+)
+
+// resyncJobHistory bounds how many finished resync jobs are kept for polling.
+const resyncJobHistory = 50
+
+var (
+	// ErrInvalidResyncRequest reports an unknown resync mode or a since mode without a time.
+	ErrInvalidResyncRequest = errors.New("invalid resync request")
+	// ErrResyncJobNotFound reports a job id that never existed or was evicted from the history.
+	ErrResyncJobNotFound = errors.New("resync job not found")
+	// ErrResyncInProgress reports a resync request for a repo that already has one running.
+	ErrResyncInProgress = errors.New("resync already running for repo")
+)
+
+// ResyncJob is the progress of one background resync. Pages counts persisted issue pages, or
+// stored issue batches in comments mode.
+type ResyncJob struct {
+	ID    string
+	Repo  string
+	Mode  string
+	Since time.Time
+	State string
+
+	Pages     int32
+	Fetched   int32
+	Persisted int32
+	// Comments counts issues whose comment threads were refetched in comments mode.
+	Comments int32
+	Err      string
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// ResyncRepo starts a background resync of a managed repo and returns the job to poll. Unlike a
+// regular run it pages until the listing is exhausted, ignores stored validators and leaves the
+// scheduler free; the scheduler skips the repo until the job is done.
+func (s *IssueSyncService) ResyncRepo(ctx context.Context, repo, mode string, since time.Time) (ResyncJob, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	switch mode {
+	case "":
+		mode = ResyncModeFull
+	case ResyncModeFull, ResyncModeComments:
+	case ResyncModeSince:
+		if since.IsZero() {
+			return ResyncJob{}, fmt.Errorf("%w: since mode requires a since time", ErrInvalidResyncRequest)
+		}
+	default:
+		return ResyncJob{}, fmt.Errorf("%w: mode %q, expect full, since or comments", ErrInvalidResyncRequest, mode)
+	}
+	if mode != ResyncModeSince {
+		since = time.Time{}
+	}
+	if mode == ResyncModeComments && s.commentStore == nil {
+		return ResyncJob{}, fmt.Errorf("%w: no comment store is configured", ErrInvalidResyncRequest)
+	}
+
+	managed, err := s.GetManagedRepo(ctx, repo)
+	if err != nil {
+		return ResyncJob{}, err
+	}
+	if err := s.validateSyncRepo(managed.Repo); err != nil {
+		return ResyncJob{}, fmt.Errorf("%w: %v", ErrInvalidResyncRequest, err)
+	}
+
+	s.mu.Lock()
+	if s.resyncingRepo(managed.Repo) {
+		s.mu.Unlock()
+		return ResyncJob{}, fmt.Errorf("%w %s", ErrResyncInProgress, managed.Repo)
+	}
+	job := &ResyncJob{
+		ID:        newRunID(),
+		Repo:      managed.Repo,
+		Mode:      mode,
+		Since:     since.UTC(),
+		State:     ResyncJobRunning,
+		StartedAt: time.Now(),
+	}
+	if s.resyncJobs == nil {
+		s.resyncJobs = make(map[string]*ResyncJob)
+	}
+	s.resyncJobs[job.ID] = job
+	snapshot := *job
+	s.mu.Unlock()
+
+	issueSyncLogger.Info("issue resync started",
+		"job_id", job.ID,
+		"repo", job.Repo,
+		"mode", job.Mode,
+		"since", job.Since,
+	)
+	// The job outlives the admin request that started it.
+	go s.runResyncJob(context.WithoutCancel(ctx), job.ID, managed)
+	return snapshot, nil
+}
+
+// GetResyncJob returns the current progress of a resync job.
+func (s *IssueSyncService) GetResyncJob(id string) (ResyncJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.resyncJobs[id]
+	if !ok {
+		return ResyncJob{}, ErrResyncJobNotFound
+	}
+	return *job, nil
+}
+
+// ListResyncJobs returns the running and recently finished resync jobs, newest first.
+func (s *IssueSyncService) ListResyncJobs() []ResyncJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]ResyncJob, 0, len(s.resyncJobs))
+	for _, job := range s.resyncJobs {
+		out = append(out, *job)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartedAt.After(out[j].StartedAt) })
+	return out
+}
+
+// resyncingRepo reports whether repo has a running resync job. s.mu must be held.
+func (s *IssueSyncService) resyncingRepo(repo string) bool {
+	for _, job := range s.resyncJobs {
+		if job.State == ResyncJobRunning && strings.EqualFold(job.Repo, repo) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *IssueSyncService) runResyncJob(ctx context.Context, id string, managed dao.ManagedRepo) {
+	s.mu.Lock()
+	job := *s.resyncJobs[id]
+	s.mu.Unlock()
+
+	cfg := s.GetConfig()
+	var result SyncRepoResult
+	if job.Mode == ResyncModeComments {
+		result = s.resyncRepoComments(ctx, cfg, id, job.Repo)
+	} else {
+		since := job.Since
+		result = s.syncRepoIssues(ctx, cfg, job.Repo, managed.Policy, repoSyncRun{
+			since:         &since,
+			unbounded:     true,
+			unconditional: true,
+			progress: func(pages int32, sofar SyncRepoResult) {
+				s.updateResyncJob(id, func(job *ResyncJob) {
+					job.Pages = pages
+					job.Fetched = sofar.Fetched
+					job.Persisted = sofar.Persisted
+				})
+			},
+		})
+	}
+
+	s.updateResyncJob(id, func(job *ResyncJob) {
+		job.Fetched = result.Fetched
+		job.Persisted = result.Persisted
+		job.Err = result.Err
+		job.State = ResyncJobSucceeded
+		if result.Err != "" {
+			job.State = ResyncJobFailed
+		}
+		job.FinishedAt = time.Now()
+	})
+	final, _ := s.GetResyncJob(id)
+	recordSyncRun(ctx, s.runs, dao.SyncRun{
+		JobType:    dao.SyncRunJobIssueSync,
+		StartedAt:  final.StartedAt,
+		FinishedAt: final.FinishedAt,
+		Targets: []dao.SyncRunTarget{{
+			Target:    final.Repo,
+			Fetched:   final.Fetched,
+			Persisted: final.Persisted,
+			Failed:    failedCount(final.Err),
+			Err:       final.Err,
+		}},
+	})
+	issueSyncLogger.Info("issue resync finished",
+		"job_id", id,
+		"repo", final.Repo,
+		"mode", final.Mode,
+		"state", final.State,
+		"pages", final.Pages,
+		"fetched", final.Fetched,
+		"persisted", final.Persisted,
+		"comments", final.Comments,
+		"duration_ms", final.FinishedAt.Sub(final.StartedAt).Milliseconds(),
+		"error", final.Err,
+	)
+
+	s.mu.Lock()
+	s.evictResyncJobs()
+	s.mu.Unlock()
+}
+
+// resyncRepoComments walks the stored issues of repo and refetches every comment thread
+// unconditionally. Issues themselves are left as stored.
+func (s *IssueSyncService) resyncRepoComments(ctx context.Context, cfg conf.GitHubSyncConfig, id, repo string) SyncRepoResult {
+	result := SyncRepoResult{Repo: repo}
+	refresh, err := s.commentRefresher(cfg, repo)
+	if err != nil {
+		result.Err = err.Error()
+		return result
+	}
+	ctx = withoutGitHubETags(ctx)
+
+	var pages int32
+	for offset := 0; ; offset += reconcileStoredPageSize {
+		stored, err := s.store.ListIssues(ctx, dao.SyncIssueFilter{
+			Repo:   repo,
+			State:  "all",
+			Offset: offset,
+			Limit:  reconcileStoredPageSize,
+		})
+		if err != nil {
+			result.Err = err.Error()
+			return result
+		}
+		var refreshed int32
+		for i := range stored {
+			if stored[i].Comments <= 0 {
+				continue
+			}
+			if err := refresh(ctx, &stored[i]); err != nil {
+				issueSyncLogger.Error("issue resync comments failed",
+					"repo", repo,
+					"issue_number", stored[i].Number,
+					"error", err,
+				)
+				result.Err = err.Error()
+				return result
+			}
+			refreshed++
+		}
+		pages++
+		s.updateResyncJob(id, func(job *ResyncJob) {
+			job.Pages = pages
+			job.Comments += refreshed
+		})
+		if len(stored) < reconcileStoredPageSize {
+			return result
+		}
+	}
+}
+
+// commentRefresher returns the function that refetches and stores the comment thread of an issue of
+// repo, through GitHub or the provider of a prefixed repo.
+func (s *IssueSyncService) commentRefresher(cfg conf.GitHubSyncConfig, repo string) (func(context.Context, *dao.SyncedIssue) error, error) {
+	target, ok, err := parseProviderRepo(repo)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		provider, err := s.issueProvider(target)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, issue *dao.SyncedIssue) error {
+			return s.syncProviderComments(ctx, cfg, provider, target, issue)
+		}, nil
+	}
+	owner, name, err := splitRepo(repo)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, issue *dao.SyncedIssue) error {
+		return s.syncIssueComments(ctx, cfg, repo, owner, name, issue)
+	}, nil
+}
+
+func (s *IssueSyncService) updateResyncJob(id string, update func(*ResyncJob)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job, ok := s.resyncJobs[id]; ok {
+		update(job)
+	}
+}
+
+// evictResyncJobs drops the oldest finished jobs beyond resyncJobHistory. s.mu must be held.
+func (s *IssueSyncService) evictResyncJobs() {
+	var finished []*ResyncJob
+	for _, job := range s.resyncJobs {
+		if job.State != ResyncJobRunning {
+			finished = append(finished, job)
+		}
+	}
+	if len(finished) <= resyncJobHistory {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool { return finished[i].FinishedAt.Before(finished[j].FinishedAt) })
+	for _, job := range finished[:len(finished)-resyncJobHistory] {
+		delete(s.resyncJobs, job.ID)
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+func waitResyncJob(t *testing.T, svc *IssueSyncService, id string) ResyncJob {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := svc.GetResyncJob(id)
+		if err != nil {
+			t.Fatalf("GetResyncJob(%s) error = %v", id, err)
+		}
+		if job.State != ResyncJobRunning {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("resync job %s still running: %#v", id, job)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestResyncRepoFullPagesPastMaxPagesUnconditionally(t *testing.T) {
+	store := newFakeSyncStore()
+	checkpoint := time.Now().UTC().Round(time.Second)
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo"}
+	store.checkpoints["owner/repo"] = dao.Checkpoint{Repo: "owner/repo", LastIssueUpdatedAt: checkpoint}
+	store.etags["owner/repo|issues|1"] = dao.GitHubETag{Repo: "owner/repo", Endpoint: githubIssuesEndpoint, Page: 1, ETag: `W/"regular"`}
+	old := checkpoint.Add(-30 * 24 * time.Hour)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(1, 1, old)}, nextPage: 2, etag: `W/"backfill-1"`},
+			{issues: []*github.Issue{ghIssue(2, 2, old.Add(time.Hour))}, nextPage: 3},
+			{issues: []*github.Issue{ghIssue(3, 3, old.Add(2*time.Hour))}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		MaxPagesPerRun:        1,
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	started, err := svc.ResyncRepo(context.Background(), "Owner/Repo", "", time.Time{})
+	if err != nil {
+		t.Fatalf("ResyncRepo() error = %v", err)
+	}
+	if started.Repo != "owner/repo" || started.Mode != ResyncModeFull || started.State != ResyncJobRunning {
+		t.Fatalf("started job = %#v", started)
+	}
+
+	job := waitResyncJob(t, svc, started.ID)
+	if job.State != ResyncJobSucceeded || job.Pages != 3 || job.Fetched != 3 || job.Persisted != 3 {
+		t.Fatalf("job = %#v, want 3 pages synced", job)
+	}
+	if len(client.calls) != 3 {
+		t.Fatalf("list calls = %d, want 3 despite max_pages_per_run 1", len(client.calls))
+	}
+	for _, call := range client.calls {
+		if !call.opts.Since.IsZero() || call.ifNoneMatch != "" {
+			t.Fatalf("list call = %#v, want no since and no validator", call)
+		}
+	}
+	if got := store.etags["owner/repo|issues|1"].ETag; got != `W/"regular"` {
+		t.Fatalf("stored page etag = %q, want the regular run validator kept", got)
+	}
+	if cp := store.checkpoints["owner/repo"]; !cp.LastIssueUpdatedAt.Equal(checkpoint) || cp.LastRunStatus != "success" {
+		t.Fatalf("checkpoint = %#v, want cursor kept at %v", cp, checkpoint)
+	}
+	if len(store.issues["owner/repo"]) != 3 {
+		t.Fatalf("stored issues = %d, want 3", len(store.issues["owner/repo"]))
+	}
+}
+
+func TestResyncRepoCommentsRefreshesStoredIssues(t *testing.T) {
+	store := newFakeSyncStore()
+	comments := newFakeIssueCommentStore()
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo"}
+	store.issues["owner/repo"] = []dao.SyncedIssue{
+		{Repo: "owner/repo", IssueID: 10, Number: 1, State: "closed", Comments: 2},
+		{Repo: "owner/repo", IssueID: 20, Number: 2, State: "open"},
+	}
+	store.etags["owner/repo|issues/1/comments|1"] = dao.GitHubETag{Repo: "owner/repo", Endpoint: "issues/1/comments", Page: 1, ETag: `W/"old"`}
+	client := &fakeGitHubIssueClient{
+		commentResponses: map[int][]fakeGitHubCommentResponse{
+			1: {{comments: []*github.IssueComment{{ID: github.Ptr(int64(11))}, {ID: github.Ptr(int64(12))}}, etag: `W/"new"`}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{RequestTimeoutSeconds: 5}, comments)
+	svc.client = client
+
+	started, err := svc.ResyncRepo(context.Background(), "owner/repo", ResyncModeComments, time.Time{})
+	if err != nil {
+		t.Fatalf("ResyncRepo() error = %v", err)
+	}
+	job := waitResyncJob(t, svc, started.ID)
+	if job.State != ResyncJobSucceeded || job.Comments != 1 || job.Pages != 1 {
+		t.Fatalf("job = %#v, want one issue refreshed", job)
+	}
+	if len(client.calls) != 0 {
+		t.Fatalf("list calls = %d, want issues left alone", len(client.calls))
+	}
+	if got := comments.saved["owner/repo/10-1.json"]; len(got) != 2 {
+		t.Fatalf("saved comments = %#v, want 2", got)
+	}
+	if got := store.etags["owner/repo|issues/1/comments|1"].ETag; got != `W/"new"` {
+		t.Fatalf("stored comment etag = %q, want refreshed validator", got)
+	}
+}
+
+func TestResyncRepoValidationAndSchedulerExclusion(t *testing.T) {
+	store := newFakeSyncStore()
+	store.managed["owner/repo"] = dao.ManagedRepo{Repo: "owner/repo"}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = &fakeGitHubIssueClient{}
+	ctx := context.Background()
+
+	if _, err := svc.ResyncRepo(ctx, "owner/repo", "everything", time.Time{}); !errors.Is(err, ErrInvalidResyncRequest) {
+		t.Fatalf("unknown mode error = %v, want ErrInvalidResyncRequest", err)
+	}
+	if _, err := svc.ResyncRepo(ctx, "owner/repo", ResyncModeSince, time.Time{}); !errors.Is(err, ErrInvalidResyncRequest) {
+		t.Fatalf("since without time error = %v, want ErrInvalidResyncRequest", err)
+	}
+	if _, err := svc.ResyncRepo(ctx, "owner/repo", ResyncModeComments, time.Time{}); !errors.Is(err, ErrInvalidResyncRequest) {
+		t.Fatalf("comments without store error = %v, want ErrInvalidResyncRequest", err)
+	}
+	if _, err := svc.ResyncRepo(ctx, "owner/missing", ResyncModeFull, time.Time{}); !errors.Is(err, dao.ErrManagedRepoNotFound) {
+		t.Fatalf("missing repo error = %v, want ErrManagedRepoNotFound", err)
+	}
+	if _, err := svc.GetResyncJob("nope"); !errors.Is(err, ErrResyncJobNotFound) {
+		t.Fatalf("GetResyncJob() error = %v, want ErrResyncJobNotFound", err)
+	}
+
+	svc.resyncJobs = map[string]*ResyncJob{"busy": {ID: "busy", Repo: "owner/repo", State: ResyncJobRunning}}
+	if _, err := svc.ResyncRepo(ctx, "owner/repo", ResyncModeFull, time.Time{}); !errors.Is(err, ErrResyncInProgress) {
+		t.Fatalf("second resync error = %v, want ErrResyncInProgress", err)
+	}
+	summary, err := svc.RunSync(ctx, "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(summary.Results) != 0 {
+		t.Fatalf("results = %#v, want repo under resync skipped", summary.Results)
+	}
+}
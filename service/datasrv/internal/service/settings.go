@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+var settingsLogger = slog.Default().With("component", "datasrv.settings")
+
+// Settings sections that can be changed through the admin API, named like their config file keys.
+const (
+	SettingsGitHubSync   = "github_sync"
+	SettingsFeedSync     = "feed_sync"
+	SettingsIssueSummary = "issue_summary"
+	SettingsPRReview     = "pr_review"
+)
+
+var (
+	ErrUnknownSettingsSection = errors.New("unknown settings section")
+	ErrInvalidSettings        = errors.New("invalid settings")
+)
+
+// The settings documents hold the fields of a config section that may change at runtime. Their
+// JSON keys match the config file, so a saved document decodes straight over the section. Repos,
+// feed sources, credentials, providers and models stay with the config file.
+
+type GitHubSyncSettings struct {
+	Enabled                  bool `json:"enabled"`
+	IntervalSeconds          int  `json:"interval_seconds"`
+	PageSize                 int  `json:"page_size"`
+	MaxPagesPerRun           int  `json:"max_pages_per_run"`
+	RequestTimeoutSeconds    int  `json:"request_timeout_seconds"`
+	Concurrency              int  `json:"concurrency"`
+	ReconcileIntervalSeconds int  `json:"reconcile_interval_seconds"`
+	DiscoveryIntervalSeconds int  `json:"discovery_interval_seconds"`
+}
+
+type FeedSyncSettings struct {
+	Enabled               bool `json:"enabled"`
+	IntervalSeconds       int  `json:"interval_seconds"`
+	RequestTimeoutSeconds int  `json:"request_timeout_seconds"`
+}
+
+type IssueSummarySettings struct {
+	Enabled               bool   `json:"enabled"`
+	IntervalSeconds       int    `json:"interval_seconds"`
+	BatchSize             int    `json:"batch_size"`
+	MaxIssuesPerRun       int    `json:"max_issues_per_run"`
+	RequestTimeoutSeconds int    `json:"request_timeout_seconds"`
+	State                 string `json:"state"`
+	OverwriteExisting     bool   `json:"overwrite_existing"`
+}
+
+type PRReviewSettings struct {
+	Enabled               bool `json:"enabled"`
+	IntervalSeconds       int  `json:"interval_seconds"`
+	BatchSize             int  `json:"batch_size"`
+	MaxPRsPerRun          int  `json:"max_prs_per_run"`
+	MaxDiffSize           int  `json:"max_diff_size"`
+	RequestTimeoutSeconds int  `json:"request_timeout_seconds"`
+	OverwriteExisting     bool `json:"overwrite_existing"`
+}
+
+// sectionSettings picks the settings of a section out of its config struct.
+func sectionSettings[T any](section any) T {
+	var out T
+	raw, _ := json.Marshal(section)
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
+
+// applySettings decodes saved settings over a config section, leaving the other fields alone.
+func applySettings(settings any, section any) {
+	raw, _ := json.Marshal(settings)
+	_ = json.Unmarshal(raw, section)
+}
+
+// validateSettings rejects negative numbers, which no section accepts, and unknown issue states.
+func validateSettings(settings any) error {
+	v := reflect.ValueOf(settings)
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Int && f.Int() < 0 {
+			return fmt.Errorf("%w: %s must not be negative", ErrInvalidSettings, v.Type().Field(i).Tag.Get("json"))
+		}
+	}
+	if s, ok := settings.(IssueSummarySettings); ok {
+		switch s.State {
+		case "", "all", "open", "closed":
+		default:
+			return fmt.Errorf("%w: state must be open, closed or all", ErrInvalidSettings)
+		}
+	}
+	return nil
+}
+
+// SettingsService keeps the settings changed through the admin API in the primary store, so that
+// they outlive restarts, and records who saved each version. A nil service keeps changes in memory.
+type SettingsService struct {
+	store  dao.SettingsStore
+	tokens AdminTokenStore
+
+	mu sync.Mutex
+	// applied holds the version of each section this replica runs with.
+	applied map[string]int64
+}
+
+// NewSettingsService returns nil when store keeps no settings. tokens resolves the admin user of a
+// request for the audit trail and may be nil.
+func NewSettingsService(store dao.SyncStore, tokens AdminTokenStore) *SettingsService {
+	settings, ok := store.(dao.SettingsStore)
+	if !ok {
+		return nil
+	}
+	return &SettingsService{store: settings, tokens: tokens, applied: make(map[string]int64)}
+}
+
+// LayerConfig decodes the saved version of each section over the values of the config file. It
+// runs at startup, before the services read their config; Reload picks up later versions.
+func (s *SettingsService) LayerConfig(ctx context.Context, cfg *conf.Config) error {
+	if s == nil {
+		return nil
+	}
+	sections := map[string]any{
+		SettingsGitHubSync:   &cfg.GitHubSync,
+		SettingsFeedSync:     &cfg.FeedSync,
+		SettingsIssueSummary: &cfg.IssueSummary,
+		SettingsPRReview:     &cfg.PRReview,
+	}
+	for _, section := range []string{SettingsGitHubSync, SettingsFeedSync, SettingsIssueSummary, SettingsPRReview} {
+		doc, err := s.store.GetSettings(ctx, section)
+		if errors.Is(err, dao.ErrSettingsNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("load %s settings: %w", section, err)
+		}
+		if err := json.Unmarshal([]byte(doc.Data), sections[section]); err != nil {
+			return fmt.Errorf("decode %s settings version %d: %w", section, doc.Version, err)
+		}
+		s.markApplied(section, doc.Version)
+		settingsLogger.Info("saved settings applied", "section", section, "version", doc.Version, "updated_by", doc.UpdatedBy)
+	}
+	return nil
+}
+
+// Reload decodes the saved version of a section over target when it is newer than the one this
+// replica runs with, and reports whether it was. Changes saved through another replica reach this
+// one that way, as the admin API only applies them where they were saved.
+func (s *SettingsService) Reload(ctx context.Context, section string, target any) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	doc, err := s.store.GetSettings(ctx, section)
+	if errors.Is(err, dao.ErrSettingsNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("load %s settings: %w", section, err)
+	}
+	s.mu.Lock()
+	current := s.applied[section]
+	s.mu.Unlock()
+	if doc.Version <= current {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(doc.Data), target); err != nil {
+		return false, fmt.Errorf("decode %s settings version %d: %w", section, doc.Version, err)
+	}
+	s.markApplied(section, doc.Version)
+	settingsLogger.Info("saved settings reloaded", "section", section, "version", doc.Version, "updated_by", doc.UpdatedBy)
+	return true, nil
+}
+
+func (s *SettingsService) markApplied(section string, version int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.applied[section] = max(s.applied[section], version)
+}
+
+// Current returns the saved version of a section, the zero document when none was saved.
+func (s *SettingsService) Current(ctx context.Context, section string) (dao.SettingsDocument, error) {
+	if s == nil {
+		return dao.SettingsDocument{Section: section}, nil
+	}
+	doc, err := s.store.GetSettings(ctx, section)
+	if errors.Is(err, dao.ErrSettingsNotFound) {
+		return dao.SettingsDocument{Section: section}, nil
+	}
+	return doc, err
+}
+
+// Save stores next as the new version of a section, recording the fields that differ from prev
+// and the admin user of ctx. version is the version the change is based on; 0 saves over
+// whatever is stored, anything else fails with dao.ErrSettingsVersionConflict once outdated.
+func (s *SettingsService) Save(ctx context.Context, section string, version int64, prev, next any) (dao.SettingsDocument, error) {
+	if s == nil {
+		return dao.SettingsDocument{Section: section}, nil
+	}
+	data, err := json.Marshal(next)
+	if err != nil {
+		return dao.SettingsDocument{}, fmt.Errorf("encode %s settings: %w", section, err)
+	}
+	if version == 0 {
+		current, err := s.Current(ctx, section)
+		if err != nil {
+			return dao.SettingsDocument{}, err
+		}
+		version = current.Version
+	}
+	fields := changedSettings(prev, next)
+	saved, err := s.store.SaveSettings(ctx, dao.SettingsDocument{
+		Section:   section,
+		Version:   version,
+		Data:      string(data),
+		UpdatedBy: s.actor(ctx),
+	}, fields)
+	if err != nil {
+		return dao.SettingsDocument{}, err
+	}
+	s.markApplied(section, saved.Version)
+	settingsLogger.Info("settings saved", "section", section, "version", saved.Version, "updated_by", saved.UpdatedBy, "fields", fields)
+	return saved, nil
+}
+
+// Changes returns the latest saved versions of a section, newest first.
+func (s *SettingsService) Changes(ctx context.Context, section string, limit int) ([]dao.SettingsChange, error) {
+	switch section {
+	case SettingsGitHubSync, SettingsFeedSync, SettingsIssueSummary, SettingsPRReview:
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownSettingsSection, section)
+	}
+	if s == nil {
+		return nil, nil
+	}
+	return s.store.ListSettingsChanges(ctx, section, limit)
+}
+
+// actor is the admin user whose token came with the request, empty when there is none.
+func (s *SettingsService) actor(ctx context.Context) string {
+	token := bearerTokenFromMetadata(ctx)
+	if token == "" || s.tokens == nil {
+		return ""
+	}
+	user, err := s.tokens.ValidateToken(ctx, token)
+	if err != nil {
+		return ""
+	}
+	return user
+}
+
+// changedSettings lists the JSON keys whose values differ between two settings documents.
+func changedSettings(prev, next any) []string {
+	var before, after map[string]any
+	raw, _ := json.Marshal(prev)
+	_ = json.Unmarshal(raw, &before)
+	raw, _ = json.Marshal(next)
+	_ = json.Unmarshal(raw, &after)
+	fields := make([]string, 0)
+	for key, value := range after {
+		if !reflect.DeepEqual(before[key], value) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
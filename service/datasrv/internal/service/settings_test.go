@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeSettingsStore struct {
+	*fakeSyncStore
+	docs    map[string]dao.SettingsDocument
+	changes []dao.SettingsChange
+}
+
+func newFakeSettingsStore() *fakeSettingsStore {
+	return &fakeSettingsStore{fakeSyncStore: newFakeSyncStore(), docs: map[string]dao.SettingsDocument{}}
+}
+
+func (f *fakeSettingsStore) GetSettings(_ context.Context, section string) (dao.SettingsDocument, error) {
+	doc, ok := f.docs[section]
+	if !ok {
+		return dao.SettingsDocument{}, dao.ErrSettingsNotFound
+	}
+	return doc, nil
+}
+
+func (f *fakeSettingsStore) SaveSettings(_ context.Context, doc dao.SettingsDocument, fields []string) (dao.SettingsDocument, error) {
+	if f.docs[doc.Section].Version != doc.Version {
+		return dao.SettingsDocument{}, dao.ErrSettingsVersionConflict
+	}
+	doc.Version++
+	doc.UpdatedAt = time.Now().UTC()
+	f.docs[doc.Section] = doc
+	f.changes = append(f.changes, dao.SettingsChange{
+		Section:   doc.Section,
+		Version:   doc.Version,
+		Data:      doc.Data,
+		Fields:    fields,
+		ChangedBy: doc.UpdatedBy,
+		ChangedAt: doc.UpdatedAt,
+	})
+	return doc, nil
+}
+
+func (f *fakeSettingsStore) ListSettingsChanges(_ context.Context, section string, limit int) ([]dao.SettingsChange, error) {
+	out := make([]dao.SettingsChange, 0)
+	for _, change := range f.changes {
+		if change.Section == section {
+			out = append(out, change)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version > out[j].Version })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// settingsTokenStore resolves every token to the same admin user.
+type settingsTokenStore struct {
+	AdminTokenStore
+	user string
+}
+
+func (s settingsTokenStore) ValidateToken(context.Context, string) (string, error) {
+	return s.user, nil
+}
+
+func TestSettingsServiceLayerConfig(t *testing.T) {
+	store := newFakeSettingsStore()
+	store.docs[SettingsGitHubSync] = dao.SettingsDocument{
+		Section: SettingsGitHubSync,
+		Version: 3,
+		Data:    `{"enabled":false,"interval_seconds":120,"page_size":20}`,
+	}
+	cfg := &conf.Config{
+		GitHubSync: conf.GitHubSyncConfig{Enabled: true, Repos: []string{"a/b"}, IntervalSeconds: 60, Concurrency: 4},
+		FeedSync:   conf.FeedSyncConfig{Enabled: true, IntervalSeconds: 300},
+	}
+
+	if err := NewSettingsService(store, nil).LayerConfig(context.Background(), cfg); err != nil {
+		t.Fatalf("LayerConfig() error = %v", err)
+	}
+	want := conf.GitHubSyncConfig{Repos: []string{"a/b"}, IntervalSeconds: 120, PageSize: 20, Concurrency: 4}
+	if !reflect.DeepEqual(cfg.GitHubSync, want) {
+		t.Fatalf("GitHubSync = %+v, want %+v", cfg.GitHubSync, want)
+	}
+	if !cfg.FeedSync.Enabled || cfg.FeedSync.IntervalSeconds != 300 {
+		t.Fatalf("FeedSync = %+v, want the config file values", cfg.FeedSync)
+	}
+	if svc := NewSettingsService(newFakeSyncStore(), nil); svc != nil {
+		t.Fatalf("NewSettingsService() = %v for a store without settings, want nil", svc)
+	}
+}
+
+func TestSettingsServiceReloadPicksUpVersionsSavedElsewhere(t *testing.T) {
+	store := newFakeSettingsStore()
+	ctx := context.Background()
+	saving := NewSettingsService(store, nil)
+	other := NewSettingsService(store, nil)
+	if err := other.LayerConfig(ctx, &conf.Config{}); err != nil {
+		t.Fatalf("LayerConfig() error = %v", err)
+	}
+
+	prev := GitHubSyncSettings{IntervalSeconds: 60}
+	next := GitHubSyncSettings{IntervalSeconds: 60, ReconcileIntervalSeconds: 3600}
+	if _, err := saving.Save(ctx, SettingsGitHubSync, 0, prev, next); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	cfg := conf.GitHubSyncConfig{Repos: []string{"a/b"}, IntervalSeconds: 60}
+	ok, err := other.Reload(ctx, SettingsGitHubSync, &cfg)
+	if err != nil || !ok {
+		t.Fatalf("Reload() = %v, %v, want the saved version", ok, err)
+	}
+	if cfg.ReconcileIntervalSeconds != 3600 || !reflect.DeepEqual(cfg.Repos, []string{"a/b"}) {
+		t.Fatalf("reloaded config = %+v, want reconcile 3600 over the config file repos", cfg)
+	}
+	if ok, err := other.Reload(ctx, SettingsGitHubSync, &cfg); err != nil || ok {
+		t.Fatalf("Reload() again = %v, %v, want nothing new", ok, err)
+	}
+	if ok, err := saving.Reload(ctx, SettingsGitHubSync, &cfg); err != nil || ok {
+		t.Fatalf("Reload() on the saving replica = %v, %v, want nothing new", ok, err)
+	}
+	if ok, err := other.Reload(ctx, SettingsFeedSync, &conf.FeedSyncConfig{}); err != nil || ok {
+		t.Fatalf("Reload(feed_sync) = %v, %v, want nothing saved", ok, err)
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_UpdateSyncConfigSavesSettings(t *testing.T) {
+	store := newFakeSettingsStore()
+	cfg := &conf.Config{}
+	syncSvc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{Enabled: true, IntervalSeconds: 60}, nil)
+	srv := NewIssueSyncAdminGRPCServer(store, syncSvc, cfg)
+	srv.SetSettingsService(NewSettingsService(store, settingsTokenStore{user: "alice"}))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer tok-123"))
+
+	updated, err := srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 120})
+	if err != nil {
+		t.Fatalf("UpdateSyncConfig() error = %v", err)
+	}
+	if updated.Version != 1 || updated.UpdatedBy != "alice" || updated.UpdatedAt == nil {
+		t.Fatalf("updated = version %d by %q at %v, want version 1 by alice", updated.Version, updated.UpdatedBy, updated.UpdatedAt)
+	}
+	if cfg.GitHubSync.IntervalSeconds != 120 {
+		t.Fatalf("config interval = %d, want 120", cfg.GitHubSync.IntervalSeconds)
+	}
+	if got := store.changes[0].Fields; !reflect.DeepEqual(got, []string{"interval_seconds"}) {
+		t.Fatalf("changed fields = %v", got)
+	}
+
+	_, err = srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: false, Version: 5})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("UpdateSyncConfig(stale version) code = %v, want Aborted", status.Code(err))
+	}
+	if !syncSvc.GetConfig().Enabled {
+		t.Fatalf("rejected update was applied")
+	}
+
+	got, err := srv.GetSyncConfig(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetSyncConfig() error = %v", err)
+	}
+	if got.Version != 1 || got.IntervalSeconds != 120 {
+		t.Fatalf("GetSyncConfig() = version %d interval %d, want version 1 interval 120", got.Version, got.IntervalSeconds)
+	}
+
+	changes, err := srv.ListSettingsChanges(ctx, &issuesv1.ListSettingsChangesRequest{Section: SettingsGitHubSync})
+	if err != nil {
+		t.Fatalf("ListSettingsChanges() error = %v", err)
+	}
+	if len(changes.Changes) != 1 || changes.Changes[0].ChangedBy != "alice" {
+		t.Fatalf("changes = %v, want the one change by alice", changes.Changes)
+	}
+	_, err = srv.ListSettingsChanges(ctx, &issuesv1.ListSettingsChangesRequest{Section: "storage"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ListSettingsChanges(storage) code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_UpdateSyncConfigKeepsFieldsLeftOut(t *testing.T) {
+	store := newFakeSettingsStore()
+	cfg := &conf.Config{}
+	syncSvc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:                  true,
+		IntervalSeconds:          60,
+		Concurrency:              8,
+		ReconcileIntervalSeconds: 86400,
+		DiscoveryIntervalSeconds: 600,
+	}, nil)
+	srv := NewIssueSyncAdminGRPCServer(store, syncSvc, cfg)
+	srv.SetSettingsService(NewSettingsService(store, nil))
+
+	if _, err := srv.UpdateSyncConfig(context.Background(), &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 120}); err != nil {
+		t.Fatalf("UpdateSyncConfig() error = %v", err)
+	}
+	got := syncSvc.GetConfig()
+	if got.Concurrency != 8 || got.ReconcileIntervalSeconds != 86400 || got.DiscoveryIntervalSeconds != 600 {
+		t.Fatalf("config = concurrency %d reconcile %d discovery %d, want 8, 86400 and 600 kept", got.Concurrency, got.ReconcileIntervalSeconds, got.DiscoveryIntervalSeconds)
+	}
+
+	// An explicit 0 still turns the reconcile sweep off.
+	if _, err := srv.UpdateSyncConfig(context.Background(), &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 120, ReconcileIntervalSeconds: proto.Int32(0), Version: 1}); err != nil {
+		t.Fatalf("UpdateSyncConfig(reconcile 0) error = %v", err)
+	}
+	if got := syncSvc.GetConfig(); got.ReconcileIntervalSeconds != 0 || got.Concurrency != 8 {
+		t.Fatalf("config = reconcile %d concurrency %d, want 0 and 8", got.ReconcileIntervalSeconds, got.Concurrency)
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_UpdateSyncConfigRejectsInvalidReposBeforeSaving(t *testing.T) {
+	store := newFakeSettingsStore()
+	cfg := &conf.Config{}
+	syncSvc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{Enabled: true, IntervalSeconds: 60}, nil)
+	srv := NewIssueSyncAdminGRPCServer(store, syncSvc, cfg)
+	srv.SetSettingsService(NewSettingsService(store, nil))
+	ctx := context.Background()
+
+	_, err := srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 120, Repos: []string{"not-a-repo"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateSyncConfig(invalid repo) code = %v, want InvalidArgument", status.Code(err))
+	}
+	if got := syncSvc.GetConfig().IntervalSeconds; got != 60 {
+		t.Fatalf("config interval = %d after a rejected update, want 60", got)
+	}
+	got, err := srv.GetSyncConfig(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetSyncConfig() error = %v", err)
+	}
+	if got.Version != 0 || len(store.changes) != 0 {
+		t.Fatalf("settings version = %d with %d changes after a rejected update, want none", got.Version, len(store.changes))
+	}
+
+	// The same version still applies once the repos are fixed.
+	updated, err := srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 120, Repos: []string{"o/r"}})
+	if err != nil {
+		t.Fatalf("UpdateSyncConfig() retry error = %v", err)
+	}
+	if updated.Version != 1 || updated.IntervalSeconds != 120 || cfg.GitHubSync.IntervalSeconds != 120 {
+		t.Fatalf("retry = version %d interval %d, want version 1 interval 120", updated.Version, updated.IntervalSeconds)
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_UpdateSyncConfigStaleVersionKeepsRepos(t *testing.T) {
+	store := newFakeSettingsStore()
+	_, _ = store.ReplaceManagedRepos(context.Background(), []string{"a/b"})
+	cfg := &conf.Config{}
+	syncSvc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{Enabled: true, IntervalSeconds: 60}, nil)
+	srv := NewIssueSyncAdminGRPCServer(store, syncSvc, cfg)
+	srv.SetSettingsService(NewSettingsService(store, nil))
+	ctx := context.Background()
+
+	if _, err := srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 120}); err != nil {
+		t.Fatalf("UpdateSyncConfig() error = %v", err)
+	}
+	_, err := srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 90, Repos: []string{"c/d"}, Version: 5})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("UpdateSyncConfig(stale version) code = %v, want Aborted", status.Code(err))
+	}
+	repos, err := syncSvc.ListManagedRepoRules(ctx)
+	if err != nil {
+		t.Fatalf("ListManagedRepoRules() error = %v", err)
+	}
+	if !reflect.DeepEqual(repos, []string{"a/b"}) {
+		t.Fatalf("managed repos = %v after a stale update, want [a/b]", repos)
+	}
+
+	updated, err := srv.UpdateSyncConfig(ctx, &issuesv1.UpdateSyncConfigRequest{Enabled: true, IntervalSeconds: 90, Repos: []string{"c/d"}, Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateSyncConfig() error = %v", err)
+	}
+	if updated.Version != 2 || !reflect.DeepEqual(updated.Repos, []string{"c/d"}) {
+		t.Fatalf("updated = version %d repos %v, want version 2 with [c/d]", updated.Version, updated.Repos)
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_UpdateIssueSummaryConfig(t *testing.T) {
+	store := newFakeSettingsStore()
+	cfg := &conf.Config{IssueSummary: conf.IssueSummaryConfig{Enabled: true, Provider: "openai", Model: "gpt"}}
+	srv := NewIssueSyncAdminGRPCServer(store, NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil), cfg)
+	srv.SetSettingsService(NewSettingsService(store, nil))
+
+	_, err := srv.UpdateIssueSummaryConfig(context.Background(), &issuesv1.UpdateIssueSummaryConfigRequest{State: "merged"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateIssueSummaryConfig(state merged) code = %v, want InvalidArgument", status.Code(err))
+	}
+	_, err = srv.UpdateIssueSummaryConfig(context.Background(), &issuesv1.UpdateIssueSummaryConfigRequest{BatchSize: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateIssueSummaryConfig(batch_size -1) code = %v, want InvalidArgument", status.Code(err))
+	}
+
+	updated, err := srv.UpdateIssueSummaryConfig(context.Background(), &issuesv1.UpdateIssueSummaryConfigRequest{
+		Enabled:   true,
+		BatchSize: 10,
+		State:     "Open",
+	})
+	if err != nil {
+		t.Fatalf("UpdateIssueSummaryConfig() error = %v", err)
+	}
+	if updated.Version != 1 || updated.State != "open" || updated.Provider != "openai" || updated.Model != "gpt" {
+		t.Fatalf("updated = %v, want version 1 with the config file provider", updated)
+	}
+	if cfg.IssueSummary.BatchSize != 10 || cfg.IssueSummary.Provider != "openai" {
+		t.Fatalf("IssueSummary = %+v, want batch size 10 and the provider kept", cfg.IssueSummary)
+	}
+	if store.docs[SettingsIssueSummary].Data != `{"enabled":true,"interval_seconds":0,"batch_size":10,"max_issues_per_run":0,"request_timeout_seconds":0,"state":"open","overwrite_existing":false}` {
+		t.Fatalf("saved data = %s", store.docs[SettingsIssueSummary].Data)
+	}
+}
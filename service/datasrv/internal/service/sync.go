@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"sort"
 	"strings"
 	"sync"
@@ -14,15 +13,27 @@ import (
 	"github.com/google/go-github/v82/github"
 	"github.com/kongken/datasrv/service/datasrv/internal/conf"
 	"github.com/kongken/datasrv/service/datasrv/internal/dao"
-	"golang.org/x/oauth2"
 )
 
 var issueSyncLogger = slog.Default().With("component", "datasrv.issue_sync")
 
+const (
+	githubIssuesEndpoint  = "issues"
+	githubCommentsPerPage = 100
+)
+
 // GitHubIssueClient is the external client contract used by sync flow.
 type GitHubIssueClient interface {
 	ListByRepo(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
 	ListComments(ctx context.Context, owner, repo string, issueNumber int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error)
+	Get(ctx context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error)
+	GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error)
+	ListPullRequestReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error)
+	ListIssueTimeline(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.Timeline, *github.Response, error)
+	ListLabels(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error)
+	ListMilestones(ctx context.Context, owner, repo string, opts *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error)
+	ListOrgRepos(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error)
+	ListUserRepos(ctx context.Context, user string, opts *github.RepositoryListByUserOptions) ([]*github.Repository, *github.Response, error)
 }
 
 type defaultGitHubIssueClient struct {
@@ -37,6 +48,38 @@ func (d *defaultGitHubIssueClient) ListComments(ctx context.Context, owner, repo
 	return d.inner.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
 }
 
+func (d *defaultGitHubIssueClient) Get(ctx context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error) {
+	return d.inner.Issues.Get(ctx, owner, repo, number)
+}
+
+func (d *defaultGitHubIssueClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error) {
+	return d.inner.PullRequests.Get(ctx, owner, repo, number)
+}
+
+func (d *defaultGitHubIssueClient) ListPullRequestReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return d.inner.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+}
+
+func (d *defaultGitHubIssueClient) ListIssueTimeline(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.Timeline, *github.Response, error) {
+	return d.inner.Issues.ListIssueTimeline(ctx, owner, repo, number, opts)
+}
+
+func (d *defaultGitHubIssueClient) ListLabels(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return d.inner.Issues.ListLabels(ctx, owner, repo, opts)
+}
+
+func (d *defaultGitHubIssueClient) ListMilestones(ctx context.Context, owner, repo string, opts *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error) {
+	return d.inner.Issues.ListMilestones(ctx, owner, repo, opts)
+}
+
+func (d *defaultGitHubIssueClient) ListOrgRepos(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+	return d.inner.Repositories.ListByOrg(ctx, org, opts)
+}
+
+func (d *defaultGitHubIssueClient) ListUserRepos(ctx context.Context, user string, opts *github.RepositoryListByUserOptions) ([]*github.Repository, *github.Response, error) {
+	return d.inner.Repositories.ListByUser(ctx, user, opts)
+}
+
 type SyncRepoResult struct {
 	Repo      string
 	Fetched   int32
@@ -52,8 +95,10 @@ type SyncRunSummary struct {
 
 // IssueSyncService orchestrates periodic/on-demand sync from GitHub to a configured datastore.
 type IssueSyncService struct {
-	mu      sync.Mutex
-	running bool
+	mu          sync.Mutex
+	running     bool
+	reconciling bool
+	discovering bool
 
 	cfgMu sync.RWMutex
 	cfg   conf.GitHubSyncConfig
@@ -61,28 +106,79 @@ type IssueSyncService struct {
 	store        dao.SyncStore
 	client       GitHubIssueClient
 	commentStore IssueCommentStore
+	rateLimiter  *githubRateLimiter
+	// etags is nil when the store cannot persist validators; requests are then unconditional.
+	etags dao.GitHubETagStore
+	// tombstones is nil when the store cannot hide issues; reconciliation then refuses to run.
+	tombstones dao.IssueTombstoneStore
+	// pullRequests is nil when the store keeps no pull request details; the detail stage is then skipped.
+	pullRequests dao.PullRequestStore
+	// events is nil when the store keeps no issue timelines; the timeline stage is then skipped.
+	events dao.IssueEventStore
+	// responsiveness is nil when the store keeps no responsiveness metrics; nothing is then invalidated.
+	responsiveness dao.IssueResponsivenessStore
+	// catalog is nil when the store keeps no label and milestone catalog; the catalog stage is then skipped.
+	catalog dao.RepoCatalogStore
+	// repoRules is nil when the store cannot keep managed repo rules; only explicit repos are then accepted.
+	repoRules dao.ManagedRepoRuleStore
+	// policies is nil when the store keeps no per-repo sync policies; every repo then follows cfg.
+	policies dao.RepoSyncPolicyStore
+	// runs is nil when the store keeps no run history; runs are then only logged.
+	runs dao.SyncRunStore
+	// commentCursors is nil when the store keeps no comment checkpoint; the comments of every
+	// changed issue are then listed one issue at a time.
+	commentCursors dao.CommentCheckpointStore
+	// providers maps the prefix of managed repos on other trackers to their client, guarded by cfgMu.
+	providers map[string]IssueProvider
+	// resyncJobs holds running and recently finished resync jobs by id, guarded by mu.
+	resyncJobs map[string]*ResyncJob
+	// job is the run in progress while running is set, guarded by mu.
+	job  *syncJob
+	jobs syncJobRegistry
 }
 
 func NewIssueSyncService(store dao.SyncStore, ghCfg conf.GitHubConfig, syncCfg conf.GitHubSyncConfig, commentStore IssueCommentStore) *IssueSyncService {
-	httpClient := http.DefaultClient
-	if ghCfg.Token != "" {
-		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: ghCfg.Token})
-		httpClient = oauth2.NewClient(context.Background(), ts)
-	}
-	ghClient := github.NewClient(httpClient)
-	if ghCfg.BaseURL != "" {
-		if enterpriseClient, err := github.NewClient(httpClient).WithEnterpriseURLs(ghCfg.BaseURL, ghCfg.BaseURL); err == nil {
-			ghClient = enterpriseClient
-		}
-	}
+	ghClient := newGitHubClient(ghCfg)
 
 	normalized := normalizeSyncConfig(syncCfg)
-	return &IssueSyncService{
+	svc := &IssueSyncService{
 		cfg:          normalized,
 		store:        store,
 		client:       &defaultGitHubIssueClient{inner: ghClient},
 		commentStore: commentStore,
+		rateLimiter:  newGitHubRateLimiter(githubAppConfigured(ghCfg.App)),
+	}
+	if etags, ok := store.(dao.GitHubETagStore); ok {
+		svc.etags = etags
+	}
+	if tombstones, ok := store.(dao.IssueTombstoneStore); ok {
+		svc.tombstones = tombstones
+	}
+	if pullRequests, ok := store.(dao.PullRequestStore); ok {
+		svc.pullRequests = pullRequests
+	}
+	if events, ok := store.(dao.IssueEventStore); ok {
+		svc.events = events
+	}
+	if responsiveness, ok := store.(dao.IssueResponsivenessStore); ok {
+		svc.responsiveness = responsiveness
+	}
+	if catalog, ok := store.(dao.RepoCatalogStore); ok {
+		svc.catalog = catalog
+	}
+	if repoRules, ok := store.(dao.ManagedRepoRuleStore); ok {
+		svc.repoRules = repoRules
 	}
+	if policies, ok := store.(dao.RepoSyncPolicyStore); ok {
+		svc.policies = policies
+	}
+	if runs, ok := store.(dao.SyncRunStore); ok {
+		svc.runs = runs
+	}
+	if commentCursors, ok := store.(dao.CommentCheckpointStore); ok {
+		svc.commentCursors = commentCursors
+	}
+	return svc
 }
 
 func normalizeSyncConfig(in conf.GitHubSyncConfig) conf.GitHubSyncConfig {
@@ -98,6 +194,12 @@ func normalizeSyncConfig(in conf.GitHubSyncConfig) conf.GitHubSyncConfig {
 	if in.RequestTimeoutSeconds <= 0 {
 		in.RequestTimeoutSeconds = 15
 	}
+	if in.Concurrency <= 0 {
+		in.Concurrency = 4
+	}
+	if in.DiscoveryIntervalSeconds <= 0 {
+		in.DiscoveryIntervalSeconds = 3600
+	}
 	return in
 }
 
@@ -145,12 +247,74 @@ func (s *IssueSyncService) ListManagedRepos(ctx context.Context) ([]dao.ManagedR
 	return repos, nil
 }
 
+// ListManagedRepoRules returns the managed repo entries as given to ReplaceManagedRepos, which
+// differ from the managed repos once discovery rules are in use.
+func (s *IssueSyncService) ListManagedRepoRules(ctx context.Context) ([]string, error) {
+	if s.repoRules != nil {
+		rules, err := s.repoRules.ListManagedRepoRules(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) > 0 {
+			return rules, nil
+		}
+	}
+	repos, err := s.store.ListManagedRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return managedRepoNames(repos), nil
+}
+
+// ReplaceManagedRepos stores explicit repos and discovery rules. Explicit repos are managed right
+// away; pattern rules keep the repos they already discovered until the next discovery run.
 func (s *IssueSyncService) ReplaceManagedRepos(ctx context.Context, repos []string) ([]dao.ManagedRepo, error) {
-	return s.store.ReplaceManagedRepos(ctx, normalizeManagedRepos(repos))
+	rules, err := s.parseManagedRepoRules(repos)
+	if err != nil {
+		return nil, err
+	}
+	if s.repoRules == nil {
+		return s.store.ReplaceManagedRepos(ctx, normalizeManagedRepos(repos))
+	}
+
+	current, err := s.store.ListManagedRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		entries = append(entries, rule.raw)
+	}
+	if err := s.repoRules.ReplaceManagedRepoRules(ctx, entries); err != nil {
+		return nil, err
+	}
+	return s.repoRules.ReplaceRuleManagedRepos(ctx, resolveManagedRepos(rules, current, nil))
+}
+
+// ValidateManagedRepos checks repos the way ReplaceManagedRepos does, without storing anything.
+func (s *IssueSyncService) ValidateManagedRepos(repos []string) error {
+	_, err := s.parseManagedRepoRules(repos)
+	return err
+}
+
+// parseManagedRepoRules parses repos, refusing patterns when the store cannot keep them.
+func (s *IssueSyncService) parseManagedRepoRules(repos []string) ([]repoRule, error) {
+	rules, err := parseRepoRules(repos)
+	if err != nil {
+		return nil, err
+	}
+	if s.repoRules == nil {
+		for _, rule := range rules {
+			if !rule.explicit {
+				return nil, fmt.Errorf("%w %q: sync store does not support repo discovery", ErrInvalidRepoRule, rule.raw)
+			}
+		}
+	}
+	return rules, nil
 }
 
 func (s *IssueSyncService) SeedManagedRepos(ctx context.Context, repos []string) error {
-	current, err := s.store.ListManagedRepos(ctx)
+	current, err := s.ListManagedRepoRules(ctx)
 	if err != nil {
 		return err
 	}
@@ -161,7 +325,7 @@ func (s *IssueSyncService) SeedManagedRepos(ctx context.Context, repos []string)
 	if len(normalized) == 0 {
 		return nil
 	}
-	_, err = s.store.ReplaceManagedRepos(ctx, normalized)
+	_, err = s.ReplaceManagedRepos(ctx, normalized)
 	return err
 }
 
@@ -171,22 +335,102 @@ func (s *IssueSyncService) IsRunning() bool {
 	return s.running
 }
 
+// RateLimit returns the most constrained GitHub quota seen by the sync workers, zero before the
+// first response.
+func (s *IssueSyncService) RateLimit() GitHubRateLimitStatus {
+	lowest, _ := lowestGitHubRateLimit(s.rateLimiter.snapshot())
+	return lowest
+}
+
+// RateLimits returns the GitHub quota of every credential used by the sync workers.
+func (s *IssueSyncService) RateLimits() []GitHubRateLimitStatus {
+	return s.rateLimiter.snapshot()
+}
+
+// RunSync syncs onlyRepo, or every enabled managed repo when it is empty.
 func (s *IssueSyncService) RunSync(ctx context.Context, onlyRepo string) (SyncRunSummary, error) {
+	return s.runSync(ctx, onlyRepo, false)
+}
+
+// RunScheduledSync syncs the enabled managed repos whose sync interval has elapsed. The scheduler
+// calls it more often than the global interval so that shorter per-repo intervals are honored.
+func (s *IssueSyncService) RunScheduledSync(ctx context.Context) (SyncRunSummary, error) {
+	return s.runSync(ctx, "", true)
+}
+
+// StartSync starts a sync of onlyRepo, or of every enabled managed repo, in the background and
+// returns its job. When a sync is already running, the job of that run is returned with attached
+// set and onlyRepo is ignored. done, if not nil, is called with the outcome of a started run.
+func (s *IssueSyncService) StartSync(ctx context.Context, onlyRepo string, done func(SyncRunSummary, error)) (job SyncJob, attached bool) {
+	running, started := s.claimSync()
+	if !started {
+		return running.SyncJob, true
+	}
+	go func() {
+		_, _ = s.runSyncJob(context.WithoutCancel(ctx), running, onlyRepo, false, done)
+	}()
+	return running.SyncJob, false
+}
+
+// WatchSyncJob sends the events of a running or recently finished sync job, then follows it until
+// it finishes.
+func (s *IssueSyncService) WatchSyncJob(ctx context.Context, id string, send func(SyncJobEvent) error) error {
+	return s.jobs.watch(ctx, id, send)
+}
+
+// claimSync marks the service as running and returns the job of the new run, or returns the job
+// of the run in progress and false.
+func (s *IssueSyncService) claimSync() (*syncJob, bool) {
 	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.running {
-		s.mu.Unlock()
-		return SyncRunSummary{}, fmt.Errorf("sync is already running")
+		return s.job, false
 	}
 	s.running = true
+	s.job = newSyncJob(dao.SyncRunJobIssueSync)
+	s.jobs.add(s.job)
+	return s.job, true
+}
+
+func (s *IssueSyncService) runSync(ctx context.Context, onlyRepo string, scheduled bool) (SyncRunSummary, error) {
+	job, started := s.claimSync()
+	if !started {
+		return SyncRunSummary{}, fmt.Errorf("sync is already running")
+	}
+	return s.runSyncJob(ctx, job, onlyRepo, scheduled, nil)
+}
+
+// runSyncJob runs a claimed sync. The service is released and done is called before the finished
+// event goes out, so a watcher that saw it can start the next run right away.
+func (s *IssueSyncService) runSyncJob(ctx context.Context, job *syncJob, onlyRepo string, scheduled bool, done func(SyncRunSummary, error)) (SyncRunSummary, error) {
+	job.publish(SyncJobEvent{Type: SyncJobEventStarted, Target: onlyRepo, At: job.StartedAt})
+	summary, err := s.syncDueRepos(withSyncJob(ctx, job), onlyRepo, scheduled, job.StartedAt)
+	record := issueSyncRunRecord(summary, err)
+	record.StartedAt = job.StartedAt
+	recordSyncRun(ctx, s.runs, record)
+
+	s.mu.Lock()
+	s.running = false
+	s.job = nil
 	s.mu.Unlock()
-	defer func() {
-		s.mu.Lock()
-		s.running = false
-		s.mu.Unlock()
-	}()
+	if done != nil {
+		done(summary, err)
+	}
 
+	finished := SyncJobEvent{Type: SyncJobEventFinished}
+	for _, result := range summary.Results {
+		finished.Fetched += result.Fetched
+		finished.Persisted += result.Persisted
+	}
+	if err != nil {
+		finished.Err = err.Error()
+	}
+	job.publish(finished)
+	return summary, err
+}
+
+func (s *IssueSyncService) syncDueRepos(ctx context.Context, onlyRepo string, scheduled bool, started time.Time) (SyncRunSummary, error) {
 	cfg := s.GetConfig()
-	started := time.Now()
 	summary := SyncRunSummary{StartedAt: started}
 	if !cfg.Enabled {
 		summary.FinishedAt = time.Now()
@@ -200,15 +444,60 @@ func (s *IssueSyncService) RunSync(ctx context.Context, onlyRepo string) (SyncRu
 	if onlyRepo != "" {
 		repos = []string{onlyRepo}
 	}
-
+	policies, err := s.repoSyncPolicies(ctx)
+	if err != nil {
+		return SyncRunSummary{}, err
+	}
+	if onlyRepo == "" {
+		due := make([]string, 0, len(repos))
+		for _, repo := range repos {
+			policy := policies[strings.ToLower(repo)]
+			if policy.Disabled || (scheduled && !s.repoSyncDue(ctx, cfg, repo, policy, started)) {
+				continue
+			}
+			due = append(due, repo)
+		}
+		repos = due
+	}
+	// A repo under resync is left to the job; both would otherwise move the same checkpoint.
+	s.mu.Lock()
+	idle := make([]string, 0, len(repos))
 	for _, repo := range repos {
-		result := s.syncOneRepo(ctx, cfg, strings.TrimSpace(repo))
-		summary.Results = append(summary.Results, result)
+		if !s.resyncingRepo(repo) {
+			idle = append(idle, repo)
+		}
 	}
+	s.mu.Unlock()
+	repos = idle
+
+	summary.Results = s.syncRepos(ctx, cfg, repos, policies)
 	summary.FinishedAt = time.Now()
 	return summary, nil
 }
 
+// syncRepos fans repos out to at most cfg.Concurrency workers. Results keep the order of repos.
+func (s *IssueSyncService) syncRepos(ctx context.Context, cfg conf.GitHubSyncConfig, repos []string, policies map[string]dao.RepoSyncPolicy) []SyncRepoResult {
+	results := make([]SyncRepoResult, len(repos))
+	jobs := make(chan int)
+	workers := min(cfg.Concurrency, len(repos))
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for i := range jobs {
+				repo := strings.TrimSpace(repos[i])
+				results[i] = s.syncOneRepo(ctx, cfg, repo, policies[strings.ToLower(repo)])
+			}
+		})
+	}
+	for i := range repos {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
 func (s *IssueSyncService) loadReposForRun(ctx context.Context, cfg conf.GitHubSyncConfig, onlyRepo string) ([]string, error) {
 	if onlyRepo != "" {
 		return []string{strings.TrimSpace(onlyRepo)}, nil
@@ -230,11 +519,68 @@ func (s *IssueSyncService) loadReposForRun(ctx context.Context, cfg conf.GitHubS
 		return repos, nil
 	}
 
-	return normalizeManagedRepos(cfg.Repos), nil
+	// Discovery rules only take effect once stored; the config fallback syncs explicit repos.
+	repos := make([]string, 0, len(cfg.Repos))
+	for _, repo := range normalizeManagedRepos(cfg.Repos) {
+		if rule, err := parseRepoRule(repo); err == nil && !rule.explicit {
+			continue
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncConfig, repo string, policy dao.RepoSyncPolicy) SyncRepoResult {
+	job := syncJobFrom(ctx)
+	if job == nil {
+		return s.syncRepoIssues(ctx, cfg, repo, policy, repoSyncRun{})
+	}
+	result := s.syncRepoIssues(ctx, cfg, repo, policy, repoSyncRun{
+		progress: func(pages int32, result SyncRepoResult) {
+			job.publish(SyncJobEvent{
+				Type:      SyncJobEventPage,
+				Target:    repo,
+				Page:      pages,
+				Fetched:   result.Fetched,
+				Persisted: result.Persisted,
+			})
+		},
+	})
+	job.publish(SyncJobEvent{
+		Type:      SyncJobEventTargetFinished,
+		Target:    repo,
+		Fetched:   result.Fetched,
+		Persisted: result.Persisted,
+		Err:       result.Err,
+	})
+	return result
+}
+
+// repoSyncRun tunes one pass over the issue listing of a repo. The zero value is the regular
+// incremental run that resumes from the checkpoint and stops after cfg.MaxPagesPerRun pages.
+type repoSyncRun struct {
+	// since replaces the checkpoint as the listing cursor when set; the policy SyncSince still applies.
+	since *time.Time
+	// unbounded keeps paging until GitHub reports no next page.
+	unbounded bool
+	// unconditional sends no stored validators, so every page and comment thread is fetched again.
+	unconditional bool
+	// progress is called after each persisted page with the page count and running totals.
+	progress func(pages int32, result SyncRepoResult)
 }
 
-func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncConfig, repo string) SyncRepoResult {
+func (s *IssueSyncService) syncRepoIssues(ctx context.Context, cfg conf.GitHubSyncConfig, repo string, policy dao.RepoSyncPolicy, run repoSyncRun) SyncRepoResult {
 	result := SyncRepoResult{Repo: repo}
+	if target, ok, err := parseProviderRepo(repo); ok {
+		if err != nil {
+			result.Err = err.Error()
+			return result
+		}
+		return s.syncProviderRepo(ctx, cfg, repo, target, policy, run)
+	}
+	if run.unconditional {
+		ctx = withoutGitHubETags(ctx)
+	}
 	owner, name, err := splitRepo(repo)
 	if err != nil {
 		result.Err = err.Error()
@@ -247,7 +593,22 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 		return result
 	}
 
+	// A catalog failure leaves labels and milestones as they were stored; the issues still sync.
+	if s.catalog != nil {
+		if err := s.syncRepoCatalog(ctx, cfg, repo, owner, name); err != nil {
+			issueSyncLogger.Error("issue sync catalog failed; continue with issues", "repo", repo, "error", err)
+			result.Err = err.Error()
+		}
+	}
+
 	maxSeenUpdate := cp.LastIssueUpdatedAt
+	since := cp.LastIssueUpdatedAt
+	if run.since != nil {
+		since = *run.since
+	}
+	if policy.SyncSince != nil && policy.SyncSince.After(since) {
+		since = *policy.SyncSince
+	}
 	currentPage := 1
 	issueSyncLogger.Info("issue sync repo started",
 		"repo", repo,
@@ -255,18 +616,25 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 		"page_size", cfg.PageSize,
 		"max_pages_per_run", cfg.MaxPagesPerRun,
 		"last_issue_updated_at", cp.LastIssueUpdatedAt,
+		"since", since,
+		"state", issueListState(policy),
+		"unbounded", run.unbounded,
 	)
-	for page := 0; page < cfg.MaxPagesPerRun; page++ {
+	var pages int32
+	// changed collects the persisted issues whose comment threads are reconciled after the pages.
+	var changed []dao.SyncedIssue
+	for page := 0; run.unbounded || page < cfg.MaxPagesPerRun; page++ {
 		opts := &github.IssueListByRepoOptions{
-			State:       "all",
+			State:       issueListState(policy),
 			Sort:        "updated",
 			Direction:   "asc",
-			Since:       cp.LastIssueUpdatedAt,
+			Since:       since,
 			ListOptions: github.ListOptions{Page: currentPage, PerPage: cfg.PageSize},
 		}
 
+		cached := s.lookupETag(ctx, repo, githubIssuesEndpoint, currentPage)
 		pageStartedAt := time.Now()
-		issues, resp, fetchErr := s.listByRepoWithRetry(ctx, cfg, owner, name, opts)
+		issues, resp, fetchErr := s.listByRepoWithRetry(withGitHubETag(ctx, cached), cfg, owner, name, opts)
 		if fetchErr != nil {
 			issueSyncLogger.Error("issue sync list issues failed",
 				"repo", repo,
@@ -285,12 +653,28 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 			})
 			return result
 		}
+		if githubNotModified(resp) {
+			issueSyncLogger.Info("issue sync page not modified",
+				"repo", repo,
+				"page", currentPage,
+				"duration_ms", time.Since(pageStartedAt).Milliseconds(),
+				"next_page", cached.NextPage,
+			)
+			if cached.NextPage == 0 {
+				break
+			}
+			currentPage = cached.NextPage
+			continue
+		}
 		if len(issues) == 0 {
 			issueSyncLogger.Info("issue sync page empty",
 				"repo", repo,
 				"page", currentPage,
 				"duration_ms", time.Since(pageStartedAt).Milliseconds(),
 			)
+			if etag, ok := githubETagFor(repo, githubIssuesEndpoint, currentPage, resp); ok && !run.unconditional {
+				s.saveETag(ctx, etag)
+			}
 			break
 		}
 		issueSyncLogger.Info("issue sync page fetched",
@@ -307,15 +691,31 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 		)
 
 		normalized := make([]dao.SyncedIssue, 0, len(issues))
+		var pullRequests []dao.PullRequestDetail
+		var events []dao.IssueEvent
+		// Validators of pull request details and timelines are saved once those are stored.
+		var detailETags []dao.GitHubETag
 		for _, it := range issues {
 			record := toSyncedIssue(repo, it)
-			if s.commentStore != nil {
-				if err := s.syncIssueComments(ctx, cfg, repo, owner, name, &record); err != nil {
-					issueSyncLogger.Error("issue sync comments failed",
+			if record.UpdatedAt.After(maxSeenUpdate) {
+				maxSeenUpdate = record.UpdatedAt
+			}
+			if policySkipsIssue(policy, record) {
+				continue
+			}
+			if record.IsPullRequest && s.pullRequests != nil {
+				fetched, err := s.fetchPullRequestDetail(ctx, cfg, repo, owner, name, record)
+				switch {
+				case err == nil:
+					if !fetched.notModified {
+						pullRequests = append(pullRequests, fetched.detail)
+					}
+					detailETags = append(detailETags, fetched.etags...)
+				case githubTransientError(err):
+					issueSyncLogger.Error("issue sync pull request detail failed",
 						"repo", repo,
 						"issue_number", record.Number,
 						"issue_id", record.IssueID,
-						"comment_count", record.Comments,
 						"error", err,
 					)
 					result.Err = err.Error()
@@ -327,13 +727,50 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 						LastError:          result.Err,
 					})
 					return result
+				default:
+					// The pull request is stored without its detail, which its next update fetches again.
+					issueSyncLogger.Error("issue sync pull request detail skipped",
+						"repo", repo,
+						"issue_number", record.Number,
+						"issue_id", record.IssueID,
+						"error", err,
+					)
+				}
+			}
+			if s.events != nil {
+				timeline, err := s.fetchIssueEvents(ctx, cfg, repo, owner, name, record)
+				switch {
+				case err == nil:
+					events = append(events, timeline.events...)
+					detailETags = append(detailETags, timeline.etags...)
+				case githubTransientError(err):
+					issueSyncLogger.Error("issue sync timeline failed",
+						"repo", repo,
+						"issue_number", record.Number,
+						"issue_id", record.IssueID,
+						"error", err,
+					)
+					result.Err = err.Error()
+					_ = s.store.SaveRepoCheckpoint(ctx, dao.Checkpoint{
+						Repo:               repo,
+						LastSyncedAt:       time.Now(),
+						LastIssueUpdatedAt: cp.LastIssueUpdatedAt,
+						LastRunStatus:      "failed",
+						LastError:          result.Err,
+					})
+					return result
+				default:
+					// The issue is stored without its timeline, which its next update fetches again.
+					issueSyncLogger.Error("issue sync timeline skipped",
+						"repo", repo,
+						"issue_number", record.Number,
+						"issue_id", record.IssueID,
+						"error", err,
+					)
 				}
 			}
 			normalized = append(normalized, record)
 			result.Fetched++
-			if record.UpdatedAt.After(maxSeenUpdate) {
-				maxSeenUpdate = record.UpdatedAt
-			}
 		}
 
 		persistStartedAt := time.Now()
@@ -356,7 +793,59 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 			})
 			return result
 		}
+		if len(pullRequests) > 0 {
+			if _, err := s.pullRequests.UpsertPullRequests(ctx, pullRequests); err != nil {
+				issueSyncLogger.Error("issue sync pull request persist failed",
+					"repo", repo,
+					"page", currentPage,
+					"pull_request_count", len(pullRequests),
+					"error", err,
+				)
+				result.Err = err.Error()
+				_ = s.store.SaveRepoCheckpoint(ctx, dao.Checkpoint{
+					Repo:               repo,
+					LastSyncedAt:       time.Now(),
+					LastIssueUpdatedAt: cp.LastIssueUpdatedAt,
+					LastRunStatus:      "failed",
+					LastError:          result.Err,
+				})
+				return result
+			}
+		}
+		if len(events) > 0 {
+			if _, err := s.events.UpsertIssueEvents(ctx, events); err != nil {
+				issueSyncLogger.Error("issue sync timeline persist failed",
+					"repo", repo,
+					"page", currentPage,
+					"event_count", len(events),
+					"error", err,
+				)
+				result.Err = err.Error()
+				_ = s.store.SaveRepoCheckpoint(ctx, dao.Checkpoint{
+					Repo:               repo,
+					LastSyncedAt:       time.Now(),
+					LastIssueUpdatedAt: cp.LastIssueUpdatedAt,
+					LastRunStatus:      "failed",
+					LastError:          result.Err,
+				})
+				return result
+			}
+			// The job may have computed these issues between their upsert and their timeline's.
+			if s.responsiveness != nil {
+				if err := s.responsiveness.InvalidateIssueResponsiveness(ctx, repo, issueEventIssueIDs(events)); err != nil {
+					issueSyncLogger.Warn("issue sync responsiveness invalidation failed",
+						"repo", repo,
+						"page", currentPage,
+						"error", err,
+					)
+				}
+			}
+		}
+		for _, etag := range detailETags {
+			s.saveETag(ctx, etag)
+		}
 		result.Persisted += int32(persisted)
+		changed = append(changed, normalized...)
 		issueSyncLogger.Info("issue sync page persisted",
 			"repo", repo,
 			"page", currentPage,
@@ -364,6 +853,14 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 			"persisted", persisted,
 			"duration_ms", time.Since(persistStartedAt).Milliseconds(),
 		)
+		// A backfill lists with a different cursor, so its validators would never match a regular run.
+		if etag, ok := githubETagFor(repo, githubIssuesEndpoint, currentPage, resp); ok && !run.unconditional {
+			s.saveETag(ctx, etag)
+		}
+		pages++
+		if run.progress != nil {
+			run.progress(pages, result)
+		}
 
 		if resp == nil || resp.NextPage == 0 {
 			break
@@ -371,12 +868,16 @@ func (s *IssueSyncService) syncOneRepo(ctx context.Context, cfg conf.GitHubSyncC
 		currentPage = resp.NextPage
 	}
 
+	if s.commentStore != nil && !policy.SkipComments {
+		s.syncRepoComments(ctx, cfg, repo, owner, name, changed, run)
+	}
+
 	_ = s.store.SaveRepoCheckpoint(ctx, dao.Checkpoint{
 		Repo:               repo,
 		LastSyncedAt:       time.Now(),
 		LastIssueUpdatedAt: maxSeenUpdate,
 		LastRunStatus:      "success",
-		LastError:          "",
+		LastError:          result.Err,
 	})
 
 	return result
@@ -388,10 +889,20 @@ func (s *IssueSyncService) syncIssueComments(ctx context.Context, cfg conf.GitHu
 	}
 
 	commentFetchStartedAt := time.Now()
-	comments, err := s.fetchIssueComments(ctx, cfg, owner, name, int(issue.Number))
+	fetched, err := s.fetchIssueComments(ctx, cfg, owner, name, issue.IssueID, int(issue.Number))
 	if err != nil {
 		return err
 	}
+	if fetched.notModified {
+		issueSyncLogger.Info("issue sync comments not modified",
+			"repo", repo,
+			"issue_number", issue.Number,
+			"issue_id", issue.IssueID,
+			"duration_ms", time.Since(commentFetchStartedAt).Milliseconds(),
+		)
+		return nil
+	}
+	comments := fetched.comments
 	issueSyncLogger.Info("issue sync comments fetched",
 		"repo", repo,
 		"issue_number", issue.Number,
@@ -415,66 +926,213 @@ func (s *IssueSyncService) syncIssueComments(ctx context.Context, cfg conf.GitHu
 		"duration_ms", time.Since(saveStartedAt).Milliseconds(),
 		"timeout_seconds", cfg.RequestTimeoutSeconds,
 	)
+	// Validators are only kept once the comments they vouch for are stored.
+	for _, etag := range fetched.etags {
+		s.saveETag(ctx, etag)
+	}
 	return nil
 }
 
-func (s *IssueSyncService) fetchIssueComments(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string, issueNumber int) ([]dao.IssueComment, error) {
+type issueCommentFetch struct {
+	comments []dao.IssueComment
+	// notModified is set when GitHub answered every page with 304.
+	notModified bool
+	etags       []dao.GitHubETag
+}
+
+// fetchIssueComments lists all comments of an issue, sending each page conditionally. Pages answered
+// with 304 are filled in from the comment store, which holds the same pagination from the last sync.
+func (s *IssueSyncService) fetchIssueComments(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string, issueID int64, issueNumber int) (issueCommentFetch, error) {
+	fullRepo := owner + "/" + repo
+	endpoint := fmt.Sprintf("issues/%d/comments", issueNumber)
+
+	var out issueCommentFetch
+	var pages [][]dao.IssueComment
+	var unchanged []int
 	currentPage := 1
-	out := make([]dao.IssueComment, 0)
 	for {
-		pageStartedAt := time.Now()
+		cached := s.lookupETag(ctx, fullRepo, endpoint, currentPage)
+		items, resp, err := s.listIssueCommentsPage(withGitHubETag(ctx, cached), cfg, owner, repo, issueNumber, currentPage)
+		if err != nil {
+			return issueCommentFetch{}, err
+		}
+
+		nextPage := cached.NextPage
+		if githubNotModified(resp) {
+			unchanged = append(unchanged, len(pages))
+			pages = append(pages, nil)
+		} else {
+			pages = append(pages, items)
+			nextPage = 0
+			if resp != nil {
+				nextPage = resp.NextPage
+			}
+			if etag, ok := githubETagFor(fullRepo, endpoint, currentPage, resp); ok {
+				out.etags = append(out.etags, etag)
+			}
+		}
+
+		if nextPage == 0 {
+			break
+		}
+		currentPage = nextPage
+	}
+
+	if len(unchanged) == len(pages) {
+		out.notModified = true
+		return out, nil
+	}
+	if len(unchanged) > 0 {
+		if err := s.fillUnchangedCommentPages(ctx, cfg, owner, repo, issueID, issueNumber, pages, unchanged); err != nil {
+			return issueCommentFetch{}, err
+		}
+	}
+	out.comments = make([]dao.IssueComment, 0)
+	for _, page := range pages {
+		out.comments = append(out.comments, page...)
+	}
+	return out, nil
+}
+
+// fillUnchangedCommentPages copies 304 pages out of the stored comments and refetches them
+// unconditionally when the stored copy does not cover them.
+func (s *IssueSyncService) fillUnchangedCommentPages(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string, issueID int64, issueNumber int, pages [][]dao.IssueComment, unchanged []int) error {
+	stored, err := s.commentStore.LoadComments(ctx, owner+"/"+repo, issueID, int32(issueNumber))
+	if err != nil {
+		issueSyncLogger.Warn("issue sync stored comments unavailable, refetching unchanged pages",
+			"repo", owner+"/"+repo,
+			"issue_number", issueNumber,
+			"error", err,
+		)
+		stored = nil
+	}
+
+	for _, idx := range unchanged {
+		start, end := idx*githubCommentsPerPage, (idx+1)*githubCommentsPerPage
+		last := idx == len(pages)-1
+		if end <= len(stored) || (last && start < len(stored)) {
+			pages[idx] = stored[start:min(end, len(stored))]
+			continue
+		}
+		items, _, err := s.listIssueCommentsPage(ctx, cfg, owner, repo, issueNumber, idx+1)
+		if err != nil {
+			return err
+		}
+		pages[idx] = items
+	}
+	return nil
+}
+
+func (s *IssueSyncService) listIssueCommentsPage(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string, issueNumber, page int) ([]dao.IssueComment, *github.Response, error) {
+	pageStartedAt := time.Now()
+	var items []*github.IssueComment
+	var resp *github.Response
+	err := s.withGitHubRateLimit(ctx, owner+"/"+repo, func() (*github.Response, error) {
 		requestCtx, cancel := requestTimeout(ctx, cfg)
-		items, resp, err := s.client.ListComments(requestCtx, owner, repo, issueNumber, &github.IssueListCommentsOptions{
+		defer cancel()
+		var err error
+		items, resp, err = s.client.ListComments(requestCtx, owner, repo, issueNumber, &github.IssueListCommentsOptions{
 			Sort:      github.Ptr("created"),
 			Direction: github.Ptr("asc"),
 			ListOptions: github.ListOptions{
-				Page:    currentPage,
-				PerPage: 100,
+				Page:    page,
+				PerPage: githubCommentsPerPage,
 			},
 		})
-		cancel()
-		if err != nil {
-			return nil, fmt.Errorf("list issue comments for %s/%s#%d: %w", owner, repo, issueNumber, err)
-		}
-		issueSyncLogger.Info("issue sync comments page fetched",
-			"repo", owner+"/"+repo,
-			"issue_number", issueNumber,
-			"page", currentPage,
-			"comment_count", len(items),
-			"duration_ms", time.Since(pageStartedAt).Milliseconds(),
-			"next_page", func() int {
-				if resp == nil {
-					return 0
-				}
-				return resp.NextPage
-			}(),
+		return resp, err
+	})
+	if err != nil && !githubNotModified(resp) {
+		return nil, nil, fmt.Errorf("list issue comments for %s/%s#%d: %w", owner, repo, issueNumber, err)
+	}
+	issueSyncLogger.Info("issue sync comments page fetched",
+		"repo", owner+"/"+repo,
+		"issue_number", issueNumber,
+		"page", page,
+		"comment_count", len(items),
+		"not_modified", githubNotModified(resp),
+		"duration_ms", time.Since(pageStartedAt).Milliseconds(),
+		"next_page", func() int {
+			if resp == nil {
+				return 0
+			}
+			return resp.NextPage
+		}(),
+	)
+
+	out := make([]dao.IssueComment, 0, len(items))
+	for _, item := range items {
+		out = append(out, toIssueComment(item))
+	}
+	return out, resp, nil
+}
+
+// lookupETag returns the stored validator for a page, or a zero one when the store keeps none.
+func (s *IssueSyncService) lookupETag(ctx context.Context, repo, endpoint string, page int) dao.GitHubETag {
+	if s.etags == nil || githubETagsDisabled(ctx) {
+		return dao.GitHubETag{}
+	}
+	etag, err := s.etags.GetGitHubETag(ctx, repo, endpoint, page)
+	if err != nil {
+		issueSyncLogger.Warn("issue sync etag lookup failed",
+			"repo", repo,
+			"endpoint", endpoint,
+			"page", page,
+			"error", err,
 		)
+		return dao.GitHubETag{}
+	}
+	return etag
+}
 
-		for _, item := range items {
-			out = append(out, toIssueComment(item))
-		}
+func (s *IssueSyncService) saveETag(ctx context.Context, etag dao.GitHubETag) {
+	if s.etags == nil {
+		return
+	}
+	if err := s.etags.SaveGitHubETag(ctx, etag); err != nil {
+		issueSyncLogger.Warn("issue sync etag save failed",
+			"repo", etag.Repo,
+			"endpoint", etag.Endpoint,
+			"page", etag.Page,
+			"error", err,
+		)
+	}
+}
 
-		if resp == nil || resp.NextPage == 0 {
-			break
-		}
-		currentPage = resp.NextPage
+func githubETagFor(repo, endpoint string, page int, resp *github.Response) (dao.GitHubETag, bool) {
+	etag, lastModified := githubETagFrom(resp)
+	if etag == "" && lastModified == "" {
+		return dao.GitHubETag{}, false
 	}
-	return out, nil
+	return dao.GitHubETag{
+		Repo:         repo,
+		Endpoint:     endpoint,
+		Page:         page,
+		ETag:         etag,
+		LastModified: lastModified,
+		NextPage:     resp.NextPage,
+	}, true
 }
 
 func (s *IssueSyncService) listByRepoWithRetry(ctx context.Context, cfg conf.GitHubSyncConfig, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
 	var lastErr error
 	for attempt := 0; attempt < 3; attempt++ {
 		attemptStartedAt := time.Now()
-		requestCtx, cancel := requestTimeout(ctx, cfg)
-		issues, resp, err := s.client.ListByRepo(requestCtx, owner, repo, opts)
-		cancel()
-		if err == nil {
+		var issues []*github.Issue
+		var resp *github.Response
+		err := s.withGitHubRateLimit(ctx, owner+"/"+repo, func() (*github.Response, error) {
+			requestCtx, cancel := requestTimeout(ctx, cfg)
+			defer cancel()
+			var err error
+			issues, resp, err = s.client.ListByRepo(requestCtx, owner, repo, opts)
+			return resp, err
+		})
+		if err == nil || githubNotModified(resp) {
 			issueSyncLogger.Info("issue sync list issues request succeeded",
 				"repo", owner+"/"+repo,
 				"page", opts.ListOptions.Page,
 				"attempt", attempt+1,
 				"issue_count", len(issues),
+				"not_modified", githubNotModified(resp),
 				"duration_ms", time.Since(attemptStartedAt).Milliseconds(),
 				"timeout_seconds", cfg.RequestTimeoutSeconds,
 			)
@@ -534,6 +1192,7 @@ func toSyncedIssue(repo string, issue *github.Issue) dao.SyncedIssue {
 		Author:        issue.GetUser().GetLogin(),
 		Assignees:     assignees,
 		Labels:        labels,
+		Milestone:     int32(issue.GetMilestone().GetNumber()),
 		Comments:      int32(issue.GetComments()),
 		IsPullRequest: isPR,
 		HTMLURL:       issue.GetHTMLURL(),
@@ -546,9 +1205,10 @@ func toSyncedIssue(repo string, issue *github.Issue) dao.SyncedIssue {
 
 func toIssueComment(in *github.IssueComment) dao.IssueComment {
 	out := dao.IssueComment{
-		ID:      in.GetID(),
-		Body:    in.GetBody(),
-		HTMLURL: in.GetHTMLURL(),
+		ID:                in.GetID(),
+		Body:              in.GetBody(),
+		HTMLURL:           in.GetHTMLURL(),
+		AuthorAssociation: in.GetAuthorAssociation(),
 	}
 	if in.User != nil {
 		out.UserLogin = in.User.GetLogin()
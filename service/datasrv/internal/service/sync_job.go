@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// syncJobHistory bounds how many finished sync jobs stay watchable.
+const syncJobHistory = 20
+
+// Sync job event types. Page events carry the running totals of one target; the finished event
+// carries the totals of the job and the error that stopped it, if any.
+const (
+	SyncJobEventStarted        = "started"
+	SyncJobEventPage           = "page"
+	SyncJobEventTargetFinished = "target_finished"
+	SyncJobEventFinished       = "finished"
+)
+
+var ErrSyncJobNotFound = errors.New("sync job not found")
+
+// SyncJobEvent is one step of an issue or feed sync job.
+type SyncJobEvent struct {
+	JobID     string
+	Type      string
+	Target    string
+	Page      int32
+	Fetched   int32
+	Persisted int32
+	Err       string
+	At        time.Time
+}
+
+// SyncJob identifies a manual or scheduled sync run that can be watched.
+type SyncJob struct {
+	ID        string
+	JobType   string
+	StartedAt time.Time
+}
+
+// syncJob keeps the events of one run so that watchers attaching late get the full history.
+type syncJob struct {
+	SyncJob
+
+	mu       sync.Mutex
+	events   []SyncJobEvent
+	finished bool
+	// changed is closed and replaced whenever an event is published.
+	changed chan struct{}
+}
+
+func newSyncJob(jobType string) *syncJob {
+	return &syncJob{
+		SyncJob: SyncJob{ID: newRunID(), JobType: jobType, StartedAt: time.Now()},
+		changed: make(chan struct{}),
+	}
+}
+
+func (j *syncJob) publish(event SyncJobEvent) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.finished {
+		return
+	}
+	event.JobID = j.ID
+	if event.At.IsZero() {
+		event.At = time.Now()
+	}
+	j.events = append(j.events, event)
+	j.finished = event.Type == SyncJobEventFinished
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+func (j *syncJob) done() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.finished
+}
+
+// watch replays the events of the job and follows it until the finished event has been sent or
+// ctx is done.
+func (j *syncJob) watch(ctx context.Context, send func(SyncJobEvent) error) error {
+	next := 0
+	for {
+		j.mu.Lock()
+		pending := j.events[next:]
+		finished := j.finished
+		changed := j.changed
+		j.mu.Unlock()
+
+		for _, event := range pending {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		next += len(pending)
+		if finished {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// syncJobRegistry holds the running job and the last finished ones of a sync service.
+type syncJobRegistry struct {
+	mu    sync.Mutex
+	jobs  map[string]*syncJob
+	order []string
+}
+
+func (r *syncJobRegistry) add(job *syncJob) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.jobs == nil {
+		r.jobs = map[string]*syncJob{}
+	}
+	r.jobs[job.ID] = job
+	r.order = append(r.order, job.ID)
+
+	finished := 0
+	for _, id := range r.order {
+		if r.jobs[id].done() {
+			finished++
+		}
+	}
+	kept := r.order[:0]
+	for _, id := range r.order {
+		if finished > syncJobHistory && r.jobs[id].done() {
+			delete(r.jobs, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	r.order = kept
+}
+
+func (r *syncJobRegistry) watch(ctx context.Context, id string, send func(SyncJobEvent) error) error {
+	r.mu.Lock()
+	job, ok := r.jobs[id]
+	r.mu.Unlock()
+	if !ok {
+		return ErrSyncJobNotFound
+	}
+	return job.watch(ctx, send)
+}
+
+type syncJobKey struct{}
+
+func withSyncJob(ctx context.Context, job *syncJob) context.Context {
+	return context.WithValue(ctx, syncJobKey{}, job)
+}
+
+// syncJobFrom returns the job of the run ctx belongs to, or nil outside of a tracked run.
+func syncJobFrom(ctx context.Context) *syncJob {
+	job, _ := ctx.Value(syncJobKey{}).(*syncJob)
+	return job
+}
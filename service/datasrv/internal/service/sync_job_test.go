@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeWatchStream collects the messages of a server-streaming RPC.
+type fakeWatchStream[T any] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*T
+}
+
+func (f *fakeWatchStream[T]) Send(msg *T) error {
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func (f *fakeWatchStream[T]) Context() context.Context { return f.ctx }
+
+func TestSyncIssuesReturnsJobAndStreamsPageProgress(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC().Round(time.Second)
+	syncSvc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	syncSvc.client = &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(1, 1, updated)}, nextPage: 2},
+			{issues: []*github.Issue{ghIssue(2, 2, updated.Add(time.Minute)), ghIssue(3, 3, updated.Add(2*time.Minute))}},
+		},
+	}
+	srv := NewIssueSyncAdminGRPCServer(store, syncSvc, &conf.Config{})
+
+	resp, err := srv.SyncIssues(context.Background(), &issuesv1.SyncIssuesRequest{})
+	if err != nil {
+		t.Fatalf("SyncIssues() error = %v", err)
+	}
+	if resp.GetJobId() == "" || resp.GetAttached() || resp.GetStartedAt() == nil {
+		t.Fatalf("SyncIssues() = %#v, want a new job", resp)
+	}
+
+	stream := &fakeWatchStream[issuesv1.SyncJobEvent]{ctx: context.Background()}
+	if err := srv.WatchSyncJob(&issuesv1.WatchSyncJobRequest{Id: resp.GetJobId()}, stream); err != nil {
+		t.Fatalf("WatchSyncJob() error = %v", err)
+	}
+	var types []string
+	for _, event := range stream.sent {
+		if event.GetJobId() != resp.GetJobId() {
+			t.Fatalf("event job id = %q, want %q", event.GetJobId(), resp.GetJobId())
+		}
+		types = append(types, event.GetType())
+	}
+	want := []string{SyncJobEventStarted, SyncJobEventPage, SyncJobEventPage, SyncJobEventTargetFinished, SyncJobEventFinished}
+	if len(types) != len(want) {
+		t.Fatalf("event types = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Fatalf("event types = %v, want %v", types, want)
+		}
+	}
+	if page := stream.sent[2]; page.GetTarget() != "owner/repo" || page.GetPage() != 2 || page.GetPersisted() != 3 {
+		t.Fatalf("second page event = %#v, want 3 persisted after 2 pages", page)
+	}
+	if finished := stream.sent[4]; finished.GetFetched() != 3 || finished.GetPersisted() != 3 || finished.GetError() != "" {
+		t.Fatalf("finished event = %#v, want 3 fetched and persisted", finished)
+	}
+	if syncSvc.IsRunning() {
+		t.Fatalf("IsRunning() = true after the finished event")
+	}
+}
+
+func TestStartSyncAttachesToRunningSync(t *testing.T) {
+	store := newFakeSyncStore()
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{Enabled: false}, nil)
+	ctx := context.Background()
+
+	running, started := svc.claimSync()
+	if !started {
+		t.Fatalf("claimSync() did not start a run")
+	}
+	job, attached := svc.StartSync(ctx, "owner/repo", nil)
+	if !attached || job.ID != running.ID {
+		t.Fatalf("StartSync() = %#v, %v, want attached to %s", job, attached, running.ID)
+	}
+	if _, err := svc.RunSync(ctx, ""); err == nil {
+		t.Fatalf("RunSync() error = nil, want the blocking call to refuse a second run")
+	}
+
+	if _, err := svc.runSyncJob(ctx, running, "", false, nil); err != nil {
+		t.Fatalf("runSyncJob() error = %v", err)
+	}
+	var events []SyncJobEvent
+	if err := svc.WatchSyncJob(ctx, running.ID, func(event SyncJobEvent) error {
+		events = append(events, event)
+		return nil
+	}); err != nil {
+		t.Fatalf("WatchSyncJob() error = %v", err)
+	}
+	if len(events) != 2 || events[0].Type != SyncJobEventStarted || events[1].Type != SyncJobEventFinished {
+		t.Fatalf("events = %#v, want the replayed history of a finished job", events)
+	}
+
+	next, attached := svc.StartSync(ctx, "", nil)
+	if attached || next.ID == running.ID {
+		t.Fatalf("StartSync() after the run = %#v, %v, want a new job", next, attached)
+	}
+}
+
+func TestWatchSyncJobUnknownID(t *testing.T) {
+	store := newFakeSyncStore()
+	srv := NewIssueSyncAdminGRPCServer(store, NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil), &conf.Config{})
+	stream := &fakeWatchStream[issuesv1.SyncJobEvent]{ctx: context.Background()}
+
+	if err := srv.WatchSyncJob(&issuesv1.WatchSyncJobRequest{Id: "missing"}, stream); status.Code(err) != codes.NotFound {
+		t.Fatalf("WatchSyncJob() error = %v, want NotFound", err)
+	}
+	if err := srv.WatchSyncJob(&issuesv1.WatchSyncJobRequest{}, stream); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("WatchSyncJob() without id error = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+)
+
+// defaultSyncRunRetention applies when no retention is configured.
+const defaultSyncRunRetention = 30 * 24 * time.Hour
+
+type syncTriggerKey struct{}
+
+// WithSyncTrigger marks the runs started with the returned context as started by trigger, such as
+// dao.SyncRunTriggerScheduler. Runs started without one are recorded as manual.
+func WithSyncTrigger(ctx context.Context, trigger string) context.Context {
+	return context.WithValue(ctx, syncTriggerKey{}, trigger)
+}
+
+func syncTriggerFrom(ctx context.Context) string {
+	if trigger, _ := ctx.Value(syncTriggerKey{}).(string); trigger != "" {
+		return trigger
+	}
+	return dao.SyncRunTriggerManual
+}
+
+// recordSyncRun completes run with an id, its trigger, totals and status, and stores it. Runs that
+// neither touched a target nor failed, such as scheduler ticks with nothing due, are not recorded.
+// The run history is best effort: a store without one drops the record and a failed save is only logged.
+func recordSyncRun(ctx context.Context, runs dao.SyncRunStore, run dao.SyncRun) {
+	if runs == nil || (len(run.Targets) == 0 && run.Err == "") {
+		return
+	}
+	run.ID = newRunID()
+	if run.Trigger == "" {
+		run.Trigger = syncTriggerFrom(ctx)
+	}
+	if run.FinishedAt.IsZero() {
+		run.FinishedAt = time.Now()
+	}
+	run.Fetched, run.Persisted, run.Failed = 0, 0, 0
+	var erroredTargets int
+	for _, target := range run.Targets {
+		run.Fetched += target.Fetched
+		run.Persisted += target.Persisted
+		run.Failed += target.Failed
+		if target.Err != "" {
+			erroredTargets++
+		}
+	}
+	switch {
+	case run.Err != "" || (erroredTargets > 0 && erroredTargets == len(run.Targets) && run.Persisted == 0):
+		run.Status = dao.SyncRunStatusFailed
+	case erroredTargets > 0 || run.Failed > 0:
+		run.Status = dao.SyncRunStatusPartial
+	default:
+		run.Status = dao.SyncRunStatusSuccess
+	}
+
+	// The record of a run cut short by shutdown is still worth keeping.
+	if err := runs.SaveSyncRun(context.WithoutCancel(ctx), run); err != nil {
+		issueSyncLogger.Warn("sync run history save failed",
+			"job_type", run.JobType,
+			"trigger", run.Trigger,
+			"status", run.Status,
+			"error", err,
+		)
+	}
+}
+
+// PruneSyncRuns drops runs older than retentionDays, or 30 days when it is not positive.
+func (s *IssueSyncService) PruneSyncRuns(ctx context.Context, retentionDays int) (int, error) {
+	if s.runs == nil {
+		return 0, nil
+	}
+	retention := defaultSyncRunRetention
+	if retentionDays > 0 {
+		retention = time.Duration(retentionDays) * 24 * time.Hour
+	}
+	return s.runs.PruneSyncRuns(ctx, time.Now().Add(-retention))
+}
+
+func issueSyncRunRecord(summary SyncRunSummary, err error) dao.SyncRun {
+	run := dao.SyncRun{
+		JobType:    dao.SyncRunJobIssueSync,
+		StartedAt:  summary.StartedAt,
+		FinishedAt: summary.FinishedAt,
+	}
+	if err != nil {
+		run.Err = err.Error()
+	}
+	for _, result := range summary.Results {
+		run.Targets = append(run.Targets, dao.SyncRunTarget{
+			Target:    result.Repo,
+			Fetched:   result.Fetched,
+			Persisted: result.Persisted,
+			Failed:    failedCount(result.Err),
+			Err:       result.Err,
+		})
+	}
+	return run
+}
+
+func feedSyncRunRecord(summary FeedSyncRunSummary, err error) dao.SyncRun {
+	run := dao.SyncRun{
+		JobType:    dao.SyncRunJobFeedSync,
+		StartedAt:  summary.StartedAt,
+		FinishedAt: summary.FinishedAt,
+	}
+	if err != nil {
+		run.Err = err.Error()
+	}
+	for _, result := range summary.Results {
+		run.Targets = append(run.Targets, dao.SyncRunTarget{
+			Target:    result.FeedSourceID,
+			Fetched:   result.Fetched,
+			Persisted: result.Persisted,
+			Failed:    failedCount(result.Error),
+			Err:       result.Error,
+		})
+	}
+	return run
+}
+
+func issueSummaryRunRecord(summary IssueSummaryRunSummary, err error) dao.SyncRun {
+	run := dao.SyncRun{
+		JobType:    dao.SyncRunJobIssueSummary,
+		StartedAt:  summary.StartedAt,
+		FinishedAt: summary.FinishedAt,
+	}
+	if err != nil {
+		run.Err = err.Error()
+	}
+	for _, result := range summary.Results {
+		run.Targets = append(run.Targets, dao.SyncRunTarget{
+			Target:    result.Repo,
+			Fetched:   int32(result.Scanned),
+			Persisted: int32(result.Updated),
+			Failed:    int32(result.Failed),
+			Err:       joinRunErrors(result.Errors),
+		})
+	}
+	return run
+}
+
+func prReviewRunRecord(summary PRReviewRunSummary, err error) dao.SyncRun {
+	run := dao.SyncRun{
+		JobType:    dao.SyncRunJobPRReview,
+		StartedAt:  summary.StartedAt,
+		FinishedAt: summary.FinishedAt,
+	}
+	if err != nil {
+		run.Err = err.Error()
+	}
+	for _, result := range summary.Results {
+		run.Targets = append(run.Targets, dao.SyncRunTarget{
+			Target:    result.Repo,
+			Fetched:   int32(result.Scanned),
+			Persisted: int32(result.Reviewed),
+			Failed:    int32(result.Failed),
+			Err:       joinRunErrors(result.Errors),
+		})
+	}
+	return run
+}
+
+func failedCount(err string) int32 {
+	if err == "" {
+		return 0
+	}
+	return 1
+}
+
+// joinRunErrors keeps the first error of a target; the rest are in the logs.
+func joinRunErrors(errs []string) string {
+	switch len(errs) {
+	case 0:
+		return ""
+	case 1:
+		return errs[0]
+	}
+	return fmt.Sprintf("%s (and %d more)", errs[0], len(errs)-1)
+}
+
+func newRunID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	issuesv1 "github.com/kongken/datasrv/pkg/proto/issues/v1"
+	"github.com/kongken/datasrv/service/datasrv/internal/conf"
+	"github.com/kongken/datasrv/service/datasrv/internal/dao"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRunSyncRecordsRunHistory(t *testing.T) {
+	store := newFakeSyncStore()
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(1, 1, time.Now().UTC()), ghIssue(2, 2, time.Now().UTC())}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	ctx := WithSyncTrigger(context.Background(), dao.SyncRunTriggerScheduler)
+	if _, err := svc.RunScheduledSync(ctx); err != nil {
+		t.Fatalf("RunScheduledSync() error = %v", err)
+	}
+	if len(store.runs) != 1 {
+		t.Fatalf("recorded runs = %d, want 1", len(store.runs))
+	}
+	run := store.runs[0]
+	if run.ID == "" || run.JobType != dao.SyncRunJobIssueSync || run.Trigger != dao.SyncRunTriggerScheduler {
+		t.Fatalf("run = %#v, want a scheduled issue sync", run)
+	}
+	if run.Status != dao.SyncRunStatusSuccess || run.Fetched != 2 || run.Persisted != 2 || run.Failed != 0 {
+		t.Fatalf("run = %#v, want 2 fetched and persisted", run)
+	}
+	if len(run.Targets) != 1 || run.Targets[0].Target != "owner/repo" {
+		t.Fatalf("targets = %#v, want owner/repo", run.Targets)
+	}
+	if run.StartedAt.IsZero() || run.FinishedAt.Before(run.StartedAt) {
+		t.Fatalf("run window = %v..%v", run.StartedAt, run.FinishedAt)
+	}
+
+	// Nothing is due right after the run, so the next tick leaves no record.
+	if _, err := svc.RunScheduledSync(ctx); err != nil {
+		t.Fatalf("RunScheduledSync() error = %v", err)
+	}
+	if len(store.runs) != 1 {
+		t.Fatalf("recorded runs = %d, want the idle tick skipped", len(store.runs))
+	}
+
+	if _, err := svc.RunSync(context.Background(), "owner/repo"); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(store.runs) != 2 || store.runs[1].Trigger != dao.SyncRunTriggerManual {
+		t.Fatalf("runs = %#v, want a manual run recorded", store.runs)
+	}
+}
+
+func TestRecordSyncRunStatus(t *testing.T) {
+	cases := []struct {
+		name string
+		run  dao.SyncRun
+		want string
+	}{
+		{
+			name: "partial",
+			run: dao.SyncRun{Targets: []dao.SyncRunTarget{
+				{Target: "o/a", Fetched: 3, Persisted: 3},
+				{Target: "o/b", Failed: 1, Err: "boom"},
+			}},
+			want: dao.SyncRunStatusPartial,
+		},
+		{
+			name: "item failures",
+			run:  dao.SyncRun{Targets: []dao.SyncRunTarget{{Target: "o/a", Fetched: 3, Persisted: 2, Failed: 1}}},
+			want: dao.SyncRunStatusPartial,
+		},
+		{
+			name: "every target failed",
+			run: dao.SyncRun{Targets: []dao.SyncRunTarget{
+				{Target: "o/a", Failed: 1, Err: "boom"},
+				{Target: "o/b", Failed: 1, Err: "boom"},
+			}},
+			want: dao.SyncRunStatusFailed,
+		},
+		{
+			name: "run error",
+			run:  dao.SyncRun{Err: "list managed repos: unavailable"},
+			want: dao.SyncRunStatusFailed,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newFakeSyncStore()
+			recordSyncRun(context.Background(), store, tc.run)
+			if len(store.runs) != 1 {
+				t.Fatalf("recorded runs = %d, want 1", len(store.runs))
+			}
+			if got := store.runs[0].Status; got != tc.want {
+				t.Fatalf("status = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGitHubWebhookDeliveryRecordsRun(t *testing.T) {
+	svc, store, _, _ := newTestWebhookService(t)
+
+	if _, err := svc.HandleDelivery(context.Background(), "issues", "delivery-1", []byte(webhookIssuePayload)); err != nil {
+		t.Fatalf("HandleDelivery() error = %v", err)
+	}
+	if _, err := svc.HandleDelivery(context.Background(), "ping", "delivery-2", []byte(`{"zen":"hi"}`)); err != nil {
+		t.Fatalf("HandleDelivery(ping) error = %v", err)
+	}
+	if len(store.runs) != 1 {
+		t.Fatalf("recorded runs = %d, want only the issues delivery", len(store.runs))
+	}
+	run := store.runs[0]
+	if run.Trigger != dao.SyncRunTriggerWebhook || run.Status != dao.SyncRunStatusSuccess || run.Persisted != 1 {
+		t.Fatalf("run = %#v, want a successful webhook run", run)
+	}
+}
+
+func TestPruneSyncRuns(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now()
+	store.runs = []dao.SyncRun{
+		{ID: "old", StartedAt: now.Add(-40 * 24 * time.Hour)},
+		{ID: "recent", StartedAt: now.Add(-10 * 24 * time.Hour)},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil)
+
+	removed, err := svc.PruneSyncRuns(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("PruneSyncRuns() error = %v", err)
+	}
+	if removed != 1 || len(store.runs) != 1 || store.runs[0].ID != "recent" {
+		t.Fatalf("removed = %d, runs = %#v, want the 40 day old run dropped", removed, store.runs)
+	}
+	if removed, _ = svc.PruneSyncRuns(context.Background(), 7); removed != 1 || len(store.runs) != 0 {
+		t.Fatalf("removed = %d, runs = %#v, want a 7 day retention to drop the rest", removed, store.runs)
+	}
+}
+
+func TestIssueSyncAdminGRPCServer_ListAndGetSyncRuns(t *testing.T) {
+	store := newFakeSyncStore()
+	now := time.Now().UTC().Truncate(time.Second)
+	store.runs = []dao.SyncRun{
+		{ID: "r1", JobType: dao.SyncRunJobIssueSync, Status: dao.SyncRunStatusSuccess, StartedAt: now.Add(-3 * time.Hour)},
+		{ID: "r2", JobType: dao.SyncRunJobFeedSync, Status: dao.SyncRunStatusFailed, StartedAt: now.Add(-2 * time.Hour)},
+		{ID: "r3", JobType: dao.SyncRunJobIssueSync, Status: dao.SyncRunStatusPartial, StartedAt: now.Add(-time.Hour),
+			Targets: []dao.SyncRunTarget{{Target: "o/a", Failed: 1, Err: "boom"}}},
+	}
+	srv := NewIssueSyncAdminGRPCServer(store, NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{}, nil), &conf.Config{})
+	ctx := context.Background()
+
+	listed, err := srv.ListSyncRuns(ctx, &issuesv1.ListSyncRunsRequest{JobType: dao.SyncRunJobIssueSync, PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListSyncRuns() error = %v", err)
+	}
+	if len(listed.Runs) != 1 || listed.Runs[0].GetId() != "r3" || !listed.HasNext {
+		t.Fatalf("first page = %#v, want r3 with more", listed)
+	}
+	if got := listed.Runs[0].GetTargets(); len(got) != 1 || got[0].GetError() != "boom" {
+		t.Fatalf("targets = %#v, want the failed target", got)
+	}
+
+	listed, err = srv.ListSyncRuns(ctx, &issuesv1.ListSyncRunsRequest{
+		StartedAfter:  timestamppb.New(now.Add(-150 * time.Minute)),
+		StartedBefore: timestamppb.New(now.Add(-30 * time.Minute)),
+	})
+	if err != nil {
+		t.Fatalf("ListSyncRuns() error = %v", err)
+	}
+	if len(listed.Runs) != 2 || listed.Runs[0].GetId() != "r3" || listed.Runs[1].GetId() != "r2" || listed.HasNext {
+		t.Fatalf("time range runs = %#v, want r3 and r2", listed.Runs)
+	}
+
+	if _, err := srv.ListSyncRuns(ctx, &issuesv1.ListSyncRunsRequest{Status: "exploded"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ListSyncRuns() unknown status error = %v, want InvalidArgument", err)
+	}
+
+	run, err := srv.GetSyncRun(ctx, &issuesv1.GetSyncRunRequest{Id: "r2"})
+	if err != nil {
+		t.Fatalf("GetSyncRun() error = %v", err)
+	}
+	if run.GetJobType() != dao.SyncRunJobFeedSync || run.GetStatus() != dao.SyncRunStatusFailed {
+		t.Fatalf("run = %#v, want r2", run)
+	}
+	if _, err := srv.GetSyncRun(ctx, &issuesv1.GetSyncRunRequest{Id: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetSyncRun() missing error = %v, want NotFound", err)
+	}
+}
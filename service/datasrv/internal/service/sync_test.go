@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"sort"
 	"sync"
 	"testing"
@@ -18,7 +21,17 @@ type fakeSyncStore struct {
 	checkpoints map[string]dao.Checkpoint
 	issues      map[string][]dao.SyncedIssue
 	managed     map[string]dao.ManagedRepo
+	etags       map[string]dao.GitHubETag
+	prs         map[int64]dao.PullRequestDetail
+	events      []dao.IssueEvent
+	labels      map[string][]dao.RepoLabel
+	milestones  map[string][]dao.RepoMilestone
+	rules       []string
+	runs        []dao.SyncRun
+	cursors     map[string]dao.CommentCheckpoint
+	threads     map[string][]dao.IssueComment
 	listCalls   int
+	upsertCalls int
 }
 
 type fakeIssueCommentStore struct {
@@ -33,6 +46,12 @@ func newFakeSyncStore() *fakeSyncStore {
 		checkpoints: map[string]dao.Checkpoint{},
 		issues:      map[string][]dao.SyncedIssue{},
 		managed:     map[string]dao.ManagedRepo{},
+		etags:       map[string]dao.GitHubETag{},
+		prs:         map[int64]dao.PullRequestDetail{},
+		labels:      map[string][]dao.RepoLabel{},
+		milestones:  map[string][]dao.RepoMilestone{},
+		cursors:     map[string]dao.CommentCheckpoint{},
+		threads:     map[string][]dao.IssueComment{},
 	}
 }
 
@@ -43,6 +62,7 @@ func newFakeIssueCommentStore() *fakeIssueCommentStore {
 func (f *fakeSyncStore) UpsertIssues(_ context.Context, repo string, issues []dao.SyncedIssue) (int, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	f.upsertCalls++
 	existing := append([]dao.SyncedIssue(nil), f.issues[repo]...)
 	for _, incoming := range issues {
 		replaced := false
@@ -51,6 +71,7 @@ func (f *fakeSyncStore) UpsertIssues(_ context.Context, repo string, issues []da
 				if incoming.AISummary == "" {
 					incoming.AISummary = current.AISummary
 				}
+				incoming.Tombstone, incoming.TombstonedAt, incoming.TransferredTo = "", nil, ""
 				existing[i] = incoming
 				replaced = true
 				break
@@ -89,6 +110,9 @@ func (f *fakeSyncStore) ListIssues(_ context.Context, filter dao.SyncIssueFilter
 		if filter.Number > 0 && it.Number != filter.Number {
 			continue
 		}
+		if !filter.IncludeTombstoned && it.Tombstone != "" {
+			continue
+		}
 		filtered = append(filtered, it)
 	}
 	sort.SliceStable(filtered, func(i, j int) bool {
@@ -151,6 +175,51 @@ func (f *fakeSyncStore) ReplaceManagedRepos(_ context.Context, repos []string) (
 	return out, nil
 }
 
+func (f *fakeSyncStore) ListManagedRepoRules(_ context.Context) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.rules...), nil
+}
+
+func (f *fakeSyncStore) ReplaceManagedRepoRules(_ context.Context, rules []string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rules = append([]string(nil), rules...)
+	return nil
+}
+
+func (f *fakeSyncStore) ReplaceRuleManagedRepos(_ context.Context, repos []dao.ManagedRepo) ([]dao.ManagedRepo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	next := make(map[string]dao.ManagedRepo, len(repos))
+	now := time.Now().UTC()
+	for _, repo := range repos {
+		item, ok := f.managed[repo.Repo]
+		if !ok {
+			item = dao.ManagedRepo{Repo: repo.Repo, CreatedAt: now}
+		}
+		item.Rule = repo.Rule
+		item.UpdatedAt = now
+		next[repo.Repo] = item
+	}
+	f.managed = next
+	return append([]dao.ManagedRepo(nil), repos...), nil
+}
+
+func (f *fakeSyncStore) UpdateRepoSyncPolicy(_ context.Context, repo string, policy dao.RepoSyncPolicy) (dao.ManagedRepo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	item, ok := f.managed[repo]
+	if !ok {
+		return dao.ManagedRepo{}, dao.ErrManagedRepoNotFound
+	}
+	item.Policy = policy
+	item.UpdatedAt = time.Now().UTC()
+	f.managed[repo] = item
+	return item, nil
+}
+
 func (f *fakeSyncStore) UpdateIssueAISummary(_ context.Context, repo string, issueID int64, number int32, summary string) (dao.SyncedIssue, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -220,12 +289,251 @@ func (f *fakeSyncStore) ListCheckpoints(_ context.Context) ([]dao.Checkpoint, er
 	return out, nil
 }
 
+func (f *fakeSyncStore) GetGitHubETag(_ context.Context, repo, endpoint string, page int) (dao.GitHubETag, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if etag, ok := f.etags[fmt.Sprintf("%s|%s|%d", repo, endpoint, page)]; ok {
+		return etag, nil
+	}
+	return dao.GitHubETag{Repo: repo, Endpoint: endpoint, Page: page}, nil
+}
+
+func (f *fakeSyncStore) SaveGitHubETag(_ context.Context, etag dao.GitHubETag) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.etags[fmt.Sprintf("%s|%s|%d", etag.Repo, etag.Endpoint, etag.Page)] = etag
+	return nil
+}
+
+func (f *fakeSyncStore) TombstoneIssues(_ context.Context, tombstones []dao.IssueTombstone) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	updated := 0
+	for _, it := range tombstones {
+		rows := f.issues[it.Repo]
+		for i, row := range rows {
+			if row.IssueID != it.IssueID {
+				continue
+			}
+			at := it.At
+			row.Tombstone, row.TombstonedAt, row.TransferredTo = it.Reason, &at, it.TransferredTo
+			rows[i] = row
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func (f *fakeSyncStore) UpsertPullRequests(_ context.Context, prs []dao.PullRequestDetail) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, pr := range prs {
+		f.prs[pr.IssueID] = pr
+	}
+	return len(prs), nil
+}
+
+func (f *fakeSyncStore) ListPullRequests(_ context.Context, issueIDs []int64) ([]dao.PullRequestDetail, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]dao.PullRequestDetail, 0, len(issueIDs))
+	for _, id := range issueIDs {
+		if pr, ok := f.prs[id]; ok {
+			out = append(out, pr)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSyncStore) UpsertIssueEvents(_ context.Context, events []dao.IssueEvent) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, incoming := range events {
+		replaced := false
+		for i, current := range f.events {
+			if current.Repo == incoming.Repo && current.IssueID == incoming.IssueID && current.Key == incoming.Key {
+				f.events[i] = incoming
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			f.events = append(f.events, incoming)
+		}
+	}
+	return len(events), nil
+}
+
+func (f *fakeSyncStore) ListIssueEvents(_ context.Context, filter dao.IssueEventFilter) ([]dao.IssueEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var filtered []dao.IssueEvent
+	for _, it := range f.events {
+		if filter.Repo != "" && it.Repo != filter.Repo {
+			continue
+		}
+		if filter.IssueID > 0 && it.IssueID != filter.IssueID {
+			continue
+		}
+		if filter.IssueNumber > 0 && it.IssueNumber != filter.IssueNumber {
+			continue
+		}
+		if len(filter.Events) > 0 && !slices.Contains(filter.Events, it.Event) {
+			continue
+		}
+		filtered = append(filtered, it)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
+	})
+	start := min(max(filter.Offset, 0), len(filtered))
+	end := len(filtered)
+	if filter.Limit > 0 && start+filter.Limit < end {
+		end = start + filter.Limit
+	}
+	return append([]dao.IssueEvent(nil), filtered[start:end]...), nil
+}
+
+func (f *fakeSyncStore) ReplaceRepoLabels(_ context.Context, repo string, labels []dao.RepoLabel) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.labels[repo] = append([]dao.RepoLabel(nil), labels...)
+	return nil
+}
+
+func (f *fakeSyncStore) ReplaceRepoMilestones(_ context.Context, repo string, milestones []dao.RepoMilestone) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.milestones[repo] = append([]dao.RepoMilestone(nil), milestones...)
+	return nil
+}
+
+func (f *fakeSyncStore) ListRepoLabels(_ context.Context, repo string) ([]dao.RepoLabel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if repo != "" {
+		return append([]dao.RepoLabel(nil), f.labels[repo]...), nil
+	}
+	var out []dao.RepoLabel
+	for _, labels := range f.labels {
+		out = append(out, labels...)
+	}
+	return out, nil
+}
+
+func (f *fakeSyncStore) ListRepoMilestones(_ context.Context, repo string) ([]dao.RepoMilestone, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if repo != "" {
+		return append([]dao.RepoMilestone(nil), f.milestones[repo]...), nil
+	}
+	var out []dao.RepoMilestone
+	for _, milestones := range f.milestones {
+		out = append(out, milestones...)
+	}
+	return out, nil
+}
+
+func (f *fakeSyncStore) SaveSyncRun(_ context.Context, run dao.SyncRun) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.runs = append(f.runs, run)
+	return nil
+}
+
+func (f *fakeSyncStore) ListSyncRuns(_ context.Context, filter dao.SyncRunFilter) ([]dao.SyncRun, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]dao.SyncRun, 0, len(f.runs))
+	for i := len(f.runs) - 1; i >= 0; i-- {
+		run := f.runs[i]
+		if filter.JobType != "" && run.JobType != filter.JobType {
+			continue
+		}
+		if filter.Status != "" && run.Status != filter.Status {
+			continue
+		}
+		if !filter.StartedAfter.IsZero() && run.StartedAt.Before(filter.StartedAfter) {
+			continue
+		}
+		if !filter.StartedBefore.IsZero() && !run.StartedAt.Before(filter.StartedBefore) {
+			continue
+		}
+		out = append(out, run)
+	}
+	out = out[min(filter.Offset, len(out)):]
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (f *fakeSyncStore) GetSyncRun(_ context.Context, id string) (dao.SyncRun, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, run := range f.runs {
+		if run.ID == id {
+			return run, nil
+		}
+	}
+	return dao.SyncRun{}, dao.ErrSyncRunNotFound
+}
+
+func (f *fakeSyncStore) PruneSyncRuns(_ context.Context, before time.Time) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	kept := f.runs[:0]
+	for _, run := range f.runs {
+		if run.StartedAt.Before(before) {
+			continue
+		}
+		kept = append(kept, run)
+	}
+	removed := len(f.runs) - len(kept)
+	f.runs = kept
+	return removed, nil
+}
+
+func (f *fakeSyncStore) GetCommentCheckpoint(_ context.Context, repo string) (dao.CommentCheckpoint, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if cp, ok := f.cursors[repo]; ok {
+		return cp, nil
+	}
+	return dao.CommentCheckpoint{Repo: repo}, nil
+}
+
+func (f *fakeSyncStore) SaveCommentCheckpoint(_ context.Context, cp dao.CommentCheckpoint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cursors[cp.Repo] = cp
+	return nil
+}
+
+func (f *fakeSyncStore) SaveIssueComments(_ context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.threads[fmt.Sprintf("%s/%d-%d", repo, issueID, issueNumber)] = append([]dao.IssueComment{}, comments...)
+	return nil
+}
+
+func (f *fakeSyncStore) LoadIssueComments(_ context.Context, repo string, issueID int64, issueNumber int32) ([]dao.IssueComment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	comments, ok := f.threads[fmt.Sprintf("%s/%d-%d", repo, issueID, issueNumber)]
+	if !ok {
+		return nil, dao.ErrIssueCommentsNotFound
+	}
+	return append([]dao.IssueComment{}, comments...), nil
+}
+
 func (f *fakeSyncStore) Close() error { return nil }
 
 type listCall struct {
-	owner string
-	repo  string
-	opts  github.IssueListByRepoOptions
+	owner       string
+	repo        string
+	opts        github.IssueListByRepoOptions
+	ifNoneMatch string
 }
 
 type fakeGitHubIssueClient struct {
@@ -233,24 +541,84 @@ type fakeGitHubIssueClient struct {
 	calls            []listCall
 	responses        []fakeGitHubResponse
 	commentResponses map[int][]fakeGitHubCommentResponse
+	// repoCommentCalls records the options of repo-wide comment listings.
+	repoCommentCalls []github.IssueListCommentsOptions
+	// commentCalls records the issue numbers whose threads were fetched.
+	commentCalls    []int
+	getResponses    map[int]fakeGitHubGetResponse
+	getCalls        []int
+	pullRequests    map[int]*github.PullRequest
+	pullRequestErrs map[int]error
+	reviews         map[int][]*github.PullRequestReview
+	timelines       map[int][]*github.Timeline
+	timelineErrs    map[int]error
+	// detailETags are the current etags of pull request, review and timeline endpoints such as
+	// "pulls/9"; requests carrying them get a 304. detailFetches records the endpoints answered in full.
+	detailETags   map[string]string
+	detailFetches []string
+	labels        []fakeGitHubCatalogResponse[*github.Label]
+	milestones    []fakeGitHubCatalogResponse[*github.Milestone]
+	// orgRepos and userRepos are keyed by owner; owners missing from a map get a 404 there.
+	orgRepos  map[string][]*github.Repository
+	userRepos map[string][]*github.Repository
 }
 
 type fakeGitHubResponse struct {
-	issues   []*github.Issue
-	nextPage int
-	err      error
+	issues      []*github.Issue
+	nextPage    int
+	rate        github.Rate
+	etag        string
+	notModified bool
+	err         error
+}
+
+type fakeGitHubGetResponse struct {
+	issue *github.Issue
+	// status is the HTTP error status, such as 404 or 410; zero means success.
+	status int
+}
+
+type fakeGitHubCatalogResponse[T any] struct {
+	items       []T
+	etag        string
+	notModified bool
+	err         error
 }
 
 type fakeGitHubCommentResponse struct {
-	comments []*github.IssueComment
-	nextPage int
-	err      error
+	comments    []*github.IssueComment
+	nextPage    int
+	etag        string
+	notModified bool
+	err         error
+}
+
+// fakeGitHubHTTPResponse mimics go-github: a 304 comes back as an *ErrorResponse next to the response.
+func fakeGitHubHTTPResponse(nextPage int, rate github.Rate, etag string, notModified bool) (*github.Response, error) {
+	resp := &github.Response{NextPage: nextPage, Rate: rate}
+	if etag == "" && !notModified {
+		return resp, nil
+	}
+	resp.Response = &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Request:    httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/owner/repo/issues", nil),
+	}
+	if etag != "" {
+		resp.Header.Set("ETag", etag)
+	}
+	if notModified {
+		resp.StatusCode = http.StatusNotModified
+		return resp, &github.ErrorResponse{Response: resp.Response}
+	}
+	return resp, nil
 }
 
-func (f *fakeGitHubIssueClient) ListByRepo(_ context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+func (f *fakeGitHubIssueClient) ListByRepo(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	f.calls = append(f.calls, listCall{owner: owner, repo: repo, opts: *opts})
+	etag, _ := ctx.Value(githubETagKey{}).(dao.GitHubETag)
+	f.calls = append(f.calls, listCall{owner: owner, repo: repo, opts: *opts, ifNoneMatch: etag.ETag})
 	if len(f.responses) == 0 {
 		return nil, &github.Response{}, nil
 	}
@@ -259,12 +627,22 @@ func (f *fakeGitHubIssueClient) ListByRepo(_ context.Context, owner, repo string
 	if resp.err != nil {
 		return nil, nil, resp.err
 	}
-	return resp.issues, &github.Response{NextPage: resp.nextPage}, nil
+	ghResp, err := fakeGitHubHTTPResponse(resp.nextPage, resp.rate, resp.etag, resp.notModified)
+	if err != nil {
+		return nil, ghResp, err
+	}
+	return resp.issues, ghResp, nil
 }
 
 func (f *fakeGitHubIssueClient) ListComments(_ context.Context, owner, repo string, issueNumber int, opts *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
+	if issueNumber == 0 && opts != nil {
+		f.repoCommentCalls = append(f.repoCommentCalls, *opts)
+	}
+	if issueNumber != 0 {
+		f.commentCalls = append(f.commentCalls, issueNumber)
+	}
 	if f.commentResponses == nil || len(f.commentResponses[issueNumber]) == 0 {
 		return nil, &github.Response{}, nil
 	}
@@ -273,7 +651,141 @@ func (f *fakeGitHubIssueClient) ListComments(_ context.Context, owner, repo stri
 	if resp.err != nil {
 		return nil, nil, resp.err
 	}
-	return resp.comments, &github.Response{NextPage: resp.nextPage}, nil
+	ghResp, err := fakeGitHubHTTPResponse(resp.nextPage, github.Rate{}, resp.etag, resp.notModified)
+	if err != nil {
+		return nil, ghResp, err
+	}
+	return resp.comments, ghResp, nil
+}
+
+func (f *fakeGitHubIssueClient) Get(_ context.Context, owner, repo string, number int) (*github.Issue, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getCalls = append(f.getCalls, number)
+	resp, ok := f.getResponses[number]
+	if !ok {
+		resp.status = http.StatusNotFound
+	}
+	if resp.status == 0 {
+		return resp.issue, &github.Response{}, nil
+	}
+	httpResp := &http.Response{
+		StatusCode: resp.status,
+		Request:    httptest.NewRequest(http.MethodGet, fmt.Sprintf("https://api.github.com/repos/%s/%s/issues/%d", owner, repo, number), nil),
+	}
+	return nil, &github.Response{Response: httpResp}, &github.ErrorResponse{Response: httpResp}
+}
+
+func (f *fakeGitHubIssueClient) GetPullRequest(ctx context.Context, owner, repo string, number int) (*github.PullRequest, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.pullRequestErrs[number]; err != nil {
+		return nil, nil, err
+	}
+	resp, err := f.detailResponse(ctx, fmt.Sprintf("pulls/%d", number))
+	if err != nil {
+		return nil, resp, err
+	}
+	if pr, ok := f.pullRequests[number]; ok {
+		return pr, resp, nil
+	}
+	return &github.PullRequest{Number: github.Ptr(number)}, resp, nil
+}
+
+func (f *fakeGitHubIssueClient) ListPullRequestReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resp, err := f.detailResponse(ctx, fmt.Sprintf("pulls/%d/reviews", number))
+	if err != nil {
+		return nil, resp, err
+	}
+	return f.reviews[number], resp, nil
+}
+
+func (f *fakeGitHubIssueClient) ListIssueTimeline(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.Timeline, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.timelineErrs[number]; err != nil {
+		return nil, nil, err
+	}
+	resp, err := f.detailResponse(ctx, fmt.Sprintf("issues/%d/timeline", number))
+	if err != nil {
+		return nil, resp, err
+	}
+	return f.timelines[number], resp, nil
+}
+
+// detailResponse answers like GitHub for an endpoint with an etag: 304 when the request carries it.
+func (f *fakeGitHubIssueClient) detailResponse(ctx context.Context, endpoint string) (*github.Response, error) {
+	etag := f.detailETags[endpoint]
+	sent, _ := ctx.Value(githubETagKey{}).(dao.GitHubETag)
+	notModified := etag != "" && sent.ETag == etag
+	if !notModified {
+		f.detailFetches = append(f.detailFetches, endpoint)
+	}
+	return fakeGitHubHTTPResponse(0, github.Rate{}, etag, notModified)
+}
+
+func (f *fakeGitHubIssueClient) ListLabels(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return nextFakeCatalogPage(ctx, &f.labels)
+}
+
+func (f *fakeGitHubIssueClient) ListMilestones(ctx context.Context, owner, repo string, opts *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return nextFakeCatalogPage(ctx, &f.milestones)
+}
+
+func (f *fakeGitHubIssueClient) ListOrgRepos(_ context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return fakeOwnerRepos(f.orgRepos, org)
+}
+
+func (f *fakeGitHubIssueClient) ListUserRepos(_ context.Context, user string, opts *github.RepositoryListByUserOptions) ([]*github.Repository, *github.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return fakeOwnerRepos(f.userRepos, user)
+}
+
+// fakeGitHubAPIError is the error go-github returns for an HTTP error status.
+func fakeGitHubAPIError(status int) error {
+	resp := &http.Response{
+		StatusCode: status,
+		Request:    httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/owner/repo", nil),
+	}
+	return &github.ErrorResponse{Response: resp, Message: http.StatusText(status)}
+}
+
+func fakeOwnerRepos(repos map[string][]*github.Repository, owner string) ([]*github.Repository, *github.Response, error) {
+	listed, ok := repos[owner]
+	if !ok {
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+		return nil, resp, &github.ErrorResponse{Response: resp.Response, Message: "Not Found"}
+	}
+	return listed, &github.Response{}, nil
+}
+
+// nextFakeCatalogPage pops the next single-page catalog response. A queued 304 is only returned to
+// requests that carry the matching validator.
+func nextFakeCatalogPage[T any](ctx context.Context, queue *[]fakeGitHubCatalogResponse[T]) ([]T, *github.Response, error) {
+	if len(*queue) == 0 {
+		return nil, &github.Response{}, nil
+	}
+	resp := (*queue)[0]
+	*queue = (*queue)[1:]
+	if resp.err != nil {
+		return nil, nil, resp.err
+	}
+	etag, _ := ctx.Value(githubETagKey{}).(dao.GitHubETag)
+	notModified := resp.notModified && etag.ETag != "" && etag.ETag == resp.etag
+	ghResp, err := fakeGitHubHTTPResponse(0, github.Rate{}, resp.etag, notModified)
+	if err != nil {
+		return nil, ghResp, err
+	}
+	return resp.items, ghResp, nil
 }
 
 func (f *fakeIssueCommentStore) SaveComments(_ context.Context, repo string, issueID int64, issueNumber int32, comments []dao.IssueComment) error {
@@ -317,7 +829,7 @@ func ghIssue(id int64, number int, updated time.Time) *github.Issue {
 
 func TestNormalizeSyncConfigDefaults(t *testing.T) {
 	cfg := normalizeSyncConfig(conf.GitHubSyncConfig{})
-	if cfg.IntervalSeconds != 300 || cfg.PageSize != 100 || cfg.MaxPagesPerRun != 10 || cfg.RequestTimeoutSeconds != 15 {
+	if cfg.IntervalSeconds != 300 || cfg.PageSize != 100 || cfg.MaxPagesPerRun != 10 || cfg.RequestTimeoutSeconds != 15 || cfg.Concurrency != 4 {
 		t.Fatalf("unexpected defaults: %#v", cfg)
 	}
 }
@@ -407,10 +919,108 @@ func TestRunSyncRetryThenSuccess(t *testing.T) {
 	}
 }
 
-func TestRunSyncPreservesExistingAISummary(t *testing.T) {
-	store := newFakeSyncStore()
-	existingUpdated := time.Now().UTC().Round(time.Second)
-	_, _ = store.UpsertIssues(context.Background(), "owner/repo", []dao.SyncedIssue{{
+// concurrentIssueClient holds each ListByRepo call until `want` calls overlap.
+type concurrentIssueClient struct {
+	want int
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	overlapped  chan struct{}
+	once        sync.Once
+}
+
+func (c *concurrentIssueClient) ListByRepo(context.Context, string, string, *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.maxInFlight {
+		c.maxInFlight = c.inFlight
+	}
+	if c.inFlight == c.want {
+		c.once.Do(func() { close(c.overlapped) })
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-c.overlapped:
+	case <-time.After(time.Second):
+	}
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return []*github.Issue{ghIssue(1, 1, time.Now().UTC())}, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListComments(context.Context, string, string, int, *github.IssueListCommentsOptions) ([]*github.IssueComment, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) Get(context.Context, string, string, int) (*github.Issue, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) GetPullRequest(context.Context, string, string, int) (*github.PullRequest, *github.Response, error) {
+	return &github.PullRequest{}, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListPullRequestReviews(context.Context, string, string, int, *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListIssueTimeline(context.Context, string, string, int, *github.ListOptions) ([]*github.Timeline, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListLabels(context.Context, string, string, *github.ListOptions) ([]*github.Label, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListMilestones(context.Context, string, string, *github.MilestoneListOptions) ([]*github.Milestone, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListOrgRepos(context.Context, string, *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func (c *concurrentIssueClient) ListUserRepos(context.Context, string, *github.RepositoryListByUserOptions) ([]*github.Repository, *github.Response, error) {
+	return nil, &github.Response{}, nil
+}
+
+func TestRunSyncBoundsConcurrentRepos(t *testing.T) {
+	store := newFakeSyncStore()
+	client := &concurrentIssueClient{want: 2, overlapped: make(chan struct{})}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"o/d", "o/c", "o/b", "o/a"},
+		RequestTimeoutSeconds: 5,
+		Concurrency:           2,
+	}, nil)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if client.maxInFlight != 2 {
+		t.Fatalf("max in-flight requests = %d, want 2", client.maxInFlight)
+	}
+	want := []string{"o/a", "o/b", "o/c", "o/d"}
+	if len(summary.Results) != len(want) {
+		t.Fatalf("results len = %d, want %d", len(summary.Results), len(want))
+	}
+	for i, repo := range want {
+		if summary.Results[i].Repo != repo || summary.Results[i].Persisted != 1 {
+			t.Fatalf("results[%d] = %#v, want %s with 1 persisted", i, summary.Results[i], repo)
+		}
+	}
+}
+
+func TestRunSyncPreservesExistingAISummary(t *testing.T) {
+	store := newFakeSyncStore()
+	existingUpdated := time.Now().UTC().Round(time.Second)
+	_, _ = store.UpsertIssues(context.Background(), "owner/repo", []dao.SyncedIssue{{
 		Repo:      "owner/repo",
 		IssueID:   1,
 		Number:    1,
@@ -494,6 +1104,450 @@ func TestRunSyncPersistsCommentsToBlobStore(t *testing.T) {
 	}
 }
 
+func TestRunSyncStoresPullRequestDetails(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC()
+	pr := ghIssue(9, 99, updated)
+	pr.PullRequestLinks = &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/99")}
+	submitted := updated.Add(-time.Minute)
+	review := func(login, state string, at time.Time) *github.PullRequestReview {
+		return &github.PullRequestReview{
+			User:        &github.User{Login: github.Ptr(login)},
+			State:       github.Ptr(state),
+			SubmittedAt: &github.Timestamp{Time: at},
+		}
+	}
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(8, 88, updated), pr}, nextPage: 0},
+		},
+		pullRequests: map[int]*github.PullRequest{
+			99: {
+				Number:             github.Ptr(99),
+				Draft:              github.Ptr(false),
+				Head:               &github.PullRequestBranch{Ref: github.Ptr("feature"), SHA: github.Ptr("abc123")},
+				Base:               &github.PullRequestBranch{Ref: github.Ptr("main")},
+				Additions:          github.Ptr(10),
+				Deletions:          github.Ptr(2),
+				ChangedFiles:       github.Ptr(3),
+				RequestedReviewers: []*github.User{{Login: github.Ptr("carol")}},
+			},
+		},
+		reviews: map[int][]*github.PullRequestReview{
+			99: {
+				review("bob", "APPROVED", submitted.Add(-time.Hour)),
+				review("bob", "COMMENTED", submitted),
+				review("dave", "PENDING", submitted),
+			},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+
+	if len(store.prs) != 1 {
+		t.Fatalf("stored pull requests = %d, want 1", len(store.prs))
+	}
+	got := store.prs[9]
+	if got.Repo != "owner/repo" || got.Number != 99 || got.HeadRef != "feature" || got.BaseRef != "main" || got.ChangedFiles != 3 {
+		t.Fatalf("pull request detail = %#v", got)
+	}
+	if len(got.Reviews) != 1 || got.Reviews[0].Reviewer != "bob" || got.Reviews[0].State != "APPROVED" {
+		t.Fatalf("reviews = %#v, want bob APPROVED", got.Reviews)
+	}
+	if got.ReviewDecision != dao.ReviewDecisionReviewRequired {
+		t.Fatalf("review_decision = %q, want %q while carol is requested", got.ReviewDecision, dao.ReviewDecisionReviewRequired)
+	}
+}
+
+func TestRunSyncSkipsPullRequestDetailFailures(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC().Round(time.Second)
+	pull := func(id int64, number int) *github.Issue {
+		issue := ghIssue(id, number, updated)
+		issue.PullRequestLinks = &github.PullRequestLinks{URL: github.Ptr(fmt.Sprintf("https://api.github.com/repos/owner/repo/pulls/%d", number))}
+		return issue
+	}
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{pull(1, 11), pull(2, 22)}},
+		},
+		pullRequestErrs: map[int]error{11: fakeGitHubAPIError(http.StatusNotFound)},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Persisted != 2 {
+		t.Fatalf("result = %#v, want both pull requests persisted", res)
+	}
+	if _, ok := store.prs[1]; ok || len(store.prs) != 1 {
+		t.Fatalf("stored pull requests = %#v, want only the detail of #22", store.prs)
+	}
+	if cp := store.checkpoints["owner/repo"]; cp.LastRunStatus != "success" || !cp.LastIssueUpdatedAt.Equal(updated) {
+		t.Fatalf("checkpoint = %#v, want success at %v", cp, updated)
+	}
+
+	// A server error would fail the next requests too, so the repo stops where it was.
+	client.responses = []fakeGitHubResponse{{issues: []*github.Issue{pull(3, 33)}}}
+	client.pullRequestErrs = map[int]error{33: fakeGitHubAPIError(http.StatusBadGateway)}
+	summary, err = svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("second RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err == "" || res.Persisted != 0 {
+		t.Fatalf("second result = %#v, want the repo failed", res)
+	}
+	if cp := store.checkpoints["owner/repo"]; cp.LastRunStatus != "failed" || !cp.LastIssueUpdatedAt.Equal(updated) {
+		t.Fatalf("checkpoint = %#v, want failed and kept at %v", cp, updated)
+	}
+}
+
+func TestRunSyncStoresIssueTimelineEvents(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC()
+	at := func(minutes int) *github.Timestamp {
+		return &github.Timestamp{Time: updated.Add(time.Duration(minutes) * time.Minute).Truncate(time.Second)}
+	}
+	client := &fakeGitHubIssueClient{
+		timelines: map[int][]*github.Timeline{
+			55: {
+				{ID: github.Ptr(int64(1)), Event: github.Ptr("labeled"), Actor: &github.User{Login: github.Ptr("alice")}, Label: &github.Label{Name: github.Ptr("bug")}, CreatedAt: at(-30)},
+				{ID: github.Ptr(int64(2)), Event: github.Ptr("commented"), Actor: &github.User{Login: github.Ptr("bob")}, CreatedAt: at(-20)},
+				{Event: github.Ptr("cross-referenced"), CreatedAt: at(-10), Source: &github.Source{
+					Actor: &github.User{Login: github.Ptr("carol")},
+					Issue: &github.Issue{Number: github.Ptr(7), RepositoryURL: github.Ptr("https://api.github.com/repos/other/repo")},
+				}},
+				{ID: github.Ptr(int64(3)), Event: github.Ptr("closed"), Actor: &github.User{Login: github.Ptr("bob")}, CreatedAt: at(-5)},
+			},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	// The second run stores the same timeline again and must not duplicate events.
+	for run := 0; run < 2; run++ {
+		client.responses = []fakeGitHubResponse{{issues: []*github.Issue{ghIssue(5, 55, updated)}, nextPage: 0}}
+		if _, err := svc.RunSync(context.Background(), ""); err != nil {
+			t.Fatalf("RunSync() error = %v", err)
+		}
+	}
+
+	events, err := store.ListIssueEvents(context.Background(), dao.IssueEventFilter{Repo: "owner/repo", IssueNumber: 55})
+	if err != nil {
+		t.Fatalf("ListIssueEvents() error = %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("events len = %d, want 3 without duplicates or comments: %#v", len(events), events)
+	}
+	if events[0].Event != dao.IssueEventLabeled || events[0].Label != "bug" || events[0].Actor != "alice" {
+		t.Fatalf("events[0] = %#v, want alice labeled bug", events[0])
+	}
+	if events[1].Event != dao.IssueEventCrossReferenced || events[1].Source != "other/repo#7" || events[1].Actor != "carol" || events[1].Key == "" {
+		t.Fatalf("events[1] = %#v, want cross-reference from other/repo#7", events[1])
+	}
+	if events[2].Event != dao.IssueEventClosed || events[2].Actor != "bob" {
+		t.Fatalf("events[2] = %#v, want closed by bob", events[2])
+	}
+}
+
+func TestRunSyncSkipsFailedTimelineAndPersistsPage(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC().Round(time.Second)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(1, 11, updated), ghIssue(2, 22, updated)}},
+		},
+		timelines: map[int][]*github.Timeline{
+			22: {{ID: github.Ptr(int64(1)), Event: github.Ptr("closed"), Actor: &github.User{Login: github.Ptr("bob")}, CreatedAt: &github.Timestamp{Time: updated}}},
+		},
+		timelineErrs: map[int]error{11: fakeGitHubAPIError(http.StatusGone)},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Persisted != 2 {
+		t.Fatalf("result = %#v, want both issues persisted", res)
+	}
+	events, err := store.ListIssueEvents(context.Background(), dao.IssueEventFilter{Repo: "owner/repo"})
+	if err != nil {
+		t.Fatalf("ListIssueEvents() error = %v", err)
+	}
+	if len(events) != 1 || events[0].IssueNumber != 22 {
+		t.Fatalf("events = %#v, want the timeline of #22 only", events)
+	}
+	if cp := store.checkpoints["owner/repo"]; cp.LastRunStatus != "success" || !cp.LastIssueUpdatedAt.Equal(updated) {
+		t.Fatalf("checkpoint = %#v, want success at %v", cp, updated)
+	}
+}
+
+func TestRunSyncSendsValidatorsForPullRequestDetailsAndTimelines(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC().Round(time.Second)
+	pull := func(at time.Time) *github.Issue {
+		issue := ghIssue(9, 99, at)
+		issue.PullRequestLinks = &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/99")}
+		return issue
+	}
+	review := func(login, state string) *github.PullRequestReview {
+		return &github.PullRequestReview{User: &github.User{Login: github.Ptr(login)}, State: github.Ptr(state), SubmittedAt: &github.Timestamp{Time: updated}}
+	}
+	client := &fakeGitHubIssueClient{
+		pullRequests: map[int]*github.PullRequest{99: {Number: github.Ptr(99), Head: &github.PullRequestBranch{Ref: github.Ptr("feature")}}},
+		reviews:      map[int][]*github.PullRequestReview{99: {review("bob", "COMMENTED")}},
+		timelines: map[int][]*github.Timeline{
+			99: {{ID: github.Ptr(int64(1)), Event: github.Ptr("labeled"), Label: &github.Label{Name: github.Ptr("bug")}, CreatedAt: &github.Timestamp{Time: updated}}},
+		},
+		detailETags: map[string]string{
+			"pulls/99":           `"pr-v1"`,
+			"pulls/99/reviews":   `"reviews-v1"`,
+			"issues/99/timeline": `"timeline-v1"`,
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	client.responses = []fakeGitHubResponse{{issues: []*github.Issue{pull(updated)}}}
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	for _, key := range []string{"owner/repo|pulls/99|1", "owner/repo|pulls/99/reviews|1", "owner/repo|issues/99/timeline|1"} {
+		if _, ok := store.etags[key]; !ok {
+			t.Fatalf("etags = %#v, want %s stored", store.etags, key)
+		}
+	}
+
+	// A comment bumps the pull request without touching its detail or timeline.
+	client.detailFetches = nil
+	store.prs[9] = dao.PullRequestDetail{IssueID: 9, HeadRef: "stored"}
+	client.responses = []fakeGitHubResponse{{issues: []*github.Issue{pull(updated.Add(time.Minute))}}}
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("second RunSync() error = %v", err)
+	}
+	if len(client.detailFetches) != 0 {
+		t.Fatalf("detail fetches = %v, want every request answered with 304", client.detailFetches)
+	}
+	if got := store.prs[9]; got.HeadRef != "stored" {
+		t.Fatalf("pull request = %#v, want the stored detail kept", got)
+	}
+
+	// A new approval changes the reviews only; the pull request is read again to settle the decision.
+	client.detailFetches = nil
+	client.reviews[99] = append(client.reviews[99], review("carol", "APPROVED"))
+	client.detailETags["pulls/99/reviews"] = `"reviews-v2"`
+	client.responses = []fakeGitHubResponse{{issues: []*github.Issue{pull(updated.Add(2 * time.Minute))}}}
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("third RunSync() error = %v", err)
+	}
+	if want := []string{"pulls/99/reviews", "pulls/99"}; !slices.Equal(client.detailFetches, want) {
+		t.Fatalf("detail fetches = %v, want %v", client.detailFetches, want)
+	}
+	if got := store.prs[9]; got.HeadRef != "feature" || got.ReviewDecision != dao.ReviewDecisionApproved {
+		t.Fatalf("pull request = %#v, want the refreshed detail approved", got)
+	}
+	if got := store.etags["owner/repo|pulls/99/reviews|1"]; got.ETag != `"reviews-v2"` {
+		t.Fatalf("reviews etag = %#v, want the new validator", got)
+	}
+}
+
+func TestRunSyncReplacesLabelAndMilestoneCatalog(t *testing.T) {
+	store := newFakeSyncStore()
+	updated := time.Now().UTC()
+	issue := ghIssue(3, 33, updated)
+	issue.Milestone = &github.Milestone{Number: github.Ptr(2)}
+	label := func(id int64, name string) *github.Label {
+		return &github.Label{ID: github.Ptr(id), Name: github.Ptr(name), Color: github.Ptr("d73a4a"), Description: github.Ptr(name + " label")}
+	}
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{{issues: []*github.Issue{issue}}},
+		labels: []fakeGitHubCatalogResponse[*github.Label]{
+			{items: []*github.Label{label(1, "bug"), label(2, "stale")}, etag: `"labels-v1"`},
+			{items: []*github.Label{label(1, "bug")}, etag: `"labels-v2"`},
+		},
+		milestones: []fakeGitHubCatalogResponse[*github.Milestone]{
+			{items: []*github.Milestone{{ID: github.Ptr(int64(20)), Number: github.Ptr(2), Title: github.Ptr("v1.0"), State: github.Ptr("open")}}, etag: `"milestones-v1"`},
+			{etag: `"milestones-v1"`, notModified: true},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(store.labels["owner/repo"]) != 2 || len(store.milestones["owner/repo"]) != 1 {
+		t.Fatalf("catalog = %d labels, %d milestones, want 2 and 1", len(store.labels["owner/repo"]), len(store.milestones["owner/repo"]))
+	}
+	rows, _ := store.ListIssues(context.Background(), dao.SyncIssueFilter{Repo: "owner/repo", Number: 33})
+	if len(rows) != 1 || rows[0].Milestone != 2 {
+		t.Fatalf("issue rows = %#v, want milestone 2", rows)
+	}
+
+	// The second run drops the deleted label and keeps the milestones GitHub reports unchanged.
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("second RunSync() error = %v", err)
+	}
+	labels := store.labels["owner/repo"]
+	if len(labels) != 1 || labels[0].Name != "bug" || labels[0].Color != "d73a4a" {
+		t.Fatalf("labels = %#v, want only bug", labels)
+	}
+	if len(store.milestones["owner/repo"]) != 1 || store.milestones["owner/repo"][0].Title != "v1.0" {
+		t.Fatalf("milestones = %#v, want v1.0 kept", store.milestones["owner/repo"])
+	}
+}
+
+func TestRunSyncCatalogFailureKeepsSyncingIssues(t *testing.T) {
+	store := newFakeSyncStore()
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{{issues: []*github.Issue{ghIssue(3, 33, time.Now().UTC())}}},
+		labels:    []fakeGitHubCatalogResponse[*github.Label]{{err: fakeGitHubAPIError(http.StatusForbidden)}},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, nil)
+	svc.client = client
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	if len(summary.Results) != 1 || summary.Results[0].Persisted != 1 || summary.Results[0].Err == "" {
+		t.Fatalf("results = %#v, want the issue persisted and the catalog error recorded", summary.Results)
+	}
+	cp := store.checkpoints["owner/repo"]
+	if cp.LastRunStatus != "success" || cp.LastError == "" || cp.LastIssueUpdatedAt.IsZero() {
+		t.Fatalf("checkpoint = %#v, want it advanced with the catalog error", cp)
+	}
+}
+
+func TestRunSyncSendsStoredETagsAndSkipsUnchangedPages(t *testing.T) {
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	updated := time.Now().UTC().Round(time.Second)
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(1, 1, updated)}, nextPage: 2, etag: `W/"issues-1"`},
+			{issues: []*github.Issue{ghIssue(2, 2, updated)}, nextPage: 0, etag: `W/"issues-2"`},
+			{notModified: true},
+			{notModified: true},
+		},
+		commentResponses: map[int][]fakeGitHubCommentResponse{
+			1: {{comments: []*github.IssueComment{{ID: github.Ptr(int64(11))}}, etag: `W/"comments-1"`}},
+			2: {{comments: []*github.IssueComment{{ID: github.Ptr(int64(21))}}, etag: `W/"comments-2"`}},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.client = client
+
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("first RunSync() error = %v", err)
+	}
+	if got := store.etags["owner/repo|issues/1/comments|1"].ETag; got != `W/"comments-1"` {
+		t.Fatalf("stored comment etag = %q", got)
+	}
+
+	summary, err := svc.RunSync(context.Background(), "")
+	if err != nil {
+		t.Fatalf("second RunSync() error = %v", err)
+	}
+	if res := summary.Results[0]; res.Err != "" || res.Fetched != 0 {
+		t.Fatalf("second run result = %#v, want nothing fetched", res)
+	}
+	if store.upsertCalls != 2 {
+		t.Fatalf("upsert calls = %d, want 2 from the first run only", store.upsertCalls)
+	}
+	if len(client.calls) != 4 || client.calls[2].ifNoneMatch != `W/"issues-1"` || client.calls[3].ifNoneMatch != `W/"issues-2"` {
+		t.Fatalf("calls = %#v, want both pages revalidated with their etags", client.calls)
+	}
+	if client.calls[3].opts.Page != 2 {
+		t.Fatalf("second revalidated page = %d, want 2 from the stored next page", client.calls[3].opts.Page)
+	}
+	if cp, _ := store.GetRepoCheckpoint(context.Background(), "owner/repo"); cp.LastRunStatus != "success" {
+		t.Fatalf("checkpoint status = %q, want success", cp.LastRunStatus)
+	}
+}
+
+func TestRunSyncFillsUnchangedCommentPagesFromStore(t *testing.T) {
+	store := newFakeSyncStore()
+	commentStore := newFakeIssueCommentStore()
+	stored := make([]dao.IssueComment, 0, githubCommentsPerPage+1)
+	for i := 0; i <= githubCommentsPerPage; i++ {
+		stored = append(stored, dao.IssueComment{ID: int64(i + 1)})
+	}
+	commentStore.saved["owner/repo/7-77.json"] = stored
+	_ = store.SaveGitHubETag(context.Background(), dao.GitHubETag{Repo: "owner/repo", Endpoint: "issues/77/comments", Page: 1, ETag: `W/"p1"`, NextPage: 2})
+	_ = store.SaveGitHubETag(context.Background(), dao.GitHubETag{Repo: "owner/repo", Endpoint: "issues/77/comments", Page: 2, ETag: `W/"p2"`})
+
+	client := &fakeGitHubIssueClient{
+		responses: []fakeGitHubResponse{
+			{issues: []*github.Issue{ghIssue(7, 77, time.Now().UTC())}},
+		},
+		commentResponses: map[int][]fakeGitHubCommentResponse{
+			77: {
+				{notModified: true},
+				{comments: []*github.IssueComment{{ID: github.Ptr(int64(101))}, {ID: github.Ptr(int64(102))}}, etag: `W/"p2b"`},
+			},
+		},
+	}
+	svc := NewIssueSyncService(store, conf.GitHubConfig{}, conf.GitHubSyncConfig{
+		Enabled:               true,
+		Repos:                 []string{"owner/repo"},
+		RequestTimeoutSeconds: 5,
+	}, commentStore)
+	svc.client = client
+
+	if _, err := svc.RunSync(context.Background(), ""); err != nil {
+		t.Fatalf("RunSync() error = %v", err)
+	}
+	saved := commentStore.saved["owner/repo/7-77.json"]
+	if len(saved) != githubCommentsPerPage+2 || saved[0].ID != 1 || saved[githubCommentsPerPage+1].ID != 102 {
+		t.Fatalf("saved comments len = %d, want stored first page plus 2 fresh comments", len(saved))
+	}
+	if got := store.etags["owner/repo|issues/77/comments|2"].ETag; got != `W/"p2b"` {
+		t.Fatalf("page 2 etag = %q, want refreshed", got)
+	}
+}
+
 func TestSplitRepo(t *testing.T) {
 	owner, repo, err := splitRepo("octo/hello")
 	if err != nil || owner != "octo" || repo != "hello" {